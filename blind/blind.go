@@ -0,0 +1,279 @@
+//Package blind implements blind SM2 signatures: a requester has a digest
+// signed without the signer learning the digest or the signature, and the
+// result is an ordinary SM2 signature accepted by SM2PublicKey.Verify.
+//
+// The signer commits to K = k * G. The requester picks blinding factors
+// a, b, c, computes R' = a * K + b * G + c * P, r = e + x(R') and sends the
+// blinded r~ = a^-1 * (r + b - c). The signer answers s~ = (1+d)^-1 *
+// (k - r~ * d), so that s~ * G + (s~ + r~) * P = K, and the requester
+// unblinds s = a * s~ + b, for which s * G + (s + r) * P = R'.
+//
+// Every session seen by the signer is explained by some blinding factors
+// for every valid signature (see Explain), so the signer cannot link a
+// signature to the session that produced it.
+package blind
+
+import (
+	"crypto/rand"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"io"
+	"math/big"
+)
+
+//ErrSessionUsed is returned when a signer session is asked to sign twice,
+// which would reveal the private key.
+var ErrSessionUsed = errors.New("blind: signer session already used")
+
+//Signer issues blind signatures with a private key.
+type Signer struct {
+	key  *gm.SM2PrivateKey
+	rand io.Reader
+}
+
+//NewSigner returns a signer of key. A nil rand uses crypto/rand.
+func NewSigner(key *gm.SM2PrivateKey, rand io.Reader) *Signer {
+	return &Signer{key: key, rand: defaultRand(rand)}
+}
+
+//SignerSession is one signing session of a signer, it signs a single blinded
+// digest.
+type SignerSession struct {
+	key *gm.SM2PrivateKey
+	k   *big.Int
+}
+
+//Commit starts a session and returns the commitment K = k * G to send to the
+// requester, 65 bytes.
+func (s *Signer) Commit() (*SignerSession, []byte, error) {
+	k, err := ec.RandScalar(s.rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &SignerSession{key: s.key, k: k}, ec.BaseMul(k).Marshal(), nil
+}
+
+//Sign signs the blinded digest r~ of the requester and returns the blinded
+// signature s~, 32 bytes. The session cannot sign again.
+func (ss *SignerSession) Sign(blinded []byte) ([]byte, error) {
+	if ss.k == nil {
+		return nil, ErrSessionUsed
+	}
+	rt, err := parseScalar(blinded)
+	if err != nil {
+		return nil, err
+	}
+	k := ss.k
+	ss.k = nil
+	defer k.SetInt64(0)
+
+	d, err := ec.PrivateScalar(ss.key)
+	if err != nil {
+		return nil, err
+	}
+	inv := new(big.Int).ModInverse(new(big.Int).Add(d, big.NewInt(1)), ec.N)
+	if inv == nil {
+		return nil, errors.New("blind: invalid private key")
+	}
+	st := new(big.Int).Mul(rt, d)
+	st.Sub(k, st)
+	st.Mul(st, inv)
+	st.Mod(st, ec.N)
+	if st.Sign() == 0 {
+		return nil, errors.New("blind: degenerate signature, sign again")
+	}
+	return st.FillBytes(make([]byte, 32)), nil
+}
+
+//Factors are the blinding factors a, b, c of a requester.
+type Factors struct {
+	A, B, C *big.Int
+}
+
+//Requester obtains a blind signature on one digest.
+type Requester struct {
+	pub     *gm.SM2PublicKey
+	rand    io.Reader
+	f       *Factors
+	r       *big.Int
+	digest  []byte
+	blinded []byte
+}
+
+//NewRequester returns a requester of a signature under pub. A nil rand uses
+// crypto/rand.
+func NewRequester(pub *gm.SM2PublicKey, rand io.Reader) *Requester {
+	return &Requester{pub: pub, rand: defaultRand(rand)}
+}
+
+//Blind draws the blinding factors and returns the blinded digest r~ for the
+// commitment of the signer, 32 bytes. digest is the hash returned by
+// gm.HashBeforeSM2.
+func (q *Requester) Blind(commitment, digest []byte) ([]byte, error) {
+	if q.blinded != nil {
+		return nil, errors.New("blind: requester already used")
+	}
+	for {
+		a, err := ec.RandScalar(q.rand)
+		if err != nil {
+			return nil, err
+		}
+		b, err := ec.RandScalar(q.rand)
+		if err != nil {
+			return nil, err
+		}
+		c, err := ec.RandScalar(q.rand)
+		if err != nil {
+			return nil, err
+		}
+		q.f = &Factors{A: a, B: b, C: c}
+		blinded, err := q.blind(commitment, digest)
+		if err != errDegenerate {
+			return blinded, err
+		}
+	}
+}
+
+var errDegenerate = errors.New("blind: degenerate blinding")
+
+func (q *Requester) blind(commitment, digest []byte) ([]byte, error) {
+	if len(digest) != 32 {
+		return nil, errors.New("blind: digest must be the 32-byte SM3 hash of Z || M")
+	}
+	k, err := parseCommitment(commitment)
+	if err != nil {
+		return nil, err
+	}
+	p, err := ec.FromPublicKey(q.pub)
+	if err != nil {
+		return nil, errors.New("blind: invalid public key")
+	}
+
+	f := q.f
+	rp := ec.Add(ec.Add(ec.Mul(k, f.A), ec.BaseMul(f.B)), ec.Mul(p, f.C))
+	if rp.IsInfinity() {
+		return nil, errDegenerate
+	}
+	r := new(big.Int).SetBytes(digest)
+	r.Add(r, rp.X())
+	r.Mod(r, ec.N)
+	if r.Sign() == 0 {
+		return nil, errDegenerate
+	}
+	rt := new(big.Int).Add(r, f.B)
+	rt.Sub(rt, f.C)
+	rt.Mul(rt, new(big.Int).ModInverse(f.A, ec.N))
+	rt.Mod(rt, ec.N)
+	if rt.Sign() == 0 {
+		return nil, errDegenerate
+	}
+	q.r = r
+	q.digest = append([]byte(nil), digest...)
+	q.blinded = rt.FillBytes(make([]byte, 32))
+	return q.blinded, nil
+}
+
+//Unblind turns the blinded signature s~ of the signer into the DER encoded
+// signature of the digest, after checking it.
+func (q *Requester) Unblind(blindedSig []byte) ([]byte, error) {
+	if q.blinded == nil {
+		return nil, errors.New("blind: Blind has not been called")
+	}
+	st, err := parseScalar(blindedSig)
+	if err != nil {
+		return nil, err
+	}
+	s := new(big.Int).Mul(q.f.A, st)
+	s.Add(s, q.f.B)
+	s.Mod(s, ec.N)
+	if s.Sign() == 0 || new(big.Int).Add(q.r, s).Cmp(ec.N) == 0 {
+		return nil, errors.New("blind: degenerate signature, sign again")
+	}
+	sig, err := gm.MarshalSignature(q.r, s)
+	if err != nil {
+		return nil, err
+	}
+	if ok, err := q.pub.Verify(nil, sig, q.digest); !ok || err != nil {
+		return nil, errors.New("blind: the signer returned an invalid signature")
+	}
+	return sig, nil
+}
+
+//View is what the signer sees of one session.
+type View struct {
+	Commitment, BlindedDigest, BlindedSignature []byte
+}
+
+//Explain returns, for any nonzero a, the blinding factors with which the
+// session seen in view yields the signature sig of digest. They exist for
+// every valid session and every valid signature, which is why the signer
+// cannot link signatures to sessions.
+func Explain(pub *gm.SM2PublicKey, view *View, digest, sig []byte, a *big.Int) (*Factors, error) {
+	if ok, err := pub.Verify(nil, sig, digest); !ok || err != nil {
+		return nil, errors.New("blind: invalid signature")
+	}
+	if a.Sign() <= 0 || a.Cmp(ec.N) >= 0 {
+		return nil, errors.New("blind: a must be in [1, n-1]")
+	}
+	r, s, err := gm.ParseSignatureFlexible(sig)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := parseScalar(view.BlindedDigest)
+	if err != nil {
+		return nil, err
+	}
+	st, err := parseScalar(view.BlindedSignature)
+	if err != nil {
+		return nil, err
+	}
+	k, err := parseCommitment(view.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	p, err := ec.FromPublicKey(pub)
+	if err != nil {
+		return nil, errors.New("blind: invalid public key")
+	}
+
+	// the session must be a valid blinded signature: s~ * G + (s~ + r~) * P = K
+	if !ec.Add(ec.BaseMul(st), ec.Mul(p, new(big.Int).Add(st, rt))).Equal(k) {
+		return nil, errors.New("blind: invalid session")
+	}
+
+	b := new(big.Int).Mul(a, st)
+	b.Sub(s, b)
+	b.Mod(b, ec.N)
+	c := new(big.Int).Mul(a, rt)
+	c.Sub(new(big.Int).Add(r, b), c)
+	c.Mod(c, ec.N)
+	return &Factors{A: new(big.Int).Set(a), B: b, C: c}, nil
+}
+
+func defaultRand(r io.Reader) io.Reader {
+	if r == nil {
+		return rand.Reader
+	}
+	return r
+}
+
+// parseScalar decodes a scalar in [1, n-1].
+func parseScalar(b []byte) (*big.Int, error) {
+	k, err := ec.ParseScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	if k.Sign() == 0 {
+		return nil, errors.New("blind: scalar out of range")
+	}
+	return k, nil
+}
+
+func parseCommitment(b []byte) (ec.Point, error) {
+	k, err := ec.Unmarshal(b)
+	if err != nil {
+		return ec.Point{}, errors.New("blind: invalid commitment")
+	}
+	return k, nil
+}
@@ -0,0 +1,110 @@
+package blind
+
+import (
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+type session struct {
+	view   *View
+	digest []byte
+	sig    []byte
+}
+
+func run(t *testing.T, signer *Signer, pub *gm.SM2PublicKey, msg []byte) session {
+	ss, commitment, err := signer.Commit()
+	assert.Nil(t, err)
+	digest := gm.HashBeforeSM2(pub, msg)
+	q := NewRequester(pub, nil)
+	blinded, err := q.Blind(commitment, digest)
+	assert.Nil(t, err)
+	blindedSig, err := ss.Sign(blinded)
+	assert.Nil(t, err)
+	sig, err := q.Unblind(blindedSig)
+	assert.Nil(t, err)
+	return session{&View{commitment, blinded, blindedSig}, digest, sig}
+}
+
+func TestBlindSignature(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	pub := &key.PublicKey
+	signer := NewSigner(key, nil)
+
+	s := run(t, signer, pub, []byte("anonymous credential"))
+	ok, err := pub.Verify(nil, s.sig, s.digest)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// the signer sees neither the digest nor the signature
+	assert.NotContains(t, string(s.view.BlindedDigest), string(s.digest))
+	assert.NotContains(t, string(s.sig), string(s.view.BlindedSignature))
+}
+
+func TestSessionSingleUse(t *testing.T) {
+	key, _ := gm.GenerateSM2Key()
+	ss, commitment, err := NewSigner(key, nil).Commit()
+	assert.Nil(t, err)
+	q := NewRequester(&key.PublicKey, nil)
+	blinded, err := q.Blind(commitment, gm.HashBeforeSM2(&key.PublicKey, []byte("msg")))
+	assert.Nil(t, err)
+	_, err = ss.Sign(blinded)
+	assert.Nil(t, err)
+	_, err = ss.Sign(blinded)
+	assert.Equal(t, ErrSessionUsed, err)
+	_, err = q.Blind(commitment, gm.HashBeforeSM2(&key.PublicKey, []byte("msg")))
+	assert.NotNil(t, err)
+}
+
+func TestUnblindRejectsBadSignature(t *testing.T) {
+	key, _ := gm.GenerateSM2Key()
+	ss, commitment, _ := NewSigner(key, nil).Commit()
+	q := NewRequester(&key.PublicKey, nil)
+	blinded, _ := q.Blind(commitment, gm.HashBeforeSM2(&key.PublicKey, []byte("msg")))
+	blindedSig, err := ss.Sign(blinded)
+	assert.Nil(t, err)
+	blindedSig[31] ^= 1
+	_, err = q.Unblind(blindedSig)
+	assert.NotNil(t, err)
+
+	_, err = q.Blind([]byte{4, 1, 2}, make([]byte, 32))
+	assert.NotNil(t, err)
+}
+
+func TestUnlinkability(t *testing.T) {
+	key, _ := gm.GenerateSM2Key()
+	pub := &key.PublicKey
+	signer := NewSigner(key, nil)
+	sessions := []session{
+		run(t, signer, pub, []byte("first")),
+		run(t, signer, pub, []byte("second")),
+	}
+
+	// every view is explained by blinding factors for every signature, and
+	// blinding with them reproduces the signature exactly
+	for _, v := range sessions {
+		for _, s := range sessions {
+			for _, a := range []int64{1, 12345} {
+				f, err := Explain(pub, v.view, s.digest, s.sig, big.NewInt(a))
+				assert.Nil(t, err)
+
+				q := NewRequester(pub, nil)
+				q.f = f
+				blinded, err := q.blind(v.view.Commitment, s.digest)
+				assert.Nil(t, err)
+				assert.Equal(t, v.view.BlindedDigest, blinded)
+				sig, err := q.Unblind(v.view.BlindedSignature)
+				assert.Nil(t, err)
+				assert.Equal(t, s.sig, sig)
+			}
+		}
+	}
+
+	// a forged view is not
+	forged := *sessions[0].view
+	forged.BlindedSignature = sessions[1].view.BlindedSignature
+	_, err := Explain(pub, &forged, sessions[0].digest, sessions[0].sig, big.NewInt(1))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"github.com/meshplus/crypto-gm/x509"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// readArg returns the content of the file named arg, or arg itself when no
+// such file exists, so that keys are given either as files or inline.
+func readArg(arg string) ([]byte, error) {
+	if arg == "" {
+		return nil, errors.New("missing key")
+	}
+	if _, err := os.Stat(arg); err == nil {
+		return ioutil.ReadFile(arg)
+	}
+	return []byte(arg), nil
+}
+
+func decodeHex(s string) ([]byte, error) {
+	s = strings.Join(strings.Fields(s), "")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex: %v", err)
+	}
+	return b, nil
+}
+
+// loadPrivateKey reads an SM2 private key in SEC 1 or PKCS #8 PEM, or as 32
+// bytes of hex.
+func loadPrivateKey(arg string) (*gm.SM2PrivateKey, error) {
+	data, err := readArg(arg)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(data); block != nil {
+		switch block.Type {
+		case "EC PRIVATE KEY", "SM2 PRIVATE KEY":
+			return x509.ParseSM2PrivateKey(block.Bytes)
+		case "PRIVATE KEY":
+			return x509.ParsePKCS8PrivateKey(block.Bytes)
+		}
+		return nil, fmt.Errorf("unsupported PEM type %q for a private key", block.Type)
+	}
+	k, err := decodeHex(string(data))
+	if err != nil {
+		return nil, err
+	}
+	if len(k) != 32 {
+		return nil, errors.New("a raw private key is 32 bytes")
+	}
+	d := new(big.Int).SetBytes(k)
+	if d.Sign() == 0 || d.Cmp(ec.N) >= 0 {
+		return nil, errors.New("private key out of range")
+	}
+	key := new(gm.SM2PrivateKey)
+	copy(key.K[:], k)
+	key.PublicKey.Curve = gm.GetSm2Curve()
+	return key.CalculatePublicKey(), nil
+}
+
+// loadPublicKey reads an SM2 public key in PKIX or certificate PEM, as hex
+// of the uncompressed, compressed or bare 64-byte point, or from a private
+// key.
+func loadPublicKey(arg string) (*gm.SM2PublicKey, error) {
+	data, err := readArg(arg)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(data); block != nil {
+		var pub interface{}
+		switch block.Type {
+		case "PUBLIC KEY":
+			if pub, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+				return nil, err
+			}
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			pub = cert.PublicKey
+		default:
+			key, err := loadPrivateKey(arg)
+			if err != nil {
+				return nil, err
+			}
+			pub = &key.PublicKey
+		}
+		if sm2Pub, ok := pub.(*gm.SM2PublicKey); ok {
+			return sm2Pub, nil
+		}
+		return nil, errors.New("not an SM2 public key")
+	}
+	b, err := decodeHex(string(data))
+	if err != nil {
+		return nil, err
+	}
+	var p ec.Point
+	switch len(b) {
+	case 64:
+		p, err = ec.Unmarshal(append([]byte{4}, b...))
+	case 65:
+		p, err = ec.Unmarshal(b)
+	case 33:
+		p, err = ec.UnmarshalCompressed(b)
+	default:
+		return nil, errors.New("a public key is 33, 64 or 65 bytes")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p.PublicKey(), nil
+}
+
+func encodePrivateKey(key *gm.SM2PrivateKey, format string) ([]byte, error) {
+	switch format {
+	case "raw":
+		return []byte(hex.EncodeToString(key.K[:]) + "\n"), nil
+	case "pem":
+		der, err := x509.MarshalSM2PrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+	case "pkcs8":
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+	}
+	return nil, fmt.Errorf("unknown private key format %q", format)
+}
+
+func encodePublicKey(pub *gm.SM2PublicKey, format string) ([]byte, error) {
+	p, err := ec.FromPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case "hex":
+		return []byte(hex.EncodeToString(p.Marshal()) + "\n"), nil
+	case "compressed":
+		return []byte(hex.EncodeToString(p.MarshalCompressed()) + "\n"), nil
+	case "pem":
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+	}
+	return nil, fmt.Errorf("unknown public key format %q", format)
+}
@@ -0,0 +1,506 @@
+//Command gmcrypto runs SM2, SM3 and SM4 operations on keys and data, to
+// generate keys and to debug signatures and ciphertexts.
+//
+// Usage:
+//
+//	gmcrypto <command> [flags]
+//
+// Keys are given as a file or inline: PEM (SEC 1, PKCS #8, PKIX or a
+// certificate) or hex. Data is read from -in and written to -out, the
+// standard input and output by default; signatures, digests and agreed keys
+// are printed in hex. Run gmcrypto <command> -h for the flags of a command.
+package main
+
+import (
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"hash"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+)
+
+const defaultUID = "1234567812345678"
+
+type command struct {
+	name, summary string
+	run           func(args []string, stdin io.Reader, stdout io.Writer) error
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{"keygen", "generate an SM2 private key", keygen},
+		{"pub", "derive, convert or compress a public key", pubCmd},
+		{"sign", "sign a message or a digest with SM2", sign},
+		{"verify", "verify an SM2 signature", verify},
+		{"encrypt", "encrypt with SM2 or SM4", encrypt},
+		{"decrypt", "decrypt with SM2 or SM4", decrypt},
+		{"hash", "SM3 hash or HMAC-SM3 of data", hashCmd},
+		{"dh", "agree on a key with the SM2 key exchange", dh},
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: gmcrypto <command> [flags]\n\ncommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-8s %s\n", c.name, c.summary)
+	}
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, "gmcrypto:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		usage(os.Stderr)
+		return flag.ErrHelp
+	}
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:], stdin, stdout)
+		}
+	}
+	usage(os.Stderr)
+	return fmt.Errorf("unknown command %q", args[0])
+}
+
+// ioFlags are the -in and -out flags of a command.
+type ioFlags struct {
+	in, out string
+}
+
+func newFlags(name string, f *ioFlags) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	if f != nil {
+		fs.StringVar(&f.in, "in", "-", "input file, - for the standard input")
+		fs.StringVar(&f.out, "out", "-", "output file, - for the standard output")
+	}
+	return fs
+}
+
+func (f *ioFlags) read(stdin io.Reader) ([]byte, error) {
+	if f.in == "-" {
+		return ioutil.ReadAll(stdin)
+	}
+	return ioutil.ReadFile(f.in)
+}
+
+func (f *ioFlags) write(stdout io.Writer, b []byte) error {
+	if f.out == "-" {
+		_, err := stdout.Write(b)
+		return err
+	}
+	return ioutil.WriteFile(f.out, b, 0600)
+}
+
+func keygen(args []string, stdin io.Reader, stdout io.Writer) error {
+	var f ioFlags
+	fs := newFlags("keygen", &f)
+	format := fs.String("format", "pem", "raw (hex), pem (SEC 1) or pkcs8")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	key, err := gm.GenerateSM2Key()
+	if err != nil {
+		return err
+	}
+	defer key.Destroy()
+	b, err := encodePrivateKey(key, *format)
+	if err != nil {
+		return err
+	}
+	return f.write(stdout, b)
+}
+
+func pubCmd(args []string, stdin io.Reader, stdout io.Writer) error {
+	var f ioFlags
+	fs := newFlags("pub", &f)
+	keyArg := fs.String("key", "", "private key to derive the public key of")
+	pubArg := fs.String("pub", "", "public key to convert")
+	format := fs.String("format", "hex", "hex (uncompressed), compressed or pem")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	var pub *gm.SM2PublicKey
+	switch {
+	case *keyArg != "":
+		key, err := loadPrivateKey(*keyArg)
+		if err != nil {
+			return err
+		}
+		defer key.Destroy()
+		pub = &key.PublicKey
+	case *pubArg != "":
+		var err error
+		if pub, err = loadPublicKey(*pubArg); err != nil {
+			return err
+		}
+	default:
+		return errors.New("pub needs -key or -pub")
+	}
+	b, err := encodePublicKey(pub, *format)
+	if err != nil {
+		return err
+	}
+	return f.write(stdout, b)
+}
+
+// digest returns the SM2 digest of the input, SM3 of Z and the message, or
+// the input itself as hex with -digest.
+func digest(f *ioFlags, stdin io.Reader, pub *gm.SM2PublicKey, uid string, isDigest bool) ([]byte, error) {
+	in, err := f.read(stdin)
+	if err != nil {
+		return nil, err
+	}
+	if isDigest {
+		d, err := decodeHex(string(in))
+		if err == nil && len(d) != 32 {
+			err = errors.New("a digest is 32 bytes")
+		}
+		return d, err
+	}
+	return gm.HashBeforeSM2WithID(pub, []byte(uid), in)
+}
+
+func sign(args []string, stdin io.Reader, stdout io.Writer) error {
+	var f ioFlags
+	fs := newFlags("sign", &f)
+	keyArg := fs.String("key", "", "private key")
+	uid := fs.String("uid", defaultUID, "user id of the signer")
+	format := fs.String("format", "der", "signature format, der or raw (r || s)")
+	isDigest := fs.Bool("digest", false, "the input is a digest in hex, not a message")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	key, err := loadPrivateKey(*keyArg)
+	if err != nil {
+		return err
+	}
+	defer key.Destroy()
+	d, err := digest(&f, stdin, &key.PublicKey, *uid, *isDigest)
+	if err != nil {
+		return err
+	}
+	sig, err := key.Sign(nil, d, rand.Reader)
+	if err != nil {
+		return err
+	}
+	switch *format {
+	case "der":
+	case "raw":
+		if sig, err = gm.SignatureToRaw(sig); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown signature format %q", *format)
+	}
+	return f.write(stdout, []byte(hex.EncodeToString(sig)+"\n"))
+}
+
+func isDER(sig []byte) bool {
+	_, _, err := gm.ParseSignature(sig)
+	return err == nil
+}
+
+func verify(args []string, stdin io.Reader, stdout io.Writer) error {
+	var f ioFlags
+	fs := newFlags("verify", &f)
+	pubArg := fs.String("pub", "", "public key")
+	sigArg := fs.String("sig", "", "signature in hex, DER or raw (r || s)")
+	uid := fs.String("uid", defaultUID, "user id of the signer")
+	isDigest := fs.Bool("digest", false, "the input is a digest in hex, not a message")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	pub, err := loadPublicKey(*pubArg)
+	if err != nil {
+		return err
+	}
+	sig, err := decodeHex(*sigArg)
+	if err != nil {
+		return err
+	}
+	if len(sig) == 64 && !isDER(sig) {
+		if sig, err = gm.SignatureFromRaw(sig); err != nil {
+			return err
+		}
+	}
+	d, err := digest(&f, stdin, pub, *uid, *isDigest)
+	if err != nil {
+		return err
+	}
+	if ok, err := pub.Verify(nil, sig, d); err != nil || !ok {
+		return errors.New("verification failed")
+	}
+	return f.write(stdout, []byte("verified OK\n"))
+}
+
+type cipherFlags struct {
+	ioFlags
+	alg, key, pub, mode, aad string
+}
+
+func newCipherFlags(name string, args []string) (*cipherFlags, error) {
+	f := new(cipherFlags)
+	fs := newFlags(name, &f.ioFlags)
+	fs.StringVar(&f.alg, "alg", "sm2", "sm2, sm4-cbc or sm4-gcm")
+	fs.StringVar(&f.key, "key", "", "SM4 key in hex, or the SM2 private key to decrypt with")
+	fs.StringVar(&f.pub, "pub", "", "SM2 public key to encrypt with")
+	fs.StringVar(&f.mode, "mode", "c1c3c2", "SM2 ciphertext order, c1c3c2 or c1c2c3")
+	fs.StringVar(&f.aad, "aad", "", "additional authenticated data of sm4-gcm, in hex")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if f.alg == "sm2" && f.mode != "c1c3c2" && f.mode != "c1c2c3" {
+		return nil, fmt.Errorf("unknown SM2 ciphertext order %q", f.mode)
+	}
+	return f, nil
+}
+
+// sm4Key returns the SM4 key of -key.
+func (f *cipherFlags) sm4Key() (gm.SM4Key, error) {
+	k, err := decodeHex(f.key)
+	if err != nil {
+		return nil, err
+	}
+	if len(k) != 16 {
+		return nil, errors.New("an SM4 key is 16 bytes of hex")
+	}
+	return k, nil
+}
+
+func (f *cipherFlags) gcm() (cipher.AEAD, []byte, error) {
+	key, err := f.sm4Key()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer key.Destroy()
+	aad, err := decodeHex(f.aad)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, err := gm.GetSm4Cipher(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	return aead, aad, err
+}
+
+func encrypt(args []string, stdin io.Reader, stdout io.Writer) error {
+	f, err := newCipherFlags("encrypt", args)
+	if err != nil {
+		return err
+	}
+	in, err := f.read(stdin)
+	if err != nil {
+		return err
+	}
+	var out []byte
+	switch f.alg {
+	case "sm2":
+		pub, err := loadPublicKey(f.pub)
+		if err != nil {
+			return err
+		}
+		if out, err = gm.Encrypt(pub, in, rand.Reader); err != nil {
+			return err
+		}
+		if f.mode == "c1c3c2" {
+			if out, err = gm.CiphertextToC1C3C2(out); err != nil {
+				return err
+			}
+		}
+	case "sm4-cbc":
+		key, err := f.sm4Key()
+		if err != nil {
+			return err
+		}
+		defer key.Destroy()
+		if out, err = gm.Sm4EncryptCBC(key, in, rand.Reader); err != nil {
+			return err
+		}
+	case "sm4-gcm":
+		aead, aad, err := f.gcm()
+		if err != nil {
+			return err
+		}
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return err
+		}
+		out = aead.Seal(nonce, nonce, in, aad)
+	default:
+		return fmt.Errorf("unknown algorithm %q", f.alg)
+	}
+	return f.write(stdout, out)
+}
+
+func decrypt(args []string, stdin io.Reader, stdout io.Writer) error {
+	f, err := newCipherFlags("decrypt", args)
+	if err != nil {
+		return err
+	}
+	in, err := f.read(stdin)
+	if err != nil {
+		return err
+	}
+	var out []byte
+	switch f.alg {
+	case "sm2":
+		key, err := loadPrivateKey(f.key)
+		if err != nil {
+			return err
+		}
+		defer key.Destroy()
+		if len(in) < 1+64+32 || in[0] != 4 {
+			return errors.New("SM2 ciphertext too short")
+		}
+		if f.mode == "c1c3c2" {
+			if in, err = gm.CiphertextFromC1C3C2(in); err != nil {
+				return err
+			}
+		}
+		if out, err = gm.Decrypt(key, in); err != nil {
+			return err
+		}
+	case "sm4-cbc":
+		key, err := f.sm4Key()
+		if err != nil {
+			return err
+		}
+		defer key.Destroy()
+		if len(in) < 32 || len(in)%16 != 0 {
+			return errors.New("SM4-CBC ciphertext is the IV and whole blocks")
+		}
+		if out, err = gm.Sm4DecryptCBC(key, in); err != nil {
+			return err
+		}
+	case "sm4-gcm":
+		aead, aad, err := f.gcm()
+		if err != nil {
+			return err
+		}
+		n := aead.NonceSize()
+		if len(in) < n+aead.Overhead() {
+			return errors.New("SM4-GCM ciphertext too short")
+		}
+		if out, err = aead.Open(nil, in[:n], in[n:], aad); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown algorithm %q", f.alg)
+	}
+	return f.write(stdout, out)
+}
+
+func hashCmd(args []string, stdin io.Reader, stdout io.Writer) error {
+	var f ioFlags
+	fs := newFlags("hash", &f)
+	hmacKey := fs.String("hmac", "", "HMAC-SM3 key in hex")
+	pubArg := fs.String("pub", "", "public key, to hash Z || message as SM2 signing does")
+	uid := fs.String("uid", defaultUID, "user id of Z, with -pub")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	in, err := f.read(stdin)
+	if err != nil {
+		return err
+	}
+	var sum []byte
+	switch {
+	case *hmacKey != "":
+		key, err := decodeHex(*hmacKey)
+		if err != nil {
+			return err
+		}
+		mac := hmac.New(func() hash.Hash { return gm.GetSM3Hasher() }, key)
+		mac.Write(in)
+		sum = mac.Sum(nil)
+	case *pubArg != "":
+		pub, err := loadPublicKey(*pubArg)
+		if err != nil {
+			return err
+		}
+		if sum, err = gm.HashBeforeSM2WithID(pub, []byte(*uid), in); err != nil {
+			return err
+		}
+	default:
+		h := gm.GetSM3Hasher()
+		h.Write(in)
+		sum = h.Sum(nil)
+	}
+	return f.write(stdout, []byte(hex.EncodeToString(sum)+"\n"))
+}
+
+func dh(args []string, stdin io.Reader, stdout io.Writer) error {
+	var f ioFlags
+	fs := newFlags("dh", &f)
+	keyArg := fs.String("key", "", "own private key")
+	ephArg := fs.String("eph", "", "own ephemeral private key")
+	peerArg := fs.String("peer", "", "public key of the peer")
+	peerEphArg := fs.String("peer-eph", "", "ephemeral public key of the peer")
+	uid := fs.String("uid", defaultUID, "own user id")
+	peerUID := fs.String("peer-uid", defaultUID, "user id of the peer")
+	initiator := fs.Bool("initiator", false, "whether this side initiated the exchange")
+	length := fs.Int("len", 16, "length of the agreed key in bytes")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *length <= 0 {
+		return errors.New("the key length must be positive")
+	}
+	key, err := loadPrivateKey(*keyArg)
+	if err != nil {
+		return err
+	}
+	defer key.Destroy()
+	eph, err := loadPrivateKey(*ephArg)
+	if err != nil {
+		return err
+	}
+	defer eph.Destroy()
+	peer, err := loadPublicKey(*peerArg)
+	if err != nil {
+		return err
+	}
+	peerEph, err := loadPublicKey(*peerEphArg)
+	if err != nil {
+		return err
+	}
+	toInt := func(b [32]byte) *big.Int { return new(big.Int).SetBytes(b[:]) }
+	_, _, z, err := gm.GenerateSM2KeyForDH([]byte(*uid), []byte(*peerUID), eph.K[:], toInt(key.K),
+		toInt(key.PublicKey.X), toInt(key.PublicKey.Y), toInt(peer.X), toInt(peer.Y), peerEph, *initiator)
+	if err != nil {
+		return err
+	}
+	return f.write(stdout, []byte(hex.EncodeToString(sm3KDF(z, *length))+"\n"))
+}
+
+// sm3KDF is the key derivation function of GM/T 0003.4 5.4.3.
+func sm3KDF(z []byte, length int) []byte {
+	out := make([]byte, 0, length+32)
+	h := gm.GetSM3Hasher()
+	for ct := uint32(1); len(out) < length; ct++ {
+		h.Reset()
+		h.Write(z)
+		h.Write([]byte{byte(ct >> 24), byte(ct >> 16), byte(ct >> 8), byte(ct)})
+		out = h.Sum(out)
+	}
+	return out[:length]
+}
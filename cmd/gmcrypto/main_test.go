@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func gmcrypto(t *testing.T, stdin []byte, args ...string) string {
+	var out bytes.Buffer
+	assert.Nil(t, run(args, bytes.NewReader(stdin), &out), strings.Join(args, " "))
+	return out.String()
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "gmcrypto")
+	assert.Nil(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestKeysAndSignatures(t *testing.T) {
+	dir := tempDir(t)
+	for _, format := range []string{"raw", "pem", "pkcs8"} {
+		keyFile := filepath.Join(dir, "key."+format)
+		gmcrypto(t, nil, "keygen", "-format", format, "-out", keyFile)
+		pub := strings.TrimSpace(gmcrypto(t, nil, "pub", "-key", keyFile))
+		assert.Len(t, pub, 130)
+		compressed := strings.TrimSpace(gmcrypto(t, nil, "pub", "-key", keyFile, "-format", "compressed"))
+		assert.Equal(t, pub, strings.TrimSpace(gmcrypto(t, nil, "pub", "-pub", compressed)))
+		pemFile := filepath.Join(dir, "pub.pem")
+		assert.Nil(t, ioutil.WriteFile(pemFile, []byte(gmcrypto(t, nil, "pub", "-pub", pub, "-format", "pem")), 0600))
+		assert.Equal(t, pub, strings.TrimSpace(gmcrypto(t, nil, "pub", "-pub", pemFile)))
+
+		msg := []byte("block 42")
+		for _, sigFormat := range []string{"der", "raw"} {
+			sig := strings.TrimSpace(gmcrypto(t, msg, "sign", "-key", keyFile, "-uid", "node1", "-format", sigFormat))
+			assert.Equal(t, "verified OK\n", gmcrypto(t, msg, "verify", "-pub", pemFile, "-uid", "node1", "-sig", sig))
+			var out bytes.Buffer
+			assert.NotNil(t, run([]string{"verify", "-pub", compressed, "-sig", sig}, bytes.NewReader(msg), &out))
+		}
+		// a raw signature with r = 0
+		var out bytes.Buffer
+		assert.NotNil(t, run([]string{"verify", "-pub", pub, "-sig", strings.Repeat("0", 64) + strings.Repeat("1", 64)}, bytes.NewReader(msg), &out))
+
+		// a digest printed by hash signs and verifies as the message
+		d := gmcrypto(t, msg, "hash", "-pub", pub)
+		sig := strings.TrimSpace(gmcrypto(t, []byte(d), "sign", "-key", keyFile, "-digest"))
+		assert.Equal(t, "verified OK\n", gmcrypto(t, msg, "verify", "-pub", pub, "-sig", sig))
+	}
+}
+
+func TestEncryption(t *testing.T) {
+	dir := tempDir(t)
+	keyFile := filepath.Join(dir, "key.pem")
+	gmcrypto(t, nil, "keygen", "-out", keyFile)
+	pub := strings.TrimSpace(gmcrypto(t, nil, "pub", "-key", keyFile))
+	msg := []byte("attack at dawn")
+	for _, mode := range []string{"c1c3c2", "c1c2c3"} {
+		c := gmcrypto(t, msg, "encrypt", "-pub", pub, "-mode", mode)
+		assert.Len(t, c, 1+64+32+len(msg))
+		assert.Equal(t, string(msg), gmcrypto(t, []byte(c), "decrypt", "-key", keyFile, "-mode", mode))
+	}
+	c := []byte(gmcrypto(t, msg, "encrypt", "-pub", pub))
+	var out bytes.Buffer
+	assert.NotNil(t, run([]string{"decrypt", "-key", keyFile, "-mode", "c1c2c3"}, bytes.NewReader(c), &out))
+
+	key := "0123456789abcdeffedcba9876543210"
+	for _, alg := range []string{"sm4-cbc", "sm4-gcm"} {
+		c := gmcrypto(t, msg, "encrypt", "-alg", alg, "-key", key, "-aad", "00ff")
+		assert.Equal(t, string(msg), gmcrypto(t, []byte(c), "decrypt", "-alg", alg, "-key", key, "-aad", "00ff"))
+	}
+	c = []byte(gmcrypto(t, msg, "encrypt", "-alg", "sm4-gcm", "-key", key))
+	c[len(c)-1] ^= 1
+	assert.NotNil(t, run([]string{"decrypt", "-alg", "sm4-gcm", "-key", key}, bytes.NewReader(c), &out))
+}
+
+func TestHash(t *testing.T) {
+	// GB/T 32905 example 1 and HMAC-SM3 of RFC 4231 case 2 parameters
+	assert.Equal(t, "66c7f0f462eeedd9d1f2d46bdc10e4e24167c4875cf2f7a2297da02b8f4ba8e0\n", gmcrypto(t, []byte("abc"), "hash"))
+	mac := gmcrypto(t, []byte("what do ya want for nothing?"), "hash", "-hmac", hex.EncodeToString([]byte("Jefe")))
+	assert.Equal(t, "2e87f1d16862e6d964b50a5200bf2b10b764faa9680a296a2405f24bec39f882\n", mac)
+}
+
+func TestKeyExchange(t *testing.T) {
+	dir := tempDir(t)
+	keys := map[string]string{}
+	pubs := map[string]string{}
+	for _, name := range []string{"a", "ea", "b", "eb"} {
+		keys[name] = filepath.Join(dir, name)
+		gmcrypto(t, nil, "keygen", "-out", keys[name])
+		pubs[name] = strings.TrimSpace(gmcrypto(t, nil, "pub", "-key", keys[name]))
+	}
+	ka := gmcrypto(t, nil, "dh", "-key", keys["a"], "-eph", keys["ea"], "-peer", pubs["b"], "-peer-eph", pubs["eb"],
+		"-uid", "alice", "-peer-uid", "bob", "-initiator")
+	kb := gmcrypto(t, nil, "dh", "-key", keys["b"], "-eph", keys["eb"], "-peer", pubs["a"], "-peer-eph", pubs["ea"],
+		"-uid", "bob", "-peer-uid", "alice", "-len", "16")
+	assert.Equal(t, ka, kb)
+	assert.Len(t, ka, 33)
+}
+
+func TestUnknownCommand(t *testing.T) {
+	var out bytes.Buffer
+	assert.NotNil(t, run([]string{"frobnicate"}, nil, &out))
+}
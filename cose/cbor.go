@@ -0,0 +1,260 @@
+package cose
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// This is the subset of CBOR (RFC 8949) which COSE needs: integers, byte and
+// text strings, arrays, maps keyed by integers or text, tags, booleans and
+// null. Maps are encoded with the keys sorted as the core deterministic
+// encoding requires; indefinite lengths and floats are not supported.
+
+const (
+	majorUint = iota
+	majorNint
+	majorBytes
+	majorText
+	majorArray
+	majorMap
+	majorTag
+	majorSimple
+)
+
+const maxDepth = 16
+
+var errCBOR = errors.New("cose: malformed CBOR")
+
+// tag is a tagged CBOR data item.
+type tag struct {
+	Number  uint64
+	Content interface{}
+}
+
+func appendHead(b []byte, major byte, n uint64) []byte {
+	m := major << 5
+	switch {
+	case n < 24:
+		return append(b, m|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, m|24, byte(n))
+	case n <= math.MaxUint16:
+		return append(append(b, m|25), byte(n>>8), byte(n))
+	case n <= math.MaxUint32:
+		b = append(b, m|26, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(b[len(b)-4:], uint32(n))
+		return b
+	}
+	b = append(b, m|27, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint64(b[len(b)-8:], n)
+	return b
+}
+
+func appendInt(b []byte, n int64) []byte {
+	if n < 0 {
+		return appendHead(b, majorNint, uint64(-1-n))
+	}
+	return appendHead(b, majorUint, uint64(n))
+}
+
+// marshal returns the CBOR encoding of v.
+func marshal(v interface{}) ([]byte, error) {
+	return appendValue(nil, v)
+}
+
+func appendValue(b []byte, v interface{}) ([]byte, error) {
+	var err error
+	switch v := v.(type) {
+	case nil:
+		return append(b, 0xf6), nil
+	case bool:
+		if v {
+			return append(b, 0xf5), nil
+		}
+		return append(b, 0xf4), nil
+	case int:
+		return appendInt(b, int64(v)), nil
+	case int64:
+		return appendInt(b, v), nil
+	case uint64:
+		return appendHead(b, majorUint, v), nil
+	case []byte:
+		return append(appendHead(b, majorBytes, uint64(len(v))), v...), nil
+	case string:
+		return append(appendHead(b, majorText, uint64(len(v))), v...), nil
+	case []interface{}:
+		b = appendHead(b, majorArray, uint64(len(v)))
+		for _, e := range v {
+			if b, err = appendValue(b, e); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case Header:
+		return appendMap(b, v)
+	case map[interface{}]interface{}:
+		return appendMap(b, v)
+	case tag:
+		return appendValue(appendHead(b, majorTag, v.Number), v.Content)
+	}
+	return nil, fmt.Errorf("cose: cannot encode %T in CBOR", v)
+}
+
+func appendMap(b []byte, m map[interface{}]interface{}) ([]byte, error) {
+	type entry struct{ k, v []byte }
+	entries := make([]entry, 0, len(m))
+	for k, v := range m {
+		switch k.(type) {
+		case int, int64, string:
+		default:
+			return nil, fmt.Errorf("cose: invalid map key type %T", k)
+		}
+		kb, err := appendValue(nil, k)
+		if err != nil {
+			return nil, err
+		}
+		vb, err := appendValue(nil, v)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry{kb, vb})
+	}
+	sort.Slice(entries, func(i, j int) bool { return bytes.Compare(entries[i].k, entries[j].k) < 0 })
+	b = appendHead(b, majorMap, uint64(len(entries)))
+	for i, e := range entries {
+		if i > 0 && bytes.Equal(entries[i-1].k, e.k) {
+			return nil, errors.New("cose: duplicate map key")
+		}
+		b = append(append(b, e.k...), e.v...)
+	}
+	return b, nil
+}
+
+type decoder struct {
+	b     []byte
+	depth int
+}
+
+// unmarshal decodes a single CBOR data item which must span all of data.
+// Integers are decoded as int64, maps as map[interface{}]interface{} and
+// tags as tag.
+func unmarshal(data []byte) (interface{}, error) {
+	d := &decoder{b: data}
+	v, err := d.value()
+	if err != nil {
+		return nil, err
+	}
+	if len(d.b) != 0 {
+		return nil, errCBOR
+	}
+	return v, nil
+}
+
+func (d *decoder) head() (byte, uint64, error) {
+	if len(d.b) == 0 {
+		return 0, 0, errCBOR
+	}
+	major, info := d.b[0]>>5, d.b[0]&0x1f
+	d.b = d.b[1:]
+	if info < 24 {
+		return major, uint64(info), nil
+	}
+	if info > 27 {
+		return 0, 0, errCBOR
+	}
+	size := 1 << (info - 24)
+	if len(d.b) < size {
+		return 0, 0, errCBOR
+	}
+	var n uint64
+	for _, c := range d.b[:size] {
+		n = n<<8 | uint64(c)
+	}
+	d.b = d.b[size:]
+	return major, n, nil
+}
+
+func (d *decoder) value() (interface{}, error) {
+	major, n, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case majorUint, majorNint:
+		if n > math.MaxInt64 {
+			return nil, errors.New("cose: CBOR integer overflows int64")
+		}
+		if major == majorNint {
+			return -1 - int64(n), nil
+		}
+		return int64(n), nil
+	case majorBytes, majorText:
+		if n > uint64(len(d.b)) {
+			return nil, errCBOR
+		}
+		s := d.b[:n:n]
+		d.b = d.b[n:]
+		if major == majorText {
+			return string(s), nil
+		}
+		return append([]byte{}, s...), nil
+	case majorSimple:
+		switch n {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		}
+		return nil, errors.New("cose: unsupported CBOR simple value or float")
+	}
+
+	// every element takes at least one byte
+	if n > uint64(len(d.b)) {
+		return nil, errCBOR
+	}
+	if d.depth++; d.depth > maxDepth {
+		return nil, errors.New("cose: CBOR nested too deeply")
+	}
+	defer func() { d.depth-- }()
+	switch major {
+	case majorArray:
+		a := make([]interface{}, n)
+		for i := range a {
+			if a[i], err = d.value(); err != nil {
+				return nil, err
+			}
+		}
+		return a, nil
+	case majorMap:
+		m := make(map[interface{}]interface{}, n)
+		for i := uint64(0); i < n; i++ {
+			k, err := d.value()
+			if err != nil {
+				return nil, err
+			}
+			switch k.(type) {
+			case int64, string:
+			default:
+				return nil, errors.New("cose: unsupported CBOR map key")
+			}
+			if _, ok := m[k]; ok {
+				return nil, errors.New("cose: duplicate CBOR map key")
+			}
+			if m[k], err = d.value(); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	}
+	v, err := d.value()
+	if err != nil {
+		return nil, err
+	}
+	return tag{n, v}, nil
+}
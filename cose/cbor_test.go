@@ -0,0 +1,87 @@
+package cose
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCBOR(t *testing.T) {
+	// RFC 8949, Appendix A
+	for _, c := range []struct {
+		v   interface{}
+		hex string
+	}{
+		{int64(0), "00"},
+		{int64(23), "17"},
+		{int64(24), "1818"},
+		{int64(100), "1864"},
+		{int64(1000), "1903e8"},
+		{int64(1000000), "1a000f4240"},
+		{int64(1000000000000), "1b000000e8d4a51000"},
+		{int64(-1), "20"},
+		{int64(-10), "29"},
+		{int64(-100), "3863"},
+		{int64(-1000), "3903e7"},
+		{[]byte{}, "40"},
+		{[]byte{1, 2, 3, 4}, "4401020304"},
+		{"", "60"},
+		{"IETF", "6449455446"},
+		{"ü", "62c3bc"},
+		{[]interface{}{}, "80"},
+		{[]interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}, "8301820203820405"},
+		{map[interface{}]interface{}{}, "a0"},
+		{map[interface{}]interface{}{int64(3): int64(4), int64(1): int64(2)}, "a201020304"},
+		{map[interface{}]interface{}{"b": []interface{}{int64(2), int64(3)}, "a": int64(1)}, "a26161016162820203"},
+		{false, "f4"},
+		{true, "f5"},
+		{nil, "f6"},
+		{tag{1, int64(1363896240)}, "c11a514b67b0"},
+	} {
+		b, err := marshal(c.v)
+		assert.Nil(t, err)
+		assert.Equal(t, c.hex, hex.EncodeToString(b))
+		v, err := unmarshal(b)
+		assert.Nil(t, err)
+		assert.Equal(t, c.v, v)
+	}
+
+	b, err := marshal(Header{1: -7, int64(-1): "x"})
+	assert.Nil(t, err)
+	assert.Equal(t, "a20126206178", hex.EncodeToString(b))
+	_, err = marshal(Header{1: 1, int64(1): 2})
+	assert.NotNil(t, err)
+	_, err = marshal(Header{1.5: 1})
+	assert.NotNil(t, err)
+	_, err = marshal(1.5)
+	assert.NotNil(t, err)
+}
+
+func TestCBORInvalid(t *testing.T) {
+	for _, h := range []string{
+		"",
+		"1a0000",             // truncated
+		"0000",               // trailing data
+		"5f4101ff",           // indefinite length
+		"f93c00",             // float
+		"f7",                 // undefined
+		"1bffffffffffffffff", // overflows int64
+		"5bffffffffffffffff", // length
+		"9bffffffffffffffff00",
+		"a2010201f6", // duplicate key
+		"a1400102",   // byte string key
+		"1c",         // reserved
+		"c1",         // tag without content
+		"8201",       // short array
+	} {
+		b, _ := hex.DecodeString(h)
+		_, err := unmarshal(b)
+		assert.NotNil(t, err, h)
+	}
+	nested := append(bytes.Repeat([]byte{0x81}, maxDepth+1), 0)
+	_, err := unmarshal(nested)
+	assert.NotNil(t, err)
+	_, err = unmarshal(nested[1:])
+	assert.Nil(t, err)
+}
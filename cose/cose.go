@@ -0,0 +1,201 @@
+//Package cose implements COSE (RFC 9052) with the Guomi algorithms:
+// COSE_Sign1 and COSE_Sign with SM2 and SM3, COSE_Encrypt0 with SM4-GCM,
+// COSE_Mac0 with HMAC-SM3, and COSE_Key for SM2 and SM4 keys.
+//
+// An SM2 signature is r || s, 64 bytes, over SM3(Z || ToBeSigned) where Z is
+// computed from the public key and the default user id, as HashBeforeSM2.
+// SM4-GCM uses a 128-bit key, a 96-bit IV carried in the unprotected header
+// and a 128-bit tag; HMAC-SM3 tags are not truncated.
+//
+// The Guomi algorithms are not registered with IANA, their identifiers are
+// variables from the private use range and can be set to the values a peer
+// uses. They must be set before any message is created or parsed.
+//
+// Messages are encoded with their CBOR tag, and parsed with or without it.
+// The package carries the small CBOR codec it needs.
+package cose
+
+import (
+	"errors"
+	"fmt"
+)
+
+//Algorithm and curve identifiers, from the private use range of COSE.
+var (
+	AlgSM2SM3  int64 = -65537 // SM2 signature with SM3
+	AlgSM4GCM  int64 = -65538 // SM4-GCM, 128-bit key and tag
+	AlgHMACSM3 int64 = -65539 // HMAC-SM3, 256-bit tag
+	CurveSM2   int64 = -65537 // the SM2 curve in an EC2 COSE_Key
+)
+
+//Header labels of RFC 9052.
+const (
+	HeaderAlgorithm   int64 = 1
+	HeaderCritical    int64 = 2
+	HeaderContentType int64 = 3
+	HeaderKeyID       int64 = 4
+	HeaderIV          int64 = 5
+)
+
+// CBOR tags of the messages.
+const (
+	tagEncrypt0 = 16
+	tagMac0     = 17
+	tagSign1    = 18
+	tagSign     = 98
+)
+
+var (
+	//ErrMalformed is returned when parsing a message which is not valid COSE.
+	ErrMalformed = errors.New("cose: malformed message")
+	//ErrVerification is returned when a signature or a MAC does not verify.
+	ErrVerification = errors.New("cose: verification failed")
+	//ErrDecryption is returned when a message cannot be decrypted with the key.
+	ErrDecryption = errors.New("cose: decryption failed")
+)
+
+//Header is a COSE header map. Labels are integers or strings; values are
+// integers, byte and text strings, booleans, arrays and maps. Decoded
+// integers are int64.
+type Header map[interface{}]interface{}
+
+//Algorithm returns the algorithm of h.
+func (h Header) Algorithm() (int64, bool) {
+	switch alg := h[HeaderAlgorithm].(type) {
+	case int64:
+		return alg, true
+	case int:
+		return int64(alg), true
+	}
+	return 0, false
+}
+
+//KeyID returns the key identifier of h.
+func (h Header) KeyID() []byte {
+	kid, _ := h[HeaderKeyID].([]byte)
+	return kid
+}
+
+// normalize returns a copy of h with int labels as int64, so that labels
+// compare equal to decoded ones.
+func (h Header) normalize() (Header, error) {
+	n := make(Header, len(h))
+	for k, v := range h {
+		switch l := k.(type) {
+		case int:
+			k = int64(l)
+		case int64, string:
+		default:
+			return nil, fmt.Errorf("cose: invalid header label type %T", k)
+		}
+		if _, ok := n[k]; ok {
+			return nil, errors.New("cose: duplicate header label")
+		}
+		n[k] = v
+	}
+	return n, nil
+}
+
+// protect returns the protected header with the algorithm set to alg, its
+// serialization and the unprotected header; a label may appear in only one
+// of them.
+func protect(protected, unprotected Header, alg int64) (Header, []byte, Header, error) {
+	p, err := protected.normalize()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	p[HeaderAlgorithm] = alg
+	u, err := unprotected.normalize()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := disjoint(p, u); err != nil {
+		return nil, nil, nil, err
+	}
+	b, err := marshal(p)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return p, b, u, nil
+}
+
+// disjoint checks that no label is both in the protected and the unprotected
+// header.
+func disjoint(protected, unprotected Header) error {
+	for k := range unprotected {
+		if _, ok := protected[k]; ok {
+			return fmt.Errorf("cose: header label %v is both protected and unprotected", k)
+		}
+	}
+	return nil
+}
+
+// decodeProtected parses a serialized protected header, where an empty
+// string stands for an empty map.
+func decodeProtected(v interface{}) (Header, []byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, nil, ErrMalformed
+	}
+	if len(b) == 0 {
+		return Header{}, b, nil
+	}
+	m, err := unmarshal(b)
+	if err != nil {
+		return nil, nil, ErrMalformed
+	}
+	h, ok := m.(map[interface{}]interface{})
+	if !ok {
+		return nil, nil, ErrMalformed
+	}
+	if _, ok := h[HeaderCritical]; ok {
+		return nil, nil, errors.New("cose: unsupported critical header parameters")
+	}
+	return h, b, nil
+}
+
+// decodeUnprotected checks an unprotected header against its protected one:
+// a label may appear in only one of them.
+func decodeUnprotected(v interface{}, protected Header) (Header, error) {
+	h, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, ErrMalformed
+	}
+	if _, ok := h[HeaderCritical]; ok || disjoint(protected, h) != nil {
+		return nil, ErrMalformed
+	}
+	return h, nil
+}
+
+// decodeMessage parses a message of n elements, tagged with number or not.
+func decodeMessage(data []byte, number uint64, n int) ([]interface{}, error) {
+	v, err := unmarshal(data)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	if t, ok := v.(tag); ok {
+		if t.Number != number {
+			return nil, ErrMalformed
+		}
+		v = t.Content
+	}
+	a, ok := v.([]interface{})
+	if !ok || len(a) != n {
+		return nil, ErrMalformed
+	}
+	return a, nil
+}
+
+// encodeMessage returns the tagged encoding of a message.
+func encodeMessage(number uint64, elements ...interface{}) ([]byte, error) {
+	return marshal(tag{number, elements})
+}
+
+// payload returns the payload of a message, which may be detached.
+func payload(v interface{}) ([]byte, bool) {
+	if v == nil {
+		return nil, true
+	}
+	b, ok := v.([]byte)
+	return b, ok
+}
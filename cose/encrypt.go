@@ -0,0 +1,119 @@
+package cose
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"io"
+)
+
+const ivSize = 12
+
+//Encrypt0Message is a COSE_Encrypt0 message, content encrypted with a key
+// known to the recipient.
+type Encrypt0Message struct {
+	Protected   Header
+	Unprotected Header
+	Ciphertext  []byte
+
+	protected []byte
+}
+
+func newGCM(key gm.SM4Key) (cipher.AEAD, error) {
+	if len(key) != 16 {
+		return nil, errors.New("cose: an SM4 key is 16 bytes")
+	}
+	b, err := gm.GetSm4Cipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(b)
+}
+
+func (m *Encrypt0Message) additionalData(external []byte) ([]byte, error) {
+	return marshal([]interface{}{"Encrypt0", m.protected, bstr(external)})
+}
+
+//Encrypt0 encrypts plaintext with key, authenticating the external
+// additional data. The algorithm of the protected header is set to AlgSM4GCM
+// and a random IV is added to the unprotected header.
+func Encrypt0(plaintext, external []byte, key gm.SM4Key, protected, unprotected Header) (*Encrypt0Message, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	p, pb, u, err := protect(protected, unprotected, AlgSM4GCM)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, ivSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+	u[HeaderIV] = iv
+	m := &Encrypt0Message{Protected: p, Unprotected: u, protected: pb}
+	aad, err := m.additionalData(external)
+	if err != nil {
+		return nil, err
+	}
+	m.Ciphertext = aead.Seal(nil, iv, plaintext, aad)
+	return m, nil
+}
+
+//Decrypt returns the plaintext decrypted with key, authenticating the
+// external additional data.
+func (m *Encrypt0Message) Decrypt(external []byte, key gm.SM4Key) ([]byte, error) {
+	if alg, ok := m.Protected.Algorithm(); !ok || alg != AlgSM4GCM {
+		return nil, ErrDecryption
+	}
+	iv, ok := m.Unprotected[HeaderIV].([]byte)
+	if !ok {
+		iv, _ = m.Protected[HeaderIV].([]byte)
+	}
+	if len(iv) != ivSize {
+		return nil, ErrDecryption
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	aad, err := m.additionalData(external)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, iv, m.Ciphertext, aad)
+	if err != nil {
+		return nil, ErrDecryption
+	}
+	return plaintext, nil
+}
+
+//MarshalCBOR returns the tagged COSE_Encrypt0 encoding of m.
+func (m *Encrypt0Message) MarshalCBOR() ([]byte, error) {
+	if m.protected == nil {
+		return nil, errors.New("cose: message is not encrypted")
+	}
+	return encodeMessage(tagEncrypt0, m.protected, m.Unprotected, m.Ciphertext)
+}
+
+//ParseEncrypt0 parses a COSE_Encrypt0 message. Detached ciphertext is not
+// supported.
+func ParseEncrypt0(data []byte) (*Encrypt0Message, error) {
+	a, err := decodeMessage(data, tagEncrypt0, 3)
+	if err != nil {
+		return nil, err
+	}
+	m := new(Encrypt0Message)
+	if m.Protected, m.protected, err = decodeProtected(a[0]); err != nil {
+		return nil, err
+	}
+	if m.Unprotected, err = decodeUnprotected(a[1], m.Protected); err != nil {
+		return nil, err
+	}
+	var ok bool
+	if m.Ciphertext, ok = a[2].([]byte); !ok {
+		return nil, ErrMalformed
+	}
+	return m, nil
+}
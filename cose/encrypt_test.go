@@ -0,0 +1,58 @@
+package cose
+
+import (
+	"encoding/hex"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEncrypt0(t *testing.T) {
+	key := gm.SM4Key("0123456789abcdef")
+	m, err := Encrypt0([]byte("secret"), []byte("aad"), key, nil, Header{HeaderKeyID: []byte("k1")})
+	assert.Nil(t, err)
+	b, err := m.MarshalCBOR()
+	assert.Nil(t, err)
+	assert.Equal(t, byte(0xd0), b[0])
+
+	parsed, err := ParseEncrypt0(b)
+	assert.Nil(t, err)
+	assert.Len(t, parsed.Unprotected[HeaderIV], ivSize)
+	plaintext, err := parsed.Decrypt([]byte("aad"), key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	_, err = parsed.Decrypt(nil, key)
+	assert.Equal(t, ErrDecryption, err)
+	_, err = parsed.Decrypt([]byte("aad"), gm.SM4Key("fedcba9876543210"))
+	assert.Equal(t, ErrDecryption, err)
+	_, err = parsed.Decrypt([]byte("aad"), gm.SM4Key("short"))
+	assert.NotNil(t, err)
+	parsed.Ciphertext[0] ^= 1
+	_, err = parsed.Decrypt([]byte("aad"), key)
+	assert.Equal(t, ErrDecryption, err)
+
+	_, err = ParseEncrypt0(b[:len(b)-1])
+	assert.Equal(t, ErrMalformed, err)
+}
+
+func TestMac0(t *testing.T) {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	m, err := Mac0([]byte("This is the content."), nil, key, nil, nil)
+	assert.Nil(t, err)
+	b, err := m.MarshalCBOR()
+	assert.Nil(t, err)
+	// computed independently with Python's hmac and sm3
+	assert.Equal(t, "d18447a1013a00010002a054546869732069732074686520636f6e74656e742e5820"+
+		"9007f850ca203562e1a3ef3ec7c7ca914603f70510b39bacae626a616fff0f08", hex.EncodeToString(b))
+
+	parsed, err := ParseMac0(b)
+	assert.Nil(t, err)
+	assert.Nil(t, parsed.Verify(nil, key))
+	assert.Equal(t, ErrVerification, parsed.Verify([]byte("aad"), key))
+	key[0] ^= 1
+	assert.Equal(t, ErrVerification, parsed.Verify(nil, key))
+}
@@ -0,0 +1,139 @@
+package cose
+
+import (
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"math/big"
+)
+
+// COSE_Key labels and key types of RFC 9052 and RFC 9053.
+const (
+	keyType      int64 = 1
+	keyID        int64 = 2
+	keyAlgorithm int64 = 3
+	keyCurve     int64 = -1
+	keyX         int64 = -2
+	keyY         int64 = -3
+	keyD         int64 = -4
+	keyK         int64 = -1
+
+	keyTypeEC2       int64 = 2
+	keyTypeSymmetric int64 = 4
+)
+
+//Key is a COSE_Key holding an SM2 key, *gm.SM2PrivateKey or
+// *gm.SM2PublicKey, or an SM4 key, gm.SM4Key. An SM2 key is of type EC2 on
+// the curve CurveSM2; a zero Algorithm is absent.
+type Key struct {
+	Key       interface{}
+	KeyID     []byte
+	Algorithm int64
+}
+
+//MarshalCBOR returns the COSE_Key encoding of k.
+func (k *Key) MarshalCBOR() ([]byte, error) {
+	m := Header{}
+	switch key := k.Key.(type) {
+	case *gm.SM2PrivateKey:
+		pub := key.Public().(*gm.SM2PublicKey)
+		m[keyType], m[keyCurve], m[keyX], m[keyY] = keyTypeEC2, CurveSM2, pub.X[:], pub.Y[:]
+		m[keyD] = key.K[:]
+	case *gm.SM2PublicKey:
+		m[keyType], m[keyCurve], m[keyX], m[keyY] = keyTypeEC2, CurveSM2, key.X[:], key.Y[:]
+	case gm.SM4Key:
+		if len(key) != 16 {
+			return nil, errors.New("cose: an SM4 key is 16 bytes")
+		}
+		m[keyType], m[keyK] = keyTypeSymmetric, []byte(key)
+	default:
+		return nil, fmt.Errorf("cose: unsupported key type %T", k.Key)
+	}
+	if k.KeyID != nil {
+		m[keyID] = k.KeyID
+	}
+	if k.Algorithm != 0 {
+		m[keyAlgorithm] = k.Algorithm
+	}
+	return marshal(m)
+}
+
+// coordinate returns a byte string parameter of exactly 32 bytes.
+func coordinate(m map[interface{}]interface{}, label int64) ([]byte, error) {
+	b, ok := m[label].([]byte)
+	if !ok || len(b) != 32 {
+		return nil, errors.New("cose: invalid SM2 key parameter")
+	}
+	return b, nil
+}
+
+//UnmarshalCBOR parses a COSE_Key, checking that an SM2 public key is on the
+// curve and matches the private key.
+func (k *Key) UnmarshalCBOR(data []byte) error {
+	v, err := unmarshal(data)
+	if err != nil {
+		return err
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return ErrMalformed
+	}
+	kty, _ := m[keyType].(int64)
+	crv, _ := m[keyCurve].(int64)
+	var key interface{}
+	switch {
+	case kty == keyTypeEC2 && crv == CurveSM2:
+		x, err := coordinate(m, keyX)
+		if err != nil {
+			return err
+		}
+		y, err := coordinate(m, keyY)
+		if err != nil {
+			return err
+		}
+		if !gm.GetSm2Curve().IsOnCurve(new(big.Int).SetBytes(x), new(big.Int).SetBytes(y)) {
+			return errors.New("cose: SM2 public key not on the curve")
+		}
+		pub := &gm.SM2PublicKey{Curve: gm.GetSm2Curve()}
+		copy(pub.X[:], x)
+		copy(pub.Y[:], y)
+		key = pub
+		if _, ok := m[keyD]; ok {
+			d, err := coordinate(m, keyD)
+			if err != nil {
+				return err
+			}
+			dInt := new(big.Int).SetBytes(d)
+			if dInt.Sign() == 0 || dInt.Cmp(gm.GetSm2Curve().Params().N) >= 0 {
+				return errors.New("cose: SM2 private key out of range")
+			}
+			priv := new(gm.SM2PrivateKey)
+			copy(priv.K[:], d)
+			priv.PublicKey.Curve = gm.GetSm2Curve()
+			priv.CalculatePublicKey()
+			if priv.PublicKey.X != pub.X || priv.PublicKey.Y != pub.Y {
+				priv.Destroy()
+				return errors.New("cose: SM2 private key does not match the public key")
+			}
+			key = priv
+		}
+	case kty == keyTypeSymmetric:
+		b, ok := m[keyK].([]byte)
+		if !ok || len(b) != 16 {
+			return errors.New("cose: an SM4 key is 16 bytes")
+		}
+		key = gm.SM4Key(b)
+	default:
+		return fmt.Errorf("cose: unsupported key type %v, curve %v", m[keyType], m[keyCurve])
+	}
+	kid, ok := m[keyID].([]byte)
+	if _, present := m[keyID]; present && !ok {
+		return ErrMalformed
+	}
+	alg, ok := m[keyAlgorithm].(int64)
+	if _, present := m[keyAlgorithm]; present && !ok {
+		return ErrMalformed
+	}
+	*k = Key{Key: key, KeyID: kid, Algorithm: alg}
+	return nil
+}
@@ -0,0 +1,46 @@
+package cose
+
+import (
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	priv, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	b, err := (&Key{Key: priv, KeyID: []byte("k1"), Algorithm: AlgSM2SM3}).MarshalCBOR()
+	assert.Nil(t, err)
+	var k Key
+	assert.Nil(t, k.UnmarshalCBOR(b))
+	assert.Equal(t, []byte("k1"), k.KeyID)
+	assert.Equal(t, AlgSM2SM3, k.Algorithm)
+	assert.Equal(t, priv.K, k.Key.(*gm.SM2PrivateKey).K)
+
+	pub := priv.Public().(*gm.SM2PublicKey)
+	b, err = (&Key{Key: pub}).MarshalCBOR()
+	assert.Nil(t, err)
+	assert.Nil(t, k.UnmarshalCBOR(b))
+	assert.Equal(t, pub.X, k.Key.(*gm.SM2PublicKey).X)
+	assert.Equal(t, pub.Y, k.Key.(*gm.SM2PublicKey).Y)
+	assert.Nil(t, k.KeyID)
+
+	b, err = (&Key{Key: gm.SM4Key("0123456789abcdef")}).MarshalCBOR()
+	assert.Nil(t, err)
+	assert.Nil(t, k.UnmarshalCBOR(b))
+	assert.Equal(t, gm.SM4Key("0123456789abcdef"), k.Key)
+
+	other, _ := gm.GenerateSM2Key()
+	for _, m := range []Header{
+		{keyType: keyTypeEC2, keyCurve: CurveSM2, keyX: pub.X[:], keyY: pub.X[:]},
+		{keyType: keyTypeEC2, keyCurve: CurveSM2, keyX: pub.X[:], keyY: pub.Y[:], keyD: other.K[:]},
+		{keyType: keyTypeEC2, keyCurve: CurveSM2, keyX: pub.X[:], keyY: true},
+		{keyType: keyTypeEC2, keyCurve: int64(1), keyX: pub.X[:], keyY: pub.Y[:]},
+		{keyType: keyTypeEC2, keyCurve: CurveSM2, keyX: pub.X[:], keyY: pub.Y[:], keyID: "k1"},
+		{keyType: keyTypeSymmetric, keyK: []byte("short")},
+	} {
+		b, err := marshal(m)
+		assert.Nil(t, err)
+		assert.NotNil(t, k.UnmarshalCBOR(b))
+	}
+}
@@ -0,0 +1,91 @@
+package cose
+
+import (
+	"crypto/hmac"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+)
+
+//Mac0Message is a COSE_Mac0 message, a payload authenticated with a key
+// known to the recipient. A nil Payload is detached as in Sign1Message.
+type Mac0Message struct {
+	Protected   Header
+	Unprotected Header
+	Payload     []byte
+	Tag         []byte
+
+	protected []byte
+}
+
+func (m *Mac0Message) mac(external, key []byte) ([]byte, error) {
+	s, err := marshal([]interface{}{"MAC0", m.protected, bstr(external), bstr(m.Payload)})
+	if err != nil {
+		return nil, err
+	}
+	h := hmac.New(gm.GetSM3Hasher, key)
+	h.Write(s)
+	return h.Sum(nil), nil
+}
+
+//Mac0 authenticates payload and the external additional data with key. The
+// algorithm of the protected header is set to AlgHMACSM3.
+func Mac0(payload, external, key []byte, protected, unprotected Header) (*Mac0Message, error) {
+	if len(key) == 0 {
+		return nil, errors.New("cose: empty MAC key")
+	}
+	p, pb, u, err := protect(protected, unprotected, AlgHMACSM3)
+	if err != nil {
+		return nil, err
+	}
+	m := &Mac0Message{Protected: p, Unprotected: u, Payload: payload, protected: pb}
+	if m.Tag, err = m.mac(external, key); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+//Verify checks the tag with key and the external additional data.
+func (m *Mac0Message) Verify(external, key []byte) error {
+	if alg, ok := m.Protected.Algorithm(); !ok || alg != AlgHMACSM3 {
+		return ErrVerification
+	}
+	tag, err := m.mac(external, key)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(tag, m.Tag) {
+		return ErrVerification
+	}
+	return nil
+}
+
+//MarshalCBOR returns the tagged COSE_Mac0 encoding of m.
+func (m *Mac0Message) MarshalCBOR() ([]byte, error) {
+	if m.protected == nil {
+		return nil, errors.New("cose: message is not authenticated")
+	}
+	return encodeMessage(tagMac0, m.protected, m.Unprotected, optional(m.Payload), m.Tag)
+}
+
+//ParseMac0 parses a COSE_Mac0 message.
+func ParseMac0(data []byte) (*Mac0Message, error) {
+	a, err := decodeMessage(data, tagMac0, 4)
+	if err != nil {
+		return nil, err
+	}
+	m := new(Mac0Message)
+	if m.Protected, m.protected, err = decodeProtected(a[0]); err != nil {
+		return nil, err
+	}
+	if m.Unprotected, err = decodeUnprotected(a[1], m.Protected); err != nil {
+		return nil, err
+	}
+	var ok bool
+	if m.Payload, ok = payload(a[2]); !ok {
+		return nil, ErrMalformed
+	}
+	if m.Tag, ok = a[3].([]byte); !ok {
+		return nil, ErrMalformed
+	}
+	return m, nil
+}
@@ -0,0 +1,259 @@
+package cose
+
+import (
+	"crypto/rand"
+	"encoding/asn1"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"math/big"
+)
+
+type derSignature struct {
+	R, S *big.Int
+}
+
+//Sign1Message is a COSE_Sign1 message. A nil Payload is detached: it is
+// encoded as null and must be set again before verifying.
+type Sign1Message struct {
+	Protected   Header
+	Unprotected Header
+	Payload     []byte
+	Signature   []byte
+
+	protected []byte
+}
+
+//Signer is a signature of a COSE_Sign message with its headers.
+type Signer struct {
+	Protected   Header
+	Unprotected Header
+	Signature   []byte
+
+	protected []byte
+}
+
+//SignMessage is a COSE_Sign message, a payload with one or more signatures.
+// A nil Payload is detached as in Sign1Message.
+type SignMessage struct {
+	Protected   Header
+	Unprotected Header
+	Payload     []byte
+	Signers     []Signer
+
+	protected []byte
+}
+
+// sign returns the raw SM2 signature of the Sig_structure s.
+func sign(key *gm.SM2PrivateKey, s []interface{}) ([]byte, error) {
+	tbs, err := marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	der, err := key.Sign(nil, gm.HashBeforeSM2(key.Public().(*gm.SM2PublicKey), tbs), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	var rs derSignature
+	if _, err := asn1.Unmarshal(der, &rs); err != nil {
+		return nil, err
+	}
+	return append(rs.R.FillBytes(make([]byte, 32)), rs.S.FillBytes(make([]byte, 32))...), nil
+}
+
+// verify checks the raw SM2 signature sig of the Sig_structure s.
+func verify(pub *gm.SM2PublicKey, protected Header, sig []byte, s []interface{}) bool {
+	if alg, ok := protected.Algorithm(); !ok || alg != AlgSM2SM3 || len(sig) != 64 {
+		return false
+	}
+	tbs, err := marshal(s)
+	if err != nil {
+		return false
+	}
+	der, err := asn1.Marshal(derSignature{new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])})
+	if err != nil {
+		return false
+	}
+	ok, err := pub.Verify(nil, der, gm.HashBeforeSM2(pub, tbs))
+	return err == nil && ok
+}
+
+// bstr returns b as a byte string, never null, for a to-be-signed structure.
+func bstr(b []byte) []byte {
+	if b == nil {
+		return []byte{}
+	}
+	return b
+}
+
+// optional returns b, or nil for a detached payload.
+func optional(b []byte) interface{} {
+	if b == nil {
+		return nil
+	}
+	return b
+}
+
+//Sign1 signs payload and the external additional data with key. The
+// algorithm of the protected header is set to AlgSM2SM3.
+func Sign1(payload, external []byte, key *gm.SM2PrivateKey, protected, unprotected Header) (*Sign1Message, error) {
+	p, pb, u, err := protect(protected, unprotected, AlgSM2SM3)
+	if err != nil {
+		return nil, err
+	}
+	m := &Sign1Message{Protected: p, Unprotected: u, Payload: payload, protected: pb}
+	m.Signature, err = sign(key, m.toBeSigned(external))
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Sign1Message) toBeSigned(external []byte) []interface{} {
+	return []interface{}{"Signature1", m.protected, bstr(external), bstr(m.Payload)}
+}
+
+//Verify checks the signature with pub and the external additional data.
+func (m *Sign1Message) Verify(external []byte, pub *gm.SM2PublicKey) error {
+	if !verify(pub, m.Protected, m.Signature, m.toBeSigned(external)) {
+		return ErrVerification
+	}
+	return nil
+}
+
+//MarshalCBOR returns the tagged COSE_Sign1 encoding of m.
+func (m *Sign1Message) MarshalCBOR() ([]byte, error) {
+	if m.protected == nil {
+		return nil, errors.New("cose: message is not signed")
+	}
+	return encodeMessage(tagSign1, m.protected, m.Unprotected, optional(m.Payload), m.Signature)
+}
+
+//ParseSign1 parses a COSE_Sign1 message.
+func ParseSign1(data []byte) (*Sign1Message, error) {
+	a, err := decodeMessage(data, tagSign1, 4)
+	if err != nil {
+		return nil, err
+	}
+	m := new(Sign1Message)
+	if m.Protected, m.protected, err = decodeProtected(a[0]); err != nil {
+		return nil, err
+	}
+	if m.Unprotected, err = decodeUnprotected(a[1], m.Protected); err != nil {
+		return nil, err
+	}
+	var ok bool
+	if m.Payload, ok = payload(a[2]); !ok {
+		return nil, ErrMalformed
+	}
+	if m.Signature, ok = a[3].([]byte); !ok {
+		return nil, ErrMalformed
+	}
+	return m, nil
+}
+
+//NewSignMessage returns a COSE_Sign message of payload without signatures.
+func NewSignMessage(payload []byte, protected, unprotected Header) (*SignMessage, error) {
+	p, err := protected.normalize()
+	if err != nil {
+		return nil, err
+	}
+	u, err := unprotected.normalize()
+	if err != nil {
+		return nil, err
+	}
+	if err := disjoint(p, u); err != nil {
+		return nil, err
+	}
+	pb := []byte{}
+	if len(p) != 0 {
+		if pb, err = marshal(p); err != nil {
+			return nil, err
+		}
+	}
+	return &SignMessage{Protected: p, Unprotected: u, Payload: payload, protected: pb}, nil
+}
+
+func (m *SignMessage) toBeSigned(s *Signer, external []byte) []interface{} {
+	return []interface{}{"Signature", m.protected, s.protected, bstr(external), bstr(m.Payload)}
+}
+
+//AddSignature adds a signature by key over the payload and the external
+// additional data. The algorithm of the signer's protected header is set to
+// AlgSM2SM3.
+func (m *SignMessage) AddSignature(external []byte, key *gm.SM2PrivateKey, protected, unprotected Header) error {
+	p, pb, u, err := protect(protected, unprotected, AlgSM2SM3)
+	if err != nil {
+		return err
+	}
+	s := Signer{Protected: p, Unprotected: u, protected: pb}
+	if s.Signature, err = sign(key, m.toBeSigned(&s, external)); err != nil {
+		return err
+	}
+	m.Signers = append(m.Signers, s)
+	return nil
+}
+
+//Verify checks that a signature verifies with pub and the external
+// additional data.
+func (m *SignMessage) Verify(external []byte, pub *gm.SM2PublicKey) error {
+	for i := range m.Signers {
+		s := &m.Signers[i]
+		if verify(pub, s.Protected, s.Signature, m.toBeSigned(s, external)) {
+			return nil
+		}
+	}
+	return ErrVerification
+}
+
+//MarshalCBOR returns the tagged COSE_Sign encoding of m.
+func (m *SignMessage) MarshalCBOR() ([]byte, error) {
+	if len(m.Signers) == 0 {
+		return nil, errors.New("cose: message is not signed")
+	}
+	signers := make([]interface{}, len(m.Signers))
+	for i, s := range m.Signers {
+		signers[i] = []interface{}{s.protected, s.Unprotected, s.Signature}
+	}
+	return encodeMessage(tagSign, m.protected, m.Unprotected, optional(m.Payload), signers)
+}
+
+//ParseSign parses a COSE_Sign message.
+func ParseSign(data []byte) (*SignMessage, error) {
+	a, err := decodeMessage(data, tagSign, 4)
+	if err != nil {
+		return nil, err
+	}
+	m := new(SignMessage)
+	if m.Protected, m.protected, err = decodeProtected(a[0]); err != nil {
+		return nil, err
+	}
+	if m.Unprotected, err = decodeUnprotected(a[1], m.Protected); err != nil {
+		return nil, err
+	}
+	var ok bool
+	if m.Payload, ok = payload(a[2]); !ok {
+		return nil, ErrMalformed
+	}
+	signers, ok := a[3].([]interface{})
+	if !ok || len(signers) == 0 {
+		return nil, ErrMalformed
+	}
+	for _, v := range signers {
+		e, ok := v.([]interface{})
+		if !ok || len(e) != 3 {
+			return nil, ErrMalformed
+		}
+		var s Signer
+		if s.Protected, s.protected, err = decodeProtected(e[0]); err != nil {
+			return nil, err
+		}
+		if s.Unprotected, err = decodeUnprotected(e[1], s.Protected); err != nil {
+			return nil, err
+		}
+		if s.Signature, ok = e[2].([]byte); !ok {
+			return nil, ErrMalformed
+		}
+		m.Signers = append(m.Signers, s)
+	}
+	return m, nil
+}
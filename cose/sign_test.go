@@ -0,0 +1,75 @@
+package cose
+
+import (
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSign1(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	pub := key.Public().(*gm.SM2PublicKey)
+	m, err := Sign1([]byte("payload"), []byte("aad"), key, Header{HeaderContentType: int64(0)}, Header{HeaderKeyID: []byte("k1")})
+	assert.Nil(t, err)
+	b, err := m.MarshalCBOR()
+	assert.Nil(t, err)
+	assert.Equal(t, byte(0xd2), b[0])
+
+	parsed, err := ParseSign1(b)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("k1"), parsed.Unprotected.KeyID())
+	alg, _ := parsed.Protected.Algorithm()
+	assert.Equal(t, AlgSM2SM3, alg)
+	assert.Nil(t, parsed.Verify([]byte("aad"), pub))
+	assert.Equal(t, ErrVerification, parsed.Verify(nil, pub))
+	other, _ := gm.GenerateSM2Key()
+	assert.Equal(t, ErrVerification, parsed.Verify([]byte("aad"), other.Public().(*gm.SM2PublicKey)))
+	parsed.Payload[0] ^= 1
+	assert.Equal(t, ErrVerification, parsed.Verify([]byte("aad"), pub))
+
+	// detached payload, untagged
+	m.Payload = nil
+	b, err = m.MarshalCBOR()
+	assert.Nil(t, err)
+	parsed, err = ParseSign1(b[1:])
+	assert.Nil(t, err)
+	assert.Nil(t, parsed.Payload)
+	parsed.Payload = []byte("payload")
+	assert.Nil(t, parsed.Verify([]byte("aad"), pub))
+
+	_, err = ParseSign1(append([]byte{0xd1}, b[1:]...))
+	assert.Equal(t, ErrMalformed, err)
+	_, err = Sign1(nil, nil, key, nil, Header{HeaderAlgorithm: AlgSM2SM3})
+	assert.NotNil(t, err)
+	b, err = encodeMessage(tagSign1, m.protected, Header{HeaderAlgorithm: AlgSM2SM3}, nil, m.Signature)
+	assert.Nil(t, err)
+	_, err = ParseSign1(b)
+	assert.Equal(t, ErrMalformed, err)
+}
+
+func TestSign(t *testing.T) {
+	k1, _ := gm.GenerateSM2Key()
+	k2, _ := gm.GenerateSM2Key()
+	m, err := NewSignMessage([]byte("payload"), nil, Header{HeaderContentType: "text/plain"})
+	assert.Nil(t, err)
+	_, err = m.MarshalCBOR()
+	assert.NotNil(t, err)
+	assert.Nil(t, m.AddSignature(nil, k1, nil, Header{HeaderKeyID: []byte("k1")}))
+	assert.Nil(t, m.AddSignature(nil, k2, nil, Header{HeaderKeyID: []byte("k2")}))
+	b, err := m.MarshalCBOR()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xd8, 0x62}, b[:2])
+
+	parsed, err := ParseSign(b)
+	assert.Nil(t, err)
+	assert.Len(t, parsed.Signers, 2)
+	assert.Equal(t, []byte("k2"), parsed.Signers[1].Unprotected.KeyID())
+	for _, k := range []*gm.SM2PrivateKey{k1, k2} {
+		assert.Nil(t, parsed.Verify(nil, k.Public().(*gm.SM2PublicKey)))
+	}
+	assert.Equal(t, ErrVerification, parsed.Verify([]byte("aad"), k1.Public().(*gm.SM2PublicKey)))
+	parsed.Signers[0].Signature[0] ^= 1
+	assert.Equal(t, ErrVerification, parsed.Verify(nil, k1.Public().(*gm.SM2PublicKey)))
+	assert.Nil(t, parsed.Verify(nil, k2.Public().(*gm.SM2PublicKey)))
+}
@@ -0,0 +1,129 @@
+package drbg
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	gm "github.com/meshplus/crypto-gm"
+)
+
+const (
+	ctrKeyLen   = 16 // SM4 key length
+	ctrBlockLen = 16 // SM4 block length
+	ctrSeedLen  = ctrKeyLen + ctrBlockLen
+)
+
+// ctrDRBG is CTR_DRBG of SP 800-90A section 10.2.1 with SM4, the derivation
+// function and ctr_len equal to the block length.
+type ctrDRBG struct {
+	key   [ctrKeyLen]byte
+	v     [ctrBlockLen]byte
+	block cipher.Block
+}
+
+func newSM4(key []byte) cipher.Block {
+	block, err := gm.GetSm4Cipher(key)
+	if err != nil {
+		// the key length is fixed
+		panic("drbg: " + err.Error())
+	}
+	return block
+}
+
+func (c *ctrDRBG) instantiate(entropy, nonce, personalization []byte) {
+	var seed [ctrSeedLen]byte
+	blockCipherDF(seed[:], entropy, nonce, personalization)
+	c.key, c.v = [ctrKeyLen]byte{}, [ctrBlockLen]byte{}
+	c.block = newSM4(c.key[:])
+	c.update(&seed)
+}
+
+func (c *ctrDRBG) reseed(entropy, additional []byte) {
+	var seed [ctrSeedLen]byte
+	blockCipherDF(seed[:], entropy, additional)
+	c.update(&seed)
+}
+
+func (c *ctrDRBG) generate(out, additional []byte, _ uint64) {
+	var seed [ctrSeedLen]byte
+	if len(additional) > 0 {
+		blockCipherDF(seed[:], additional)
+		c.update(&seed)
+	}
+	var block [ctrBlockLen]byte
+	for len(out) > 0 {
+		addMod(c.v[:], []byte{1})
+		c.block.Encrypt(block[:], c.v[:])
+		n := copy(out, block[:])
+		out = out[n:]
+	}
+	c.update(&seed)
+}
+
+// update is CTR_DRBG_Update of SP 800-90A section 10.2.1.2.
+func (c *ctrDRBG) update(provided *[ctrSeedLen]byte) {
+	var temp [ctrSeedLen]byte
+	for i := 0; i < ctrSeedLen; i += ctrBlockLen {
+		addMod(c.v[:], []byte{1})
+		c.block.Encrypt(temp[i:], c.v[:])
+	}
+	for i := range temp {
+		temp[i] ^= provided[i]
+	}
+	copy(c.key[:], temp[:ctrKeyLen])
+	copy(c.v[:], temp[ctrKeyLen:])
+	c.block = newSM4(c.key[:])
+}
+
+// blockCipherDF is Block_Cipher_df of SP 800-90A section 10.3.2, it fills out
+// with the derivation of the concatenated inputs.
+func blockCipherDF(out []byte, inputs ...[]byte) {
+	// S = L || N || input || 0x80, padded with zeros to the block length
+	var length int
+	for _, in := range inputs {
+		length += len(in)
+	}
+	s := make([]byte, 8, 8+length+ctrBlockLen)
+	binary.BigEndian.PutUint32(s, uint32(length))
+	binary.BigEndian.PutUint32(s[4:], uint32(len(out)))
+	for _, in := range inputs {
+		s = append(s, in...)
+	}
+	s = append(s, 0x80)
+	for len(s)%ctrBlockLen != 0 {
+		s = append(s, 0)
+	}
+
+	var k [ctrKeyLen]byte
+	for i := range k {
+		k[i] = byte(i)
+	}
+	block := newSM4(k[:])
+	temp := make([]byte, 0, ctrSeedLen)
+	var iv [ctrBlockLen]byte
+	for i := uint32(0); len(temp) < ctrSeedLen; i++ {
+		binary.BigEndian.PutUint32(iv[:], i)
+		temp = append(temp, bcc(block, iv[:], s)...)
+	}
+
+	block = newSM4(temp[:ctrKeyLen])
+	x := temp[ctrKeyLen:ctrSeedLen]
+	for len(out) > 0 {
+		block.Encrypt(x, x)
+		n := copy(out, x)
+		out = out[n:]
+	}
+}
+
+// bcc is BCC of SP 800-90A section 10.3.3, the CBC-MAC of iv || data.
+func bcc(block cipher.Block, iv, data []byte) []byte {
+	chaining := make([]byte, ctrBlockLen)
+	for _, in := range [][]byte{iv, data} {
+		for ; len(in) > 0; in = in[ctrBlockLen:] {
+			for i := range chaining {
+				chaining[i] ^= in[i]
+			}
+			block.Encrypt(chaining, chaining)
+		}
+	}
+	return chaining
+}
@@ -0,0 +1,249 @@
+//Package drbg implements the deterministic random bit generators of
+// GM/T 0105-2021, which follow NIST SP 800-90A: Hash_DRBG with SM3 and
+// CTR_DRBG with SM4 and the derivation function. A DRBG is an io.Reader, so it
+// can be passed wherever the module reads randomness, e.g. key generation and
+// signing.
+package drbg
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// entropyLength is the number of bytes read from the entropy source for
+	// instantiation and every reseed, enough for a security strength of 256
+	// bits.
+	entropyLength = 32
+	// nonceLength is the length of the nonce read from the entropy source
+	// when Config.Nonce is nil.
+	nonceLength = 16
+	// maxBytesPerRequest is max_number_of_bits_per_request of SP 800-90A,
+	// 2^19 bits.
+	maxBytesPerRequest = 1 << 16
+	// maxInputLength bounds the nonce, personalization string and additional
+	// input.
+	maxInputLength = 1 << 16
+)
+
+//DefaultReseedInterval is the number of Generate calls after which a DRBG
+// reseeds when Config.ReseedInterval is zero.
+const DefaultReseedInterval = 1 << 20
+
+var (
+	//ErrHealthTest is returned once a health test of the DRBG has failed,
+	// the DRBG can't be used anymore.
+	ErrHealthTest = errors.New("drbg: health test failed")
+	//ErrReseedRequired is returned by a seeded DRBG, which has no entropy
+	// source, when it has to reseed.
+	ErrReseedRequired = errors.New("drbg: reseed required")
+
+	errInputTooLong   = errors.New("drbg: input too long")
+	errRequestTooLong = errors.New("drbg: too many bytes requested at once")
+)
+
+//Config holds the instantiation parameters of a DRBG. The zero value reads
+// entropy from crypto/rand and reseeds every DefaultReseedInterval requests.
+type Config struct {
+	// Entropy is the entropy source, crypto/rand if nil.
+	Entropy io.Reader
+	// Nonce is used at instantiation, if nil it is read from Entropy.
+	Nonce []byte
+	// Personalization is the optional personalization string.
+	Personalization []byte
+	// PredictionResistance makes every request reseed from Entropy first.
+	PredictionResistance bool
+	// ReseedInterval is the number of requests between two reseeds,
+	// DefaultReseedInterval if zero.
+	ReseedInterval uint64
+	// ReseedTimeInterval, if not zero, is the maximum time between two
+	// reseeds.
+	ReseedTimeInterval time.Duration
+}
+
+// mechanism is the state of a DRBG mechanism of SP 800-90A section 10,
+// reseed counting and health testing are left to DRBG.
+type mechanism interface {
+	instantiate(entropy, nonce, personalization []byte)
+	reseed(entropy, additional []byte)
+	// generate fills out, reseedCounter is the number of requests since the
+	// last (re)seeding, 1 for the first one.
+	generate(out, additional []byte, reseedCounter uint64)
+}
+
+//DRBG is a deterministic random bit generator, it is safe for concurrent
+// use.
+type DRBG struct {
+	mu            sync.Mutex
+	m             mechanism
+	entropy       io.Reader // nil for a seeded DRBG
+	prediction    bool
+	interval      uint64
+	timeInterval  time.Duration
+	reseedCounter uint64
+	reseedTime    time.Time
+	lastEntropy   []byte
+	failed        bool
+}
+
+//NewHashDRBG returns a Hash_DRBG using SM3, config may be nil.
+func NewHashDRBG(config *Config) (*DRBG, error) {
+	if err := selfTest(); err != nil {
+		return nil, err
+	}
+	return newDRBG(new(hashDRBG), config)
+}
+
+//NewCTRDRBG returns a CTR_DRBG using SM4 and the derivation function, config
+// may be nil.
+func NewCTRDRBG(config *Config) (*DRBG, error) {
+	if err := selfTest(); err != nil {
+		return nil, err
+	}
+	return newDRBG(new(ctrDRBG), config)
+}
+
+//NewSeededHashDRBG returns a Hash_DRBG instantiated with seed as its entropy
+// input. It has no entropy source and fails with ErrReseedRequired after
+// DefaultReseedInterval requests; it is meant to reproduce test runs, its
+// output is only as secret as the seed.
+func NewSeededHashDRBG(seed, personalization []byte) (*DRBG, error) {
+	if err := selfTest(); err != nil {
+		return nil, err
+	}
+	if len(seed) > maxInputLength || len(personalization) > maxInputLength {
+		return nil, errInputTooLong
+	}
+	d := &DRBG{m: new(hashDRBG), interval: DefaultReseedInterval}
+	d.m.instantiate(seed, nil, personalization)
+	d.reseedCounter = 1
+	return d, nil
+}
+
+func newDRBG(m mechanism, config *Config) (*DRBG, error) {
+	if config == nil {
+		config = new(Config)
+	}
+	d := &DRBG{
+		m:            m,
+		entropy:      config.Entropy,
+		prediction:   config.PredictionResistance,
+		interval:     config.ReseedInterval,
+		timeInterval: config.ReseedTimeInterval,
+	}
+	if d.entropy == nil {
+		d.entropy = rand.Reader
+	}
+	if d.interval == 0 {
+		d.interval = DefaultReseedInterval
+	}
+	if len(config.Nonce) > maxInputLength || len(config.Personalization) > maxInputLength {
+		return nil, errInputTooLong
+	}
+
+	entropy, err := d.readEntropy()
+	if err != nil {
+		return nil, err
+	}
+	nonce := config.Nonce
+	if nonce == nil {
+		nonce = make([]byte, nonceLength)
+		if _, err := io.ReadFull(d.entropy, nonce); err != nil {
+			return nil, errors.New("drbg: reading nonce: " + err.Error())
+		}
+	}
+	d.m.instantiate(entropy, nonce, config.Personalization)
+	d.reseedCounter = 1
+	d.reseedTime = time.Now()
+	return d, nil
+}
+
+// readEntropy reads an entropy input and runs the continuous test on it:
+// two consecutive inputs must differ.
+func (d *DRBG) readEntropy() ([]byte, error) {
+	entropy := make([]byte, entropyLength)
+	if _, err := io.ReadFull(d.entropy, entropy); err != nil {
+		return nil, errors.New("drbg: reading entropy: " + err.Error())
+	}
+	if d.lastEntropy != nil && bytes.Equal(entropy, d.lastEntropy) {
+		d.failed = true
+		return nil, ErrHealthTest
+	}
+	d.lastEntropy = entropy
+	return entropy, nil
+}
+
+//Reseed mixes fresh entropy and the optional additional input into the
+// state.
+func (d *DRBG) Reseed(additional []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.failed {
+		return ErrHealthTest
+	}
+	if len(additional) > maxInputLength {
+		return errInputTooLong
+	}
+	return d.reseed(additional)
+}
+
+func (d *DRBG) reseed(additional []byte) error {
+	if d.entropy == nil {
+		return ErrReseedRequired
+	}
+	entropy, err := d.readEntropy()
+	if err != nil {
+		return err
+	}
+	d.m.reseed(entropy, additional)
+	d.reseedCounter = 1
+	d.reseedTime = time.Now()
+	return nil
+}
+
+//Generate fills out with random bytes, mixing in the optional additional
+// input. At most 65536 bytes can be requested at once.
+func (d *DRBG) Generate(out, additional []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.failed {
+		return ErrHealthTest
+	}
+	if len(out) > maxBytesPerRequest {
+		return errRequestTooLong
+	}
+	if len(additional) > maxInputLength {
+		return errInputTooLong
+	}
+
+	if d.prediction || d.reseedCounter > d.interval ||
+		(d.timeInterval > 0 && time.Since(d.reseedTime) >= d.timeInterval) {
+		if err := d.reseed(additional); err != nil {
+			return err
+		}
+		additional = nil
+	}
+	d.m.generate(out, additional, d.reseedCounter)
+	d.reseedCounter++
+	return nil
+}
+
+//Read implements io.Reader, it splits p into requests as large as allowed.
+func (d *DRBG) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		chunk := p[n:]
+		if len(chunk) > maxBytesPerRequest {
+			chunk = chunk[:maxBytesPerRequest]
+		}
+		if err := d.Generate(chunk, nil); err != nil {
+			return n, err
+		}
+		n += len(chunk)
+	}
+	return n, nil
+}
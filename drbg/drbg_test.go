@@ -0,0 +1,193 @@
+package drbg
+
+import (
+	"bytes"
+	"encoding/hex"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+	"time"
+)
+
+// The expected outputs were computed with an independent implementation of
+// SP 800-90A, using the SM3 of Python's hashlib and the SM4 of OpenSSL.
+
+func entropyInputs(n int) []byte {
+	var entropy []byte
+	for _, start := range []byte{0x00, 0x40, 0x80}[:n] {
+		for i := byte(0); i < 32; i++ {
+			entropy = append(entropy, start+i)
+		}
+	}
+	return entropy
+}
+
+func testNonce() []byte {
+	nonce := make([]byte, 16)
+	for i := range nonce {
+		nonce[i] = byte(0x20 + i)
+	}
+	return nonce
+}
+
+var constructors = map[string]func(*Config) (*DRBG, error){
+	"hash": NewHashDRBG,
+	"ctr":  NewCTRDRBG,
+}
+
+func TestDRBG(t *testing.T) {
+	want := map[string][]string{
+		"hash": {
+			"4ea563b95851e9340545b90202f857e476a33a64b56a775e3048bd6c139535a5ef09651533eb1a5569f7bffa32bf9566abc18e85e44ccbd65c2cbdd0ac5e2a03",
+			"9f170bb51ab252f5d690bdbcfcf01b2202dc7443be148cee06970a4848a22a6cf4b11807749cfc0f40d91b49f3e30fbe8ee9a3b64a39a38eb58484739b79e20a",
+			"47e8cbaa8f551b71a89ec72bbed3e3b638d0815b2582e56bc124a2664103d25c3a31fa5ceb34d3b435fc38df4eed2f81b583a81957d8c7976ef3d87c851a06b2",
+		},
+		"ctr": {
+			"70d46f0fded893cfe503da9bd09e0fe68f8f7beb4552f4c764e7f2c146398b68fccc4be781647740fe826981e3c2a0ab73b8ad6a80c03007f53866eed786e60a",
+			"c1f8c766f39fe9cf2f1686c81a79f9bade56079ae75ca80f323e7bda25d4eaeceb9431222cc05b7d0a98b8cd108ed5a4738b788853b569dbfc507a6442ae1b05",
+			"fa7ea333dcb49eb1eb7b6ce769157e546543fc8643d652fb293c0d164d89b747d36cb7e6b517ef21b96fd56fa3d495404da0e8ea7780d9fd6e2c46d571f2aa32",
+		},
+	}
+	for name, newDRBG := range constructors {
+		d, err := newDRBG(&Config{
+			Entropy:         bytes.NewReader(entropyInputs(2)),
+			Nonce:           testNonce(),
+			Personalization: []byte("personalization"),
+		})
+		assert.Nil(t, err)
+		out := make([]byte, 64)
+		assert.Nil(t, d.Generate(out, nil))
+		assert.Equal(t, want[name][0], hex.EncodeToString(out), name)
+		assert.Nil(t, d.Generate(out, []byte("additional input")))
+		assert.Equal(t, want[name][1], hex.EncodeToString(out), name)
+		assert.Nil(t, d.Reseed([]byte("reseed")))
+		assert.Nil(t, d.Generate(out, nil))
+		assert.Equal(t, want[name][2], hex.EncodeToString(out), name)
+
+		// the entropy source is exhausted
+		assert.NotNil(t, d.Reseed(nil))
+	}
+}
+
+func TestPredictionResistance(t *testing.T) {
+	want := map[string][]string{
+		"hash": {
+			"476686566f596606df625c03bf6381d26f68f723ccefdbcb0df911da98b033891bcaf737b10c53a83b5bb74eab1866a9cb95f3dc225ba9adfeaf39cd37d1eea0",
+			"54f484e7b93ea6133da4f13d936eb81b6265af4b38deae02cca619f9a268600ade2a5956a994a365dc1ea42d25da53cc63b7d209dc21e095018d207ee1152f78",
+		},
+		"ctr": {
+			"5d6f8662470b64996468637d16f5b4f292a8878d419bd912450b813f0f1d1954269f589b95e755c2485ca6dc534f293e4dc197f3f5847a5f71540582c72ab69e",
+			"f0af8d0a68837ecc977c9f26feefe5bf3408c187dbdc7ef213e6ee5245ad1711313b7eca161d490e8117d2684ebcb1d32c5e7b279e8b88cf725324dea07b3dc1",
+		},
+	}
+	for name, newDRBG := range constructors {
+		d, err := newDRBG(&Config{
+			Entropy:              bytes.NewReader(entropyInputs(3)),
+			Nonce:                testNonce(),
+			Personalization:      []byte("personalization"),
+			PredictionResistance: true,
+		})
+		assert.Nil(t, err)
+		out := make([]byte, 64)
+		assert.Nil(t, d.Generate(out, []byte("additional input")))
+		assert.Equal(t, want[name][0], hex.EncodeToString(out), name)
+		assert.Nil(t, d.Generate(out, nil))
+		assert.Equal(t, want[name][1], hex.EncodeToString(out), name)
+		// every request needs fresh entropy
+		assert.NotNil(t, d.Generate(out, nil))
+	}
+}
+
+func TestSeededHashDRBG(t *testing.T) {
+	d, err := NewSeededHashDRBG(entropyInputs(1), nil)
+	assert.Nil(t, err)
+	out := make([]byte, 100)
+	_, err = io.ReadFull(d, out)
+	assert.Nil(t, err)
+	assert.Equal(t, "e8901c93650a10456d74d6f7aaeed176175e77370fbe51c04d51c380dc4e90912a12e8f9abc22807e6ea98884e6431bb2d4c092328cd716334277fd44f0f0da0283a62493ef961307fb5bdaf120e4bb4cee073297f07881ec1ee7b3e9b3ab4dac73bf245", hex.EncodeToString(out))
+	assert.Equal(t, ErrReseedRequired, d.Reseed(nil))
+
+	d.reseedCounter = DefaultReseedInterval + 1
+	assert.Equal(t, ErrReseedRequired, d.Generate(out, nil))
+}
+
+type countingReader struct {
+	r     io.Reader
+	reads int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	c.reads++
+	return c.r.Read(p)
+}
+
+func TestReseedInterval(t *testing.T) {
+	for name, newDRBG := range constructors {
+		entropy := &countingReader{r: bytes.NewReader(entropyInputs(3))}
+		d, err := newDRBG(&Config{Entropy: entropy, Nonce: testNonce(), ReseedInterval: 2})
+		assert.Nil(t, err)
+		out := make([]byte, 16)
+		assert.Nil(t, d.Generate(out, nil))
+		assert.Nil(t, d.Generate(out, nil))
+		assert.Equal(t, 1, entropy.reads, name)
+		assert.Nil(t, d.Generate(out, nil))
+		assert.Equal(t, 2, entropy.reads, name)
+
+		entropy = &countingReader{r: bytes.NewReader(entropyInputs(3))}
+		d, err = newDRBG(&Config{Entropy: entropy, Nonce: testNonce(), ReseedTimeInterval: time.Nanosecond})
+		assert.Nil(t, err)
+		time.Sleep(time.Millisecond)
+		assert.Nil(t, d.Generate(out, nil))
+		assert.Equal(t, 2, entropy.reads, name)
+	}
+}
+
+func TestContinuousHealthTest(t *testing.T) {
+	for name, newDRBG := range constructors {
+		// a stuck entropy source repeats its output
+		stuck := bytes.Repeat(entropyInputs(1), 2)
+		d, err := newDRBG(&Config{Entropy: bytes.NewReader(stuck), Nonce: testNonce()})
+		assert.Nil(t, err, name)
+		assert.Equal(t, ErrHealthTest, d.Reseed(nil), name)
+		assert.Equal(t, ErrHealthTest, d.Generate(make([]byte, 16), nil), name)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	d, err := NewCTRDRBG(nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, d.Generate(make([]byte, maxBytesPerRequest+1), nil))
+	assert.NotNil(t, d.Generate(make([]byte, 16), make([]byte, maxInputLength+1)))
+
+	// Read splits long requests
+	out := make([]byte, 3*maxBytesPerRequest+5)
+	n, err := d.Read(out)
+	assert.Nil(t, err)
+	assert.Equal(t, len(out), n)
+	assert.NotEqual(t, make([]byte, 32), out[len(out)-32:])
+}
+
+func TestSelfTest(t *testing.T) {
+	assert.Nil(t, selfTest())
+}
+
+func TestSeededKeyGeneration(t *testing.T) {
+	newKey := func() *gm.SM2PrivateKey {
+		d, err := NewSeededHashDRBG([]byte("test run 42"), nil)
+		assert.Nil(t, err)
+		key, err := gm.GenerateSM2KeyWithReader(d)
+		assert.Nil(t, err)
+		return key
+	}
+	key := newKey()
+	assert.Equal(t, key.K, newKey().K)
+
+	d, _ := NewHashDRBG(nil)
+	digest := make([]byte, 32)
+	sig, err := key.Sign(nil, digest, d)
+	assert.Nil(t, err)
+	ok, err := key.PublicKey.Verify(nil, sig, digest)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,83 @@
+package drbg
+
+import (
+	"encoding/binary"
+	gm "github.com/meshplus/crypto-gm"
+)
+
+const (
+	hashOutLen  = 32 // SM3 output length
+	hashSeedLen = 55 // seedlen of SP 800-90A table 2 for 256-bit hashes, 440 bits
+)
+
+// hashDRBG is Hash_DRBG of SP 800-90A section 10.1.1 with SM3.
+type hashDRBG struct {
+	v, c [hashSeedLen]byte
+}
+
+func (h *hashDRBG) instantiate(entropy, nonce, personalization []byte) {
+	hashDF(h.v[:], entropy, nonce, personalization)
+	hashDF(h.c[:], []byte{0x00}, h.v[:])
+}
+
+func (h *hashDRBG) reseed(entropy, additional []byte) {
+	var seed [hashSeedLen]byte
+	hashDF(seed[:], []byte{0x01}, h.v[:], entropy, additional)
+	h.v = seed
+	hashDF(h.c[:], []byte{0x00}, h.v[:])
+}
+
+func (h *hashDRBG) generate(out, additional []byte, reseedCounter uint64) {
+	if len(additional) > 0 {
+		addMod(h.v[:], sm3Sum([]byte{0x02}, h.v[:], additional))
+	}
+
+	// Hashgen
+	data := h.v
+	for len(out) > 0 {
+		n := copy(out, sm3Sum(data[:]))
+		out = out[n:]
+		addMod(data[:], []byte{1})
+	}
+
+	hash := sm3Sum([]byte{0x03}, h.v[:])
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], reseedCounter)
+	addMod(h.v[:], hash)
+	addMod(h.v[:], h.c[:])
+	addMod(h.v[:], counter[:])
+}
+
+// hashDF is Hash_df of SP 800-90A section 10.3.1, it fills out with the
+// derivation of the concatenated inputs.
+func hashDF(out []byte, inputs ...[]byte) {
+	var prefix [5]byte
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(out)*8))
+	for counter := byte(1); len(out) > 0; counter++ {
+		prefix[0] = counter
+		n := copy(out, sm3Sum(append([][]byte{prefix[:]}, inputs...)...))
+		out = out[n:]
+	}
+}
+
+func sm3Sum(inputs ...[]byte) []byte {
+	h, _ := gm.NewSM3Hasher().BatchHash(inputs)
+	return h
+}
+
+// addMod sets dst to dst + src modulo 2^(8*len(dst)), both big endian and
+// src not longer than dst.
+func addMod(dst, src []byte) {
+	carry := 0
+	for i, j := len(dst)-1, len(src)-1; i >= 0; i, j = i-1, j-1 {
+		sum := int(dst[i]) + carry
+		if j >= 0 {
+			sum += int(src[j])
+		}
+		dst[i] = byte(sum)
+		carry = sum >> 8
+		if j < 0 && carry == 0 {
+			break
+		}
+	}
+}
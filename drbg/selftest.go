@@ -0,0 +1,60 @@
+package drbg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sync"
+)
+
+var (
+	selfTestOnce sync.Once
+	selfTestErr  error
+)
+
+// selfTest runs the known-answer health tests of both mechanisms once, on
+// the first instantiation: instantiate, generate with and without additional
+// input, reseed and generate again.
+func selfTest() error {
+	selfTestOnce.Do(func() {
+		tests := []struct {
+			m    mechanism
+			want string
+		}{
+			{new(hashDRBG), "47e8cbaa8f551b71a89ec72bbed3e3b638d0815b2582e56bc124a2664103d25c3a31fa5ceb34d3b435fc38df4eed2f81b583a81957d8c7976ef3d87c851a06b2"},
+			{new(ctrDRBG), "fa7ea333dcb49eb1eb7b6ce769157e546543fc8643d652fb293c0d164d89b747d36cb7e6b517ef21b96fd56fa3d495404da0e8ea7780d9fd6e2c46d571f2aa32"},
+		}
+		for _, test := range tests {
+			if !bytes.Equal(knownAnswer(test.m), mustHex(test.want)) {
+				selfTestErr = ErrHealthTest
+				return
+			}
+		}
+	})
+	return selfTestErr
+}
+
+func knownAnswer(m mechanism) []byte {
+	entropy, reseedEntropy, nonce := make([]byte, 32), make([]byte, 32), make([]byte, 16)
+	for i := range entropy {
+		entropy[i], reseedEntropy[i] = byte(i), byte(0x40+i)
+	}
+	for i := range nonce {
+		nonce[i] = byte(0x20 + i)
+	}
+
+	out := make([]byte, 64)
+	m.instantiate(entropy, nonce, []byte("personalization"))
+	m.generate(out, nil, 1)
+	m.generate(out, []byte("additional input"), 2)
+	m.reseed(reseedEntropy, []byte("reseed"))
+	m.generate(out, nil, 1)
+	return out
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,60 @@
+package hdkey
+
+import (
+	"errors"
+	"math/big"
+)
+
+const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	radix   = big.NewInt(58)
+	indexes [256]int8
+)
+
+func init() {
+	for i := range indexes {
+		indexes[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		indexes[alphabet[i]] = int8(i)
+	}
+}
+
+// base58Encode encodes b in Base58, every leading zero byte becomes a '1'.
+func base58Encode(b []byte) string {
+	x := new(big.Int).SetBytes(b)
+	var out []byte
+	mod := new(big.Int)
+	for x.Sign() > 0 {
+		x.DivMod(x, radix, mod)
+		out = append(out, alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+func base58Decode(s string) ([]byte, error) {
+	x := new(big.Int)
+	zeros := 0
+	for i := 0; i < len(s); i++ {
+		d := indexes[s[i]]
+		if d < 0 {
+			return nil, errors.New("hdkey: invalid base58 character")
+		}
+		if d == 0 && i == zeros {
+			zeros++
+		}
+		x.Mul(x, radix)
+		x.Add(x, big.NewInt(int64(d)))
+	}
+	return append(make([]byte, zeros), x.Bytes()...), nil
+}
@@ -0,0 +1,296 @@
+//Package hdkey implements BIP32-style hierarchical deterministic keys on the
+// SM2 curve, with HMAC-SM3 in place of HMAC-SHA512 and SM3 in place of the
+// BIP32 hashes.
+//
+// An HMAC-SM3 output of 32 bytes is too short to hold both a key and a chain
+// code, so each derivation step computes IL = HMAC-SM3(c, 0x00 || data) and
+// IR = HMAC-SM3(c, 0x01 || data), for the key and the chain code. The master
+// key uses the key "SM2 seed". As in SLIP-0010, an IL which does not give a
+// valid key is not skipped: the step is repeated with IR as the data. A key
+// of n-1 is not valid either, SM2 cannot sign with it.
+//
+// Extended keys serialize to the 78-byte BIP32 layout, with a fingerprint
+// made of the first 4 bytes of SM3 of the compressed public key, and to
+// Base58 with a 4-byte double SM3 checksum.
+package hdkey
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"hash"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+//HardenedOffset is the first hardened child index.
+const HardenedOffset uint32 = 0x80000000
+
+// Version bytes of the serialized extended keys, distinct from the BIP32
+// versions so that SM2 keys are never taken for secp256k1 keys.
+var (
+	PrivateVersion = [4]byte{0x04, 0x88, 0xad, 0x53}
+	PublicVersion  = [4]byte{0x04, 0x88, 0xb2, 0x53}
+)
+
+var (
+	masterKey = []byte("SM2 seed")
+
+	//ErrHardenedFromPublic is returned when deriving a hardened child of an
+	// extended public key.
+	ErrHardenedFromPublic = errors.New("hdkey: cannot derive a hardened child from a public key")
+	//ErrInvalidKey is returned when parsing a malformed extended key.
+	ErrInvalidKey = errors.New("hdkey: invalid extended key")
+)
+
+//ExtendedKey is an SM2 key with its chain code and its position in the
+// tree. It holds either a private key or only a public key.
+type ExtendedKey struct {
+	Depth             uint8
+	ParentFingerprint [4]byte
+	ChildNumber       uint32
+	ChainCode         [32]byte
+
+	private *big.Int
+	public  ec.Point
+}
+
+func newHMAC(key []byte) hash.Hash {
+	return hmac.New(func() hash.Hash { return gm.GetSM3Hasher() }, key)
+}
+
+// derive returns IL and IR for the chain code and data, retrying while IL is
+// not valid for the parent key.
+func derive(chain, data []byte, valid func(il *big.Int) bool) (*big.Int, []byte) {
+	for {
+		mac := newHMAC(chain)
+		mac.Write([]byte{1})
+		mac.Write(data)
+		ir := mac.Sum(nil)
+
+		mac = newHMAC(chain)
+		mac.Write([]byte{0})
+		mac.Write(data)
+		il := new(big.Int).SetBytes(mac.Sum(nil))
+		if il.Sign() > 0 && il.Cmp(ec.N) < 0 && valid(il) {
+			return il, ir
+		}
+		data = ir
+	}
+}
+
+//NewMaster returns the master key of a seed of 16 to 64 bytes.
+func NewMaster(seed []byte) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, errors.New("hdkey: seed must be 16 to 64 bytes")
+	}
+	il, ir := derive(masterKey, seed, ec.ValidPrivate)
+	k := &ExtendedKey{private: il, public: ec.BaseMul(il)}
+	copy(k.ChainCode[:], ir)
+	return k, nil
+}
+
+//IsPrivate reports whether k holds a private key.
+func (k *ExtendedKey) IsPrivate() bool {
+	return k.private != nil
+}
+
+//PrivateKey returns the private key of k.
+func (k *ExtendedKey) PrivateKey() (*gm.SM2PrivateKey, error) {
+	if k.private == nil {
+		return nil, errors.New("hdkey: not a private extended key")
+	}
+	key := new(gm.SM2PrivateKey)
+	k.private.FillBytes(key.K[:])
+	key.SetPublicKey(k.PublicKey())
+	return key, nil
+}
+
+//PublicKey returns the public key of k.
+func (k *ExtendedKey) PublicKey() *gm.SM2PublicKey {
+	return k.public.PublicKey()
+}
+
+//Fingerprint returns the first 4 bytes of SM3 of the compressed public key.
+func (k *ExtendedKey) Fingerprint() [4]byte {
+	var f [4]byte
+	h := gm.GetSM3Hasher()
+	h.Write(k.public.MarshalCompressed())
+	copy(f[:], h.Sum(nil))
+	return f
+}
+
+//Neuter returns the extended public key of k.
+func (k *ExtendedKey) Neuter() *ExtendedKey {
+	n := *k
+	n.private = nil
+	return &n
+}
+
+//Child returns the child i of k, i >= HardenedOffset is a hardened child.
+func (k *ExtendedKey) Child(i uint32) (*ExtendedKey, error) {
+	var data []byte
+	if i >= HardenedOffset {
+		if k.private == nil {
+			return nil, ErrHardenedFromPublic
+		}
+		data = append([]byte{0}, k.private.FillBytes(make([]byte, 32))...)
+	} else {
+		data = k.public.MarshalCompressed()
+	}
+	data = append(data, byte(i>>24), byte(i>>16), byte(i>>8), byte(i))
+
+	if k.Depth == 255 {
+		return nil, errors.New("hdkey: maximum depth reached")
+	}
+	child := &ExtendedKey{
+		Depth:             k.Depth + 1,
+		ParentFingerprint: k.Fingerprint(),
+		ChildNumber:       i,
+	}
+	var ir []byte
+	if k.private != nil {
+		var key *big.Int
+		_, ir = derive(k.ChainCode[:], data, func(il *big.Int) bool {
+			key = new(big.Int).Add(il, k.private)
+			key.Mod(key, ec.N)
+			return ec.ValidPrivate(key)
+		})
+		child.private, child.public = key, ec.BaseMul(key)
+	} else {
+		var pub ec.Point
+		_, ir = derive(k.ChainCode[:], data, func(il *big.Int) bool {
+			pub = ec.Add(ec.BaseMul(il), k.public)
+			return ec.ValidPublic(pub)
+		})
+		child.public = pub
+	}
+	copy(child.ChainCode[:], ir)
+	return child, nil
+}
+
+//ParsePath parses a derivation path such as m/44'/0'/0'/0/1; a leading m or
+// M is optional and h or H also marks a hardened index.
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] == "m" || parts[0] == "M" {
+		parts = parts[1:]
+	}
+	indexes := make([]uint32, 0, len(parts))
+	for _, p := range parts {
+		var offset uint32
+		if n := len(p); n > 0 && strings.ContainsAny(p[n-1:], "'hH") {
+			offset, p = HardenedOffset, p[:n-1]
+		}
+		i, err := strconv.ParseUint(p, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("hdkey: invalid path component %q", p)
+		}
+		indexes = append(indexes, uint32(i)+offset)
+	}
+	return indexes, nil
+}
+
+//Derive returns the descendant of k at path, relative to k.
+func (k *ExtendedKey) Derive(path string) (*ExtendedKey, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range indexes {
+		if k, err = k.Child(i); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+//MarshalBinary returns the 78-byte serialization of k.
+func (k *ExtendedKey) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, 78)
+	if k.private != nil {
+		b = append(b, PrivateVersion[:]...)
+	} else {
+		b = append(b, PublicVersion[:]...)
+	}
+	b = append(b, k.Depth)
+	b = append(b, k.ParentFingerprint[:]...)
+	b = append(b, byte(k.ChildNumber>>24), byte(k.ChildNumber>>16), byte(k.ChildNumber>>8), byte(k.ChildNumber))
+	b = append(b, k.ChainCode[:]...)
+	if k.private != nil {
+		b = append(b, 0)
+		b = append(b, k.private.FillBytes(make([]byte, 32))...)
+	} else {
+		b = append(b, k.public.MarshalCompressed()...)
+	}
+	return b, nil
+}
+
+//UnmarshalBinary parses the serialization returned by MarshalBinary.
+func (k *ExtendedKey) UnmarshalBinary(b []byte) error {
+	if len(b) != 78 {
+		return ErrInvalidKey
+	}
+	var v ExtendedKey
+	v.Depth = b[4]
+	copy(v.ParentFingerprint[:], b[5:9])
+	v.ChildNumber = binary.BigEndian.Uint32(b[9:13])
+	copy(v.ChainCode[:], b[13:45])
+	if v.Depth == 0 && (v.ChildNumber != 0 || v.ParentFingerprint != [4]byte{}) {
+		return ErrInvalidKey
+	}
+	switch {
+	case bytes.Equal(b[:4], PrivateVersion[:]) && b[45] == 0:
+		d := new(big.Int).SetBytes(b[46:])
+		if !ec.ValidPrivate(d) {
+			return ErrInvalidKey
+		}
+		v.private, v.public = d, ec.BaseMul(d)
+	case bytes.Equal(b[:4], PublicVersion[:]):
+		p, err := ec.UnmarshalCompressed(b[45:])
+		if err != nil || !ec.ValidPublic(p) {
+			return ErrInvalidKey
+		}
+		v.public = p
+	default:
+		return ErrInvalidKey
+	}
+	*k = v
+	return nil
+}
+
+func checksum(b []byte) []byte {
+	h := gm.GetSM3Hasher()
+	h.Write(b)
+	first := h.Sum(nil)
+	h.Reset()
+	h.Write(first)
+	return h.Sum(nil)[:4]
+}
+
+//String returns the Base58 encoding of k with a checksum.
+func (k *ExtendedKey) String() string {
+	b, _ := k.MarshalBinary()
+	return base58Encode(append(b, checksum(b)...))
+}
+
+//Parse parses an extended key encoded by String.
+func Parse(s string) (*ExtendedKey, error) {
+	b, err := base58Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 82 || !bytes.Equal(checksum(b[:78]), b[78:]) {
+		return nil, ErrInvalidKey
+	}
+	k := new(ExtendedKey)
+	if err := k.UnmarshalBinary(b[:78]); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
@@ -0,0 +1,203 @@
+package hdkey
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+type step struct {
+	path, priv, xprv, xpub string
+}
+
+// The vectors are regression values produced by this package, there are no
+// published vectors for this derivation; the seeds are those of the BIP32
+// test vectors 1 and 2.
+var vectors = []struct {
+	seed  string
+	steps []step
+}{
+	{
+		"000102030405060708090a0b0c0d0e0f",
+		[]step{
+			{
+				"m",
+				"f4798f0e1bb7aa295eea4966f8cf94e027a9b63e1c185053cf8f8481070d9d2e",
+				"xprZPcJfJb2Vzjc8aJAgkwEPJC6xH6fYPrMyV33kacJVuPALzTH3jDnMTaaTwU11xWbtJDZMBEZSSfzz19aXZgvyoSymGSNxzsQ4hmu4m6FCVSd",
+				"xpuigGBgBLkjHYLQsD7HcJfbQyJrE39xZbSn6Dj9R3xBvyK7yYfNqmFdGxFAB6iAiyfrncXW3YXJJFnLT5vD95PvKa6TVpd8WHmL8QPZ6Ynobjd",
+			},
+			{
+				"m/0'",
+				"c80784bbced08b633fa31ac9c41848f66e50ceba2726c5c86edbc54e609ac729",
+				"xprZPg47kf4RnZcgd1fxuKNRAEajRPnf8FswySbj71TuN5XsZSTgHYwHaJhaLfw5uGGfZJ1suFhZvtXW86bo6ATWZ9RaUXh5LYSAVP9dzLGM24X",
+				"xpuigKw8dQnf5NLxuvcZkgodH1ndNLH5HzxkadH7wT7bPfgeYXr1Q6QZPgNGaL19KvJZPamD8HgYkyUHarSTbjyCEmrsnBwTT6PrMCXeM1adsWR",
+			},
+			{
+				"m/0'/1",
+				"0661c2ca747f9c9199cc480da4759d68d3d66deae75c182676e6c17c22e5b1f4",
+				"xprZPhQry73Jmht9FsdYjqEzFjQNBxGegtuFvtwzmGxoELtsQkrV4kNpWgUps5eAVkWKrTzRWKeS5Agq3ocyUeoEU3PzeYMA4UgZjtq3LLk94iK",
+				"xpuigMHsqrmY4WcRYna9bCgCNWcG8tm4rdz4Y5dPbicVFw3ePrEpBHr6L49X6oPvLm6i4jQBLmeFnCCojPDPYVc4eLDVF8R5hQJnewwVarMkqb3",
+			},
+			{
+				"m/0'/1/2'",
+				"511a40edce1809ddff88a66ed5f355ab4788a3f339925f9e2c138af9c9f1d0d8",
+				"xprZPishS4sQtHtYeDPgRpe5ZzPFB4zZzfpXsQKu8jJA24wmCT8R4JcP12w31LMmoerPBTZyCfw4WHSqQ2wApw4KDjRmj3ikheFk265L6EwWu9C",
+				"xpuigNkiJpbeB6cpw8LHHC5Hgmb981UzAQuLUb1HyAwr3f6YBYWkAr5epQbjF3eiDWQVDWjRr4JE9si1d8kgpjfkWrEqGEJ7CHDpKUNp6AyCJws",
+			},
+			{
+				"m/0'/1/2'/2",
+				"141f18308e5c3bbbfeb59bc4d47c16e82feabe52818d67138fa4284b45f3a0e2",
+				"xprZPm7aJyhkzU3fjrmajDky2bobcNUnKjSwrhK3oELoembtgstsR4HjQugCccYwpQ45DMYDhvuopdAp8H8rm3xfExckJutAjWh9o1CGSb88LVH",
+				"xpuigQzbBjRzHGmx2miBabCB9P1VZJyCVUXkTszSdfzVgMkffyHCXbm1EHLtrKWX4G5btuNKmVTnhm3JstguQS3Xaeem5xfbAW6nTHfBZwGxuat",
+			},
+			{
+				"m/0'/1/2'/2/1000000000",
+				"345ba1dbd90952b7e343b9ee5d984adbc3cb5bc45245016af938dd02540816be",
+				"xprZPoEfrLKVptk4RgPjmCyqEjZFLuG8goLYbAH2YJ481rsPnr8Ucr9cDLDPBkkgddXdzmAf32HTfJQeNRp8FocY9SwquyJwBumB4jUs1w9vZv8",
+				"xpuigT7gj63j7hULibLLcaR3MWm9HqkYrYRMCLxRNjhp3T2AmwWojPct2ht5RRtSonyhXsfEFngkweF8R1oBDgH57GKYxvaimdP3cQePE4s6xJG",
+			},
+		},
+	},
+	{
+		"fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542",
+		[]step{
+			{
+				"m",
+				"e8ab8de4cdb2dda179198dd51c1bc720479ab49452fe7f5d86eb1c467018ad88",
+				"xprZPcJfJb2Vzjc8aPqBv2kyy4GLqJ16GmKhnQVYFupaBYF8ZxJHqDDWFQddsiMYDPBqyuLEjnVa4woJGKAXA7KpVka9sMXpFnGUTwoi2kRXfk6",
+				"xpuigGBgBLkjHYLQsJmnmQCC5qUEnEVWSWQWPbAw6MUGD8PuZ3gcwkgn4nJL7N3XS4BfqTcAj21asjbtsWzkTPNo9WVM248sNeDatSNBxCasBrS",
+			},
+			{
+				"m/44'",
+				"1200d03f9abbf4c59f24d62173c33ccd44c3f19cd15952de3610d2fa3f9db889",
+				"xprZPesfBduJ5wyDedQbZ1NwF37g7GTTbGHWgzPXv3LDFsM6TsunsgEuckYb8Cu3Az4iKkUrZxn8mMAEqGKnKS7v2qwA9gLwyFfqbrwzfSxdQhC",
+				"xpuigJkg4PdXNkhVwYMCQNp9MpKa4Cwsm1NKJB4vkUyuHTVsSyJ7zDiBS8DHMteSwy9dc8mJq1hdK2Bjf1kgAXiEbqKf5WuyP6eKzjEMBky4LF7",
+			},
+			{
+				"m/44'/0'",
+				"0e5002e3780cc9bfe3ba2dd10f23cf6f777a944c847b0fa8cf5203f0843ae915",
+				"xprZPhZqzkDRqdNeYr47YAa41ofDxBjhAgKpTASKUfRHps5pAERLPdeKr1sXZdGQ7PxTzeX71vkG1UBJWZhYjxL2ZAK2LDYiVCGbMJTa12PCe2k",
+				"xpuigMSrsVwf8S6vqkziPY1G8as7u8E7LRQd4M7iK74yrTEb9KofWB7bfPYDoLPc73xRfMUiK3ew8RKHtWd865T3y1HigyhiY9u4eJC85ncAEKw",
+			},
+			{
+				"m/44'/0'/0'",
+				"92dcf8c0a0461c6c41f1d7e06253b5bf5bc1b092ea7e1160a533356ec10fd96d",
+				"xprZPj3CFg4skN5po5vcVKLxvfHEhT9qRtzDEfqcGgk9TAPTFiQT8YTTt9td4AH9pkZyJ2aUfoJ3iKwiWZQWNsq9j4N7g7cBhu7ahNYmX7XuVaj",
+				"xpuigNvD8Ro73Ap75zsDLgnB3SV8ePeFbe51qrX178PqUkYEEonnF5vjhXZKHrUkUyrdsNQ6nQdmHUq22ovMyyK1jkLJ1Q41KYr6t11jeT5FGBv",
+			},
+			{
+				"m/44'/0'/0'/0",
+				"79c1371844180cc14c943622493cf3f7014fb8ea896cb7670ac0f64af63dff6c",
+				"xprZPmS2wafBdwqvSdGbP6AgA9Ta46io9KS96HEqWs3QpnNfy9Ym9TM6yb7xFAKVSF9mGry1XWgSuKS794RwLZzCMUv73iKSSk2rqjiWUAPykPL",
+				"xpuigRK3pLPQvkaCjYDCETbtGvfU13DDK4WwhTvEMJh6rNXSxEw6FzpNnxneUpYEqiNDYZEmRQUqXU9RCZP6ANygPCREsxiqDfJUTRVwGun5tgi",
+			},
+			{
+				"m/44'/0'/0'/0/1",
+				"9c8bd1048c240d341de9af80ff6ee63477666c66cac1009d2d3e4fa6e3dac3e8",
+				"xprZPnUEbWKjC2DayuRFdJCNnCLYmyzZg6UXe5nSY6LoneTKjififw9uP1brQyTbNmHg84fhgyUX14iM4zEGbfixy2JeMT6WVKEJ9nZfibRgjjn",
+				"xpuigSMFUG3xUpwsGpMrUfdatyYSivUyqqZLFGTqNXzVpEc6ip43nUdBCPGYefrsaiyZZ3RJBEexTMzZC7tCPsGWfC65ir3WeX3JDVBdmx3y3p8",
+			},
+		},
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		seed, _ := hex.DecodeString(v.seed)
+		master, err := NewMaster(seed)
+		assert.Nil(t, err)
+		for _, s := range v.steps {
+			k, err := master.Derive(s.path)
+			assert.Nil(t, err)
+			key, err := k.PrivateKey()
+			assert.Nil(t, err)
+			assert.Equal(t, s.priv, hex.EncodeToString(key.K[:]), s.path)
+			assert.Equal(t, s.xprv, k.String(), s.path)
+			assert.Equal(t, s.xpub, k.Neuter().String(), s.path)
+
+			parsed, err := Parse(s.xprv)
+			assert.Nil(t, err)
+			assert.Equal(t, k, parsed)
+			parsed, err = Parse(s.xpub)
+			assert.Nil(t, err)
+			assert.Equal(t, k.Neuter(), parsed)
+			assert.Equal(t, k.PublicKey(), parsed.PublicKey())
+		}
+	}
+}
+
+func TestPublicDerivation(t *testing.T) {
+	master, _ := NewMaster([]byte("0123456789abcdef0123456789abcdef"))
+	account, err := master.Derive("m/44'/1'/0'")
+	assert.Nil(t, err)
+	xpub := account.Neuter()
+	for i := uint32(0); i < 5; i++ {
+		priv, err := account.Derive("0/" + string(rune('0'+i)))
+		assert.Nil(t, err)
+		pub, err := xpub.Derive("0/" + string(rune('0'+i)))
+		assert.Nil(t, err)
+		assert.False(t, pub.IsPrivate())
+		assert.Equal(t, priv.Neuter(), pub)
+	}
+	_, err = xpub.Child(HardenedOffset)
+	assert.Equal(t, ErrHardenedFromPublic, err)
+	_, err = xpub.PrivateKey()
+	assert.NotNil(t, err)
+}
+
+func TestDerivedKeySigns(t *testing.T) {
+	master, _ := NewMaster([]byte("0123456789abcdef"))
+	k, _ := master.Derive("m/44'/0'/0'/0/7")
+	key, err := k.PrivateKey()
+	assert.Nil(t, err)
+	digest := gm.HashBeforeSM2(&key.PublicKey, []byte("msg"))
+	sig, err := key.Sign(nil, digest, rand.Reader)
+	assert.Nil(t, err)
+	ok, err := k.Neuter().PublicKey().Verify(nil, sig, digest)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestParsePath(t *testing.T) {
+	p, err := ParsePath("m/44'/0h/0H/0/1")
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{HardenedOffset + 44, HardenedOffset, HardenedOffset, 0, 1}, p)
+	p, err = ParsePath("m")
+	assert.Nil(t, err)
+	assert.Empty(t, p)
+	for _, bad := range []string{"", "m/", "m/x", "m/-1", "m/2147483648", "m/1''"} {
+		_, err = ParsePath(bad)
+		assert.NotNil(t, err, bad)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	s := vectors[0].steps[1].xprv
+	_, err := Parse(s[:len(s)-1] + "1")
+	assert.NotNil(t, err)
+	_, err = Parse(s[1:])
+	assert.NotNil(t, err)
+	_, err = Parse("0" + s[1:])
+	assert.NotNil(t, err)
+	_, err = NewMaster(make([]byte, 15))
+	assert.NotNil(t, err)
+}
+
+func TestRejectNMinus1(t *testing.T) {
+	nMinus1 := new(big.Int).Sub(ec.N, big.NewInt(1))
+	k, err := NewMaster(make([]byte, 16))
+	assert.Nil(t, err)
+	b, err := k.MarshalBinary()
+	assert.Nil(t, err)
+	nMinus1.FillBytes(b[46:])
+	assert.Equal(t, ErrInvalidKey, new(ExtendedKey).UnmarshalBinary(b))
+
+	b, err = k.Neuter().MarshalBinary()
+	assert.Nil(t, err)
+	copy(b[45:], ec.BaseMul(nMinus1).MarshalCompressed())
+	assert.Equal(t, ErrInvalidKey, new(ExtendedKey).UnmarshalBinary(b))
+}
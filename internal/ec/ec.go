@@ -0,0 +1,245 @@
+//Package ec provides the group arithmetic of the SM2 curve used by the
+// Schnorr-style signature schemes.
+package ec
+
+import (
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/sm2"
+	"io"
+	"math/big"
+)
+
+var (
+	curve = sm2.Sm2()
+	//N is the order of the group.
+	N   = curve.Params().N
+	one = big.NewInt(1)
+)
+
+//Point is a point of the SM2 curve, the zero value is the point at infinity.
+type Point struct {
+	x, y *big.Int
+}
+
+//Base returns the generator G.
+func Base() Point {
+	return Point{curve.Params().Gx, curve.Params().Gy}
+}
+
+//IsInfinity reports whether p is the point at infinity.
+func (p Point) IsInfinity() bool {
+	return p.x == nil || p.x.Sign() == 0 && p.y.Sign() == 0
+}
+
+//Equal reports whether p and q are the same point.
+func (p Point) Equal(q Point) bool {
+	if p.IsInfinity() || q.IsInfinity() {
+		return p.IsInfinity() == q.IsInfinity()
+	}
+	return p.x.Cmp(q.x) == 0 && p.y.Cmp(q.y) == 0
+}
+
+//X returns the x coordinate of p, which must not be the point at infinity.
+func (p Point) X() *big.Int {
+	return p.x
+}
+
+//Add returns p + q. The Add of the curve handles neither doubling nor the
+// point at infinity.
+func Add(p, q Point) Point {
+	switch {
+	case p.IsInfinity():
+		return q
+	case q.IsInfinity():
+		return p
+	case p.x.Cmp(q.x) == 0:
+		if p.y.Cmp(q.y) == 0 {
+			x, y := curve.Double(p.x, p.y)
+			return Point{x, y}
+		}
+		return Point{}
+	}
+	x, y := curve.Add(p.x, p.y, q.x, q.y)
+	return Point{x, y}
+}
+
+//Neg returns -p.
+func (p Point) Neg() Point {
+	if p.IsInfinity() {
+		return Point{}
+	}
+	return Point{p.x, new(big.Int).Sub(curve.Params().P, p.y)}
+}
+
+//Mul returns k * p.
+func Mul(p Point, k *big.Int) Point {
+	k = new(big.Int).Mod(k, N)
+	if p.IsInfinity() || k.Sign() == 0 {
+		return Point{}
+	}
+	x, y := curve.ScalarMult(p.x, p.y, k.Bytes())
+	return Point{x, y}
+}
+
+//BaseMul returns k * G.
+func BaseMul(k *big.Int) Point {
+	k = new(big.Int).Mod(k, N)
+	if k.Sign() == 0 {
+		return Point{}
+	}
+	x, y := curve.ScalarBaseMult(k.Bytes())
+	return Point{x, y}
+}
+
+//Marshal returns the 65-byte uncompressed encoding of p, a single zero byte
+// for the point at infinity.
+func (p Point) Marshal() []byte {
+	if p.IsInfinity() {
+		return []byte{0}
+	}
+	b := make([]byte, 65)
+	b[0] = 4
+	p.x.FillBytes(b[1:33])
+	p.y.FillBytes(b[33:])
+	return b
+}
+
+//ErrInvalidPoint is returned for an encoding which is not a point of the
+// curve.
+var ErrInvalidPoint = errors.New("ec: invalid curve point")
+
+//Unmarshal decodes a point encoded by Marshal, the point at infinity is
+// rejected.
+func Unmarshal(b []byte) (Point, error) {
+	if len(b) != 65 || b[0] != 4 {
+		return Point{}, ErrInvalidPoint
+	}
+	p := Point{new(big.Int).SetBytes(b[1:33]), new(big.Int).SetBytes(b[33:])}
+	if !curve.IsOnCurve(p.x, p.y) {
+		return Point{}, ErrInvalidPoint
+	}
+	return p, nil
+}
+
+//MarshalCompressed returns the 33-byte compressed encoding of p, which must
+// not be the point at infinity.
+func (p Point) MarshalCompressed() []byte {
+	b := make([]byte, 33)
+	b[0] = byte(2 | p.y.Bit(0))
+	p.x.FillBytes(b[1:])
+	return b
+}
+
+//UnmarshalCompressed decodes a point encoded by MarshalCompressed.
+func UnmarshalCompressed(b []byte) (Point, error) {
+	if len(b) != 33 || b[0] != 2 && b[0] != 3 {
+		return Point{}, ErrInvalidPoint
+	}
+	params := curve.Params()
+	x := new(big.Int).SetBytes(b[1:])
+	if x.Cmp(params.P) >= 0 {
+		return Point{}, ErrInvalidPoint
+	}
+	// y^2 = x^3 - 3x + b
+	y2 := new(big.Int).Exp(x, big.NewInt(3), params.P)
+	y2.Sub(y2, new(big.Int).Mul(x, big.NewInt(3)))
+	y2.Add(y2, params.B)
+	y2.Mod(y2, params.P)
+	y := new(big.Int).ModSqrt(y2, params.P)
+	if y == nil {
+		return Point{}, ErrInvalidPoint
+	}
+	if y.Bit(0) != uint(b[0]&1) {
+		y.Sub(params.P, y)
+	}
+	return Point{x, y}, nil
+}
+
+//FromPublicKey returns the point of pub.
+func FromPublicKey(pub *gm.SM2PublicKey) (Point, error) {
+	p := Point{new(big.Int).SetBytes(pub.X[:]), new(big.Int).SetBytes(pub.Y[:])}
+	if !curve.IsOnCurve(p.x, p.y) {
+		return Point{}, ErrInvalidPoint
+	}
+	return p, nil
+}
+
+//PublicKey returns p as a public key.
+func (p Point) PublicKey() *gm.SM2PublicKey {
+	pub := &gm.SM2PublicKey{Curve: curve}
+	p.x.FillBytes(pub.X[:])
+	p.y.FillBytes(pub.Y[:])
+	return pub
+}
+
+// nMinus1 is the private key n-1 of the public key -G.
+var nMinus1 = new(big.Int).Sub(N, one)
+
+//ValidPrivate reports whether d is an SM2 private key, in [1, n-2]: SM2
+// cannot sign with n-1, for which 1+d is not invertible.
+func ValidPrivate(d *big.Int) bool {
+	return d.Sign() > 0 && d.Cmp(nMinus1) < 0
+}
+
+//ValidPublic reports whether p is the public key of a valid private key:
+// neither the point at infinity nor -G.
+func ValidPublic(p Point) bool {
+	return !p.IsInfinity() && !p.Equal(Base().Neg())
+}
+
+//PrivateScalar returns the private key of key as a scalar, it must be valid
+// for ValidPrivate.
+func PrivateScalar(key *gm.SM2PrivateKey) (*big.Int, error) {
+	d := new(big.Int).SetBytes(key.K[:])
+	if !ValidPrivate(d) {
+		return nil, errors.New("ec: invalid private key")
+	}
+	return d, nil
+}
+
+//RandScalar returns a uniform scalar in [1, n-1].
+func RandScalar(rand io.Reader) (*big.Int, error) {
+	b := make([]byte, 40)
+	if _, err := io.ReadFull(rand, b); err != nil {
+		return nil, errors.New("ec: reading randomness: " + err.Error())
+	}
+	k := new(big.Int).SetBytes(b)
+	k.Mod(k, new(big.Int).Sub(N, one))
+	return k.Add(k, one), nil
+}
+
+//HashToScalar hashes the length-prefixed parts with SM3 under a domain tag
+// and reduces the hash mod n.
+func HashToScalar(tag string, parts ...[]byte) *big.Int {
+	h := gm.GetSM3Hasher()
+	writePrefixed(h, []byte(tag))
+	for _, part := range parts {
+		writePrefixed(h, part)
+	}
+	k := new(big.Int).SetBytes(h.Sum(nil))
+	return k.Mod(k, N)
+}
+
+func writePrefixed(w io.Writer, b []byte) {
+	n := len(b)
+	_, _ = w.Write([]byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)})
+	_, _ = w.Write(b)
+}
+
+//ScalarBytes returns k as 32 big-endian bytes.
+func ScalarBytes(k *big.Int) []byte {
+	return k.FillBytes(make([]byte, 32))
+}
+
+//ParseScalar decodes 32 big-endian bytes as a scalar in [0, n-1].
+func ParseScalar(b []byte) (*big.Int, error) {
+	if len(b) != 32 {
+		return nil, errors.New("ec: scalar must be 32 bytes")
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Cmp(N) >= 0 {
+		return nil, errors.New("ec: scalar out of range")
+	}
+	return k, nil
+}
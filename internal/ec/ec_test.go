@@ -0,0 +1,140 @@
+package ec
+
+import (
+	"bytes"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	g := Base()
+	assert.True(t, Add(Point{}, g).Equal(g))
+	assert.True(t, Add(g, Point{}).Equal(g))
+	assert.True(t, Add(Point{}, Point{}).IsInfinity())
+	assert.True(t, Add(g, g).Equal(BaseMul(big.NewInt(2))))
+	assert.True(t, Add(g, g.Neg()).IsInfinity())
+	assert.True(t, Add(BaseMul(big.NewInt(2)), g).Equal(BaseMul(big.NewInt(3))))
+	assert.True(t, BaseMul(N).IsInfinity())
+	assert.True(t, BaseMul(new(big.Int).Sub(N, one)).Equal(g.Neg()))
+}
+
+func TestMarshal(t *testing.T) {
+	p := BaseMul(big.NewInt(7))
+	q, err := Unmarshal(p.Marshal())
+	assert.Nil(t, err)
+	assert.True(t, p.Equal(q))
+
+	b := p.Marshal()
+	b[64] ^= 1
+	_, err = Unmarshal(b)
+	assert.Equal(t, ErrInvalidPoint, err)
+	_, err = Unmarshal(p.Marshal()[:64])
+	assert.Equal(t, ErrInvalidPoint, err)
+}
+
+func TestMarshalCompressed(t *testing.T) {
+	for k := int64(1); k <= 8; k++ {
+		for _, p := range []Point{BaseMul(big.NewInt(k)), BaseMul(big.NewInt(k)).Neg()} {
+			b := p.MarshalCompressed()
+			assert.Len(t, b, 33)
+			q, err := UnmarshalCompressed(b)
+			assert.Nil(t, err)
+			assert.True(t, p.Equal(q))
+		}
+	}
+
+	b := Base().MarshalCompressed()
+	for _, prefix := range []byte{0, 1, 4} {
+		c := append([]byte{prefix}, b[1:]...)
+		_, err := UnmarshalCompressed(c)
+		assert.Equal(t, ErrInvalidPoint, err)
+	}
+	_, err := UnmarshalCompressed(b[:32])
+	assert.Equal(t, ErrInvalidPoint, err)
+
+	// x = p is out of range
+	c := append([]byte{2}, curve.Params().P.Bytes()...)
+	_, err = UnmarshalCompressed(c)
+	assert.Equal(t, ErrInvalidPoint, err)
+
+	// an x with no y on the curve
+	var x int64
+	for x = 1; ; x++ {
+		c = append([]byte{2}, ScalarBytes(big.NewInt(x))...)
+		if _, err = UnmarshalCompressed(c); err != nil {
+			break
+		}
+	}
+	assert.Equal(t, ErrInvalidPoint, err)
+}
+
+func TestParseScalar(t *testing.T) {
+	k, err := ParseScalar(ScalarBytes(new(big.Int).Sub(N, one)))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, k.Cmp(new(big.Int).Sub(N, one)))
+	k, err = ParseScalar(make([]byte, 32))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, k.Sign())
+
+	_, err = ParseScalar(ScalarBytes(N))
+	assert.NotNil(t, err)
+	_, err = ParseScalar(bytes.Repeat([]byte{0xff}, 32))
+	assert.NotNil(t, err)
+	_, err = ParseScalar(make([]byte, 31))
+	assert.NotNil(t, err)
+	_, err = ParseScalar(make([]byte, 33))
+	assert.NotNil(t, err)
+}
+
+func TestHashToScalar(t *testing.T) {
+	a := HashToScalar("tag", []byte("a"), []byte("b"))
+	assert.Equal(t, 0, a.Cmp(HashToScalar("tag", []byte("a"), []byte("b"))))
+	assert.True(t, a.Cmp(N) < 0)
+	assert.NotEqual(t, 0, a.Cmp(HashToScalar("other", []byte("a"), []byte("b"))))
+	// the parts are length-prefixed
+	assert.NotEqual(t, 0, a.Cmp(HashToScalar("tag", []byte("ab"))))
+	assert.NotEqual(t, 0, a.Cmp(HashToScalar("tag", []byte("a"), nil, []byte("b"))))
+}
+
+func TestRandScalar(t *testing.T) {
+	k, err := RandScalar(bytes.NewReader(make([]byte, 40)))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, k.Cmp(one))
+	k, err = RandScalar(bytes.NewReader(bytes.Repeat([]byte{0xff}, 40)))
+	assert.Nil(t, err)
+	assert.True(t, k.Sign() > 0 && k.Cmp(N) < 0)
+	for i := 0; i < 64; i++ {
+		k, err = RandScalar(bytes.NewReader(bytes.Repeat([]byte{byte(i * 4)}, 40)))
+		assert.Nil(t, err)
+		assert.True(t, k.Sign() > 0 && k.Cmp(N) < 0)
+	}
+	_, err = RandScalar(bytes.NewReader(make([]byte, 39)))
+	assert.NotNil(t, err)
+}
+
+func TestValidPrivate(t *testing.T) {
+	nMinus2 := new(big.Int).Sub(N, big.NewInt(2))
+	assert.True(t, ValidPrivate(one))
+	assert.True(t, ValidPrivate(nMinus2))
+	assert.False(t, ValidPrivate(new(big.Int)))
+	assert.False(t, ValidPrivate(nMinus1))
+	assert.False(t, ValidPrivate(N))
+
+	assert.True(t, ValidPublic(Base()))
+	assert.False(t, ValidPublic(Point{}))
+	assert.False(t, ValidPublic(Base().Neg()))
+
+	for _, d := range []*big.Int{new(big.Int), nMinus1, N} {
+		key := new(gm.SM2PrivateKey)
+		d.FillBytes(key.K[:])
+		_, err := PrivateScalar(key)
+		assert.NotNil(t, err)
+	}
+	key := new(gm.SM2PrivateKey)
+	nMinus2.FillBytes(key.K[:])
+	d, err := PrivateScalar(key)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, d.Cmp(nMinus2))
+}
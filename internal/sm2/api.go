@@ -4,6 +4,7 @@ import (
 	"crypto/elliptic"
 	"github.com/meshplus/crypto-gm/internal/sm2/internal"
 	"io"
+	"math/big"
 )
 
 /*
@@ -24,8 +25,30 @@ func VerifySignature(sig, dgst []byte, X []byte, Y []byte) (bool, error) {
 	return internal.VerifySignature_32bit(sig, dgst, X, Y)
 }
 
+//PublicKeyTable is a precomputed table of the multiples of a public key.
+type PublicKeyTable = internal.PublicKeyTable
+
+//NewPublicKeyTable returns the table of the public key (X, Y).
+func NewPublicKeyTable(X, Y []byte) (*PublicKeyTable, error) {
+	return internal.NewPublicKeyTable(X, Y)
+}
+
+//VerifySignatureWithTable verifies a signature with the table of the public key.
+func VerifySignatureWithTable(sig, dgst []byte, table *PublicKeyTable) (bool, error) {
+	return internal.VerifySignatureWithTable(sig, dgst, table)
+}
+
 //MarshalSig marshal signature
-func MarshalSig(x, y []byte) []byte
+func MarshalSig(x, y []byte) []byte {
+	return internal.MarshalSig(x, y)
+}
 
 //Unmarshal unmarshal signature
-func Unmarshal(in []byte) (x []byte, y []byte)
+func Unmarshal(in []byte) (x []byte, y []byte) {
+	return internal.Unmarshal(in)
+}
+
+//WipeInt zeroes every limb of x, including those beyond its length.
+func WipeInt(x *big.Int) {
+	internal.WipeInt(x)
+}
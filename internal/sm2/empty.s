@@ -1 +0,0 @@
-//used to remove the "-complete" of the compiler in some architectures
\ No newline at end of file
@@ -18,6 +18,12 @@ func InitTable() {
 		{0x185a1bba, 0x354e593, 0x1295fac1, 0xf2bc469, 0x47c60fa, 0xc19b8a9, 0xf63533e, 0x903ae6b, 0xc79acba},
 		{0x2, 0, 0x1fffff00, 0x7ff, 0, 0, 0, 0x2000000, 0x0},
 	}
+	buildTable(&sm2Precomputed, basePoint)
+}
+
+// buildTable fills table with the affine multiples j * 2^(6i) * point, for j
+// in [1, 32] and i in [0, 42], of a point in the Montgomery domain with z = 1.
+func buildTable(table *[43][32 * 18]uint32, basePoint [3]sm2FieldElement) {
 	t1 := new([3]sm2FieldElement)
 
 	t2 := new([3]sm2FieldElement)
@@ -44,8 +50,8 @@ func InitTable() {
 
 			copy(t1[2][:], basePoint[2][:])
 			// Update the table entry
-			copy(sm2Precomputed[i][j*18:j*18+9], t1[0][:])
-			copy(sm2Precomputed[i][j*18+9:j*18+18], t1[1][:])
+			copy(table[i][j*18:j*18+9], t1[0][:])
+			copy(table[i][j*18+9:j*18+18], t1[1][:])
 		}
 		if j == 0 {
 			sm2PointDouble(&t2[0], &t2[1], &t2[2], &basePoint[0], &basePoint[1], &basePoint[2])
@@ -130,9 +136,14 @@ func negCond(p *sm2FieldElement, sign int) {
 }
 func sm2BaseMult2(xOut, yOut, zOut *sm2FieldElement, scalar *[8]uint32) {
 	precomputedOnce.Do(InitTable)
+	sm2TableMult(&sm2Precomputed, xOut, yOut, zOut, scalar)
+}
+
+// sm2TableMult multiplies the point of a table of buildTable by scalar.
+func sm2TableMult(table *[43][32 * 18]uint32, xOut, yOut, zOut *sm2FieldElement, scalar *[8]uint32) {
 	wvalue := (scalar[0] << 1) & 0x7f
 	sel, sign := boothW6(uint(wvalue))
-	p256SelectBase2(xOut, yOut, 0, sel)
+	p256SelectBase2(table, xOut, yOut, 0, sel)
 
 	negCond(yOut, sign)
 	// (This is one, in the Montgomery domain.)
@@ -152,7 +163,7 @@ func sm2BaseMult2(xOut, yOut, zOut *sm2FieldElement, scalar *[8]uint32) {
 		}
 		index += 6
 		sel, sign = boothW6(uint(wvalue))
-		p256SelectBase2(&t[0], &t[1], i, sel)
+		p256SelectBase2(table, &t[0], &t[1], i, sel)
 		sm2PointAddCond(xOut, yOut, zOut, xOut, yOut, zOut, &t[0], &t[1], sign, sel, zero)
 		zero |= sel
 	}
@@ -176,14 +187,14 @@ func sm2PointAddCond(xOut, yOut, zOut, x1, y1, z1, x2, y2 *sm2FieldElement, sign
 	}
 	sm2PointAddMixed2(xOut, yOut, zOut, x1, y1, z1, x2, y2)
 }
-func p256SelectBase2(x, y *sm2FieldElement, index, idx int) {
+func p256SelectBase2(table *[43][32 * 18]uint32, x, y *sm2FieldElement, index, idx int) {
 	if idx == 0 {
 		*x = sm2FieldElement{0, 0, 0, 0}
 		*y = sm2FieldElement{0, 0, 0, 0}
 		return
 	}
-	copy((*x)[:], sm2Precomputed[index][(idx-1)*18:(idx-1)*18+9])
-	copy((*y)[:], sm2Precomputed[index][(idx-1)*18+9:(idx-1)*18+18])
+	copy((*x)[:], table[index][(idx-1)*18:(idx-1)*18+9])
+	copy((*y)[:], table[index][(idx-1)*18+9:(idx-1)*18+18])
 }
 
 func sm2PointToAffine(xOut, yOut, x, y, z *sm2FieldElement) {
@@ -1023,18 +1034,36 @@ func sm2CombinedMult(X, Y *big.Int, baseScalar, scalar *big.Int) (*big.Int, *big
 	sm2FromBig(&r2[1], Y)
 	r2[2] = sm2FieldElement{0x2, 0, 0x1fffff00, 0x7ff, 0, 0, 0, 0x2000000, 0x0}
 	sm2ScalarMult2(&r2[0], &r2[1], &r2[2], &r2[0], &r2[1], &newScalar)
+	return sm2SumX(&r1, &r2, r1IsInfinity, r2IsInfinity), nil
+}
+
+// sm2CombinedMultTable is sm2CombinedMult with the table of the point.
+func sm2CombinedMultTable(table *PublicKeyTable, baseScalar, scalar *big.Int) *big.Int {
+	var r1, r2 [3]sm2FieldElement
+	var newScalar [8]uint32
+	sm2GetScalar2(&newScalar, baseScalar.Bytes())
+	r1IsInfinity := scalarIsZero(&newScalar)
+	sm2BaseMult2(&r1[0], &r1[1], &r1[2], &newScalar)
+
+	sm2GetScalar2(&newScalar, scalar.Bytes())
+	r2IsInfinity := scalarIsZero(&newScalar)
+	sm2TableMult((*[43][32 * 18]uint32)(table), &r2[0], &r2[1], &r2[2], &newScalar)
+	return sm2SumX(&r1, &r2, r1IsInfinity, r2IsInfinity)
+}
 
+// sm2SumX returns the affine x of r1 + r2.
+func sm2SumX(r1, r2 *[3]sm2FieldElement, r1IsInfinity, r2IsInfinity int) *big.Int {
 	var sum [3]sm2FieldElement
 	sm2PointAdd(&r1[0], &r1[1], &r1[2], &r2[0], &r2[1], &r2[2], &sum[0], &sum[1], &sum[2])
 	if isZeroPoint(&sum[0], &sum[1], &sum[2]) {
 		sm2PointDouble(&sum[0], &sum[1], &sum[2], &r1[0], &r1[1], &r1[2])
 	}
 	if r2IsInfinity == 1 {
-		sum = r1
+		sum = *r1
 	}
 
 	if r1IsInfinity == 1 {
-		sum = r2
+		sum = *r2
 	}
 
 	zz := sm2ToBig(&sum[2])
@@ -1043,5 +1072,5 @@ func sm2CombinedMult(X, Y *big.Int, baseScalar, scalar *big.Int) (*big.Int, *big
 	sm2Square(&sum[2], &sum[2])
 	sm2Mul(&sum[0], &sum[0], &sum[2])
 
-	return sm2ToBig(&sum[0]), nil
+	return sm2ToBig(&sum[0])
 }
@@ -46,6 +46,24 @@ func PutInt(in *big.Int) {
 	bigIntPool.Put(in)
 }
 
+//PutSecretInt wipes a big.Int holding secret data, up to the capacity of its
+// limbs, and puts it back to the pool.
+func PutSecretInt(in *big.Int) {
+	WipeInt(in)
+	bigIntPool.Put(in)
+}
+
+//WipeInt zeroes every limb of x, including those beyond its length, and
+// sets x to 0.
+func WipeInt(x *big.Int) {
+	w := x.Bits()
+	w = w[:cap(w)]
+	for i := range w {
+		w[i] = 0
+	}
+	x.SetInt64(0)
+}
+
 func init() {
 	sm2.Name = "sm2"
 	sm2.P, _ = new(big.Int).SetString("FFFFFFFEFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF00000000FFFFFFFFFFFFFFFF", 16)
@@ -1,58 +1,64 @@
 package internal
 
-import _ "unsafe"
-
 // 0    | 1    | 2    | 3  | 4    | 4+xl  | 5+xl | 6+xl
 // 0x30 | xy+4 | 0x02 | xl | ____ | 0x02  | yl   | ____
 
 //MarshalSig marshal
-//go:linkname MarshalSig github.com/meshplus/crypto-gm/internal/sm2.MarshalSig
 func MarshalSig(x, y []byte) []byte {
-	offset := 0
-	for ; x[offset] == byte(0); offset++ {
-	}
-	x = x[offset:]
-	offset = 0
-	for ; y[offset] == byte(0); offset++ {
-	}
-	y = y[offset:]
-
-	xl := byte(len(x)) + x[0]>>7
-	yl := byte(len(y)) + y[0]>>7
-	xy := xl + yl
-
-	out := make([]byte, 6+xy)
+	out := make([]byte, 2, 6+2+len(x)+len(y))
 	out[0] = 0x30
-	out[1] = xy + 4
-	out[2] = 0x02
-	out[3] = xl
-
-	copy(out[(x[0]>>7)+4:], x)
-	out[4+xl] = 0x02
-	out[5+xl] = yl
-	copy(out[(y[0]>>7)+6+xl:], y)
+	out = appendInteger(out, x)
+	out = appendInteger(out, y)
+	out[1] = byte(len(out) - 2)
 	return out
 }
 
-//Unmarshal unmarshal
-//go:linkname Unmarshal github.com/meshplus/crypto-gm/internal/sm2.Unmarshal
-func Unmarshal(in []byte) (x []byte, y []byte) {
-	defer func() {
-		e := recover()
-		if e != nil {
-			x, y = nil, nil
-		}
-	}()
-	xl := in[3]
-	x, y = in[4:4+xl], in[6+xl:]
-	offset := 0
-	for ; x[offset] == 0; offset++ {
-	}
-	x = x[offset:]
-	offset = 0
-	for ; y[offset] == 0; offset++ {
-	}
-	y = y[offset:]
+// appendInteger appends the DER INTEGER of the big-endian unsigned v.
+func appendInteger(b, v []byte) []byte {
+	for len(v) > 0 && v[0] == 0 {
+		v = v[1:]
+	}
+	switch {
+	case len(v) == 0:
+		return append(b, 0x02, 1, 0)
+	case v[0]&0x80 != 0:
+		b = append(b, 0x02, byte(len(v)+1), 0)
+	default:
+		b = append(b, 0x02, byte(len(v)))
+	}
+	return append(b, v...)
+}
 
+//Unmarshal unmarshal, it returns nil for input which is not a sequence of
+// two positive integers of at most 32 bytes. Unlike DER, leading zeros of
+// the integers are accepted.
+func Unmarshal(in []byte) (x []byte, y []byte) {
+	if len(in) < 2 || in[0] != 0x30 || int(in[1]) != len(in)-2 {
+		return nil, nil
+	}
+	x, rest := integer(in[2:])
+	y, rest = integer(rest)
+	if x == nil || y == nil || len(rest) != 0 {
+		return nil, nil
+	}
 	return x, y
 }
+
+// integer returns the value of a short INTEGER without its leading zeros,
+// nil if it is not positive or longer than 32 bytes, and the rest of in.
+func integer(in []byte) ([]byte, []byte) {
+	if len(in) < 2 || in[0] != 0x02 || in[1] == 0 || int(in[1]) > len(in)-2 {
+		return nil, nil
+	}
+	v, rest := in[2:2+in[1]], in[2+in[1]:]
+	if v[0]&0x80 != 0 {
+		return nil, nil
+	}
+	for len(v) > 0 && v[0] == 0 {
+		v = v[1:]
+	}
+	if len(v) == 0 || len(v) > 32 {
+		return nil, nil
+	}
+	return v, rest
+}
@@ -28,3 +28,22 @@ func TestMarshal(t *testing.T) {
 		assert.Equal(t, sig.S.Bytes(), y)
 	}
 }
+
+func TestUnmarshalInvalid(t *testing.T) {
+	assert.Equal(t, []byte{0x30, 6, 2, 1, 0, 2, 1, 0}, MarshalSig([]byte{0, 0}, nil))
+	for _, in := range [][]byte{
+		nil,
+		{0x30},
+		{0x30, 6, 2, 1, 0, 2, 1, 0},    // zero
+		{0x30, 6, 2, 1, 1, 2, 1, 0xff}, // negative
+		{0x30, 6, 2, 1, 1, 2, 1, 1, 0}, // trailing data
+		{0x30, 6, 2, 4, 1, 2, 1, 1},    // integer overruns
+		{0x30, 5, 2, 1, 1, 2, 1},       // truncated
+		{0x30, 4, 2, 2, 0, 0},          // all zero, missing s
+		append([]byte{0x30, 39, 2, 34}, make([]byte, 37)...),
+	} {
+		x, y := Unmarshal(in)
+		assert.Nil(t, x)
+		assert.Nil(t, y)
+	}
+}
@@ -28,15 +28,25 @@ var (
 func Sign_32bit(dgst []byte, reader io.Reader, key []byte) ([]byte, uint8, error) {
 	e := GetInt().SetBytes(dgst)
 	keyBig := GetInt().SetBytes(key)
-	r, s, randK := GetInt(), GetInt(), GetInt()
-	var err error
+	r, s, t := GetInt(), GetInt(), GetInt()
+	// the nonce, 1+d and the products with them are secrets, wiped before the
+	// big.Ints go back to the pool
+	defer func() {
+		PutInt(r)
+		PutInt(e)
+		PutSecretInt(s)
+		PutSecretInt(keyBig)
+		PutSecretInt(t)
+	}()
 	var flag uint8
 	for {
+		var randK *big.Int
 		for {
-			randK, err = rand.Int(reader, Sm2_32bit().Params().N)
+			k, err := rand.Int(reader, Sm2_32bit().Params().N)
 			if err != nil {
 				return nil, 0, err
 			}
+			randK = k
 			if randK.Cmp(zeroBig) == 0 { //k ∈ [1,n-1]
 				return nil, 0, fmt.Errorf("zero rander")
 			}
@@ -52,41 +62,53 @@ func Sign_32bit(dgst []byte, reader io.Reader, key []byte) ([]byte, uint8, error
 
 			r.Add(e, x)
 			r.Mod(r, Sm2_32bit().Params().N)
-			t := GetInt().Add(r, randK)
+			t.Add(r, randK)
 			t.Mod(t, Sm2_32bit().Params().N)
 			if r.Sign() != 0 && t.Sign() != 0 {
-				PutInt(t)
 				break
 			}
-			PutInt(t)
+			WipeInt(randK)
 		}
 		s.Add(oneBig, keyBig).Mod(s, Sm2_32bit().Params().N)
 		s.ModInverse(s, Sm2_32bit().Params().N)
-		keyBig.Mul(r, keyBig)
-		randK.Sub(randK, keyBig)
-		s.Mul(s, randK)
+		// t = k - r * d, without aliasing keyBig which would leave its limbs
+		// to the garbage collector
+		t.Mul(r, keyBig)
+		t.Sub(randK, t)
+		WipeInt(randK)
+		s.Mul(s, t)
 		s.Mod(s, Sm2_32bit().Params().N)
 		if s.Sign() != 0 {
 			break
 		}
 	}
-	ret := MarshalSig(r.Bytes(), s.Bytes())
-	PutInt(r)
-	PutInt(s)
-	PutInt(e)
-	PutInt(keyBig)
-	PutInt(randK)
-	return ret, flag, nil
+	return MarshalSig(r.Bytes(), s.Bytes()), flag, nil
 }
 
 //VerifySignature_32bit to verify a signature and return error
 func VerifySignature_32bit(sig, dgst []byte, X []byte, Y []byte) (bool, error) {
+	return verifySignature(sig, dgst, func(s, t *big.Int) *big.Int {
+		xBig := GetInt().SetBytes(X)
+		yBig := GetInt().SetBytes(Y)
+		x, _ := sm2CombinedMult(xBig, yBig, s, t)
+		PutInt(xBig)
+		PutInt(yBig)
+		return x
+	})
+}
+
+// verifySignature verifies sig with combinedMult returning the x-coordinate
+// of [s]G + [t]P.
+func verifySignature(sig, dgst []byte, combinedMult func(s, t *big.Int) *big.Int) (bool, error) {
 	var head int
 	for head < len(sig) && sig[head] != 0x30 {
 		head++
 	}
 	sig = sig[head:]
 	r, s := Unmarshal(sig)
+	if r == nil {
+		return false, errors.New("invalid signature")
+	}
 	ss := GetInt().SetBytes(s)
 	rr := GetInt().SetBytes(r)
 	if ss.Cmp(Sm2_32bit().Params().N) >= 0 || rr.Cmp(Sm2_32bit().Params().N) >= 0 {
@@ -98,9 +120,7 @@ func VerifySignature_32bit(sig, dgst []byte, X []byte, Y []byte) (bool, error) {
 	if t.Sign() == 0 {
 		return false, errors.New("invalid signature")
 	}
-	xBig := GetInt().SetBytes(X)
-	yBig := GetInt().SetBytes(Y)
-	x, _ := sm2CombinedMult(xBig, yBig, ss, t)
+	x := combinedMult(ss, t)
 	e.Add(e, x)
 	e.Mod(e, Sm2_32bit().Params().N)
 	ret := e.Cmp(rr) != 0
@@ -109,8 +129,6 @@ func VerifySignature_32bit(sig, dgst []byte, X []byte, Y []byte) (bool, error) {
 	PutInt(ss)
 	PutInt(e)
 	PutInt(t)
-	PutInt(xBig)
-	PutInt(yBig)
 	if ret {
 		return false, errors.New("invalid signature")
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"github.com/stretchr/testify/assert"
 	"github.com/meshplus/crypto-gm/internal/sm3"
+	"math/big"
 	"testing"
 )
 
@@ -33,3 +34,14 @@ func TestVerifySignature(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, b)
 }
+
+func TestWipeInt(t *testing.T) {
+	x, _ := new(big.Int).SetString("123456789abcdef0123456789abcdef0123456789abcdef", 16)
+	w := x.Bits()
+	x.SetInt64(1) // shrinks the length, the old limbs stay in the array
+	WipeInt(x)
+	assert.Equal(t, 0, x.Sign())
+	for _, limb := range w[:cap(w)] {
+		assert.Equal(t, big.Word(0), limb)
+	}
+}
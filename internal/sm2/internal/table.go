@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"errors"
+	"math/big"
+)
+
+//PublicKeyTable holds the multiples of a public key in the layout of the
+// base point table, so that verifying with it takes two fixed-base
+// multiplications instead of a fixed-base and a variable-base one.
+type PublicKeyTable [43][32 * 18]uint32
+
+//NewPublicKeyTable returns the table of the point (X, Y), which must be on
+// the curve.
+func NewPublicKeyTable(X, Y []byte) (*PublicKeyTable, error) {
+	x, y := new(big.Int).SetBytes(X), new(big.Int).SetBytes(Y)
+	if !sm2.IsOnCurve(x, y) {
+		return nil, errors.New("public key is not on the curve")
+	}
+	var point [3]sm2FieldElement
+	sm2FromBig(&point[0], x)
+	sm2FromBig(&point[1], y)
+	point[2] = sm2FieldElement{0x2, 0, 0x1fffff00, 0x7ff, 0, 0, 0, 0x2000000, 0x0}
+	table := new(PublicKeyTable)
+	buildTable((*[43][32 * 18]uint32)(table), point)
+	return table, nil
+}
+
+//VerifySignatureWithTable is VerifySignature_32bit with the table of the
+// public key.
+func VerifySignatureWithTable(sig, dgst []byte, table *PublicKeyTable) (bool, error) {
+	return verifySignature(sig, dgst, func(s, t *big.Int) *big.Int {
+		return sm2CombinedMultTable(table, s, t)
+	})
+}
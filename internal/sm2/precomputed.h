@@ -1,11009 +0,0 @@
-DATA ·precomputed<>+0x0(SB)/8, $0x61328990f418029e
-DATA ·precomputed<>+0x8(SB)/8, $0x3e7981eddca6c050
-DATA ·precomputed<>+0x10(SB)/8, $0xd6a1ed99ac24c3c3
-DATA ·precomputed<>+0x18(SB)/8, $0x91167a5ee1c13b05
-DATA ·precomputed<>+0x20(SB)/8, $0xc1354e593c2d0ddd
-DATA ·precomputed<>+0x28(SB)/8, $0xc1f5e5788d3295fa
-DATA ·precomputed<>+0x30(SB)/8, $0x8d4cfb066e2a48f8
-DATA ·precomputed<>+0x38(SB)/8, $0x63cd65d481d735bd
-DATA ·precomputed<>+0x40(SB)/8, $0xaf037bfbc3be46a
-DATA ·precomputed<>+0x48(SB)/8, $0x83bdc9ba2d8fa938
-DATA ·precomputed<>+0x50(SB)/8, $0x5349d94b5788cd24
-DATA ·precomputed<>+0x58(SB)/8, $0xd7e9c18caa5736a
-DATA ·precomputed<>+0x60(SB)/8, $0x6a7e1a1d69db9ac1
-DATA ·precomputed<>+0x68(SB)/8, $0xccbd8d37c4a8e82b
-DATA ·precomputed<>+0x70(SB)/8, $0xc7b145169b7157ac
-DATA ·precomputed<>+0x78(SB)/8, $0x947e74656c21bdf5
-DATA ·precomputed<>+0x80(SB)/8, $0x1cda54fdab589e4a
-DATA ·precomputed<>+0x88(SB)/8, $0x26765289db4f0a0d
-DATA ·precomputed<>+0x90(SB)/8, $0xa265a308ceb4a0a
-DATA ·precomputed<>+0x98(SB)/8, $0x3019fd6bfe887c64
-DATA ·precomputed<>+0xa0(SB)/8, $0xa10fbe94b2fc190
-DATA ·precomputed<>+0xa8(SB)/8, $0xf40aa52b87cbce60
-DATA ·precomputed<>+0xb0(SB)/8, $0xcc496bfa6dc13c97
-DATA ·precomputed<>+0xb8(SB)/8, $0x28ad34785bb3fbb4
-DATA ·precomputed<>+0xc0(SB)/8, $0x393f7c5a98615060
-DATA ·precomputed<>+0xc8(SB)/8, $0x487ea27fe9016209
-DATA ·precomputed<>+0xd0(SB)/8, $0x8a86bcb4a09f9020
-DATA ·precomputed<>+0xd8(SB)/8, $0x50dc8e3ac899dbe1
-DATA ·precomputed<>+0xe0(SB)/8, $0xfc099043fd619998
-DATA ·precomputed<>+0xe8(SB)/8, $0x1de135ea7c7383bd
-DATA ·precomputed<>+0xf0(SB)/8, $0x4d0bd55632cf70ed
-DATA ·precomputed<>+0xf8(SB)/8, $0x6ffc31c525bce9e3
-DATA ·precomputed<>+0x100(SB)/8, $0x9a5756336a9c8162
-DATA ·precomputed<>+0x108(SB)/8, $0x15aa58f221dfcc53
-DATA ·precomputed<>+0x110(SB)/8, $0x7ad354bf1ef5f4c5
-DATA ·precomputed<>+0x118(SB)/8, $0xf443ef363f875b9
-DATA ·precomputed<>+0x120(SB)/8, $0x2e81d68fd3450133
-DATA ·precomputed<>+0x128(SB)/8, $0xb30f4bbde3607d18
-DATA ·precomputed<>+0x130(SB)/8, $0xb1826a4c362258ef
-DATA ·precomputed<>+0x138(SB)/8, $0x7b415276142a6768
-DATA ·precomputed<>+0x140(SB)/8, $0x136a9c4c0acd72ba
-DATA ·precomputed<>+0x148(SB)/8, $0xb1274a255e7ec73c
-DATA ·precomputed<>+0x150(SB)/8, $0xf15a876e5de34db6
-DATA ·precomputed<>+0x158(SB)/8, $0x85e74ca08cba8047
-DATA ·precomputed<>+0x160(SB)/8, $0x8454cddb469eb37
-DATA ·precomputed<>+0x168(SB)/8, $0x8fbf6d1fc99754f8
-DATA ·precomputed<>+0x170(SB)/8, $0x1060e7f8ec30e984
-DATA ·precomputed<>+0x178(SB)/8, $0xb568bc974b8c598a
-DATA ·precomputed<>+0x180(SB)/8, $0xaa3531c781f06784
-DATA ·precomputed<>+0x188(SB)/8, $0xb89419307132520
-DATA ·precomputed<>+0x190(SB)/8, $0x84ee5b69acfe18c5
-DATA ·precomputed<>+0x198(SB)/8, $0xbbf492e0d9fbec28
-DATA ·precomputed<>+0x1a0(SB)/8, $0x313a35c1e5f6186d
-DATA ·precomputed<>+0x1a8(SB)/8, $0xe449a2e757a01b8
-DATA ·precomputed<>+0x1b0(SB)/8, $0x96c9b9922bd99baf
-DATA ·precomputed<>+0x1b8(SB)/8, $0x2ba05a8f3b84d777
-DATA ·precomputed<>+0x1c0(SB)/8, $0xde523a1c09122670
-DATA ·precomputed<>+0x1c8(SB)/8, $0x90be6f2a22cc810c
-DATA ·precomputed<>+0x1d0(SB)/8, $0x86e63414387df9e
-DATA ·precomputed<>+0x1d8(SB)/8, $0x115c2fc0d9c44134
-DATA ·precomputed<>+0x1e0(SB)/8, $0x9334430d8799302a
-DATA ·precomputed<>+0x1e8(SB)/8, $0x693b3500e27b7ea4
-DATA ·precomputed<>+0x1f0(SB)/8, $0xcbe1136f9a8f3382
-DATA ·precomputed<>+0x1f8(SB)/8, $0xe77fd5f2b5778247
-DATA ·precomputed<>+0x200(SB)/8, $0x98e795c330fbde86
-DATA ·precomputed<>+0x208(SB)/8, $0x8e5e0495ab21af8f
-DATA ·precomputed<>+0x210(SB)/8, $0x3925bf83b48669b4
-DATA ·precomputed<>+0x218(SB)/8, $0x77d88740469522c8
-DATA ·precomputed<>+0x220(SB)/8, $0x8fbf8b5b987b04ce
-DATA ·precomputed<>+0x228(SB)/8, $0x63c563a83aff4428
-DATA ·precomputed<>+0x230(SB)/8, $0x5dc1116553a6e969
-DATA ·precomputed<>+0x238(SB)/8, $0x822a6c2432697f4c
-DATA ·precomputed<>+0x240(SB)/8, $0x6774298a642cb143
-DATA ·precomputed<>+0x248(SB)/8, $0xecdb60d82d110e71
-DATA ·precomputed<>+0x250(SB)/8, $0xe810b11b1388728e
-DATA ·precomputed<>+0x258(SB)/8, $0x2e8237d8d8603a8a
-DATA ·precomputed<>+0x260(SB)/8, $0x673968fc50aeeae1
-DATA ·precomputed<>+0x268(SB)/8, $0x8c65d196746a3f4
-DATA ·precomputed<>+0x270(SB)/8, $0x7a61a6b5d7dd7165
-DATA ·precomputed<>+0x278(SB)/8, $0xe31bbfd9a9b6df3a
-DATA ·precomputed<>+0x280(SB)/8, $0x2b252ad03421e115
-DATA ·precomputed<>+0x288(SB)/8, $0x7557c8c7c6affc01
-DATA ·precomputed<>+0x290(SB)/8, $0xd90c19fd8a509267
-DATA ·precomputed<>+0x298(SB)/8, $0x483da168e0d871c8
-DATA ·precomputed<>+0x2a0(SB)/8, $0x72d6f9b3c10729bf
-DATA ·precomputed<>+0x2a8(SB)/8, $0x5dd8402115b7061e
-DATA ·precomputed<>+0x2b0(SB)/8, $0x9bfea2db9f2c587d
-DATA ·precomputed<>+0x2b8(SB)/8, $0x528398a798641ec2
-DATA ·precomputed<>+0x2c0(SB)/8, $0x18a65d8df3afdd62
-DATA ·precomputed<>+0x2c8(SB)/8, $0x89f38500d6d7e4e4
-DATA ·precomputed<>+0x2d0(SB)/8, $0x65708c6a9d8d4f07
-DATA ·precomputed<>+0x2d8(SB)/8, $0xb90ea13cd0bdc7f4
-DATA ·precomputed<>+0x2e0(SB)/8, $0x589858558c3e2b32
-DATA ·precomputed<>+0x2e8(SB)/8, $0xfa48d5c5bcfad3a1
-DATA ·precomputed<>+0x2f0(SB)/8, $0x5c3544e762385ffa
-DATA ·precomputed<>+0x2f8(SB)/8, $0xb6bd39ed7e72aeb7
-DATA ·precomputed<>+0x300(SB)/8, $0x34e51c6a8000fe4e
-DATA ·precomputed<>+0x308(SB)/8, $0x7da2bdfd89c46941
-DATA ·precomputed<>+0x310(SB)/8, $0x667ba91de1bc2b2e
-DATA ·precomputed<>+0x318(SB)/8, $0x3c80c9d010a73e5c
-DATA ·precomputed<>+0x320(SB)/8, $0x4fadebbec7f5c64d
-DATA ·precomputed<>+0x328(SB)/8, $0xaef09eb43ea35052
-DATA ·precomputed<>+0x330(SB)/8, $0x167ee11b26ec55f9
-DATA ·precomputed<>+0x338(SB)/8, $0x45fa508a85189260
-DATA ·precomputed<>+0x340(SB)/8, $0xa0e9a43922542fc3
-DATA ·precomputed<>+0x348(SB)/8, $0x3f194a6cddac78dc
-DATA ·precomputed<>+0x350(SB)/8, $0xa75ae72d6f74d053
-DATA ·precomputed<>+0x358(SB)/8, $0xf8babeb097c6617
-DATA ·precomputed<>+0x360(SB)/8, $0x1d12bc5c4303c247
-DATA ·precomputed<>+0x368(SB)/8, $0xfe0c027abd1e246c
-DATA ·precomputed<>+0x370(SB)/8, $0xe9ca1a99b69b55ad
-DATA ·precomputed<>+0x378(SB)/8, $0xff6cd2b0117cd63a
-DATA ·precomputed<>+0x380(SB)/8, $0xf3489343dde97d4d
-DATA ·precomputed<>+0x388(SB)/8, $0x9c14e38abbb2ce1f
-DATA ·precomputed<>+0x390(SB)/8, $0x25866911cfddf221
-DATA ·precomputed<>+0x398(SB)/8, $0xdf89411460efef1
-DATA ·precomputed<>+0x3a0(SB)/8, $0xf713f30e73ae8326
-DATA ·precomputed<>+0x3a8(SB)/8, $0xd9be66a8cdd274a1
-DATA ·precomputed<>+0x3b0(SB)/8, $0xdf915ae236885947
-DATA ·precomputed<>+0x3b8(SB)/8, $0x2c5c1e9e7878b781
-DATA ·precomputed<>+0x3c0(SB)/8, $0xf71560c939e8a120
-DATA ·precomputed<>+0x3c8(SB)/8, $0x7121d6b87273b59a
-DATA ·precomputed<>+0x3d0(SB)/8, $0x649535ce8ef4639d
-DATA ·precomputed<>+0x3d8(SB)/8, $0xcd01076e14cc6d58
-DATA ·precomputed<>+0x3e0(SB)/8, $0x2705729a96e74f8a
-DATA ·precomputed<>+0x3e8(SB)/8, $0xb07e32305533037e
-DATA ·precomputed<>+0x3f0(SB)/8, $0x846dcc1663c5c62
-DATA ·precomputed<>+0x3f8(SB)/8, $0x6a4759c110fc3ac1
-DATA ·precomputed<>+0x400(SB)/8, $0x3c126193cfbdfeff
-DATA ·precomputed<>+0x408(SB)/8, $0x4a31dd204996d845
-DATA ·precomputed<>+0x410(SB)/8, $0x48a76ba019f2b658
-DATA ·precomputed<>+0x418(SB)/8, $0xbe3301428890a8bc
-DATA ·precomputed<>+0x420(SB)/8, $0x287b34e1308aa041
-DATA ·precomputed<>+0x428(SB)/8, $0xcbf5da24813adf29
-DATA ·precomputed<>+0x430(SB)/8, $0xcdfc5a58cdcdc439
-DATA ·precomputed<>+0x438(SB)/8, $0xbda3bda2198a6075
-DATA ·precomputed<>+0x440(SB)/8, $0x639f92bc1497fe38
-DATA ·precomputed<>+0x448(SB)/8, $0x8ed8eeacd58bd278
-DATA ·precomputed<>+0x450(SB)/8, $0xcf5d7ce6b417bfe4
-DATA ·precomputed<>+0x458(SB)/8, $0xf617c54e44400c59
-DATA ·precomputed<>+0x460(SB)/8, $0xde6356357d8dc939
-DATA ·precomputed<>+0x468(SB)/8, $0x2e6a3a75241baaff
-DATA ·precomputed<>+0x470(SB)/8, $0x2f324e5e07e8e97
-DATA ·precomputed<>+0x478(SB)/8, $0xeb71548770f9fc9d
-DATA ·precomputed<>+0x480(SB)/8, $0xbefd338086712116
-DATA ·precomputed<>+0x488(SB)/8, $0x9b9e9707884efe46
-DATA ·precomputed<>+0x490(SB)/8, $0x611a1eec8c9e513f
-DATA ·precomputed<>+0x498(SB)/8, $0xe2d8e3f53b6dbcec
-DATA ·precomputed<>+0x4a0(SB)/8, $0x7cedab1c4f8964e4
-DATA ·precomputed<>+0x4a8(SB)/8, $0xee12d062f4e139f8
-DATA ·precomputed<>+0x4b0(SB)/8, $0x8e63c9c09a9af4f3
-DATA ·precomputed<>+0x4b8(SB)/8, $0xe3246dbb8b907b23
-DATA ·precomputed<>+0x4c0(SB)/8, $0x70d5bda271099001
-DATA ·precomputed<>+0x4c8(SB)/8, $0x3d876d4a15fae7dd
-DATA ·precomputed<>+0x4d0(SB)/8, $0xaba0500f7b69c20e
-DATA ·precomputed<>+0x4d8(SB)/8, $0xa8e3e0949834adf2
-DATA ·precomputed<>+0x4e0(SB)/8, $0x69db851b980b21b9
-DATA ·precomputed<>+0x4e8(SB)/8, $0x274c1de2788c2a30
-DATA ·precomputed<>+0x4f0(SB)/8, $0x5caa5336d47d153d
-DATA ·precomputed<>+0x4f8(SB)/8, $0xada6987757cef318
-DATA ·precomputed<>+0x500(SB)/8, $0x83879486a0551c80
-DATA ·precomputed<>+0x508(SB)/8, $0x1611dea0658e61be
-DATA ·precomputed<>+0x510(SB)/8, $0x1fe95c821b935068
-DATA ·precomputed<>+0x518(SB)/8, $0x8f01e0195b229223
-DATA ·precomputed<>+0x520(SB)/8, $0x23017e057e93c389
-DATA ·precomputed<>+0x528(SB)/8, $0xce4ac99d9840dd64
-DATA ·precomputed<>+0x530(SB)/8, $0xddc9b9001de86399
-DATA ·precomputed<>+0x538(SB)/8, $0x6abe5cc388015785
-DATA ·precomputed<>+0x540(SB)/8, $0xc09545a9b3c50898
-DATA ·precomputed<>+0x548(SB)/8, $0xbd4433616c05d902
-DATA ·precomputed<>+0x550(SB)/8, $0xed71f70c2c6bcc8c
-DATA ·precomputed<>+0x558(SB)/8, $0x8dbc0b88bdf8e908
-DATA ·precomputed<>+0x560(SB)/8, $0x56eb5b984fcbcd9a
-DATA ·precomputed<>+0x568(SB)/8, $0xafb6fedc08114397
-DATA ·precomputed<>+0x570(SB)/8, $0x500ce5bb35f7927
-DATA ·precomputed<>+0x578(SB)/8, $0x7005bcf995efe710
-DATA ·precomputed<>+0x580(SB)/8, $0x125cbed22eba7f39
-DATA ·precomputed<>+0x588(SB)/8, $0xc7c42e766c488d44
-DATA ·precomputed<>+0x590(SB)/8, $0xdb8991f9676915c4
-DATA ·precomputed<>+0x598(SB)/8, $0xdf6ae5949183839f
-DATA ·precomputed<>+0x5a0(SB)/8, $0x4f69c304c79f8bd1
-DATA ·precomputed<>+0x5a8(SB)/8, $0x638cb070aa1662fa
-DATA ·precomputed<>+0x5b0(SB)/8, $0xc7f68c72ba6f2599
-DATA ·precomputed<>+0x5b8(SB)/8, $0x11bb84d91f6edfa9
-DATA ·precomputed<>+0x5c0(SB)/8, $0x9ed156eca215fda2
-DATA ·precomputed<>+0x5c8(SB)/8, $0x19de7a9120c5ddb6
-DATA ·precomputed<>+0x5d0(SB)/8, $0xc1ed949d0668c65d
-DATA ·precomputed<>+0x5d8(SB)/8, $0x96683044d0826f6a
-DATA ·precomputed<>+0x5e0(SB)/8, $0x1e6325e01adaa8ff
-DATA ·precomputed<>+0x5e8(SB)/8, $0xbc53bc2407ac392a
-DATA ·precomputed<>+0x5f0(SB)/8, $0x2c342db5d9f06e44
-DATA ·precomputed<>+0x5f8(SB)/8, $0x3f52938530db8c1a
-DATA ·precomputed<>+0x600(SB)/8, $0xc5957d29e7492326
-DATA ·precomputed<>+0x608(SB)/8, $0x3addc3df0663f829
-DATA ·precomputed<>+0x610(SB)/8, $0x8faa3169728cfdc1
-DATA ·precomputed<>+0x618(SB)/8, $0xde53aa7c6b975134
-DATA ·precomputed<>+0x620(SB)/8, $0xf481759befddc764
-DATA ·precomputed<>+0x628(SB)/8, $0xd605474b09edaff3
-DATA ·precomputed<>+0x630(SB)/8, $0xc7df1eb9653d48c9
-DATA ·precomputed<>+0x638(SB)/8, $0xa71e6854c5040212
-DATA ·precomputed<>+0x640(SB)/8, $0x136d8342afe945b5
-DATA ·precomputed<>+0x648(SB)/8, $0x91707e7de9d239c7
-DATA ·precomputed<>+0x650(SB)/8, $0xeda23dc5fb2e80de
-DATA ·precomputed<>+0x658(SB)/8, $0x892bed73ff614966
-DATA ·precomputed<>+0x660(SB)/8, $0x2ded2367838dc12d
-DATA ·precomputed<>+0x668(SB)/8, $0x73fd298cb002bd9c
-DATA ·precomputed<>+0x670(SB)/8, $0xc548b4262c4629df
-DATA ·precomputed<>+0x678(SB)/8, $0x93605d178f7e03b7
-DATA ·precomputed<>+0x680(SB)/8, $0x32861816d37c24cc
-DATA ·precomputed<>+0x688(SB)/8, $0x5bb54ee2e427975a
-DATA ·precomputed<>+0x690(SB)/8, $0x6da013d232f943a9
-DATA ·precomputed<>+0x698(SB)/8, $0x746a77a9bc202e5
-DATA ·precomputed<>+0x6a0(SB)/8, $0x6db07a84cd1def5b
-DATA ·precomputed<>+0x6a8(SB)/8, $0x9421fe7f861d9f9b
-DATA ·precomputed<>+0x6b0(SB)/8, $0x71767292692181fb
-DATA ·precomputed<>+0x6b8(SB)/8, $0x560e7e5c9d2441d
-DATA ·precomputed<>+0x6c0(SB)/8, $0xf1496afd4d7e922a
-DATA ·precomputed<>+0x6c8(SB)/8, $0x67f42a3fe11fa533
-DATA ·precomputed<>+0x6d0(SB)/8, $0x9f903e5b977956cd
-DATA ·precomputed<>+0x6d8(SB)/8, $0x37671e241eb49608
-DATA ·precomputed<>+0x6e0(SB)/8, $0x967950a021fb2047
-DATA ·precomputed<>+0x6e8(SB)/8, $0x141f96fb35da3c6b
-DATA ·precomputed<>+0x6f0(SB)/8, $0xe07c3c40d27bba59
-DATA ·precomputed<>+0x6f8(SB)/8, $0xbde5ed1d0e1af754
-DATA ·precomputed<>+0x700(SB)/8, $0xdc64c4b054f1f257
-DATA ·precomputed<>+0x708(SB)/8, $0xecb033c8b01196dc
-DATA ·precomputed<>+0x710(SB)/8, $0x54e65f4d8202d5bd
-DATA ·precomputed<>+0x718(SB)/8, $0x63afcc932b2fd451
-DATA ·precomputed<>+0x720(SB)/8, $0x1e929a3930640fb7
-DATA ·precomputed<>+0x728(SB)/8, $0xdc91387e5b361718
-DATA ·precomputed<>+0x730(SB)/8, $0x10aadecbf8f0bbe8
-DATA ·precomputed<>+0x738(SB)/8, $0x81d8f4660977e2bb
-DATA ·precomputed<>+0x740(SB)/8, $0xdcaa3790bd64cd96
-DATA ·precomputed<>+0x748(SB)/8, $0xbc8ac152cee698d3
-DATA ·precomputed<>+0x750(SB)/8, $0xde7192f7a1143c45
-DATA ·precomputed<>+0x758(SB)/8, $0xf7c9d826f5fb9ea0
-DATA ·precomputed<>+0x760(SB)/8, $0x54aea92ec9468f50
-DATA ·precomputed<>+0x768(SB)/8, $0x340f4459cc427ed4
-DATA ·precomputed<>+0x770(SB)/8, $0x3fec5be902ad5467
-DATA ·precomputed<>+0x778(SB)/8, $0xec780d9c2cc6c8b5
-DATA ·precomputed<>+0x780(SB)/8, $0x7b179a8bb889c78a
-DATA ·precomputed<>+0x788(SB)/8, $0x69a7ab90aca32c5
-DATA ·precomputed<>+0x790(SB)/8, $0xe4e5215e591b9a36
-DATA ·precomputed<>+0x798(SB)/8, $0x7802fb3e3bd54630
-DATA ·precomputed<>+0x7a0(SB)/8, $0x9a479313233c6eeb
-DATA ·precomputed<>+0x7a8(SB)/8, $0x18c612ad4e1cbabc
-DATA ·precomputed<>+0x7b0(SB)/8, $0x28a29273c0e36f3b
-DATA ·precomputed<>+0x7b8(SB)/8, $0xf4e2dfb17d3deb26
-DATA ·precomputed<>+0x7c0(SB)/8, $0xa6c11369adbb3c8b
-DATA ·precomputed<>+0x7c8(SB)/8, $0xd78af40b4c8ec378
-DATA ·precomputed<>+0x7d0(SB)/8, $0xffb3a80d03f0a982
-DATA ·precomputed<>+0x7d8(SB)/8, $0x550e3e71a83be50a
-DATA ·precomputed<>+0x7e0(SB)/8, $0x845c0fb2418ee45b
-DATA ·precomputed<>+0x7e8(SB)/8, $0x5297cf430791b964
-DATA ·precomputed<>+0x7f0(SB)/8, $0x676b638ccc47e33b
-DATA ·precomputed<>+0x7f8(SB)/8, $0xb1c52facfecf85b2
-DATA ·precomputed<>+0x800(SB)/8, $0x80ffa5ae0d038ad4
-DATA ·precomputed<>+0x808(SB)/8, $0xf44d3df336256c8f
-DATA ·precomputed<>+0x810(SB)/8, $0xa3077c8bc978dce
-DATA ·precomputed<>+0x818(SB)/8, $0xf3d9b4b0745b8317
-DATA ·precomputed<>+0x820(SB)/8, $0x8bbf4484b6b1852c
-DATA ·precomputed<>+0x828(SB)/8, $0xcd02ed40e78ff07
-DATA ·precomputed<>+0x830(SB)/8, $0x91cb827e49c24238
-DATA ·precomputed<>+0x838(SB)/8, $0x58adaee5daa3cb55
-DATA ·precomputed<>+0x840(SB)/8, $0x7e6ce4d033fc12a
-DATA ·precomputed<>+0x848(SB)/8, $0xba4f98a14886f316
-DATA ·precomputed<>+0x850(SB)/8, $0xb24b38f3e66f3f11
-DATA ·precomputed<>+0x858(SB)/8, $0xe3f6205a5ea4bde3
-DATA ·precomputed<>+0x860(SB)/8, $0x705387a77b998f
-DATA ·precomputed<>+0x868(SB)/8, $0x2c9b44579549f3b1
-DATA ·precomputed<>+0x870(SB)/8, $0xdef6625b533a61d6
-DATA ·precomputed<>+0x878(SB)/8, $0x4eda7e2a7e4f781a
-DATA ·precomputed<>+0x880(SB)/8, $0x483a080d9e82eba8
-DATA ·precomputed<>+0x888(SB)/8, $0xce1971bbbf5145e8
-DATA ·precomputed<>+0x890(SB)/8, $0xafb346e3a014c2e6
-DATA ·precomputed<>+0x898(SB)/8, $0xc38daaa32414afeb
-DATA ·precomputed<>+0x8a0(SB)/8, $0x70cb2c723f42b286
-DATA ·precomputed<>+0x8a8(SB)/8, $0x14500e25625080
-DATA ·precomputed<>+0x8b0(SB)/8, $0xd9f0ef435b518c82
-DATA ·precomputed<>+0x8b8(SB)/8, $0x87c753e1813f39b3
-DATA ·precomputed<>+0x8c0(SB)/8, $0xe9730aaafd120134
-DATA ·precomputed<>+0x8c8(SB)/8, $0xb22b9089c057309c
-DATA ·precomputed<>+0x8d0(SB)/8, $0x98e7956584726ce7
-DATA ·precomputed<>+0x8d8(SB)/8, $0xe1431a0d635a584
-DATA ·precomputed<>+0x8e0(SB)/8, $0xbd387023e834ffa6
-DATA ·precomputed<>+0x8e8(SB)/8, $0x64198ddf036ab1ae
-DATA ·precomputed<>+0x8f0(SB)/8, $0x46e5ebb19124b684
-DATA ·precomputed<>+0x8f8(SB)/8, $0xa316fa44233b3c6d
-DATA ·precomputed<>+0x900(SB)/8, $0xcb2f94161d0195d9
-DATA ·precomputed<>+0x908(SB)/8, $0xaf18fea52d23ea78
-DATA ·precomputed<>+0x910(SB)/8, $0x9af21137a65de11e
-DATA ·precomputed<>+0x918(SB)/8, $0xf9bb3d00b328c4c4
-DATA ·precomputed<>+0x920(SB)/8, $0x37dd2b79dedb9cd1
-DATA ·precomputed<>+0x928(SB)/8, $0x6c8c9047f4dc56b7
-DATA ·precomputed<>+0x930(SB)/8, $0xedcb8ef03d88e77
-DATA ·precomputed<>+0x938(SB)/8, $0xc23784a3ace70471
-DATA ·precomputed<>+0x940(SB)/8, $0xec2a932584782513
-DATA ·precomputed<>+0x948(SB)/8, $0xd67c8ab72903d20b
-DATA ·precomputed<>+0x950(SB)/8, $0x6b65b262157f9aee
-DATA ·precomputed<>+0x958(SB)/8, $0x547be60c69f964a2
-DATA ·precomputed<>+0x960(SB)/8, $0x1bf327ee0419db
-DATA ·precomputed<>+0x968(SB)/8, $0x92fa0800f20c7005
-DATA ·precomputed<>+0x970(SB)/8, $0x1e11e745cdc1ccda
-DATA ·precomputed<>+0x978(SB)/8, $0xa785ec10e471f822
-DATA ·precomputed<>+0x980(SB)/8, $0xfc25ee5d2e694eee
-DATA ·precomputed<>+0x988(SB)/8, $0x679dd524fa668d58
-DATA ·precomputed<>+0x990(SB)/8, $0x3a0b07f03c02d6b1
-DATA ·precomputed<>+0x998(SB)/8, $0xb80f3dcf869c0ff6
-DATA ·precomputed<>+0x9a0(SB)/8, $0x60d2951eab28cdcd
-DATA ·precomputed<>+0x9a8(SB)/8, $0x6a47780d079b7e3d
-DATA ·precomputed<>+0x9b0(SB)/8, $0xb824635cabab337
-DATA ·precomputed<>+0x9b8(SB)/8, $0xf6279a015fbd140d
-DATA ·precomputed<>+0x9c0(SB)/8, $0xbc970210a1371aa4
-DATA ·precomputed<>+0x9c8(SB)/8, $0xaff481a054b5424e
-DATA ·precomputed<>+0x9d0(SB)/8, $0xbcdf91fd0e64269b
-DATA ·precomputed<>+0x9d8(SB)/8, $0x18bb37bbb02fc7cf
-DATA ·precomputed<>+0x9e0(SB)/8, $0xd99edd796f69d439
-DATA ·precomputed<>+0x9e8(SB)/8, $0x4e27a58f169514b2
-DATA ·precomputed<>+0x9f0(SB)/8, $0x80eca1ca66e19ae4
-DATA ·precomputed<>+0x9f8(SB)/8, $0x470e9650788696d
-DATA ·precomputed<>+0xa00(SB)/8, $0x309a105bb16512c7
-DATA ·precomputed<>+0xa08(SB)/8, $0xf7fdd288fccbb41c
-DATA ·precomputed<>+0xa10(SB)/8, $0xa21c9be3ff543b99
-DATA ·precomputed<>+0xa18(SB)/8, $0xe974c3cf34770daf
-DATA ·precomputed<>+0xa20(SB)/8, $0x8b5ad24702f4bc50
-DATA ·precomputed<>+0xa28(SB)/8, $0xef8524c2cbb81e3c
-DATA ·precomputed<>+0xa30(SB)/8, $0xf36d956456dd9c9e
-DATA ·precomputed<>+0xa38(SB)/8, $0x4cb230702b0eac5
-DATA ·precomputed<>+0xa40(SB)/8, $0xa6b1992f8c9d34f6
-DATA ·precomputed<>+0xa48(SB)/8, $0xaf062ffea5ed969e
-DATA ·precomputed<>+0xa50(SB)/8, $0xbca2580d3a6d7ae2
-DATA ·precomputed<>+0xa58(SB)/8, $0xf30cd9e6c8999158
-DATA ·precomputed<>+0xa60(SB)/8, $0x93e5789749d1ab0d
-DATA ·precomputed<>+0xa68(SB)/8, $0xcfa3aa4d30214280
-DATA ·precomputed<>+0xa70(SB)/8, $0xca8b4fd0a814831
-DATA ·precomputed<>+0xa78(SB)/8, $0xdad179db0b10097c
-DATA ·precomputed<>+0xa80(SB)/8, $0xca1eed07d9b11bfa
-DATA ·precomputed<>+0xa88(SB)/8, $0x32033d0a54662ba2
-DATA ·precomputed<>+0xa90(SB)/8, $0xb5a5c94d78568288
-DATA ·precomputed<>+0xa98(SB)/8, $0xbdf5b6772ecd623c
-DATA ·precomputed<>+0xaa0(SB)/8, $0xff3e3194571adda7
-DATA ·precomputed<>+0xaa8(SB)/8, $0xb551b2abf2075dfe
-DATA ·precomputed<>+0xab0(SB)/8, $0xeb4d158fd0f29abd
-DATA ·precomputed<>+0xab8(SB)/8, $0xaa7e2a05464f5b4c
-DATA ·precomputed<>+0xac0(SB)/8, $0x63778bfc3dfdc228
-DATA ·precomputed<>+0xac8(SB)/8, $0xc0bae0adb9648a36
-DATA ·precomputed<>+0xad0(SB)/8, $0xda8cb8ab015a99b5
-DATA ·precomputed<>+0xad8(SB)/8, $0xb045cccb8366b58a
-DATA ·precomputed<>+0xae0(SB)/8, $0x74ef8ef44164cebd
-DATA ·precomputed<>+0xae8(SB)/8, $0x41e71fc8c5e00e5f
-DATA ·precomputed<>+0xaf0(SB)/8, $0x753cf9064479468e
-DATA ·precomputed<>+0xaf8(SB)/8, $0x78b5223f332ea72d
-DATA ·precomputed<>+0xb00(SB)/8, $0x78d3ad48b11c1780
-DATA ·precomputed<>+0xb08(SB)/8, $0x3ae27490dc70df6c
-DATA ·precomputed<>+0xb10(SB)/8, $0x299a0f3e42d4844f
-DATA ·precomputed<>+0xb18(SB)/8, $0x409ceccb391441e1
-DATA ·precomputed<>+0xb20(SB)/8, $0xfac8962964de0712
-DATA ·precomputed<>+0xb28(SB)/8, $0x7ddf1ede68237d49
-DATA ·precomputed<>+0xb30(SB)/8, $0x63707c44ecf54def
-DATA ·precomputed<>+0xb38(SB)/8, $0xdaecf50662841fff
-DATA ·precomputed<>+0xb40(SB)/8, $0x8fc3e370ddebafa2
-DATA ·precomputed<>+0xb48(SB)/8, $0x15ffcce0351f9f04
-DATA ·precomputed<>+0xb50(SB)/8, $0x3fbd5f5c45b0efdc
-DATA ·precomputed<>+0xb58(SB)/8, $0xb82166230fe3b460
-DATA ·precomputed<>+0xb60(SB)/8, $0xe8322fbd533c7db6
-DATA ·precomputed<>+0xb68(SB)/8, $0xf3866d1500a243ff
-DATA ·precomputed<>+0xb70(SB)/8, $0xf1194ae2a0e5aaea
-DATA ·precomputed<>+0xb78(SB)/8, $0x3e93eb01b9287b3d
-DATA ·precomputed<>+0xb80(SB)/8, $0x330107a6f3829f18
-DATA ·precomputed<>+0xb88(SB)/8, $0x1c2c488f105ed546
-DATA ·precomputed<>+0xb90(SB)/8, $0x2c0a6637ff2137aa
-DATA ·precomputed<>+0xb98(SB)/8, $0xc29d98228f6dfa1a
-DATA ·precomputed<>+0xba0(SB)/8, $0xbeae532bd57b3b68
-DATA ·precomputed<>+0xba8(SB)/8, $0x3956fa8523088b02
-DATA ·precomputed<>+0xbb0(SB)/8, $0x7a1512e8fae0ca9b
-DATA ·precomputed<>+0xbb8(SB)/8, $0x5e43d9867589d3ec
-DATA ·precomputed<>+0xbc0(SB)/8, $0x528a9e2f5876d6e8
-DATA ·precomputed<>+0xbc8(SB)/8, $0x93c48f85d2b622d7
-DATA ·precomputed<>+0xbd0(SB)/8, $0x88d9eac83e5411d7
-DATA ·precomputed<>+0xbd8(SB)/8, $0xb7e4a6ba00a70e91
-DATA ·precomputed<>+0xbe0(SB)/8, $0xaf18e5baf1c43b2e
-DATA ·precomputed<>+0xbe8(SB)/8, $0x46578c7ea2f347de
-DATA ·precomputed<>+0xbf0(SB)/8, $0x19ca736df009638f
-DATA ·precomputed<>+0xbf8(SB)/8, $0xa6563f1ebd1acd29
-DATA ·precomputed<>+0xc00(SB)/8, $0x7d3e654ce6f8f771
-DATA ·precomputed<>+0xc08(SB)/8, $0xe58dfa213dce90ce
-DATA ·precomputed<>+0xc10(SB)/8, $0xfa95a5dd3a6b5505
-DATA ·precomputed<>+0xc18(SB)/8, $0x4737caf5a81d1288
-DATA ·precomputed<>+0xc20(SB)/8, $0xdfa2869fd4480cdc
-DATA ·precomputed<>+0xc28(SB)/8, $0x38f46134b29d9903
-DATA ·precomputed<>+0xc30(SB)/8, $0x3bea93f6066f2743
-DATA ·precomputed<>+0xc38(SB)/8, $0xcd325bcdc2a60973
-DATA ·precomputed<>+0xc40(SB)/8, $0xdf9bcd3a2f4126e7
-DATA ·precomputed<>+0xc48(SB)/8, $0xecc22d13d62efebd
-DATA ·precomputed<>+0xc50(SB)/8, $0xd9b29b4b10943242
-DATA ·precomputed<>+0xc58(SB)/8, $0x499ffa74670136f9
-DATA ·precomputed<>+0xc60(SB)/8, $0xa2a9ad2c2b889952
-DATA ·precomputed<>+0xc68(SB)/8, $0x945f306efd132dad
-DATA ·precomputed<>+0xc70(SB)/8, $0xfd05b88415cebfd7
-DATA ·precomputed<>+0xc78(SB)/8, $0x653e70afc7a5627f
-DATA ·precomputed<>+0xc80(SB)/8, $0x843c11584828b4ed
-DATA ·precomputed<>+0xc88(SB)/8, $0xb845592a7e3f9273
-DATA ·precomputed<>+0xc90(SB)/8, $0xe4e79adbf4a4cb89
-DATA ·precomputed<>+0xc98(SB)/8, $0x868cf480b45a8dee
-DATA ·precomputed<>+0xca0(SB)/8, $0xff43df3003e7679e
-DATA ·precomputed<>+0xca8(SB)/8, $0x8126ef3a194c52f4
-DATA ·precomputed<>+0xcb0(SB)/8, $0x527593b7b41057cd
-DATA ·precomputed<>+0xcb8(SB)/8, $0x781f2a59c32223ba
-DATA ·precomputed<>+0xcc0(SB)/8, $0xfefc54b5577dae35
-DATA ·precomputed<>+0xcc8(SB)/8, $0x9d2f0546aac3a655
-DATA ·precomputed<>+0xcd0(SB)/8, $0xb96bd298fac31d00
-DATA ·precomputed<>+0xcd8(SB)/8, $0x3328a51cee69563d
-DATA ·precomputed<>+0xce0(SB)/8, $0x5e19098e43195f4e
-DATA ·precomputed<>+0xce8(SB)/8, $0x657f4ba5a998010b
-DATA ·precomputed<>+0xcf0(SB)/8, $0x45f29a844047ccb6
-DATA ·precomputed<>+0xcf8(SB)/8, $0x833a54436e423da6
-DATA ·precomputed<>+0xd00(SB)/8, $0x90addbe0418eed4
-DATA ·precomputed<>+0xd08(SB)/8, $0xdc95967522c512b0
-DATA ·precomputed<>+0xd10(SB)/8, $0xd9c825bd496b6ab6
-DATA ·precomputed<>+0xd18(SB)/8, $0x6ac6863cafbe9072
-DATA ·precomputed<>+0xd20(SB)/8, $0x76179c8168741539
-DATA ·precomputed<>+0xd28(SB)/8, $0xbbd0dcc2554c1c1e
-DATA ·precomputed<>+0xd30(SB)/8, $0xc550795adf7d4dbc
-DATA ·precomputed<>+0xd38(SB)/8, $0xcdf1a9ad38b651dd
-DATA ·precomputed<>+0xd40(SB)/8, $0x97e480c6ca33f42b
-DATA ·precomputed<>+0xd48(SB)/8, $0x20a5103306e52a05
-DATA ·precomputed<>+0xd50(SB)/8, $0x85e872550a9be572
-DATA ·precomputed<>+0xd58(SB)/8, $0xe8bc857ab988b582
-DATA ·precomputed<>+0xd60(SB)/8, $0x782495e8c183c500
-DATA ·precomputed<>+0xd68(SB)/8, $0xf33a87fdfee0ae2f
-DATA ·precomputed<>+0xd70(SB)/8, $0xf069fe20c64d1dec
-DATA ·precomputed<>+0xd78(SB)/8, $0xb6dd98af4b08816
-DATA ·precomputed<>+0xd80(SB)/8, $0xb99055ecfaaf3c36
-DATA ·precomputed<>+0xd88(SB)/8, $0xd770f098606a1b4
-DATA ·precomputed<>+0xd90(SB)/8, $0x9f98a6c0fc7608ef
-DATA ·precomputed<>+0xd98(SB)/8, $0x734ba34a4f7a3de3
-DATA ·precomputed<>+0xda0(SB)/8, $0x97f8a5c72cdd3f6b
-DATA ·precomputed<>+0xda8(SB)/8, $0x178a4bae13a191b8
-DATA ·precomputed<>+0xdb0(SB)/8, $0xcbcbc28c051a3f93
-DATA ·precomputed<>+0xdb8(SB)/8, $0xc8c50dcae28c865b
-DATA ·precomputed<>+0xdc0(SB)/8, $0x6e6cf29899229a90
-DATA ·precomputed<>+0xdc8(SB)/8, $0xa6840bc81d71d532
-DATA ·precomputed<>+0xdd0(SB)/8, $0x803e540771e3a8b7
-DATA ·precomputed<>+0xdd8(SB)/8, $0xd5611ee46afd9a0e
-DATA ·precomputed<>+0xde0(SB)/8, $0xd739ca0ebbbefa73
-DATA ·precomputed<>+0xde8(SB)/8, $0x6082dbabc5ec48b7
-DATA ·precomputed<>+0xdf0(SB)/8, $0xa0ab10dfbbdea0ec
-DATA ·precomputed<>+0xdf8(SB)/8, $0xb1b7ebe4f1633e03
-DATA ·precomputed<>+0xe00(SB)/8, $0xbaaecd8d036838fd
-DATA ·precomputed<>+0xe08(SB)/8, $0xdf9cb397abaa4877
-DATA ·precomputed<>+0xe10(SB)/8, $0x8b74c4fb73b0ad37
-DATA ·precomputed<>+0xe18(SB)/8, $0x698fe415b57ecdee
-DATA ·precomputed<>+0xe20(SB)/8, $0x6ccbe68e84de25ef
-DATA ·precomputed<>+0xe28(SB)/8, $0x8f6e8658eb14ae02
-DATA ·precomputed<>+0xe30(SB)/8, $0x21f958da22f5f353
-DATA ·precomputed<>+0xe38(SB)/8, $0xfd8cc06fdc7e384
-DATA ·precomputed<>+0xe40(SB)/8, $0xfa7524967be26441
-DATA ·precomputed<>+0xe48(SB)/8, $0xf52cb1b60ef683e6
-DATA ·precomputed<>+0xe50(SB)/8, $0x1c96401f39dd611d
-DATA ·precomputed<>+0xe58(SB)/8, $0x9c5a35b7bb19083
-DATA ·precomputed<>+0xe60(SB)/8, $0xa2f002b800a5d5a1
-DATA ·precomputed<>+0xe68(SB)/8, $0x4e300dddacf4e8ed
-DATA ·precomputed<>+0xe70(SB)/8, $0xd26b600b4cc58c6
-DATA ·precomputed<>+0xe78(SB)/8, $0x5a53863a50062651
-DATA ·precomputed<>+0xe80(SB)/8, $0x2e43b703cdaa3907
-DATA ·precomputed<>+0xe88(SB)/8, $0xe184a2f393dac765
-DATA ·precomputed<>+0xe90(SB)/8, $0xefcf2495411852c9
-DATA ·precomputed<>+0xe98(SB)/8, $0x5760e0ce0f76e020
-DATA ·precomputed<>+0xea0(SB)/8, $0xf8ebc66633fa069b
-DATA ·precomputed<>+0xea8(SB)/8, $0xf377b3071eb68df2
-DATA ·precomputed<>+0xeb0(SB)/8, $0x7ba4ac1b426038be
-DATA ·precomputed<>+0xeb8(SB)/8, $0x4fe06322c8557a5a
-DATA ·precomputed<>+0xec0(SB)/8, $0x62e64475ad1cac22
-DATA ·precomputed<>+0xec8(SB)/8, $0x2008653ec7e11395
-DATA ·precomputed<>+0xed0(SB)/8, $0xa875ad01d9479c4a
-DATA ·precomputed<>+0xed8(SB)/8, $0x3e6cf633804b30d1
-DATA ·precomputed<>+0xee0(SB)/8, $0x58b3ef6eb6b06e46
-DATA ·precomputed<>+0xee8(SB)/8, $0x74c45dbef7b8410b
-DATA ·precomputed<>+0xef0(SB)/8, $0x2675759c278458d
-DATA ·precomputed<>+0xef8(SB)/8, $0xb2ef4956acd30bd1
-DATA ·precomputed<>+0xf00(SB)/8, $0xd65ec893ffb31de6
-DATA ·precomputed<>+0xf08(SB)/8, $0xeddb6698708cb34f
-DATA ·precomputed<>+0xf10(SB)/8, $0xe4aa6a878832be62
-DATA ·precomputed<>+0xf18(SB)/8, $0xb10ff454887da089
-DATA ·precomputed<>+0xf20(SB)/8, $0xd7d9eed42c19a025
-DATA ·precomputed<>+0xf28(SB)/8, $0x63c80ed1bf9d4fe2
-DATA ·precomputed<>+0xf30(SB)/8, $0x574932233d0cdb08
-DATA ·precomputed<>+0xf38(SB)/8, $0x4824e12bbe764ac5
-DATA ·precomputed<>+0xf40(SB)/8, $0x1a4a5773339aae8d
-DATA ·precomputed<>+0xf48(SB)/8, $0xa775b9520c0fe175
-DATA ·precomputed<>+0xf50(SB)/8, $0x7b39ac1b5d5d5ac1
-DATA ·precomputed<>+0xf58(SB)/8, $0x3f183d4911a511b6
-DATA ·precomputed<>+0xf60(SB)/8, $0x9524e286045ac045
-DATA ·precomputed<>+0xf68(SB)/8, $0x498d2964934c52f
-DATA ·precomputed<>+0xf70(SB)/8, $0x1fec54749b636528
-DATA ·precomputed<>+0xf78(SB)/8, $0xec6f7a37c3e9b84b
-DATA ·precomputed<>+0xf80(SB)/8, $0x791f93f67a86edab
-DATA ·precomputed<>+0xf88(SB)/8, $0x23cf8b8e18598ddc
-DATA ·precomputed<>+0xf90(SB)/8, $0xc5b46933408ace7f
-DATA ·precomputed<>+0xf98(SB)/8, $0x3f0adb0655676d71
-DATA ·precomputed<>+0xfa0(SB)/8, $0x837bea99e611fc58
-DATA ·precomputed<>+0xfa8(SB)/8, $0x25b2797ddc83d98b
-DATA ·precomputed<>+0xfb0(SB)/8, $0xadc2aa2ca2650d84
-DATA ·precomputed<>+0xfb8(SB)/8, $0x2422557ba414defd
-DATA ·precomputed<>+0xfc0(SB)/8, $0x870b12dd12ee579d
-DATA ·precomputed<>+0xfc8(SB)/8, $0x2a9a12ab06dd62d6
-DATA ·precomputed<>+0xfd0(SB)/8, $0xbcd52599071d7582
-DATA ·precomputed<>+0xfd8(SB)/8, $0x7a36193aa869c457
-DATA ·precomputed<>+0xfe0(SB)/8, $0xd29e6592e976ae5b
-DATA ·precomputed<>+0xfe8(SB)/8, $0xe82c8712adfecd58
-DATA ·precomputed<>+0xff0(SB)/8, $0xbc83a440f714686d
-DATA ·precomputed<>+0xff8(SB)/8, $0xfe19344a0c21e3ba
-DATA ·precomputed<>+0x1000(SB)/8, $0x1f41010b2c439171
-DATA ·precomputed<>+0x1008(SB)/8, $0x3ff85ee6e8139388
-DATA ·precomputed<>+0x1010(SB)/8, $0x4ada4c7d8f077633
-DATA ·precomputed<>+0x1018(SB)/8, $0x9976011a824e6023
-DATA ·precomputed<>+0x1020(SB)/8, $0xa2501197eaf49f63
-DATA ·precomputed<>+0x1028(SB)/8, $0xdff2122fd60b0c4c
-DATA ·precomputed<>+0x1030(SB)/8, $0x1a6a3abbbab3df90
-DATA ·precomputed<>+0x1038(SB)/8, $0x854bbcc6b66ffd5f
-DATA ·precomputed<>+0x1040(SB)/8, $0xbb45c28718d13bd3
-DATA ·precomputed<>+0x1048(SB)/8, $0xbbf3a89423c6dd1a
-DATA ·precomputed<>+0x1050(SB)/8, $0xc8171c5e13b9cf87
-DATA ·precomputed<>+0x1058(SB)/8, $0x2dfc779234f5348d
-DATA ·precomputed<>+0x1060(SB)/8, $0x9b9a662d985cabd4
-DATA ·precomputed<>+0x1068(SB)/8, $0x588a6ebc4d971de0
-DATA ·precomputed<>+0x1070(SB)/8, $0xda9fd894574cba64
-DATA ·precomputed<>+0x1078(SB)/8, $0x7e0f0cca651e6e67
-DATA ·precomputed<>+0x1080(SB)/8, $0x274c572f9f177d65
-DATA ·precomputed<>+0x1088(SB)/8, $0xc39e9e152c2394c4
-DATA ·precomputed<>+0x1090(SB)/8, $0xc9e7b18c1e307ad6
-DATA ·precomputed<>+0x1098(SB)/8, $0x33da596c4c22d9c8
-DATA ·precomputed<>+0x10a0(SB)/8, $0x5c300e12b74b9c00
-DATA ·precomputed<>+0x10a8(SB)/8, $0x5f434a9d59f5d134
-DATA ·precomputed<>+0x10b0(SB)/8, $0x5b872943719e8442
-DATA ·precomputed<>+0x10b8(SB)/8, $0xc0164ba48acd11c3
-DATA ·precomputed<>+0x10c0(SB)/8, $0x88ca276c4b8bceb8
-DATA ·precomputed<>+0x10c8(SB)/8, $0x6d4ec101752d1106
-DATA ·precomputed<>+0x10d0(SB)/8, $0x2ad98063f834dcbf
-DATA ·precomputed<>+0x10d8(SB)/8, $0x4da81d19dfff19d0
-DATA ·precomputed<>+0x10e0(SB)/8, $0x4ccc7cd23a9828ff
-DATA ·precomputed<>+0x10e8(SB)/8, $0xf1e389b02e64b332
-DATA ·precomputed<>+0x10f0(SB)/8, $0xe2fb6a6c7308b81c
-DATA ·precomputed<>+0x10f8(SB)/8, $0xc6df66b25bcc0ac6
-DATA ·precomputed<>+0x1100(SB)/8, $0x42f029d74220fbbf
-DATA ·precomputed<>+0x1108(SB)/8, $0x405e1d84009ba10e
-DATA ·precomputed<>+0x1110(SB)/8, $0xe53bd89048858df9
-DATA ·precomputed<>+0x1118(SB)/8, $0xfd7bc7a945dabb23
-DATA ·precomputed<>+0x1120(SB)/8, $0x6e76f3807f06c21c
-DATA ·precomputed<>+0x1128(SB)/8, $0x616f89c04324828f
-DATA ·precomputed<>+0x1130(SB)/8, $0xe30abf04a517d5a3
-DATA ·precomputed<>+0x1138(SB)/8, $0x7714fd2d9d0db60f
-DATA ·precomputed<>+0x1140(SB)/8, $0x40a313306dccac08
-DATA ·precomputed<>+0x1148(SB)/8, $0xb67ab624f610b074
-DATA ·precomputed<>+0x1150(SB)/8, $0x6fd86597e7edd616
-DATA ·precomputed<>+0x1158(SB)/8, $0x129abb54af0c4666
-DATA ·precomputed<>+0x1160(SB)/8, $0x2feff5f3c3ca87bd
-DATA ·precomputed<>+0x1168(SB)/8, $0xbee8fe92dde60e83
-DATA ·precomputed<>+0x1170(SB)/8, $0x51c192c98477b8c7
-DATA ·precomputed<>+0x1178(SB)/8, $0x2b0bc40c23995302
-DATA ·precomputed<>+0x1180(SB)/8, $0x567fe53d299f5d07
-DATA ·precomputed<>+0x1188(SB)/8, $0xb1afc7c5c132e675
-DATA ·precomputed<>+0x1190(SB)/8, $0x8ac9a604647ce589
-DATA ·precomputed<>+0x1198(SB)/8, $0x454e6274c41846d1
-DATA ·precomputed<>+0x11a0(SB)/8, $0x663b160130ed07db
-DATA ·precomputed<>+0x11a8(SB)/8, $0x648bea2292bd8860
-DATA ·precomputed<>+0x11b0(SB)/8, $0xdef2b055c569a710
-DATA ·precomputed<>+0x11b8(SB)/8, $0xba36e6254096bc83
-DATA ·precomputed<>+0x11c0(SB)/8, $0x5ccb8c75e1c58c80
-DATA ·precomputed<>+0x11c8(SB)/8, $0x2ba9de0483fcc95a
-DATA ·precomputed<>+0x11d0(SB)/8, $0xccdeb0eedfccbcf9
-DATA ·precomputed<>+0x11d8(SB)/8, $0x1d667d4f70f3d3ad
-DATA ·precomputed<>+0x11e0(SB)/8, $0xc6aa14a536269820
-DATA ·precomputed<>+0x11e8(SB)/8, $0x329a308b0fe87940
-DATA ·precomputed<>+0x11f0(SB)/8, $0x39869970ede5cfb2
-DATA ·precomputed<>+0x11f8(SB)/8, $0xc33c3068f601bb2c
-DATA ·precomputed<>+0x1200(SB)/8, $0x1b7e8f2a8dcde5c1
-DATA ·precomputed<>+0x1208(SB)/8, $0x5c7234cd09ca0072
-DATA ·precomputed<>+0x1210(SB)/8, $0x65868aac62e9ca38
-DATA ·precomputed<>+0x1218(SB)/8, $0xf03c3eee969714cd
-DATA ·precomputed<>+0x1220(SB)/8, $0x55a8b417b41f60bb
-DATA ·precomputed<>+0x1228(SB)/8, $0x2d2f1283f1c4a317
-DATA ·precomputed<>+0x1230(SB)/8, $0x4a363345a25a5e99
-DATA ·precomputed<>+0x1238(SB)/8, $0xecb3941a69e6df0
-DATA ·precomputed<>+0x1240(SB)/8, $0x158037194d27d138
-DATA ·precomputed<>+0x1248(SB)/8, $0x34886d4179c22772
-DATA ·precomputed<>+0x1250(SB)/8, $0x552b4e54de752a1e
-DATA ·precomputed<>+0x1258(SB)/8, $0xb3e41e4fb08df41
-DATA ·precomputed<>+0x1260(SB)/8, $0x71e398c5b2771eb4
-DATA ·precomputed<>+0x1268(SB)/8, $0xcaed5324dcad8cfa
-DATA ·precomputed<>+0x1270(SB)/8, $0xe42cb7d584c1a1d5
-DATA ·precomputed<>+0x1278(SB)/8, $0x8ff828bc3bdf08a8
-DATA ·precomputed<>+0x1280(SB)/8, $0xb1ed6d0284f7055b
-DATA ·precomputed<>+0x1288(SB)/8, $0xc5390b2b09023bb1
-DATA ·precomputed<>+0x1290(SB)/8, $0xa32f6561f833ab8a
-DATA ·precomputed<>+0x1298(SB)/8, $0x1d747c4db7934e03
-DATA ·precomputed<>+0x12a0(SB)/8, $0x113ba0dad08290c1
-DATA ·precomputed<>+0x12a8(SB)/8, $0x44dc48efba88556d
-DATA ·precomputed<>+0x12b0(SB)/8, $0xda059e52493ae9e1
-DATA ·precomputed<>+0x12b8(SB)/8, $0xfbb6f3136919c2c1
-DATA ·precomputed<>+0x12c0(SB)/8, $0x3087444aa1a8781b
-DATA ·precomputed<>+0x12c8(SB)/8, $0x6cb5b7065cff3cbf
-DATA ·precomputed<>+0x12d0(SB)/8, $0x7673a8e483082714
-DATA ·precomputed<>+0x12d8(SB)/8, $0xc4bce0150842a792
-DATA ·precomputed<>+0x12e0(SB)/8, $0xae71a03353e2a531
-DATA ·precomputed<>+0x12e8(SB)/8, $0x147b28f88b5315f9
-DATA ·precomputed<>+0x12f0(SB)/8, $0xcc4601336c5ab37a
-DATA ·precomputed<>+0x12f8(SB)/8, $0xb1dd088b540dde16
-DATA ·precomputed<>+0x1300(SB)/8, $0x412c7ec144eb01d
-DATA ·precomputed<>+0x1308(SB)/8, $0x7aab91d6c89c00e4
-DATA ·precomputed<>+0x1310(SB)/8, $0x617ce2df0a1057e3
-DATA ·precomputed<>+0x1318(SB)/8, $0x2d2eb93d844892c9
-DATA ·precomputed<>+0x1320(SB)/8, $0xc3669101f7a5c78c
-DATA ·precomputed<>+0x1328(SB)/8, $0xa5ccb6f277f1146b
-DATA ·precomputed<>+0x1330(SB)/8, $0x306c7cf19e58ad6d
-DATA ·precomputed<>+0x1338(SB)/8, $0xbee5266289fd9e9f
-DATA ·precomputed<>+0x1340(SB)/8, $0x37f31dfcafdb1b1f
-DATA ·precomputed<>+0x1348(SB)/8, $0xa8dd77fa7421422b
-DATA ·precomputed<>+0x1350(SB)/8, $0xb5837fa2da3f89c
-DATA ·precomputed<>+0x1358(SB)/8, $0xfa4782238949b398
-DATA ·precomputed<>+0x1360(SB)/8, $0x20559cc41ad42299
-DATA ·precomputed<>+0x1368(SB)/8, $0xecf3716451207a28
-DATA ·precomputed<>+0x1370(SB)/8, $0x4171e517ec007907
-DATA ·precomputed<>+0x1378(SB)/8, $0x8eb7df5ad372fa1a
-DATA ·precomputed<>+0x1380(SB)/8, $0x1fdf3c9b45a5e1e
-DATA ·precomputed<>+0x1388(SB)/8, $0x549050bfee36382c
-DATA ·precomputed<>+0x1390(SB)/8, $0xaf4692573947529a
-DATA ·precomputed<>+0x1398(SB)/8, $0x61416b449d3625c1
-DATA ·precomputed<>+0x13a0(SB)/8, $0x38dcd6eb7c4ea684
-DATA ·precomputed<>+0x13a8(SB)/8, $0xe899233b15742e16
-DATA ·precomputed<>+0x13b0(SB)/8, $0xfc074e422175e077
-DATA ·precomputed<>+0x13b8(SB)/8, $0xaaffcbe3883417e7
-DATA ·precomputed<>+0x13c0(SB)/8, $0xec25045511c09289
-DATA ·precomputed<>+0x13c8(SB)/8, $0x83042ba7164079c9
-DATA ·precomputed<>+0x13d0(SB)/8, $0x4881640c6e3879a2
-DATA ·precomputed<>+0x13d8(SB)/8, $0x77c5babc802452ee
-DATA ·precomputed<>+0x13e0(SB)/8, $0x7a7759a67088f360
-DATA ·precomputed<>+0x13e8(SB)/8, $0x2da352cb74be7e9
-DATA ·precomputed<>+0x13f0(SB)/8, $0x15800cdbe0338289
-DATA ·precomputed<>+0x13f8(SB)/8, $0xad69f7c9501688c6
-DATA ·precomputed<>+0x1400(SB)/8, $0x59107f1d23fe3e61
-DATA ·precomputed<>+0x1408(SB)/8, $0xdd25cd1560bb18a3
-DATA ·precomputed<>+0x1410(SB)/8, $0x1cc12674b8d6e174
-DATA ·precomputed<>+0x1418(SB)/8, $0x99ecc5977e5df32e
-DATA ·precomputed<>+0x1420(SB)/8, $0x8bad549e69c6d88
-DATA ·precomputed<>+0x1428(SB)/8, $0x81aac6de548f79a2
-DATA ·precomputed<>+0x1430(SB)/8, $0x770bf932c73a1890
-DATA ·precomputed<>+0x1438(SB)/8, $0x4d0b942eed3a77ce
-DATA ·precomputed<>+0x1440(SB)/8, $0x3399206a1820ed07
-DATA ·precomputed<>+0x1448(SB)/8, $0x295b1770ec6ef2f7
-DATA ·precomputed<>+0x1450(SB)/8, $0x490529e275bf28a3
-DATA ·precomputed<>+0x1458(SB)/8, $0x630ad28a83469fd5
-DATA ·precomputed<>+0x1460(SB)/8, $0x3070e3c42ecae459
-DATA ·precomputed<>+0x1468(SB)/8, $0x98879977e24f8e10
-DATA ·precomputed<>+0x1470(SB)/8, $0x45a30a525c293cce
-DATA ·precomputed<>+0x1478(SB)/8, $0xadfc522fef57c273
-DATA ·precomputed<>+0x1480(SB)/8, $0x24b3833541eb6126
-DATA ·precomputed<>+0x1488(SB)/8, $0x2cafc696144df0e2
-DATA ·precomputed<>+0x1490(SB)/8, $0x97eb13090384d899
-DATA ·precomputed<>+0x1498(SB)/8, $0xa288af3323541ada
-DATA ·precomputed<>+0x14a0(SB)/8, $0x486c954ccf959aed
-DATA ·precomputed<>+0x14a8(SB)/8, $0x6dfb82b6eac463c8
-DATA ·precomputed<>+0x14b0(SB)/8, $0x8108978f5664a71b
-DATA ·precomputed<>+0x14b8(SB)/8, $0xcdcc34dd07259497
-DATA ·precomputed<>+0x14c0(SB)/8, $0xb7d3506357ae1213
-DATA ·precomputed<>+0x14c8(SB)/8, $0xd536753a97024ecd
-DATA ·precomputed<>+0x14d0(SB)/8, $0x9d68071624938196
-DATA ·precomputed<>+0x14d8(SB)/8, $0xac1bee4c44ed6d4e
-DATA ·precomputed<>+0x14e0(SB)/8, $0x6dd9c9bf33e95503
-DATA ·precomputed<>+0x14e8(SB)/8, $0x5ee9f1fd88fc1c3d
-DATA ·precomputed<>+0x14f0(SB)/8, $0x4a701ff421654473
-DATA ·precomputed<>+0x14f8(SB)/8, $0x9a316450bd2ffe36
-DATA ·precomputed<>+0x1500(SB)/8, $0xff6adcfb64a39581
-DATA ·precomputed<>+0x1508(SB)/8, $0xfc87f8a6217c490
-DATA ·precomputed<>+0x1510(SB)/8, $0xb5cb806904eadb9b
-DATA ·precomputed<>+0x1518(SB)/8, $0xed5990edc6bba018
-DATA ·precomputed<>+0x1520(SB)/8, $0xf2f47a0e677189bb
-DATA ·precomputed<>+0x1528(SB)/8, $0x2b87aea133191c76
-DATA ·precomputed<>+0x1530(SB)/8, $0xb7f3f3c8713fcadd
-DATA ·precomputed<>+0x1538(SB)/8, $0xca18c70887857b7d
-DATA ·precomputed<>+0x1540(SB)/8, $0xefe68dc9444feac0
-DATA ·precomputed<>+0x1548(SB)/8, $0x4c45d402aad0d577
-DATA ·precomputed<>+0x1550(SB)/8, $0x735178ba3e1393d0
-DATA ·precomputed<>+0x1558(SB)/8, $0xa733c33711224648
-DATA ·precomputed<>+0x1560(SB)/8, $0x800f449874c19bae
-DATA ·precomputed<>+0x1568(SB)/8, $0x1bbb9448d0119e6a
-DATA ·precomputed<>+0x1570(SB)/8, $0xb5a99b48e2cc5c64
-DATA ·precomputed<>+0x1578(SB)/8, $0x2c07c5b764502817
-DATA ·precomputed<>+0x1580(SB)/8, $0x71a563fe22c7634c
-DATA ·precomputed<>+0x1588(SB)/8, $0x6a41dfe536e884ab
-DATA ·precomputed<>+0x1590(SB)/8, $0x9a1def671d947683
-DATA ·precomputed<>+0x1598(SB)/8, $0x142d8ec7d54ac166
-DATA ·precomputed<>+0x15a0(SB)/8, $0x8ec2be62ebdeb8bf
-DATA ·precomputed<>+0x15a8(SB)/8, $0xe4826846b95979b3
-DATA ·precomputed<>+0x15b0(SB)/8, $0x281a978d311ed7ca
-DATA ·precomputed<>+0x15b8(SB)/8, $0xf61194eada9123ce
-DATA ·precomputed<>+0x15c0(SB)/8, $0xe9130a63103b5fa2
-DATA ·precomputed<>+0x15c8(SB)/8, $0xe97f71208eee983b
-DATA ·precomputed<>+0x15d0(SB)/8, $0x54b7f85be8749cba
-DATA ·precomputed<>+0x15d8(SB)/8, $0x69976910bb1bca55
-DATA ·precomputed<>+0x15e0(SB)/8, $0x9ec4034ff4e621d3
-DATA ·precomputed<>+0x15e8(SB)/8, $0xaad567ed695e17da
-DATA ·precomputed<>+0x15f0(SB)/8, $0x7647f054cedb2ea8
-DATA ·precomputed<>+0x15f8(SB)/8, $0xf85f944c09fc7433
-DATA ·precomputed<>+0x1600(SB)/8, $0xda3fcbfeac64471a
-DATA ·precomputed<>+0x1608(SB)/8, $0xa3f5c4bdf68e193a
-DATA ·precomputed<>+0x1610(SB)/8, $0xb8be5d42f3cc57c2
-DATA ·precomputed<>+0x1618(SB)/8, $0x2bffe0763648bc73
-DATA ·precomputed<>+0x1620(SB)/8, $0xeb2a97082d2bfa97
-DATA ·precomputed<>+0x1628(SB)/8, $0xe8bac1833b1c357
-DATA ·precomputed<>+0x1630(SB)/8, $0x86d976de9067eda4
-DATA ·precomputed<>+0x1638(SB)/8, $0x2750b6764f05031b
-DATA ·precomputed<>+0x1640(SB)/8, $0xe6aac9de2049154f
-DATA ·precomputed<>+0x1648(SB)/8, $0xac2e5380a5822d34
-DATA ·precomputed<>+0x1650(SB)/8, $0x7d79547aba6f2e8c
-DATA ·precomputed<>+0x1658(SB)/8, $0x6644bb65eb407c29
-DATA ·precomputed<>+0x1660(SB)/8, $0xa61ce5eaa0021180
-DATA ·precomputed<>+0x1668(SB)/8, $0x8fd9e5d6a0b99d99
-DATA ·precomputed<>+0x1670(SB)/8, $0x5426a8baa978b910
-DATA ·precomputed<>+0x1678(SB)/8, $0x1eb0bb42c04eccca
-DATA ·precomputed<>+0x1680(SB)/8, $0x71ef99a8840b5874
-DATA ·precomputed<>+0x1688(SB)/8, $0x12867ba61cda4ea6
-DATA ·precomputed<>+0x1690(SB)/8, $0xb204bdcc448af3c5
-DATA ·precomputed<>+0x1698(SB)/8, $0xfd3b026684583d82
-DATA ·precomputed<>+0x16a0(SB)/8, $0x47e21b71cecc69d2
-DATA ·precomputed<>+0x16a8(SB)/8, $0x59681521aa26cebb
-DATA ·precomputed<>+0x16b0(SB)/8, $0xe4a742cf5bbbc025
-DATA ·precomputed<>+0x16b8(SB)/8, $0xdd8a3222b6995271
-DATA ·precomputed<>+0x16c0(SB)/8, $0x30af23b3b95eeddd
-DATA ·precomputed<>+0x16c8(SB)/8, $0xfd1d565a89985f3d
-DATA ·precomputed<>+0x16d0(SB)/8, $0xfbb531734c254738
-DATA ·precomputed<>+0x16d8(SB)/8, $0xb07ba56a171170a4
-DATA ·precomputed<>+0x16e0(SB)/8, $0x5069882c294d55d1
-DATA ·precomputed<>+0x16e8(SB)/8, $0xae0385c4792694c1
-DATA ·precomputed<>+0x16f0(SB)/8, $0xa0c792711225dc6
-DATA ·precomputed<>+0x16f8(SB)/8, $0xadcc5f08e22867c9
-DATA ·precomputed<>+0x1700(SB)/8, $0xddd8a18ed2d5206e
-DATA ·precomputed<>+0x1708(SB)/8, $0x432b81b53968e49e
-DATA ·precomputed<>+0x1710(SB)/8, $0xa92409f2af6dbf60
-DATA ·precomputed<>+0x1718(SB)/8, $0x7385a70d71d3feb5
-DATA ·precomputed<>+0x1720(SB)/8, $0xef97a2d04445cf9f
-DATA ·precomputed<>+0x1728(SB)/8, $0x5fbbed96df013fbd
-DATA ·precomputed<>+0x1730(SB)/8, $0xfbc1b21a51b5ee95
-DATA ·precomputed<>+0x1738(SB)/8, $0x428b718387af2e3e
-DATA ·precomputed<>+0x1740(SB)/8, $0x22108f9b1780c10a
-DATA ·precomputed<>+0x1748(SB)/8, $0x95007dedbbe2a920
-DATA ·precomputed<>+0x1750(SB)/8, $0x4b2041a4624addbc
-DATA ·precomputed<>+0x1758(SB)/8, $0xa9afb5979bae6278
-DATA ·precomputed<>+0x1760(SB)/8, $0xfa5fc16f585aa316
-DATA ·precomputed<>+0x1768(SB)/8, $0x69085e36d3493240
-DATA ·precomputed<>+0x1770(SB)/8, $0xbb6e5343d4c4aa8a
-DATA ·precomputed<>+0x1778(SB)/8, $0x8909c2906ca495cf
-DATA ·precomputed<>+0x1780(SB)/8, $0x5f03670840e4644a
-DATA ·precomputed<>+0x1788(SB)/8, $0x7c620ba45d0c845c
-DATA ·precomputed<>+0x1790(SB)/8, $0x7c977d4e31e3bf26
-DATA ·precomputed<>+0x1798(SB)/8, $0x1035d6b953f4f061
-DATA ·precomputed<>+0x17a0(SB)/8, $0xf9e25910bd84748e
-DATA ·precomputed<>+0x17a8(SB)/8, $0x5737bfc5f2dacef0
-DATA ·precomputed<>+0x17b0(SB)/8, $0x1ebef0d060c6076e
-DATA ·precomputed<>+0x17b8(SB)/8, $0xce757d148b433376
-DATA ·precomputed<>+0x17c0(SB)/8, $0x164ac67faee03999
-DATA ·precomputed<>+0x17c8(SB)/8, $0x4de174d379ff7f91
-DATA ·precomputed<>+0x17d0(SB)/8, $0x63e4943548da6ea
-DATA ·precomputed<>+0x17d8(SB)/8, $0x5264880bdb7ccdf7
-DATA ·precomputed<>+0x17e0(SB)/8, $0x4a18f34b49b992cc
-DATA ·precomputed<>+0x17e8(SB)/8, $0xe16b6f4d14065870
-DATA ·precomputed<>+0x17f0(SB)/8, $0xd32479ac4cdb0e21
-DATA ·precomputed<>+0x17f8(SB)/8, $0xce8151f6162bc9f8
-DATA ·precomputed<>+0x1800(SB)/8, $0x7eb7f516da0abf3e
-DATA ·precomputed<>+0x1808(SB)/8, $0x3c92ac9461b3381f
-DATA ·precomputed<>+0x1810(SB)/8, $0xbad7320ed3418870
-DATA ·precomputed<>+0x1818(SB)/8, $0xbab7a12607dbe066
-DATA ·precomputed<>+0x1820(SB)/8, $0xe7ce59be2def303f
-DATA ·precomputed<>+0x1828(SB)/8, $0xbf1f2372d1e0c9f
-DATA ·precomputed<>+0x1830(SB)/8, $0x12c18d1e38f418dc
-DATA ·precomputed<>+0x1838(SB)/8, $0x7fcc5e3eb85bb676
-DATA ·precomputed<>+0x1840(SB)/8, $0x2cda5caee30ae90b
-DATA ·precomputed<>+0x1848(SB)/8, $0x2cc34290caabea0d
-DATA ·precomputed<>+0x1850(SB)/8, $0x564afcd941e67856
-DATA ·precomputed<>+0x1858(SB)/8, $0x210c7a09cf6ef8b7
-DATA ·precomputed<>+0x1860(SB)/8, $0xc316d352f82a591d
-DATA ·precomputed<>+0x1868(SB)/8, $0x5fe8cc4dab43d2a1
-DATA ·precomputed<>+0x1870(SB)/8, $0xd8ebce978b4e9470
-DATA ·precomputed<>+0x1878(SB)/8, $0x26c78f44ba321a07
-DATA ·precomputed<>+0x1880(SB)/8, $0x2444c682a0cb5443
-DATA ·precomputed<>+0x1888(SB)/8, $0x264c26624b743ee7
-DATA ·precomputed<>+0x1890(SB)/8, $0xd7a1adc4a303eb20
-DATA ·precomputed<>+0x1898(SB)/8, $0x3f14821bf60a5b98
-DATA ·precomputed<>+0x18a0(SB)/8, $0xa439102d1a1d7661
-DATA ·precomputed<>+0x18a8(SB)/8, $0x47c25a378d8a5a1a
-DATA ·precomputed<>+0x18b0(SB)/8, $0xdf4a48dba34c66a9
-DATA ·precomputed<>+0x18b8(SB)/8, $0xab4673644c828c73
-DATA ·precomputed<>+0x18c0(SB)/8, $0x681a38c765604726
-DATA ·precomputed<>+0x18c8(SB)/8, $0x4f8c6ae3247a421e
-DATA ·precomputed<>+0x18d0(SB)/8, $0x1a51eaa01294956e
-DATA ·precomputed<>+0x18d8(SB)/8, $0x984b1ef47c9b324
-DATA ·precomputed<>+0x18e0(SB)/8, $0x3749bd0d597b7696
-DATA ·precomputed<>+0x18e8(SB)/8, $0x9d432b7808e57ee7
-DATA ·precomputed<>+0x18f0(SB)/8, $0x3092afe12ba112d2
-DATA ·precomputed<>+0x18f8(SB)/8, $0x89ccee4916c5a7f5
-DATA ·precomputed<>+0x1900(SB)/8, $0xfbc2ecf0d8315f77
-DATA ·precomputed<>+0x1908(SB)/8, $0x906e9ef07371c335
-DATA ·precomputed<>+0x1910(SB)/8, $0xbd928af70a5f7301
-DATA ·precomputed<>+0x1918(SB)/8, $0x802a8afacd41fe13
-DATA ·precomputed<>+0x1920(SB)/8, $0x8d8b87f074630acb
-DATA ·precomputed<>+0x1928(SB)/8, $0x6aab2d716d09e87d
-DATA ·precomputed<>+0x1930(SB)/8, $0x2e7af1afef3df71b
-DATA ·precomputed<>+0x1938(SB)/8, $0x19bd9bc42d9888f9
-DATA ·precomputed<>+0x1940(SB)/8, $0xe3f5e675a0042ad4
-DATA ·precomputed<>+0x1948(SB)/8, $0x2f160c4da5cc7bc6
-DATA ·precomputed<>+0x1950(SB)/8, $0x55611dabdca6b4aa
-DATA ·precomputed<>+0x1958(SB)/8, $0x753472d900b350fc
-DATA ·precomputed<>+0x1960(SB)/8, $0x873ed16992a3bf78
-DATA ·precomputed<>+0x1968(SB)/8, $0x1bd1c81b33afd106
-DATA ·precomputed<>+0x1970(SB)/8, $0xd5c1d3cb2ab88a6
-DATA ·precomputed<>+0x1978(SB)/8, $0x91fc493db666fb61
-DATA ·precomputed<>+0x1980(SB)/8, $0x7b454592016222e4
-DATA ·precomputed<>+0x1988(SB)/8, $0x32d7e1571dac8e01
-DATA ·precomputed<>+0x1990(SB)/8, $0xfedc89a72eee9d8b
-DATA ·precomputed<>+0x1998(SB)/8, $0xd5d34e34191510f
-DATA ·precomputed<>+0x19a0(SB)/8, $0xbb33dafea23f8e9f
-DATA ·precomputed<>+0x19a8(SB)/8, $0xf6535f6f756b6951
-DATA ·precomputed<>+0x19b0(SB)/8, $0x632dc6bef68e50a0
-DATA ·precomputed<>+0x19b8(SB)/8, $0x47728b7b573835f2
-DATA ·precomputed<>+0x19c0(SB)/8, $0x355e9d7b54089685
-DATA ·precomputed<>+0x19c8(SB)/8, $0x9f0ec68f40818349
-DATA ·precomputed<>+0x19d0(SB)/8, $0x4cf4d8cd3861b80f
-DATA ·precomputed<>+0x19d8(SB)/8, $0xcce669fdc1f5fa14
-DATA ·precomputed<>+0x19e0(SB)/8, $0xea2125091788f9da
-DATA ·precomputed<>+0x19e8(SB)/8, $0x32953613f3ccf239
-DATA ·precomputed<>+0x19f0(SB)/8, $0x1048d09250027f3b
-DATA ·precomputed<>+0x19f8(SB)/8, $0xe807b39d4270fbcb
-DATA ·precomputed<>+0x1a00(SB)/8, $0xf325420e9a46edf8
-DATA ·precomputed<>+0x1a08(SB)/8, $0x9d85d2679fed02fb
-DATA ·precomputed<>+0x1a10(SB)/8, $0xae4a36e489b8f690
-DATA ·precomputed<>+0x1a18(SB)/8, $0xd4c51500ac55807d
-DATA ·precomputed<>+0x1a20(SB)/8, $0x1cbddca1cdc23b8d
-DATA ·precomputed<>+0x1a28(SB)/8, $0xfcb169eba9cc8960
-DATA ·precomputed<>+0x1a30(SB)/8, $0x38acbfdcade7a842
-DATA ·precomputed<>+0x1a38(SB)/8, $0x65d6837eff19779f
-DATA ·precomputed<>+0x1a40(SB)/8, $0xc9f4d9aa63aa3bbe
-DATA ·precomputed<>+0x1a48(SB)/8, $0xe1e36d303df93dae
-DATA ·precomputed<>+0x1a50(SB)/8, $0x1046efe279059758
-DATA ·precomputed<>+0x1a58(SB)/8, $0xcdbc1a38fb07ff8
-DATA ·precomputed<>+0x1a60(SB)/8, $0xc680c830e133ac6
-DATA ·precomputed<>+0x1a68(SB)/8, $0x860fd7e9c34679da
-DATA ·precomputed<>+0x1a70(SB)/8, $0xcc54830e8932e8b0
-DATA ·precomputed<>+0x1a78(SB)/8, $0x299b6337326dae9c
-DATA ·precomputed<>+0x1a80(SB)/8, $0x264a12117e968b8e
-DATA ·precomputed<>+0x1a88(SB)/8, $0x31851eb3422de934
-DATA ·precomputed<>+0x1a90(SB)/8, $0x4c80117bdc84521f
-DATA ·precomputed<>+0x1a98(SB)/8, $0x7f869027e4e75c07
-DATA ·precomputed<>+0x1aa0(SB)/8, $0xe0341bc99ba6bb79
-DATA ·precomputed<>+0x1aa8(SB)/8, $0x5b07e1d643a908dc
-DATA ·precomputed<>+0x1ab0(SB)/8, $0x6f048aa068f0f583
-DATA ·precomputed<>+0x1ab8(SB)/8, $0x6e9674101e1fd50a
-DATA ·precomputed<>+0x1ac0(SB)/8, $0x5660c73cdf18eddf
-DATA ·precomputed<>+0x1ac8(SB)/8, $0x77edb4b9e2465ee9
-DATA ·precomputed<>+0x1ad0(SB)/8, $0xaf24eff83af950c8
-DATA ·precomputed<>+0x1ad8(SB)/8, $0xb822c6b26f6cb68b
-DATA ·precomputed<>+0x1ae0(SB)/8, $0x250352f6149d2bb2
-DATA ·precomputed<>+0x1ae8(SB)/8, $0x926809daf4a66ae7
-DATA ·precomputed<>+0x1af0(SB)/8, $0x9964c2803f7e1c68
-DATA ·precomputed<>+0x1af8(SB)/8, $0xd253298ab668129b
-DATA ·precomputed<>+0x1b00(SB)/8, $0xa5a1e4df86c0bc75
-DATA ·precomputed<>+0x1b08(SB)/8, $0xd52e307c4d88fef2
-DATA ·precomputed<>+0x1b10(SB)/8, $0x25f4008e2b164baf
-DATA ·precomputed<>+0x1b18(SB)/8, $0xc4fd7d771523b409
-DATA ·precomputed<>+0x1b20(SB)/8, $0x1adc3f918b4688a
-DATA ·precomputed<>+0x1b28(SB)/8, $0xe0fe568a68dde898
-DATA ·precomputed<>+0x1b30(SB)/8, $0x6a0906c4b30c8c4
-DATA ·precomputed<>+0x1b38(SB)/8, $0x6eedbc135141a475
-DATA ·precomputed<>+0x1b40(SB)/8, $0xb7260fc20ad9cd00
-DATA ·precomputed<>+0x1b48(SB)/8, $0xa6a4ef1d37868c0
-DATA ·precomputed<>+0x1b50(SB)/8, $0xd4aecd270655fe5d
-DATA ·precomputed<>+0x1b58(SB)/8, $0xed6f944dbd274443
-DATA ·precomputed<>+0x1b60(SB)/8, $0x2a29f6bfd32a2cb1
-DATA ·precomputed<>+0x1b68(SB)/8, $0x2f66231ebc9e06af
-DATA ·precomputed<>+0x1b70(SB)/8, $0xa6faf0a6f9ca63c2
-DATA ·precomputed<>+0x1b78(SB)/8, $0xc77bcccdd20269e5
-DATA ·precomputed<>+0x1b80(SB)/8, $0xe0a149ac553e8de8
-DATA ·precomputed<>+0x1b88(SB)/8, $0x4b2b4f92690e4b05
-DATA ·precomputed<>+0x1b90(SB)/8, $0xd41888f17bae7cc6
-DATA ·precomputed<>+0x1b98(SB)/8, $0xa0fb376e06051134
-DATA ·precomputed<>+0x1ba0(SB)/8, $0x88f55bbc709c4996
-DATA ·precomputed<>+0x1ba8(SB)/8, $0x85af6de028da9350
-DATA ·precomputed<>+0x1bb0(SB)/8, $0x6436c4cd9e375afa
-DATA ·precomputed<>+0x1bb8(SB)/8, $0xda5f8c0bf5215a91
-DATA ·precomputed<>+0x1bc0(SB)/8, $0x5099dc5595e388c3
-DATA ·precomputed<>+0x1bc8(SB)/8, $0xd0670ff5ea44e3ea
-DATA ·precomputed<>+0x1bd0(SB)/8, $0xd212c99361b41f7b
-DATA ·precomputed<>+0x1bd8(SB)/8, $0x4f594af9faf13305
-DATA ·precomputed<>+0x1be0(SB)/8, $0xbc508bf205c01232
-DATA ·precomputed<>+0x1be8(SB)/8, $0x7683353639ff08a5
-DATA ·precomputed<>+0x1bf0(SB)/8, $0xa1cf70bdb837741a
-DATA ·precomputed<>+0x1bf8(SB)/8, $0xba8e6616aaf7bd2a
-DATA ·precomputed<>+0x1c00(SB)/8, $0x5b0871b90ecbbb11
-DATA ·precomputed<>+0x1c08(SB)/8, $0x4fc03aa869c32f39
-DATA ·precomputed<>+0x1c10(SB)/8, $0x2a4883480a508d3b
-DATA ·precomputed<>+0x1c18(SB)/8, $0x4c61ff5b68b39b57
-DATA ·precomputed<>+0x1c20(SB)/8, $0xcacac5d6dbcb8d1
-DATA ·precomputed<>+0x1c28(SB)/8, $0x1ad9d4f158bfadd5
-DATA ·precomputed<>+0x1c30(SB)/8, $0x847d9df884961c45
-DATA ·precomputed<>+0x1c38(SB)/8, $0x2a78175e23ad47c5
-DATA ·precomputed<>+0x1c40(SB)/8, $0xbf64b87d36b03b3d
-DATA ·precomputed<>+0x1c48(SB)/8, $0x98b5482b90709f59
-DATA ·precomputed<>+0x1c50(SB)/8, $0xe5852add8019e5e2
-DATA ·precomputed<>+0x1c58(SB)/8, $0x4725b7c2885c36d1
-DATA ·precomputed<>+0x1c60(SB)/8, $0x88e94961e4086c8
-DATA ·precomputed<>+0x1c68(SB)/8, $0x1011c16c1c287124
-DATA ·precomputed<>+0x1c70(SB)/8, $0x77339e09ea71fdf4
-DATA ·precomputed<>+0x1c78(SB)/8, $0xeaeeaa0f5109999e
-DATA ·precomputed<>+0x1c80(SB)/8, $0xf80158aef1e5617a
-DATA ·precomputed<>+0x1c88(SB)/8, $0x2873c8772101ef3b
-DATA ·precomputed<>+0x1c90(SB)/8, $0x311fe7e704e2247a
-DATA ·precomputed<>+0x1c98(SB)/8, $0x7335098945f0dd3a
-DATA ·precomputed<>+0x1ca0(SB)/8, $0xcec35fc4ff9d9d4e
-DATA ·precomputed<>+0x1ca8(SB)/8, $0xbbfabe2e401eea
-DATA ·precomputed<>+0x1cb0(SB)/8, $0x545dff61701fb3e3
-DATA ·precomputed<>+0x1cb8(SB)/8, $0xa664a82fc3c0b0c1
-DATA ·precomputed<>+0x1cc0(SB)/8, $0xab7b68da909bf8c6
-DATA ·precomputed<>+0x1cc8(SB)/8, $0xb5bd7c8b8f4bad4a
-DATA ·precomputed<>+0x1cd0(SB)/8, $0xa0ebb027fae39585
-DATA ·precomputed<>+0x1cd8(SB)/8, $0x68932a36c676d2d9
-DATA ·precomputed<>+0x1ce0(SB)/8, $0x562f954b730901cb
-DATA ·precomputed<>+0x1ce8(SB)/8, $0x108194a724fa3192
-DATA ·precomputed<>+0x1cf0(SB)/8, $0xe32829e6a547b351
-DATA ·precomputed<>+0x1cf8(SB)/8, $0x5ca08b2e5b343765
-DATA ·precomputed<>+0x1d00(SB)/8, $0xa6bd762c50b17c2c
-DATA ·precomputed<>+0x1d08(SB)/8, $0x515a4ed2b8981a40
-DATA ·precomputed<>+0x1d10(SB)/8, $0x68b155ba3a9f955e
-DATA ·precomputed<>+0x1d18(SB)/8, $0x391e67710d28578f
-DATA ·precomputed<>+0x1d20(SB)/8, $0x59f68c94022067ff
-DATA ·precomputed<>+0x1d28(SB)/8, $0xf3d582895e441d49
-DATA ·precomputed<>+0x1d30(SB)/8, $0xd899e7cea1984153
-DATA ·precomputed<>+0x1d38(SB)/8, $0xec26cec82ac3b7d5
-DATA ·precomputed<>+0x1d40(SB)/8, $0xa580b01fde2bf94c
-DATA ·precomputed<>+0x1d48(SB)/8, $0x53d0693b69c8609e
-DATA ·precomputed<>+0x1d50(SB)/8, $0x3e2ef63d69079dfd
-DATA ·precomputed<>+0x1d58(SB)/8, $0x7ed8d2dee7362eaa
-DATA ·precomputed<>+0x1d60(SB)/8, $0xa35385c8dc2506a8
-DATA ·precomputed<>+0x1d68(SB)/8, $0x292ba8149e526496
-DATA ·precomputed<>+0x1d70(SB)/8, $0xde4ec1955d47bfcf
-DATA ·precomputed<>+0x1d78(SB)/8, $0x9ea0ef7aa676d5d2
-DATA ·precomputed<>+0x1d80(SB)/8, $0x60a14ac8b78f3a4c
-DATA ·precomputed<>+0x1d88(SB)/8, $0xd92fd23dfee7f384
-DATA ·precomputed<>+0x1d90(SB)/8, $0xc3ff83d3a7bcd981
-DATA ·precomputed<>+0x1d98(SB)/8, $0x5804049e7a07f923
-DATA ·precomputed<>+0x1da0(SB)/8, $0x100f9a2ada81707d
-DATA ·precomputed<>+0x1da8(SB)/8, $0x861f8f04bc78a549
-DATA ·precomputed<>+0x1db0(SB)/8, $0x87f6ee771c66d8f9
-DATA ·precomputed<>+0x1db8(SB)/8, $0xdc646a7d441b9bc1
-DATA ·precomputed<>+0x1dc0(SB)/8, $0xde04c343def27938
-DATA ·precomputed<>+0x1dc8(SB)/8, $0x3f15ca9148cee32a
-DATA ·precomputed<>+0x1dd0(SB)/8, $0xcb61573b9dd142da
-DATA ·precomputed<>+0x1dd8(SB)/8, $0xc094eefd126dd9bc
-DATA ·precomputed<>+0x1de0(SB)/8, $0x5d42f1a5136bb4da
-DATA ·precomputed<>+0x1de8(SB)/8, $0x75693952db2f3449
-DATA ·precomputed<>+0x1df0(SB)/8, $0x98017cd65c16795e
-DATA ·precomputed<>+0x1df8(SB)/8, $0x9e4015302afb67db
-DATA ·precomputed<>+0x1e00(SB)/8, $0x44258dec26041a11
-DATA ·precomputed<>+0x1e08(SB)/8, $0x350eedb69faea8ec
-DATA ·precomputed<>+0x1e10(SB)/8, $0x141b8abed3867155
-DATA ·precomputed<>+0x1e18(SB)/8, $0x65b7d1e36c44c998
-DATA ·precomputed<>+0x1e20(SB)/8, $0x97c7c234f52c043b
-DATA ·precomputed<>+0x1e28(SB)/8, $0xaff6a157042e7642
-DATA ·precomputed<>+0x1e30(SB)/8, $0xf2d0cde821cdeb
-DATA ·precomputed<>+0x1e38(SB)/8, $0xccf2b0cc49a13fe
-DATA ·precomputed<>+0x1e40(SB)/8, $0xe7fffc7f1940b754
-DATA ·precomputed<>+0x1e48(SB)/8, $0x4b903341e4ae952d
-DATA ·precomputed<>+0x1e50(SB)/8, $0xb850aa2a3fde4edd
-DATA ·precomputed<>+0x1e58(SB)/8, $0xd84909f4ed635434
-DATA ·precomputed<>+0x1e60(SB)/8, $0x63639e0e912840ae
-DATA ·precomputed<>+0x1e68(SB)/8, $0xa20e1e508c7e894b
-DATA ·precomputed<>+0x1e70(SB)/8, $0xc2f881717864c65f
-DATA ·precomputed<>+0x1e78(SB)/8, $0xb5bfe3b0a01b8352
-DATA ·precomputed<>+0x1e80(SB)/8, $0xdb14ccd1f2b92765
-DATA ·precomputed<>+0x1e88(SB)/8, $0x94220e2e3763f87d
-DATA ·precomputed<>+0x1e90(SB)/8, $0x4c3815691d73fd5e
-DATA ·precomputed<>+0x1e98(SB)/8, $0xa3d76d7379a93585
-DATA ·precomputed<>+0x1ea0(SB)/8, $0xecbbbd80f22cf8a8
-DATA ·precomputed<>+0x1ea8(SB)/8, $0x17acf947bd9b5fe8
-DATA ·precomputed<>+0x1eb0(SB)/8, $0xe641df633e61059b
-DATA ·precomputed<>+0x1eb8(SB)/8, $0x30a5d5caa9a4c031
-DATA ·precomputed<>+0x1ec0(SB)/8, $0x90cd608550643d18
-DATA ·precomputed<>+0x1ec8(SB)/8, $0x821f27f37902eb91
-DATA ·precomputed<>+0x1ed0(SB)/8, $0x766f7c645d7964ba
-DATA ·precomputed<>+0x1ed8(SB)/8, $0xf56feaa48c7bdad5
-DATA ·precomputed<>+0x1ee0(SB)/8, $0xf6aa29281bfda5ec
-DATA ·precomputed<>+0x1ee8(SB)/8, $0x5c09581dc624a7de
-DATA ·precomputed<>+0x1ef0(SB)/8, $0x33d1a64a6ff7f3b1
-DATA ·precomputed<>+0x1ef8(SB)/8, $0xa19a86e56c36de4b
-DATA ·precomputed<>+0x1f00(SB)/8, $0xeb124c5c768f488a
-DATA ·precomputed<>+0x1f08(SB)/8, $0x9bcdddb6e1db242a
-DATA ·precomputed<>+0x1f10(SB)/8, $0xdcc03ab1596c5d3d
-DATA ·precomputed<>+0x1f18(SB)/8, $0x449b9cc67ddd11d5
-DATA ·precomputed<>+0x1f20(SB)/8, $0xfc879728b3cb1513
-DATA ·precomputed<>+0x1f28(SB)/8, $0x3a3bd2673f26b3b
-DATA ·precomputed<>+0x1f30(SB)/8, $0x89af202bb0a67208
-DATA ·precomputed<>+0x1f38(SB)/8, $0xd7d73a46436da84a
-DATA ·precomputed<>+0x1f40(SB)/8, $0xb9a6986a0a17953d
-DATA ·precomputed<>+0x1f48(SB)/8, $0x329b5b853dbb82f2
-DATA ·precomputed<>+0x1f50(SB)/8, $0xe784304143ebb8e4
-DATA ·precomputed<>+0x1f58(SB)/8, $0x7c91c7bd6a4ebf03
-DATA ·precomputed<>+0x1f60(SB)/8, $0xb442dad12300400
-DATA ·precomputed<>+0x1f68(SB)/8, $0x1fafea147ac13ae0
-DATA ·precomputed<>+0x1f70(SB)/8, $0x746b9fcd127a9826
-DATA ·precomputed<>+0x1f78(SB)/8, $0x9fa2012a71d3a443
-DATA ·precomputed<>+0x1f80(SB)/8, $0x99d315bfa5ff925b
-DATA ·precomputed<>+0x1f88(SB)/8, $0x734cf6541d35f04c
-DATA ·precomputed<>+0x1f90(SB)/8, $0xb887c5b008f3412e
-DATA ·precomputed<>+0x1f98(SB)/8, $0xbfad8791377cb4ef
-DATA ·precomputed<>+0x1fa0(SB)/8, $0x8a0807da9b8a01da
-DATA ·precomputed<>+0x1fa8(SB)/8, $0x46a82473ef027cca
-DATA ·precomputed<>+0x1fb0(SB)/8, $0x9968b2d4e73ce60b
-DATA ·precomputed<>+0x1fb8(SB)/8, $0x76dc41d883295b56
-DATA ·precomputed<>+0x1fc0(SB)/8, $0x6376749f9b7c6c75
-DATA ·precomputed<>+0x1fc8(SB)/8, $0x680eacdcacbca35d
-DATA ·precomputed<>+0x1fd0(SB)/8, $0xe87fd5b55e145b32
-DATA ·precomputed<>+0x1fd8(SB)/8, $0xeb20d1ba36b886af
-DATA ·precomputed<>+0x1fe0(SB)/8, $0xca499055779b12bb
-DATA ·precomputed<>+0x1fe8(SB)/8, $0x6f290ff20be39fb7
-DATA ·precomputed<>+0x1ff0(SB)/8, $0x33ad6fe0f4a128ce
-DATA ·precomputed<>+0x1ff8(SB)/8, $0xf09e2a409b31da81
-DATA ·precomputed<>+0x2000(SB)/8, $0xf0c24783f357999b
-DATA ·precomputed<>+0x2008(SB)/8, $0x2c21474c26bfacb3
-DATA ·precomputed<>+0x2010(SB)/8, $0xe3abed6ad3ddb945
-DATA ·precomputed<>+0x2018(SB)/8, $0xbb21b7646031a5ea
-DATA ·precomputed<>+0x2020(SB)/8, $0x6db3b68b8afc2a09
-DATA ·precomputed<>+0x2028(SB)/8, $0x1aac2f0881306b71
-DATA ·precomputed<>+0x2030(SB)/8, $0x882c3371852eb6f5
-DATA ·precomputed<>+0x2038(SB)/8, $0xadfe0c1ad98e9b6f
-DATA ·precomputed<>+0x2040(SB)/8, $0x1e0bf0633fd5a1de
-DATA ·precomputed<>+0x2048(SB)/8, $0x5d05e901a75b5b8c
-DATA ·precomputed<>+0x2050(SB)/8, $0xbbbdb1abcb3c617a
-DATA ·precomputed<>+0x2058(SB)/8, $0x44954a8c1aef4706
-DATA ·precomputed<>+0x2060(SB)/8, $0xbc3ceea3ff6a6e47
-DATA ·precomputed<>+0x2068(SB)/8, $0x6140f4210ded1275
-DATA ·precomputed<>+0x2070(SB)/8, $0xbb4b4c044dabe95f
-DATA ·precomputed<>+0x2078(SB)/8, $0xc55e87da7135e813
-DATA ·precomputed<>+0x2080(SB)/8, $0x6a65fda84e1d5d9a
-DATA ·precomputed<>+0x2088(SB)/8, $0xc0fe385345ccdef
-DATA ·precomputed<>+0x2090(SB)/8, $0x19ff360fd6d72c0a
-DATA ·precomputed<>+0x2098(SB)/8, $0x1be1e8d7fb016131
-DATA ·precomputed<>+0x20a0(SB)/8, $0xe2f27e91025b45e1
-DATA ·precomputed<>+0x20a8(SB)/8, $0x25bec26605259bf1
-DATA ·precomputed<>+0x20b0(SB)/8, $0xd7b8b4e7e51cc67e
-DATA ·precomputed<>+0x20b8(SB)/8, $0x3a839aa5ab80a20e
-DATA ·precomputed<>+0x20c0(SB)/8, $0xffa1de9738f4194d
-DATA ·precomputed<>+0x20c8(SB)/8, $0x33d2726a3b996b63
-DATA ·precomputed<>+0x20d0(SB)/8, $0x787c0ec30d2053a7
-DATA ·precomputed<>+0x20d8(SB)/8, $0x9447e9cbeecd5c0c
-DATA ·precomputed<>+0x20e0(SB)/8, $0x77f121c284773c0
-DATA ·precomputed<>+0x20e8(SB)/8, $0x496427e4815829a1
-DATA ·precomputed<>+0x20f0(SB)/8, $0x4b11978694def08b
-DATA ·precomputed<>+0x20f8(SB)/8, $0x9e7b29e69c15a778
-DATA ·precomputed<>+0x2100(SB)/8, $0xbf7e9c5550b66fec
-DATA ·precomputed<>+0x2108(SB)/8, $0x5db7dd710c065cfa
-DATA ·precomputed<>+0x2110(SB)/8, $0x3525e31050d459ea
-DATA ·precomputed<>+0x2118(SB)/8, $0xad7abe5a8122941a
-DATA ·precomputed<>+0x2120(SB)/8, $0xc7aeba80122d92fa
-DATA ·precomputed<>+0x2128(SB)/8, $0x66c3765efcc1c24
-DATA ·precomputed<>+0x2130(SB)/8, $0xa6d767ca8ffd71b1
-DATA ·precomputed<>+0x2138(SB)/8, $0x4a75fab59cc16dbc
-DATA ·precomputed<>+0x2140(SB)/8, $0xf1b318af2988abcd
-DATA ·precomputed<>+0x2148(SB)/8, $0xf887558f8e7da518
-DATA ·precomputed<>+0x2150(SB)/8, $0xb8b9939c97836b57
-DATA ·precomputed<>+0x2158(SB)/8, $0xf793e3b5c0a74cf3
-DATA ·precomputed<>+0x2160(SB)/8, $0xe191008a37684170
-DATA ·precomputed<>+0x2168(SB)/8, $0x7708823b05cb453c
-DATA ·precomputed<>+0x2170(SB)/8, $0xec221d40361beb2c
-DATA ·precomputed<>+0x2178(SB)/8, $0xe5a6cceeb1b68f4
-DATA ·precomputed<>+0x2180(SB)/8, $0xc6f0a0068ae66f00
-DATA ·precomputed<>+0x2188(SB)/8, $0x2620157e78d648f0
-DATA ·precomputed<>+0x2190(SB)/8, $0xfc71776240d2880f
-DATA ·precomputed<>+0x2198(SB)/8, $0x2e0e293cbe105017
-DATA ·precomputed<>+0x21a0(SB)/8, $0xb320f214854116f4
-DATA ·precomputed<>+0x21a8(SB)/8, $0x5e4fa7002d5cd4ec
-DATA ·precomputed<>+0x21b0(SB)/8, $0x83fa0a23dffc1c55
-DATA ·precomputed<>+0x21b8(SB)/8, $0x18fcb8d2c9a9ca15
-DATA ·precomputed<>+0x21c0(SB)/8, $0xe85f995e2a8ed3d7
-DATA ·precomputed<>+0x21c8(SB)/8, $0x9dc712e82f319e47
-DATA ·precomputed<>+0x21d0(SB)/8, $0xc4402eff536d98a2
-DATA ·precomputed<>+0x21d8(SB)/8, $0xca61e31037521e35
-DATA ·precomputed<>+0x21e0(SB)/8, $0xfed39621c3196672
-DATA ·precomputed<>+0x21e8(SB)/8, $0x29e7743fff17e8a7
-DATA ·precomputed<>+0x21f0(SB)/8, $0x47eca488412a7c49
-DATA ·precomputed<>+0x21f8(SB)/8, $0xf011451333a2a6da
-DATA ·precomputed<>+0x2200(SB)/8, $0x9da9326ce3018c30
-DATA ·precomputed<>+0x2208(SB)/8, $0x957272bed6eba17d
-DATA ·precomputed<>+0x2210(SB)/8, $0x32db31402bc030fb
-DATA ·precomputed<>+0x2218(SB)/8, $0xeafeea88c74ba6eb
-DATA ·precomputed<>+0x2220(SB)/8, $0x5edc82c4ee7d5290
-DATA ·precomputed<>+0x2228(SB)/8, $0x459047881908e516
-DATA ·precomputed<>+0x2230(SB)/8, $0x28da03f2fd8d0213
-DATA ·precomputed<>+0x2238(SB)/8, $0x999b9739143cfa5f
-DATA ·precomputed<>+0x2240(SB)/8, $0x62ded9277df3899
-DATA ·precomputed<>+0x2248(SB)/8, $0xf569331d60d4bc4c
-DATA ·precomputed<>+0x2250(SB)/8, $0x7fd95223b8091def
-DATA ·precomputed<>+0x2258(SB)/8, $0x7b63bb33c26fc5a3
-DATA ·precomputed<>+0x2260(SB)/8, $0x4c4ad2fe1492b4f7
-DATA ·precomputed<>+0x2268(SB)/8, $0xf7387774ce232301
-DATA ·precomputed<>+0x2270(SB)/8, $0x1bb8f757d23e8ff9
-DATA ·precomputed<>+0x2278(SB)/8, $0x27e4ede532d3d3e1
-DATA ·precomputed<>+0x2280(SB)/8, $0x715c8b1ac58718a2
-DATA ·precomputed<>+0x2288(SB)/8, $0xae4da5b3911279d8
-DATA ·precomputed<>+0x2290(SB)/8, $0x8445d96d0cca3a4f
-DATA ·precomputed<>+0x2298(SB)/8, $0x4a1760cd8cbbc03a
-DATA ·precomputed<>+0x22a0(SB)/8, $0xc020ad25e85fc4cb
-DATA ·precomputed<>+0x22a8(SB)/8, $0x949394fb58d4bd3c
-DATA ·precomputed<>+0x22b0(SB)/8, $0x2a03f5ec971bff69
-DATA ·precomputed<>+0x22b8(SB)/8, $0x45a3d9e0b9773d44
-DATA ·precomputed<>+0x22c0(SB)/8, $0x225ae64936e63b10
-DATA ·precomputed<>+0x22c8(SB)/8, $0x2f3c242c196a507
-DATA ·precomputed<>+0x22d0(SB)/8, $0x77dadf55698f757a
-DATA ·precomputed<>+0x22d8(SB)/8, $0x6593e5d2b1a7abd1
-DATA ·precomputed<>+0x22e0(SB)/8, $0xe44f3b25a8ef53dd
-DATA ·precomputed<>+0x22e8(SB)/8, $0x1633197abf4ac533
-DATA ·precomputed<>+0x22f0(SB)/8, $0x5ef0df96a4480cb3
-DATA ·precomputed<>+0x22f8(SB)/8, $0xaa6d10b3cfc2d2f5
-DATA ·precomputed<>+0x2300(SB)/8, $0x1b59dce02399d2ac
-DATA ·precomputed<>+0x2308(SB)/8, $0x7e3efa98ae245e10
-DATA ·precomputed<>+0x2310(SB)/8, $0xd3d70cde40fa8640
-DATA ·precomputed<>+0x2318(SB)/8, $0xa3f1189eb467e34d
-DATA ·precomputed<>+0x2320(SB)/8, $0xba41ded8f9a5366b
-DATA ·precomputed<>+0x2328(SB)/8, $0x6d40123c7441d927
-DATA ·precomputed<>+0x2330(SB)/8, $0x9334311e50fd7a16
-DATA ·precomputed<>+0x2338(SB)/8, $0x4f36806fca79cda6
-DATA ·precomputed<>+0x2340(SB)/8, $0x763ed31629092264
-DATA ·precomputed<>+0x2348(SB)/8, $0x5796c2b96d8820cb
-DATA ·precomputed<>+0x2350(SB)/8, $0xf51e060ee6b69df7
-DATA ·precomputed<>+0x2358(SB)/8, $0xdb96136c5460a8c
-DATA ·precomputed<>+0x2360(SB)/8, $0x5b2b0288fb71d943
-DATA ·precomputed<>+0x2368(SB)/8, $0xe27c6ef0e64d278c
-DATA ·precomputed<>+0x2370(SB)/8, $0xa2c4ba06649b8e94
-DATA ·precomputed<>+0x2378(SB)/8, $0x24a7f4d5afd34c2
-DATA ·precomputed<>+0x2380(SB)/8, $0x82b04f9feb64b5e
-DATA ·precomputed<>+0x2388(SB)/8, $0xe1ca0a24b0031fb1
-DATA ·precomputed<>+0x2390(SB)/8, $0xccda4398785853e1
-DATA ·precomputed<>+0x2398(SB)/8, $0x90b26421c4776cb4
-DATA ·precomputed<>+0x23a0(SB)/8, $0x1d54b0413d574aa4
-DATA ·precomputed<>+0x23a8(SB)/8, $0x19e8e2b0e4c0414
-DATA ·precomputed<>+0x23b0(SB)/8, $0x8da8dcd93e1232a3
-DATA ·precomputed<>+0x23b8(SB)/8, $0x27b3c0643208c51
-DATA ·precomputed<>+0x23c0(SB)/8, $0x5675a12ae6880b5f
-DATA ·precomputed<>+0x23c8(SB)/8, $0x9ba1e92ce2606d25
-DATA ·precomputed<>+0x23d0(SB)/8, $0xb012facbeb3b2125
-DATA ·precomputed<>+0x23d8(SB)/8, $0x3c50fdfbc37b0099
-DATA ·precomputed<>+0x23e0(SB)/8, $0xc9ce461c9ce223e9
-DATA ·precomputed<>+0x23e8(SB)/8, $0xcb90bdd6eefbd8ac
-DATA ·precomputed<>+0x23f0(SB)/8, $0xf657e5a4c631ea8e
-DATA ·precomputed<>+0x23f8(SB)/8, $0x6584520b38a83ff6
-DATA ·precomputed<>+0x2400(SB)/8, $0x3998f8bb9c3c4727
-DATA ·precomputed<>+0x2408(SB)/8, $0xf523ad177a8ecb59
-DATA ·precomputed<>+0x2410(SB)/8, $0x660cc2c25161dbde
-DATA ·precomputed<>+0x2418(SB)/8, $0xadd0686aebfdaea3
-DATA ·precomputed<>+0x2420(SB)/8, $0x9cb2401f16ebb278
-DATA ·precomputed<>+0x2428(SB)/8, $0xbce736c5fa58ea41
-DATA ·precomputed<>+0x2430(SB)/8, $0x466bb02694b608db
-DATA ·precomputed<>+0x2438(SB)/8, $0xe037791dfcaf38b6
-DATA ·precomputed<>+0x2440(SB)/8, $0x24bea2fcd50e8d96
-DATA ·precomputed<>+0x2448(SB)/8, $0x1e5420b92b83a1f6
-DATA ·precomputed<>+0x2450(SB)/8, $0x6fdac8ff4fe195c6
-DATA ·precomputed<>+0x2458(SB)/8, $0x8071e9fd54e0eaa5
-DATA ·precomputed<>+0x2460(SB)/8, $0x802f980ab489d9df
-DATA ·precomputed<>+0x2468(SB)/8, $0x5cda958011d8693f
-DATA ·precomputed<>+0x2470(SB)/8, $0xb01aa560cc8ab303
-DATA ·precomputed<>+0x2478(SB)/8, $0xfda36425fc891da4
-DATA ·precomputed<>+0x2480(SB)/8, $0x482fb7200ad86ed7
-DATA ·precomputed<>+0x2488(SB)/8, $0xbd5d66559edc9b0d
-DATA ·precomputed<>+0x2490(SB)/8, $0xdd8a0457c0fe720d
-DATA ·precomputed<>+0x2498(SB)/8, $0x7be57c966b1c31ee
-DATA ·precomputed<>+0x24a0(SB)/8, $0x6761111136ed4c25
-DATA ·precomputed<>+0x24a8(SB)/8, $0xd52a414dbfff1f85
-DATA ·precomputed<>+0x24b0(SB)/8, $0x5314613d2d4f3dfc
-DATA ·precomputed<>+0x24b8(SB)/8, $0x861b9557e2b33110
-DATA ·precomputed<>+0x24c0(SB)/8, $0xc8e75363f2a7d1f6
-DATA ·precomputed<>+0x24c8(SB)/8, $0x31ab0e6918b83086
-DATA ·precomputed<>+0x24d0(SB)/8, $0x5c021eb701358791
-DATA ·precomputed<>+0x24d8(SB)/8, $0x911f64246f1d32f1
-DATA ·precomputed<>+0x24e0(SB)/8, $0xe585a982d14294f3
-DATA ·precomputed<>+0x24e8(SB)/8, $0x75ea28a0e8410ff6
-DATA ·precomputed<>+0x24f0(SB)/8, $0x1a0812acc62a08f0
-DATA ·precomputed<>+0x24f8(SB)/8, $0x679b79cd07d8ac72
-DATA ·precomputed<>+0x2500(SB)/8, $0x3e3808c623cfe1b4
-DATA ·precomputed<>+0x2508(SB)/8, $0xe7a630932270ee14
-DATA ·precomputed<>+0x2510(SB)/8, $0xdbc22c67ad916a18
-DATA ·precomputed<>+0x2518(SB)/8, $0x4e49ce91d15635d9
-DATA ·precomputed<>+0x2520(SB)/8, $0x2b27ab48f81f3654
-DATA ·precomputed<>+0x2528(SB)/8, $0xbc0a53af65db419f
-DATA ·precomputed<>+0x2530(SB)/8, $0x6940776a1a4c6f3f
-DATA ·precomputed<>+0x2538(SB)/8, $0x4453d75f51329043
-DATA ·precomputed<>+0x2540(SB)/8, $0xed3bdc2dffaecdbc
-DATA ·precomputed<>+0x2548(SB)/8, $0x9d619006b8974474
-DATA ·precomputed<>+0x2550(SB)/8, $0x72c8f73d7f02162e
-DATA ·precomputed<>+0x2558(SB)/8, $0x9877c3ec7286396e
-DATA ·precomputed<>+0x2560(SB)/8, $0x428b42d6e9c5882f
-DATA ·precomputed<>+0x2568(SB)/8, $0x7941bee01f45303a
-DATA ·precomputed<>+0x2570(SB)/8, $0x85666f6e0d0a5128
-DATA ·precomputed<>+0x2578(SB)/8, $0xb460784d2ba467ad
-DATA ·precomputed<>+0x2580(SB)/8, $0x4f08c0172c06def5
-DATA ·precomputed<>+0x2588(SB)/8, $0x6a84a56328f08e2a
-DATA ·precomputed<>+0x2590(SB)/8, $0x352de066ad128097
-DATA ·precomputed<>+0x2598(SB)/8, $0x9c765c329948a31b
-DATA ·precomputed<>+0x25a0(SB)/8, $0x3350ae2dca2c1a08
-DATA ·precomputed<>+0x25a8(SB)/8, $0x580a38fa991fe202
-DATA ·precomputed<>+0x25b0(SB)/8, $0x61e38a771e6d734c
-DATA ·precomputed<>+0x25b8(SB)/8, $0x4df1847da226a025
-DATA ·precomputed<>+0x25c0(SB)/8, $0xa5b99f4881071fdb
-DATA ·precomputed<>+0x25c8(SB)/8, $0x8e2dfc2ac59a2c1c
-DATA ·precomputed<>+0x25d0(SB)/8, $0x2a9828a8e4aaa74f
-DATA ·precomputed<>+0x25d8(SB)/8, $0xa4d203e324b57b54
-DATA ·precomputed<>+0x25e0(SB)/8, $0xe67369539c3cf562
-DATA ·precomputed<>+0x25e8(SB)/8, $0xad8ed4dc0244ff9
-DATA ·precomputed<>+0x25f0(SB)/8, $0x2e5d1a604e71e5a1
-DATA ·precomputed<>+0x25f8(SB)/8, $0xd4e11acd108381ee
-DATA ·precomputed<>+0x2600(SB)/8, $0xeb2c407f2299b8ef
-DATA ·precomputed<>+0x2608(SB)/8, $0x88ec9b10091ddea4
-DATA ·precomputed<>+0x2610(SB)/8, $0x10d4bf2f8e43ebd4
-DATA ·precomputed<>+0x2618(SB)/8, $0xb196e423dfe044d1
-DATA ·precomputed<>+0x2620(SB)/8, $0x25881ec69dba548c
-DATA ·precomputed<>+0x2628(SB)/8, $0x4b8a7213b5517b31
-DATA ·precomputed<>+0x2630(SB)/8, $0x7f63e001811a143a
-DATA ·precomputed<>+0x2638(SB)/8, $0x7d7d242a9e54596f
-DATA ·precomputed<>+0x2640(SB)/8, $0x42feea6bc5d4cb3a
-DATA ·precomputed<>+0x2648(SB)/8, $0xe715aeba1efdefc3
-DATA ·precomputed<>+0x2650(SB)/8, $0x4f62d3e7d5a3afd8
-DATA ·precomputed<>+0x2658(SB)/8, $0x7ec255b98399602
-DATA ·precomputed<>+0x2660(SB)/8, $0xe1716d5d60b5af04
-DATA ·precomputed<>+0x2668(SB)/8, $0xf06580d28f259d34
-DATA ·precomputed<>+0x2670(SB)/8, $0x69387ce0c6a6a02
-DATA ·precomputed<>+0x2678(SB)/8, $0x500dc2b8d601ef5e
-DATA ·precomputed<>+0x2680(SB)/8, $0x15716f17557ec4f4
-DATA ·precomputed<>+0x2688(SB)/8, $0xbc4a12072557bd57
-DATA ·precomputed<>+0x2690(SB)/8, $0x48d5f9de3d2ebab5
-DATA ·precomputed<>+0x2698(SB)/8, $0xa71b4457c47ebb63
-DATA ·precomputed<>+0x26a0(SB)/8, $0x4c1bb6c98bb71ccd
-DATA ·precomputed<>+0x26a8(SB)/8, $0x4447b1fa578adb63
-DATA ·precomputed<>+0x26b0(SB)/8, $0xa02ab8f5e5df2483
-DATA ·precomputed<>+0x26b8(SB)/8, $0x4df6309fa5fd236f
-DATA ·precomputed<>+0x26c0(SB)/8, $0x2d86ae2d512ecb5c
-DATA ·precomputed<>+0x26c8(SB)/8, $0x81d73c4d7643ada6
-DATA ·precomputed<>+0x26d0(SB)/8, $0x8702f33a41da8811
-DATA ·precomputed<>+0x26d8(SB)/8, $0xeb02d514853d74bb
-DATA ·precomputed<>+0x26e0(SB)/8, $0x5bdc0fbf529ef701
-DATA ·precomputed<>+0x26e8(SB)/8, $0xaf5e7f91925b2178
-DATA ·precomputed<>+0x26f0(SB)/8, $0xeebf945efd585e
-DATA ·precomputed<>+0x26f8(SB)/8, $0x1a827d7bcba1d151
-DATA ·precomputed<>+0x2700(SB)/8, $0x810902d4ff60461e
-DATA ·precomputed<>+0x2708(SB)/8, $0x85cb7ae7675f9865
-DATA ·precomputed<>+0x2710(SB)/8, $0x20385739fe052c32
-DATA ·precomputed<>+0x2718(SB)/8, $0x857c67bfa17ce04
-DATA ·precomputed<>+0x2720(SB)/8, $0x20528f0734b435f4
-DATA ·precomputed<>+0x2728(SB)/8, $0xba7f75716023095b
-DATA ·precomputed<>+0x2730(SB)/8, $0xe0bfdff5a61ca562
-DATA ·precomputed<>+0x2738(SB)/8, $0xfdb7ec63f10fe5a1
-DATA ·precomputed<>+0x2740(SB)/8, $0xc431d39bed935d2a
-DATA ·precomputed<>+0x2748(SB)/8, $0x58325d58adf96171
-DATA ·precomputed<>+0x2750(SB)/8, $0x51a33b1a95bbe4ab
-DATA ·precomputed<>+0x2758(SB)/8, $0x1df5c824c9cdfcd8
-DATA ·precomputed<>+0x2760(SB)/8, $0xfa8158c3fb02548e
-DATA ·precomputed<>+0x2768(SB)/8, $0x877d2977dfa1055a
-DATA ·precomputed<>+0x2770(SB)/8, $0xba1e4fef9c14daf3
-DATA ·precomputed<>+0x2778(SB)/8, $0xbee9ab9209b71f19
-DATA ·precomputed<>+0x2780(SB)/8, $0x908486f545aca2fe
-DATA ·precomputed<>+0x2788(SB)/8, $0x8e82ea196ff7bca9
-DATA ·precomputed<>+0x2790(SB)/8, $0x79ad224cfae25456
-DATA ·precomputed<>+0x2798(SB)/8, $0xba21069ce4ae6c09
-DATA ·precomputed<>+0x27a0(SB)/8, $0xd62c86151dfbc94c
-DATA ·precomputed<>+0x27a8(SB)/8, $0x14d114e65e3bdce9
-DATA ·precomputed<>+0x27b0(SB)/8, $0xc2e4c5183e1ec12b
-DATA ·precomputed<>+0x27b8(SB)/8, $0xd130dfe657b3a606
-DATA ·precomputed<>+0x27c0(SB)/8, $0xd959f317635abcf0
-DATA ·precomputed<>+0x27c8(SB)/8, $0xa516a43f99e17618
-DATA ·precomputed<>+0x27d0(SB)/8, $0xed90ccf2ce3bd99b
-DATA ·precomputed<>+0x27d8(SB)/8, $0x2fc6d460a9fb3290
-DATA ·precomputed<>+0x27e0(SB)/8, $0xb61ebe090cde4302
-DATA ·precomputed<>+0x27e8(SB)/8, $0x5a3b061ff908003b
-DATA ·precomputed<>+0x27f0(SB)/8, $0xf51bb736f60f5787
-DATA ·precomputed<>+0x27f8(SB)/8, $0x1717f6e9057efc2f
-DATA ·precomputed<>+0x2800(SB)/8, $0xa8bf21726ddc3d83
-DATA ·precomputed<>+0x2808(SB)/8, $0xf68deb6ed88207bb
-DATA ·precomputed<>+0x2810(SB)/8, $0xa8eae2ebcd03bd7e
-DATA ·precomputed<>+0x2818(SB)/8, $0x64c7f57e951f59a4
-DATA ·precomputed<>+0x2820(SB)/8, $0x8badb223a1786d57
-DATA ·precomputed<>+0x2828(SB)/8, $0x2e7fda6071182790
-DATA ·precomputed<>+0x2830(SB)/8, $0x9dc90e369a5a9457
-DATA ·precomputed<>+0x2838(SB)/8, $0x6eca838bf4b07e07
-DATA ·precomputed<>+0x2840(SB)/8, $0x9a4b9b92167cdd1f
-DATA ·precomputed<>+0x2848(SB)/8, $0xa9118fc0f879b894
-DATA ·precomputed<>+0x2850(SB)/8, $0xf6e73387c55479f5
-DATA ·precomputed<>+0x2858(SB)/8, $0xfadf82edc626d292
-DATA ·precomputed<>+0x2860(SB)/8, $0xa03bb76156e80e6a
-DATA ·precomputed<>+0x2868(SB)/8, $0x59a783f9f27555d1
-DATA ·precomputed<>+0x2870(SB)/8, $0x27d63b63d087e43
-DATA ·precomputed<>+0x2878(SB)/8, $0x29f9ff3202fdeded
-DATA ·precomputed<>+0x2880(SB)/8, $0x865e07611b8c82e2
-DATA ·precomputed<>+0x2888(SB)/8, $0xcf11bcb9a659f2ab
-DATA ·precomputed<>+0x2890(SB)/8, $0x1804bbeb7c868143
-DATA ·precomputed<>+0x2898(SB)/8, $0x2fa89a0e453e36eb
-DATA ·precomputed<>+0x28a0(SB)/8, $0x42d69d8f2e17bad1
-DATA ·precomputed<>+0x28a8(SB)/8, $0xe7fcea6fdc2ec741
-DATA ·precomputed<>+0x28b0(SB)/8, $0xe7f19b45379ceb37
-DATA ·precomputed<>+0x28b8(SB)/8, $0x84f0bd8949bb35a0
-DATA ·precomputed<>+0x28c0(SB)/8, $0xecb8f92d0cf4efe5
-DATA ·precomputed<>+0x28c8(SB)/8, $0x88c47214960e2d22
-DATA ·precomputed<>+0x28d0(SB)/8, $0xca9549ef6059f079
-DATA ·precomputed<>+0x28d8(SB)/8, $0xd0a3774a7016da7c
-DATA ·precomputed<>+0x28e0(SB)/8, $0xd51c95f61d001cab
-DATA ·precomputed<>+0x28e8(SB)/8, $0x2d744defa3feeec1
-DATA ·precomputed<>+0x28f0(SB)/8, $0xb7c20cc20afedf2b
-DATA ·precomputed<>+0x28f8(SB)/8, $0xbf16c5f171d144a5
-DATA ·precomputed<>+0x2900(SB)/8, $0x20bfbd77642c467a
-DATA ·precomputed<>+0x2908(SB)/8, $0x3452bb12259d50c8
-DATA ·precomputed<>+0x2910(SB)/8, $0xd3ba9c7ec7ffab2
-DATA ·precomputed<>+0x2918(SB)/8, $0xbbdb54543560e541
-DATA ·precomputed<>+0x2920(SB)/8, $0xab1d6e22d63ba04b
-DATA ·precomputed<>+0x2928(SB)/8, $0xdf6f11d37d24f015
-DATA ·precomputed<>+0x2930(SB)/8, $0x7c4d61d2f3df15fa
-DATA ·precomputed<>+0x2938(SB)/8, $0xd5269f7940b3288c
-DATA ·precomputed<>+0x2940(SB)/8, $0xa8ca0be24c4b12c5
-DATA ·precomputed<>+0x2948(SB)/8, $0x633292b628762d5d
-DATA ·precomputed<>+0x2950(SB)/8, $0xc04983f2827c0d5e
-DATA ·precomputed<>+0x2958(SB)/8, $0xcb6b867dc707ef03
-DATA ·precomputed<>+0x2960(SB)/8, $0xa7fc0d5bb9ac1124
-DATA ·precomputed<>+0x2968(SB)/8, $0xa5ce085baab7dcaf
-DATA ·precomputed<>+0x2970(SB)/8, $0xb85e8f1c1cfda998
-DATA ·precomputed<>+0x2978(SB)/8, $0x8208df4227822503
-DATA ·precomputed<>+0x2980(SB)/8, $0xc480938fc83f86c4
-DATA ·precomputed<>+0x2988(SB)/8, $0x6479b8efe43bfcc6
-DATA ·precomputed<>+0x2990(SB)/8, $0x8e6f2e2238cabad7
-DATA ·precomputed<>+0x2998(SB)/8, $0x48e57fdd31f8c6aa
-DATA ·precomputed<>+0x29a0(SB)/8, $0x66dd6a77cfbbdcac
-DATA ·precomputed<>+0x29a8(SB)/8, $0xc7d9950b50ece329
-DATA ·precomputed<>+0x29b0(SB)/8, $0x2e31f2050747a937
-DATA ·precomputed<>+0x29b8(SB)/8, $0xc0f8a7e2a07acb8a
-DATA ·precomputed<>+0x29c0(SB)/8, $0x81125450677b7a8f
-DATA ·precomputed<>+0x29c8(SB)/8, $0xba889fcee69273d2
-DATA ·precomputed<>+0x29d0(SB)/8, $0x4a40a859582c5990
-DATA ·precomputed<>+0x29d8(SB)/8, $0x836638b3f48934c3
-DATA ·precomputed<>+0x29e0(SB)/8, $0xe964e189f3596ba6
-DATA ·precomputed<>+0x29e8(SB)/8, $0x2f417c0ede8b0754
-DATA ·precomputed<>+0x29f0(SB)/8, $0xd883169fd5f93f1b
-DATA ·precomputed<>+0x29f8(SB)/8, $0x318fe4ed45bb389
-DATA ·precomputed<>+0x2a00(SB)/8, $0x55b77ef4418acd7b
-DATA ·precomputed<>+0x2a08(SB)/8, $0x64ba62d347a77d32
-DATA ·precomputed<>+0x2a10(SB)/8, $0xaec1aa932d1f562e
-DATA ·precomputed<>+0x2a18(SB)/8, $0x3468725b10dc5999
-DATA ·precomputed<>+0x2a20(SB)/8, $0x422851b16ff0d478
-DATA ·precomputed<>+0x2a28(SB)/8, $0x15da84298e7dddcc
-DATA ·precomputed<>+0x2a30(SB)/8, $0x38567920b8ac5238
-DATA ·precomputed<>+0x2a38(SB)/8, $0xfd29eb4a2e3344d8
-DATA ·precomputed<>+0x2a40(SB)/8, $0xb0a31a1c855512cf
-DATA ·precomputed<>+0x2a48(SB)/8, $0x293a819ed71d4c4e
-DATA ·precomputed<>+0x2a50(SB)/8, $0xc1ebc896cd6a900f
-DATA ·precomputed<>+0x2a58(SB)/8, $0xc727a6469b9e0a4b
-DATA ·precomputed<>+0x2a60(SB)/8, $0x6124fc00018f29f
-DATA ·precomputed<>+0x2a68(SB)/8, $0x67bd8fed41b7730c
-DATA ·precomputed<>+0x2a70(SB)/8, $0xeeebf0f0c77be72e
-DATA ·precomputed<>+0x2a78(SB)/8, $0x427fe6fe474d747a
-DATA ·precomputed<>+0x2a80(SB)/8, $0xde4873646fecb64c
-DATA ·precomputed<>+0x2a88(SB)/8, $0xa8fda1fdd15fb62f
-DATA ·precomputed<>+0x2a90(SB)/8, $0x97e2febe088de028
-DATA ·precomputed<>+0x2a98(SB)/8, $0x4a769019ecdce095
-DATA ·precomputed<>+0x2aa0(SB)/8, $0x4cb6a33850a58ddb
-DATA ·precomputed<>+0x2aa8(SB)/8, $0x8df59d817028d36
-DATA ·precomputed<>+0x2ab0(SB)/8, $0xfe3a80ffb51722b7
-DATA ·precomputed<>+0x2ab8(SB)/8, $0xa3cc2fe2963c2383
-DATA ·precomputed<>+0x2ac0(SB)/8, $0xb559e34d674d10cf
-DATA ·precomputed<>+0x2ac8(SB)/8, $0x6955bb699f962ec5
-DATA ·precomputed<>+0x2ad0(SB)/8, $0x8bf1ab6c542af42d
-DATA ·precomputed<>+0x2ad8(SB)/8, $0x3f2f33fadfa61256
-DATA ·precomputed<>+0x2ae0(SB)/8, $0x3214019573d1049e
-DATA ·precomputed<>+0x2ae8(SB)/8, $0xf5089278dfd7f39b
-DATA ·precomputed<>+0x2af0(SB)/8, $0xb42eb51cb4237be0
-DATA ·precomputed<>+0x2af8(SB)/8, $0xdf747f44874d0e57
-DATA ·precomputed<>+0x2b00(SB)/8, $0x851631f7fd52a3d
-DATA ·precomputed<>+0x2b08(SB)/8, $0x9881db71949ad4be
-DATA ·precomputed<>+0x2b10(SB)/8, $0x4b2337dd88caf772
-DATA ·precomputed<>+0x2b18(SB)/8, $0x2da59de33ec7979
-DATA ·precomputed<>+0x2b20(SB)/8, $0x2473c620afe840df
-DATA ·precomputed<>+0x2b28(SB)/8, $0x2965ebffa92ef1d0
-DATA ·precomputed<>+0x2b30(SB)/8, $0x2452854f6fcd9651
-DATA ·precomputed<>+0x2b38(SB)/8, $0x97092935bac2ed99
-DATA ·precomputed<>+0x2b40(SB)/8, $0x91973d4d4aa359d7
-DATA ·precomputed<>+0x2b48(SB)/8, $0x249f510cc8dd0582
-DATA ·precomputed<>+0x2b50(SB)/8, $0xef11ac877608be27
-DATA ·precomputed<>+0x2b58(SB)/8, $0xce116714d940b1c7
-DATA ·precomputed<>+0x2b60(SB)/8, $0xf34881f3fef20037
-DATA ·precomputed<>+0x2b68(SB)/8, $0x2622247298203f4e
-DATA ·precomputed<>+0x2b70(SB)/8, $0x4c9e98ede9363154
-DATA ·precomputed<>+0x2b78(SB)/8, $0xa806b3a603a8158d
-DATA ·precomputed<>+0x2b80(SB)/8, $0xdd4a1a18cec38056
-DATA ·precomputed<>+0x2b88(SB)/8, $0xe6ef179375544998
-DATA ·precomputed<>+0x2b90(SB)/8, $0xf58f69cf30583fb6
-DATA ·precomputed<>+0x2b98(SB)/8, $0x12197860aa76bf2b
-DATA ·precomputed<>+0x2ba0(SB)/8, $0x717813e53bb686fc
-DATA ·precomputed<>+0x2ba8(SB)/8, $0x9beeb1ae0f06c403
-DATA ·precomputed<>+0x2bb0(SB)/8, $0xd83416ee2782dc86
-DATA ·precomputed<>+0x2bb8(SB)/8, $0x5fc89c01b5500ccc
-DATA ·precomputed<>+0x2bc0(SB)/8, $0x26bc537af0c1bb4f
-DATA ·precomputed<>+0x2bc8(SB)/8, $0x37f376ffd06b90f5
-DATA ·precomputed<>+0x2bd0(SB)/8, $0x4d48d994be7c89cf
-DATA ·precomputed<>+0x2bd8(SB)/8, $0x511c21588d572003
-DATA ·precomputed<>+0x2be0(SB)/8, $0xc26fbac1088dda1e
-DATA ·precomputed<>+0x2be8(SB)/8, $0xc3d551897ad4934c
-DATA ·precomputed<>+0x2bf0(SB)/8, $0x5233c17685dcaf7c
-DATA ·precomputed<>+0x2bf8(SB)/8, $0xec3a8a29a88b473d
-DATA ·precomputed<>+0x2c00(SB)/8, $0xec49fa2000756ff4
-DATA ·precomputed<>+0x2c08(SB)/8, $0x8a3780fc170012f6
-DATA ·precomputed<>+0x2c10(SB)/8, $0xf705a3be47e2330f
-DATA ·precomputed<>+0x2c18(SB)/8, $0x8824a93e6b3830fc
-DATA ·precomputed<>+0x2c20(SB)/8, $0x705dc2eacf8012be
-DATA ·precomputed<>+0x2c28(SB)/8, $0xb03d003a026251c0
-DATA ·precomputed<>+0x2c30(SB)/8, $0x9cd702c2d74dfeb
-DATA ·precomputed<>+0x2c38(SB)/8, $0x72bb4b3ca14d425b
-DATA ·precomputed<>+0x2c40(SB)/8, $0x770017d62ffc0d21
-DATA ·precomputed<>+0x2c48(SB)/8, $0x62cb5ab672a6cdbc
-DATA ·precomputed<>+0x2c50(SB)/8, $0xeb81fc06d01b23f
-DATA ·precomputed<>+0x2c58(SB)/8, $0xb01ebe7526d88b5
-DATA ·precomputed<>+0x2c60(SB)/8, $0xee530b9cbd3ac659
-DATA ·precomputed<>+0x2c68(SB)/8, $0x79040d26d2d960ec
-DATA ·precomputed<>+0x2c70(SB)/8, $0xdfecd174cf860639
-DATA ·precomputed<>+0x2c78(SB)/8, $0xc808a4f9df80f814
-DATA ·precomputed<>+0x2c80(SB)/8, $0xea19be530a8156ba
-DATA ·precomputed<>+0x2c88(SB)/8, $0x7c6b7a0f62414a63
-DATA ·precomputed<>+0x2c90(SB)/8, $0xffba03d4b84b3ad0
-DATA ·precomputed<>+0x2c98(SB)/8, $0xa785a9900238a670
-DATA ·precomputed<>+0x2ca0(SB)/8, $0xba00667531bb3251
-DATA ·precomputed<>+0x2ca8(SB)/8, $0x4eff247c8f533e3
-DATA ·precomputed<>+0x2cb0(SB)/8, $0xcf34c993a38acfac
-DATA ·precomputed<>+0x2cb8(SB)/8, $0xb00f3f0faee24e79
-DATA ·precomputed<>+0x2cc0(SB)/8, $0xa0a30fbb573c9aaf
-DATA ·precomputed<>+0x2cc8(SB)/8, $0xe711249e3cc6c78
-DATA ·precomputed<>+0x2cd0(SB)/8, $0x3b57c921a1cc5c2f
-DATA ·precomputed<>+0x2cd8(SB)/8, $0x35b1badbcd70c03e
-DATA ·precomputed<>+0x2ce0(SB)/8, $0x882b286a9384dc1e
-DATA ·precomputed<>+0x2ce8(SB)/8, $0x94e98c4843a5febb
-DATA ·precomputed<>+0x2cf0(SB)/8, $0xf2872b3abb46e3c6
-DATA ·precomputed<>+0x2cf8(SB)/8, $0xc8258ff8afa45b10
-DATA ·precomputed<>+0x2d00(SB)/8, $0x47f19c7e4a97ab3
-DATA ·precomputed<>+0x2d08(SB)/8, $0xf803eee4503cc66c
-DATA ·precomputed<>+0x2d10(SB)/8, $0xe29f0ac521b81d13
-DATA ·precomputed<>+0x2d18(SB)/8, $0xab59461db451ad83
-DATA ·precomputed<>+0x2d20(SB)/8, $0x73397e57f77e82df
-DATA ·precomputed<>+0x2d28(SB)/8, $0x724bc1f1dbc3ddd
-DATA ·precomputed<>+0x2d30(SB)/8, $0xa226c95a413409e2
-DATA ·precomputed<>+0x2d38(SB)/8, $0x1460335473b6b6a7
-DATA ·precomputed<>+0x2d40(SB)/8, $0x9dde6bde19c0ae99
-DATA ·precomputed<>+0x2d48(SB)/8, $0xf8ed7b1214cb1e1b
-DATA ·precomputed<>+0x2d50(SB)/8, $0xc4ab7b9b19f290c8
-DATA ·precomputed<>+0x2d58(SB)/8, $0x30cfd608d79d83e3
-DATA ·precomputed<>+0x2d60(SB)/8, $0x7fd5cbf29d758101
-DATA ·precomputed<>+0x2d68(SB)/8, $0x6489dc7e39bb5972
-DATA ·precomputed<>+0x2d70(SB)/8, $0x97c41fce7cc0dc12
-DATA ·precomputed<>+0x2d78(SB)/8, $0x4742aa823916d023
-DATA ·precomputed<>+0x2d80(SB)/8, $0xf4cdabb86975f70
-DATA ·precomputed<>+0x2d88(SB)/8, $0xaae8fbe98f56ccdc
-DATA ·precomputed<>+0x2d90(SB)/8, $0x173f352bc889a20d
-DATA ·precomputed<>+0x2d98(SB)/8, $0xe721d8f6c0a6a43f
-DATA ·precomputed<>+0x2da0(SB)/8, $0x1008616a58dae8dd
-DATA ·precomputed<>+0x2da8(SB)/8, $0x15e82d497f7808af
-DATA ·precomputed<>+0x2db0(SB)/8, $0xa9eea40bcd5c8a7b
-DATA ·precomputed<>+0x2db8(SB)/8, $0x697e6d001e1cf62
-DATA ·precomputed<>+0x2dc0(SB)/8, $0x5e09a3a880b5f763
-DATA ·precomputed<>+0x2dc8(SB)/8, $0xc365267964ed2fd1
-DATA ·precomputed<>+0x2dd0(SB)/8, $0x92b39fb7bd69d361
-DATA ·precomputed<>+0x2dd8(SB)/8, $0xf8018599b1386a62
-DATA ·precomputed<>+0x2de0(SB)/8, $0xff642d562056479b
-DATA ·precomputed<>+0x2de8(SB)/8, $0xace29a145837087f
-DATA ·precomputed<>+0x2df0(SB)/8, $0xd5dac94ce8a2e3c3
-DATA ·precomputed<>+0x2df8(SB)/8, $0x9f0d3dcd8bbf810a
-DATA ·precomputed<>+0x2e00(SB)/8, $0x97ebd735488e9250
-DATA ·precomputed<>+0x2e08(SB)/8, $0xab8515ee6b06a4f4
-DATA ·precomputed<>+0x2e10(SB)/8, $0x4a8135791602cf4b
-DATA ·precomputed<>+0x2e18(SB)/8, $0xb564a3fbecb4ba15
-DATA ·precomputed<>+0x2e20(SB)/8, $0x9637e7e722051dd6
-DATA ·precomputed<>+0x2e28(SB)/8, $0x590cc6177d693077
-DATA ·precomputed<>+0x2e30(SB)/8, $0x8aaa136a6377448c
-DATA ·precomputed<>+0x2e38(SB)/8, $0x90a047784d596679
-DATA ·precomputed<>+0x2e40(SB)/8, $0x7079cd9268c778c4
-DATA ·precomputed<>+0x2e48(SB)/8, $0xdefec5c18a2fa076
-DATA ·precomputed<>+0x2e50(SB)/8, $0xed21b49fbcde1fb4
-DATA ·precomputed<>+0x2e58(SB)/8, $0xdb191310cf773e14
-DATA ·precomputed<>+0x2e60(SB)/8, $0x2f00bd6e107c1070
-DATA ·precomputed<>+0x2e68(SB)/8, $0x4912355dbf4836bf
-DATA ·precomputed<>+0x2e70(SB)/8, $0xa620527eec613284
-DATA ·precomputed<>+0x2e78(SB)/8, $0xba0c95c326feb2ef
-DATA ·precomputed<>+0x2e80(SB)/8, $0x6c3b1c0614204e49
-DATA ·precomputed<>+0x2e88(SB)/8, $0x500c2bfa5ee6ff20
-DATA ·precomputed<>+0x2e90(SB)/8, $0xd74499f8203dfada
-DATA ·precomputed<>+0x2e98(SB)/8, $0xeff3057074e8e023
-DATA ·precomputed<>+0x2ea0(SB)/8, $0x32eec1a73a748ce8
-DATA ·precomputed<>+0x2ea8(SB)/8, $0xae9759039933f0d
-DATA ·precomputed<>+0x2eb0(SB)/8, $0xa6178cefce39bb2e
-DATA ·precomputed<>+0x2eb8(SB)/8, $0x3324d7e67476c02
-DATA ·precomputed<>+0x2ec0(SB)/8, $0x56001c95b261429
-DATA ·precomputed<>+0x2ec8(SB)/8, $0xf1566d639f1c4c91
-DATA ·precomputed<>+0x2ed0(SB)/8, $0x2e30662c3c708120
-DATA ·precomputed<>+0x2ed8(SB)/8, $0x2130cfa7fa306a44
-DATA ·precomputed<>+0x2ee0(SB)/8, $0x686c1a8e4f57f772
-DATA ·precomputed<>+0x2ee8(SB)/8, $0xd53c9e669cca3398
-DATA ·precomputed<>+0x2ef0(SB)/8, $0xa355d4890bfde0e6
-DATA ·precomputed<>+0x2ef8(SB)/8, $0xdaf31990e2eeb53
-DATA ·precomputed<>+0x2f00(SB)/8, $0xd8983ce744d242f9
-DATA ·precomputed<>+0x2f08(SB)/8, $0xeba307a320e6560
-DATA ·precomputed<>+0x2f10(SB)/8, $0xb0f38572d6d08198
-DATA ·precomputed<>+0x2f18(SB)/8, $0xb9d703c1a0478ba1
-DATA ·precomputed<>+0x2f20(SB)/8, $0xa1f4be9b86229ebd
-DATA ·precomputed<>+0x2f28(SB)/8, $0x322e8761b1fa13d
-DATA ·precomputed<>+0x2f30(SB)/8, $0x9490a6f5b989bc8b
-DATA ·precomputed<>+0x2f38(SB)/8, $0xe50d9c5e6d22c2da
-DATA ·precomputed<>+0x2f40(SB)/8, $0xa5e66b7affda1e6
-DATA ·precomputed<>+0x2f48(SB)/8, $0xc995fbb823350a9e
-DATA ·precomputed<>+0x2f50(SB)/8, $0x470f18b3162ab2e4
-DATA ·precomputed<>+0x2f58(SB)/8, $0x867084efd602bfb2
-DATA ·precomputed<>+0x2f60(SB)/8, $0x30ca62d713e3a749
-DATA ·precomputed<>+0x2f68(SB)/8, $0x62174f08c55b29a3
-DATA ·precomputed<>+0x2f70(SB)/8, $0x3a0a32950918f74b
-DATA ·precomputed<>+0x2f78(SB)/8, $0xf3cb806de9481808
-DATA ·precomputed<>+0x2f80(SB)/8, $0x1d650bda087e09f2
-DATA ·precomputed<>+0x2f88(SB)/8, $0x1c5dca56c8054e04
-DATA ·precomputed<>+0x2f90(SB)/8, $0xd59a259d190099c0
-DATA ·precomputed<>+0x2f98(SB)/8, $0x203f43a1091f3536
-DATA ·precomputed<>+0x2fa0(SB)/8, $0x60b7754c142ae17
-DATA ·precomputed<>+0x2fa8(SB)/8, $0x7d4ebc2264435fc0
-DATA ·precomputed<>+0x2fb0(SB)/8, $0xf3573caaaccbbb09
-DATA ·precomputed<>+0x2fb8(SB)/8, $0x40eeaace55996375
-DATA ·precomputed<>+0x2fc0(SB)/8, $0xfd96667ab1f0c175
-DATA ·precomputed<>+0x2fc8(SB)/8, $0xa256a6112ab99e7d
-DATA ·precomputed<>+0x2fd0(SB)/8, $0xff07c1ea05e43f9d
-DATA ·precomputed<>+0x2fd8(SB)/8, $0x305700bc7e1c9cd6
-DATA ·precomputed<>+0x2fe0(SB)/8, $0x3f1e25462b2887a3
-DATA ·precomputed<>+0x2fe8(SB)/8, $0xdd782f49c772fd14
-DATA ·precomputed<>+0x2ff0(SB)/8, $0x9125f99638584057
-DATA ·precomputed<>+0x2ff8(SB)/8, $0x19fd039616a02cf9
-DATA ·precomputed<>+0x3000(SB)/8, $0xa8d62bd34c58174d
-DATA ·precomputed<>+0x3008(SB)/8, $0x872251d3a900551f
-DATA ·precomputed<>+0x3010(SB)/8, $0x6f5862df12802c3
-DATA ·precomputed<>+0x3018(SB)/8, $0x5d93c48add925555
-DATA ·precomputed<>+0x3020(SB)/8, $0xc39b67d5bd6006f8
-DATA ·precomputed<>+0x3028(SB)/8, $0xea6f756bf96ccc67
-DATA ·precomputed<>+0x3030(SB)/8, $0x140e853e543014db
-DATA ·precomputed<>+0x3038(SB)/8, $0x2bdc5674e9de42c0
-DATA ·precomputed<>+0x3040(SB)/8, $0xfa1a58faeda49f74
-DATA ·precomputed<>+0x3048(SB)/8, $0xddb899570e4545a3
-DATA ·precomputed<>+0x3050(SB)/8, $0xd3576489c74c07e4
-DATA ·precomputed<>+0x3058(SB)/8, $0x64e4b39eb59b1008
-DATA ·precomputed<>+0x3060(SB)/8, $0x3b090340f66b546c
-DATA ·precomputed<>+0x3068(SB)/8, $0xe0f4013cdeb912f
-DATA ·precomputed<>+0x3070(SB)/8, $0xbb00b46c01e55cca
-DATA ·precomputed<>+0x3078(SB)/8, $0x55b61b3499ad0768
-DATA ·precomputed<>+0x3080(SB)/8, $0xc0d22337e989963e
-DATA ·precomputed<>+0x3088(SB)/8, $0x2c4831ced3778d5a
-DATA ·precomputed<>+0x3090(SB)/8, $0xd775c6a5ee8c4178
-DATA ·precomputed<>+0x3098(SB)/8, $0xe23916549d0c2894
-DATA ·precomputed<>+0x30a0(SB)/8, $0xf7d4fe865d0eb314
-DATA ·precomputed<>+0x30a8(SB)/8, $0x42801b8f8b2290d3
-DATA ·precomputed<>+0x30b0(SB)/8, $0x73e9b332cdcefa78
-DATA ·precomputed<>+0x30b8(SB)/8, $0xc0d169d93e877fea
-DATA ·precomputed<>+0x30c0(SB)/8, $0xcab02370734a1327
-DATA ·precomputed<>+0x30c8(SB)/8, $0xa1df7afc1951afa8
-DATA ·precomputed<>+0x30d0(SB)/8, $0x581cfbaf42638b8a
-DATA ·precomputed<>+0x30d8(SB)/8, $0x39db6d2b2130eaa6
-DATA ·precomputed<>+0x30e0(SB)/8, $0x4bbc805bda2f91a5
-DATA ·precomputed<>+0x30e8(SB)/8, $0x3dcb0a7ee569add8
-DATA ·precomputed<>+0x30f0(SB)/8, $0x724ab65ad721fa7d
-DATA ·precomputed<>+0x30f8(SB)/8, $0xa5152b95f88f8008
-DATA ·precomputed<>+0x3100(SB)/8, $0xedf38dc441177a46
-DATA ·precomputed<>+0x3108(SB)/8, $0xd9a955bb7f039a7b
-DATA ·precomputed<>+0x3110(SB)/8, $0x4f1525814d8ae7c2
-DATA ·precomputed<>+0x3118(SB)/8, $0x63c9f834f848819
-DATA ·precomputed<>+0x3120(SB)/8, $0x54ea4526841e8783
-DATA ·precomputed<>+0x3128(SB)/8, $0xe86a4119aa5f2b32
-DATA ·precomputed<>+0x3130(SB)/8, $0xb7529a3b19846dcf
-DATA ·precomputed<>+0x3138(SB)/8, $0x91356a0735689d70
-DATA ·precomputed<>+0x3140(SB)/8, $0x658f4dea8910763e
-DATA ·precomputed<>+0x3148(SB)/8, $0x6e5fcb48076a0f80
-DATA ·precomputed<>+0x3150(SB)/8, $0x6a5447a4ab65f9b9
-DATA ·precomputed<>+0x3158(SB)/8, $0xd7d863d4a75bb0c5
-DATA ·precomputed<>+0x3160(SB)/8, $0x806c34a7e87e7916
-DATA ·precomputed<>+0x3168(SB)/8, $0x5391559cd961e88
-DATA ·precomputed<>+0x3170(SB)/8, $0x5def2d8874fe6aeb
-DATA ·precomputed<>+0x3178(SB)/8, $0x8ac350b2f9226ca1
-DATA ·precomputed<>+0x3180(SB)/8, $0xff9635d081443d16
-DATA ·precomputed<>+0x3188(SB)/8, $0x2342cbfaa6cc364b
-DATA ·precomputed<>+0x3190(SB)/8, $0x63b0a03225bf8438
-DATA ·precomputed<>+0x3198(SB)/8, $0x6ccd3ce5a078d298
-DATA ·precomputed<>+0x31a0(SB)/8, $0xf93bd10891292fd3
-DATA ·precomputed<>+0x31a8(SB)/8, $0xc887a31b14073286
-DATA ·precomputed<>+0x31b0(SB)/8, $0xeb1275bf9f62cd16
-DATA ·precomputed<>+0x31b8(SB)/8, $0x335bae361578b46
-DATA ·precomputed<>+0x31c0(SB)/8, $0xbee2aca7614d9ff3
-DATA ·precomputed<>+0x31c8(SB)/8, $0xd1f13b2c358f245a
-DATA ·precomputed<>+0x31d0(SB)/8, $0x9e92d83fc46f62ab
-DATA ·precomputed<>+0x31d8(SB)/8, $0xc1dd32dd827d7374
-DATA ·precomputed<>+0x31e0(SB)/8, $0x1636d593c3e566e7
-DATA ·precomputed<>+0x31e8(SB)/8, $0x81c2f4e704ccb02b
-DATA ·precomputed<>+0x31f0(SB)/8, $0xb57782c6cd35b652
-DATA ·precomputed<>+0x31f8(SB)/8, $0xad88787e88210d42
-DATA ·precomputed<>+0x3200(SB)/8, $0xf38f09cab4f6bd9
-DATA ·precomputed<>+0x3208(SB)/8, $0xec3037b4922dcac1
-DATA ·precomputed<>+0x3210(SB)/8, $0x706b201a08a1a51e
-DATA ·precomputed<>+0x3218(SB)/8, $0x159113518ffff040
-DATA ·precomputed<>+0x3220(SB)/8, $0x239d7b6accf63d87
-DATA ·precomputed<>+0x3228(SB)/8, $0xeca37dc85187f595
-DATA ·precomputed<>+0x3230(SB)/8, $0x4ea79e4ad5a0ab3
-DATA ·precomputed<>+0x3238(SB)/8, $0xcdd81522e9520e8f
-DATA ·precomputed<>+0x3240(SB)/8, $0xfeed0feb66ed96f9
-DATA ·precomputed<>+0x3248(SB)/8, $0x1632632eecc3a8dc
-DATA ·precomputed<>+0x3250(SB)/8, $0x904493631455c8ae
-DATA ·precomputed<>+0x3258(SB)/8, $0x8d7619d40aeada65
-DATA ·precomputed<>+0x3260(SB)/8, $0x2f2fa8989f630ee9
-DATA ·precomputed<>+0x3268(SB)/8, $0xd78caf0c370db87c
-DATA ·precomputed<>+0x3270(SB)/8, $0x46fa0fc9c45898cf
-DATA ·precomputed<>+0x3278(SB)/8, $0xa509cc3e2d84244f
-DATA ·precomputed<>+0x3280(SB)/8, $0x5bb96415ee4df6ce
-DATA ·precomputed<>+0x3288(SB)/8, $0xd1e27bcfa3ae4cf3
-DATA ·precomputed<>+0x3290(SB)/8, $0x9bf7ace3c7f1868e
-DATA ·precomputed<>+0x3298(SB)/8, $0xe821aa8b82091dca
-DATA ·precomputed<>+0x32a0(SB)/8, $0xf732e6bcd381b6c4
-DATA ·precomputed<>+0x32a8(SB)/8, $0x5feda346dd01864f
-DATA ·precomputed<>+0x32b0(SB)/8, $0x933b92cb6387846
-DATA ·precomputed<>+0x32b8(SB)/8, $0xbf1f1e83a0028029
-DATA ·precomputed<>+0x32c0(SB)/8, $0xdbba28741f25da8b
-DATA ·precomputed<>+0x32c8(SB)/8, $0x14027f11097ba4a9
-DATA ·precomputed<>+0x32d0(SB)/8, $0xe429b3c734b8e4a2
-DATA ·precomputed<>+0x32d8(SB)/8, $0xd66a43e3056b4afc
-DATA ·precomputed<>+0x32e0(SB)/8, $0x158644ad2ac351e6
-DATA ·precomputed<>+0x32e8(SB)/8, $0xff4aecd9164bc6cc
-DATA ·precomputed<>+0x32f0(SB)/8, $0xbb5b0c87f6c615ee
-DATA ·precomputed<>+0x32f8(SB)/8, $0xc497d8eed7679b1b
-DATA ·precomputed<>+0x3300(SB)/8, $0x8bb8c27601232d03
-DATA ·precomputed<>+0x3308(SB)/8, $0xd446c82efb371cf1
-DATA ·precomputed<>+0x3310(SB)/8, $0xe5e8b639efa495f4
-DATA ·precomputed<>+0x3318(SB)/8, $0x51a7b34a477e6493
-DATA ·precomputed<>+0x3320(SB)/8, $0xffba5466824f2b6e
-DATA ·precomputed<>+0x3328(SB)/8, $0xcc67ddadf0eaa6a9
-DATA ·precomputed<>+0x3330(SB)/8, $0xcf0f8ce1fee19b24
-DATA ·precomputed<>+0x3338(SB)/8, $0x3430912783b3df41
-DATA ·precomputed<>+0x3340(SB)/8, $0x72a26c5f52bf29a5
-DATA ·precomputed<>+0x3348(SB)/8, $0xb513d669b6534592
-DATA ·precomputed<>+0x3350(SB)/8, $0xb8ac15ad578195ea
-DATA ·precomputed<>+0x3358(SB)/8, $0xd6ed33eac0785f88
-DATA ·precomputed<>+0x3360(SB)/8, $0x39e23dbfb9e33946
-DATA ·precomputed<>+0x3368(SB)/8, $0xeadb2453f43e88eb
-DATA ·precomputed<>+0x3370(SB)/8, $0x6d82fefa2746c34b
-DATA ·precomputed<>+0x3378(SB)/8, $0xe9172aa0cc542b54
-DATA ·precomputed<>+0x3380(SB)/8, $0xcc3f76b66a05ab55
-DATA ·precomputed<>+0x3388(SB)/8, $0x2ab4b29e98091425
-DATA ·precomputed<>+0x3390(SB)/8, $0xbf373ad1b6478fc8
-DATA ·precomputed<>+0x3398(SB)/8, $0x8a1a9489178b5844
-DATA ·precomputed<>+0x33a0(SB)/8, $0xb5295edf09daf4be
-DATA ·precomputed<>+0x33a8(SB)/8, $0x7fbb1194ed54766
-DATA ·precomputed<>+0x33b0(SB)/8, $0x6e44367b7d0b9d8f
-DATA ·precomputed<>+0x33b8(SB)/8, $0x6dc4d8f6edb96a10
-DATA ·precomputed<>+0x33c0(SB)/8, $0xb5b383c6150b0fcd
-DATA ·precomputed<>+0x33c8(SB)/8, $0xf948da80ed9b0f4c
-DATA ·precomputed<>+0x33d0(SB)/8, $0xcf075225ccd05413
-DATA ·precomputed<>+0x33d8(SB)/8, $0x3f31b12c4f62be64
-DATA ·precomputed<>+0x33e0(SB)/8, $0x23b21fc8368c17f6
-DATA ·precomputed<>+0x33e8(SB)/8, $0x423d5369400bc690
-DATA ·precomputed<>+0x33f0(SB)/8, $0x5335dd1edeac140e
-DATA ·precomputed<>+0x33f8(SB)/8, $0xe631c2499493ad61
-DATA ·precomputed<>+0x3400(SB)/8, $0x5cbe488394d3d60f
-DATA ·precomputed<>+0x3408(SB)/8, $0x6eba464d91cbc054
-DATA ·precomputed<>+0x3410(SB)/8, $0xf9c880d0021c38fa
-DATA ·precomputed<>+0x3418(SB)/8, $0x6200faf121af4942
-DATA ·precomputed<>+0x3420(SB)/8, $0xd5b2b12d5f03e261
-DATA ·precomputed<>+0x3428(SB)/8, $0x1659a0acf3ea3e07
-DATA ·precomputed<>+0x3430(SB)/8, $0x8008f18d836757a8
-DATA ·precomputed<>+0x3438(SB)/8, $0xfb2f467b75a8f8e0
-DATA ·precomputed<>+0x3440(SB)/8, $0x9887e28fb6170a5f
-DATA ·precomputed<>+0x3448(SB)/8, $0xf5b85d21f3c0c30c
-DATA ·precomputed<>+0x3450(SB)/8, $0x30861cf8632af7a4
-DATA ·precomputed<>+0x3458(SB)/8, $0x2fb670adbb4ec123
-DATA ·precomputed<>+0x3460(SB)/8, $0x668b84c3c425976
-DATA ·precomputed<>+0x3468(SB)/8, $0x55c21b4e02883af7
-DATA ·precomputed<>+0x3470(SB)/8, $0xfad58b5f8698d29
-DATA ·precomputed<>+0x3478(SB)/8, $0xef21077068b671c5
-DATA ·precomputed<>+0x3480(SB)/8, $0x4f915e1ca5a1162e
-DATA ·precomputed<>+0x3488(SB)/8, $0xdd539c2137efa40c
-DATA ·precomputed<>+0x3490(SB)/8, $0x61a45c53789201c2
-DATA ·precomputed<>+0x3498(SB)/8, $0x1bc2333de7890746
-DATA ·precomputed<>+0x34a0(SB)/8, $0xeed38f50bbed8f77
-DATA ·precomputed<>+0x34a8(SB)/8, $0xc1e93732178501a0
-DATA ·precomputed<>+0x34b0(SB)/8, $0xfed5b1d1a8fb8623
-DATA ·precomputed<>+0x34b8(SB)/8, $0xa3be3e2cdc3e1148
-DATA ·precomputed<>+0x34c0(SB)/8, $0xbcd02e8a8220dee8
-DATA ·precomputed<>+0x34c8(SB)/8, $0xbd4d1f2a705632fd
-DATA ·precomputed<>+0x34d0(SB)/8, $0x119bfd22f8e30b
-DATA ·precomputed<>+0x34d8(SB)/8, $0x6c6e73e6eb97c32
-DATA ·precomputed<>+0x34e0(SB)/8, $0xa26f0a6a35abff53
-DATA ·precomputed<>+0x34e8(SB)/8, $0x7d79a89f8564c37d
-DATA ·precomputed<>+0x34f0(SB)/8, $0x347bb171b207495
-DATA ·precomputed<>+0x34f8(SB)/8, $0x1baf90e9b5c8253a
-DATA ·precomputed<>+0x3500(SB)/8, $0xe8b0e7e953df6fd
-DATA ·precomputed<>+0x3508(SB)/8, $0x38ea7cea62036a87
-DATA ·precomputed<>+0x3510(SB)/8, $0x57e01428655c3685
-DATA ·precomputed<>+0x3518(SB)/8, $0xaedfee73c39d8a43
-DATA ·precomputed<>+0x3520(SB)/8, $0xed7f65985fb27e0a
-DATA ·precomputed<>+0x3528(SB)/8, $0x524c3653946888e0
-DATA ·precomputed<>+0x3530(SB)/8, $0xd84a299be949b72f
-DATA ·precomputed<>+0x3538(SB)/8, $0x76c1397ab0c61ea4
-DATA ·precomputed<>+0x3540(SB)/8, $0x6129f861e8bfd84d
-DATA ·precomputed<>+0x3548(SB)/8, $0x1df491d677e35a47
-DATA ·precomputed<>+0x3550(SB)/8, $0xefe0e9a9a84a82cb
-DATA ·precomputed<>+0x3558(SB)/8, $0x972bc3bc6d949612
-DATA ·precomputed<>+0x3560(SB)/8, $0x8d7795f53a766eca
-DATA ·precomputed<>+0x3568(SB)/8, $0x6119383f12fcc6d4
-DATA ·precomputed<>+0x3570(SB)/8, $0xa66d9836c95f0e21
-DATA ·precomputed<>+0x3578(SB)/8, $0x77a0aa0a684e434b
-DATA ·precomputed<>+0x3580(SB)/8, $0x7502146b38b99f23
-DATA ·precomputed<>+0x3588(SB)/8, $0x479ab73c21992e8f
-DATA ·precomputed<>+0x3590(SB)/8, $0xf605370ad52c41d3
-DATA ·precomputed<>+0x3598(SB)/8, $0x358b746d3a60435f
-DATA ·precomputed<>+0x35a0(SB)/8, $0xb2cbab945bc537b8
-DATA ·precomputed<>+0x35a8(SB)/8, $0x1fd24431b99057d3
-DATA ·precomputed<>+0x35b0(SB)/8, $0xff2242a0b8510f3c
-DATA ·precomputed<>+0x35b8(SB)/8, $0x74b4965d0733bc53
-DATA ·precomputed<>+0x35c0(SB)/8, $0x721ca22c275bce43
-DATA ·precomputed<>+0x35c8(SB)/8, $0xf058b8a7d24f78e8
-DATA ·precomputed<>+0x35d0(SB)/8, $0xd178eb57eed46b97
-DATA ·precomputed<>+0x35d8(SB)/8, $0x4ad7d425259fdb5b
-DATA ·precomputed<>+0x35e0(SB)/8, $0x669ed8531b515fe5
-DATA ·precomputed<>+0x35e8(SB)/8, $0x9f14b8e576fa1b5e
-DATA ·precomputed<>+0x35f0(SB)/8, $0xfaba8d0c3da46b02
-DATA ·precomputed<>+0x35f8(SB)/8, $0x759c2c95338f7652
-DATA ·precomputed<>+0x3600(SB)/8, $0x7115ab5c61b3a72a
-DATA ·precomputed<>+0x3608(SB)/8, $0xdd19f34b337194fc
-DATA ·precomputed<>+0x3610(SB)/8, $0xf23bfec8f0a14c3
-DATA ·precomputed<>+0x3618(SB)/8, $0x1fe19eeca60485d3
-DATA ·precomputed<>+0x3620(SB)/8, $0x1ca308c3a463dc9b
-DATA ·precomputed<>+0x3628(SB)/8, $0x83e18dd05e1ae8be
-DATA ·precomputed<>+0x3630(SB)/8, $0x849eabdfd928c0e7
-DATA ·precomputed<>+0x3638(SB)/8, $0x2d131ff56bd3e7b3
-DATA ·precomputed<>+0x3640(SB)/8, $0xfae6c0bf18ffaef0
-DATA ·precomputed<>+0x3648(SB)/8, $0x2e7b0ee385ed1ede
-DATA ·precomputed<>+0x3650(SB)/8, $0x3cebaa05125d1488
-DATA ·precomputed<>+0x3658(SB)/8, $0xcd0de0fe7c8b7fb8
-DATA ·precomputed<>+0x3660(SB)/8, $0x59434d54464bc74a
-DATA ·precomputed<>+0x3668(SB)/8, $0x17472da2a03fd77b
-DATA ·precomputed<>+0x3670(SB)/8, $0xab23d0422c1a9edc
-DATA ·precomputed<>+0x3678(SB)/8, $0x5390625ed9cf4b37
-DATA ·precomputed<>+0x3680(SB)/8, $0xe834843f9bce2b39
-DATA ·precomputed<>+0x3688(SB)/8, $0x8de8e41da90cfc7d
-DATA ·precomputed<>+0x3690(SB)/8, $0x398800edd81115b4
-DATA ·precomputed<>+0x3698(SB)/8, $0x4d33f7c5ff2532da
-DATA ·precomputed<>+0x36a0(SB)/8, $0x5ae37fb2dcc59e2c
-DATA ·precomputed<>+0x36a8(SB)/8, $0xca27b62224015306
-DATA ·precomputed<>+0x36b0(SB)/8, $0x51beca8911e8d6e6
-DATA ·precomputed<>+0x36b8(SB)/8, $0x8c0b7e2a9693774
-DATA ·precomputed<>+0x36c0(SB)/8, $0x653335a0e7ce649c
-DATA ·precomputed<>+0x36c8(SB)/8, $0x8b30baef6857eff7
-DATA ·precomputed<>+0x36d0(SB)/8, $0x7ea7c856f3288377
-DATA ·precomputed<>+0x36d8(SB)/8, $0x1387b347e8572f5d
-DATA ·precomputed<>+0x36e0(SB)/8, $0x8a6b0352be10c0cf
-DATA ·precomputed<>+0x36e8(SB)/8, $0x2a74e834037c97b9
-DATA ·precomputed<>+0x36f0(SB)/8, $0xfe10bf59197b122e
-DATA ·precomputed<>+0x36f8(SB)/8, $0xd1ee174c1918aced
-DATA ·precomputed<>+0x3700(SB)/8, $0xce4ff56602bb383b
-DATA ·precomputed<>+0x3708(SB)/8, $0xcad561c6fd62813d
-DATA ·precomputed<>+0x3710(SB)/8, $0x927c34801dfc9a8
-DATA ·precomputed<>+0x3718(SB)/8, $0xdde73fb00fb9a61
-DATA ·precomputed<>+0x3720(SB)/8, $0xd859809ffce59f34
-DATA ·precomputed<>+0x3728(SB)/8, $0x225bd9b681872a46
-DATA ·precomputed<>+0x3730(SB)/8, $0x2642add20314bb90
-DATA ·precomputed<>+0x3738(SB)/8, $0x82dc79580ae61eb8
-DATA ·precomputed<>+0x3740(SB)/8, $0xe556c3fcebbc623d
-DATA ·precomputed<>+0x3748(SB)/8, $0x30a3242fb1b968fa
-DATA ·precomputed<>+0x3750(SB)/8, $0x842ce9b0bcd05a51
-DATA ·precomputed<>+0x3758(SB)/8, $0x241a35ed0ad576ce
-DATA ·precomputed<>+0x3760(SB)/8, $0x49ccaf3cbb4a793e
-DATA ·precomputed<>+0x3768(SB)/8, $0x6e6c7a7b4492a828
-DATA ·precomputed<>+0x3770(SB)/8, $0x72f4f5fcba53eb42
-DATA ·precomputed<>+0x3778(SB)/8, $0xca4ba533ea74dab
-DATA ·precomputed<>+0x3780(SB)/8, $0x6b1d4868e8822711
-DATA ·precomputed<>+0x3788(SB)/8, $0x8857e28273d452b8
-DATA ·precomputed<>+0x3790(SB)/8, $0xad59adfdf08ed046
-DATA ·precomputed<>+0x3798(SB)/8, $0xdb755d65c1c47abe
-DATA ·precomputed<>+0x37a0(SB)/8, $0x2df8520b63275d49
-DATA ·precomputed<>+0x37a8(SB)/8, $0xc3c712ec7f8a3249
-DATA ·precomputed<>+0x37b0(SB)/8, $0x55f2a1085215ef57
-DATA ·precomputed<>+0x37b8(SB)/8, $0x955e07a33ee2f149
-DATA ·precomputed<>+0x37c0(SB)/8, $0xb23d262021afa86f
-DATA ·precomputed<>+0x37c8(SB)/8, $0xea757f0386b11457
-DATA ·precomputed<>+0x37d0(SB)/8, $0xbc4d2d1b0148d30
-DATA ·precomputed<>+0x37d8(SB)/8, $0x119b553588ce4170
-DATA ·precomputed<>+0x37e0(SB)/8, $0xaab5bb670aa9c8f6
-DATA ·precomputed<>+0x37e8(SB)/8, $0xdfc9693c88e05de2
-DATA ·precomputed<>+0x37f0(SB)/8, $0x6cae7e57e3f1e9c3
-DATA ·precomputed<>+0x37f8(SB)/8, $0x2b1ceb546f6c3b9c
-DATA ·precomputed<>+0x3800(SB)/8, $0x12e335ca87636183
-DATA ·precomputed<>+0x3808(SB)/8, $0x1461a65a719d1ca3
-DATA ·precomputed<>+0x3810(SB)/8, $0x8150080ab14161d8
-DATA ·precomputed<>+0x3818(SB)/8, $0x8da4ebfc612e112
-DATA ·precomputed<>+0x3820(SB)/8, $0xc95dfb6ba8498a9a
-DATA ·precomputed<>+0x3828(SB)/8, $0x99cf91dba0f8dba
-DATA ·precomputed<>+0x3830(SB)/8, $0x12d2ae144fb4f497
-DATA ·precomputed<>+0x3838(SB)/8, $0xfa3a28b033cb7306
-DATA ·precomputed<>+0x3840(SB)/8, $0xc89fc5d00f01c7ce
-DATA ·precomputed<>+0x3848(SB)/8, $0x6fc45ffd7283bdf0
-DATA ·precomputed<>+0x3850(SB)/8, $0x71dece8181151923
-DATA ·precomputed<>+0x3858(SB)/8, $0xed1cb14cc433fcc9
-DATA ·precomputed<>+0x3860(SB)/8, $0x4279612bd3959bcf
-DATA ·precomputed<>+0x3868(SB)/8, $0xe163880b35b5732f
-DATA ·precomputed<>+0x3870(SB)/8, $0x35414ca771d0a1ca
-DATA ·precomputed<>+0x3878(SB)/8, $0xe8b9e6512c1e47f3
-DATA ·precomputed<>+0x3880(SB)/8, $0x4ff11b0cc8df0a74
-DATA ·precomputed<>+0x3888(SB)/8, $0x346ba520e095ea9a
-DATA ·precomputed<>+0x3890(SB)/8, $0x81dd2268cc2bc6c0
-DATA ·precomputed<>+0x3898(SB)/8, $0x2fb2e99fc2701468
-DATA ·precomputed<>+0x38a0(SB)/8, $0xd21336198053f0e
-DATA ·precomputed<>+0x38a8(SB)/8, $0xe0b8280df7ae879a
-DATA ·precomputed<>+0x38b0(SB)/8, $0xd92b7a75952560f7
-DATA ·precomputed<>+0x38b8(SB)/8, $0x8d17dfad9723b62e
-DATA ·precomputed<>+0x38c0(SB)/8, $0x5ce8a78a08b21362
-DATA ·precomputed<>+0x38c8(SB)/8, $0xf37f5e7fd9fe0b36
-DATA ·precomputed<>+0x38d0(SB)/8, $0xdca66c7f2c87837c
-DATA ·precomputed<>+0x38d8(SB)/8, $0x92524b940bf2e993
-DATA ·precomputed<>+0x38e0(SB)/8, $0xfc0f020c71745788
-DATA ·precomputed<>+0x38e8(SB)/8, $0x6018de463cbfbf4c
-DATA ·precomputed<>+0x38f0(SB)/8, $0xa8446691ac3de1c8
-DATA ·precomputed<>+0x38f8(SB)/8, $0xb194d4195de5ae41
-DATA ·precomputed<>+0x3900(SB)/8, $0x1586cdff2ff27af2
-DATA ·precomputed<>+0x3908(SB)/8, $0xee628535de26b5ef
-DATA ·precomputed<>+0x3910(SB)/8, $0x58480040c682923e
-DATA ·precomputed<>+0x3918(SB)/8, $0x4dd4596b5e37da30
-DATA ·precomputed<>+0x3920(SB)/8, $0x247b9fd72f64225f
-DATA ·precomputed<>+0x3928(SB)/8, $0xdcc6de5f51ca2121
-DATA ·precomputed<>+0x3930(SB)/8, $0x99fb41ac86e7ab9a
-DATA ·precomputed<>+0x3938(SB)/8, $0x54c782a0952b413a
-DATA ·precomputed<>+0x3940(SB)/8, $0x7641190e7298c7d9
-DATA ·precomputed<>+0x3948(SB)/8, $0x499c35ed716eda14
-DATA ·precomputed<>+0x3950(SB)/8, $0x316134bfbb764e90
-DATA ·precomputed<>+0x3958(SB)/8, $0x4d23467e884fc34e
-DATA ·precomputed<>+0x3960(SB)/8, $0xfd1208a9f1d13484
-DATA ·precomputed<>+0x3968(SB)/8, $0x89d9605cd298a74
-DATA ·precomputed<>+0x3970(SB)/8, $0xb398c85a73c4346a
-DATA ·precomputed<>+0x3978(SB)/8, $0x50064076f37f13de
-DATA ·precomputed<>+0x3980(SB)/8, $0xfe10d25aa6ebb83d
-DATA ·precomputed<>+0x3988(SB)/8, $0xc5e3edf8a834b30d
-DATA ·precomputed<>+0x3990(SB)/8, $0x546b5d5c683e09ff
-DATA ·precomputed<>+0x3998(SB)/8, $0x2f96218c6dc44c6
-DATA ·precomputed<>+0x39a0(SB)/8, $0x64528c55c0edfc04
-DATA ·precomputed<>+0x39a8(SB)/8, $0xb5a44a2cb0fc3058
-DATA ·precomputed<>+0x39b0(SB)/8, $0x9f09b116ceeff21c
-DATA ·precomputed<>+0x39b8(SB)/8, $0x77bcd676b0fbbcd
-DATA ·precomputed<>+0x39c0(SB)/8, $0x29aaa4a89ce76a94
-DATA ·precomputed<>+0x39c8(SB)/8, $0x847cd081c0725c97
-DATA ·precomputed<>+0x39d0(SB)/8, $0xc099e9097e16665
-DATA ·precomputed<>+0x39d8(SB)/8, $0xe409ffc98f7b1fc4
-DATA ·precomputed<>+0x39e0(SB)/8, $0xc0575b80690941ed
-DATA ·precomputed<>+0x39e8(SB)/8, $0x8e25100a92c0ee9d
-DATA ·precomputed<>+0x39f0(SB)/8, $0x71662d279b75837d
-DATA ·precomputed<>+0x39f8(SB)/8, $0x6eeb9e97e56bb22b
-DATA ·precomputed<>+0x3a00(SB)/8, $0xf1d6333f85c6a60b
-DATA ·precomputed<>+0x3a08(SB)/8, $0x982fee9d1d7ccfaa
-DATA ·precomputed<>+0x3a10(SB)/8, $0x1c5e28e7d4634535
-DATA ·precomputed<>+0x3a18(SB)/8, $0xa76e1d2794fec469
-DATA ·precomputed<>+0x3a20(SB)/8, $0x1fe944d6afe377ec
-DATA ·precomputed<>+0x3a28(SB)/8, $0xbd579a252f68ae6b
-DATA ·precomputed<>+0x3a30(SB)/8, $0x10eabb93ab6b515e
-DATA ·precomputed<>+0x3a38(SB)/8, $0xa17b5f6c31b4e4b8
-DATA ·precomputed<>+0x3a40(SB)/8, $0x5e785fbaf72c239
-DATA ·precomputed<>+0x3a48(SB)/8, $0x597e20168af42e92
-DATA ·precomputed<>+0x3a50(SB)/8, $0x663f5a72b32ae6c9
-DATA ·precomputed<>+0x3a58(SB)/8, $0x3040ff1345541cc6
-DATA ·precomputed<>+0x3a60(SB)/8, $0x6140081fdeca6b32
-DATA ·precomputed<>+0x3a68(SB)/8, $0xcdaccaf7c735001b
-DATA ·precomputed<>+0x3a70(SB)/8, $0x62de5066daef0e68
-DATA ·precomputed<>+0x3a78(SB)/8, $0x56e9021d837df99
-DATA ·precomputed<>+0x3a80(SB)/8, $0xba39928316cd1be7
-DATA ·precomputed<>+0x3a88(SB)/8, $0x2a486323cfacf7ad
-DATA ·precomputed<>+0x3a90(SB)/8, $0xc15730277777ce
-DATA ·precomputed<>+0x3a98(SB)/8, $0x5d2f200fd49a254c
-DATA ·precomputed<>+0x3aa0(SB)/8, $0xf38a1f3bdb68078d
-DATA ·precomputed<>+0x3aa8(SB)/8, $0x595dea3f33604a36
-DATA ·precomputed<>+0x3ab0(SB)/8, $0x14749d8c904b60b2
-DATA ·precomputed<>+0x3ab8(SB)/8, $0xe70c96d8246348ff
-DATA ·precomputed<>+0x3ac0(SB)/8, $0x4340d52390e35da
-DATA ·precomputed<>+0x3ac8(SB)/8, $0xc098e3d327a9947c
-DATA ·precomputed<>+0x3ad0(SB)/8, $0xe6d781989ecc7a3f
-DATA ·precomputed<>+0x3ad8(SB)/8, $0x2c39102e23aa6df6
-DATA ·precomputed<>+0x3ae0(SB)/8, $0xb83fed0d300f3cb1
-DATA ·precomputed<>+0x3ae8(SB)/8, $0xc0b1e356dcfbe054
-DATA ·precomputed<>+0x3af0(SB)/8, $0x3da2224c20cf45a8
-DATA ·precomputed<>+0x3af8(SB)/8, $0x5be55df72f30deda
-DATA ·precomputed<>+0x3b00(SB)/8, $0x4d31c29d2faa9530
-DATA ·precomputed<>+0x3b08(SB)/8, $0x1d5783ae49d42f79
-DATA ·precomputed<>+0x3b10(SB)/8, $0xe588c224f618b3f3
-DATA ·precomputed<>+0x3b18(SB)/8, $0x7d8a6f90f8f5b65d
-DATA ·precomputed<>+0x3b20(SB)/8, $0xa802a3d262d09174
-DATA ·precomputed<>+0x3b28(SB)/8, $0x4f1a93d9bddd1cb7
-DATA ·precomputed<>+0x3b30(SB)/8, $0xe08e1d3c35a5c1dc
-DATA ·precomputed<>+0x3b38(SB)/8, $0x856b2323f9d2958e
-DATA ·precomputed<>+0x3b40(SB)/8, $0xefd1e3ba96f00090
-DATA ·precomputed<>+0x3b48(SB)/8, $0xd489943f3e0d25de
-DATA ·precomputed<>+0x3b50(SB)/8, $0x82c40ae30c8626f
-DATA ·precomputed<>+0x3b58(SB)/8, $0xf6e5b5efa4f428e0
-DATA ·precomputed<>+0x3b60(SB)/8, $0x660414a338a7f623
-DATA ·precomputed<>+0x3b68(SB)/8, $0xcd4e68de23eefed8
-DATA ·precomputed<>+0x3b70(SB)/8, $0x6dcadc62fc14e750
-DATA ·precomputed<>+0x3b78(SB)/8, $0xcb78b3bcbeae89b6
-DATA ·precomputed<>+0x3b80(SB)/8, $0x445acc561d5e580e
-DATA ·precomputed<>+0x3b88(SB)/8, $0xbf6547efc43abe19
-DATA ·precomputed<>+0x3b90(SB)/8, $0xd160a81bc922d50f
-DATA ·precomputed<>+0x3b98(SB)/8, $0x3877c7f8f68eed4e
-DATA ·precomputed<>+0x3ba0(SB)/8, $0x395745eaf8a9f64a
-DATA ·precomputed<>+0x3ba8(SB)/8, $0x9085b253603350f6
-DATA ·precomputed<>+0x3bb0(SB)/8, $0x2a4c71f18b1df366
-DATA ·precomputed<>+0x3bb8(SB)/8, $0x49b9e818abe332dc
-DATA ·precomputed<>+0x3bc0(SB)/8, $0xb3e76e66528960b1
-DATA ·precomputed<>+0x3bc8(SB)/8, $0x445dc393d84aecb3
-DATA ·precomputed<>+0x3bd0(SB)/8, $0x136184361612ad64
-DATA ·precomputed<>+0x3bd8(SB)/8, $0x3ccbeccc8c831e37
-DATA ·precomputed<>+0x3be0(SB)/8, $0xfb0bd416121383c
-DATA ·precomputed<>+0x3be8(SB)/8, $0x316164a380d895a3
-DATA ·precomputed<>+0x3bf0(SB)/8, $0xc3d34153233f2f1e
-DATA ·precomputed<>+0x3bf8(SB)/8, $0x2905906fe0d92225
-DATA ·precomputed<>+0x3c00(SB)/8, $0xe12d66e295456622
-DATA ·precomputed<>+0x3c08(SB)/8, $0x10469942ff554b13
-DATA ·precomputed<>+0x3c10(SB)/8, $0xa894af86f7126c09
-DATA ·precomputed<>+0x3c18(SB)/8, $0x448f3267f581d3f5
-DATA ·precomputed<>+0x3c20(SB)/8, $0xb5512926a2b5e758
-DATA ·precomputed<>+0x3c28(SB)/8, $0x8f0298843fddd90
-DATA ·precomputed<>+0x3c30(SB)/8, $0x5f4370358ba319e6
-DATA ·precomputed<>+0x3c38(SB)/8, $0xd254188e865b37e7
-DATA ·precomputed<>+0x3c40(SB)/8, $0x5b281b238a5cb63a
-DATA ·precomputed<>+0x3c48(SB)/8, $0xa15a27126dd136c2
-DATA ·precomputed<>+0x3c50(SB)/8, $0xfab229169beae4
-DATA ·precomputed<>+0x3c58(SB)/8, $0x400d3f37de31b4a1
-DATA ·precomputed<>+0x3c60(SB)/8, $0x275877a4f8545cb0
-DATA ·precomputed<>+0x3c68(SB)/8, $0xb396a51336df0277
-DATA ·precomputed<>+0x3c70(SB)/8, $0xf9896978838ced07
-DATA ·precomputed<>+0x3c78(SB)/8, $0x86e68167715cea8d
-DATA ·precomputed<>+0x3c80(SB)/8, $0xeb0f0de06a5a96d
-DATA ·precomputed<>+0x3c88(SB)/8, $0x2c7a36721fcf91ae
-DATA ·precomputed<>+0x3c90(SB)/8, $0x287bf614630eca3a
-DATA ·precomputed<>+0x3c98(SB)/8, $0x65347473f60c9d2d
-DATA ·precomputed<>+0x3ca0(SB)/8, $0xed15a229906efa7f
-DATA ·precomputed<>+0x3ca8(SB)/8, $0xe7be6381d549b5b3
-DATA ·precomputed<>+0x3cb0(SB)/8, $0x23f329722ce9141b
-DATA ·precomputed<>+0x3cb8(SB)/8, $0x9618d9a1fcf823f8
-DATA ·precomputed<>+0x3cc0(SB)/8, $0x3d0ef0d3a3d89e15
-DATA ·precomputed<>+0x3cc8(SB)/8, $0x4d5a30c90d07f5eb
-DATA ·precomputed<>+0x3cd0(SB)/8, $0xc359e31073e4887a
-DATA ·precomputed<>+0x3cd8(SB)/8, $0x2f4c6b7edbdec349
-DATA ·precomputed<>+0x3ce0(SB)/8, $0xc5a1d3e9ba142643
-DATA ·precomputed<>+0x3ce8(SB)/8, $0x8f4fd58e11c794b6
-DATA ·precomputed<>+0x3cf0(SB)/8, $0xcad091d11810c63d
-DATA ·precomputed<>+0x3cf8(SB)/8, $0x5b616239f0bfa76c
-DATA ·precomputed<>+0x3d00(SB)/8, $0xe3433562a838792a
-DATA ·precomputed<>+0x3d08(SB)/8, $0x4aead02b54148e98
-DATA ·precomputed<>+0x3d10(SB)/8, $0x809f2bafdb66f216
-DATA ·precomputed<>+0x3d18(SB)/8, $0x9cc90ffeabfe5da
-DATA ·precomputed<>+0x3d20(SB)/8, $0x69eb235a63e8edad
-DATA ·precomputed<>+0x3d28(SB)/8, $0x64f7acb5a7f95997
-DATA ·precomputed<>+0x3d30(SB)/8, $0xe999ea18fae20f25
-DATA ·precomputed<>+0x3d38(SB)/8, $0xcd7ff2083c4966b3
-DATA ·precomputed<>+0x3d40(SB)/8, $0x595e0cc0345c8929
-DATA ·precomputed<>+0x3d48(SB)/8, $0xfe43c73cde5e2498
-DATA ·precomputed<>+0x3d50(SB)/8, $0xcdefc98503f216d
-DATA ·precomputed<>+0x3d58(SB)/8, $0x8e4e170df98826fb
-DATA ·precomputed<>+0x3d60(SB)/8, $0x1492247db6c79b1c
-DATA ·precomputed<>+0x3d68(SB)/8, $0xf8e24b38ef0532aa
-DATA ·precomputed<>+0x3d70(SB)/8, $0x9f349d51044bc458
-DATA ·precomputed<>+0x3d78(SB)/8, $0x2ef04ead1002d315
-DATA ·precomputed<>+0x3d80(SB)/8, $0xaf322f23da60d581
-DATA ·precomputed<>+0x3d88(SB)/8, $0x7deaa880681173f
-DATA ·precomputed<>+0x3d90(SB)/8, $0x86b97444a78feca0
-DATA ·precomputed<>+0x3d98(SB)/8, $0x64d336eac633a54d
-DATA ·precomputed<>+0x3da0(SB)/8, $0x10dd4b1f2a426cbf
-DATA ·precomputed<>+0x3da8(SB)/8, $0x8d97c157af59869
-DATA ·precomputed<>+0x3db0(SB)/8, $0xb8cc814b2d7fe97e
-DATA ·precomputed<>+0x3db8(SB)/8, $0x7eacd2e13bfb60fe
-DATA ·precomputed<>+0x3dc0(SB)/8, $0x967dafb7b790881c
-DATA ·precomputed<>+0x3dc8(SB)/8, $0x2002b8e43663e76c
-DATA ·precomputed<>+0x3dd0(SB)/8, $0x3bd28edef8e82490
-DATA ·precomputed<>+0x3dd8(SB)/8, $0x44dd2e814bb2a47a
-DATA ·precomputed<>+0x3de0(SB)/8, $0xde750dfedbc3f2f8
-DATA ·precomputed<>+0x3de8(SB)/8, $0xd9b6e9126e2eec70
-DATA ·precomputed<>+0x3df0(SB)/8, $0xe8400e2f1e4c4d2f
-DATA ·precomputed<>+0x3df8(SB)/8, $0xd332569723217be2
-DATA ·precomputed<>+0x3e00(SB)/8, $0x30b7e39d4231a1d
-DATA ·precomputed<>+0x3e08(SB)/8, $0x1f72e8b1613d17d8
-DATA ·precomputed<>+0x3e10(SB)/8, $0xcd42351201857d37
-DATA ·precomputed<>+0x3e18(SB)/8, $0x9ecd682c0b4b7926
-DATA ·precomputed<>+0x3e20(SB)/8, $0xfe4ac1c38ec44636
-DATA ·precomputed<>+0x3e28(SB)/8, $0x4a030cbf9aacc091
-DATA ·precomputed<>+0x3e30(SB)/8, $0x12bb252e0b133f54
-DATA ·precomputed<>+0x3e38(SB)/8, $0xbf90ea5df970d266
-DATA ·precomputed<>+0x3e40(SB)/8, $0xe00d25f7f5484410
-DATA ·precomputed<>+0x3e48(SB)/8, $0xb4984eeb2a922894
-DATA ·precomputed<>+0x3e50(SB)/8, $0x498102fd8e1e09ce
-DATA ·precomputed<>+0x3e58(SB)/8, $0x8f8c9fcbe1d731bf
-DATA ·precomputed<>+0x3e60(SB)/8, $0xdb8976690b4983b7
-DATA ·precomputed<>+0x3e68(SB)/8, $0x7a7767f97b2468f5
-DATA ·precomputed<>+0x3e70(SB)/8, $0x1a2f9fe872f4d5f4
-DATA ·precomputed<>+0x3e78(SB)/8, $0x10e95aa9a6169daa
-DATA ·precomputed<>+0x3e80(SB)/8, $0x9487e50f520166e9
-DATA ·precomputed<>+0x3e88(SB)/8, $0x6f6b115bc4ee6a95
-DATA ·precomputed<>+0x3e90(SB)/8, $0xaf29926fcf7560f8
-DATA ·precomputed<>+0x3e98(SB)/8, $0x20a324581f989e46
-DATA ·precomputed<>+0x3ea0(SB)/8, $0x165a2232d3bd2473
-DATA ·precomputed<>+0x3ea8(SB)/8, $0x93d841ffe9fecdf8
-DATA ·precomputed<>+0x3eb0(SB)/8, $0x71d63fa7bf9978c0
-DATA ·precomputed<>+0x3eb8(SB)/8, $0x381bcf34e7de184b
-DATA ·precomputed<>+0x3ec0(SB)/8, $0x317c8e40347dfaef
-DATA ·precomputed<>+0x3ec8(SB)/8, $0x795b0f7d64464bf3
-DATA ·precomputed<>+0x3ed0(SB)/8, $0x15dc99d61364ec20
-DATA ·precomputed<>+0x3ed8(SB)/8, $0xc07fce2891600d3f
-DATA ·precomputed<>+0x3ee0(SB)/8, $0x9825338bc8bebbda
-DATA ·precomputed<>+0x3ee8(SB)/8, $0x5e5e89f6a8547c03
-DATA ·precomputed<>+0x3ef0(SB)/8, $0x3c50032f1a040b84
-DATA ·precomputed<>+0x3ef8(SB)/8, $0xcea7544f2b3a533d
-DATA ·precomputed<>+0x3f00(SB)/8, $0xea26d87d43247e19
-DATA ·precomputed<>+0x3f08(SB)/8, $0x7e753390fba8368e
-DATA ·precomputed<>+0x3f10(SB)/8, $0xb35e75cb3c7bcfc6
-DATA ·precomputed<>+0x3f18(SB)/8, $0xf78cb5ce7e44aab3
-DATA ·precomputed<>+0x3f20(SB)/8, $0x4a3534e9a98d7104
-DATA ·precomputed<>+0x3f28(SB)/8, $0x2b83ea6c6f5852ea
-DATA ·precomputed<>+0x3f30(SB)/8, $0x11337fff68dced7c
-DATA ·precomputed<>+0x3f38(SB)/8, $0xcca0f2c6d1a2a294
-DATA ·precomputed<>+0x3f40(SB)/8, $0xb547c662426bf202
-DATA ·precomputed<>+0x3f48(SB)/8, $0xec50423e66194a34
-DATA ·precomputed<>+0x3f50(SB)/8, $0x11d3486578161e84
-DATA ·precomputed<>+0x3f58(SB)/8, $0x83508c0664f04dec
-DATA ·precomputed<>+0x3f60(SB)/8, $0xd1c72976f7732345
-DATA ·precomputed<>+0x3f68(SB)/8, $0xd624bacd18e77e0a
-DATA ·precomputed<>+0x3f70(SB)/8, $0x71344b75ba79bdd9
-DATA ·precomputed<>+0x3f78(SB)/8, $0xe4bfe0858d6c1474
-DATA ·precomputed<>+0x3f80(SB)/8, $0x505e8fd9cc5eb43a
-DATA ·precomputed<>+0x3f88(SB)/8, $0x612ab1d0daaf0621
-DATA ·precomputed<>+0x3f90(SB)/8, $0xde170783e6672368
-DATA ·precomputed<>+0x3f98(SB)/8, $0xfee7df4483788673
-DATA ·precomputed<>+0x3fa0(SB)/8, $0x364d6885d119271d
-DATA ·precomputed<>+0x3fa8(SB)/8, $0xdd70bae8e1b0cea2
-DATA ·precomputed<>+0x3fb0(SB)/8, $0xb4b873ad5832adba
-DATA ·precomputed<>+0x3fb8(SB)/8, $0xad3ecc188c683749
-DATA ·precomputed<>+0x3fc0(SB)/8, $0x963d87934d217a2e
-DATA ·precomputed<>+0x3fc8(SB)/8, $0x99e8c561fa4702d
-DATA ·precomputed<>+0x3fd0(SB)/8, $0x6d91bc47e6431f1b
-DATA ·precomputed<>+0x3fd8(SB)/8, $0x3fd21287a5f61399
-DATA ·precomputed<>+0x3fe0(SB)/8, $0x2fc90bae682fa08b
-DATA ·precomputed<>+0x3fe8(SB)/8, $0x51699c85c1ca371c
-DATA ·precomputed<>+0x3ff0(SB)/8, $0x16f29d74831c428f
-DATA ·precomputed<>+0x3ff8(SB)/8, $0xecefb669fa2b504
-DATA ·precomputed<>+0x4000(SB)/8, $0x8487eb9068755cf3
-DATA ·precomputed<>+0x4008(SB)/8, $0x1887394e7fe12541
-DATA ·precomputed<>+0x4010(SB)/8, $0x2e4c65d446af8ca8
-DATA ·precomputed<>+0x4018(SB)/8, $0x72aae645b9e119dc
-DATA ·precomputed<>+0x4020(SB)/8, $0x958e00941ec6ad73
-DATA ·precomputed<>+0x4028(SB)/8, $0x84a7eec48ce4573e
-DATA ·precomputed<>+0x4030(SB)/8, $0x3d6d00d4f9254b96
-DATA ·precomputed<>+0x4038(SB)/8, $0x4ef44f588e421732
-DATA ·precomputed<>+0x4040(SB)/8, $0xf59de0f87d3ad2ac
-DATA ·precomputed<>+0x4048(SB)/8, $0xd2670cb1c0f92c5c
-DATA ·precomputed<>+0x4050(SB)/8, $0x8f05944ac900b6a5
-DATA ·precomputed<>+0x4058(SB)/8, $0x11aeed238d9668eb
-DATA ·precomputed<>+0x4060(SB)/8, $0x21b038e47c488ea6
-DATA ·precomputed<>+0x4068(SB)/8, $0x406ea3f778083d75
-DATA ·precomputed<>+0x4070(SB)/8, $0xd22197b43bd31fe2
-DATA ·precomputed<>+0x4078(SB)/8, $0xdc8f8ccb28a6ef9a
-DATA ·precomputed<>+0x4080(SB)/8, $0x32b3cfbffc918821
-DATA ·precomputed<>+0x4088(SB)/8, $0x51cb7e5c1e0fbf60
-DATA ·precomputed<>+0x4090(SB)/8, $0xa73f6716bf62452f
-DATA ·precomputed<>+0x4098(SB)/8, $0x62b74252605f29f0
-DATA ·precomputed<>+0x40a0(SB)/8, $0xf2f19d259e88b0f1
-DATA ·precomputed<>+0x40a8(SB)/8, $0x67525d10c5841f61
-DATA ·precomputed<>+0x40b0(SB)/8, $0xe54c3b5661378ac8
-DATA ·precomputed<>+0x40b8(SB)/8, $0xb9fade9529a452d6
-DATA ·precomputed<>+0x40c0(SB)/8, $0x679a648302887281
-DATA ·precomputed<>+0x40c8(SB)/8, $0x13f3d39b7f9de66f
-DATA ·precomputed<>+0x40d0(SB)/8, $0x289c3c50f1a7dee2
-DATA ·precomputed<>+0x40d8(SB)/8, $0xa510a53c40b698b8
-DATA ·precomputed<>+0x40e0(SB)/8, $0xc566c3fb06f799ad
-DATA ·precomputed<>+0x40e8(SB)/8, $0xcc95a879b5374650
-DATA ·precomputed<>+0x40f0(SB)/8, $0xbd7343c061c947b2
-DATA ·precomputed<>+0x40f8(SB)/8, $0xbbbff69d9543e51b
-DATA ·precomputed<>+0x4100(SB)/8, $0x327a41aa7a0a745a
-DATA ·precomputed<>+0x4108(SB)/8, $0x92d43e3243371bd1
-DATA ·precomputed<>+0x4110(SB)/8, $0xcde41b9031b5f824
-DATA ·precomputed<>+0x4118(SB)/8, $0xf5e1e18cda04478
-DATA ·precomputed<>+0x4120(SB)/8, $0x6db2c18624785314
-DATA ·precomputed<>+0x4128(SB)/8, $0xc4cfdb24ef5518b
-DATA ·precomputed<>+0x4130(SB)/8, $0xacc9f6ec1fb7c8ee
-DATA ·precomputed<>+0x4138(SB)/8, $0x1efb06594d6c09d7
-DATA ·precomputed<>+0x4140(SB)/8, $0xb80d38dcba75aba9
-DATA ·precomputed<>+0x4148(SB)/8, $0xe9b61ac6d150f881
-DATA ·precomputed<>+0x4150(SB)/8, $0x9f56af52ca7b47c5
-DATA ·precomputed<>+0x4158(SB)/8, $0x40300d977fb3128
-DATA ·precomputed<>+0x4160(SB)/8, $0x36877184c01fd0c1
-DATA ·precomputed<>+0x4168(SB)/8, $0x40112a048b6e134b
-DATA ·precomputed<>+0x4170(SB)/8, $0x56daed90ccd71653
-DATA ·precomputed<>+0x4178(SB)/8, $0xec553aa6b74bd62e
-DATA ·precomputed<>+0x4180(SB)/8, $0xf3d8472aab109ef7
-DATA ·precomputed<>+0x4188(SB)/8, $0xdbdfe41cb59b268a
-DATA ·precomputed<>+0x4190(SB)/8, $0x147dd06eeb314e79
-DATA ·precomputed<>+0x4198(SB)/8, $0xcae5583763c5b77c
-DATA ·precomputed<>+0x41a0(SB)/8, $0xc8bd83c8b1c8952
-DATA ·precomputed<>+0x41a8(SB)/8, $0xcf4b71c8a1ba0322
-DATA ·precomputed<>+0x41b0(SB)/8, $0x68f32934ea98beee
-DATA ·precomputed<>+0x41b8(SB)/8, $0x5580dbb621a4b342
-DATA ·precomputed<>+0x41c0(SB)/8, $0x398381d11476dde
-DATA ·precomputed<>+0x41c8(SB)/8, $0x4959204d1ea0923f
-DATA ·precomputed<>+0x41d0(SB)/8, $0xd67427ad017745bd
-DATA ·precomputed<>+0x41d8(SB)/8, $0xef022a746935e467
-DATA ·precomputed<>+0x41e0(SB)/8, $0x57e799f524e0380a
-DATA ·precomputed<>+0x41e8(SB)/8, $0x6ee2b64fb5f1a730
-DATA ·precomputed<>+0x41f0(SB)/8, $0x9aeaac48521771d8
-DATA ·precomputed<>+0x41f8(SB)/8, $0x2c8521c0992a13c
-DATA ·precomputed<>+0x4200(SB)/8, $0xd1565a7899410538
-DATA ·precomputed<>+0x4208(SB)/8, $0x5b6338625bf2e33c
-DATA ·precomputed<>+0x4210(SB)/8, $0xf45f79077a9f1fa2
-DATA ·precomputed<>+0x4218(SB)/8, $0xca74d05b8c2bc47e
-DATA ·precomputed<>+0x4220(SB)/8, $0xfa31b273ec042c99
-DATA ·precomputed<>+0x4228(SB)/8, $0x89509b259b1ddf12
-DATA ·precomputed<>+0x4230(SB)/8, $0x99b9b564b0e1867d
-DATA ·precomputed<>+0x4238(SB)/8, $0x3d7e0be57be83f44
-DATA ·precomputed<>+0x4240(SB)/8, $0x25dd9f4148f6934a
-DATA ·precomputed<>+0x4248(SB)/8, $0x8d1919883e38e31f
-DATA ·precomputed<>+0x4250(SB)/8, $0x3554884432899837
-DATA ·precomputed<>+0x4258(SB)/8, $0xf87c696bf56b07d6
-DATA ·precomputed<>+0x4260(SB)/8, $0xbc66e7d773e927b9
-DATA ·precomputed<>+0x4268(SB)/8, $0x4cdac77bb0bedc5
-DATA ·precomputed<>+0x4270(SB)/8, $0x1e6f29030bcd022a
-DATA ·precomputed<>+0x4278(SB)/8, $0xafa637be22c574b5
-DATA ·precomputed<>+0x4280(SB)/8, $0x7d1ff0f16914da62
-DATA ·precomputed<>+0x4288(SB)/8, $0xb11de3fdb0d1e852
-DATA ·precomputed<>+0x4290(SB)/8, $0x88cf2d7011d8f2cc
-DATA ·precomputed<>+0x4298(SB)/8, $0xda614f099a93e393
-DATA ·precomputed<>+0x42a0(SB)/8, $0xaf4e53e0263d597
-DATA ·precomputed<>+0x42a8(SB)/8, $0xc404573b5b032ca2
-DATA ·precomputed<>+0x42b0(SB)/8, $0x2f7cb579f307063
-DATA ·precomputed<>+0x42b8(SB)/8, $0x3bf92eee1e3ac824
-DATA ·precomputed<>+0x42c0(SB)/8, $0xcdca4b1e55c1759b
-DATA ·precomputed<>+0x42c8(SB)/8, $0x3d46ee3ba6819d39
-DATA ·precomputed<>+0x42d0(SB)/8, $0xf7497adeb4b0fce9
-DATA ·precomputed<>+0x42d8(SB)/8, $0x54aef506dcb613c3
-DATA ·precomputed<>+0x42e0(SB)/8, $0xbc11d721522ff464
-DATA ·precomputed<>+0x42e8(SB)/8, $0xf53f16f237bd3c51
-DATA ·precomputed<>+0x42f0(SB)/8, $0x88f29955485695b9
-DATA ·precomputed<>+0x42f8(SB)/8, $0x428ce742dac00fe3
-DATA ·precomputed<>+0x4300(SB)/8, $0x60ac4c03f0a39ea0
-DATA ·precomputed<>+0x4308(SB)/8, $0xa8a1f2299f342614
-DATA ·precomputed<>+0x4310(SB)/8, $0x3f1674cc00823c4b
-DATA ·precomputed<>+0x4318(SB)/8, $0x2cf68e7b70c746f3
-DATA ·precomputed<>+0x4320(SB)/8, $0xb5bf8fde239b6bf1
-DATA ·precomputed<>+0x4328(SB)/8, $0x32ada38515c65229
-DATA ·precomputed<>+0x4330(SB)/8, $0x64fa60aae0ea866b
-DATA ·precomputed<>+0x4338(SB)/8, $0x4cf2d7e4b21902d5
-DATA ·precomputed<>+0x4340(SB)/8, $0xd971fbd2a3520c27
-DATA ·precomputed<>+0x4348(SB)/8, $0x2204fe54e05e1b9d
-DATA ·precomputed<>+0x4350(SB)/8, $0xb08be507f0c15c89
-DATA ·precomputed<>+0x4358(SB)/8, $0xfeeda919901a15c3
-DATA ·precomputed<>+0x4360(SB)/8, $0x6576ad3b84b60eb5
-DATA ·precomputed<>+0x4368(SB)/8, $0x40d4b9a159e951da
-DATA ·precomputed<>+0x4370(SB)/8, $0xbe96e1b876244608
-DATA ·precomputed<>+0x4378(SB)/8, $0x3af35ec958ef9f37
-DATA ·precomputed<>+0x4380(SB)/8, $0x7531be155317571c
-DATA ·precomputed<>+0x4388(SB)/8, $0x49e3842796585239
-DATA ·precomputed<>+0x4390(SB)/8, $0x6f87fb2fc5e5f333
-DATA ·precomputed<>+0x4398(SB)/8, $0x6dd399880270d0d6
-DATA ·precomputed<>+0x43a0(SB)/8, $0xd1aef634dce8c14e
-DATA ·precomputed<>+0x43a8(SB)/8, $0xb62e2295a3612ffd
-DATA ·precomputed<>+0x43b0(SB)/8, $0xc06c6e8584c3e3c7
-DATA ·precomputed<>+0x43b8(SB)/8, $0xa3402478fa673620
-DATA ·precomputed<>+0x43c0(SB)/8, $0xbfe5c43b153adbf7
-DATA ·precomputed<>+0x43c8(SB)/8, $0x7a66edf80351fec
-DATA ·precomputed<>+0x43d0(SB)/8, $0x3d8042353b109e60
-DATA ·precomputed<>+0x43d8(SB)/8, $0x4dc97176a832c162
-DATA ·precomputed<>+0x43e0(SB)/8, $0x3fec75fb1db1e5c
-DATA ·precomputed<>+0x43e8(SB)/8, $0x6aa02da6a15b9900
-DATA ·precomputed<>+0x43f0(SB)/8, $0x5f9e808f4faa1cff
-DATA ·precomputed<>+0x43f8(SB)/8, $0x90aa28bda6412a26
-DATA ·precomputed<>+0x4400(SB)/8, $0x718ff5b5a7e131a4
-DATA ·precomputed<>+0x4408(SB)/8, $0xb4cf23f259032842
-DATA ·precomputed<>+0x4410(SB)/8, $0x8d638517d2cfafb7
-DATA ·precomputed<>+0x4418(SB)/8, $0x9d9ccd9eac38d083
-DATA ·precomputed<>+0x4420(SB)/8, $0x6c7d80e5edd07cff
-DATA ·precomputed<>+0x4428(SB)/8, $0xee8cace0df101eec
-DATA ·precomputed<>+0x4430(SB)/8, $0x4daa76558357393d
-DATA ·precomputed<>+0x4438(SB)/8, $0xe25ccb9f769a7900
-DATA ·precomputed<>+0x4440(SB)/8, $0x2fb2c15be041d453
-DATA ·precomputed<>+0x4448(SB)/8, $0x2b847efa86c18ef0
-DATA ·precomputed<>+0x4450(SB)/8, $0x84f5ee9d115b7318
-DATA ·precomputed<>+0x4458(SB)/8, $0xd568b08071699936
-DATA ·precomputed<>+0x4460(SB)/8, $0x34658ae76ea5c852
-DATA ·precomputed<>+0x4468(SB)/8, $0x99f918b3126d10ce
-DATA ·precomputed<>+0x4470(SB)/8, $0x75e3d9cc09b9407f
-DATA ·precomputed<>+0x4478(SB)/8, $0x11c6a0bb7883f978
-DATA ·precomputed<>+0x4480(SB)/8, $0xe124c5b7d1d813af
-DATA ·precomputed<>+0x4488(SB)/8, $0x9a8ab920dd17e0e6
-DATA ·precomputed<>+0x4490(SB)/8, $0x32561a5aadf72cb7
-DATA ·precomputed<>+0x4498(SB)/8, $0xd13fd246f4220b35
-DATA ·precomputed<>+0x44a0(SB)/8, $0x548d1c13c813866b
-DATA ·precomputed<>+0x44a8(SB)/8, $0x9688b2d123fcde81
-DATA ·precomputed<>+0x44b0(SB)/8, $0xf62e4bc6c56b8f93
-DATA ·precomputed<>+0x44b8(SB)/8, $0xbabd19ab9db17e23
-DATA ·precomputed<>+0x44c0(SB)/8, $0x522a6925876db0fd
-DATA ·precomputed<>+0x44c8(SB)/8, $0xc5401ca17a9a4211
-DATA ·precomputed<>+0x44d0(SB)/8, $0x89163b576789e755
-DATA ·precomputed<>+0x44d8(SB)/8, $0xd2b2c99a0fd6f1b8
-DATA ·precomputed<>+0x44e0(SB)/8, $0x427eea22a7b452dc
-DATA ·precomputed<>+0x44e8(SB)/8, $0xce8e6682ef9db65c
-DATA ·precomputed<>+0x44f0(SB)/8, $0xfd835810da9c80f7
-DATA ·precomputed<>+0x44f8(SB)/8, $0xdb91bfbbea916411
-DATA ·precomputed<>+0x4500(SB)/8, $0x36be935287dcbc2c
-DATA ·precomputed<>+0x4508(SB)/8, $0xf2cbc555f8d0a427
-DATA ·precomputed<>+0x4510(SB)/8, $0x618303d20be32e3e
-DATA ·precomputed<>+0x4518(SB)/8, $0x6e062fd0ab073f8e
-DATA ·precomputed<>+0x4520(SB)/8, $0x89242ae8ba7392f9
-DATA ·precomputed<>+0x4528(SB)/8, $0x2bf0d22e51ccf2d8
-DATA ·precomputed<>+0x4530(SB)/8, $0xef3e42908c4b2bb8
-DATA ·precomputed<>+0x4538(SB)/8, $0x1cfe73d5aa6407ac
-DATA ·precomputed<>+0x4540(SB)/8, $0x7a5aefad798b5051
-DATA ·precomputed<>+0x4548(SB)/8, $0xbd7ebc8842a0d4cd
-DATA ·precomputed<>+0x4550(SB)/8, $0x958e327abac28520
-DATA ·precomputed<>+0x4558(SB)/8, $0xfa8bf6d47d010c77
-DATA ·precomputed<>+0x4560(SB)/8, $0x8a7040aa579752f4
-DATA ·precomputed<>+0x4568(SB)/8, $0x47974d84e573d895
-DATA ·precomputed<>+0x4570(SB)/8, $0xfd2a0cdcfe16f77b
-DATA ·precomputed<>+0x4578(SB)/8, $0xf08f86adbf71fdc
-DATA ·precomputed<>+0x4580(SB)/8, $0x7c2e99d9b365656e
-DATA ·precomputed<>+0x4588(SB)/8, $0x18269a646965a953
-DATA ·precomputed<>+0x4590(SB)/8, $0x38fb933fedd282d
-DATA ·precomputed<>+0x4598(SB)/8, $0xf490c7215eb9183e
-DATA ·precomputed<>+0x45a0(SB)/8, $0xf76d6fe8329dbd6b
-DATA ·precomputed<>+0x45a8(SB)/8, $0x3ff3d1b784960083
-DATA ·precomputed<>+0x45b0(SB)/8, $0xe56155ec21b75f5b
-DATA ·precomputed<>+0x45b8(SB)/8, $0x3c96b68d2ee05504
-DATA ·precomputed<>+0x45c0(SB)/8, $0xb19de6f12983bd4d
-DATA ·precomputed<>+0x45c8(SB)/8, $0xb990931e1e3a28b3
-DATA ·precomputed<>+0x45d0(SB)/8, $0x43b71b8b00cbc4f9
-DATA ·precomputed<>+0x45d8(SB)/8, $0x35d1ddd0f4d75a0e
-DATA ·precomputed<>+0x45e0(SB)/8, $0xc211951dc653f111
-DATA ·precomputed<>+0x45e8(SB)/8, $0xbbc4682488750928
-DATA ·precomputed<>+0x45f0(SB)/8, $0xcf6e752174803e3
-DATA ·precomputed<>+0x45f8(SB)/8, $0x81f5e0ac8960d80c
-DATA ·precomputed<>+0x4600(SB)/8, $0xddeac527b897689a
-DATA ·precomputed<>+0x4608(SB)/8, $0x8646111e8a20d9f4
-DATA ·precomputed<>+0x4610(SB)/8, $0x7789e8835da1fde8
-DATA ·precomputed<>+0x4618(SB)/8, $0xd1b0531b3e1480fe
-DATA ·precomputed<>+0x4620(SB)/8, $0x5a2f1f4817ebc254
-DATA ·precomputed<>+0x4628(SB)/8, $0x514d213b7a6fa0bf
-DATA ·precomputed<>+0x4630(SB)/8, $0xb1d3d4184ef9688a
-DATA ·precomputed<>+0x4638(SB)/8, $0xfb187528f2111b10
-DATA ·precomputed<>+0x4640(SB)/8, $0xe03ca0850c52fcf9
-DATA ·precomputed<>+0x4648(SB)/8, $0xa795382e0865ced4
-DATA ·precomputed<>+0x4650(SB)/8, $0x3bd561ce7117376
-DATA ·precomputed<>+0x4658(SB)/8, $0x8608dde13fd7184a
-DATA ·precomputed<>+0x4660(SB)/8, $0xfd48fd50a2a98acc
-DATA ·precomputed<>+0x4668(SB)/8, $0x902fa58711df74b0
-DATA ·precomputed<>+0x4670(SB)/8, $0x683f101dfa73b8f1
-DATA ·precomputed<>+0x4678(SB)/8, $0xc805d31be7c0efa6
-DATA ·precomputed<>+0x4680(SB)/8, $0x98567398e351f2a2
-DATA ·precomputed<>+0x4688(SB)/8, $0x3bdcef1366ae0b1f
-DATA ·precomputed<>+0x4690(SB)/8, $0x289fc419aa6d0588
-DATA ·precomputed<>+0x4698(SB)/8, $0x95834ba5172ac17
-DATA ·precomputed<>+0x46a0(SB)/8, $0xc6ce1c047048f0e0
-DATA ·precomputed<>+0x46a8(SB)/8, $0x33c704f4ce423027
-DATA ·precomputed<>+0x46b0(SB)/8, $0x3c1fc35a2c25cebb
-DATA ·precomputed<>+0x46b8(SB)/8, $0x38badd01ad102bfe
-DATA ·precomputed<>+0x46c0(SB)/8, $0xe5effb4e5b11d6c0
-DATA ·precomputed<>+0x46c8(SB)/8, $0xba30f74701c8374c
-DATA ·precomputed<>+0x46d0(SB)/8, $0x8733511b0c275aec
-DATA ·precomputed<>+0x46d8(SB)/8, $0xf140b74097354e36
-DATA ·precomputed<>+0x46e0(SB)/8, $0xb01ded690341268e
-DATA ·precomputed<>+0x46e8(SB)/8, $0x17bc317627eac17b
-DATA ·precomputed<>+0x46f0(SB)/8, $0x880977038984992c
-DATA ·precomputed<>+0x46f8(SB)/8, $0x37bfafab3e05061c
-DATA ·precomputed<>+0x4700(SB)/8, $0x5613fa59d4caa1af
-DATA ·precomputed<>+0x4708(SB)/8, $0x6c7843c761617bcb
-DATA ·precomputed<>+0x4710(SB)/8, $0xabe76d1647e00339
-DATA ·precomputed<>+0x4718(SB)/8, $0xe025f18ae046e932
-DATA ·precomputed<>+0x4720(SB)/8, $0xe74fdaa5df4cb985
-DATA ·precomputed<>+0x4728(SB)/8, $0x27a950565a7601be
-DATA ·precomputed<>+0x4730(SB)/8, $0x1b835b5a0d931e69
-DATA ·precomputed<>+0x4738(SB)/8, $0xf5f52febddd07e35
-DATA ·precomputed<>+0x4740(SB)/8, $0x7eca9f09111d0eb8
-DATA ·precomputed<>+0x4748(SB)/8, $0xda7eb0238f243481
-DATA ·precomputed<>+0x4750(SB)/8, $0xac3cb2d659b5e491
-DATA ·precomputed<>+0x4758(SB)/8, $0x56e725b14f794842
-DATA ·precomputed<>+0x4760(SB)/8, $0x4324525445b2dff6
-DATA ·precomputed<>+0x4768(SB)/8, $0xeafe73b9ef10ec78
-DATA ·precomputed<>+0x4770(SB)/8, $0xd3cb2bc78819dbf
-DATA ·precomputed<>+0x4778(SB)/8, $0xff1cd617e784eb22
-DATA ·precomputed<>+0x4780(SB)/8, $0x2fe702c5aace9e5b
-DATA ·precomputed<>+0x4788(SB)/8, $0x1431e45f253e0b81
-DATA ·precomputed<>+0x4790(SB)/8, $0x8f743becd800584f
-DATA ·precomputed<>+0x4798(SB)/8, $0x93042b21bafe2837
-DATA ·precomputed<>+0x47a0(SB)/8, $0x2fdc8bf3c9182982
-DATA ·precomputed<>+0x47a8(SB)/8, $0x96741e4a1bfca468
-DATA ·precomputed<>+0x47b0(SB)/8, $0xc07863754c520f1f
-DATA ·precomputed<>+0x47b8(SB)/8, $0x85bb9c6f77425d9d
-DATA ·precomputed<>+0x47c0(SB)/8, $0xdbaf1c99ce0fcd6
-DATA ·precomputed<>+0x47c8(SB)/8, $0x732ea65e3232a847
-DATA ·precomputed<>+0x47d0(SB)/8, $0xdb2ce2186a75d822
-DATA ·precomputed<>+0x47d8(SB)/8, $0x88ffd4793d2273ca
-DATA ·precomputed<>+0x47e0(SB)/8, $0x89092ad2f2f26b61
-DATA ·precomputed<>+0x47e8(SB)/8, $0xfb7041bd686706ab
-DATA ·precomputed<>+0x47f0(SB)/8, $0xe3d5fa755e23597c
-DATA ·precomputed<>+0x47f8(SB)/8, $0xa2035bf85995fc84
-DATA ·precomputed<>+0x4800(SB)/8, $0xb5deb892313de667
-DATA ·precomputed<>+0x4808(SB)/8, $0x75c872d766a478a8
-DATA ·precomputed<>+0x4810(SB)/8, $0xb67b5513c4992428
-DATA ·precomputed<>+0x4818(SB)/8, $0xf97e010ef70fde09
-DATA ·precomputed<>+0x4820(SB)/8, $0x49b0f05360ee268c
-DATA ·precomputed<>+0x4828(SB)/8, $0x981b5141f67cd321
-DATA ·precomputed<>+0x4830(SB)/8, $0xb5a1ac8d4fbc187c
-DATA ·precomputed<>+0x4838(SB)/8, $0x162417e2c12e6da8
-DATA ·precomputed<>+0x4840(SB)/8, $0xd44550f8849e6d32
-DATA ·precomputed<>+0x4848(SB)/8, $0xe7bc29d4fe16485e
-DATA ·precomputed<>+0x4850(SB)/8, $0x29bbfec62f343924
-DATA ·precomputed<>+0x4858(SB)/8, $0xeeb802f240f2b5ce
-DATA ·precomputed<>+0x4860(SB)/8, $0x2b337542bbb64f33
-DATA ·precomputed<>+0x4868(SB)/8, $0x4c1d3a369f9bdb3c
-DATA ·precomputed<>+0x4870(SB)/8, $0x1067cf3bc7a1cb88
-DATA ·precomputed<>+0x4878(SB)/8, $0x3f12a31d4601fb6e
-DATA ·precomputed<>+0x4880(SB)/8, $0xe1c9a1c4d02a63e0
-DATA ·precomputed<>+0x4888(SB)/8, $0xf64a697e2c621187
-DATA ·precomputed<>+0x4890(SB)/8, $0x5d76bfb899baa463
-DATA ·precomputed<>+0x4898(SB)/8, $0xa16900d31ffbf9bb
-DATA ·precomputed<>+0x48a0(SB)/8, $0x548452a4a7eb8812
-DATA ·precomputed<>+0x48a8(SB)/8, $0x9cadafddddf192fd
-DATA ·precomputed<>+0x48b0(SB)/8, $0xb6d9e438147d0321
-DATA ·precomputed<>+0x48b8(SB)/8, $0x84d63d894d0860ea
-DATA ·precomputed<>+0x48c0(SB)/8, $0xb720a78f1f8a4a91
-DATA ·precomputed<>+0x48c8(SB)/8, $0x59e22211753dbe73
-DATA ·precomputed<>+0x48d0(SB)/8, $0x9f5ad99cadd0991a
-DATA ·precomputed<>+0x48d8(SB)/8, $0x3a0db8027380726f
-DATA ·precomputed<>+0x48e0(SB)/8, $0x37f0761c7dfb4f1c
-DATA ·precomputed<>+0x48e8(SB)/8, $0x68e7098a5ac819cd
-DATA ·precomputed<>+0x48f0(SB)/8, $0x9683d61037ffe348
-DATA ·precomputed<>+0x48f8(SB)/8, $0x5bf205e52b7b5140
-DATA ·precomputed<>+0x4900(SB)/8, $0xeaf6330d9ac86fe
-DATA ·precomputed<>+0x4908(SB)/8, $0xacc2fc233455173f
-DATA ·precomputed<>+0x4910(SB)/8, $0x239c03d560c9f072
-DATA ·precomputed<>+0x4918(SB)/8, $0x3a8694d8926d5a7
-DATA ·precomputed<>+0x4920(SB)/8, $0x674bb181cac8c695
-DATA ·precomputed<>+0x4928(SB)/8, $0x1d185727114f64b9
-DATA ·precomputed<>+0x4930(SB)/8, $0x6478eaa2db1d1e8d
-DATA ·precomputed<>+0x4938(SB)/8, $0xb076cacc0a5deba5
-DATA ·precomputed<>+0x4940(SB)/8, $0x32a7189573e92186
-DATA ·precomputed<>+0x4948(SB)/8, $0xbd16cfe9c6279363
-DATA ·precomputed<>+0x4950(SB)/8, $0xe3e4199cebe054d6
-DATA ·precomputed<>+0x4958(SB)/8, $0xab0ce65f01902295
-DATA ·precomputed<>+0x4960(SB)/8, $0x72c932d0f8f9734e
-DATA ·precomputed<>+0x4968(SB)/8, $0x853cb323f5fb04af
-DATA ·precomputed<>+0x4970(SB)/8, $0xa3950df5054a2611
-DATA ·precomputed<>+0x4978(SB)/8, $0xc1a3afaf285703bc
-DATA ·precomputed<>+0x4980(SB)/8, $0x54dbeda774361e20
-DATA ·precomputed<>+0x4988(SB)/8, $0x94d1e75fb042aaef
-DATA ·precomputed<>+0x4990(SB)/8, $0xddb995c60b5fe7c9
-DATA ·precomputed<>+0x4998(SB)/8, $0x821c92516aa225e8
-DATA ·precomputed<>+0x49a0(SB)/8, $0x81e3eb9cf9c85db2
-DATA ·precomputed<>+0x49a8(SB)/8, $0x10762adefa41d164
-DATA ·precomputed<>+0x49b0(SB)/8, $0xb4e24d33bbbebf27
-DATA ·precomputed<>+0x49b8(SB)/8, $0xbca0ff89c021951e
-DATA ·precomputed<>+0x49c0(SB)/8, $0x9846b5f661a97262
-DATA ·precomputed<>+0x49c8(SB)/8, $0xedf2cacb974a82f7
-DATA ·precomputed<>+0x49d0(SB)/8, $0x3dfab85faf439654
-DATA ·precomputed<>+0x49d8(SB)/8, $0x43fb0ef9c724ee09
-DATA ·precomputed<>+0x49e0(SB)/8, $0xd0d5016f53b0119a
-DATA ·precomputed<>+0x49e8(SB)/8, $0x684453635bc8fc81
-DATA ·precomputed<>+0x49f0(SB)/8, $0x6d10b6491f65d298
-DATA ·precomputed<>+0x49f8(SB)/8, $0xf3c88c621a4e64f
-DATA ·precomputed<>+0x4a00(SB)/8, $0xd415dec13730c515
-DATA ·precomputed<>+0x4a08(SB)/8, $0xfc8255fea49de520
-DATA ·precomputed<>+0x4a10(SB)/8, $0x414c523b8657656d
-DATA ·precomputed<>+0x4a18(SB)/8, $0x1b54b87a16f709cb
-DATA ·precomputed<>+0x4a20(SB)/8, $0x907d0871bdf37f70
-DATA ·precomputed<>+0x4a28(SB)/8, $0x10ead3e6f044bc88
-DATA ·precomputed<>+0x4a30(SB)/8, $0xf616f00a4cf47d00
-DATA ·precomputed<>+0x4a38(SB)/8, $0x9e94d84be93db45f
-DATA ·precomputed<>+0x4a40(SB)/8, $0x18682b0864588bf1
-DATA ·precomputed<>+0x4a48(SB)/8, $0x7bd229bb4c38616c
-DATA ·precomputed<>+0x4a50(SB)/8, $0x57a7b29c2f655d93
-DATA ·precomputed<>+0x4a58(SB)/8, $0x34993505e888ef2d
-DATA ·precomputed<>+0x4a60(SB)/8, $0xd3e4ef880983dd2a
-DATA ·precomputed<>+0x4a68(SB)/8, $0x1d895d8f76b0f1c0
-DATA ·precomputed<>+0x4a70(SB)/8, $0x9982e2ab09f2d5e2
-DATA ·precomputed<>+0x4a78(SB)/8, $0x6b63dd3e7afc1d29
-DATA ·precomputed<>+0x4a80(SB)/8, $0x5f74c9aaf931e4e5
-DATA ·precomputed<>+0x4a88(SB)/8, $0x2e3c71a4063d239d
-DATA ·precomputed<>+0x4a90(SB)/8, $0x47b7703e3323eba7
-DATA ·precomputed<>+0x4a98(SB)/8, $0x828c3f3559c4060
-DATA ·precomputed<>+0x4aa0(SB)/8, $0xf734402331287f70
-DATA ·precomputed<>+0x4aa8(SB)/8, $0xac56c0cc0b06048e
-DATA ·precomputed<>+0x4ab0(SB)/8, $0xfeb9fa4af2a3e67c
-DATA ·precomputed<>+0x4ab8(SB)/8, $0x897f7f608179ed0f
-DATA ·precomputed<>+0x4ac0(SB)/8, $0x320372a17f34c517
-DATA ·precomputed<>+0x4ac8(SB)/8, $0x5602bd162378bc27
-DATA ·precomputed<>+0x4ad0(SB)/8, $0x666a592d91aae024
-DATA ·precomputed<>+0x4ad8(SB)/8, $0x716886ab317bbdaa
-DATA ·precomputed<>+0x4ae0(SB)/8, $0xce55fe68e3045103
-DATA ·precomputed<>+0x4ae8(SB)/8, $0xf2c4b0b27de1d701
-DATA ·precomputed<>+0x4af0(SB)/8, $0x8da358857d724cb6
-DATA ·precomputed<>+0x4af8(SB)/8, $0x9aac623c9ec47314
-DATA ·precomputed<>+0x4b00(SB)/8, $0xf2f13e4d7ba91ace
-DATA ·precomputed<>+0x4b08(SB)/8, $0xb1650e77712b971
-DATA ·precomputed<>+0x4b10(SB)/8, $0x7b7b6e29ac522fc4
-DATA ·precomputed<>+0x4b18(SB)/8, $0xb39cd000fd0821a1
-DATA ·precomputed<>+0x4b20(SB)/8, $0xa1b1af6907bdf46f
-DATA ·precomputed<>+0x4b28(SB)/8, $0xf5b31304dbcd7db5
-DATA ·precomputed<>+0x4b30(SB)/8, $0xc7bcf337c03872dc
-DATA ·precomputed<>+0x4b38(SB)/8, $0x644f35086bd5eae3
-DATA ·precomputed<>+0x4b40(SB)/8, $0xab8a97218bcb77a3
-DATA ·precomputed<>+0x4b48(SB)/8, $0x437bdc8f2d8f79dc
-DATA ·precomputed<>+0x4b50(SB)/8, $0x7dd76986d2a560fb
-DATA ·precomputed<>+0x4b58(SB)/8, $0x5cf29d50f2064cc2
-DATA ·precomputed<>+0x4b60(SB)/8, $0xb946d54dd571813c
-DATA ·precomputed<>+0x4b68(SB)/8, $0xadf6ee2b0bcc97f
-DATA ·precomputed<>+0x4b70(SB)/8, $0x4424255d498cc8f1
-DATA ·precomputed<>+0x4b78(SB)/8, $0x562a6c66bd067832
-DATA ·precomputed<>+0x4b80(SB)/8, $0x5da1a4658c3c8e0f
-DATA ·precomputed<>+0x4b88(SB)/8, $0xd1db1a964355362
-DATA ·precomputed<>+0x4b90(SB)/8, $0xd50c0cc9cc23f27
-DATA ·precomputed<>+0x4b98(SB)/8, $0x9254532ccbb24be8
-DATA ·precomputed<>+0x4ba0(SB)/8, $0xbf3f6f936716b20
-DATA ·precomputed<>+0x4ba8(SB)/8, $0x3d1ca7386bd39486
-DATA ·precomputed<>+0x4bb0(SB)/8, $0xecbecf157b15c2b8
-DATA ·precomputed<>+0x4bb8(SB)/8, $0x47e49af1151bb965
-DATA ·precomputed<>+0x4bc0(SB)/8, $0x824cff46b8529a01
-DATA ·precomputed<>+0x4bc8(SB)/8, $0x6e4d82a24856b95c
-DATA ·precomputed<>+0x4bd0(SB)/8, $0x58c6b833c65af7f7
-DATA ·precomputed<>+0x4bd8(SB)/8, $0x8a6c4125ae110e53
-DATA ·precomputed<>+0x4be0(SB)/8, $0x38207c304f083340
-DATA ·precomputed<>+0x4be8(SB)/8, $0x71aa384b176cdb31
-DATA ·precomputed<>+0x4bf0(SB)/8, $0x1ada294142882de1
-DATA ·precomputed<>+0x4bf8(SB)/8, $0x38b1ad2ec16a2e4a
-DATA ·precomputed<>+0x4c00(SB)/8, $0x85c2a0d771362bcb
-DATA ·precomputed<>+0x4c08(SB)/8, $0x38dd386643cdf075
-DATA ·precomputed<>+0x4c10(SB)/8, $0xc25ad10591aac7ef
-DATA ·precomputed<>+0x4c18(SB)/8, $0xa8742cf709e6c9bc
-DATA ·precomputed<>+0x4c20(SB)/8, $0x1747d195c20c21fd
-DATA ·precomputed<>+0x4c28(SB)/8, $0xa88994bdbc750787
-DATA ·precomputed<>+0x4c30(SB)/8, $0x642e5493b03eb593
-DATA ·precomputed<>+0x4c38(SB)/8, $0xc07b15eb678cc4e5
-DATA ·precomputed<>+0x4c40(SB)/8, $0x556b74fa0738fcd
-DATA ·precomputed<>+0x4c48(SB)/8, $0x6dc0f08a1bd795d8
-DATA ·precomputed<>+0x4c50(SB)/8, $0x601b55aceaf039f9
-DATA ·precomputed<>+0x4c58(SB)/8, $0x8cab0d33e5623b97
-DATA ·precomputed<>+0x4c60(SB)/8, $0x30b2e6550f7a398f
-DATA ·precomputed<>+0x4c68(SB)/8, $0x83859b76a94efaa6
-DATA ·precomputed<>+0x4c70(SB)/8, $0x9324f2676dd07b3e
-DATA ·precomputed<>+0x4c78(SB)/8, $0xe6ebc4d73b75ff7a
-DATA ·precomputed<>+0x4c80(SB)/8, $0xe955e07c7f20c5a6
-DATA ·precomputed<>+0x4c88(SB)/8, $0xc019290a76115c64
-DATA ·precomputed<>+0x4c90(SB)/8, $0x79c5f02e891f08cf
-DATA ·precomputed<>+0x4c98(SB)/8, $0xdfeee82a4924cd9b
-DATA ·precomputed<>+0x4ca0(SB)/8, $0xd00cd7169e510182
-DATA ·precomputed<>+0x4ca8(SB)/8, $0xeebedd2d14fee10
-DATA ·precomputed<>+0x4cb0(SB)/8, $0xe19016f621802d67
-DATA ·precomputed<>+0x4cb8(SB)/8, $0xe4aea9356cbc135d
-DATA ·precomputed<>+0x4cc0(SB)/8, $0xbdda2720142bcb30
-DATA ·precomputed<>+0x4cc8(SB)/8, $0x56175263faf604d1
-DATA ·precomputed<>+0x4cd0(SB)/8, $0x86189c1e6796314
-DATA ·precomputed<>+0x4cd8(SB)/8, $0xdab01c685b04dd19
-DATA ·precomputed<>+0x4ce0(SB)/8, $0xce54e4b0ba8ed3c1
-DATA ·precomputed<>+0x4ce8(SB)/8, $0xf616513be281acfb
-DATA ·precomputed<>+0x4cf0(SB)/8, $0xaf1796295e0a6319
-DATA ·precomputed<>+0x4cf8(SB)/8, $0x85e79ac9328b587b
-DATA ·precomputed<>+0x4d00(SB)/8, $0xc782af0def7f77a2
-DATA ·precomputed<>+0x4d08(SB)/8, $0x1c2a2e9651fcf359
-DATA ·precomputed<>+0x4d10(SB)/8, $0xafdd6a08f1990e8b
-DATA ·precomputed<>+0x4d18(SB)/8, $0x865af1b5259b502f
-DATA ·precomputed<>+0x4d20(SB)/8, $0x6020bfaaa2842a15
-DATA ·precomputed<>+0x4d28(SB)/8, $0xd6850e6a3f59d6ce
-DATA ·precomputed<>+0x4d30(SB)/8, $0xde2b3080009e4745
-DATA ·precomputed<>+0x4d38(SB)/8, $0x1b2d981fdd193a5d
-DATA ·precomputed<>+0x4d40(SB)/8, $0xd8af013c122359a7
-DATA ·precomputed<>+0x4d48(SB)/8, $0x9202869ff0147aa5
-DATA ·precomputed<>+0x4d50(SB)/8, $0x2880977a7b7b2bf7
-DATA ·precomputed<>+0x4d58(SB)/8, $0xc2f0e68ca2286cba
-DATA ·precomputed<>+0x4d60(SB)/8, $0x1f70239db1b03e79
-DATA ·precomputed<>+0x4d68(SB)/8, $0x7418b9f16b5fc301
-DATA ·precomputed<>+0x4d70(SB)/8, $0x3131f26e2af9c326
-DATA ·precomputed<>+0x4d78(SB)/8, $0x346a460c076e47f4
-DATA ·precomputed<>+0x4d80(SB)/8, $0x9f4f34115d35686
-DATA ·precomputed<>+0x4d88(SB)/8, $0x9c3ac90fade18cf2
-DATA ·precomputed<>+0x4d90(SB)/8, $0xcf7f09f326eebaeb
-DATA ·precomputed<>+0x4d98(SB)/8, $0x69ed6c45507bee64
-DATA ·precomputed<>+0x4da0(SB)/8, $0x55f3c6e0742bb142
-DATA ·precomputed<>+0x4da8(SB)/8, $0x863d4056926032ac
-DATA ·precomputed<>+0x4db0(SB)/8, $0xb3855cc3e8986347
-DATA ·precomputed<>+0x4db8(SB)/8, $0xf4942a105d8d5e0
-DATA ·precomputed<>+0x4dc0(SB)/8, $0x11d84588c9fd7da0
-DATA ·precomputed<>+0x4dc8(SB)/8, $0xa78682d01238d0c4
-DATA ·precomputed<>+0x4dd0(SB)/8, $0x333ddde0829d6475
-DATA ·precomputed<>+0x4dd8(SB)/8, $0x80c8844069de9e18
-DATA ·precomputed<>+0x4de0(SB)/8, $0x5d15f21ac6d8176f
-DATA ·precomputed<>+0x4de8(SB)/8, $0xdaff9434a509d470
-DATA ·precomputed<>+0x4df0(SB)/8, $0x191bb0a8bbbfcd5
-DATA ·precomputed<>+0x4df8(SB)/8, $0xff7732b808fc2688
-DATA ·precomputed<>+0x4e00(SB)/8, $0x862221223fe14214
-DATA ·precomputed<>+0x4e08(SB)/8, $0xb221e1b8593212e4
-DATA ·precomputed<>+0x4e10(SB)/8, $0xac9c3c4ceba5b3f
-DATA ·precomputed<>+0x4e18(SB)/8, $0xb3010de7b9cb8689
-DATA ·precomputed<>+0x4e20(SB)/8, $0xb3e57946b72abf31
-DATA ·precomputed<>+0x4e28(SB)/8, $0x383da2787ff5986
-DATA ·precomputed<>+0x4e30(SB)/8, $0xeec03e619dbd7ea6
-DATA ·precomputed<>+0x4e38(SB)/8, $0x43605ca311f51904
-DATA ·precomputed<>+0x4e40(SB)/8, $0x4629a59e0e83ed1
-DATA ·precomputed<>+0x4e48(SB)/8, $0x177971c0059a7ea5
-DATA ·precomputed<>+0x4e50(SB)/8, $0xfd79fe74bcd1a2f4
-DATA ·precomputed<>+0x4e58(SB)/8, $0xfe9f0af34fded695
-DATA ·precomputed<>+0x4e60(SB)/8, $0x437d272ce14a791e
-DATA ·precomputed<>+0x4e68(SB)/8, $0x450381fbb596eebc
-DATA ·precomputed<>+0x4e70(SB)/8, $0xafc9c15882f3a78f
-DATA ·precomputed<>+0x4e78(SB)/8, $0xee8910e5ff2d711e
-DATA ·precomputed<>+0x4e80(SB)/8, $0x23147aaf6aee49fb
-DATA ·precomputed<>+0x4e88(SB)/8, $0x73f34763c0dce15a
-DATA ·precomputed<>+0x4e90(SB)/8, $0x16a4509de46fd938
-DATA ·precomputed<>+0x4e98(SB)/8, $0x704e61e4c1cce41
-DATA ·precomputed<>+0x4ea0(SB)/8, $0xeb418bf60c0f6388
-DATA ·precomputed<>+0x4ea8(SB)/8, $0xe40831684c268fd1
-DATA ·precomputed<>+0x4eb0(SB)/8, $0x571b610252bdc26b
-DATA ·precomputed<>+0x4eb8(SB)/8, $0x36451a317fcbb2d8
-DATA ·precomputed<>+0x4ec0(SB)/8, $0x2fe772d5ab3d89e
-DATA ·precomputed<>+0x4ec8(SB)/8, $0xf1580ec99a786c91
-DATA ·precomputed<>+0x4ed0(SB)/8, $0x8fd834175a323866
-DATA ·precomputed<>+0x4ed8(SB)/8, $0x93711d49badec96f
-DATA ·precomputed<>+0x4ee0(SB)/8, $0x2020c34a6b9b4a30
-DATA ·precomputed<>+0x4ee8(SB)/8, $0xbf10e000b8b0de24
-DATA ·precomputed<>+0x4ef0(SB)/8, $0x2a5f298d28de3ce5
-DATA ·precomputed<>+0x4ef8(SB)/8, $0x807a398efe1a1c63
-DATA ·precomputed<>+0x4f00(SB)/8, $0x44712f474cd0aace
-DATA ·precomputed<>+0x4f08(SB)/8, $0xbff97ea2f60536ef
-DATA ·precomputed<>+0x4f10(SB)/8, $0x915cf31e0d15d844
-DATA ·precomputed<>+0x4f18(SB)/8, $0xf3bbe1aaf508e4c5
-DATA ·precomputed<>+0x4f20(SB)/8, $0xd3580e53d3dd846e
-DATA ·precomputed<>+0x4f28(SB)/8, $0x57f7b78cc4e57878
-DATA ·precomputed<>+0x4f30(SB)/8, $0x907c3bcb1f7025a4
-DATA ·precomputed<>+0x4f38(SB)/8, $0x4eba937ebba16cf5
-DATA ·precomputed<>+0x4f40(SB)/8, $0x2a529afb63c60793
-DATA ·precomputed<>+0x4f48(SB)/8, $0x370d3a5ee69b13b7
-DATA ·precomputed<>+0x4f50(SB)/8, $0x5e8e1e881a94059c
-DATA ·precomputed<>+0x4f58(SB)/8, $0x38401da1232474b
-DATA ·precomputed<>+0x4f60(SB)/8, $0x450b9304fba5da30
-DATA ·precomputed<>+0x4f68(SB)/8, $0x8c22409c4a3142f0
-DATA ·precomputed<>+0x4f70(SB)/8, $0x3c77747840a98d19
-DATA ·precomputed<>+0x4f78(SB)/8, $0xe026b7e7d6928ad6
-DATA ·precomputed<>+0x4f80(SB)/8, $0x35ff39152bf4c76e
-DATA ·precomputed<>+0x4f88(SB)/8, $0x3549b3735a475b00
-DATA ·precomputed<>+0x4f90(SB)/8, $0x1e43904e90ecc136
-DATA ·precomputed<>+0x4f98(SB)/8, $0x1761e75bc55475e0
-DATA ·precomputed<>+0x4fa0(SB)/8, $0xf7f37188b242dd1a
-DATA ·precomputed<>+0x4fa8(SB)/8, $0xed74e312fa311c91
-DATA ·precomputed<>+0x4fb0(SB)/8, $0x14fc70a71bca63b9
-DATA ·precomputed<>+0x4fb8(SB)/8, $0x10b82c178da9a87d
-DATA ·precomputed<>+0x4fc0(SB)/8, $0x9fb640cd73f7c45c
-DATA ·precomputed<>+0x4fc8(SB)/8, $0xeb1f87ad0afe059c
-DATA ·precomputed<>+0x4fd0(SB)/8, $0xa3c3979a52b168d4
-DATA ·precomputed<>+0x4fd8(SB)/8, $0x6eef460c7b1e403f
-DATA ·precomputed<>+0x4fe0(SB)/8, $0x6d943e502724bb3f
-DATA ·precomputed<>+0x4fe8(SB)/8, $0x53f3f1bbf9d922d1
-DATA ·precomputed<>+0x4ff0(SB)/8, $0x547e7a03cd538b4a
-DATA ·precomputed<>+0x4ff8(SB)/8, $0x37631e20d2c4145f
-DATA ·precomputed<>+0x5000(SB)/8, $0xb7c9bf06970e164d
-DATA ·precomputed<>+0x5008(SB)/8, $0xc27a88d8bd3d3087
-DATA ·precomputed<>+0x5010(SB)/8, $0x8a37c9cdf4e7c899
-DATA ·precomputed<>+0x5018(SB)/8, $0x18494d5aab411371
-DATA ·precomputed<>+0x5020(SB)/8, $0x6532375d9d8b29c
-DATA ·precomputed<>+0x5028(SB)/8, $0xb92dd45c915a2f74
-DATA ·precomputed<>+0x5030(SB)/8, $0x8a23f6bf515acb02
-DATA ·precomputed<>+0x5038(SB)/8, $0xe69248c435bfa89
-DATA ·precomputed<>+0x5040(SB)/8, $0x561454fd6113a9f8
-DATA ·precomputed<>+0x5048(SB)/8, $0x78ebe733e70e67e6
-DATA ·precomputed<>+0x5050(SB)/8, $0x8764360b903f2feb
-DATA ·precomputed<>+0x5058(SB)/8, $0x2ba3b3d897902f36
-DATA ·precomputed<>+0x5060(SB)/8, $0x28808cef87490b8a
-DATA ·precomputed<>+0x5068(SB)/8, $0xb1175954f05f31b3
-DATA ·precomputed<>+0x5070(SB)/8, $0xbd5d60056c9b4f4d
-DATA ·precomputed<>+0x5078(SB)/8, $0x12b13fcadd254e60
-DATA ·precomputed<>+0x5080(SB)/8, $0xbf8474449a7a4ede
-DATA ·precomputed<>+0x5088(SB)/8, $0xb26b1f15f5cfd20f
-DATA ·precomputed<>+0x5090(SB)/8, $0xf380ed7d83b33b64
-DATA ·precomputed<>+0x5098(SB)/8, $0xa21f95643d1998c9
-DATA ·precomputed<>+0x50a0(SB)/8, $0xd985c7d3a720e347
-DATA ·precomputed<>+0x50a8(SB)/8, $0x980789748bdf09d5
-DATA ·precomputed<>+0x50b0(SB)/8, $0xa1f34ce2ce947692
-DATA ·precomputed<>+0x50b8(SB)/8, $0xf69e6144f419c385
-DATA ·precomputed<>+0x50c0(SB)/8, $0x7fc071ef16f55d40
-DATA ·precomputed<>+0x50c8(SB)/8, $0x701954389bb45ea5
-DATA ·precomputed<>+0x50d0(SB)/8, $0x83cf09f2a35543ca
-DATA ·precomputed<>+0x50d8(SB)/8, $0x7e91a8420554c19
-DATA ·precomputed<>+0x50e0(SB)/8, $0x51ecd70162a9d06e
-DATA ·precomputed<>+0x50e8(SB)/8, $0xe14c622044a663
-DATA ·precomputed<>+0x50f0(SB)/8, $0xb1317c1300423dd9
-DATA ·precomputed<>+0x50f8(SB)/8, $0xf49431bca46eab4c
-DATA ·precomputed<>+0x5100(SB)/8, $0x2ed5548a60bffa60
-DATA ·precomputed<>+0x5108(SB)/8, $0x903708bb6e0c4e34
-DATA ·precomputed<>+0x5110(SB)/8, $0xcbc42d78a1db5bad
-DATA ·precomputed<>+0x5118(SB)/8, $0x152ad7376be148a5
-DATA ·precomputed<>+0x5120(SB)/8, $0x8b3fcda3ef8265d6
-DATA ·precomputed<>+0x5128(SB)/8, $0xcfcb34f3790c675d
-DATA ·precomputed<>+0x5130(SB)/8, $0xc2954b5d333d235
-DATA ·precomputed<>+0x5138(SB)/8, $0xd4a7d1694a3203cb
-DATA ·precomputed<>+0x5140(SB)/8, $0x575bdffbcdc0e5cf
-DATA ·precomputed<>+0x5148(SB)/8, $0xeeb8cc135f611433
-DATA ·precomputed<>+0x5150(SB)/8, $0xe3b489f974c16d43
-DATA ·precomputed<>+0x5158(SB)/8, $0xb5c174dfc22ed997
-DATA ·precomputed<>+0x5160(SB)/8, $0x68cea9b6afb9c1d8
-DATA ·precomputed<>+0x5168(SB)/8, $0xda546da265cb574a
-DATA ·precomputed<>+0x5170(SB)/8, $0x688d2edc0e15c4a8
-DATA ·precomputed<>+0x5178(SB)/8, $0x3ffc898fbf45b307
-DATA ·precomputed<>+0x5180(SB)/8, $0x2e355f1be45b69cf
-DATA ·precomputed<>+0x5188(SB)/8, $0x570bb7976a5a8c53
-DATA ·precomputed<>+0x5190(SB)/8, $0xa0a43c059f7d0264
-DATA ·precomputed<>+0x5198(SB)/8, $0x1cd74a04220e4a61
-DATA ·precomputed<>+0x51a0(SB)/8, $0x2c352e876cf3ac0a
-DATA ·precomputed<>+0x51a8(SB)/8, $0xb7672e628212cb78
-DATA ·precomputed<>+0x51b0(SB)/8, $0x45a0ba3fc6c032d
-DATA ·precomputed<>+0x51b8(SB)/8, $0x33e7bc4403ad9bc2
-DATA ·precomputed<>+0x51c0(SB)/8, $0x35118434d0614aa1
-DATA ·precomputed<>+0x51c8(SB)/8, $0x8bae9779d1418434
-DATA ·precomputed<>+0x51d0(SB)/8, $0xf5641d82b8c15b89
-DATA ·precomputed<>+0x51d8(SB)/8, $0x2383af56416432eb
-DATA ·precomputed<>+0x51e0(SB)/8, $0xa552d3f02c73f990
-DATA ·precomputed<>+0x51e8(SB)/8, $0x8df82e9ea6bbdc7d
-DATA ·precomputed<>+0x51f0(SB)/8, $0xf336aa8d75ec634
-DATA ·precomputed<>+0x51f8(SB)/8, $0xc42e3b2d1603e53f
-DATA ·precomputed<>+0x5200(SB)/8, $0xd91049cd9ecdf09a
-DATA ·precomputed<>+0x5208(SB)/8, $0xf02fd6837df9e948
-DATA ·precomputed<>+0x5210(SB)/8, $0x29ea2cbc75999b7f
-DATA ·precomputed<>+0x5218(SB)/8, $0xada6da88deb478cb
-DATA ·precomputed<>+0x5220(SB)/8, $0x1ed02e2f159d0642
-DATA ·precomputed<>+0x5228(SB)/8, $0xfd90aec58d6e28c5
-DATA ·precomputed<>+0x5230(SB)/8, $0x1795082ed2fe2b50
-DATA ·precomputed<>+0x5238(SB)/8, $0xd16d33cdcced0c3f
-DATA ·precomputed<>+0x5240(SB)/8, $0x700e4ed20f51d7a9
-DATA ·precomputed<>+0x5248(SB)/8, $0xb29a71b431ea3e87
-DATA ·precomputed<>+0x5250(SB)/8, $0x33c7ad4a1717ee5c
-DATA ·precomputed<>+0x5258(SB)/8, $0xe093577874793310
-DATA ·precomputed<>+0x5260(SB)/8, $0xe066ceb1c198206c
-DATA ·precomputed<>+0x5268(SB)/8, $0x560e71bdfbc80797
-DATA ·precomputed<>+0x5270(SB)/8, $0xee3e56feaec5d6d2
-DATA ·precomputed<>+0x5278(SB)/8, $0xf8608a5a6ae9c6ca
-DATA ·precomputed<>+0x5280(SB)/8, $0xe7ffde102308a01d
-DATA ·precomputed<>+0x5288(SB)/8, $0xc14fcf7baf0a8194
-DATA ·precomputed<>+0x5290(SB)/8, $0x2f3a852bc67b1b1c
-DATA ·precomputed<>+0x5298(SB)/8, $0x67487f0130f0468e
-DATA ·precomputed<>+0x52a0(SB)/8, $0x12ab50a11a969483
-DATA ·precomputed<>+0x52a8(SB)/8, $0xde6d6e48a91366b1
-DATA ·precomputed<>+0x52b0(SB)/8, $0x82d74ee9176e1047
-DATA ·precomputed<>+0x52b8(SB)/8, $0xe1a933e77950f1e1
-DATA ·precomputed<>+0x52c0(SB)/8, $0xd3b730bac3c32c0f
-DATA ·precomputed<>+0x52c8(SB)/8, $0xd40b1514e2a7ffeb
-DATA ·precomputed<>+0x52d0(SB)/8, $0x9fe2f2ca691aaceb
-DATA ·precomputed<>+0x52d8(SB)/8, $0x1d402e6a5804b98c
-DATA ·precomputed<>+0x52e0(SB)/8, $0x286df9c2b8c225bb
-DATA ·precomputed<>+0x52e8(SB)/8, $0xa920c30e897f3131
-DATA ·precomputed<>+0x52f0(SB)/8, $0xa53cf00b18df5c0f
-DATA ·precomputed<>+0x52f8(SB)/8, $0xdf2af8fb941a9454
-DATA ·precomputed<>+0x5300(SB)/8, $0xd87d4ca55e81a678
-DATA ·precomputed<>+0x5308(SB)/8, $0x133f46665a9d66a0
-DATA ·precomputed<>+0x5310(SB)/8, $0xc25210b67a16cdf7
-DATA ·precomputed<>+0x5318(SB)/8, $0xe05a81fbe229205e
-DATA ·precomputed<>+0x5320(SB)/8, $0x9390321da1e5e3e2
-DATA ·precomputed<>+0x5328(SB)/8, $0x557c497f4335d268
-DATA ·precomputed<>+0x5330(SB)/8, $0x5fa1638d27a5c554
-DATA ·precomputed<>+0x5338(SB)/8, $0x90a6add34c7488aa
-DATA ·precomputed<>+0x5340(SB)/8, $0x21a82697fc5d6ecc
-DATA ·precomputed<>+0x5348(SB)/8, $0xab09603e93075997
-DATA ·precomputed<>+0x5350(SB)/8, $0xa258cf8423c1860a
-DATA ·precomputed<>+0x5358(SB)/8, $0xe7c7afdcde5b40bb
-DATA ·precomputed<>+0x5360(SB)/8, $0xb5f56b9ebb4a2cac
-DATA ·precomputed<>+0x5368(SB)/8, $0x486d13f06390f712
-DATA ·precomputed<>+0x5370(SB)/8, $0xfb806e2303c27c38
-DATA ·precomputed<>+0x5378(SB)/8, $0x174d4c22c423af8b
-DATA ·precomputed<>+0x5380(SB)/8, $0x2a242883bcf966e3
-DATA ·precomputed<>+0x5388(SB)/8, $0xba0a3f06296ff65f
-DATA ·precomputed<>+0x5390(SB)/8, $0x58f644c40974a2d6
-DATA ·precomputed<>+0x5398(SB)/8, $0x1b6757e16f868b99
-DATA ·precomputed<>+0x53a0(SB)/8, $0xcf03daa89ca9083
-DATA ·precomputed<>+0x53a8(SB)/8, $0x395df427d73bfb60
-DATA ·precomputed<>+0x53b0(SB)/8, $0x8337909148f793ac
-DATA ·precomputed<>+0x53b8(SB)/8, $0xc20a1c45028cd306
-DATA ·precomputed<>+0x53c0(SB)/8, $0x4b33e020bad830d2
-DATA ·precomputed<>+0x53c8(SB)/8, $0x5c101f9e590dffb3
-DATA ·precomputed<>+0x53d0(SB)/8, $0xcd0e0498bc80ecb0
-DATA ·precomputed<>+0x53d8(SB)/8, $0x302787f852aa293e
-DATA ·precomputed<>+0x53e0(SB)/8, $0xbfd64ced220f8fc8
-DATA ·precomputed<>+0x53e8(SB)/8, $0xcf5cebe0be0ee377
-DATA ·precomputed<>+0x53f0(SB)/8, $0xdc03a0388913b128
-DATA ·precomputed<>+0x53f8(SB)/8, $0x4b096971fde23279
-DATA ·precomputed<>+0x5400(SB)/8, $0x92d6392c4b61f5f8
-DATA ·precomputed<>+0x5408(SB)/8, $0xdcf90a8a0a87823c
-DATA ·precomputed<>+0x5410(SB)/8, $0x6318cb10efe4eb4c
-DATA ·precomputed<>+0x5418(SB)/8, $0x1c8dd7209758e4cd
-DATA ·precomputed<>+0x5420(SB)/8, $0xf6d0811be7eba097
-DATA ·precomputed<>+0x5428(SB)/8, $0x558f7343ad1e2447
-DATA ·precomputed<>+0x5430(SB)/8, $0xcae8f747fcc7f325
-DATA ·precomputed<>+0x5438(SB)/8, $0xee13152e610af007
-DATA ·precomputed<>+0x5440(SB)/8, $0x387841d41a447e26
-DATA ·precomputed<>+0x5448(SB)/8, $0xfbbb05cd24c36468
-DATA ·precomputed<>+0x5450(SB)/8, $0xc2967a08c47e2196
-DATA ·precomputed<>+0x5458(SB)/8, $0xb5ee765b0c880751
-DATA ·precomputed<>+0x5460(SB)/8, $0x6a46ef6b62c0005
-DATA ·precomputed<>+0x5468(SB)/8, $0xaa3ebfeaa49a7364
-DATA ·precomputed<>+0x5470(SB)/8, $0x4bc4bc2d0547634d
-DATA ·precomputed<>+0x5478(SB)/8, $0x90dbc44c5f6eb06a
-DATA ·precomputed<>+0x5480(SB)/8, $0xac43f5051882d296
-DATA ·precomputed<>+0x5488(SB)/8, $0xf126519aa51ccb29
-DATA ·precomputed<>+0x5490(SB)/8, $0x18b49bc951f7dd90
-DATA ·precomputed<>+0x5498(SB)/8, $0x299f7450113634f7
-DATA ·precomputed<>+0x54a0(SB)/8, $0x3cab192723c2437a
-DATA ·precomputed<>+0x54a8(SB)/8, $0xe70274a2fe7e5a41
-DATA ·precomputed<>+0x54b0(SB)/8, $0x5f2856e57bfef5d0
-DATA ·precomputed<>+0x54b8(SB)/8, $0x2af941f26c14f34b
-DATA ·precomputed<>+0x54c0(SB)/8, $0x25e62f4afc32b9bf
-DATA ·precomputed<>+0x54c8(SB)/8, $0xd55f38d13446805b
-DATA ·precomputed<>+0x54d0(SB)/8, $0xfdb6326b603f39ab
-DATA ·precomputed<>+0x54d8(SB)/8, $0xcefc4f5c9872bbb1
-DATA ·precomputed<>+0x54e0(SB)/8, $0xc5d8c4d48b776616
-DATA ·precomputed<>+0x54e8(SB)/8, $0x75a5f0376f5685e
-DATA ·precomputed<>+0x54f0(SB)/8, $0x4973bbd1a390b0b9
-DATA ·precomputed<>+0x54f8(SB)/8, $0xfdd8d5a64e463ac9
-DATA ·precomputed<>+0x5500(SB)/8, $0x4c002916cea427c0
-DATA ·precomputed<>+0x5508(SB)/8, $0xbde4865888b9d730
-DATA ·precomputed<>+0x5510(SB)/8, $0x5e3fbcae8bb7f960
-DATA ·precomputed<>+0x5518(SB)/8, $0xfd57eae3a412dae6
-DATA ·precomputed<>+0x5520(SB)/8, $0x4c007334c03802bd
-DATA ·precomputed<>+0x5528(SB)/8, $0x1ff5380f107d55eb
-DATA ·precomputed<>+0x5530(SB)/8, $0xfa381ec209e4e419
-DATA ·precomputed<>+0x5538(SB)/8, $0x87a18ce1964d3d28
-DATA ·precomputed<>+0x5540(SB)/8, $0xeef9efad25a4b1e1
-DATA ·precomputed<>+0x5548(SB)/8, $0x4c757498accbf1b1
-DATA ·precomputed<>+0x5550(SB)/8, $0x391f3efdf86713a
-DATA ·precomputed<>+0x5558(SB)/8, $0x895f6818dd41b1c
-DATA ·precomputed<>+0x5560(SB)/8, $0x95bc3b62cc5bd2d5
-DATA ·precomputed<>+0x5568(SB)/8, $0x885c8a6289865813
-DATA ·precomputed<>+0x5570(SB)/8, $0x9f7bdd38c36049c4
-DATA ·precomputed<>+0x5578(SB)/8, $0x7d543fb748833e07
-DATA ·precomputed<>+0x5580(SB)/8, $0x8abfbde37d6fcdd2
-DATA ·precomputed<>+0x5588(SB)/8, $0xf3dfa901976effb7
-DATA ·precomputed<>+0x5590(SB)/8, $0xdc3977fdd8d77e87
-DATA ·precomputed<>+0x5598(SB)/8, $0x3b06f26e093343dc
-DATA ·precomputed<>+0x55a0(SB)/8, $0x70fcd18820b4be31
-DATA ·precomputed<>+0x55a8(SB)/8, $0xc7c243a91f467923
-DATA ·precomputed<>+0x55b0(SB)/8, $0x8fd3543e89c864e1
-DATA ·precomputed<>+0x55b8(SB)/8, $0xebeb0c77e32b4e2d
-DATA ·precomputed<>+0x55c0(SB)/8, $0xb0f8c0ded2d638ad
-DATA ·precomputed<>+0x55c8(SB)/8, $0x47fc8c774f299d5f
-DATA ·precomputed<>+0x55d0(SB)/8, $0xd1720a929b68d48e
-DATA ·precomputed<>+0x55d8(SB)/8, $0xf944e708a1c6f103
-DATA ·precomputed<>+0x55e0(SB)/8, $0x36e34e04a146889b
-DATA ·precomputed<>+0x55e8(SB)/8, $0xb0aad2d6e74a2a28
-DATA ·precomputed<>+0x55f0(SB)/8, $0xedbb034bca52f53c
-DATA ·precomputed<>+0x55f8(SB)/8, $0xe987a8e187fb2713
-DATA ·precomputed<>+0x5600(SB)/8, $0x646ed9fcc1442abf
-DATA ·precomputed<>+0x5608(SB)/8, $0xbcbb43713beae2dd
-DATA ·precomputed<>+0x5610(SB)/8, $0x772be1e6c6f8ddaf
-DATA ·precomputed<>+0x5618(SB)/8, $0xfc2531b5212ec27a
-DATA ·precomputed<>+0x5620(SB)/8, $0xf3446fc22691039b
-DATA ·precomputed<>+0x5628(SB)/8, $0x950d448c66da9fa0
-DATA ·precomputed<>+0x5630(SB)/8, $0x6df63e9600a95fc0
-DATA ·precomputed<>+0x5638(SB)/8, $0x8973274d52b716ff
-DATA ·precomputed<>+0x5640(SB)/8, $0x4319ab3c0d90388d
-DATA ·precomputed<>+0x5648(SB)/8, $0xc85c837c09253137
-DATA ·precomputed<>+0x5650(SB)/8, $0x7abdb6cbd31000c2
-DATA ·precomputed<>+0x5658(SB)/8, $0x89bbf099dd42878b
-DATA ·precomputed<>+0x5660(SB)/8, $0x83f8319ca7c2b1ad
-DATA ·precomputed<>+0x5668(SB)/8, $0xf4e9cabc66102727
-DATA ·precomputed<>+0x5670(SB)/8, $0xd3b612d9c8391680
-DATA ·precomputed<>+0x5678(SB)/8, $0x927e7c7ba4565c9f
-DATA ·precomputed<>+0x5680(SB)/8, $0xce3f5ab12c26b835
-DATA ·precomputed<>+0x5688(SB)/8, $0xc3e20901dd0b5cf5
-DATA ·precomputed<>+0x5690(SB)/8, $0x263d4ab95a75dd49
-DATA ·precomputed<>+0x5698(SB)/8, $0x23925a07b1d5a031
-DATA ·precomputed<>+0x56a0(SB)/8, $0x8ba17621e3f592eb
-DATA ·precomputed<>+0x56a8(SB)/8, $0x7c074be0f3b8308c
-DATA ·precomputed<>+0x56b0(SB)/8, $0xd357a22cf26f2845
-DATA ·precomputed<>+0x56b8(SB)/8, $0xaa1b5a8a2382ecd4
-DATA ·precomputed<>+0x56c0(SB)/8, $0x9c57b8e8869cf757
-DATA ·precomputed<>+0x56c8(SB)/8, $0x45cf27528838c15f
-DATA ·precomputed<>+0x56d0(SB)/8, $0xab97b81ee1d1b674
-DATA ·precomputed<>+0x56d8(SB)/8, $0xc7a681c1868f4b9b
-DATA ·precomputed<>+0x56e0(SB)/8, $0xb47f04c9300a89f9
-DATA ·precomputed<>+0x56e8(SB)/8, $0x6e2f2151f379acdc
-DATA ·precomputed<>+0x56f0(SB)/8, $0x278e80cc2499ef19
-DATA ·precomputed<>+0x56f8(SB)/8, $0x576d5b46cc7d1e56
-DATA ·precomputed<>+0x5700(SB)/8, $0x2b130c818c842a18
-DATA ·precomputed<>+0x5708(SB)/8, $0x41ecd3a579248dcf
-DATA ·precomputed<>+0x5710(SB)/8, $0x8bf6880561e0c5da
-DATA ·precomputed<>+0x5718(SB)/8, $0x41122c142c0942e5
-DATA ·precomputed<>+0x5720(SB)/8, $0xb4bc3e105d5932d4
-DATA ·precomputed<>+0x5728(SB)/8, $0x30b339da55a6105
-DATA ·precomputed<>+0x5730(SB)/8, $0xdb01198ee3d1a274
-DATA ·precomputed<>+0x5738(SB)/8, $0x3bfe6d284f4c26c0
-DATA ·precomputed<>+0x5740(SB)/8, $0x721ed15216a2ac8c
-DATA ·precomputed<>+0x5748(SB)/8, $0x9c835d8635442e38
-DATA ·precomputed<>+0x5750(SB)/8, $0xbe4a02c1d1cd1395
-DATA ·precomputed<>+0x5758(SB)/8, $0xd0ffa949a098052a
-DATA ·precomputed<>+0x5760(SB)/8, $0xb60583690ec30cc8
-DATA ·precomputed<>+0x5768(SB)/8, $0xd3a7179a317c803f
-DATA ·precomputed<>+0x5770(SB)/8, $0xe46da0ce4b6487e9
-DATA ·precomputed<>+0x5778(SB)/8, $0x5d814cdc5b32a864
-DATA ·precomputed<>+0x5780(SB)/8, $0x14909af34413725c
-DATA ·precomputed<>+0x5788(SB)/8, $0xd67cb1e4252b9db2
-DATA ·precomputed<>+0x5790(SB)/8, $0xa6ff44379701ed4f
-DATA ·precomputed<>+0x5798(SB)/8, $0x447872911e373c46
-DATA ·precomputed<>+0x57a0(SB)/8, $0xf85e44707307bddf
-DATA ·precomputed<>+0x57a8(SB)/8, $0x42d4cdc184c8958b
-DATA ·precomputed<>+0x57b0(SB)/8, $0x1bbd7b5c33e4d858
-DATA ·precomputed<>+0x57b8(SB)/8, $0x1bdc8b07fd33110c
-DATA ·precomputed<>+0x57c0(SB)/8, $0x6c5389aff727ef3a
-DATA ·precomputed<>+0x57c8(SB)/8, $0x95ffeb9533db88fb
-DATA ·precomputed<>+0x57d0(SB)/8, $0x27cb70429dae0777
-DATA ·precomputed<>+0x57d8(SB)/8, $0xd20afe81616dbf02
-DATA ·precomputed<>+0x57e0(SB)/8, $0xfab8e18914bf706
-DATA ·precomputed<>+0x57e8(SB)/8, $0x3b1e66f30517cd09
-DATA ·precomputed<>+0x57f0(SB)/8, $0x24b46dce12e40644
-DATA ·precomputed<>+0x57f8(SB)/8, $0xff1016808f2d8fa
-DATA ·precomputed<>+0x5800(SB)/8, $0xb13caaddca9a9526
-DATA ·precomputed<>+0x5808(SB)/8, $0x701c63fa69a303e9
-DATA ·precomputed<>+0x5810(SB)/8, $0xb97d667ab0a50f3c
-DATA ·precomputed<>+0x5818(SB)/8, $0x27c03d7c68e6557f
-DATA ·precomputed<>+0x5820(SB)/8, $0xab24e712eb105607
-DATA ·precomputed<>+0x5828(SB)/8, $0x4936aedd8dd86ccb
-DATA ·precomputed<>+0x5830(SB)/8, $0x32196f8a0a986d68
-DATA ·precomputed<>+0x5838(SB)/8, $0x307b826248f5a65
-DATA ·precomputed<>+0x5840(SB)/8, $0x605f581a88a45b65
-DATA ·precomputed<>+0x5848(SB)/8, $0xcb78920068e58c1c
-DATA ·precomputed<>+0x5850(SB)/8, $0x14cbed65bc5bfe1c
-DATA ·precomputed<>+0x5858(SB)/8, $0xd1af7dc7f02b11d7
-DATA ·precomputed<>+0x5860(SB)/8, $0xb8341bc0cd3a7cc8
-DATA ·precomputed<>+0x5868(SB)/8, $0x8e9aefe8a01a77b7
-DATA ·precomputed<>+0x5870(SB)/8, $0x8eeafe875ae2a402
-DATA ·precomputed<>+0x5878(SB)/8, $0x27a0698fc11f3859
-DATA ·precomputed<>+0x5880(SB)/8, $0x3f06c14699775c4e
-DATA ·precomputed<>+0x5888(SB)/8, $0xb10a4ed3f66e7d05
-DATA ·precomputed<>+0x5890(SB)/8, $0x9300e3ca3a3ab903
-DATA ·precomputed<>+0x5898(SB)/8, $0xa5610e0de3c3e1f
-DATA ·precomputed<>+0x58a0(SB)/8, $0xe28273121af56fb7
-DATA ·precomputed<>+0x58a8(SB)/8, $0x7e2a2365d75d9a9c
-DATA ·precomputed<>+0x58b0(SB)/8, $0x9c3bb05af11f8963
-DATA ·precomputed<>+0x58b8(SB)/8, $0xdf94cac730c80488
-DATA ·precomputed<>+0x58c0(SB)/8, $0x9b805759c8544885
-DATA ·precomputed<>+0x58c8(SB)/8, $0xbe9b99ca7bfcad78
-DATA ·precomputed<>+0x58d0(SB)/8, $0xd1db36e12b8fe78e
-DATA ·precomputed<>+0x58d8(SB)/8, $0x37255a2dd5387bcf
-DATA ·precomputed<>+0x58e0(SB)/8, $0x44b3a3ea150ad32
-DATA ·precomputed<>+0x58e8(SB)/8, $0xc65bc2a36671ae59
-DATA ·precomputed<>+0x58f0(SB)/8, $0x41ce078e1d52384b
-DATA ·precomputed<>+0x58f8(SB)/8, $0x3115f1b19e72c300
-DATA ·precomputed<>+0x5900(SB)/8, $0x51383e614f4c9c8b
-DATA ·precomputed<>+0x5908(SB)/8, $0x78d171829182fc81
-DATA ·precomputed<>+0x5910(SB)/8, $0xbed6f0d490d72cb4
-DATA ·precomputed<>+0x5918(SB)/8, $0x987612917bea62f0
-DATA ·precomputed<>+0x5920(SB)/8, $0x27594570ef3cd3fc
-DATA ·precomputed<>+0x5928(SB)/8, $0xf475953491a8c364
-DATA ·precomputed<>+0x5930(SB)/8, $0xf5c607c52744eb2d
-DATA ·precomputed<>+0x5938(SB)/8, $0xd6264ebd8d8f337
-DATA ·precomputed<>+0x5940(SB)/8, $0x7835e4e9876f8f38
-DATA ·precomputed<>+0x5948(SB)/8, $0xcd421d77090ca6b6
-DATA ·precomputed<>+0x5950(SB)/8, $0x71a1d12dad0604f7
-DATA ·precomputed<>+0x5958(SB)/8, $0x51c2d1581a22e872
-DATA ·precomputed<>+0x5960(SB)/8, $0xfe7dfcc8429e45e9
-DATA ·precomputed<>+0x5968(SB)/8, $0x20028f5c48224b6f
-DATA ·precomputed<>+0x5970(SB)/8, $0xf7afed3750abf907
-DATA ·precomputed<>+0x5978(SB)/8, $0x92183692c4ce1a69
-DATA ·precomputed<>+0x5980(SB)/8, $0x1c1bf593c60f1799
-DATA ·precomputed<>+0x5988(SB)/8, $0x388d695064ef800f
-DATA ·precomputed<>+0x5990(SB)/8, $0xf78ef00fce927a87
-DATA ·precomputed<>+0x5998(SB)/8, $0x2a0104196abfff9f
-DATA ·precomputed<>+0x59a0(SB)/8, $0x13a7b08eb0b7ffe2
-DATA ·precomputed<>+0x59a8(SB)/8, $0x4619da3e6da4cc8f
-DATA ·precomputed<>+0x59b0(SB)/8, $0x8ac191907937e0bd
-DATA ·precomputed<>+0x59b8(SB)/8, $0xf97d3fcb1af4f84c
-DATA ·precomputed<>+0x59c0(SB)/8, $0x14a4517480eed542
-DATA ·precomputed<>+0x59c8(SB)/8, $0xadd645613362ef7f
-DATA ·precomputed<>+0x59d0(SB)/8, $0x39228bfcc5dd0396
-DATA ·precomputed<>+0x59d8(SB)/8, $0xe9fdf903ea0c538b
-DATA ·precomputed<>+0x59e0(SB)/8, $0x6bfd91ec74d235de
-DATA ·precomputed<>+0x59e8(SB)/8, $0x96ec237824aa0a47
-DATA ·precomputed<>+0x59f0(SB)/8, $0xf5699241af8d6168
-DATA ·precomputed<>+0x59f8(SB)/8, $0xa7b9be3c548a60b
-DATA ·precomputed<>+0x5a00(SB)/8, $0x746f089529f96075
-DATA ·precomputed<>+0x5a08(SB)/8, $0x4742ffa3e6c7ddc8
-DATA ·precomputed<>+0x5a10(SB)/8, $0x909403f848717ce2
-DATA ·precomputed<>+0x5a18(SB)/8, $0x31508e6a04b1f3e3
-DATA ·precomputed<>+0x5a20(SB)/8, $0x60a47dfda18f20ac
-DATA ·precomputed<>+0x5a28(SB)/8, $0x9b6155ee96d2d786
-DATA ·precomputed<>+0x5a30(SB)/8, $0xc1e23c5ceb6be06c
-DATA ·precomputed<>+0x5a38(SB)/8, $0x34488a90d68afe67
-DATA ·precomputed<>+0x5a40(SB)/8, $0xdf8105b41e3e18dd
-DATA ·precomputed<>+0x5a48(SB)/8, $0xfa61a08b301b1a36
-DATA ·precomputed<>+0x5a50(SB)/8, $0x4eba0d53508b7ef8
-DATA ·precomputed<>+0x5a58(SB)/8, $0x69e916f9eb0d1628
-DATA ·precomputed<>+0x5a60(SB)/8, $0xc9a7f0f3a67a3be8
-DATA ·precomputed<>+0x5a68(SB)/8, $0xa9a16491516a88e1
-DATA ·precomputed<>+0x5a70(SB)/8, $0x4fb87eb45ed51371
-DATA ·precomputed<>+0x5a78(SB)/8, $0xa4e9579c8bbc7eb1
-DATA ·precomputed<>+0x5a80(SB)/8, $0x9fc8534a95b67cc9
-DATA ·precomputed<>+0x5a88(SB)/8, $0x4b12d84089134030
-DATA ·precomputed<>+0x5a90(SB)/8, $0x1dc5e6ea82aa5b4b
-DATA ·precomputed<>+0x5a98(SB)/8, $0xb404fddf99f10a34
-DATA ·precomputed<>+0x5aa0(SB)/8, $0x6ee7b8e1920d298b
-DATA ·precomputed<>+0x5aa8(SB)/8, $0x361d83e68c5a325d
-DATA ·precomputed<>+0x5ab0(SB)/8, $0x1fed9864e6cdebc3
-DATA ·precomputed<>+0x5ab8(SB)/8, $0x6dabe88236f189a4
-DATA ·precomputed<>+0x5ac0(SB)/8, $0xbe672854f2b4c581
-DATA ·precomputed<>+0x5ac8(SB)/8, $0x6df8d8494a26223a
-DATA ·precomputed<>+0x5ad0(SB)/8, $0xd1debdf2e3aa188c
-DATA ·precomputed<>+0x5ad8(SB)/8, $0x80bfdc09b3d8c4eb
-DATA ·precomputed<>+0x5ae0(SB)/8, $0x943649164502ad48
-DATA ·precomputed<>+0x5ae8(SB)/8, $0xd1ce82b0ec4eec04
-DATA ·precomputed<>+0x5af0(SB)/8, $0xb7c386c272526b7a
-DATA ·precomputed<>+0x5af8(SB)/8, $0x5a6cc4f46b8deec1
-DATA ·precomputed<>+0x5b00(SB)/8, $0x3eff986fc984806a
-DATA ·precomputed<>+0x5b08(SB)/8, $0x4c574662a8c12ccf
-DATA ·precomputed<>+0x5b10(SB)/8, $0x37f33df01f0d63d7
-DATA ·precomputed<>+0x5b18(SB)/8, $0xa624b076827f324e
-DATA ·precomputed<>+0x5b20(SB)/8, $0x7f948b311fdccd20
-DATA ·precomputed<>+0x5b28(SB)/8, $0x4e8fc8c9d8b7a054
-DATA ·precomputed<>+0x5b30(SB)/8, $0xcdd74238a048836
-DATA ·precomputed<>+0x5b38(SB)/8, $0x93a4b40080bea401
-DATA ·precomputed<>+0x5b40(SB)/8, $0xb164221f9d54c91b
-DATA ·precomputed<>+0x5b48(SB)/8, $0x1b46faa169279a98
-DATA ·precomputed<>+0x5b50(SB)/8, $0x8ee3715bf2ed6900
-DATA ·precomputed<>+0x5b58(SB)/8, $0x11660dd001cee79c
-DATA ·precomputed<>+0x5b60(SB)/8, $0xc3f4a9e893219eac
-DATA ·precomputed<>+0x5b68(SB)/8, $0x88a076522ea8ecf9
-DATA ·precomputed<>+0x5b70(SB)/8, $0xf0dbd3aaefd60c47
-DATA ·precomputed<>+0x5b78(SB)/8, $0x7daf910644a68794
-DATA ·precomputed<>+0x5b80(SB)/8, $0x6f8f90b2879e0436
-DATA ·precomputed<>+0x5b88(SB)/8, $0x3a4ca56465f769a3
-DATA ·precomputed<>+0x5b90(SB)/8, $0xfd139c02bc1f4bc5
-DATA ·precomputed<>+0x5b98(SB)/8, $0xcfab943f90940295
-DATA ·precomputed<>+0x5ba0(SB)/8, $0x76f490568dba32a
-DATA ·precomputed<>+0x5ba8(SB)/8, $0x85225582366469cf
-DATA ·precomputed<>+0x5bb0(SB)/8, $0x5b2f6e0f765e69ec
-DATA ·precomputed<>+0x5bb8(SB)/8, $0x8ff175211bfd73f
-DATA ·precomputed<>+0x5bc0(SB)/8, $0xe5255c302ade9556
-DATA ·precomputed<>+0x5bc8(SB)/8, $0xe328af1b75ba2e9b
-DATA ·precomputed<>+0x5bd0(SB)/8, $0x9d3391ef41ce9e47
-DATA ·precomputed<>+0x5bd8(SB)/8, $0xb74cd668fb0ffcc9
-DATA ·precomputed<>+0x5be0(SB)/8, $0xc67103e4e3226acf
-DATA ·precomputed<>+0x5be8(SB)/8, $0xa65ad22cd2959e42
-DATA ·precomputed<>+0x5bf0(SB)/8, $0x3aaa840699d490fc
-DATA ·precomputed<>+0x5bf8(SB)/8, $0x3e26a1c29ecc6356
-DATA ·precomputed<>+0x5c00(SB)/8, $0x3fad1d6e1a1550bc
-DATA ·precomputed<>+0x5c08(SB)/8, $0xaa6ab3ecdc756bbd
-DATA ·precomputed<>+0x5c10(SB)/8, $0x96468168ec9c80ea
-DATA ·precomputed<>+0x5c18(SB)/8, $0xa36eb024822c5187
-DATA ·precomputed<>+0x5c20(SB)/8, $0x4563d9c8e082d22b
-DATA ·precomputed<>+0x5c28(SB)/8, $0x4ba87816dab9ef11
-DATA ·precomputed<>+0x5c30(SB)/8, $0xf10883c368596414
-DATA ·precomputed<>+0x5c38(SB)/8, $0xb9ca807190ae9232
-DATA ·precomputed<>+0x5c40(SB)/8, $0x7ab1b81ea5c7f9e5
-DATA ·precomputed<>+0x5c48(SB)/8, $0xf65996b24a504735
-DATA ·precomputed<>+0x5c50(SB)/8, $0x6adffef5f91afebc
-DATA ·precomputed<>+0x5c58(SB)/8, $0xea94e9b9848d635a
-DATA ·precomputed<>+0x5c60(SB)/8, $0x3f7a58e81ab0499c
-DATA ·precomputed<>+0x5c68(SB)/8, $0xae6a6cecad3bd5ad
-DATA ·precomputed<>+0x5c70(SB)/8, $0xbdf7d69d0037d52e
-DATA ·precomputed<>+0x5c78(SB)/8, $0x5426ba63c78396c5
-DATA ·precomputed<>+0x5c80(SB)/8, $0x26185ea7cbe97689
-DATA ·precomputed<>+0x5c88(SB)/8, $0xf44b654477336e87
-DATA ·precomputed<>+0x5c90(SB)/8, $0x1598e6ed4d39340e
-DATA ·precomputed<>+0x5c98(SB)/8, $0x7dc1ccd7f78676ef
-DATA ·precomputed<>+0x5ca0(SB)/8, $0xed12f542aedfe795
-DATA ·precomputed<>+0x5ca8(SB)/8, $0xaebba7c2b3bd724c
-DATA ·precomputed<>+0x5cb0(SB)/8, $0xefdc0b3e22d3c0a3
-DATA ·precomputed<>+0x5cb8(SB)/8, $0xec491746db9c5dfc
-DATA ·precomputed<>+0x5cc0(SB)/8, $0xb178c3180561c002
-DATA ·precomputed<>+0x5cc8(SB)/8, $0x8e636d7460356bad
-DATA ·precomputed<>+0x5cd0(SB)/8, $0xf8d07145e36c7856
-DATA ·precomputed<>+0x5cd8(SB)/8, $0xa77305672ae7901a
-DATA ·precomputed<>+0x5ce0(SB)/8, $0x691f5ddcb77d9db9
-DATA ·precomputed<>+0x5ce8(SB)/8, $0xf824b7f989d16e89
-DATA ·precomputed<>+0x5cf0(SB)/8, $0xafb5c9b7eb5142a2
-DATA ·precomputed<>+0x5cf8(SB)/8, $0x9b34446f6015bfa
-DATA ·precomputed<>+0x5d00(SB)/8, $0x1e3ff8dee640a909
-DATA ·precomputed<>+0x5d08(SB)/8, $0x5297dde47a6f8e32
-DATA ·precomputed<>+0x5d10(SB)/8, $0xf5edd814369b064a
-DATA ·precomputed<>+0x5d18(SB)/8, $0xebe3b26a5b06680d
-DATA ·precomputed<>+0x5d20(SB)/8, $0x25ac16f81daaddd5
-DATA ·precomputed<>+0x5d28(SB)/8, $0x14d92bee62121c01
-DATA ·precomputed<>+0x5d30(SB)/8, $0x43c95a1195460ba7
-DATA ·precomputed<>+0x5d38(SB)/8, $0xc3b8d79dcff5ad3
-DATA ·precomputed<>+0x5d40(SB)/8, $0x1294202d930caf2
-DATA ·precomputed<>+0x5d48(SB)/8, $0xfdb4b8b6bcee69de
-DATA ·precomputed<>+0x5d50(SB)/8, $0x93a5fd1ad9d133f9
-DATA ·precomputed<>+0x5d58(SB)/8, $0x8cfbcd61a5d81432
-DATA ·precomputed<>+0x5d60(SB)/8, $0x37e61ac67af1eaf9
-DATA ·precomputed<>+0x5d68(SB)/8, $0x228bf0c6133a60c6
-DATA ·precomputed<>+0x5d70(SB)/8, $0x91c850b216667fcc
-DATA ·precomputed<>+0x5d78(SB)/8, $0xa70f3a2a3e294769
-DATA ·precomputed<>+0x5d80(SB)/8, $0x7edd65f0efb02b2b
-DATA ·precomputed<>+0x5d88(SB)/8, $0xeb95e4f2a5d01c33
-DATA ·precomputed<>+0x5d90(SB)/8, $0x9a0e14689187f747
-DATA ·precomputed<>+0x5d98(SB)/8, $0x6f499a22d5d528de
-DATA ·precomputed<>+0x5da0(SB)/8, $0xbccc823ae343e5e7
-DATA ·precomputed<>+0x5da8(SB)/8, $0x77e1604ecf08cc73
-DATA ·precomputed<>+0x5db0(SB)/8, $0x91b02f1addbed3e4
-DATA ·precomputed<>+0x5db8(SB)/8, $0xcadb72048d50fa06
-DATA ·precomputed<>+0x5dc0(SB)/8, $0x349b0be45824c88a
-DATA ·precomputed<>+0x5dc8(SB)/8, $0xe3a35e814847b35b
-DATA ·precomputed<>+0x5dd0(SB)/8, $0x1594fd284027aa0
-DATA ·precomputed<>+0x5dd8(SB)/8, $0xaaff184aee873823
-DATA ·precomputed<>+0x5de0(SB)/8, $0xd5fce135810fa474
-DATA ·precomputed<>+0x5de8(SB)/8, $0xaba6165a8f7398d7
-DATA ·precomputed<>+0x5df0(SB)/8, $0xe3aaa99a8a96a1f3
-DATA ·precomputed<>+0x5df8(SB)/8, $0x6ff0fd3b6295924
-DATA ·precomputed<>+0x5e00(SB)/8, $0x60ba39f1d17f40a7
-DATA ·precomputed<>+0x5e08(SB)/8, $0x11a0b39e017a6a56
-DATA ·precomputed<>+0x5e10(SB)/8, $0x6ce0df78acb38460
-DATA ·precomputed<>+0x5e18(SB)/8, $0x6f625219336bd300
-DATA ·precomputed<>+0x5e20(SB)/8, $0x8656bab9cb98ba64
-DATA ·precomputed<>+0x5e28(SB)/8, $0xf15391d0e9fbc157
-DATA ·precomputed<>+0x5e30(SB)/8, $0x6339778321145b87
-DATA ·precomputed<>+0x5e38(SB)/8, $0x9b5287940dc9399f
-DATA ·precomputed<>+0x5e40(SB)/8, $0xded7eec8eb1440d8
-DATA ·precomputed<>+0x5e48(SB)/8, $0x6288a760c19f756b
-DATA ·precomputed<>+0x5e50(SB)/8, $0xa40c86af92f224bc
-DATA ·precomputed<>+0x5e58(SB)/8, $0xd520aec2ded19dfc
-DATA ·precomputed<>+0x5e60(SB)/8, $0x57d2c26af4390b94
-DATA ·precomputed<>+0x5e68(SB)/8, $0xc598d339c4893275
-DATA ·precomputed<>+0x5e70(SB)/8, $0x16b27684976989f3
-DATA ·precomputed<>+0x5e78(SB)/8, $0x9508bab7fd67aee0
-DATA ·precomputed<>+0x5e80(SB)/8, $0x1227c7a0e8b0377b
-DATA ·precomputed<>+0x5e88(SB)/8, $0x40c1e04642a958a2
-DATA ·precomputed<>+0x5e90(SB)/8, $0x31081b5c86c15ee2
-DATA ·precomputed<>+0x5e98(SB)/8, $0xb438fe1590c7eb48
-DATA ·precomputed<>+0x5ea0(SB)/8, $0xa9621b72e1215431
-DATA ·precomputed<>+0x5ea8(SB)/8, $0x6250ea468bdf513c
-DATA ·precomputed<>+0x5eb0(SB)/8, $0x75726d057b5fbfa6
-DATA ·precomputed<>+0x5eb8(SB)/8, $0xcfc478cfebf001c7
-DATA ·precomputed<>+0x5ec0(SB)/8, $0xe26b8b7dfddda1bc
-DATA ·precomputed<>+0x5ec8(SB)/8, $0xc93512b9b5f713ec
-DATA ·precomputed<>+0x5ed0(SB)/8, $0x29ffeb33b12edcb
-DATA ·precomputed<>+0x5ed8(SB)/8, $0x2ea0649903564095
-DATA ·precomputed<>+0x5ee0(SB)/8, $0xae37b5a0fcd32167
-DATA ·precomputed<>+0x5ee8(SB)/8, $0x200841693c2eb30c
-DATA ·precomputed<>+0x5ef0(SB)/8, $0xb2663d607c6cd018
-DATA ·precomputed<>+0x5ef8(SB)/8, $0xd810c96235b2b502
-DATA ·precomputed<>+0x5f00(SB)/8, $0x42c593752eaca3ea
-DATA ·precomputed<>+0x5f08(SB)/8, $0x995b9b40e2e0f088
-DATA ·precomputed<>+0x5f10(SB)/8, $0xb2a53f9e74fd844c
-DATA ·precomputed<>+0x5f18(SB)/8, $0xaf3131424705d584
-DATA ·precomputed<>+0x5f20(SB)/8, $0x55f684f227a556c6
-DATA ·precomputed<>+0x5f28(SB)/8, $0x88d98e90b92b2e9
-DATA ·precomputed<>+0x5f30(SB)/8, $0xce90aa3966b5edc1
-DATA ·precomputed<>+0x5f38(SB)/8, $0x5128c5f6c78e51bc
-DATA ·precomputed<>+0x5f40(SB)/8, $0x75ec11485568add4
-DATA ·precomputed<>+0x5f48(SB)/8, $0x967516b7c593f342
-DATA ·precomputed<>+0x5f50(SB)/8, $0x1bfb12a233d21f4d
-DATA ·precomputed<>+0x5f58(SB)/8, $0xf03638d7a3e9462d
-DATA ·precomputed<>+0x5f60(SB)/8, $0xd0a5cd0e1ffe22aa
-DATA ·precomputed<>+0x5f68(SB)/8, $0x270b00780979b1ef
-DATA ·precomputed<>+0x5f70(SB)/8, $0x682c6e2a054a98d5
-DATA ·precomputed<>+0x5f78(SB)/8, $0xe06cac2b72dbd924
-DATA ·precomputed<>+0x5f80(SB)/8, $0xbf8fb67b5d46f42b
-DATA ·precomputed<>+0x5f88(SB)/8, $0x343a329c3c8e144b
-DATA ·precomputed<>+0x5f90(SB)/8, $0x85e066835cd2935a
-DATA ·precomputed<>+0x5f98(SB)/8, $0xa30471be154377be
-DATA ·precomputed<>+0x5fa0(SB)/8, $0xe403edf289bec17c
-DATA ·precomputed<>+0x5fa8(SB)/8, $0x83344b0e313a9df4
-DATA ·precomputed<>+0x5fb0(SB)/8, $0x627f92396797c41b
-DATA ·precomputed<>+0x5fb8(SB)/8, $0xfcd22958e7102e42
-DATA ·precomputed<>+0x5fc0(SB)/8, $0x71c975de4e92defc
-DATA ·precomputed<>+0x5fc8(SB)/8, $0x81aeb173d0089883
-DATA ·precomputed<>+0x5fd0(SB)/8, $0x8a30ce4a2ec09190
-DATA ·precomputed<>+0x5fd8(SB)/8, $0x426e783869a36d64
-DATA ·precomputed<>+0x5fe0(SB)/8, $0x5899a0b6309bd2d7
-DATA ·precomputed<>+0x5fe8(SB)/8, $0x3b1c24af3cc1a4af
-DATA ·precomputed<>+0x5ff0(SB)/8, $0xb2aa8142345163b3
-DATA ·precomputed<>+0x5ff8(SB)/8, $0xd2ad9a692c78c86d
-DATA ·precomputed<>+0x6000(SB)/8, $0xf67233c6ba4e4651
-DATA ·precomputed<>+0x6008(SB)/8, $0x8cf956600714d4ac
-DATA ·precomputed<>+0x6010(SB)/8, $0xd70decc371f1f8da
-DATA ·precomputed<>+0x6018(SB)/8, $0xb674732a7078370e
-DATA ·precomputed<>+0x6020(SB)/8, $0x4270416d4ccc773b
-DATA ·precomputed<>+0x6028(SB)/8, $0xc848ff35de87951e
-DATA ·precomputed<>+0x6030(SB)/8, $0xa61506a8493dfb56
-DATA ·precomputed<>+0x6038(SB)/8, $0xd8371ea9767eb110
-DATA ·precomputed<>+0x6040(SB)/8, $0xc066ee2fda3adfe0
-DATA ·precomputed<>+0x6048(SB)/8, $0xce6a9bdc0c964e7d
-DATA ·precomputed<>+0x6050(SB)/8, $0x4a0115b0c859476
-DATA ·precomputed<>+0x6058(SB)/8, $0xb5e02dc99c95699c
-DATA ·precomputed<>+0x6060(SB)/8, $0xf8301f6211377eb9
-DATA ·precomputed<>+0x6068(SB)/8, $0x57b245a2172bca2e
-DATA ·precomputed<>+0x6070(SB)/8, $0xa7d9b4707b47cf1f
-DATA ·precomputed<>+0x6078(SB)/8, $0x1b469bab1774b1c1
-DATA ·precomputed<>+0x6080(SB)/8, $0xcdfcfa67dfd6586e
-DATA ·precomputed<>+0x6088(SB)/8, $0x358953e51ba23faa
-DATA ·precomputed<>+0x6090(SB)/8, $0xf467275aeec5d6f
-DATA ·precomputed<>+0x6098(SB)/8, $0xb815967a5b0e6b2a
-DATA ·precomputed<>+0x60a0(SB)/8, $0xb01bf133012b89b4
-DATA ·precomputed<>+0x60a8(SB)/8, $0xdd924bbc6839cc04
-DATA ·precomputed<>+0x60b0(SB)/8, $0xa5cd2180120dfd73
-DATA ·precomputed<>+0x60b8(SB)/8, $0x1abb11ef19bf8098
-DATA ·precomputed<>+0x60c0(SB)/8, $0x3977889f1ef2232
-DATA ·precomputed<>+0x60c8(SB)/8, $0xbe2c82f19c7409a5
-DATA ·precomputed<>+0x60d0(SB)/8, $0x35ac44f932004e03
-DATA ·precomputed<>+0x60d8(SB)/8, $0x48bb359856823a3
-DATA ·precomputed<>+0x60e0(SB)/8, $0x2e108d6cec1cf253
-DATA ·precomputed<>+0x60e8(SB)/8, $0xe98e74d7703eb1d2
-DATA ·precomputed<>+0x60f0(SB)/8, $0xcaf64f60570ac34d
-DATA ·precomputed<>+0x60f8(SB)/8, $0xff814e7d4d7797fa
-DATA ·precomputed<>+0x6100(SB)/8, $0x5deeb2eb0d1b96aa
-DATA ·precomputed<>+0x6108(SB)/8, $0x25783ce33e52ccf1
-DATA ·precomputed<>+0x6110(SB)/8, $0xe4e251f429d534ef
-DATA ·precomputed<>+0x6118(SB)/8, $0x9fe9693d55797dad
-DATA ·precomputed<>+0x6120(SB)/8, $0x6a173d69c6ab9935
-DATA ·precomputed<>+0x6128(SB)/8, $0x239913187721ca8e
-DATA ·precomputed<>+0x6130(SB)/8, $0x38cbcd30c393eb26
-DATA ·precomputed<>+0x6138(SB)/8, $0xe3627ab971d95a9e
-DATA ·precomputed<>+0x6140(SB)/8, $0xe9b60e575ef764b4
-DATA ·precomputed<>+0x6148(SB)/8, $0x61ad29fcbe73c698
-DATA ·precomputed<>+0x6150(SB)/8, $0x18489017dd2be1ee
-DATA ·precomputed<>+0x6158(SB)/8, $0xac95e3b67febda71
-DATA ·precomputed<>+0x6160(SB)/8, $0xa69853465ac96092
-DATA ·precomputed<>+0x6168(SB)/8, $0x906265c3bfc83566
-DATA ·precomputed<>+0x6170(SB)/8, $0x1692c8125972fa4a
-DATA ·precomputed<>+0x6178(SB)/8, $0x4e76f87900773540
-DATA ·precomputed<>+0x6180(SB)/8, $0x26a45c3ea7b82f5c
-DATA ·precomputed<>+0x6188(SB)/8, $0x494694deea98adfb
-DATA ·precomputed<>+0x6190(SB)/8, $0x44a06ec3134b182c
-DATA ·precomputed<>+0x6198(SB)/8, $0x5570befa75b82b41
-DATA ·precomputed<>+0x61a0(SB)/8, $0x819f6808129f952e
-DATA ·precomputed<>+0x61a8(SB)/8, $0xa6fad25f914f87c4
-DATA ·precomputed<>+0x61b0(SB)/8, $0x831e56687c7be905
-DATA ·precomputed<>+0x61b8(SB)/8, $0x93107f38e623a5c2
-DATA ·precomputed<>+0x61c0(SB)/8, $0xf7cd1bcc89e35108
-DATA ·precomputed<>+0x61c8(SB)/8, $0x924efa4393f1cbaf
-DATA ·precomputed<>+0x61d0(SB)/8, $0xe3716559f35b13ac
-DATA ·precomputed<>+0x61d8(SB)/8, $0xa0a88e8760370a1d
-DATA ·precomputed<>+0x61e0(SB)/8, $0x1203be0a8c286ea3
-DATA ·precomputed<>+0x61e8(SB)/8, $0x97fc5ab66ebd50c7
-DATA ·precomputed<>+0x61f0(SB)/8, $0x2b5b360274284d08
-DATA ·precomputed<>+0x61f8(SB)/8, $0x3055716f694a20e0
-DATA ·precomputed<>+0x6200(SB)/8, $0xf48703adcdee6f8d
-DATA ·precomputed<>+0x6208(SB)/8, $0xf1ba030912d39694
-DATA ·precomputed<>+0x6210(SB)/8, $0xeb2d4d929fcda52c
-DATA ·precomputed<>+0x6218(SB)/8, $0x984f51115507b401
-DATA ·precomputed<>+0x6220(SB)/8, $0xe3aa26aef6bab9ec
-DATA ·precomputed<>+0x6228(SB)/8, $0x557b5e3f6b2e077d
-DATA ·precomputed<>+0x6230(SB)/8, $0x7185ab4f2f9c5f35
-DATA ·precomputed<>+0x6238(SB)/8, $0x96f21a331680bcbc
-DATA ·precomputed<>+0x6240(SB)/8, $0x47b9368b393ab61c
-DATA ·precomputed<>+0x6248(SB)/8, $0xdb8ee3a827441f92
-DATA ·precomputed<>+0x6250(SB)/8, $0x214a6a5ffb0b0117
-DATA ·precomputed<>+0x6258(SB)/8, $0x429e61ad43759430
-DATA ·precomputed<>+0x6260(SB)/8, $0x78116e8877316c0e
-DATA ·precomputed<>+0x6268(SB)/8, $0x59c82616a6a29e98
-DATA ·precomputed<>+0x6270(SB)/8, $0xbfed454faaef4619
-DATA ·precomputed<>+0x6278(SB)/8, $0x673327c435926335
-DATA ·precomputed<>+0x6280(SB)/8, $0x94237ba25d39731d
-DATA ·precomputed<>+0x6288(SB)/8, $0x6359d1958532a968
-DATA ·precomputed<>+0x6290(SB)/8, $0x8bca94c9f86b8b75
-DATA ·precomputed<>+0x6298(SB)/8, $0xdb5c6a9cde316226
-DATA ·precomputed<>+0x62a0(SB)/8, $0xdf300c59a2fa0c26
-DATA ·precomputed<>+0x62a8(SB)/8, $0x6dbf608248af4357
-DATA ·precomputed<>+0x62b0(SB)/8, $0x25066c6c06535fc9
-DATA ·precomputed<>+0x62b8(SB)/8, $0xba2e774ea29b2477
-DATA ·precomputed<>+0x62c0(SB)/8, $0x8c1725ed142596dc
-DATA ·precomputed<>+0x62c8(SB)/8, $0xd321d49ab2d413a6
-DATA ·precomputed<>+0x62d0(SB)/8, $0x19c25fc32b5e8ae7
-DATA ·precomputed<>+0x62d8(SB)/8, $0xfc32cbebbd3c7dc6
-DATA ·precomputed<>+0x62e0(SB)/8, $0xf3ec98b857b98ff5
-DATA ·precomputed<>+0x62e8(SB)/8, $0x52e5f1adf75b1a00
-DATA ·precomputed<>+0x62f0(SB)/8, $0x16812bb48f8ad463
-DATA ·precomputed<>+0x62f8(SB)/8, $0x9d67cb11a274f0c3
-DATA ·precomputed<>+0x6300(SB)/8, $0x2c1a229ee5aaa980
-DATA ·precomputed<>+0x6308(SB)/8, $0x446cd46bd29eb83e
-DATA ·precomputed<>+0x6310(SB)/8, $0xe0c044da7f5aa015
-DATA ·precomputed<>+0x6318(SB)/8, $0xa55d5f23a18f23f7
-DATA ·precomputed<>+0x6320(SB)/8, $0xd71e58c1b6b70f51
-DATA ·precomputed<>+0x6328(SB)/8, $0x77c72d10b5862c51
-DATA ·precomputed<>+0x6330(SB)/8, $0x1db731afce94ceb
-DATA ·precomputed<>+0x6338(SB)/8, $0x877703a813a47553
-DATA ·precomputed<>+0x6340(SB)/8, $0xbeaf1a1696671c53
-DATA ·precomputed<>+0x6348(SB)/8, $0x9be643296bc4cbbf
-DATA ·precomputed<>+0x6350(SB)/8, $0xc8f66f6380017bf3
-DATA ·precomputed<>+0x6358(SB)/8, $0x92d700f28836ff35
-DATA ·precomputed<>+0x6360(SB)/8, $0x9ddd7a8113a4daf1
-DATA ·precomputed<>+0x6368(SB)/8, $0xb3c427239b72664b
-DATA ·precomputed<>+0x6370(SB)/8, $0x3d96f79a81babf43
-DATA ·precomputed<>+0x6378(SB)/8, $0xa46c9c0c7ce5830d
-DATA ·precomputed<>+0x6380(SB)/8, $0xe9de4106968ddb9
-DATA ·precomputed<>+0x6388(SB)/8, $0x10f0f42912985b32
-DATA ·precomputed<>+0x6390(SB)/8, $0xbe21b10f8ca7d2fa
-DATA ·precomputed<>+0x6398(SB)/8, $0x844d8e8c610ae2b
-DATA ·precomputed<>+0x63a0(SB)/8, $0x58a08694d57107d5
-DATA ·precomputed<>+0x63a8(SB)/8, $0x45f44bd5c34441f3
-DATA ·precomputed<>+0x63b0(SB)/8, $0xe8b3b3df79a54186
-DATA ·precomputed<>+0x63b8(SB)/8, $0x6496d668b8b5f26b
-DATA ·precomputed<>+0x63c0(SB)/8, $0x7d67a63d5b99708d
-DATA ·precomputed<>+0x63c8(SB)/8, $0xfb29bef74b80189a
-DATA ·precomputed<>+0x63d0(SB)/8, $0x3cb7eeaa241c402e
-DATA ·precomputed<>+0x63d8(SB)/8, $0x328cb6de2c5c2767
-DATA ·precomputed<>+0x63e0(SB)/8, $0xd24a7b49cec231d
-DATA ·precomputed<>+0x63e8(SB)/8, $0x725955fc0e2e6a7f
-DATA ·precomputed<>+0x63f0(SB)/8, $0xa2040cfab7f17b13
-DATA ·precomputed<>+0x63f8(SB)/8, $0x215eff8da25c71cf
-DATA ·precomputed<>+0x6400(SB)/8, $0xd8bc00766701f419
-DATA ·precomputed<>+0x6408(SB)/8, $0x977b70cebacb641f
-DATA ·precomputed<>+0x6410(SB)/8, $0xb5f871d15a04deca
-DATA ·precomputed<>+0x6418(SB)/8, $0xcd4429fa0e52efd8
-DATA ·precomputed<>+0x6420(SB)/8, $0xe25983192132ac4f
-DATA ·precomputed<>+0x6428(SB)/8, $0xc2158a979f8e27c0
-DATA ·precomputed<>+0x6430(SB)/8, $0x3257c080ffd76b1f
-DATA ·precomputed<>+0x6438(SB)/8, $0xc54cd7fb95321113
-DATA ·precomputed<>+0x6440(SB)/8, $0xe34ac46bfb716e6f
-DATA ·precomputed<>+0x6448(SB)/8, $0x10adc4ee3ed5c957
-DATA ·precomputed<>+0x6450(SB)/8, $0x195923f7c97971ee
-DATA ·precomputed<>+0x6458(SB)/8, $0x81cc3bf3e76f529c
-DATA ·precomputed<>+0x6460(SB)/8, $0x552f85e6d167826b
-DATA ·precomputed<>+0x6468(SB)/8, $0xef7c40e96fb68dac
-DATA ·precomputed<>+0x6470(SB)/8, $0xeac51e0a0f00b6f4
-DATA ·precomputed<>+0x6478(SB)/8, $0x2f878db6a919663d
-DATA ·precomputed<>+0x6480(SB)/8, $0xe048708b1faa359f
-DATA ·precomputed<>+0x6488(SB)/8, $0x30fce9d417e02364
-DATA ·precomputed<>+0x6490(SB)/8, $0xd308f2905938873a
-DATA ·precomputed<>+0x6498(SB)/8, $0x626431bcd84ea76a
-DATA ·precomputed<>+0x64a0(SB)/8, $0xbb140967a69fcb3a
-DATA ·precomputed<>+0x64a8(SB)/8, $0x53c5b12df746cf37
-DATA ·precomputed<>+0x64b0(SB)/8, $0xcfb9cb0540ff8d8a
-DATA ·precomputed<>+0x64b8(SB)/8, $0xa21776bb9e8d5be6
-DATA ·precomputed<>+0x64c0(SB)/8, $0xeecd8a8e5613c351
-DATA ·precomputed<>+0x64c8(SB)/8, $0x7605b483503703d1
-DATA ·precomputed<>+0x64d0(SB)/8, $0x40e72b4d9c4094b4
-DATA ·precomputed<>+0x64d8(SB)/8, $0xa68e64ab940edb9c
-DATA ·precomputed<>+0x64e0(SB)/8, $0x8ea64c934c6f55ff
-DATA ·precomputed<>+0x64e8(SB)/8, $0x9047eece8f00b0b3
-DATA ·precomputed<>+0x64f0(SB)/8, $0xbf89a3ab17bcab9c
-DATA ·precomputed<>+0x64f8(SB)/8, $0xafb2600d38eb3b10
-DATA ·precomputed<>+0x6500(SB)/8, $0xc083c063377eda3e
-DATA ·precomputed<>+0x6508(SB)/8, $0xc36c3f3c066967c9
-DATA ·precomputed<>+0x6510(SB)/8, $0xa98e09c0ccd6dc24
-DATA ·precomputed<>+0x6518(SB)/8, $0x6095f033a109b560
-DATA ·precomputed<>+0x6520(SB)/8, $0xcef5f43e34334081
-DATA ·precomputed<>+0x6528(SB)/8, $0x1bf7fb37eca70f2f
-DATA ·precomputed<>+0x6530(SB)/8, $0xd33e441493a8f553
-DATA ·precomputed<>+0x6538(SB)/8, $0x3adc5dae51f0b12
-DATA ·precomputed<>+0x6540(SB)/8, $0xe3a734e235afae1f
-DATA ·precomputed<>+0x6548(SB)/8, $0xf74f0cb348544b26
-DATA ·precomputed<>+0x6550(SB)/8, $0xfcb9b8fded581cc2
-DATA ·precomputed<>+0x6558(SB)/8, $0x9618e51e885851ec
-DATA ·precomputed<>+0x6560(SB)/8, $0xa8d92c84deb3cba9
-DATA ·precomputed<>+0x6568(SB)/8, $0x6c844b5584954f15
-DATA ·precomputed<>+0x6570(SB)/8, $0x979892d11a860d32
-DATA ·precomputed<>+0x6578(SB)/8, $0xaf69b4eb06ce36d
-DATA ·precomputed<>+0x6580(SB)/8, $0x6a16db7643be5de4
-DATA ·precomputed<>+0x6588(SB)/8, $0xff43f7cc095de3d1
-DATA ·precomputed<>+0x6590(SB)/8, $0x1ed3e4fbe113445f
-DATA ·precomputed<>+0x6598(SB)/8, $0xd9e760390f6110
-DATA ·precomputed<>+0x65a0(SB)/8, $0xbe2eff47b3121400
-DATA ·precomputed<>+0x65a8(SB)/8, $0x26a49d26d565b589
-DATA ·precomputed<>+0x65b0(SB)/8, $0x8dd5581f272347a5
-DATA ·precomputed<>+0x65b8(SB)/8, $0x78cff3be72033a15
-DATA ·precomputed<>+0x65c0(SB)/8, $0x2912176326d2b704
-DATA ·precomputed<>+0x65c8(SB)/8, $0x90985c21dafaabb9
-DATA ·precomputed<>+0x65d0(SB)/8, $0x7a109419a549f1b
-DATA ·precomputed<>+0x65d8(SB)/8, $0xbfe6175b5b920bef
-DATA ·precomputed<>+0x65e0(SB)/8, $0xdc228e114005f56f
-DATA ·precomputed<>+0x65e8(SB)/8, $0x24a97a4cc031f91f
-DATA ·precomputed<>+0x65f0(SB)/8, $0x2b23e3d1f745dc19
-DATA ·precomputed<>+0x65f8(SB)/8, $0x256e1495b4128814
-DATA ·precomputed<>+0x6600(SB)/8, $0xb1e92aa79aabbb34
-DATA ·precomputed<>+0x6608(SB)/8, $0x3fd684e29c6847ac
-DATA ·precomputed<>+0x6610(SB)/8, $0xdef8d81506dee3f6
-DATA ·precomputed<>+0x6618(SB)/8, $0x52140e8a3039344b
-DATA ·precomputed<>+0x6620(SB)/8, $0x6467e5a9de3becfa
-DATA ·precomputed<>+0x6628(SB)/8, $0x74012f5a8f8dd3ba
-DATA ·precomputed<>+0x6630(SB)/8, $0x85cfbfe2ebb6d5bb
-DATA ·precomputed<>+0x6638(SB)/8, $0x651e7fdb0fd0a71a
-DATA ·precomputed<>+0x6640(SB)/8, $0x769928aa6c9c7e38
-DATA ·precomputed<>+0x6648(SB)/8, $0xe835bbe739182c66
-DATA ·precomputed<>+0x6650(SB)/8, $0xba9b3ad67ae8be03
-DATA ·precomputed<>+0x6658(SB)/8, $0x88250d1ca6e6133a
-DATA ·precomputed<>+0x6660(SB)/8, $0x9ad9dc1da1a68278
-DATA ·precomputed<>+0x6668(SB)/8, $0x1a395e4da70a8bb1
-DATA ·precomputed<>+0x6670(SB)/8, $0x39edc6df3fc1d802
-DATA ·precomputed<>+0x6678(SB)/8, $0x8e810f31acd738f0
-DATA ·precomputed<>+0x6680(SB)/8, $0xd1a33ca565c81ea9
-DATA ·precomputed<>+0x6688(SB)/8, $0x9c86ab6c7df9025a
-DATA ·precomputed<>+0x6690(SB)/8, $0xcb0995173cf7fa59
-DATA ·precomputed<>+0x6698(SB)/8, $0x90abda99673cfa4a
-DATA ·precomputed<>+0x66a0(SB)/8, $0x86d1200daf5bf233
-DATA ·precomputed<>+0x66a8(SB)/8, $0x4830879ef4619de7
-DATA ·precomputed<>+0x66b0(SB)/8, $0x1313f3f2bd6e3dd7
-DATA ·precomputed<>+0x66b8(SB)/8, $0x57d077b0461d1c71
-DATA ·precomputed<>+0x66c0(SB)/8, $0x9c146e8732a6a70b
-DATA ·precomputed<>+0x66c8(SB)/8, $0x130f7541b2196f4
-DATA ·precomputed<>+0x66d0(SB)/8, $0xf4d2dbbfd3171d63
-DATA ·precomputed<>+0x66d8(SB)/8, $0xaa4fc9badb142656
-DATA ·precomputed<>+0x66e0(SB)/8, $0x98d1e92071f72a3
-DATA ·precomputed<>+0x66e8(SB)/8, $0xf865e9bbb9a2962c
-DATA ·precomputed<>+0x66f0(SB)/8, $0x44272428c47840a7
-DATA ·precomputed<>+0x66f8(SB)/8, $0x8d2077208e1f2f12
-DATA ·precomputed<>+0x6700(SB)/8, $0xe0f4ad3a13484fa7
-DATA ·precomputed<>+0x6708(SB)/8, $0x97d149ebddf27ccf
-DATA ·precomputed<>+0x6710(SB)/8, $0x4b2d643023de3873
-DATA ·precomputed<>+0x6718(SB)/8, $0xe468f06c8bc26316
-DATA ·precomputed<>+0x6720(SB)/8, $0x73e14b56deda6401
-DATA ·precomputed<>+0x6728(SB)/8, $0xcf32037dbd4f824d
-DATA ·precomputed<>+0x6730(SB)/8, $0x5d47caf9dcf2c4d9
-DATA ·precomputed<>+0x6738(SB)/8, $0x59fa06cc958d7f55
-DATA ·precomputed<>+0x6740(SB)/8, $0xfc6ba9b8d8cb634a
-DATA ·precomputed<>+0x6748(SB)/8, $0xa410fe582516a89d
-DATA ·precomputed<>+0x6750(SB)/8, $0xcf82171ce99b7e19
-DATA ·precomputed<>+0x6758(SB)/8, $0xdcb253229deb1f0a
-DATA ·precomputed<>+0x6760(SB)/8, $0xd413d41c007dbd1
-DATA ·precomputed<>+0x6768(SB)/8, $0x7dc367051ac2f4a2
-DATA ·precomputed<>+0x6770(SB)/8, $0xb28aff53a8681ee6
-DATA ·precomputed<>+0x6778(SB)/8, $0x641cc4e305d2fe49
-DATA ·precomputed<>+0x6780(SB)/8, $0x315de3e0662dae8a
-DATA ·precomputed<>+0x6788(SB)/8, $0x83543f25d9e373c4
-DATA ·precomputed<>+0x6790(SB)/8, $0x31faf768e5a084a5
-DATA ·precomputed<>+0x6798(SB)/8, $0x3c515743d23864f9
-DATA ·precomputed<>+0x67a0(SB)/8, $0xe6ed03f870e115ef
-DATA ·precomputed<>+0x67a8(SB)/8, $0xe4f32aab31cb5a33
-DATA ·precomputed<>+0x67b0(SB)/8, $0xfa3ef8951e6e8313
-DATA ·precomputed<>+0x67b8(SB)/8, $0xd01374f19f7e58ee
-DATA ·precomputed<>+0x67c0(SB)/8, $0xe4d9cab1c0d41a94
-DATA ·precomputed<>+0x67c8(SB)/8, $0xc38b202a9e60f7d4
-DATA ·precomputed<>+0x67d0(SB)/8, $0x2bbf6b179336116c
-DATA ·precomputed<>+0x67d8(SB)/8, $0x2f9aa8772e068f13
-DATA ·precomputed<>+0x67e0(SB)/8, $0xf8820627a4bac9fd
-DATA ·precomputed<>+0x67e8(SB)/8, $0x2209cb9e8a593cb4
-DATA ·precomputed<>+0x67f0(SB)/8, $0xaa78ec63c7417931
-DATA ·precomputed<>+0x67f8(SB)/8, $0x42d212517cfccfbf
-DATA ·precomputed<>+0x6800(SB)/8, $0x40cee5ae3e611940
-DATA ·precomputed<>+0x6808(SB)/8, $0x4e2d9ea90aa090ec
-DATA ·precomputed<>+0x6810(SB)/8, $0x73d167ef1b926e42
-DATA ·precomputed<>+0x6818(SB)/8, $0x7fff36df5d5112a3
-DATA ·precomputed<>+0x6820(SB)/8, $0x25587745caffa3fb
-DATA ·precomputed<>+0x6828(SB)/8, $0x224f7f4ec5a83504
-DATA ·precomputed<>+0x6830(SB)/8, $0x5ceff0183b47bf2a
-DATA ·precomputed<>+0x6838(SB)/8, $0xed9bfa73ecfab5c6
-DATA ·precomputed<>+0x6840(SB)/8, $0xc7d59054f831d0b
-DATA ·precomputed<>+0x6848(SB)/8, $0xfaaaa26c2d47d4fe
-DATA ·precomputed<>+0x6850(SB)/8, $0x5ac2859985042e12
-DATA ·precomputed<>+0x6858(SB)/8, $0x7eda370b7796977d
-DATA ·precomputed<>+0x6860(SB)/8, $0x9f0bd71d95c0be63
-DATA ·precomputed<>+0x6868(SB)/8, $0x7c4601bc8e821005
-DATA ·precomputed<>+0x6870(SB)/8, $0xf1ecbc604c2ffae9
-DATA ·precomputed<>+0x6878(SB)/8, $0x7e3efc579b688173
-DATA ·precomputed<>+0x6880(SB)/8, $0x9d237c2d1ed8fed0
-DATA ·precomputed<>+0x6888(SB)/8, $0x69b4ec804e0c6f10
-DATA ·precomputed<>+0x6890(SB)/8, $0x11f83283e4648395
-DATA ·precomputed<>+0x6898(SB)/8, $0x6f4636a7306e152a
-DATA ·precomputed<>+0x68a0(SB)/8, $0xf70fd23a804539b3
-DATA ·precomputed<>+0x68a8(SB)/8, $0x4db0903ab3cdb981
-DATA ·precomputed<>+0x68b0(SB)/8, $0xe506ae6f6691eb18
-DATA ·precomputed<>+0x68b8(SB)/8, $0xaa69c7aa1d8e9d9d
-DATA ·precomputed<>+0x68c0(SB)/8, $0x8348ca15587feffa
-DATA ·precomputed<>+0x68c8(SB)/8, $0x585d07407d69e4ad
-DATA ·precomputed<>+0x68d0(SB)/8, $0x6fbe5619885a0745
-DATA ·precomputed<>+0x68d8(SB)/8, $0x4ee9ebab10b24dd
-DATA ·precomputed<>+0x68e0(SB)/8, $0x5c27075c0f4c12d7
-DATA ·precomputed<>+0x68e8(SB)/8, $0xacf4acdc3c51c605
-DATA ·precomputed<>+0x68f0(SB)/8, $0x782fa52bfce336d0
-DATA ·precomputed<>+0x68f8(SB)/8, $0x6e1d078f483621d2
-DATA ·precomputed<>+0x6900(SB)/8, $0x958da4703e8d5eef
-DATA ·precomputed<>+0x6908(SB)/8, $0x9561898d3c80414
-DATA ·precomputed<>+0x6910(SB)/8, $0xba6b731c8bb74691
-DATA ·precomputed<>+0x6918(SB)/8, $0x6b7355cd577f2ef9
-DATA ·precomputed<>+0x6920(SB)/8, $0xd1f416edb8a98efa
-DATA ·precomputed<>+0x6928(SB)/8, $0xd4a1293a11590271
-DATA ·precomputed<>+0x6930(SB)/8, $0x2c4d59344658e9eb
-DATA ·precomputed<>+0x6938(SB)/8, $0x51803651d1f15d39
-DATA ·precomputed<>+0x6940(SB)/8, $0xc27d18472ea265d2
-DATA ·precomputed<>+0x6948(SB)/8, $0xec0789c62862781e
-DATA ·precomputed<>+0x6950(SB)/8, $0xa79ac1f5d86a60e
-DATA ·precomputed<>+0x6958(SB)/8, $0xe325b563130670a5
-DATA ·precomputed<>+0x6960(SB)/8, $0xf47944606d33bfee
-DATA ·precomputed<>+0x6968(SB)/8, $0x126e703eec25bb10
-DATA ·precomputed<>+0x6970(SB)/8, $0xeae22fd3a7bf902f
-DATA ·precomputed<>+0x6978(SB)/8, $0x8b2fb28228eef62e
-DATA ·precomputed<>+0x6980(SB)/8, $0x58c96a919b2223dd
-DATA ·precomputed<>+0x6988(SB)/8, $0x912fc79551461b94
-DATA ·precomputed<>+0x6990(SB)/8, $0xc18ced632df3329a
-DATA ·precomputed<>+0x6998(SB)/8, $0x79d6f75f88a002d0
-DATA ·precomputed<>+0x69a0(SB)/8, $0x73d7a089f44d3d84
-DATA ·precomputed<>+0x69a8(SB)/8, $0x98c78aa78c058073
-DATA ·precomputed<>+0x69b0(SB)/8, $0xab8b3c7333ae8ff
-DATA ·precomputed<>+0x69b8(SB)/8, $0xf5a8f5ecebd4e536
-DATA ·precomputed<>+0x69c0(SB)/8, $0x74387a1bb2b806a8
-DATA ·precomputed<>+0x69c8(SB)/8, $0x14efa300bad5d0f4
-DATA ·precomputed<>+0x69d0(SB)/8, $0xee7e442123a0e9e4
-DATA ·precomputed<>+0x69d8(SB)/8, $0x504ae4283b6abdec
-DATA ·precomputed<>+0x69e0(SB)/8, $0xb8c06fcb927b1aac
-DATA ·precomputed<>+0x69e8(SB)/8, $0x55e1c85c323b71d3
-DATA ·precomputed<>+0x69f0(SB)/8, $0xf47e180f48d6dae1
-DATA ·precomputed<>+0x69f8(SB)/8, $0x6d80dd63a84cb0b8
-DATA ·precomputed<>+0x6a00(SB)/8, $0x756f0f543ee4f7cd
-DATA ·precomputed<>+0x6a08(SB)/8, $0x4337ac7b26e4c865
-DATA ·precomputed<>+0x6a10(SB)/8, $0xf94596c335b9c3ae
-DATA ·precomputed<>+0x6a18(SB)/8, $0x66fd3da4d6aa5d2
-DATA ·precomputed<>+0x6a20(SB)/8, $0xce1a5b7e43c8d94c
-DATA ·precomputed<>+0x6a28(SB)/8, $0xaed496a8614c0fc2
-DATA ·precomputed<>+0x6a30(SB)/8, $0x355e16f52a6d5481
-DATA ·precomputed<>+0x6a38(SB)/8, $0x8f934639a09d3afe
-DATA ·precomputed<>+0x6a40(SB)/8, $0xf80b787b728429e6
-DATA ·precomputed<>+0x6a48(SB)/8, $0x896b6ea53dd8c4f8
-DATA ·precomputed<>+0x6a50(SB)/8, $0x711eef39c7d9fe06
-DATA ·precomputed<>+0x6a58(SB)/8, $0xfff28d03ebced829
-DATA ·precomputed<>+0x6a60(SB)/8, $0x5d7823b84ad40c88
-DATA ·precomputed<>+0x6a68(SB)/8, $0x40a5a1663b112bd4
-DATA ·precomputed<>+0x6a70(SB)/8, $0x84230bfa63bce926
-DATA ·precomputed<>+0x6a78(SB)/8, $0x39d2e6bdbe17e7cd
-DATA ·precomputed<>+0x6a80(SB)/8, $0xbcaafb453556d74f
-DATA ·precomputed<>+0x6a88(SB)/8, $0xbc216224eb4c7ea0
-DATA ·precomputed<>+0x6a90(SB)/8, $0x73ad1043234a62c8
-DATA ·precomputed<>+0x6a98(SB)/8, $0xa644eb6a2d95ff46
-DATA ·precomputed<>+0x6aa0(SB)/8, $0xd545b60a0a3373f8
-DATA ·precomputed<>+0x6aa8(SB)/8, $0xf7a0572cd4edaa10
-DATA ·precomputed<>+0x6ab0(SB)/8, $0xa7177049a97a85b4
-DATA ·precomputed<>+0x6ab8(SB)/8, $0x529dbadd7d3ec769
-DATA ·precomputed<>+0x6ac0(SB)/8, $0xa0dbc3819ec64698
-DATA ·precomputed<>+0x6ac8(SB)/8, $0x770e4d111ef36dd2
-DATA ·precomputed<>+0x6ad0(SB)/8, $0x1d179158d56bdfd
-DATA ·precomputed<>+0x6ad8(SB)/8, $0xb48153cd75eb9947
-DATA ·precomputed<>+0x6ae0(SB)/8, $0xc1d17a54fde98390
-DATA ·precomputed<>+0x6ae8(SB)/8, $0x817eaf70fe2d6fc
-DATA ·precomputed<>+0x6af0(SB)/8, $0x44a63591a6a704f1
-DATA ·precomputed<>+0x6af8(SB)/8, $0x9423465f7f554182
-DATA ·precomputed<>+0x6b00(SB)/8, $0x9a988d1ced2ea3df
-DATA ·precomputed<>+0x6b08(SB)/8, $0xa3f50efdff39d3df
-DATA ·precomputed<>+0x6b10(SB)/8, $0x418a3627ec1d7614
-DATA ·precomputed<>+0x6b18(SB)/8, $0xafc1520c3d4fa3e8
-DATA ·precomputed<>+0x6b20(SB)/8, $0x741305af891a9c69
-DATA ·precomputed<>+0x6b28(SB)/8, $0xe87064d45d6f8296
-DATA ·precomputed<>+0x6b30(SB)/8, $0x47c9394c12307b05
-DATA ·precomputed<>+0x6b38(SB)/8, $0x6b038acbc35f0f40
-DATA ·precomputed<>+0x6b40(SB)/8, $0x1ff29a09f810812f
-DATA ·precomputed<>+0x6b48(SB)/8, $0x56b64acb15e9b102
-DATA ·precomputed<>+0x6b50(SB)/8, $0xb5f6d4d45b353184
-DATA ·precomputed<>+0x6b58(SB)/8, $0xc3c9292c1c593774
-DATA ·precomputed<>+0x6b60(SB)/8, $0x167810362c700292
-DATA ·precomputed<>+0x6b68(SB)/8, $0xf0948fc93ae2f0c6
-DATA ·precomputed<>+0x6b70(SB)/8, $0x40e353cc4da778ea
-DATA ·precomputed<>+0x6b78(SB)/8, $0x7febf09a34df03a
-DATA ·precomputed<>+0x6b80(SB)/8, $0x82140df3cec09c3e
-DATA ·precomputed<>+0x6b88(SB)/8, $0xcd34ca30539db03f
-DATA ·precomputed<>+0x6b90(SB)/8, $0xf07cf030e7dd0e09
-DATA ·precomputed<>+0x6b98(SB)/8, $0x7b08a24256ae3487
-DATA ·precomputed<>+0x6ba0(SB)/8, $0x9c0fd607bf5a6549
-DATA ·precomputed<>+0x6ba8(SB)/8, $0xb1fc745d189d68e
-DATA ·precomputed<>+0x6bb0(SB)/8, $0xd91be749cf52022
-DATA ·precomputed<>+0x6bb8(SB)/8, $0x6013f31f43ff7fc3
-DATA ·precomputed<>+0x6bc0(SB)/8, $0x88a686430d7fff59
-DATA ·precomputed<>+0x6bc8(SB)/8, $0x82b9219367bfe743
-DATA ·precomputed<>+0x6bd0(SB)/8, $0x1a8b86cfc2ce06f9
-DATA ·precomputed<>+0x6bd8(SB)/8, $0xa38414a0f9ad6134
-DATA ·precomputed<>+0x6be0(SB)/8, $0x7f10261028e2c39f
-DATA ·precomputed<>+0x6be8(SB)/8, $0x34805c20480856a0
-DATA ·precomputed<>+0x6bf0(SB)/8, $0x1b3f930218c3034d
-DATA ·precomputed<>+0x6bf8(SB)/8, $0x1713f457574c0c9d
-DATA ·precomputed<>+0x6c00(SB)/8, $0xa6e7fa540463ac73
-DATA ·precomputed<>+0x6c08(SB)/8, $0xa0deed89c77b19e5
-DATA ·precomputed<>+0x6c10(SB)/8, $0x4e0a3396ff218725
-DATA ·precomputed<>+0x6c18(SB)/8, $0x7cfbbd572edf2704
-DATA ·precomputed<>+0x6c20(SB)/8, $0x8114d0ca4e8608c5
-DATA ·precomputed<>+0x6c28(SB)/8, $0xceae65b938c935b7
-DATA ·precomputed<>+0x6c30(SB)/8, $0x52b1407330af8fd
-DATA ·precomputed<>+0x6c38(SB)/8, $0x2e189a1723c422b
-DATA ·precomputed<>+0x6c40(SB)/8, $0x871a832f02bc97fe
-DATA ·precomputed<>+0x6c48(SB)/8, $0xa14b33268dc7f1f2
-DATA ·precomputed<>+0x6c50(SB)/8, $0xc9bd8b4187f78ad1
-DATA ·precomputed<>+0x6c58(SB)/8, $0xd378d02a0b59b9c5
-DATA ·precomputed<>+0x6c60(SB)/8, $0x418a32a535c0dc14
-DATA ·precomputed<>+0x6c68(SB)/8, $0x4c96979df53d85af
-DATA ·precomputed<>+0x6c70(SB)/8, $0xb6f40e9708eb4b26
-DATA ·precomputed<>+0x6c78(SB)/8, $0xa21349cacaa6252f
-DATA ·precomputed<>+0x6c80(SB)/8, $0x117a63f277ad71de
-DATA ·precomputed<>+0x6c88(SB)/8, $0x1cca30d0c94c8c82
-DATA ·precomputed<>+0x6c90(SB)/8, $0xe5fefba92f05382d
-DATA ·precomputed<>+0x6c98(SB)/8, $0xcc9e89169b4b42f1
-DATA ·precomputed<>+0x6ca0(SB)/8, $0xbe939e139fe716c1
-DATA ·precomputed<>+0x6ca8(SB)/8, $0xbf2b9c8095e38cc2
-DATA ·precomputed<>+0x6cb0(SB)/8, $0xf60c449137adde62
-DATA ·precomputed<>+0x6cb8(SB)/8, $0x3eb3338af4df75a3
-DATA ·precomputed<>+0x6cc0(SB)/8, $0x19cfc8821b96672b
-DATA ·precomputed<>+0x6cc8(SB)/8, $0x2759c76b379d1f57
-DATA ·precomputed<>+0x6cd0(SB)/8, $0xa6cc7a982269a514
-DATA ·precomputed<>+0x6cd8(SB)/8, $0x1bc2015b683b1413
-DATA ·precomputed<>+0x6ce0(SB)/8, $0xc43b11781bf4be62
-DATA ·precomputed<>+0x6ce8(SB)/8, $0xd29419757bf2b0be
-DATA ·precomputed<>+0x6cf0(SB)/8, $0x1eac3587c4591cfd
-DATA ·precomputed<>+0x6cf8(SB)/8, $0x283169e60e66d495
-DATA ·precomputed<>+0x6d00(SB)/8, $0x52704cbe7dd34fde
-DATA ·precomputed<>+0x6d08(SB)/8, $0xfb7224a2926bb6a
-DATA ·precomputed<>+0x6d10(SB)/8, $0xd58bdddf2b0af92
-DATA ·precomputed<>+0x6d18(SB)/8, $0x2f986a070e9cad36
-DATA ·precomputed<>+0x6d20(SB)/8, $0xc85549d480e3a6f9
-DATA ·precomputed<>+0x6d28(SB)/8, $0xa013e913322cb14c
-DATA ·precomputed<>+0x6d30(SB)/8, $0x8a19cf30f25ac201
-DATA ·precomputed<>+0x6d38(SB)/8, $0x130e4ce0ffb8f2e4
-DATA ·precomputed<>+0x6d40(SB)/8, $0xa14112875df66852
-DATA ·precomputed<>+0x6d48(SB)/8, $0x4e9d333ca30445d3
-DATA ·precomputed<>+0x6d50(SB)/8, $0xb5a26c14917568a9
-DATA ·precomputed<>+0x6d58(SB)/8, $0x885f1857e857a6ac
-DATA ·precomputed<>+0x6d60(SB)/8, $0x5fbd3ee84b1f8cf
-DATA ·precomputed<>+0x6d68(SB)/8, $0x5c1f40971e81e4e1
-DATA ·precomputed<>+0x6d70(SB)/8, $0x43999be4011f30e6
-DATA ·precomputed<>+0x6d78(SB)/8, $0xa8aab3bda890719d
-DATA ·precomputed<>+0x6d80(SB)/8, $0x119b3c4b4182b9fc
-DATA ·precomputed<>+0x6d88(SB)/8, $0xcab6659127b5e101
-DATA ·precomputed<>+0x6d90(SB)/8, $0xfff2e9392ab87a02
-DATA ·precomputed<>+0x6d98(SB)/8, $0x1c10c40deec5949b
-DATA ·precomputed<>+0x6da0(SB)/8, $0x9836622430aa1242
-DATA ·precomputed<>+0x6da8(SB)/8, $0x833e9deef225a4e7
-DATA ·precomputed<>+0x6db0(SB)/8, $0x7f1cfec992e8475
-DATA ·precomputed<>+0x6db8(SB)/8, $0x377a9d791ef83a8a
-DATA ·precomputed<>+0x6dc0(SB)/8, $0xd8594800da0723bc
-DATA ·precomputed<>+0x6dc8(SB)/8, $0x524452dff3c8381d
-DATA ·precomputed<>+0x6dd0(SB)/8, $0x846dfa02138ca980
-DATA ·precomputed<>+0x6dd8(SB)/8, $0xaa77a80ce2d32e56
-DATA ·precomputed<>+0x6de0(SB)/8, $0x27573fbc419c86b5
-DATA ·precomputed<>+0x6de8(SB)/8, $0xe7486807b70216c3
-DATA ·precomputed<>+0x6df0(SB)/8, $0x8b7a685ac72036e6
-DATA ·precomputed<>+0x6df8(SB)/8, $0xa176462715fae3d8
-DATA ·precomputed<>+0x6e00(SB)/8, $0x5445e3a20e78191b
-DATA ·precomputed<>+0x6e08(SB)/8, $0x134dba0b8c80db2f
-DATA ·precomputed<>+0x6e10(SB)/8, $0xe9925a8794002b55
-DATA ·precomputed<>+0x6e18(SB)/8, $0xe56fa2be4293c71d
-DATA ·precomputed<>+0x6e20(SB)/8, $0x72aca4d2a9d009c2
-DATA ·precomputed<>+0x6e28(SB)/8, $0xc1219dd02fb0741
-DATA ·precomputed<>+0x6e30(SB)/8, $0x689fbc66208fd227
-DATA ·precomputed<>+0x6e38(SB)/8, $0x8266f2f7e4bb09d8
-DATA ·precomputed<>+0x6e40(SB)/8, $0xbca9585910a966c
-DATA ·precomputed<>+0x6e48(SB)/8, $0x80385b476f12c20c
-DATA ·precomputed<>+0x6e50(SB)/8, $0xf63a1605a4b30374
-DATA ·precomputed<>+0x6e58(SB)/8, $0x2f91b24c104b4783
-DATA ·precomputed<>+0x6e60(SB)/8, $0x9210f5b9b3ab423f
-DATA ·precomputed<>+0x6e68(SB)/8, $0xb9aa656d2fd424a6
-DATA ·precomputed<>+0x6e70(SB)/8, $0x63c615d5f7e8d640
-DATA ·precomputed<>+0x6e78(SB)/8, $0xd567ff98bb59cfec
-DATA ·precomputed<>+0x6e80(SB)/8, $0xb9fd8ae4e321c228
-DATA ·precomputed<>+0x6e88(SB)/8, $0x4a46bd2d360692ba
-DATA ·precomputed<>+0x6e90(SB)/8, $0x91d5396ed05b84b0
-DATA ·precomputed<>+0x6e98(SB)/8, $0x266e89fdd6b058d0
-DATA ·precomputed<>+0x6ea0(SB)/8, $0x6fb142d7b2c42e38
-DATA ·precomputed<>+0x6ea8(SB)/8, $0x93c9fe18994ebc2f
-DATA ·precomputed<>+0x6eb0(SB)/8, $0x90e17885104b04a3
-DATA ·precomputed<>+0x6eb8(SB)/8, $0x6a5fa420654eb6ac
-DATA ·precomputed<>+0x6ec0(SB)/8, $0xaea8d0556b1fb104
-DATA ·precomputed<>+0x6ec8(SB)/8, $0x2ff339a431fe146f
-DATA ·precomputed<>+0x6ed0(SB)/8, $0x3d7ef85bcf63c413
-DATA ·precomputed<>+0x6ed8(SB)/8, $0x1f0f57c5289a097b
-DATA ·precomputed<>+0x6ee0(SB)/8, $0x82f2f83b5bda1160
-DATA ·precomputed<>+0x6ee8(SB)/8, $0x433eea4d6fea66e8
-DATA ·precomputed<>+0x6ef0(SB)/8, $0x1f3fff4fcae11651
-DATA ·precomputed<>+0x6ef8(SB)/8, $0xfa71c3fd6b1c243b
-DATA ·precomputed<>+0x6f00(SB)/8, $0xb30780c3ba64d50b
-DATA ·precomputed<>+0x6f08(SB)/8, $0x163283457acb4fca
-DATA ·precomputed<>+0x6f10(SB)/8, $0xf64e01fd84b258de
-DATA ·precomputed<>+0x6f18(SB)/8, $0x2a25873e35dcd2f1
-DATA ·precomputed<>+0x6f20(SB)/8, $0x36606813ce4b39da
-DATA ·precomputed<>+0x6f28(SB)/8, $0x5285c91ea69a93e3
-DATA ·precomputed<>+0x6f30(SB)/8, $0x4da13aaadcb501d6
-DATA ·precomputed<>+0x6f38(SB)/8, $0xb90d0a5252e3dc24
-DATA ·precomputed<>+0x6f40(SB)/8, $0x954fbd835cb7623d
-DATA ·precomputed<>+0x6f48(SB)/8, $0xba8b30070b83d55c
-DATA ·precomputed<>+0x6f50(SB)/8, $0x71946b92e2b23256
-DATA ·precomputed<>+0x6f58(SB)/8, $0xe0a2a7bffaf95492
-DATA ·precomputed<>+0x6f60(SB)/8, $0x32ed3d914e0c81ef
-DATA ·precomputed<>+0x6f68(SB)/8, $0xb8c8b14c46f058d6
-DATA ·precomputed<>+0x6f70(SB)/8, $0xc76c917f67221924
-DATA ·precomputed<>+0x6f78(SB)/8, $0xd26c1d512ddf3cd4
-DATA ·precomputed<>+0x6f80(SB)/8, $0x40aaf88f624e9ae2
-DATA ·precomputed<>+0x6f88(SB)/8, $0x6499b3f5f5f6e5c5
-DATA ·precomputed<>+0x6f90(SB)/8, $0x1fb0b8e98157f61
-DATA ·precomputed<>+0x6f98(SB)/8, $0x70438f333827220
-DATA ·precomputed<>+0x6fa0(SB)/8, $0x7409012f50ab0b43
-DATA ·precomputed<>+0x6fa8(SB)/8, $0xdbbba56363c50e65
-DATA ·precomputed<>+0x6fb0(SB)/8, $0x6b572ca3c0d084ad
-DATA ·precomputed<>+0x6fb8(SB)/8, $0xf10f66847b76cd6c
-DATA ·precomputed<>+0x6fc0(SB)/8, $0xcb52edc2023cb0df
-DATA ·precomputed<>+0x6fc8(SB)/8, $0x8773a4dd5a24591
-DATA ·precomputed<>+0x6fd0(SB)/8, $0xd9a6aaae12a9072
-DATA ·precomputed<>+0x6fd8(SB)/8, $0x4261f56f5bf5586e
-DATA ·precomputed<>+0x6fe0(SB)/8, $0x184b040260a08106
-DATA ·precomputed<>+0x6fe8(SB)/8, $0x1b398053b09cfa61
-DATA ·precomputed<>+0x6ff0(SB)/8, $0xdf7f55b1d5dae483
-DATA ·precomputed<>+0x6ff8(SB)/8, $0x9554210e86ef2cde
-DATA ·precomputed<>+0x7000(SB)/8, $0x564d6e859204db30
-DATA ·precomputed<>+0x7008(SB)/8, $0x139bb9282aa84cdf
-DATA ·precomputed<>+0x7010(SB)/8, $0x9413d7ea88476456
-DATA ·precomputed<>+0x7018(SB)/8, $0x5c5544835a1ffa66
-DATA ·precomputed<>+0x7020(SB)/8, $0x7b8630892ed18080
-DATA ·precomputed<>+0x7028(SB)/8, $0x589aaf20d14e5daf
-DATA ·precomputed<>+0x7030(SB)/8, $0xeee4f96f7b5f81ca
-DATA ·precomputed<>+0x7038(SB)/8, $0x88d470071bb0b415
-DATA ·precomputed<>+0x7040(SB)/8, $0x1bb400d355c9bd11
-DATA ·precomputed<>+0x7048(SB)/8, $0x8402465c06fc2851
-DATA ·precomputed<>+0x7050(SB)/8, $0xa81ba22d65063b3e
-DATA ·precomputed<>+0x7058(SB)/8, $0xbab2dcbc6e1aa0c6
-DATA ·precomputed<>+0x7060(SB)/8, $0xe5f43f1abe645e25
-DATA ·precomputed<>+0x7068(SB)/8, $0x623205334df84be1
-DATA ·precomputed<>+0x7070(SB)/8, $0x14ac708021a2eaf4
-DATA ·precomputed<>+0x7078(SB)/8, $0x3f94646458beb26f
-DATA ·precomputed<>+0x7080(SB)/8, $0x5f2a3e9a7a82d20f
-DATA ·precomputed<>+0x7088(SB)/8, $0x399e015c191011f2
-DATA ·precomputed<>+0x7090(SB)/8, $0xfbec312a886ac8e6
-DATA ·precomputed<>+0x7098(SB)/8, $0xdd5140aeda47f96
-DATA ·precomputed<>+0x70a0(SB)/8, $0xd4df31326b47318
-DATA ·precomputed<>+0x70a8(SB)/8, $0xe2c9ec78e6685ec8
-DATA ·precomputed<>+0x70b0(SB)/8, $0x4df119aecd8442cd
-DATA ·precomputed<>+0x70b8(SB)/8, $0xdb1ca9557b32a1cf
-DATA ·precomputed<>+0x70c0(SB)/8, $0x7e2c5852126506cc
-DATA ·precomputed<>+0x70c8(SB)/8, $0xba94aac708b3567d
-DATA ·precomputed<>+0x70d0(SB)/8, $0x6905cdf4c05a3f24
-DATA ·precomputed<>+0x70d8(SB)/8, $0xbf5f559b3547f8b3
-DATA ·precomputed<>+0x70e0(SB)/8, $0x9e4b4e62aade7a1d
-DATA ·precomputed<>+0x70e8(SB)/8, $0x56b8b9d61fda3088
-DATA ·precomputed<>+0x70f0(SB)/8, $0xea3eb4c64c43d89f
-DATA ·precomputed<>+0x70f8(SB)/8, $0xfb7e537c9c69e047
-DATA ·precomputed<>+0x7100(SB)/8, $0xc23d9491dfe5f6ab
-DATA ·precomputed<>+0x7108(SB)/8, $0x42fc362dc1a9c0af
-DATA ·precomputed<>+0x7110(SB)/8, $0x4170b01127d2b35
-DATA ·precomputed<>+0x7118(SB)/8, $0x4f0f17bc04116aeb
-DATA ·precomputed<>+0x7120(SB)/8, $0x716c01dfc9184cf6
-DATA ·precomputed<>+0x7128(SB)/8, $0x914dc877895ceae7
-DATA ·precomputed<>+0x7130(SB)/8, $0x696b2ae8390bff2e
-DATA ·precomputed<>+0x7138(SB)/8, $0xf6ccd628f88af5db
-DATA ·precomputed<>+0x7140(SB)/8, $0xdada9bb90f88095a
-DATA ·precomputed<>+0x7148(SB)/8, $0x7155c28f919ce305
-DATA ·precomputed<>+0x7150(SB)/8, $0x32a01e476d78b266
-DATA ·precomputed<>+0x7158(SB)/8, $0x6da94459b652c4f8
-DATA ·precomputed<>+0x7160(SB)/8, $0xa31783a6827ea8ef
-DATA ·precomputed<>+0x7168(SB)/8, $0x4d69b7c6bdb1af2b
-DATA ·precomputed<>+0x7170(SB)/8, $0x2874eb38af31dab9
-DATA ·precomputed<>+0x7178(SB)/8, $0xa0ed9910afd9bace
-DATA ·precomputed<>+0x7180(SB)/8, $0x7d892e3a4037f17e
-DATA ·precomputed<>+0x7188(SB)/8, $0x81fa98415f91a4fa
-DATA ·precomputed<>+0x7190(SB)/8, $0x17c7292d961cf02f
-DATA ·precomputed<>+0x7198(SB)/8, $0x35af0c0e388bcc75
-DATA ·precomputed<>+0x71a0(SB)/8, $0x340bec90127a29b0
-DATA ·precomputed<>+0x71a8(SB)/8, $0x955714a43d087445
-DATA ·precomputed<>+0x71b0(SB)/8, $0xfd430880a587c273
-DATA ·precomputed<>+0x71b8(SB)/8, $0x715ecd50d24dfda2
-DATA ·precomputed<>+0x71c0(SB)/8, $0x4ade066daafd6cef
-DATA ·precomputed<>+0x71c8(SB)/8, $0xce59c8def8c1decc
-DATA ·precomputed<>+0x71d0(SB)/8, $0x3e12a24a77b96ece
-DATA ·precomputed<>+0x71d8(SB)/8, $0xee7c32fc44cc710c
-DATA ·precomputed<>+0x71e0(SB)/8, $0x70700e4f240e9bb7
-DATA ·precomputed<>+0x71e8(SB)/8, $0x837ada546a63b06e
-DATA ·precomputed<>+0x71f0(SB)/8, $0xa58ce980d19644ee
-DATA ·precomputed<>+0x71f8(SB)/8, $0xcaa5d14d27e7451c
-DATA ·precomputed<>+0x7200(SB)/8, $0x8e78d2ed387272fc
-DATA ·precomputed<>+0x7208(SB)/8, $0x9163a377fd8a0f13
-DATA ·precomputed<>+0x7210(SB)/8, $0x858436bd635c55f0
-DATA ·precomputed<>+0x7218(SB)/8, $0xa414f9b5ba5b0eb
-DATA ·precomputed<>+0x7220(SB)/8, $0x2b58373a7d7383b1
-DATA ·precomputed<>+0x7228(SB)/8, $0x5e7b9d366030a376
-DATA ·precomputed<>+0x7230(SB)/8, $0x9c69af86543514ef
-DATA ·precomputed<>+0x7238(SB)/8, $0x44698cc26080ff3
-DATA ·precomputed<>+0x7240(SB)/8, $0x76f54954a2e23074
-DATA ·precomputed<>+0x7248(SB)/8, $0x9039326417526081
-DATA ·precomputed<>+0x7250(SB)/8, $0xd095055f3b78a50
-DATA ·precomputed<>+0x7258(SB)/8, $0x1f3a377669d8b26d
-DATA ·precomputed<>+0x7260(SB)/8, $0x575e3bbf5e7c8fb
-DATA ·precomputed<>+0x7268(SB)/8, $0xee7dd406ee40b0c5
-DATA ·precomputed<>+0x7270(SB)/8, $0xe6522e5d55dab556
-DATA ·precomputed<>+0x7278(SB)/8, $0x2d1b5709b61cd918
-DATA ·precomputed<>+0x7280(SB)/8, $0xea9278e01400b8d
-DATA ·precomputed<>+0x7288(SB)/8, $0x9552e7456464f584
-DATA ·precomputed<>+0x7290(SB)/8, $0x67f5645b12fc094f
-DATA ·precomputed<>+0x7298(SB)/8, $0x77c40f3cde303128
-DATA ·precomputed<>+0x72a0(SB)/8, $0x16d7e9a50e3f3381
-DATA ·precomputed<>+0x72a8(SB)/8, $0x17795ab59947693
-DATA ·precomputed<>+0x72b0(SB)/8, $0xb69b57089222eaf5
-DATA ·precomputed<>+0x72b8(SB)/8, $0x61b213e01b77f122
-DATA ·precomputed<>+0x72c0(SB)/8, $0xa7cc8bbfdc8db00e
-DATA ·precomputed<>+0x72c8(SB)/8, $0x1c51f5e43aa7fc1f
-DATA ·precomputed<>+0x72d0(SB)/8, $0xb85b782eb4ac2d0c
-DATA ·precomputed<>+0x72d8(SB)/8, $0x32fde94b0468e5ea
-DATA ·precomputed<>+0x72e0(SB)/8, $0x8ad5b9a27f7ff0a9
-DATA ·precomputed<>+0x72e8(SB)/8, $0xcd26f4188fdbb3f9
-DATA ·precomputed<>+0x72f0(SB)/8, $0x853bc95d6ebf89db
-DATA ·precomputed<>+0x72f8(SB)/8, $0x1da0a323a066b849
-DATA ·precomputed<>+0x7300(SB)/8, $0xc4cc7aab4bce0fa7
-DATA ·precomputed<>+0x7308(SB)/8, $0xd4a05b696bc940f1
-DATA ·precomputed<>+0x7310(SB)/8, $0xc77300e6392dbd11
-DATA ·precomputed<>+0x7318(SB)/8, $0xdc2eac621f70aae
-DATA ·precomputed<>+0x7320(SB)/8, $0x9d4b513b4b2ad7e0
-DATA ·precomputed<>+0x7328(SB)/8, $0x19822167a6daee1d
-DATA ·precomputed<>+0x7330(SB)/8, $0x7d71d20269b98eee
-DATA ·precomputed<>+0x7338(SB)/8, $0xdfd435dc35f3f150
-DATA ·precomputed<>+0x7340(SB)/8, $0x66d46ad3ddfd45ed
-DATA ·precomputed<>+0x7348(SB)/8, $0xf0325189e50a2f01
-DATA ·precomputed<>+0x7350(SB)/8, $0xe19b95003ec5683d
-DATA ·precomputed<>+0x7358(SB)/8, $0xc46ab0a291dd97e9
-DATA ·precomputed<>+0x7360(SB)/8, $0x74c971d7ed682c4a
-DATA ·precomputed<>+0x7368(SB)/8, $0xafedac2da14da289
-DATA ·precomputed<>+0x7370(SB)/8, $0xd17838fee39ba740
-DATA ·precomputed<>+0x7378(SB)/8, $0xeb497bca053536bc
-DATA ·precomputed<>+0x7380(SB)/8, $0x551ba4cade6d4c38
-DATA ·precomputed<>+0x7388(SB)/8, $0xa67be2474f52298b
-DATA ·precomputed<>+0x7390(SB)/8, $0x984131889a5b40a8
-DATA ·precomputed<>+0x7398(SB)/8, $0x83a26aabb0acfb5
-DATA ·precomputed<>+0x73a0(SB)/8, $0x4929ff5e11d16ebb
-DATA ·precomputed<>+0x73a8(SB)/8, $0x91f08b63a942ae7e
-DATA ·precomputed<>+0x73b0(SB)/8, $0xaa428ef3876663ec
-DATA ·precomputed<>+0x73b8(SB)/8, $0xfaabd3091e97cbb2
-DATA ·precomputed<>+0x73c0(SB)/8, $0xca0ed50cf1edd62f
-DATA ·precomputed<>+0x73c8(SB)/8, $0xc3c7ae6fd29f48d9
-DATA ·precomputed<>+0x73d0(SB)/8, $0xff47bf288a72ae88
-DATA ·precomputed<>+0x73d8(SB)/8, $0x584ddfe5348c6666
-DATA ·precomputed<>+0x73e0(SB)/8, $0x271137e936731fdf
-DATA ·precomputed<>+0x73e8(SB)/8, $0x714bc7db88d98bc8
-DATA ·precomputed<>+0x73f0(SB)/8, $0xcea912c10da6be30
-DATA ·precomputed<>+0x73f8(SB)/8, $0x91cb844dbe62d6a5
-DATA ·precomputed<>+0x7400(SB)/8, $0xe16ca42aec027bfa
-DATA ·precomputed<>+0x7408(SB)/8, $0xc88f70117603e76
-DATA ·precomputed<>+0x7410(SB)/8, $0x799418e363d5a31a
-DATA ·precomputed<>+0x7418(SB)/8, $0x33bb53bebb063f6
-DATA ·precomputed<>+0x7420(SB)/8, $0xbcd05461625d3909
-DATA ·precomputed<>+0x7428(SB)/8, $0x2d7b786885f23129
-DATA ·precomputed<>+0x7430(SB)/8, $0x23b0788795090997
-DATA ·precomputed<>+0x7438(SB)/8, $0x216c08ae18d2c218
-DATA ·precomputed<>+0x7440(SB)/8, $0xe1ccb6c1eebdbcf9
-DATA ·precomputed<>+0x7448(SB)/8, $0x89ca4552e873842e
-DATA ·precomputed<>+0x7450(SB)/8, $0x4837f1373c2fcdd5
-DATA ·precomputed<>+0x7458(SB)/8, $0x805874e8108a8c0a
-DATA ·precomputed<>+0x7460(SB)/8, $0xe7e524f43d442fa7
-DATA ·precomputed<>+0x7468(SB)/8, $0x580d82bef8131f8a
-DATA ·precomputed<>+0x7470(SB)/8, $0x6dcb7d2793d3d50f
-DATA ·precomputed<>+0x7478(SB)/8, $0x51207d3eb5b39168
-DATA ·precomputed<>+0x7480(SB)/8, $0x9a3ce11709110fe9
-DATA ·precomputed<>+0x7488(SB)/8, $0x8f3c6e4f48721d93
-DATA ·precomputed<>+0x7490(SB)/8, $0x60a62b4887bdfa61
-DATA ·precomputed<>+0x7498(SB)/8, $0x86dac657c01d84a
-DATA ·precomputed<>+0x74a0(SB)/8, $0x4af7878c53841493
-DATA ·precomputed<>+0x74a8(SB)/8, $0x3b1a8935b3bd5aa1
-DATA ·precomputed<>+0x74b0(SB)/8, $0x65c8445b902e5686
-DATA ·precomputed<>+0x74b8(SB)/8, $0xde16cfa52e3b1822
-DATA ·precomputed<>+0x74c0(SB)/8, $0x19879e780a3e3684
-DATA ·precomputed<>+0x74c8(SB)/8, $0xec553912ee249180
-DATA ·precomputed<>+0x74d0(SB)/8, $0x8eb73faef8f4c1ee
-DATA ·precomputed<>+0x74d8(SB)/8, $0xdee59877b81fd20d
-DATA ·precomputed<>+0x74e0(SB)/8, $0x2452e63f20b5ece3
-DATA ·precomputed<>+0x74e8(SB)/8, $0x17be9422b632dddb
-DATA ·precomputed<>+0x74f0(SB)/8, $0x1f8922094311e6d
-DATA ·precomputed<>+0x74f8(SB)/8, $0x8f0fe052a332f84f
-DATA ·precomputed<>+0x7500(SB)/8, $0x59657aab1b9784d5
-DATA ·precomputed<>+0x7508(SB)/8, $0x6f2ce032d8a7f347
-DATA ·precomputed<>+0x7510(SB)/8, $0x842477936b95e6e9
-DATA ·precomputed<>+0x7518(SB)/8, $0x34301cf44395b044
-DATA ·precomputed<>+0x7520(SB)/8, $0x98ebfd98f7fb5401
-DATA ·precomputed<>+0x7528(SB)/8, $0x14fd494bfcdb31a4
-DATA ·precomputed<>+0x7530(SB)/8, $0x42f89d8f90e0481
-DATA ·precomputed<>+0x7538(SB)/8, $0x6b90a0084134ab52
-DATA ·precomputed<>+0x7540(SB)/8, $0x8fa225557fe2ffec
-DATA ·precomputed<>+0x7548(SB)/8, $0xc6dc3d32a778448f
-DATA ·precomputed<>+0x7550(SB)/8, $0x4886fedb85f45aad
-DATA ·precomputed<>+0x7558(SB)/8, $0x5bdef90e51704d0c
-DATA ·precomputed<>+0x7560(SB)/8, $0x46ad596de2d1fdaf
-DATA ·precomputed<>+0x7568(SB)/8, $0x914e009004126f0d
-DATA ·precomputed<>+0x7570(SB)/8, $0x71aaeb18aef960a6
-DATA ·precomputed<>+0x7578(SB)/8, $0x8f4601e5ac77472c
-DATA ·precomputed<>+0x7580(SB)/8, $0x42e5a186d8d9768c
-DATA ·precomputed<>+0x7588(SB)/8, $0x8cbf3a6c00f6004f
-DATA ·precomputed<>+0x7590(SB)/8, $0x9d4bf5acc1ddebdc
-DATA ·precomputed<>+0x7598(SB)/8, $0x13354792a9c066fb
-DATA ·precomputed<>+0x75a0(SB)/8, $0x72e0b81c923fe808
-DATA ·precomputed<>+0x75a8(SB)/8, $0x1e73b868c526d6e4
-DATA ·precomputed<>+0x75b0(SB)/8, $0x3f7bedc6a81f1e24
-DATA ·precomputed<>+0x75b8(SB)/8, $0xed1ff363e920ba24
-DATA ·precomputed<>+0x75c0(SB)/8, $0x58234c89659604c5
-DATA ·precomputed<>+0x75c8(SB)/8, $0xa6a421adce4b0872
-DATA ·precomputed<>+0x75d0(SB)/8, $0x5dc8848acc19578f
-DATA ·precomputed<>+0x75d8(SB)/8, $0xfcb418d04f28bdfc
-DATA ·precomputed<>+0x75e0(SB)/8, $0xf2e748208d6442f5
-DATA ·precomputed<>+0x75e8(SB)/8, $0xc481d854dcf6378
-DATA ·precomputed<>+0x75f0(SB)/8, $0x4987d1a64556438b
-DATA ·precomputed<>+0x75f8(SB)/8, $0x763593633157c6be
-DATA ·precomputed<>+0x7600(SB)/8, $0x29bbf3b71c1dceef
-DATA ·precomputed<>+0x7608(SB)/8, $0x995c340576f1dbd
-DATA ·precomputed<>+0x7610(SB)/8, $0x405db3d8fa61304
-DATA ·precomputed<>+0x7618(SB)/8, $0x63438f3dcc7d345e
-DATA ·precomputed<>+0x7620(SB)/8, $0x688174dd942120e5
-DATA ·precomputed<>+0x7628(SB)/8, $0xc7dd05bdcd70c93c
-DATA ·precomputed<>+0x7630(SB)/8, $0xdc8a32dc5e871ae0
-DATA ·precomputed<>+0x7638(SB)/8, $0x1a7896b96178647a
-DATA ·precomputed<>+0x7640(SB)/8, $0x1fc3f7a259c437e3
-DATA ·precomputed<>+0x7648(SB)/8, $0x737de2e324235e5e
-DATA ·precomputed<>+0x7650(SB)/8, $0x589a56e37a5eaabd
-DATA ·precomputed<>+0x7658(SB)/8, $0x5a79da8ecca140f3
-DATA ·precomputed<>+0x7660(SB)/8, $0x3d8b0d82a12463fa
-DATA ·precomputed<>+0x7668(SB)/8, $0x63fc83d80875daf5
-DATA ·precomputed<>+0x7670(SB)/8, $0x42a30803bd9211f7
-DATA ·precomputed<>+0x7678(SB)/8, $0x62f6167f32d3935f
-DATA ·precomputed<>+0x7680(SB)/8, $0x70cd64676f269922
-DATA ·precomputed<>+0x7688(SB)/8, $0xf694ca2196163b47
-DATA ·precomputed<>+0x7690(SB)/8, $0xf3bafb2d5f5ba669
-DATA ·precomputed<>+0x7698(SB)/8, $0xcf7cf341b8ed8333
-DATA ·precomputed<>+0x76a0(SB)/8, $0x34b2022d9997edc2
-DATA ·precomputed<>+0x76a8(SB)/8, $0x57e6f4b5309c6508
-DATA ·precomputed<>+0x76b0(SB)/8, $0xf6fbf86464841008
-DATA ·precomputed<>+0x76b8(SB)/8, $0xbc9821f5ed075d44
-DATA ·precomputed<>+0x76c0(SB)/8, $0x78c80f73f37cc6b7
-DATA ·precomputed<>+0x76c8(SB)/8, $0x41d286266ab88fc2
-DATA ·precomputed<>+0x76d0(SB)/8, $0x2126981c58ca26fc
-DATA ·precomputed<>+0x76d8(SB)/8, $0x7a956c64be3dbf87
-DATA ·precomputed<>+0x76e0(SB)/8, $0x2f41e27dce0ce9f3
-DATA ·precomputed<>+0x76e8(SB)/8, $0xcb49ae0f4c98e5b
-DATA ·precomputed<>+0x76f0(SB)/8, $0xba6224a6cace473e
-DATA ·precomputed<>+0x76f8(SB)/8, $0x25dddbc0393e092f
-DATA ·precomputed<>+0x7700(SB)/8, $0x747daf46a4fb974d
-DATA ·precomputed<>+0x7708(SB)/8, $0xfb775fe7c76dbe2e
-DATA ·precomputed<>+0x7710(SB)/8, $0xb7b3ad6d9670c22e
-DATA ·precomputed<>+0x7718(SB)/8, $0xc6580b2310a380bc
-DATA ·precomputed<>+0x7720(SB)/8, $0x4ea226f592087c3d
-DATA ·precomputed<>+0x7728(SB)/8, $0xe67c379fb53aa3c7
-DATA ·precomputed<>+0x7730(SB)/8, $0x4133f831991c3c9b
-DATA ·precomputed<>+0x7738(SB)/8, $0x80f9e5bd4fa0dd18
-DATA ·precomputed<>+0x7740(SB)/8, $0x94e7c6c6f80fb4
-DATA ·precomputed<>+0x7748(SB)/8, $0x16e99ebc351bebd3
-DATA ·precomputed<>+0x7750(SB)/8, $0xc555ed44aae16a6f
-DATA ·precomputed<>+0x7758(SB)/8, $0xe9d2846f2f6367eb
-DATA ·precomputed<>+0x7760(SB)/8, $0xb34c93d083d46d0f
-DATA ·precomputed<>+0x7768(SB)/8, $0xc0cb137a894fadc6
-DATA ·precomputed<>+0x7770(SB)/8, $0x21e289f8ab31f937
-DATA ·precomputed<>+0x7778(SB)/8, $0xac5e05161bc72a35
-DATA ·precomputed<>+0x7780(SB)/8, $0x6221871bf3d4db0d
-DATA ·precomputed<>+0x7788(SB)/8, $0x72d1fdcea039826c
-DATA ·precomputed<>+0x7790(SB)/8, $0x69d9cc8b668c8022
-DATA ·precomputed<>+0x7798(SB)/8, $0xbf359cefee064ff
-DATA ·precomputed<>+0x77a0(SB)/8, $0xb8e636b7e8d16f19
-DATA ·precomputed<>+0x77a8(SB)/8, $0xde88f403443160ac
-DATA ·precomputed<>+0x77b0(SB)/8, $0x4228177a032836ee
-DATA ·precomputed<>+0x77b8(SB)/8, $0xee8fac37e9801d86
-DATA ·precomputed<>+0x77c0(SB)/8, $0x496c93634626e343
-DATA ·precomputed<>+0x77c8(SB)/8, $0xf6999578f4e4c8fa
-DATA ·precomputed<>+0x77d0(SB)/8, $0xce7306f6b8648a06
-DATA ·precomputed<>+0x77d8(SB)/8, $0xe2775c8cae7996e5
-DATA ·precomputed<>+0x77e0(SB)/8, $0x7b47e678bf09d221
-DATA ·precomputed<>+0x77e8(SB)/8, $0xf5251e1e515c2ace
-DATA ·precomputed<>+0x77f0(SB)/8, $0x87f912177b48b41
-DATA ·precomputed<>+0x77f8(SB)/8, $0xc40e7725eb38d74b
-DATA ·precomputed<>+0x7800(SB)/8, $0x4b7fab3cc064f530
-DATA ·precomputed<>+0x7808(SB)/8, $0x731153aede175892
-DATA ·precomputed<>+0x7810(SB)/8, $0x335e65033d4c4e60
-DATA ·precomputed<>+0x7818(SB)/8, $0xb0876a8a776ce13a
-DATA ·precomputed<>+0x7820(SB)/8, $0xa8a566ee22241ecd
-DATA ·precomputed<>+0x7828(SB)/8, $0xb7456b3e011e861c
-DATA ·precomputed<>+0x7830(SB)/8, $0xa9aff4eb177dd490
-DATA ·precomputed<>+0x7838(SB)/8, $0x189b1ed9c8f77c40
-DATA ·precomputed<>+0x7840(SB)/8, $0x624de6872857a1fc
-DATA ·precomputed<>+0x7848(SB)/8, $0xbd0a0d9c2ff8f505
-DATA ·precomputed<>+0x7850(SB)/8, $0xeecb4fadc381bc9a
-DATA ·precomputed<>+0x7858(SB)/8, $0x72386292fa94e41b
-DATA ·precomputed<>+0x7860(SB)/8, $0x354d3f83e75fc753
-DATA ·precomputed<>+0x7868(SB)/8, $0x6afc753a7a5a6bf
-DATA ·precomputed<>+0x7870(SB)/8, $0x1ce792eeb2f568dc
-DATA ·precomputed<>+0x7878(SB)/8, $0xc5faaee3bd2f9647
-DATA ·precomputed<>+0x7880(SB)/8, $0x41cab3091b957580
-DATA ·precomputed<>+0x7888(SB)/8, $0x2f50e07bf1d67866
-DATA ·precomputed<>+0x7890(SB)/8, $0x804dbb9457dbe1
-DATA ·precomputed<>+0x7898(SB)/8, $0xccf64dec97296123
-DATA ·precomputed<>+0x78a0(SB)/8, $0xcbe4187b89d74171
-DATA ·precomputed<>+0x78a8(SB)/8, $0x9b2fd202210ec594
-DATA ·precomputed<>+0x78b0(SB)/8, $0x15b6a51fbfdf2029
-DATA ·precomputed<>+0x78b8(SB)/8, $0xc10b095b6732bdb4
-DATA ·precomputed<>+0x78c0(SB)/8, $0x175fbeb0f912b74f
-DATA ·precomputed<>+0x78c8(SB)/8, $0x45fbe8e16e0ceedd
-DATA ·precomputed<>+0x78d0(SB)/8, $0xf0e1aa68d9233ee7
-DATA ·precomputed<>+0x78d8(SB)/8, $0xe55fc1ce406a626e
-DATA ·precomputed<>+0x78e0(SB)/8, $0x20efa1b9e08712e7
-DATA ·precomputed<>+0x78e8(SB)/8, $0x5fd108b5bcfd6360
-DATA ·precomputed<>+0x78f0(SB)/8, $0xea431df6eec1edac
-DATA ·precomputed<>+0x78f8(SB)/8, $0xae1c0521940803f1
-DATA ·precomputed<>+0x7900(SB)/8, $0x6a165ee044ac89e1
-DATA ·precomputed<>+0x7908(SB)/8, $0x124bfca66a7923c0
-DATA ·precomputed<>+0x7910(SB)/8, $0x384cd031a30e1249
-DATA ·precomputed<>+0x7918(SB)/8, $0xde22a21fbc8c254d
-DATA ·precomputed<>+0x7920(SB)/8, $0xa78701b123a54910
-DATA ·precomputed<>+0x7928(SB)/8, $0xf0c384bab3f35bf7
-DATA ·precomputed<>+0x7930(SB)/8, $0x5522821568075bb1
-DATA ·precomputed<>+0x7938(SB)/8, $0xf4edbd9c07d89e10
-DATA ·precomputed<>+0x7940(SB)/8, $0x584a16d015407ffe
-DATA ·precomputed<>+0x7948(SB)/8, $0xa977f70208a82a69
-DATA ·precomputed<>+0x7950(SB)/8, $0x52eefecf67f8a198
-DATA ·precomputed<>+0x7958(SB)/8, $0xec21373819f7a7e0
-DATA ·precomputed<>+0x7960(SB)/8, $0x6795cfef35987b9a
-DATA ·precomputed<>+0x7968(SB)/8, $0xb243403b97028480
-DATA ·precomputed<>+0x7970(SB)/8, $0xac24b12b9c1b9124
-DATA ·precomputed<>+0x7978(SB)/8, $0x1f379501a90f8aeb
-DATA ·precomputed<>+0x7980(SB)/8, $0x7493df74cf87bdba
-DATA ·precomputed<>+0x7988(SB)/8, $0x178f158b155861e9
-DATA ·precomputed<>+0x7990(SB)/8, $0x1f8b625f45bf1171
-DATA ·precomputed<>+0x7998(SB)/8, $0x83ac4d1b9653b6c8
-DATA ·precomputed<>+0x79a0(SB)/8, $0x3cda34f0abd687c7
-DATA ·precomputed<>+0x79a8(SB)/8, $0xf935facf87d2f0e4
-DATA ·precomputed<>+0x79b0(SB)/8, $0x64e1a01b475ede40
-DATA ·precomputed<>+0x79b8(SB)/8, $0xed0f8e602ee963d9
-DATA ·precomputed<>+0x79c0(SB)/8, $0xa8e97fb664bc0f09
-DATA ·precomputed<>+0x79c8(SB)/8, $0xb913991c953cd08
-DATA ·precomputed<>+0x79d0(SB)/8, $0x8385a1b37fc3bf00
-DATA ·precomputed<>+0x79d8(SB)/8, $0xb6e74decb09ccd8f
-DATA ·precomputed<>+0x79e0(SB)/8, $0x6e1df026ec473ea7
-DATA ·precomputed<>+0x79e8(SB)/8, $0xf2f7fbbe530766bd
-DATA ·precomputed<>+0x79f0(SB)/8, $0xf18cb47a3292052b
-DATA ·precomputed<>+0x79f8(SB)/8, $0x7f8d45929114866a
-DATA ·precomputed<>+0x7a00(SB)/8, $0x82f5ad936870b614
-DATA ·precomputed<>+0x7a08(SB)/8, $0x9016f839e165c07f
-DATA ·precomputed<>+0x7a10(SB)/8, $0xbf8a97a48ae5ef3a
-DATA ·precomputed<>+0x7a18(SB)/8, $0x411dff109f9d7e68
-DATA ·precomputed<>+0x7a20(SB)/8, $0x548a249c35c0dca6
-DATA ·precomputed<>+0x7a28(SB)/8, $0xdc79c65d5ed30b54
-DATA ·precomputed<>+0x7a30(SB)/8, $0x6225f1bc2b82ecf
-DATA ·precomputed<>+0x7a38(SB)/8, $0x2351222a8bbd0aa7
-DATA ·precomputed<>+0x7a40(SB)/8, $0xf0a1c5658bfa2c22
-DATA ·precomputed<>+0x7a48(SB)/8, $0xc28518c32b326c0e
-DATA ·precomputed<>+0x7a50(SB)/8, $0xabafc6f0ec107d66
-DATA ·precomputed<>+0x7a58(SB)/8, $0xbc7a6abf8478907a
-DATA ·precomputed<>+0x7a60(SB)/8, $0x8c1c8f6aa2920288
-DATA ·precomputed<>+0x7a68(SB)/8, $0x6c87579d930c043e
-DATA ·precomputed<>+0x7a70(SB)/8, $0x25ee808db309696d
-DATA ·precomputed<>+0x7a78(SB)/8, $0x433bbbdab7a71041
-DATA ·precomputed<>+0x7a80(SB)/8, $0xc4a833632e3d2a17
-DATA ·precomputed<>+0x7a88(SB)/8, $0x8d94399291c9950d
-DATA ·precomputed<>+0x7a90(SB)/8, $0xd360561685b1e1b0
-DATA ·precomputed<>+0x7a98(SB)/8, $0x71995a518cd36b85
-DATA ·precomputed<>+0x7aa0(SB)/8, $0x2bdfde5243c5e42a
-DATA ·precomputed<>+0x7aa8(SB)/8, $0xbb7438326f43f3a
-DATA ·precomputed<>+0x7ab0(SB)/8, $0xcd566dfc620619a7
-DATA ·precomputed<>+0x7ab8(SB)/8, $0xfbbfc0dcfff87333
-DATA ·precomputed<>+0x7ac0(SB)/8, $0x48d6d957b3086691
-DATA ·precomputed<>+0x7ac8(SB)/8, $0x9946a29b26640916
-DATA ·precomputed<>+0x7ad0(SB)/8, $0x932ca93c43db59a9
-DATA ·precomputed<>+0x7ad8(SB)/8, $0xaa61a0c5e4fe91ba
-DATA ·precomputed<>+0x7ae0(SB)/8, $0x9e22e112815bf003
-DATA ·precomputed<>+0x7ae8(SB)/8, $0xa9ed1b18c86ba8d3
-DATA ·precomputed<>+0x7af0(SB)/8, $0x1b5d3c141069f434
-DATA ·precomputed<>+0x7af8(SB)/8, $0x3cd2ebd01cc01754
-DATA ·precomputed<>+0x7b00(SB)/8, $0x3fdf1bc39b461ae0
-DATA ·precomputed<>+0x7b08(SB)/8, $0x63d64792670ecc8f
-DATA ·precomputed<>+0x7b10(SB)/8, $0x1387fe336e3cdfef
-DATA ·precomputed<>+0x7b18(SB)/8, $0x34a1a84678ac488b
-DATA ·precomputed<>+0x7b20(SB)/8, $0x270304424b8246e2
-DATA ·precomputed<>+0x7b28(SB)/8, $0x1e64b28578a013b3
-DATA ·precomputed<>+0x7b30(SB)/8, $0xb13c87775cb49f38
-DATA ·precomputed<>+0x7b38(SB)/8, $0x10dcc0d90758703c
-DATA ·precomputed<>+0x7b40(SB)/8, $0x5c06b2443350f670
-DATA ·precomputed<>+0x7b48(SB)/8, $0x7557dc9df6f9c751
-DATA ·precomputed<>+0x7b50(SB)/8, $0xa7ebd3b8de66fd97
-DATA ·precomputed<>+0x7b58(SB)/8, $0xc126dbaa2befe6fe
-DATA ·precomputed<>+0x7b60(SB)/8, $0x312f4897396f434a
-DATA ·precomputed<>+0x7b68(SB)/8, $0xe05cfcd661a4124d
-DATA ·precomputed<>+0x7b70(SB)/8, $0xc83b86881525c05e
-DATA ·precomputed<>+0x7b78(SB)/8, $0x4646dbf211899f64
-DATA ·precomputed<>+0x7b80(SB)/8, $0xef9b9458b8aa54a1
-DATA ·precomputed<>+0x7b88(SB)/8, $0xd8becf2751e1f47
-DATA ·precomputed<>+0x7b90(SB)/8, $0x69620c61780523d4
-DATA ·precomputed<>+0x7b98(SB)/8, $0x5b9742f7d89c8fe1
-DATA ·precomputed<>+0x7ba0(SB)/8, $0x183128b0d9c93958
-DATA ·precomputed<>+0x7ba8(SB)/8, $0xf7b642ea47ac9cd
-DATA ·precomputed<>+0x7bb0(SB)/8, $0xfd4cc6d06878bd48
-DATA ·precomputed<>+0x7bb8(SB)/8, $0x9a1b73d0d957c0b2
-DATA ·precomputed<>+0x7bc0(SB)/8, $0x2b7507cb8e419e08
-DATA ·precomputed<>+0x7bc8(SB)/8, $0x785328d7af855eec
-DATA ·precomputed<>+0x7bd0(SB)/8, $0x875db0c77b8683a5
-DATA ·precomputed<>+0x7bd8(SB)/8, $0x3d1bc96890a597e9
-DATA ·precomputed<>+0x7be0(SB)/8, $0x7d4afa1047eeeab4
-DATA ·precomputed<>+0x7be8(SB)/8, $0x2668dd43d680ca71
-DATA ·precomputed<>+0x7bf0(SB)/8, $0xc3210d1f17365023
-DATA ·precomputed<>+0x7bf8(SB)/8, $0xd5bb2ee417fb31cc
-DATA ·precomputed<>+0x7c00(SB)/8, $0xec5678f360056dfd
-DATA ·precomputed<>+0x7c08(SB)/8, $0xe207acae3f8dc3e8
-DATA ·precomputed<>+0x7c10(SB)/8, $0x96bf7c4112365096
-DATA ·precomputed<>+0x7c18(SB)/8, $0xde7a7f312ffdef4e
-DATA ·precomputed<>+0x7c20(SB)/8, $0xbcc0c9406173876
-DATA ·precomputed<>+0x7c28(SB)/8, $0x1c5346a0174c37fb
-DATA ·precomputed<>+0x7c30(SB)/8, $0x75c6aab83dd179d3
-DATA ·precomputed<>+0x7c38(SB)/8, $0xc41aa2ecc885a379
-DATA ·precomputed<>+0x7c40(SB)/8, $0xbefb6a4f08e9ba09
-DATA ·precomputed<>+0x7c48(SB)/8, $0xc6beedb8b0c1b6e1
-DATA ·precomputed<>+0x7c50(SB)/8, $0x59daf0573510ef35
-DATA ·precomputed<>+0x7c58(SB)/8, $0x604047cfdbbabc65
-DATA ·precomputed<>+0x7c60(SB)/8, $0xfabc80a8a06b7340
-DATA ·precomputed<>+0x7c68(SB)/8, $0x7433dee7df765977
-DATA ·precomputed<>+0x7c70(SB)/8, $0x149a2c4afd807cfb
-DATA ·precomputed<>+0x7c78(SB)/8, $0x14e8ad3b3480a086
-DATA ·precomputed<>+0x7c80(SB)/8, $0xcafb2f42d986c89f
-DATA ·precomputed<>+0x7c88(SB)/8, $0x1c121422434989ff
-DATA ·precomputed<>+0x7c90(SB)/8, $0x1ae4c73992d7d124
-DATA ·precomputed<>+0x7c98(SB)/8, $0xca030c7e265f1805
-DATA ·precomputed<>+0x7ca0(SB)/8, $0x73b19ccacdb34ca7
-DATA ·precomputed<>+0x7ca8(SB)/8, $0x725fedf3df2cfd8a
-DATA ·precomputed<>+0x7cb0(SB)/8, $0x717962d5962bea9a
-DATA ·precomputed<>+0x7cb8(SB)/8, $0x894b75f16d7a8e90
-DATA ·precomputed<>+0x7cc0(SB)/8, $0xb0c3156fb22c5f89
-DATA ·precomputed<>+0x7cc8(SB)/8, $0xd10ece4abf78675a
-DATA ·precomputed<>+0x7cd0(SB)/8, $0xe270e31780b8ad9f
-DATA ·precomputed<>+0x7cd8(SB)/8, $0xfe7a6210b0c2b420
-DATA ·precomputed<>+0x7ce0(SB)/8, $0xf091d738125ef635
-DATA ·precomputed<>+0x7ce8(SB)/8, $0xf1f277d6c1a6f202
-DATA ·precomputed<>+0x7cf0(SB)/8, $0xe2727e7b3587d9bb
-DATA ·precomputed<>+0x7cf8(SB)/8, $0x83b209a9b3e2b84b
-DATA ·precomputed<>+0x7d00(SB)/8, $0x7a1e7122cc7351de
-DATA ·precomputed<>+0x7d08(SB)/8, $0x9d2ae616267a98e0
-DATA ·precomputed<>+0x7d10(SB)/8, $0x77c497c853e10604
-DATA ·precomputed<>+0x7d18(SB)/8, $0x9958de38e58860db
-DATA ·precomputed<>+0x7d20(SB)/8, $0xc585b54e3e51046b
-DATA ·precomputed<>+0x7d28(SB)/8, $0x6205aecb66754df1
-DATA ·precomputed<>+0x7d30(SB)/8, $0x458a59ead85cade2
-DATA ·precomputed<>+0x7d38(SB)/8, $0xee79c42c8470f9d8
-DATA ·precomputed<>+0x7d40(SB)/8, $0xc9eb445d7a13effa
-DATA ·precomputed<>+0x7d48(SB)/8, $0x89b856f10d697480
-DATA ·precomputed<>+0x7d50(SB)/8, $0x834bbae225c03cb7
-DATA ·precomputed<>+0x7d58(SB)/8, $0xd8adb85e0b4a7b2
-DATA ·precomputed<>+0x7d60(SB)/8, $0x7b6884afc7fbc240
-DATA ·precomputed<>+0x7d68(SB)/8, $0x6b485409aa4f9097
-DATA ·precomputed<>+0x7d70(SB)/8, $0x4d0a367f290c106f
-DATA ·precomputed<>+0x7d78(SB)/8, $0xab87d2183f0efdfd
-DATA ·precomputed<>+0x7d80(SB)/8, $0xfadd0e317f95d3ca
-DATA ·precomputed<>+0x7d88(SB)/8, $0x5fb2a329cc3860f1
-DATA ·precomputed<>+0x7d90(SB)/8, $0xf56f710e405d98de
-DATA ·precomputed<>+0x7d98(SB)/8, $0xe7b5e6907b9df35
-DATA ·precomputed<>+0x7da0(SB)/8, $0x793584c2556ffd5e
-DATA ·precomputed<>+0x7da8(SB)/8, $0x1201aba71a8da80e
-DATA ·precomputed<>+0x7db0(SB)/8, $0xfd3f719803552fc0
-DATA ·precomputed<>+0x7db8(SB)/8, $0x10066a8ebf9ee5bf
-DATA ·precomputed<>+0x7dc0(SB)/8, $0x15b9bab750f2b65b
-DATA ·precomputed<>+0x7dc8(SB)/8, $0xa7403d4b5e5d53e4
-DATA ·precomputed<>+0x7dd0(SB)/8, $0x2e23e37628529212
-DATA ·precomputed<>+0x7dd8(SB)/8, $0x6fe903a26e050767
-DATA ·precomputed<>+0x7de0(SB)/8, $0x4c5291a16cf570fb
-DATA ·precomputed<>+0x7de8(SB)/8, $0x4bfb86077a30b326
-DATA ·precomputed<>+0x7df0(SB)/8, $0xec4905f827c572a9
-DATA ·precomputed<>+0x7df8(SB)/8, $0x72eeb8c90f381c31
-DATA ·precomputed<>+0x7e00(SB)/8, $0x25d254c366fd51a2
-DATA ·precomputed<>+0x7e08(SB)/8, $0xd39a589485a3e7f7
-DATA ·precomputed<>+0x7e10(SB)/8, $0x95cee32f81ef26f7
-DATA ·precomputed<>+0x7e18(SB)/8, $0xae3fc291eb6a4e12
-DATA ·precomputed<>+0x7e20(SB)/8, $0xdc1bad3d59c47abe
-DATA ·precomputed<>+0x7e28(SB)/8, $0x5216db040058a074
-DATA ·precomputed<>+0x7e30(SB)/8, $0xfc9725860d74d062
-DATA ·precomputed<>+0x7e38(SB)/8, $0xd0347350a11db37d
-DATA ·precomputed<>+0x7e40(SB)/8, $0x33346cec460adca0
-DATA ·precomputed<>+0x7e48(SB)/8, $0xd4d5bba87b34756a
-DATA ·precomputed<>+0x7e50(SB)/8, $0x2b2e2d4eac84add
-DATA ·precomputed<>+0x7e58(SB)/8, $0xa129845bdc1053b5
-DATA ·precomputed<>+0x7e60(SB)/8, $0x53f067e0dca6f9ce
-DATA ·precomputed<>+0x7e68(SB)/8, $0x6e9998ed3526aba6
-DATA ·precomputed<>+0x7e70(SB)/8, $0xa4aef9e21c0982da
-DATA ·precomputed<>+0x7e78(SB)/8, $0xfe5b606e93f5d96f
-DATA ·precomputed<>+0x7e80(SB)/8, $0xc190520918d229c4
-DATA ·precomputed<>+0x7e88(SB)/8, $0x7074c95eb66d624d
-DATA ·precomputed<>+0x7e90(SB)/8, $0xc66a1a770e93fe2c
-DATA ·precomputed<>+0x7e98(SB)/8, $0x34b04d13cc53abea
-DATA ·precomputed<>+0x7ea0(SB)/8, $0xb94463da8b17ae24
-DATA ·precomputed<>+0x7ea8(SB)/8, $0x6b16c58d7f7b8181
-DATA ·precomputed<>+0x7eb0(SB)/8, $0xb2fc63ac6ad50b21
-DATA ·precomputed<>+0x7eb8(SB)/8, $0xc44a0241cdc91db5
-DATA ·precomputed<>+0x7ec0(SB)/8, $0x26b8502e9c14b699
-DATA ·precomputed<>+0x7ec8(SB)/8, $0xf1bcdca60948a291
-DATA ·precomputed<>+0x7ed0(SB)/8, $0x73e43a322aefd415
-DATA ·precomputed<>+0x7ed8(SB)/8, $0x7f523357d1e2cfb5
-DATA ·precomputed<>+0x7ee0(SB)/8, $0xa60151c097d3fa94
-DATA ·precomputed<>+0x7ee8(SB)/8, $0x820c0d5872129630
-DATA ·precomputed<>+0x7ef0(SB)/8, $0xb8f2e1ed5854acf5
-DATA ·precomputed<>+0x7ef8(SB)/8, $0x86d6646c3c656ac3
-DATA ·precomputed<>+0x7f00(SB)/8, $0xf1d3fa80a7f17b84
-DATA ·precomputed<>+0x7f08(SB)/8, $0xc4276f86c2fb8992
-DATA ·precomputed<>+0x7f10(SB)/8, $0x53285e84d3f028b
-DATA ·precomputed<>+0x7f18(SB)/8, $0x158fb01366005fd9
-DATA ·precomputed<>+0x7f20(SB)/8, $0x5f3f0548332261d6
-DATA ·precomputed<>+0x7f28(SB)/8, $0xd4dc71ef5a2a96de
-DATA ·precomputed<>+0x7f30(SB)/8, $0x72c699ddd609a8b5
-DATA ·precomputed<>+0x7f38(SB)/8, $0xaa31bb6588146327
-DATA ·precomputed<>+0x7f40(SB)/8, $0x2284a612bef1d0da
-DATA ·precomputed<>+0x7f48(SB)/8, $0x2e7c5f4ea8c8faba
-DATA ·precomputed<>+0x7f50(SB)/8, $0xfd441ae770303ea3
-DATA ·precomputed<>+0x7f58(SB)/8, $0x9613f3295161cf82
-DATA ·precomputed<>+0x7f60(SB)/8, $0x65a3cc652e19531f
-DATA ·precomputed<>+0x7f68(SB)/8, $0x177a277534281f69
-DATA ·precomputed<>+0x7f70(SB)/8, $0xcc692a47c82e094
-DATA ·precomputed<>+0x7f78(SB)/8, $0x9d62a55bb6f377f0
-DATA ·precomputed<>+0x7f80(SB)/8, $0x4b4283e111d0802d
-DATA ·precomputed<>+0x7f88(SB)/8, $0x953b5aeec54f1df6
-DATA ·precomputed<>+0x7f90(SB)/8, $0x9e32cc186594bf9b
-DATA ·precomputed<>+0x7f98(SB)/8, $0x9b9e394a4b42dd12
-DATA ·precomputed<>+0x7fa0(SB)/8, $0xb042d956b5a3ef35
-DATA ·precomputed<>+0x7fa8(SB)/8, $0x85b93733976e976d
-DATA ·precomputed<>+0x7fb0(SB)/8, $0x1391258b978eea5b
-DATA ·precomputed<>+0x7fb8(SB)/8, $0xff4fef2b2315f553
-DATA ·precomputed<>+0x7fc0(SB)/8, $0xa24cf6acf96ec2b8
-DATA ·precomputed<>+0x7fc8(SB)/8, $0xd06747c3a961cc16
-DATA ·precomputed<>+0x7fd0(SB)/8, $0x57c7001cbd17f0a2
-DATA ·precomputed<>+0x7fd8(SB)/8, $0x5f298db034afe2d6
-DATA ·precomputed<>+0x7fe0(SB)/8, $0x51b01ef2df12f671
-DATA ·precomputed<>+0x7fe8(SB)/8, $0xc01c50665ce712fe
-DATA ·precomputed<>+0x7ff0(SB)/8, $0xac0f403492a74776
-DATA ·precomputed<>+0x7ff8(SB)/8, $0xa3e9934f08d696bd
-DATA ·precomputed<>+0x8000(SB)/8, $0x4599b8941abd31f0
-DATA ·precomputed<>+0x8008(SB)/8, $0xdb34198d9a1da7d3
-DATA ·precomputed<>+0x8010(SB)/8, $0xa8b89523a0f0217d
-DATA ·precomputed<>+0x8018(SB)/8, $0x2014cc43e56b884e
-DATA ·precomputed<>+0x8020(SB)/8, $0x6fb94f8849efd4ee
-DATA ·precomputed<>+0x8028(SB)/8, $0xf1b81710287f4ae0
-DATA ·precomputed<>+0x8030(SB)/8, $0x89d38a9a99fd2deb
-DATA ·precomputed<>+0x8038(SB)/8, $0x8179277a72b67a53
-DATA ·precomputed<>+0x8040(SB)/8, $0xdd5fee9e2d3a6e28
-DATA ·precomputed<>+0x8048(SB)/8, $0x8eed56d4686d8ca3
-DATA ·precomputed<>+0x8050(SB)/8, $0x36889a2778083491
-DATA ·precomputed<>+0x8058(SB)/8, $0xea1a6555bef20457
-DATA ·precomputed<>+0x8060(SB)/8, $0xe7e6b609a501e2a3
-DATA ·precomputed<>+0x8068(SB)/8, $0x1ea0ae29fb23de2f
-DATA ·precomputed<>+0x8070(SB)/8, $0x5f537d07632c9a6b
-DATA ·precomputed<>+0x8078(SB)/8, $0x61770d1f9a3db961
-DATA ·precomputed<>+0x8080(SB)/8, $0xce21d1fa9047d3c6
-DATA ·precomputed<>+0x8088(SB)/8, $0x847d15739e2d51ce
-DATA ·precomputed<>+0x8090(SB)/8, $0x80167a634eb10c9c
-DATA ·precomputed<>+0x8098(SB)/8, $0x8896686878c07623
-DATA ·precomputed<>+0x80a0(SB)/8, $0xee878f2cdc401ce6
-DATA ·precomputed<>+0x80a8(SB)/8, $0x7f46062a697d1c83
-DATA ·precomputed<>+0x80b0(SB)/8, $0x4a047da6862b7c2
-DATA ·precomputed<>+0x80b8(SB)/8, $0x68a2caccfafd636e
-DATA ·precomputed<>+0x80c0(SB)/8, $0x325c60db7497e8a5
-DATA ·precomputed<>+0x80c8(SB)/8, $0x5d8eab88c6949a9
-DATA ·precomputed<>+0x80d0(SB)/8, $0x3169e466c7bd5898
-DATA ·precomputed<>+0x80d8(SB)/8, $0xadc06264192d8e3f
-DATA ·precomputed<>+0x80e0(SB)/8, $0x1ff468f4d55959fe
-DATA ·precomputed<>+0x80e8(SB)/8, $0x97b33ee0202dba19
-DATA ·precomputed<>+0x80f0(SB)/8, $0xaa0c3fe221cf84bb
-DATA ·precomputed<>+0x80f8(SB)/8, $0x48cdc0af04a8d176
-DATA ·precomputed<>+0x8100(SB)/8, $0xe4f3730ec599b822
-DATA ·precomputed<>+0x8108(SB)/8, $0x821b583dc1db1fe7
-DATA ·precomputed<>+0x8110(SB)/8, $0xa5b97c09f68091cd
-DATA ·precomputed<>+0x8118(SB)/8, $0xbeb36d94a7afbcff
-DATA ·precomputed<>+0x8120(SB)/8, $0x2e71e9729ef451b2
-DATA ·precomputed<>+0x8128(SB)/8, $0xc20a694be72474cf
-DATA ·precomputed<>+0x8130(SB)/8, $0xd30f6220dd75c396
-DATA ·precomputed<>+0x8138(SB)/8, $0xe0b129df9fa41fa4
-DATA ·precomputed<>+0x8140(SB)/8, $0xfa9f7a8b81ba9b34
-DATA ·precomputed<>+0x8148(SB)/8, $0xe32ba9453196b512
-DATA ·precomputed<>+0x8150(SB)/8, $0x93a571c82476f535
-DATA ·precomputed<>+0x8158(SB)/8, $0xafdf29231810863f
-DATA ·precomputed<>+0x8160(SB)/8, $0x53bc9a3c16169f60
-DATA ·precomputed<>+0x8168(SB)/8, $0x2a0397f29b5c7c35
-DATA ·precomputed<>+0x8170(SB)/8, $0xf1059d5016d93e03
-DATA ·precomputed<>+0x8178(SB)/8, $0x5e6e9109388f5ef4
-DATA ·precomputed<>+0x8180(SB)/8, $0x40cea9ddaaa05d08
-DATA ·precomputed<>+0x8188(SB)/8, $0xd0caffaaa51b5a25
-DATA ·precomputed<>+0x8190(SB)/8, $0xd63010c8585d54b4
-DATA ·precomputed<>+0x8198(SB)/8, $0xc852d033be35b009
-DATA ·precomputed<>+0x81a0(SB)/8, $0xd508e50ef3df425c
-DATA ·precomputed<>+0x81a8(SB)/8, $0x9e459701ff86f10c
-DATA ·precomputed<>+0x81b0(SB)/8, $0xe36cccc2081059ff
-DATA ·precomputed<>+0x81b8(SB)/8, $0x48d3f14fe3989ff4
-DATA ·precomputed<>+0x81c0(SB)/8, $0x53d8c4489c0d4009
-DATA ·precomputed<>+0x81c8(SB)/8, $0xd37146172e24dbaa
-DATA ·precomputed<>+0x81d0(SB)/8, $0xdd92e7309b62e5f1
-DATA ·precomputed<>+0x81d8(SB)/8, $0x97b344d79922cc8a
-DATA ·precomputed<>+0x81e0(SB)/8, $0x416b009b0bfe3e8f
-DATA ·precomputed<>+0x81e8(SB)/8, $0x56873834f3c82269
-DATA ·precomputed<>+0x81f0(SB)/8, $0xf82a980fe6623555
-DATA ·precomputed<>+0x81f8(SB)/8, $0xb027ecaa5ce68e54
-DATA ·precomputed<>+0x8200(SB)/8, $0x721b4fc28724792d
-DATA ·precomputed<>+0x8208(SB)/8, $0xed871af80f1f97ff
-DATA ·precomputed<>+0x8210(SB)/8, $0x69121694b14f21d
-DATA ·precomputed<>+0x8218(SB)/8, $0x29b8f6d7624891df
-DATA ·precomputed<>+0x8220(SB)/8, $0xc1f6d556ac605453
-DATA ·precomputed<>+0x8228(SB)/8, $0x204d9dc1d3a0bf19
-DATA ·precomputed<>+0x8230(SB)/8, $0x61191ff5de357304
-DATA ·precomputed<>+0x8238(SB)/8, $0xc50edd164272990f
-DATA ·precomputed<>+0x8240(SB)/8, $0x1f6b82685af40af8
-DATA ·precomputed<>+0x8248(SB)/8, $0x7714a2cee9860c5e
-DATA ·precomputed<>+0x8250(SB)/8, $0x433476768a0b6868
-DATA ·precomputed<>+0x8258(SB)/8, $0x72ba0302862daec9
-DATA ·precomputed<>+0x8260(SB)/8, $0xe46d1d5939b642e4
-DATA ·precomputed<>+0x8268(SB)/8, $0x1c19391d97e5cc66
-DATA ·precomputed<>+0x8270(SB)/8, $0x467b8d5709fc7b3
-DATA ·precomputed<>+0x8278(SB)/8, $0xa65d787df30d5f30
-DATA ·precomputed<>+0x8280(SB)/8, $0x6bba85e9908f6e9b
-DATA ·precomputed<>+0x8288(SB)/8, $0xcf72a3854904c1b9
-DATA ·precomputed<>+0x8290(SB)/8, $0x8412227116b4394f
-DATA ·precomputed<>+0x8298(SB)/8, $0x2f9c1780b8456ab9
-DATA ·precomputed<>+0x82a0(SB)/8, $0xed5f8cab12b85e32
-DATA ·precomputed<>+0x82a8(SB)/8, $0xc01ad4383531c9dd
-DATA ·precomputed<>+0x82b0(SB)/8, $0x9100b303d1ef5650
-DATA ·precomputed<>+0x82b8(SB)/8, $0x4ea30af7430ff637
-DATA ·precomputed<>+0x82c0(SB)/8, $0x5a4b24fe87680d
-DATA ·precomputed<>+0x82c8(SB)/8, $0xd92532dc4cf6ee32
-DATA ·precomputed<>+0x82d0(SB)/8, $0xfcd27c8c4bd3e01f
-DATA ·precomputed<>+0x82d8(SB)/8, $0xda7d9949e1b59ffa
-DATA ·precomputed<>+0x82e0(SB)/8, $0xe3d5f31f2735d373
-DATA ·precomputed<>+0x82e8(SB)/8, $0x7e139ca5288e71fb
-DATA ·precomputed<>+0x82f0(SB)/8, $0xe474bc8093979cb4
-DATA ·precomputed<>+0x82f8(SB)/8, $0x7f4f6017f6fcc665
-DATA ·precomputed<>+0x8300(SB)/8, $0x2203a213db7ecc41
-DATA ·precomputed<>+0x8308(SB)/8, $0x881d27f1719fc68c
-DATA ·precomputed<>+0x8310(SB)/8, $0xa71d7e0009bbe63e
-DATA ·precomputed<>+0x8318(SB)/8, $0xe1a29f4a829463bf
-DATA ·precomputed<>+0x8320(SB)/8, $0x99333740ac0abe3e
-DATA ·precomputed<>+0x8328(SB)/8, $0x2a173747cd53a450
-DATA ·precomputed<>+0x8330(SB)/8, $0x9f58c07e5496fe0f
-DATA ·precomputed<>+0x8338(SB)/8, $0x23c5a9980a6f4327
-DATA ·precomputed<>+0x8340(SB)/8, $0x8a13f6839da39ff5
-DATA ·precomputed<>+0x8348(SB)/8, $0xfff72d44d030fbf4
-DATA ·precomputed<>+0x8350(SB)/8, $0x420cebb8d9ec8ed3
-DATA ·precomputed<>+0x8358(SB)/8, $0xb270310b971589f2
-DATA ·precomputed<>+0x8360(SB)/8, $0x997ee0275c02d985
-DATA ·precomputed<>+0x8368(SB)/8, $0x9bb7491deeb640a2
-DATA ·precomputed<>+0x8370(SB)/8, $0xbfa2d52856fb1799
-DATA ·precomputed<>+0x8378(SB)/8, $0x2ac7b51bacfcf1c6
-DATA ·precomputed<>+0x8380(SB)/8, $0x11f9b08db75023b3
-DATA ·precomputed<>+0x8388(SB)/8, $0x6b5876935b6fa25f
-DATA ·precomputed<>+0x8390(SB)/8, $0xa934de8ecba926d3
-DATA ·precomputed<>+0x8398(SB)/8, $0xbf1d092e9dbdf6d2
-DATA ·precomputed<>+0x83a0(SB)/8, $0x3ae034bec4166f96
-DATA ·precomputed<>+0x83a8(SB)/8, $0xa30c24a6938fc472
-DATA ·precomputed<>+0x83b0(SB)/8, $0x838748043beae15f
-DATA ·precomputed<>+0x83b8(SB)/8, $0xc347d0de88505f19
-DATA ·precomputed<>+0x83c0(SB)/8, $0xe6982c86fbd613c3
-DATA ·precomputed<>+0x83c8(SB)/8, $0xf4af69d5c366f17a
-DATA ·precomputed<>+0x83d0(SB)/8, $0x8683eed6b85c2343
-DATA ·precomputed<>+0x83d8(SB)/8, $0xf5bb244a24bc2116
-DATA ·precomputed<>+0x83e0(SB)/8, $0x997a74bcc9fc77d4
-DATA ·precomputed<>+0x83e8(SB)/8, $0xe202eb916f44b54b
-DATA ·precomputed<>+0x83f0(SB)/8, $0x77886412a6997e76
-DATA ·precomputed<>+0x83f8(SB)/8, $0x6996c8fb02c8837e
-DATA ·precomputed<>+0x8400(SB)/8, $0x86c9b3506335caa8
-DATA ·precomputed<>+0x8408(SB)/8, $0x6df932c387061705
-DATA ·precomputed<>+0x8410(SB)/8, $0xe825aa9ae82919df
-DATA ·precomputed<>+0x8418(SB)/8, $0xdf32b4946a5a049d
-DATA ·precomputed<>+0x8420(SB)/8, $0xbe540d6f9101574
-DATA ·precomputed<>+0x8428(SB)/8, $0x36a70fc28eac7341
-DATA ·precomputed<>+0x8430(SB)/8, $0x23b275e83948ef13
-DATA ·precomputed<>+0x8438(SB)/8, $0x29df13781f7ec610
-DATA ·precomputed<>+0x8440(SB)/8, $0x9a8fb8ee65c2a20a
-DATA ·precomputed<>+0x8448(SB)/8, $0x52525c74ee2f43c3
-DATA ·precomputed<>+0x8450(SB)/8, $0x88c398d8f3d1f920
-DATA ·precomputed<>+0x8458(SB)/8, $0x2e3337a4779fac21
-DATA ·precomputed<>+0x8460(SB)/8, $0xedc2fe09672ed805
-DATA ·precomputed<>+0x8468(SB)/8, $0x40f4e35ed16eebfc
-DATA ·precomputed<>+0x8470(SB)/8, $0xf6316ca188b23b36
-DATA ·precomputed<>+0x8478(SB)/8, $0x972728d02460a9e5
-DATA ·precomputed<>+0x8480(SB)/8, $0xb9e810b322c8ab41
-DATA ·precomputed<>+0x8488(SB)/8, $0x7ba3a9d69ec04532
-DATA ·precomputed<>+0x8490(SB)/8, $0x61f6bdfd6fec2aca
-DATA ·precomputed<>+0x8498(SB)/8, $0xc3572d56eff3180b
-DATA ·precomputed<>+0x84a0(SB)/8, $0xe7e25240309d6b31
-DATA ·precomputed<>+0x84a8(SB)/8, $0x1de25c0f18cdc5b0
-DATA ·precomputed<>+0x84b0(SB)/8, $0xa230a5d175bf8071
-DATA ·precomputed<>+0x84b8(SB)/8, $0x99cc79b145a978c0
-DATA ·precomputed<>+0x84c0(SB)/8, $0x986df8a2c61382c
-DATA ·precomputed<>+0x84c8(SB)/8, $0x90607b92667c8ee9
-DATA ·precomputed<>+0x84d0(SB)/8, $0x51fcbf7084eacde
-DATA ·precomputed<>+0x84d8(SB)/8, $0x84e3dba46e685877
-DATA ·precomputed<>+0x84e0(SB)/8, $0x35861a82e458da50
-DATA ·precomputed<>+0x84e8(SB)/8, $0xd036823fcf392b51
-DATA ·precomputed<>+0x84f0(SB)/8, $0x431814813dd86e74
-DATA ·precomputed<>+0x84f8(SB)/8, $0x8dcfe17d3741a385
-DATA ·precomputed<>+0x8500(SB)/8, $0xeeaec241b936a540
-DATA ·precomputed<>+0x8508(SB)/8, $0x9b5da217743a7e76
-DATA ·precomputed<>+0x8510(SB)/8, $0xc9f874c891a1ff14
-DATA ·precomputed<>+0x8518(SB)/8, $0xc5945b544eade7e9
-DATA ·precomputed<>+0x8520(SB)/8, $0x862dc18cd905e087
-DATA ·precomputed<>+0x8528(SB)/8, $0xd437732f92d088e
-DATA ·precomputed<>+0x8530(SB)/8, $0x19b127a22436f280
-DATA ·precomputed<>+0x8538(SB)/8, $0xf34115e70eedccbe
-DATA ·precomputed<>+0x8540(SB)/8, $0xec24f4544d23b333
-DATA ·precomputed<>+0x8548(SB)/8, $0x27759b77f21d320c
-DATA ·precomputed<>+0x8550(SB)/8, $0x534348ed306af706
-DATA ·precomputed<>+0x8558(SB)/8, $0xcd5309e8192c1560
-DATA ·precomputed<>+0x8560(SB)/8, $0xcadb6010cbb95748
-DATA ·precomputed<>+0x8568(SB)/8, $0x4c30cf775510d2eb
-DATA ·precomputed<>+0x8570(SB)/8, $0x2c00ab0f56174360
-DATA ·precomputed<>+0x8578(SB)/8, $0xaa16857c3e6344d1
-DATA ·precomputed<>+0x8580(SB)/8, $0x93f15c5e55b59390
-DATA ·precomputed<>+0x8588(SB)/8, $0xa0ddb2ff1c1697a3
-DATA ·precomputed<>+0x8590(SB)/8, $0xd341594f1030f70b
-DATA ·precomputed<>+0x8598(SB)/8, $0xc4887cb5aa691d9d
-DATA ·precomputed<>+0x85a0(SB)/8, $0xd49bdbf2f9c06de4
-DATA ·precomputed<>+0x85a8(SB)/8, $0x7d75bce43d654c93
-DATA ·precomputed<>+0x85b0(SB)/8, $0x28e5db5deece42eb
-DATA ·precomputed<>+0x85b8(SB)/8, $0x4bc8af6939d35678
-DATA ·precomputed<>+0x85c0(SB)/8, $0x8e1a77cf40f56786
-DATA ·precomputed<>+0x85c8(SB)/8, $0xc5bca7f66d4b7774
-DATA ·precomputed<>+0x85d0(SB)/8, $0x86b588a0c81ec077
-DATA ·precomputed<>+0x85d8(SB)/8, $0x88952a019206354f
-DATA ·precomputed<>+0x85e0(SB)/8, $0x5444a98943a8519a
-DATA ·precomputed<>+0x85e8(SB)/8, $0xe29dd68c2857b210
-DATA ·precomputed<>+0x85f0(SB)/8, $0x366589039a144624
-DATA ·precomputed<>+0x85f8(SB)/8, $0x8c4dedb0e423e899
-DATA ·precomputed<>+0x8600(SB)/8, $0x758f489c4c8a8003
-DATA ·precomputed<>+0x8608(SB)/8, $0x1cea0ab1e9844117
-DATA ·precomputed<>+0x8610(SB)/8, $0x32ac6d213eddf7cc
-DATA ·precomputed<>+0x8618(SB)/8, $0x52e3ad04594db642
-DATA ·precomputed<>+0x8620(SB)/8, $0xb3348e121d9e13a4
-DATA ·precomputed<>+0x8628(SB)/8, $0x313e445b4ad0f953
-DATA ·precomputed<>+0x8630(SB)/8, $0x135350f3a2cde1ce
-DATA ·precomputed<>+0x8638(SB)/8, $0xde0ef45ca0fd6347
-DATA ·precomputed<>+0x8640(SB)/8, $0xe72a2fadc32071ba
-DATA ·precomputed<>+0x8648(SB)/8, $0x471191b390796e6
-DATA ·precomputed<>+0x8650(SB)/8, $0xbacb3ce68d80bc91
-DATA ·precomputed<>+0x8658(SB)/8, $0x76b89c1e892b607e
-DATA ·precomputed<>+0x8660(SB)/8, $0xe2dc412560359180
-DATA ·precomputed<>+0x8668(SB)/8, $0xb9dccb88f4360acf
-DATA ·precomputed<>+0x8670(SB)/8, $0xdd3ff3a67a266501
-DATA ·precomputed<>+0x8678(SB)/8, $0xecbba7b26028ba03
-DATA ·precomputed<>+0x8680(SB)/8, $0x424ea200eb3a81e8
-DATA ·precomputed<>+0x8688(SB)/8, $0xafe630257d111e71
-DATA ·precomputed<>+0x8690(SB)/8, $0x733dc441e72665b5
-DATA ·precomputed<>+0x8698(SB)/8, $0xe9f182ad1f4bfd55
-DATA ·precomputed<>+0x86a0(SB)/8, $0x6e898047a11214e6
-DATA ·precomputed<>+0x86a8(SB)/8, $0x6c833e12d7912979
-DATA ·precomputed<>+0x86b0(SB)/8, $0xdf2c232ebcb175da
-DATA ·precomputed<>+0x86b8(SB)/8, $0x7f46ec3b7bbdfd14
-DATA ·precomputed<>+0x86c0(SB)/8, $0x482040c5ea886e5c
-DATA ·precomputed<>+0x86c8(SB)/8, $0x42fe5a561cdd50f7
-DATA ·precomputed<>+0x86d0(SB)/8, $0xf034f132453b6e7f
-DATA ·precomputed<>+0x86d8(SB)/8, $0xba3fa97db3c69762
-DATA ·precomputed<>+0x86e0(SB)/8, $0x34262560cadb598e
-DATA ·precomputed<>+0x86e8(SB)/8, $0x7ed74b5107afe0a8
-DATA ·precomputed<>+0x86f0(SB)/8, $0x2261d849ebe0e8bb
-DATA ·precomputed<>+0x86f8(SB)/8, $0x23747e55608cea1c
-DATA ·precomputed<>+0x8700(SB)/8, $0xa4c77fde037812bc
-DATA ·precomputed<>+0x8708(SB)/8, $0x7dc4abd41ba3ec9a
-DATA ·precomputed<>+0x8710(SB)/8, $0xce2358ffcce48298
-DATA ·precomputed<>+0x8718(SB)/8, $0xbf4f2cc0c0225abc
-DATA ·precomputed<>+0x8720(SB)/8, $0x8532fbbfea481196
-DATA ·precomputed<>+0x8728(SB)/8, $0xe9b1f8ef28b8ead9
-DATA ·precomputed<>+0x8730(SB)/8, $0x11f341be9fe194d
-DATA ·precomputed<>+0x8738(SB)/8, $0x40c34b773d921d42
-DATA ·precomputed<>+0x8740(SB)/8, $0xc87cb477b8e332bc
-DATA ·precomputed<>+0x8748(SB)/8, $0x3f60922780e94b07
-DATA ·precomputed<>+0x8750(SB)/8, $0xae88cd00b1a7c0c8
-DATA ·precomputed<>+0x8758(SB)/8, $0x943a5c6b590ab6ad
-DATA ·precomputed<>+0x8760(SB)/8, $0x94034e48d11f6c2d
-DATA ·precomputed<>+0x8768(SB)/8, $0x2ca67c23b14e8077
-DATA ·precomputed<>+0x8770(SB)/8, $0x964a70064b5790b
-DATA ·precomputed<>+0x8778(SB)/8, $0xd8332a32a0eefdf7
-DATA ·precomputed<>+0x8780(SB)/8, $0x6f4c20ca36a57192
-DATA ·precomputed<>+0x8788(SB)/8, $0xb4d9d5c35fa3e38
-DATA ·precomputed<>+0x8790(SB)/8, $0x9faffd784c47a1e3
-DATA ·precomputed<>+0x8798(SB)/8, $0x54cc483359b2948d
-DATA ·precomputed<>+0x87a0(SB)/8, $0x1586a3545f9955ad
-DATA ·precomputed<>+0x87a8(SB)/8, $0xb40fc9c6d6180885
-DATA ·precomputed<>+0x87b0(SB)/8, $0xfe41c2046e24f1c4
-DATA ·precomputed<>+0x87b8(SB)/8, $0xb15efc161894494
-DATA ·precomputed<>+0x87c0(SB)/8, $0xb2e9371c902c343d
-DATA ·precomputed<>+0x87c8(SB)/8, $0xf57b2de8da4fdba1
-DATA ·precomputed<>+0x87d0(SB)/8, $0x43f9afa4b67703a1
-DATA ·precomputed<>+0x87d8(SB)/8, $0xeafafb41f79fe203
-DATA ·precomputed<>+0x87e0(SB)/8, $0xfec99dc9f649a494
-DATA ·precomputed<>+0x87e8(SB)/8, $0x14799ef9fe378232
-DATA ·precomputed<>+0x87f0(SB)/8, $0xba3f81147184e31e
-DATA ·precomputed<>+0x87f8(SB)/8, $0xabbb815dc0e987f
-DATA ·precomputed<>+0x8800(SB)/8, $0xd4085efcb4e31aa4
-DATA ·precomputed<>+0x8808(SB)/8, $0xc760aec018b26adf
-DATA ·precomputed<>+0x8810(SB)/8, $0x14c1f78e76a7400d
-DATA ·precomputed<>+0x8818(SB)/8, $0x87b8aced317fe128
-DATA ·precomputed<>+0x8820(SB)/8, $0x4433582ecbd85bb4
-DATA ·precomputed<>+0x8828(SB)/8, $0x58f0142686adc041
-DATA ·precomputed<>+0x8830(SB)/8, $0x3596dd508f0d5781
-DATA ·precomputed<>+0x8838(SB)/8, $0x2e7f3b801a31a82f
-DATA ·precomputed<>+0x8840(SB)/8, $0x2d832a29161f6475
-DATA ·precomputed<>+0x8848(SB)/8, $0x435b8d78fc8797eb
-DATA ·precomputed<>+0x8850(SB)/8, $0x66bc156dd71b609c
-DATA ·precomputed<>+0x8858(SB)/8, $0xb3dca798fe0c2004
-DATA ·precomputed<>+0x8860(SB)/8, $0x445d47bf02fd92d7
-DATA ·precomputed<>+0x8868(SB)/8, $0x1d1c9798c8b03083
-DATA ·precomputed<>+0x8870(SB)/8, $0xca46d98d079a7c51
-DATA ·precomputed<>+0x8878(SB)/8, $0xb93f286c1afeb89a
-DATA ·precomputed<>+0x8880(SB)/8, $0xb853465a3a4a0217
-DATA ·precomputed<>+0x8888(SB)/8, $0x74a2534f66f3a4d5
-DATA ·precomputed<>+0x8890(SB)/8, $0xae1a7ff3eff0423b
-DATA ·precomputed<>+0x8898(SB)/8, $0xd2a01a09bb126028
-DATA ·precomputed<>+0x88a0(SB)/8, $0xff84c6f04963e855
-DATA ·precomputed<>+0x88a8(SB)/8, $0x63db264c6bc18d50
-DATA ·precomputed<>+0x88b0(SB)/8, $0x39792dcacc6a5e25
-DATA ·precomputed<>+0x88b8(SB)/8, $0xf20cdf3eedb37a25
-DATA ·precomputed<>+0x88c0(SB)/8, $0x40372d2d4919affe
-DATA ·precomputed<>+0x88c8(SB)/8, $0x2d4a2ea3a009bd16
-DATA ·precomputed<>+0x88d0(SB)/8, $0x48f1e7f8c1a62eb1
-DATA ·precomputed<>+0x88d8(SB)/8, $0xce083725587a1124
-DATA ·precomputed<>+0x88e0(SB)/8, $0xe874261be7ebadd3
-DATA ·precomputed<>+0x88e8(SB)/8, $0x7ca5c156057b93e9
-DATA ·precomputed<>+0x88f0(SB)/8, $0xe8b381e5fe39e6ff
-DATA ·precomputed<>+0x88f8(SB)/8, $0x2d16b32fd30815c3
-DATA ·precomputed<>+0x8900(SB)/8, $0x3eb4b147000e61fa
-DATA ·precomputed<>+0x8908(SB)/8, $0xe61cd10137468b9c
-DATA ·precomputed<>+0x8910(SB)/8, $0x6e4e442dcb977ba7
-DATA ·precomputed<>+0x8918(SB)/8, $0x8fb87a927b6c74c3
-DATA ·precomputed<>+0x8920(SB)/8, $0x40c646890ff86679
-DATA ·precomputed<>+0x8928(SB)/8, $0xdaaa2f7e29beb5ae
-DATA ·precomputed<>+0x8930(SB)/8, $0x674207bc8981c97f
-DATA ·precomputed<>+0x8938(SB)/8, $0x1baa33b41a0c396c
-DATA ·precomputed<>+0x8940(SB)/8, $0x843c4a918b31da95
-DATA ·precomputed<>+0x8948(SB)/8, $0x7b78ce9f9a088fbc
-DATA ·precomputed<>+0x8950(SB)/8, $0x637c5fa30a84e1f
-DATA ·precomputed<>+0x8958(SB)/8, $0x779a84f0ff5c8f9
-DATA ·precomputed<>+0x8960(SB)/8, $0xd3ccc1f8adf896ce
-DATA ·precomputed<>+0x8968(SB)/8, $0xf6a7ab8715dcb51d
-DATA ·precomputed<>+0x8970(SB)/8, $0x79d5be19f197e966
-DATA ·precomputed<>+0x8978(SB)/8, $0x1e1e6362e73039e5
-DATA ·precomputed<>+0x8980(SB)/8, $0xe572f99ec311b4dd
-DATA ·precomputed<>+0x8988(SB)/8, $0x797078532112e1db
-DATA ·precomputed<>+0x8990(SB)/8, $0x21e5ebe809c34185
-DATA ·precomputed<>+0x8998(SB)/8, $0x5ff5d66a907d25c1
-DATA ·precomputed<>+0x89a0(SB)/8, $0x197afae4a412f005
-DATA ·precomputed<>+0x89a8(SB)/8, $0x41dbd8f1a661c190
-DATA ·precomputed<>+0x89b0(SB)/8, $0x699dab106b703e1
-DATA ·precomputed<>+0x89b8(SB)/8, $0x3b5cd2617913fe00
-DATA ·precomputed<>+0x89c0(SB)/8, $0xdfd30b28ad2e996a
-DATA ·precomputed<>+0x89c8(SB)/8, $0x64d4eeec9df0fc37
-DATA ·precomputed<>+0x89d0(SB)/8, $0x8e049e3dddc39763
-DATA ·precomputed<>+0x89d8(SB)/8, $0x9f55e0ce37ea2e8e
-DATA ·precomputed<>+0x89e0(SB)/8, $0xf3fcba4e4bf01984
-DATA ·precomputed<>+0x89e8(SB)/8, $0x764d5c3d9d55bc4b
-DATA ·precomputed<>+0x89f0(SB)/8, $0x98cb92a1cfda895f
-DATA ·precomputed<>+0x89f8(SB)/8, $0x27dfe7955c7bca69
-DATA ·precomputed<>+0x8a00(SB)/8, $0xc1257cb15602ee59
-DATA ·precomputed<>+0x8a08(SB)/8, $0xd1efae3e1b715bcd
-DATA ·precomputed<>+0x8a10(SB)/8, $0xcb2983223405bbfe
-DATA ·precomputed<>+0x8a18(SB)/8, $0x5b98ec5e04b9252f
-DATA ·precomputed<>+0x8a20(SB)/8, $0xfeb3e47fec27f320
-DATA ·precomputed<>+0x8a28(SB)/8, $0x2e71731dfa0eeae4
-DATA ·precomputed<>+0x8a30(SB)/8, $0x827678ef727b35e4
-DATA ·precomputed<>+0x8a38(SB)/8, $0x2273dfe2cf120c6a
-DATA ·precomputed<>+0x8a40(SB)/8, $0x439966e0a2c61004
-DATA ·precomputed<>+0x8a48(SB)/8, $0xebd321af28f76b0f
-DATA ·precomputed<>+0x8a50(SB)/8, $0xccfda35fce801b4
-DATA ·precomputed<>+0x8a58(SB)/8, $0x14429b678880f161
-DATA ·precomputed<>+0x8a60(SB)/8, $0x8cb93848f039eb99
-DATA ·precomputed<>+0x8a68(SB)/8, $0xc18961b758b0333
-DATA ·precomputed<>+0x8a70(SB)/8, $0x387ce1f5694c1378
-DATA ·precomputed<>+0x8a78(SB)/8, $0x9a819678dbdf8a77
-DATA ·precomputed<>+0x8a80(SB)/8, $0x91c00fe232d62fcd
-DATA ·precomputed<>+0x8a88(SB)/8, $0xc71b5901eff72017
-DATA ·precomputed<>+0x8a90(SB)/8, $0xf50744f5c89c0e7c
-DATA ·precomputed<>+0x8a98(SB)/8, $0x9dc0beb90d4b9c2c
-DATA ·precomputed<>+0x8aa0(SB)/8, $0x9fa24fc14c178d57
-DATA ·precomputed<>+0x8aa8(SB)/8, $0xb2f3368cf3ff0b3e
-DATA ·precomputed<>+0x8ab0(SB)/8, $0x9f1c175e5be5a6d8
-DATA ·precomputed<>+0x8ab8(SB)/8, $0x63abecc8bab5b61c
-DATA ·precomputed<>+0x8ac0(SB)/8, $0xa82d127df32d462
-DATA ·precomputed<>+0x8ac8(SB)/8, $0x2b2d2a55b353d137
-DATA ·precomputed<>+0x8ad0(SB)/8, $0xec57e59161a2347
-DATA ·precomputed<>+0x8ad8(SB)/8, $0xef4ff6ea05ff8b45
-DATA ·precomputed<>+0x8ae0(SB)/8, $0xc2b0400ac40a9d45
-DATA ·precomputed<>+0x8ae8(SB)/8, $0x9ecfec500596718f
-DATA ·precomputed<>+0x8af0(SB)/8, $0x66c183470f7a0dba
-DATA ·precomputed<>+0x8af8(SB)/8, $0x4f1aef694d4cacc0
-DATA ·precomputed<>+0x8b00(SB)/8, $0x2a221c62a196e8a2
-DATA ·precomputed<>+0x8b08(SB)/8, $0xb1413633c9d7c368
-DATA ·precomputed<>+0x8b10(SB)/8, $0x69041a31c35323d6
-DATA ·precomputed<>+0x8b18(SB)/8, $0x6123f1bb293e6203
-DATA ·precomputed<>+0x8b20(SB)/8, $0xb44dd7a44e6b85cb
-DATA ·precomputed<>+0x8b28(SB)/8, $0xcdd70ef032a0f068
-DATA ·precomputed<>+0x8b30(SB)/8, $0xb950e697246f764d
-DATA ·precomputed<>+0x8b38(SB)/8, $0x530786d2726a154f
-DATA ·precomputed<>+0x8b40(SB)/8, $0x904448329f5f4daf
-DATA ·precomputed<>+0x8b48(SB)/8, $0x9f89f47d4a9bdbe5
-DATA ·precomputed<>+0x8b50(SB)/8, $0xcfccee649ae1c490
-DATA ·precomputed<>+0x8b58(SB)/8, $0xb69eab8141ca0033
-DATA ·precomputed<>+0x8b60(SB)/8, $0xa5407f2613701912
-DATA ·precomputed<>+0x8b68(SB)/8, $0x48b9622ef1368055
-DATA ·precomputed<>+0x8b70(SB)/8, $0x79df582e2e1bf611
-DATA ·precomputed<>+0x8b78(SB)/8, $0xad44a4a7450b18df
-DATA ·precomputed<>+0x8b80(SB)/8, $0xa7e751c0f168e32
-DATA ·precomputed<>+0x8b88(SB)/8, $0x7a7d705ecd9f53a0
-DATA ·precomputed<>+0x8b90(SB)/8, $0x37694465ad4a9e2a
-DATA ·precomputed<>+0x8b98(SB)/8, $0xeb54b040e2ed6bf0
-DATA ·precomputed<>+0x8ba0(SB)/8, $0xa3b06a3124a3c34b
-DATA ·precomputed<>+0x8ba8(SB)/8, $0x7b76958362e5fc60
-DATA ·precomputed<>+0x8bb0(SB)/8, $0xaa7e04363c4489e8
-DATA ·precomputed<>+0x8bb8(SB)/8, $0xacf1bcd19b54c94e
-DATA ·precomputed<>+0x8bc0(SB)/8, $0x86dfdecf23a86e2c
-DATA ·precomputed<>+0x8bc8(SB)/8, $0x2ac466b3387f712
-DATA ·precomputed<>+0x8bd0(SB)/8, $0xc30a1ac2d63509d1
-DATA ·precomputed<>+0x8bd8(SB)/8, $0xd391342263aebbd1
-DATA ·precomputed<>+0x8be0(SB)/8, $0xdc7b789f068ae34f
-DATA ·precomputed<>+0x8be8(SB)/8, $0x499f2d01487dcd10
-DATA ·precomputed<>+0x8bf0(SB)/8, $0x68e2a3bca3e8a4b4
-DATA ·precomputed<>+0x8bf8(SB)/8, $0xdf87ba7114d2a26c
-DATA ·precomputed<>+0x8c00(SB)/8, $0x7c948fd9c9569a77
-DATA ·precomputed<>+0x8c08(SB)/8, $0xeadfd879c229f2f1
-DATA ·precomputed<>+0x8c10(SB)/8, $0xcb88d7100dc1be24
-DATA ·precomputed<>+0x8c18(SB)/8, $0xb49ec735338c29cf
-DATA ·precomputed<>+0x8c20(SB)/8, $0x35da1140c53aa340
-DATA ·precomputed<>+0x8c28(SB)/8, $0x5eca72e8cdfc6a97
-DATA ·precomputed<>+0x8c30(SB)/8, $0xa93b4f1c955fed54
-DATA ·precomputed<>+0x8c38(SB)/8, $0x8dfca3f03e000946
-DATA ·precomputed<>+0x8c40(SB)/8, $0x910dd01df32ef27d
-DATA ·precomputed<>+0x8c48(SB)/8, $0xbdff53dd8bfb6fdf
-DATA ·precomputed<>+0x8c50(SB)/8, $0x91e37ec2873ff98d
-DATA ·precomputed<>+0x8c58(SB)/8, $0x87248c3c945810fc
-DATA ·precomputed<>+0x8c60(SB)/8, $0xcf7e0e0f5a468687
-DATA ·precomputed<>+0x8c68(SB)/8, $0xdbfc3cdac9febdfb
-DATA ·precomputed<>+0x8c70(SB)/8, $0x200e4f5f0a20f8e2
-DATA ·precomputed<>+0x8c78(SB)/8, $0xe8d4d44153766eca
-DATA ·precomputed<>+0x8c80(SB)/8, $0x5f92cc3811624778
-DATA ·precomputed<>+0x8c88(SB)/8, $0x818c1fddfe2ee7d9
-DATA ·precomputed<>+0x8c90(SB)/8, $0xa65a22441b5d3766
-DATA ·precomputed<>+0x8c98(SB)/8, $0xd903b5ab58d91086
-DATA ·precomputed<>+0x8ca0(SB)/8, $0x10062612d050a791
-DATA ·precomputed<>+0x8ca8(SB)/8, $0x325ce834c7450649
-DATA ·precomputed<>+0x8cb0(SB)/8, $0xf8beec1f05f38c48
-DATA ·precomputed<>+0x8cb8(SB)/8, $0x5f2367c128b31b09
-DATA ·precomputed<>+0x8cc0(SB)/8, $0x1f5826848490bfdd
-DATA ·precomputed<>+0x8cc8(SB)/8, $0xb81059add5430227
-DATA ·precomputed<>+0x8cd0(SB)/8, $0xd5557551b17ae001
-DATA ·precomputed<>+0x8cd8(SB)/8, $0x69a0e4f00a9c0449
-DATA ·precomputed<>+0x8ce0(SB)/8, $0xab72ffb5aa7f70c1
-DATA ·precomputed<>+0x8ce8(SB)/8, $0xac4e4ba02e46ce9a
-DATA ·precomputed<>+0x8cf0(SB)/8, $0xe56ecf595ba020f9
-DATA ·precomputed<>+0x8cf8(SB)/8, $0xb74c5d6559374477
-DATA ·precomputed<>+0x8d00(SB)/8, $0x8daa80969c34a71e
-DATA ·precomputed<>+0x8d08(SB)/8, $0x1604ca0483367823
-DATA ·precomputed<>+0x8d10(SB)/8, $0xbbe939b727416401
-DATA ·precomputed<>+0x8d18(SB)/8, $0x802ce7a14545cc1e
-DATA ·precomputed<>+0x8d20(SB)/8, $0x7e90c3068346f5fa
-DATA ·precomputed<>+0x8d28(SB)/8, $0xf88d71294b856d30
-DATA ·precomputed<>+0x8d30(SB)/8, $0x857ac126289f125f
-DATA ·precomputed<>+0x8d38(SB)/8, $0x42a7b0151423080f
-DATA ·precomputed<>+0x8d40(SB)/8, $0x9e27b0725ec18c6b
-DATA ·precomputed<>+0x8d48(SB)/8, $0x53cf3088c8e70ecc
-DATA ·precomputed<>+0x8d50(SB)/8, $0x3ff717aca1b4df2b
-DATA ·precomputed<>+0x8d58(SB)/8, $0x2bd5d3c045c5b2
-DATA ·precomputed<>+0x8d60(SB)/8, $0xfa248bb66c5e6574
-DATA ·precomputed<>+0x8d68(SB)/8, $0x3fe2e0b4d6c3f52e
-DATA ·precomputed<>+0x8d70(SB)/8, $0xdd20f400d62e158f
-DATA ·precomputed<>+0x8d78(SB)/8, $0x5d5f248365b0e822
-DATA ·precomputed<>+0x8d80(SB)/8, $0x8922ff85c77083a3
-DATA ·precomputed<>+0x8d88(SB)/8, $0x55b2bc137ea12a22
-DATA ·precomputed<>+0x8d90(SB)/8, $0xa86208c3fdf5eac8
-DATA ·precomputed<>+0x8d98(SB)/8, $0x2a81dffdd37de93f
-DATA ·precomputed<>+0x8da0(SB)/8, $0x98ba49388c2b7d80
-DATA ·precomputed<>+0x8da8(SB)/8, $0x930bd0de51865ca6
-DATA ·precomputed<>+0x8db0(SB)/8, $0x4139d871a263bdbc
-DATA ·precomputed<>+0x8db8(SB)/8, $0xd91d1bee822e77a3
-DATA ·precomputed<>+0x8dc0(SB)/8, $0x9e3ab99934479e7b
-DATA ·precomputed<>+0x8dc8(SB)/8, $0x9d5f2dc3b026e780
-DATA ·precomputed<>+0x8dd0(SB)/8, $0x131374fd4f1bd429
-DATA ·precomputed<>+0x8dd8(SB)/8, $0x92e2e7389be1379a
-DATA ·precomputed<>+0x8de0(SB)/8, $0x6cc32f80d13bc111
-DATA ·precomputed<>+0x8de8(SB)/8, $0x6fbfc35086f81c92
-DATA ·precomputed<>+0x8df0(SB)/8, $0x12ca1b309263913a
-DATA ·precomputed<>+0x8df8(SB)/8, $0x6f7da1ffe06ab75c
-DATA ·precomputed<>+0x8e00(SB)/8, $0x415ce5bd9e8bc345
-DATA ·precomputed<>+0x8e08(SB)/8, $0x28b0daec3e5eb55c
-DATA ·precomputed<>+0x8e10(SB)/8, $0x9451469554c6e543
-DATA ·precomputed<>+0x8e18(SB)/8, $0x87daebc0d1fb9912
-DATA ·precomputed<>+0x8e20(SB)/8, $0x5c118126d9394322
-DATA ·precomputed<>+0x8e28(SB)/8, $0xccfa1bd82af964a1
-DATA ·precomputed<>+0x8e30(SB)/8, $0xbc7d8b8a348640f3
-DATA ·precomputed<>+0x8e38(SB)/8, $0x9db664261af0a554
-DATA ·precomputed<>+0x8e40(SB)/8, $0x4602aa32766c056d
-DATA ·precomputed<>+0x8e48(SB)/8, $0x59619e89620d9999
-DATA ·precomputed<>+0x8e50(SB)/8, $0xacf4955c147ff36b
-DATA ·precomputed<>+0x8e58(SB)/8, $0xe4bbbbaf92b26fb2
-DATA ·precomputed<>+0x8e60(SB)/8, $0x5fef9f5e4032138e
-DATA ·precomputed<>+0x8e68(SB)/8, $0x8b3f6408c2870538
-DATA ·precomputed<>+0x8e70(SB)/8, $0x4a5b0e361b1379aa
-DATA ·precomputed<>+0x8e78(SB)/8, $0x53303c2ec886b77e
-DATA ·precomputed<>+0x8e80(SB)/8, $0x1b62531f049bd4f1
-DATA ·precomputed<>+0x8e88(SB)/8, $0xd853307623482b1a
-DATA ·precomputed<>+0x8e90(SB)/8, $0xa09625a6af229540
-DATA ·precomputed<>+0x8e98(SB)/8, $0x67db97d8e9c9ffda
-DATA ·precomputed<>+0x8ea0(SB)/8, $0x8dbb6f5824e5fe4b
-DATA ·precomputed<>+0x8ea8(SB)/8, $0xaf1680bcb89bea0f
-DATA ·precomputed<>+0x8eb0(SB)/8, $0x4fd8b6bb2e402aa3
-DATA ·precomputed<>+0x8eb8(SB)/8, $0x800ae3213971439a
-DATA ·precomputed<>+0x8ec0(SB)/8, $0xd94bea765fe235a8
-DATA ·precomputed<>+0x8ec8(SB)/8, $0x64be929f6de31524
-DATA ·precomputed<>+0x8ed0(SB)/8, $0x6ed505fa080ebfbb
-DATA ·precomputed<>+0x8ed8(SB)/8, $0xe03434e24a6db7ec
-DATA ·precomputed<>+0x8ee0(SB)/8, $0x21006ebad02efcc6
-DATA ·precomputed<>+0x8ee8(SB)/8, $0xf23ba6a09651266d
-DATA ·precomputed<>+0x8ef0(SB)/8, $0x96fc0cc05d52a6a6
-DATA ·precomputed<>+0x8ef8(SB)/8, $0x48d95322b6ce09c5
-DATA ·precomputed<>+0x8f00(SB)/8, $0xb1233469d21d03b1
-DATA ·precomputed<>+0x8f08(SB)/8, $0xa83d4d5f7bd5f899
-DATA ·precomputed<>+0x8f10(SB)/8, $0x78ceac8ba830f007
-DATA ·precomputed<>+0x8f18(SB)/8, $0x6843a18907a92eb
-DATA ·precomputed<>+0x8f20(SB)/8, $0x43efabc83897fdb
-DATA ·precomputed<>+0x8f28(SB)/8, $0x1d15ad58afbfcf46
-DATA ·precomputed<>+0x8f30(SB)/8, $0xe0149f03bd467ae5
-DATA ·precomputed<>+0x8f38(SB)/8, $0x1c35a5fd0b8b0e73
-DATA ·precomputed<>+0x8f40(SB)/8, $0x1807d61b1b38dbf6
-DATA ·precomputed<>+0x8f48(SB)/8, $0x69fb46e9383bc76f
-DATA ·precomputed<>+0x8f50(SB)/8, $0x4e7f0856c46a9682
-DATA ·precomputed<>+0x8f58(SB)/8, $0xb869e00836670993
-DATA ·precomputed<>+0x8f60(SB)/8, $0x6e9bf3a475840174
-DATA ·precomputed<>+0x8f68(SB)/8, $0x9aff07b2abdcd89
-DATA ·precomputed<>+0x8f70(SB)/8, $0xac7425f580133b5e
-DATA ·precomputed<>+0x8f78(SB)/8, $0x43358c9ccbad334f
-DATA ·precomputed<>+0x8f80(SB)/8, $0x457407fd20ce84b1
-DATA ·precomputed<>+0x8f88(SB)/8, $0x13e810d489c91921
-DATA ·precomputed<>+0x8f90(SB)/8, $0x57efc7ac03db0cb9
-DATA ·precomputed<>+0x8f98(SB)/8, $0x96bfbc63c2c2eee6
-DATA ·precomputed<>+0x8fa0(SB)/8, $0x8bb966aad092cef0
-DATA ·precomputed<>+0x8fa8(SB)/8, $0xc056ccfbc893f61e
-DATA ·precomputed<>+0x8fb0(SB)/8, $0x3be48333b046ee3c
-DATA ·precomputed<>+0x8fb8(SB)/8, $0x6c3d2670857e366d
-DATA ·precomputed<>+0x8fc0(SB)/8, $0x4780f12a19301b16
-DATA ·precomputed<>+0x8fc8(SB)/8, $0x233bc231bc368a20
-DATA ·precomputed<>+0x8fd0(SB)/8, $0xd9650892cbadb344
-DATA ·precomputed<>+0x8fd8(SB)/8, $0x38a0d964ad9425a1
-DATA ·precomputed<>+0x8fe0(SB)/8, $0x277abcf24a8d4d7e
-DATA ·precomputed<>+0x8fe8(SB)/8, $0x4ccd16b1b806ce9e
-DATA ·precomputed<>+0x8ff0(SB)/8, $0x82ff40f07570d473
-DATA ·precomputed<>+0x8ff8(SB)/8, $0x57491414df130780
-DATA ·precomputed<>+0x9000(SB)/8, $0x8d8d41205d8f6fab
-DATA ·precomputed<>+0x9008(SB)/8, $0x5e420839abed09c8
-DATA ·precomputed<>+0x9010(SB)/8, $0x5120e0794aacbb1e
-DATA ·precomputed<>+0x9018(SB)/8, $0xf7e840f8ec1bc996
-DATA ·precomputed<>+0x9020(SB)/8, $0xd921d63b9707c3bb
-DATA ·precomputed<>+0x9028(SB)/8, $0x3ab4b8b8ae891a71
-DATA ·precomputed<>+0x9030(SB)/8, $0xbe46575673bb2278
-DATA ·precomputed<>+0x9038(SB)/8, $0x776ce040553ee91c
-DATA ·precomputed<>+0x9040(SB)/8, $0xebadb010fdc7945f
-DATA ·precomputed<>+0x9048(SB)/8, $0x62c9cff003750a4b
-DATA ·precomputed<>+0x9050(SB)/8, $0x75192383a0c85b9d
-DATA ·precomputed<>+0x9058(SB)/8, $0x2aba7b5316eb0bf9
-DATA ·precomputed<>+0x9060(SB)/8, $0x93d4adaaa4c06f9a
-DATA ·precomputed<>+0x9068(SB)/8, $0x573a86a25f8e482c
-DATA ·precomputed<>+0x9070(SB)/8, $0x109c6fdce791a79f
-DATA ·precomputed<>+0x9078(SB)/8, $0xd9ed02ceb37eeec2
-DATA ·precomputed<>+0x9080(SB)/8, $0x321119d38fc7ea72
-DATA ·precomputed<>+0x9088(SB)/8, $0x390b4ef57211ef45
-DATA ·precomputed<>+0x9090(SB)/8, $0x289f39451feda1a2
-DATA ·precomputed<>+0x9098(SB)/8, $0xcee9cb33605c8596
-DATA ·precomputed<>+0x90a0(SB)/8, $0x91109e96971b6897
-DATA ·precomputed<>+0x90a8(SB)/8, $0xdf70c17319701ea7
-DATA ·precomputed<>+0x90b0(SB)/8, $0xa07d0ecda92c6b2b
-DATA ·precomputed<>+0x90b8(SB)/8, $0xf8eb97fea9eab766
-DATA ·precomputed<>+0x90c0(SB)/8, $0x86f18c448b63b669
-DATA ·precomputed<>+0x90c8(SB)/8, $0x53c6eba66972d2d1
-DATA ·precomputed<>+0x90d0(SB)/8, $0x2a522d8c8cff59bc
-DATA ·precomputed<>+0x90d8(SB)/8, $0xbc181d156ed25ce5
-DATA ·precomputed<>+0x90e0(SB)/8, $0x913f173d5feb0eca
-DATA ·precomputed<>+0x90e8(SB)/8, $0x34273f8da207bd71
-DATA ·precomputed<>+0x90f0(SB)/8, $0x41b7572efa1715ae
-DATA ·precomputed<>+0x90f8(SB)/8, $0x8a8ffea27f16f4ae
-DATA ·precomputed<>+0x9100(SB)/8, $0x5ed75e681e13eaee
-DATA ·precomputed<>+0x9108(SB)/8, $0xbebd474409dafdb9
-DATA ·precomputed<>+0x9110(SB)/8, $0x8b46621f69941fc4
-DATA ·precomputed<>+0x9118(SB)/8, $0x1fd3c13f91129bc0
-DATA ·precomputed<>+0x9120(SB)/8, $0x371448d0b7b9da22
-DATA ·precomputed<>+0x9128(SB)/8, $0xd452ccead87a4742
-DATA ·precomputed<>+0x9130(SB)/8, $0xe2f04778f91c38b9
-DATA ·precomputed<>+0x9138(SB)/8, $0xfb443a5dbd516bfe
-DATA ·precomputed<>+0x9140(SB)/8, $0xe21ab4797e8215d8
-DATA ·precomputed<>+0x9148(SB)/8, $0x84384686923b4b27
-DATA ·precomputed<>+0x9150(SB)/8, $0xa93c08fe6a3d21ef
-DATA ·precomputed<>+0x9158(SB)/8, $0x7bd962482fa6de1c
-DATA ·precomputed<>+0x9160(SB)/8, $0xb858ecd7ca6de3e0
-DATA ·precomputed<>+0x9168(SB)/8, $0x466a48c847c9fced
-DATA ·precomputed<>+0x9170(SB)/8, $0x23ca9b75c708239e
-DATA ·precomputed<>+0x9178(SB)/8, $0x860d553db5bbe833
-DATA ·precomputed<>+0x9180(SB)/8, $0xe66ce02a5022112c
-DATA ·precomputed<>+0x9188(SB)/8, $0x8415df811461b1c6
-DATA ·precomputed<>+0x9190(SB)/8, $0xc2546e6aad247c38
-DATA ·precomputed<>+0x9198(SB)/8, $0x4b9788e69a9c74d6
-DATA ·precomputed<>+0x91a0(SB)/8, $0x9d0cb2e0a22be3e8
-DATA ·precomputed<>+0x91a8(SB)/8, $0x15db086c295f76ad
-DATA ·precomputed<>+0x91b0(SB)/8, $0x9758f99ba2337670
-DATA ·precomputed<>+0x91b8(SB)/8, $0x61ae09bb9ab57f54
-DATA ·precomputed<>+0x91c0(SB)/8, $0xf356917a2f8ebcf5
-DATA ·precomputed<>+0x91c8(SB)/8, $0x6f2c400b953f056c
-DATA ·precomputed<>+0x91d0(SB)/8, $0x9d9ac41dd84bb48
-DATA ·precomputed<>+0x91d8(SB)/8, $0x50dc7a8ea61e98e3
-DATA ·precomputed<>+0x91e0(SB)/8, $0x1179a9d33d3a6776
-DATA ·precomputed<>+0x91e8(SB)/8, $0xdda312e716de8b3e
-DATA ·precomputed<>+0x91f0(SB)/8, $0x62a8b7c3ce6e2bea
-DATA ·precomputed<>+0x91f8(SB)/8, $0x2b00036c645e4ca0
-DATA ·precomputed<>+0x9200(SB)/8, $0xadc17a4ec4c2be6c
-DATA ·precomputed<>+0x9208(SB)/8, $0x4523b21566bf583f
-DATA ·precomputed<>+0x9210(SB)/8, $0xfdbd1730c01451d2
-DATA ·precomputed<>+0x9218(SB)/8, $0x99860b5b711604c0
-DATA ·precomputed<>+0x9220(SB)/8, $0x4746f5e944ec0a41
-DATA ·precomputed<>+0x9228(SB)/8, $0x621ed51225bbed7
-DATA ·precomputed<>+0x9230(SB)/8, $0x817825e0bc23090c
-DATA ·precomputed<>+0x9238(SB)/8, $0x37ef4902e012a9cf
-DATA ·precomputed<>+0x9240(SB)/8, $0x2b19489219e1dd37
-DATA ·precomputed<>+0x9248(SB)/8, $0xbe04d2c7cbdd694
-DATA ·precomputed<>+0x9250(SB)/8, $0x2f05f516771dee2c
-DATA ·precomputed<>+0x9258(SB)/8, $0x9844405b016758b0
-DATA ·precomputed<>+0x9260(SB)/8, $0xa5ea254e29f4714
-DATA ·precomputed<>+0x9268(SB)/8, $0xb28be26399b9cbb0
-DATA ·precomputed<>+0x9270(SB)/8, $0xdcfaabd8d24603db
-DATA ·precomputed<>+0x9278(SB)/8, $0xc3817fa2cfc5003
-DATA ·precomputed<>+0x9280(SB)/8, $0xe652f91504d558dc
-DATA ·precomputed<>+0x9288(SB)/8, $0x7a5c09480f6728c5
-DATA ·precomputed<>+0x9290(SB)/8, $0x89994dc1fbfa79b7
-DATA ·precomputed<>+0x9298(SB)/8, $0x1007588fc47e18e5
-DATA ·precomputed<>+0x92a0(SB)/8, $0x2e53d9b1d1182a24
-DATA ·precomputed<>+0x92a8(SB)/8, $0xa97b67a72df01d5
-DATA ·precomputed<>+0x92b0(SB)/8, $0x3dee35a2b40b87b
-DATA ·precomputed<>+0x92b8(SB)/8, $0xcfd37df6dcb0c8c0
-DATA ·precomputed<>+0x92c0(SB)/8, $0xda4a4c577847ce81
-DATA ·precomputed<>+0x92c8(SB)/8, $0xb0a684e1fa3f8d6
-DATA ·precomputed<>+0x92d0(SB)/8, $0xcc96115adb4dcb7a
-DATA ·precomputed<>+0x92d8(SB)/8, $0x96e75435024de3f6
-DATA ·precomputed<>+0x92e0(SB)/8, $0x98c439d1fa7aaa89
-DATA ·precomputed<>+0x92e8(SB)/8, $0x674817482b15aea7
-DATA ·precomputed<>+0x92f0(SB)/8, $0x9d46df705045921e
-DATA ·precomputed<>+0x92f8(SB)/8, $0x9f98e0586867b5d1
-DATA ·precomputed<>+0x9300(SB)/8, $0x9ce030d176524a44
-DATA ·precomputed<>+0x9308(SB)/8, $0x996d3a1c0ade5589
-DATA ·precomputed<>+0x9310(SB)/8, $0x8b147fa27cbe7914
-DATA ·precomputed<>+0x9318(SB)/8, $0x5b4ce04ddd1c032d
-DATA ·precomputed<>+0x9320(SB)/8, $0x600b1745cb4c8141
-DATA ·precomputed<>+0x9328(SB)/8, $0x28779f36ee6ad393
-DATA ·precomputed<>+0x9330(SB)/8, $0x6946a0dbbc2efc19
-DATA ·precomputed<>+0x9338(SB)/8, $0x7c19c0e3a23f5dcc
-DATA ·precomputed<>+0x9340(SB)/8, $0x7d12764676668ad5
-DATA ·precomputed<>+0x9348(SB)/8, $0x439cdcad46b6a95b
-DATA ·precomputed<>+0x9350(SB)/8, $0x516d1ea0fa49a680
-DATA ·precomputed<>+0x9358(SB)/8, $0x314da769d644fc50
-DATA ·precomputed<>+0x9360(SB)/8, $0x52799f6a9fcb2e5a
-DATA ·precomputed<>+0x9368(SB)/8, $0xed729bbe02b7ec37
-DATA ·precomputed<>+0x9370(SB)/8, $0xb8cb72ed2fbc63e
-DATA ·precomputed<>+0x9378(SB)/8, $0x92ec48d7d6094bf6
-DATA ·precomputed<>+0x9380(SB)/8, $0x1090536c33a819a
-DATA ·precomputed<>+0x9388(SB)/8, $0xc52f13ecc7b1cce6
-DATA ·precomputed<>+0x9390(SB)/8, $0x99774e9daa3c8ba7
-DATA ·precomputed<>+0x9398(SB)/8, $0xce628bcd728db5f9
-DATA ·precomputed<>+0x93a0(SB)/8, $0x38f1bd8ac1c80442
-DATA ·precomputed<>+0x93a8(SB)/8, $0x7a4585b23feab811
-DATA ·precomputed<>+0x93b0(SB)/8, $0xe75af0115edd134
-DATA ·precomputed<>+0x93b8(SB)/8, $0x89741b5cf1589951
-DATA ·precomputed<>+0x93c0(SB)/8, $0x9ad2cbd7ab6cf0b4
-DATA ·precomputed<>+0x93c8(SB)/8, $0x7a1e67f4f13d1ddf
-DATA ·precomputed<>+0x93d0(SB)/8, $0xa58f0c73746003ba
-DATA ·precomputed<>+0x93d8(SB)/8, $0x8263e888a64a8fcc
-DATA ·precomputed<>+0x93e0(SB)/8, $0x535cbe37be2452f7
-DATA ·precomputed<>+0x93e8(SB)/8, $0x931257666ae81a76
-DATA ·precomputed<>+0x93f0(SB)/8, $0x7d2ed0ab3a553701
-DATA ·precomputed<>+0x93f8(SB)/8, $0x93d7e7dfb0717d78
-DATA ·precomputed<>+0x9400(SB)/8, $0x2aebe45901a62d89
-DATA ·precomputed<>+0x9408(SB)/8, $0xa47a7b8812e7ae36
-DATA ·precomputed<>+0x9410(SB)/8, $0x30daae74d574c383
-DATA ·precomputed<>+0x9418(SB)/8, $0x20ebeb87ebef31a1
-DATA ·precomputed<>+0x9420(SB)/8, $0x267ff69746396442
-DATA ·precomputed<>+0x9428(SB)/8, $0x604069cfd27e9142
-DATA ·precomputed<>+0x9430(SB)/8, $0x8cc19ef064f073ca
-DATA ·precomputed<>+0x9438(SB)/8, $0x99aaee3f8093ea88
-DATA ·precomputed<>+0x9440(SB)/8, $0xf2ec7cce1b655db5
-DATA ·precomputed<>+0x9448(SB)/8, $0xd51c75bdfb600dd0
-DATA ·precomputed<>+0x9450(SB)/8, $0x714271377b593e7d
-DATA ·precomputed<>+0x9458(SB)/8, $0x62221bfcfcf55a46
-DATA ·precomputed<>+0x9460(SB)/8, $0x18469b05baf83d8
-DATA ·precomputed<>+0x9468(SB)/8, $0xc4806b203fab6f6c
-DATA ·precomputed<>+0x9470(SB)/8, $0x4c08ff03c81a5e0f
-DATA ·precomputed<>+0x9478(SB)/8, $0x8b12617cf3080b80
-DATA ·precomputed<>+0x9480(SB)/8, $0xc66bce9e2bb749b2
-DATA ·precomputed<>+0x9488(SB)/8, $0xd8be5fb32ba8c907
-DATA ·precomputed<>+0x9490(SB)/8, $0x24c0517686e91042
-DATA ·precomputed<>+0x9498(SB)/8, $0xae6d58d94b9ef4b1
-DATA ·precomputed<>+0x94a0(SB)/8, $0xe20ce0937ac59db7
-DATA ·precomputed<>+0x94a8(SB)/8, $0x419e187439c64ac0
-DATA ·precomputed<>+0x94b0(SB)/8, $0x769f50054622922b
-DATA ·precomputed<>+0x94b8(SB)/8, $0x81682264c73ff8e6
-DATA ·precomputed<>+0x94c0(SB)/8, $0x9a6bccd97e734552
-DATA ·precomputed<>+0x94c8(SB)/8, $0x979a109c4952a6f6
-DATA ·precomputed<>+0x94d0(SB)/8, $0xd9ae4b6b53249d48
-DATA ·precomputed<>+0x94d8(SB)/8, $0xb2d1c3d0ff657e2b
-DATA ·precomputed<>+0x94e0(SB)/8, $0x4c2b9a8be02a1637
-DATA ·precomputed<>+0x94e8(SB)/8, $0x3bc8d284f300cf14
-DATA ·precomputed<>+0x94f0(SB)/8, $0x7bcced03a5ca5b49
-DATA ·precomputed<>+0x94f8(SB)/8, $0x37700806bdee3388
-DATA ·precomputed<>+0x9500(SB)/8, $0x8f24fc46035e658e
-DATA ·precomputed<>+0x9508(SB)/8, $0xac27ced93911eabf
-DATA ·precomputed<>+0x9510(SB)/8, $0x552fcace036f9514
-DATA ·precomputed<>+0x9518(SB)/8, $0xd2de4ebbd4a81dd
-DATA ·precomputed<>+0x9520(SB)/8, $0xa71e15951fab7776
-DATA ·precomputed<>+0x9528(SB)/8, $0x741a8fa81118f25
-DATA ·precomputed<>+0x9530(SB)/8, $0x1d6b62c8b6c08ee3
-DATA ·precomputed<>+0x9538(SB)/8, $0x8dc50083f8735756
-DATA ·precomputed<>+0x9540(SB)/8, $0x43f813dd5a917cac
-DATA ·precomputed<>+0x9548(SB)/8, $0xbfb3c074047ee75f
-DATA ·precomputed<>+0x9550(SB)/8, $0x841cf3a4054e4a4b
-DATA ·precomputed<>+0x9558(SB)/8, $0xc0393bbe572ec006
-DATA ·precomputed<>+0x9560(SB)/8, $0x54e68ed0087f320b
-DATA ·precomputed<>+0x9568(SB)/8, $0xcca9d8f12daafb7b
-DATA ·precomputed<>+0x9570(SB)/8, $0x2f64ceba157f68d
-DATA ·precomputed<>+0x9578(SB)/8, $0xfe66f5ae53c56aa
-DATA ·precomputed<>+0x9580(SB)/8, $0x924f24291f1ef9b6
-DATA ·precomputed<>+0x9588(SB)/8, $0x6208b649bd41a02d
-DATA ·precomputed<>+0x9590(SB)/8, $0xbcde59012a13c078
-DATA ·precomputed<>+0x9598(SB)/8, $0xeb5fdb55fc5925e4
-DATA ·precomputed<>+0x95a0(SB)/8, $0xd2dcb08af4a1d392
-DATA ·precomputed<>+0x95a8(SB)/8, $0x6ac2656a083fbb7e
-DATA ·precomputed<>+0x95b0(SB)/8, $0x9d726903808148a7
-DATA ·precomputed<>+0x95b8(SB)/8, $0x3219787c8697b102
-DATA ·precomputed<>+0x95c0(SB)/8, $0xd3d1eede06d18b28
-DATA ·precomputed<>+0x95c8(SB)/8, $0xbc90fd8a0333c57
-DATA ·precomputed<>+0x95d0(SB)/8, $0x848b66a55e9cfcd4
-DATA ·precomputed<>+0x95d8(SB)/8, $0x3fe3ae3d39c11934
-DATA ·precomputed<>+0x95e0(SB)/8, $0xb44a66a976fbbb4e
-DATA ·precomputed<>+0x95e8(SB)/8, $0x6de50d3a9f1a9953
-DATA ·precomputed<>+0x95f0(SB)/8, $0x18c3e3237b649f7a
-DATA ·precomputed<>+0x95f8(SB)/8, $0xa3c10eaff3dbbc8
-DATA ·precomputed<>+0x9600(SB)/8, $0x560ff085ac874d1d
-DATA ·precomputed<>+0x9608(SB)/8, $0xf2064a1d6ceb7d01
-DATA ·precomputed<>+0x9610(SB)/8, $0x50453b0907e0c776
-DATA ·precomputed<>+0x9618(SB)/8, $0xc2ac6b42a88093cf
-DATA ·precomputed<>+0x9620(SB)/8, $0x5f643d74b2d5cbc0
-DATA ·precomputed<>+0x9628(SB)/8, $0x9a9f895a356e816a
-DATA ·precomputed<>+0x9630(SB)/8, $0x158c1cba95e062eb
-DATA ·precomputed<>+0x9638(SB)/8, $0x50496c4c2fcf63a5
-DATA ·precomputed<>+0x9640(SB)/8, $0x73f2aaca9ce61daa
-DATA ·precomputed<>+0x9648(SB)/8, $0xaf01ebaecd395b78
-DATA ·precomputed<>+0x9650(SB)/8, $0x31ad73b24c1d0728
-DATA ·precomputed<>+0x9658(SB)/8, $0x383a36f892606e27
-DATA ·precomputed<>+0x9660(SB)/8, $0x79c4fb3361b86dca
-DATA ·precomputed<>+0x9668(SB)/8, $0x2702aa8c73ff73e6
-DATA ·precomputed<>+0x9670(SB)/8, $0x6adcfa8b8c9200b4
-DATA ·precomputed<>+0x9678(SB)/8, $0x3413938d3b0132ba
-DATA ·precomputed<>+0x9680(SB)/8, $0x4163bf858bc23b47
-DATA ·precomputed<>+0x9688(SB)/8, $0x6327aad590121613
-DATA ·precomputed<>+0x9690(SB)/8, $0x9e347bf6e428ac4
-DATA ·precomputed<>+0x9698(SB)/8, $0x601267ff1bc5eb48
-DATA ·precomputed<>+0x96a0(SB)/8, $0x8a57cfe6bf9badd7
-DATA ·precomputed<>+0x96a8(SB)/8, $0x5f4c24c3b8cdef68
-DATA ·precomputed<>+0x96b0(SB)/8, $0x91d59d7adcc37abf
-DATA ·precomputed<>+0x96b8(SB)/8, $0xbc4b46066084da7
-DATA ·precomputed<>+0x96c0(SB)/8, $0x4b88edb92717bc37
-DATA ·precomputed<>+0x96c8(SB)/8, $0x3d6452d3b405430d
-DATA ·precomputed<>+0x96d0(SB)/8, $0x6d3058dce7ea8c5d
-DATA ·precomputed<>+0x96d8(SB)/8, $0x27b34eec5f55254e
-DATA ·precomputed<>+0x96e0(SB)/8, $0x4eee18f1f9681f2d
-DATA ·precomputed<>+0x96e8(SB)/8, $0x4a759a8da27e2d9c
-DATA ·precomputed<>+0x96f0(SB)/8, $0x1a1dc5241526e89
-DATA ·precomputed<>+0x96f8(SB)/8, $0xdde2d31715f7a360
-DATA ·precomputed<>+0x9700(SB)/8, $0xd388e1a9d183d9c
-DATA ·precomputed<>+0x9708(SB)/8, $0x5d805bfbd2dadc3e
-DATA ·precomputed<>+0x9710(SB)/8, $0x9a9d5c43fc8785c2
-DATA ·precomputed<>+0x9718(SB)/8, $0xa06580151b6af96f
-DATA ·precomputed<>+0x9720(SB)/8, $0x91046332bf145018
-DATA ·precomputed<>+0x9728(SB)/8, $0x45087340f665cc0c
-DATA ·precomputed<>+0x9730(SB)/8, $0x25708291a5927203
-DATA ·precomputed<>+0x9738(SB)/8, $0x26cc8eda7e1dcec2
-DATA ·precomputed<>+0x9740(SB)/8, $0x4fc677334d1783ed
-DATA ·precomputed<>+0x9748(SB)/8, $0x2d203e7d54f96551
-DATA ·precomputed<>+0x9750(SB)/8, $0x93c199537159e52b
-DATA ·precomputed<>+0x9758(SB)/8, $0xb3aacbaefe32fa2
-DATA ·precomputed<>+0x9760(SB)/8, $0xf8255b2ce7969bc5
-DATA ·precomputed<>+0x9768(SB)/8, $0x8a5556398fe9f6a7
-DATA ·precomputed<>+0x9770(SB)/8, $0x96a4fbafc092efa7
-DATA ·precomputed<>+0x9778(SB)/8, $0x319b31878e702820
-DATA ·precomputed<>+0x9780(SB)/8, $0x79c82dd25afd564a
-DATA ·precomputed<>+0x9788(SB)/8, $0x97412b200f46fb7b
-DATA ·precomputed<>+0x9790(SB)/8, $0x44e3c1af9f97739f
-DATA ·precomputed<>+0x9798(SB)/8, $0xfe278f3beb2a58c0
-DATA ·precomputed<>+0x97a0(SB)/8, $0x7d7bd11bc62380fe
-DATA ·precomputed<>+0x97a8(SB)/8, $0xc82bf9f66a8eca92
-DATA ·precomputed<>+0x97b0(SB)/8, $0xc363fb7ac4b70806
-DATA ·precomputed<>+0x97b8(SB)/8, $0x9a588938405464c0
-DATA ·precomputed<>+0x97c0(SB)/8, $0x61bc013cf9cf03ba
-DATA ·precomputed<>+0x97c8(SB)/8, $0x36223b88feee3a78
-DATA ·precomputed<>+0x97d0(SB)/8, $0x86efc9233d7e4c95
-DATA ·precomputed<>+0x97d8(SB)/8, $0xaf3801be965625e4
-DATA ·precomputed<>+0x97e0(SB)/8, $0xa7bba1413f32fd9d
-DATA ·precomputed<>+0x97e8(SB)/8, $0x70724dec4e564acb
-DATA ·precomputed<>+0x97f0(SB)/8, $0x52587f32b7edcac1
-DATA ·precomputed<>+0x97f8(SB)/8, $0xb1cd94cb3969985
-DATA ·precomputed<>+0x9800(SB)/8, $0xc9e4aa244e1c5e60
-DATA ·precomputed<>+0x9808(SB)/8, $0x54bb528913253d51
-DATA ·precomputed<>+0x9810(SB)/8, $0xf4a86ab39bbabe53
-DATA ·precomputed<>+0x9818(SB)/8, $0xd561feae6ac0aa64
-DATA ·precomputed<>+0x9820(SB)/8, $0x27a896ab1911bad7
-DATA ·precomputed<>+0x9828(SB)/8, $0x9cb22b9864337303
-DATA ·precomputed<>+0x9830(SB)/8, $0xf14262fb161f6928
-DATA ·precomputed<>+0x9838(SB)/8, $0x1661885c59ba6c9f
-DATA ·precomputed<>+0x9840(SB)/8, $0x27e19335f576cb3c
-DATA ·precomputed<>+0x9848(SB)/8, $0xe16e4573dfb3b78f
-DATA ·precomputed<>+0x9850(SB)/8, $0xaf96d78563da0001
-DATA ·precomputed<>+0x9858(SB)/8, $0xb38deafdf7444c5e
-DATA ·precomputed<>+0x9860(SB)/8, $0xaca6b38cc0eb0e28
-DATA ·precomputed<>+0x9868(SB)/8, $0xa6ca35417fe41b98
-DATA ·precomputed<>+0x9870(SB)/8, $0xfeb37b4718965b31
-DATA ·precomputed<>+0x9878(SB)/8, $0x739cc322597d5bc6
-DATA ·precomputed<>+0x9880(SB)/8, $0xb969bd3c1570d6e9
-DATA ·precomputed<>+0x9888(SB)/8, $0x7350b9dbe0fb517e
-DATA ·precomputed<>+0x9890(SB)/8, $0x83b142e55aa543b
-DATA ·precomputed<>+0x9898(SB)/8, $0x424757beaa2bc581
-DATA ·precomputed<>+0x98a0(SB)/8, $0x4bd50f6469ea3302
-DATA ·precomputed<>+0x98a8(SB)/8, $0x53dcf83ed4c8288
-DATA ·precomputed<>+0x98b0(SB)/8, $0xac2b3074c118ac52
-DATA ·precomputed<>+0x98b8(SB)/8, $0x57f066a8e76ca88b
-DATA ·precomputed<>+0x98c0(SB)/8, $0xe19fac99a211ffd3
-DATA ·precomputed<>+0x98c8(SB)/8, $0x408f94243f1f6bca
-DATA ·precomputed<>+0x98d0(SB)/8, $0x1f5b76d1a5648244
-DATA ·precomputed<>+0x98d8(SB)/8, $0xef3942e895b2bd67
-DATA ·precomputed<>+0x98e0(SB)/8, $0x1b9dee7fb538f1d7
-DATA ·precomputed<>+0x98e8(SB)/8, $0x1cb78620444b8f85
-DATA ·precomputed<>+0x98f0(SB)/8, $0x9f8ecd63cb8ea6a3
-DATA ·precomputed<>+0x98f8(SB)/8, $0xca111b2eb9d3b71f
-DATA ·precomputed<>+0x9900(SB)/8, $0x11aa7070b110cffe
-DATA ·precomputed<>+0x9908(SB)/8, $0x91a100d9755605d
-DATA ·precomputed<>+0x9910(SB)/8, $0xc5a0c654d27d86a6
-DATA ·precomputed<>+0x9918(SB)/8, $0x1031a244db30feaa
-DATA ·precomputed<>+0x9920(SB)/8, $0x36804045c02228d9
-DATA ·precomputed<>+0x9928(SB)/8, $0x1877fc678b746039
-DATA ·precomputed<>+0x9930(SB)/8, $0xba554015e09bb238
-DATA ·precomputed<>+0x9938(SB)/8, $0xc50d8f921de9b40d
-DATA ·precomputed<>+0x9940(SB)/8, $0xd397edcd833396ea
-DATA ·precomputed<>+0x9948(SB)/8, $0x78a75693ed5e6747
-DATA ·precomputed<>+0x9950(SB)/8, $0xf2c844ba1a5f8260
-DATA ·precomputed<>+0x9958(SB)/8, $0xbcafe59d5fb9fec5
-DATA ·precomputed<>+0x9960(SB)/8, $0xa2413d5fd3147e7e
-DATA ·precomputed<>+0x9968(SB)/8, $0x130dd9e3afdf26cd
-DATA ·precomputed<>+0x9970(SB)/8, $0x44be87ec9ad1abde
-DATA ·precomputed<>+0x9978(SB)/8, $0xe925c4956e77fbe8
-DATA ·precomputed<>+0x9980(SB)/8, $0x98c5d7682c16bb1a
-DATA ·precomputed<>+0x9988(SB)/8, $0xbf91b62dee31dc08
-DATA ·precomputed<>+0x9990(SB)/8, $0xe9ad408d33a54959
-DATA ·precomputed<>+0x9998(SB)/8, $0x9d754a6438b7170b
-DATA ·precomputed<>+0x99a0(SB)/8, $0x106db7bcd9d6da2b
-DATA ·precomputed<>+0x99a8(SB)/8, $0xf556cbb4add533af
-DATA ·precomputed<>+0x99b0(SB)/8, $0x62db0de0f16d3b58
-DATA ·precomputed<>+0x99b8(SB)/8, $0x78a1b0be1fa579ba
-DATA ·precomputed<>+0x99c0(SB)/8, $0x3cc00e21091e1c4c
-DATA ·precomputed<>+0x99c8(SB)/8, $0xd500db44867ccc75
-DATA ·precomputed<>+0x99d0(SB)/8, $0xa8e2e84bf5ebbbe4
-DATA ·precomputed<>+0x99d8(SB)/8, $0xc3266881c4279ac0
-DATA ·precomputed<>+0x99e0(SB)/8, $0x2e8fb4de7a170658
-DATA ·precomputed<>+0x99e8(SB)/8, $0x219c5ec151da4a2e
-DATA ·precomputed<>+0x99f0(SB)/8, $0xda69a3fdeeacee19
-DATA ·precomputed<>+0x99f8(SB)/8, $0x9d4c6fbd30462243
-DATA ·precomputed<>+0x9a00(SB)/8, $0x8e06336c55ffea13
-DATA ·precomputed<>+0x9a08(SB)/8, $0xdeaeda00a1f99f5
-DATA ·precomputed<>+0x9a10(SB)/8, $0x9b738c4bfda98fc9
-DATA ·precomputed<>+0x9a18(SB)/8, $0x61cc613a59c98ba
-DATA ·precomputed<>+0x9a20(SB)/8, $0x5ceb5b83b477692c
-DATA ·precomputed<>+0x9a28(SB)/8, $0x5db775591fcc473b
-DATA ·precomputed<>+0x9a30(SB)/8, $0x77214b6283df476c
-DATA ·precomputed<>+0x9a38(SB)/8, $0x2ffac971427ea01d
-DATA ·precomputed<>+0x9a40(SB)/8, $0x35ecb4e20a6dc5b9
-DATA ·precomputed<>+0x9a48(SB)/8, $0x8b3fc2c851c54ed2
-DATA ·precomputed<>+0x9a50(SB)/8, $0x59f86eb9eede13d
-DATA ·precomputed<>+0x9a58(SB)/8, $0xa796c750791dd5eb
-DATA ·precomputed<>+0x9a60(SB)/8, $0xb2f33680ea88555b
-DATA ·precomputed<>+0x9a68(SB)/8, $0x927309501245348c
-DATA ·precomputed<>+0x9a70(SB)/8, $0x1a5545f8d1e63bfb
-DATA ·precomputed<>+0x9a78(SB)/8, $0xfebc9a14bebb7858
-DATA ·precomputed<>+0x9a80(SB)/8, $0x3ec9d0e39b336663
-DATA ·precomputed<>+0x9a88(SB)/8, $0x5ac2df38b1438d2b
-DATA ·precomputed<>+0x9a90(SB)/8, $0x7f2de910ff93fde4
-DATA ·precomputed<>+0x9a98(SB)/8, $0xbbc460dad92534ba
-DATA ·precomputed<>+0x9aa0(SB)/8, $0x74de315959a94ab9
-DATA ·precomputed<>+0x9aa8(SB)/8, $0xd51cfd32c45b80dc
-DATA ·precomputed<>+0x9ab0(SB)/8, $0x9f1f349c6e5b2397
-DATA ·precomputed<>+0x9ab8(SB)/8, $0xbdbd16ed995f7271
-DATA ·precomputed<>+0x9ac0(SB)/8, $0xd9621d166087c5e5
-DATA ·precomputed<>+0x9ac8(SB)/8, $0xae49c2cec53a8bc5
-DATA ·precomputed<>+0x9ad0(SB)/8, $0xd7868882cad314cd
-DATA ·precomputed<>+0x9ad8(SB)/8, $0xfde10dc7aa57af18
-DATA ·precomputed<>+0x9ae0(SB)/8, $0x3fa8a60d3800f397
-DATA ·precomputed<>+0x9ae8(SB)/8, $0xcec8ae7b388b333c
-DATA ·precomputed<>+0x9af0(SB)/8, $0xefd8d69285fa8129
-DATA ·precomputed<>+0x9af8(SB)/8, $0x33d5685b90776c32
-DATA ·precomputed<>+0x9b00(SB)/8, $0x2e8a4c575e4c950d
-DATA ·precomputed<>+0x9b08(SB)/8, $0x6f5341d74dd32002
-DATA ·precomputed<>+0x9b10(SB)/8, $0xd0345db66efa5908
-DATA ·precomputed<>+0x9b18(SB)/8, $0x4b043197f1d2bbe6
-DATA ·precomputed<>+0x9b20(SB)/8, $0xde8a4cb6e8730bae
-DATA ·precomputed<>+0x9b28(SB)/8, $0x9a89231fa485cb62
-DATA ·precomputed<>+0x9b30(SB)/8, $0xb24ace89fcd9bcd4
-DATA ·precomputed<>+0x9b38(SB)/8, $0x1892cc03ed5801d
-DATA ·precomputed<>+0x9b40(SB)/8, $0xab65c8f6aed5be73
-DATA ·precomputed<>+0x9b48(SB)/8, $0x838c3958174bf00b
-DATA ·precomputed<>+0x9b50(SB)/8, $0x27c57199f1c7e594
-DATA ·precomputed<>+0x9b58(SB)/8, $0x62643d810d02fae6
-DATA ·precomputed<>+0x9b60(SB)/8, $0xc1e70c125f4431fa
-DATA ·precomputed<>+0x9b68(SB)/8, $0xfac86124b2b41f7e
-DATA ·precomputed<>+0x9b70(SB)/8, $0x62ac35af0108e3c
-DATA ·precomputed<>+0x9b78(SB)/8, $0xd7d34dfca43d3a28
-DATA ·precomputed<>+0x9b80(SB)/8, $0xb7e2a5dead6fd7c6
-DATA ·precomputed<>+0x9b88(SB)/8, $0x9d6919392745dca8
-DATA ·precomputed<>+0x9b90(SB)/8, $0xff669c38ad053184
-DATA ·precomputed<>+0x9b98(SB)/8, $0x394ca6b7ecd0703e
-DATA ·precomputed<>+0x9ba0(SB)/8, $0x59e32e8060b15e75
-DATA ·precomputed<>+0x9ba8(SB)/8, $0x82dde88913c14864
-DATA ·precomputed<>+0x9bb0(SB)/8, $0xfd1624c64d4f086
-DATA ·precomputed<>+0x9bb8(SB)/8, $0x7fb502a7c9893d7f
-DATA ·precomputed<>+0x9bc0(SB)/8, $0xf0acf768680dcbf9
-DATA ·precomputed<>+0x9bc8(SB)/8, $0x5072b8254f0a51df
-DATA ·precomputed<>+0x9bd0(SB)/8, $0x3a74751cd88df9c5
-DATA ·precomputed<>+0x9bd8(SB)/8, $0x9d20f9891cc1a332
-DATA ·precomputed<>+0x9be0(SB)/8, $0x4e90042b6926c34a
-DATA ·precomputed<>+0x9be8(SB)/8, $0x5c728b1e00766880
-DATA ·precomputed<>+0x9bf0(SB)/8, $0xf2e3bfe8f76e9dcb
-DATA ·precomputed<>+0x9bf8(SB)/8, $0xd9822f0a15a125ae
-DATA ·precomputed<>+0x9c00(SB)/8, $0xb521f5be1a60bd7e
-DATA ·precomputed<>+0x9c08(SB)/8, $0x50b72330b542bf2e
-DATA ·precomputed<>+0x9c10(SB)/8, $0x8ddedb424a7ff691
-DATA ·precomputed<>+0x9c18(SB)/8, $0x4bfd3967092d7884
-DATA ·precomputed<>+0x9c20(SB)/8, $0xd2aba794f82645b8
-DATA ·precomputed<>+0x9c28(SB)/8, $0xd568a268af274d0a
-DATA ·precomputed<>+0x9c30(SB)/8, $0x78d8314114cea9f5
-DATA ·precomputed<>+0x9c38(SB)/8, $0x7ae41dfa1d273375
-DATA ·precomputed<>+0x9c40(SB)/8, $0xe43384ebd73aa034
-DATA ·precomputed<>+0x9c48(SB)/8, $0xaa8121d826c773a0
-DATA ·precomputed<>+0x9c50(SB)/8, $0xfc2af3478b20e0ea
-DATA ·precomputed<>+0x9c58(SB)/8, $0xe26fb634114c8c97
-DATA ·precomputed<>+0x9c60(SB)/8, $0x28db7dc4093c2193
-DATA ·precomputed<>+0x9c68(SB)/8, $0x935e816422da82e3
-DATA ·precomputed<>+0x9c70(SB)/8, $0x195d6ddf4d7670a
-DATA ·precomputed<>+0x9c78(SB)/8, $0xe1e1fca77b2a9370
-DATA ·precomputed<>+0x9c80(SB)/8, $0x3792f3779ea0e876
-DATA ·precomputed<>+0x9c88(SB)/8, $0x1051cdb8ac6fbce4
-DATA ·precomputed<>+0x9c90(SB)/8, $0xf6ac6a5f156af994
-DATA ·precomputed<>+0x9c98(SB)/8, $0xf5912980f542d5ec
-DATA ·precomputed<>+0x9ca0(SB)/8, $0x47a5a87ca59747b1
-DATA ·precomputed<>+0x9ca8(SB)/8, $0x761d6cd713ebeec
-DATA ·precomputed<>+0x9cb0(SB)/8, $0x6138cc99f1df622e
-DATA ·precomputed<>+0x9cb8(SB)/8, $0x6c5cfbefd0d70ecf
-DATA ·precomputed<>+0x9cc0(SB)/8, $0x641aeafec58b6d02
-DATA ·precomputed<>+0x9cc8(SB)/8, $0x750cd60353443629
-DATA ·precomputed<>+0x9cd0(SB)/8, $0xa68af789e4823652
-DATA ·precomputed<>+0x9cd8(SB)/8, $0x284d1e24c8d6ad5e
-DATA ·precomputed<>+0x9ce0(SB)/8, $0xa3f93ee3c4d56ddf
-DATA ·precomputed<>+0x9ce8(SB)/8, $0x8365004fac18e362
-DATA ·precomputed<>+0x9cf0(SB)/8, $0xe409c5b85a2fe79
-DATA ·precomputed<>+0x9cf8(SB)/8, $0x6127bfe025cfddf9
-DATA ·precomputed<>+0x9d00(SB)/8, $0x5ec7ad225499302b
-DATA ·precomputed<>+0x9d08(SB)/8, $0x41d71b70a8178a9c
-DATA ·precomputed<>+0x9d10(SB)/8, $0xebe66d29914400e
-DATA ·precomputed<>+0x9d18(SB)/8, $0x320501ba0b66d743
-DATA ·precomputed<>+0x9d20(SB)/8, $0x5bea4f01c606e89e
-DATA ·precomputed<>+0x9d28(SB)/8, $0x7ef2e93ef526fed8
-DATA ·precomputed<>+0x9d30(SB)/8, $0x72505aa1bb45dc07
-DATA ·precomputed<>+0x9d38(SB)/8, $0x51c0230ae2fdd08c
-DATA ·precomputed<>+0x9d40(SB)/8, $0xe465bbb77323f574
-DATA ·precomputed<>+0x9d48(SB)/8, $0xd0a1229fb539a2a2
-DATA ·precomputed<>+0x9d50(SB)/8, $0x9ee038e8c1905a62
-DATA ·precomputed<>+0x9d58(SB)/8, $0x75ecdb48f419ba98
-DATA ·precomputed<>+0x9d60(SB)/8, $0x8b470521aa0412d5
-DATA ·precomputed<>+0x9d68(SB)/8, $0x822dd6f6c1303f4e
-DATA ·precomputed<>+0x9d70(SB)/8, $0x6f97151646f65a9a
-DATA ·precomputed<>+0x9d78(SB)/8, $0x27c6c7b230b27486
-DATA ·precomputed<>+0x9d80(SB)/8, $0x987b909990f7a299
-DATA ·precomputed<>+0x9d88(SB)/8, $0x91fafcc8bdaac0ee
-DATA ·precomputed<>+0x9d90(SB)/8, $0xf66e9136edbdd950
-DATA ·precomputed<>+0x9d98(SB)/8, $0xf6edebac2ab5d838
-DATA ·precomputed<>+0x9da0(SB)/8, $0xd14e0f2291d1b595
-DATA ·precomputed<>+0x9da8(SB)/8, $0x66930d98fdc2a732
-DATA ·precomputed<>+0x9db0(SB)/8, $0x740d89c13f65de7
-DATA ·precomputed<>+0x9db8(SB)/8, $0x24158ce6357c48e6
-DATA ·precomputed<>+0x9dc0(SB)/8, $0xdc56ff1d35689ad9
-DATA ·precomputed<>+0x9dc8(SB)/8, $0xe0f21142a32addf2
-DATA ·precomputed<>+0x9dd0(SB)/8, $0x4d52297877fdde42
-DATA ·precomputed<>+0x9dd8(SB)/8, $0xf402832cdd838f5f
-DATA ·precomputed<>+0x9de0(SB)/8, $0x2a13267d915ac881
-DATA ·precomputed<>+0x9de8(SB)/8, $0xcf224e200c9963a6
-DATA ·precomputed<>+0x9df0(SB)/8, $0xd44419ac52a42bd
-DATA ·precomputed<>+0x9df8(SB)/8, $0x3ed2898d1cc0ac50
-DATA ·precomputed<>+0x9e00(SB)/8, $0xe19a86525578cc3a
-DATA ·precomputed<>+0x9e08(SB)/8, $0xb8c621b5a2686334
-DATA ·precomputed<>+0x9e10(SB)/8, $0xdc2aea9ce0bf6b23
-DATA ·precomputed<>+0x9e18(SB)/8, $0x30a04a85091dc2a4
-DATA ·precomputed<>+0x9e20(SB)/8, $0xcc9b567df94008ba
-DATA ·precomputed<>+0x9e28(SB)/8, $0xb44ee8e10ac6721c
-DATA ·precomputed<>+0x9e30(SB)/8, $0x12335b13d3cdc7d2
-DATA ·precomputed<>+0x9e38(SB)/8, $0x11b5987b81a72b97
-DATA ·precomputed<>+0x9e40(SB)/8, $0x2803fa55a993ccf
-DATA ·precomputed<>+0x9e48(SB)/8, $0x538c595f4d4b991a
-DATA ·precomputed<>+0x9e50(SB)/8, $0x855db705badf6f9f
-DATA ·precomputed<>+0x9e58(SB)/8, $0xb42ef6a8ddf2b874
-DATA ·precomputed<>+0x9e60(SB)/8, $0x3412471ddc6dd526
-DATA ·precomputed<>+0x9e68(SB)/8, $0x3a10c35f47ad62cf
-DATA ·precomputed<>+0x9e70(SB)/8, $0x5e0b3312d9616d1d
-DATA ·precomputed<>+0x9e78(SB)/8, $0x249d80d2d0cb7e76
-DATA ·precomputed<>+0x9e80(SB)/8, $0xdcd593451ed0279d
-DATA ·precomputed<>+0x9e88(SB)/8, $0x147ed22c6b6db1e4
-DATA ·precomputed<>+0x9e90(SB)/8, $0xe4912dc53248c016
-DATA ·precomputed<>+0x9e98(SB)/8, $0x3fdd6107b3e9b88a
-DATA ·precomputed<>+0x9ea0(SB)/8, $0x5aa77c4f4fa16fae
-DATA ·precomputed<>+0x9ea8(SB)/8, $0xd9f58ae8f5fb6a9c
-DATA ·precomputed<>+0x9eb0(SB)/8, $0xbeeab400292e74fc
-DATA ·precomputed<>+0x9eb8(SB)/8, $0x8c0d6a5c70b3ae23
-DATA ·precomputed<>+0x9ec0(SB)/8, $0x3e7305799f67b96b
-DATA ·precomputed<>+0x9ec8(SB)/8, $0xc6d265ea5f509e96
-DATA ·precomputed<>+0x9ed0(SB)/8, $0xe985dceed416dc34
-DATA ·precomputed<>+0x9ed8(SB)/8, $0xe308207f48803493
-DATA ·precomputed<>+0x9ee0(SB)/8, $0x9fcb4271dc7a39d4
-DATA ·precomputed<>+0x9ee8(SB)/8, $0x839afda23b9f5dc4
-DATA ·precomputed<>+0x9ef0(SB)/8, $0x1c08ba2fc7d33ea6
-DATA ·precomputed<>+0x9ef8(SB)/8, $0xf3ae1c278c59165a
-DATA ·precomputed<>+0x9f00(SB)/8, $0x9b20349c8681f5ab
-DATA ·precomputed<>+0x9f08(SB)/8, $0xd00b3f79a9e1c5db
-DATA ·precomputed<>+0x9f10(SB)/8, $0x552f8785d1ddb0be
-DATA ·precomputed<>+0x9f18(SB)/8, $0xd0a9b19816d239eb
-DATA ·precomputed<>+0x9f20(SB)/8, $0x25dd2f95de553a7b
-DATA ·precomputed<>+0x9f28(SB)/8, $0xb3679f7e46586fe9
-DATA ·precomputed<>+0x9f30(SB)/8, $0x160042f1f6042369
-DATA ·precomputed<>+0x9f38(SB)/8, $0x90ba851ac41bcb51
-DATA ·precomputed<>+0x9f40(SB)/8, $0x536358206b9cdb63
-DATA ·precomputed<>+0x9f48(SB)/8, $0xc5951d3bebcf5bd1
-DATA ·precomputed<>+0x9f50(SB)/8, $0x1e3f01a8676a2a8f
-DATA ·precomputed<>+0x9f58(SB)/8, $0x52dd8ccc08a66079
-DATA ·precomputed<>+0x9f60(SB)/8, $0x805337592a64c9a6
-DATA ·precomputed<>+0x9f68(SB)/8, $0xd9f6ff24fa78f975
-DATA ·precomputed<>+0x9f70(SB)/8, $0x48801e99b76bccf0
-DATA ·precomputed<>+0x9f78(SB)/8, $0xea8632e365fbd3dd
-DATA ·precomputed<>+0x9f80(SB)/8, $0x8665d650f5b6d18c
-DATA ·precomputed<>+0x9f88(SB)/8, $0x761e0fdb5b58ac99
-DATA ·precomputed<>+0x9f90(SB)/8, $0x975e6c241776a6b7
-DATA ·precomputed<>+0x9f98(SB)/8, $0x55e98689598bfc74
-DATA ·precomputed<>+0x9fa0(SB)/8, $0xd2d029a5da4fbce6
-DATA ·precomputed<>+0x9fa8(SB)/8, $0x6dcf54d203dee69c
-DATA ·precomputed<>+0x9fb0(SB)/8, $0x85043bf79321a36e
-DATA ·precomputed<>+0x9fb8(SB)/8, $0xc7352bdb073c79d
-DATA ·precomputed<>+0x9fc0(SB)/8, $0xbf84db58f51b14b0
-DATA ·precomputed<>+0x9fc8(SB)/8, $0xdf73ccf5a39a79f0
-DATA ·precomputed<>+0x9fd0(SB)/8, $0xce1e5842b5a1f11
-DATA ·precomputed<>+0x9fd8(SB)/8, $0x841fa6a3185fc400
-DATA ·precomputed<>+0x9fe0(SB)/8, $0x94b09c682455c32a
-DATA ·precomputed<>+0x9fe8(SB)/8, $0x383c9bdebfa71cc3
-DATA ·precomputed<>+0x9ff0(SB)/8, $0xb63814861e797929
-DATA ·precomputed<>+0x9ff8(SB)/8, $0x33036faf623d0a5d
-DATA ·precomputed<>+0xa000(SB)/8, $0x41b6cf7c90f17cba
-DATA ·precomputed<>+0xa008(SB)/8, $0x5d655ff430c7c5f4
-DATA ·precomputed<>+0xa010(SB)/8, $0xc64f29d54ccc7f38
-DATA ·precomputed<>+0xa018(SB)/8, $0xf28e85316124a79e
-DATA ·precomputed<>+0xa020(SB)/8, $0x1efa8d5167bf1e98
-DATA ·precomputed<>+0xa028(SB)/8, $0x8610027f5d7a33b0
-DATA ·precomputed<>+0xa030(SB)/8, $0x35fe2bb2cb9a40a4
-DATA ·precomputed<>+0xa038(SB)/8, $0xc5cc1bf143d50a0b
-DATA ·precomputed<>+0xa040(SB)/8, $0x60ae2bbfa32106c2
-DATA ·precomputed<>+0xa048(SB)/8, $0xef155b2a3e917750
-DATA ·precomputed<>+0xa050(SB)/8, $0x5567c3c713853a30
-DATA ·precomputed<>+0xa058(SB)/8, $0xa6be8290eddb305b
-DATA ·precomputed<>+0xa060(SB)/8, $0x2db58c21ade26eec
-DATA ·precomputed<>+0xa068(SB)/8, $0xfa3c895c003c17ed
-DATA ·precomputed<>+0xa070(SB)/8, $0x96ab0de16293f8a2
-DATA ·precomputed<>+0xa078(SB)/8, $0xbd2365ecac3710c6
-DATA ·precomputed<>+0xa080(SB)/8, $0x9ab03e73e194c642
-DATA ·precomputed<>+0xa088(SB)/8, $0x607b7106789e3c85
-DATA ·precomputed<>+0xa090(SB)/8, $0x952aab024bdacd55
-DATA ·precomputed<>+0xa098(SB)/8, $0x31ca3ee221cc6084
-DATA ·precomputed<>+0xa0a0(SB)/8, $0xd3149b2b1c6b93f9
-DATA ·precomputed<>+0xa0a8(SB)/8, $0xcbc5ef3bead930f8
-DATA ·precomputed<>+0xa0b0(SB)/8, $0xed04984f22872630
-DATA ·precomputed<>+0xa0b8(SB)/8, $0xef5d052d6c4b6fe2
-DATA ·precomputed<>+0xa0c0(SB)/8, $0xd2028d0557028677
-DATA ·precomputed<>+0xa0c8(SB)/8, $0x90eebeebce9aabdf
-DATA ·precomputed<>+0xa0d0(SB)/8, $0xab977aee06d4e5d0
-DATA ·precomputed<>+0xa0d8(SB)/8, $0x7a98c527f9361873
-DATA ·precomputed<>+0xa0e0(SB)/8, $0xe49b1251b7c2474d
-DATA ·precomputed<>+0xa0e8(SB)/8, $0xcdaf2a365f3e7b02
-DATA ·precomputed<>+0xa0f0(SB)/8, $0x638bcaf46fe54af1
-DATA ·precomputed<>+0xa0f8(SB)/8, $0xfec426241dac06b7
-DATA ·precomputed<>+0xa100(SB)/8, $0xda738cf51eafe37b
-DATA ·precomputed<>+0xa108(SB)/8, $0x503eac2430dd7c2b
-DATA ·precomputed<>+0xa110(SB)/8, $0xf9b7b7a511dd77af
-DATA ·precomputed<>+0xa118(SB)/8, $0xade03afe9dcfe7c
-DATA ·precomputed<>+0xa120(SB)/8, $0x489bd34af479e3b5
-DATA ·precomputed<>+0xa128(SB)/8, $0x993ab403030a33f3
-DATA ·precomputed<>+0xa130(SB)/8, $0xaef322bf9fb64068
-DATA ·precomputed<>+0xa138(SB)/8, $0xa47cc71b0e27f110
-DATA ·precomputed<>+0xa140(SB)/8, $0xe19ff8f8c63b9e75
-DATA ·precomputed<>+0xa148(SB)/8, $0x43f6b4fc0d7d130a
-DATA ·precomputed<>+0xa150(SB)/8, $0xdba3385d7c8490b7
-DATA ·precomputed<>+0xa158(SB)/8, $0x97889df70252c14a
-DATA ·precomputed<>+0xa160(SB)/8, $0xfccfca86b2f18b9f
-DATA ·precomputed<>+0xa168(SB)/8, $0xf092ff9ec3a87422
-DATA ·precomputed<>+0xa170(SB)/8, $0xf96dd67567474838
-DATA ·precomputed<>+0xa178(SB)/8, $0x39e82875bad2e9f
-DATA ·precomputed<>+0xa180(SB)/8, $0x46a609112da32c0a
-DATA ·precomputed<>+0xa188(SB)/8, $0xb2e1ac32b2676ca3
-DATA ·precomputed<>+0xa190(SB)/8, $0xfb74093f17614dc6
-DATA ·precomputed<>+0xa198(SB)/8, $0xf44939e43f27f965
-DATA ·precomputed<>+0xa1a0(SB)/8, $0x4656a402c922422b
-DATA ·precomputed<>+0xa1a8(SB)/8, $0xd60a55ba3ff5c56f
-DATA ·precomputed<>+0xa1b0(SB)/8, $0xd061b41ab9aa62e
-DATA ·precomputed<>+0xa1b8(SB)/8, $0xc9ceacfeaca3acd2
-DATA ·precomputed<>+0xa1c0(SB)/8, $0x4f581d47515f954c
-DATA ·precomputed<>+0xa1c8(SB)/8, $0x145f925b7f470a40
-DATA ·precomputed<>+0xa1d0(SB)/8, $0xfee6b6b0736feaaf
-DATA ·precomputed<>+0xa1d8(SB)/8, $0xf90744af2ea5743b
-DATA ·precomputed<>+0xa1e0(SB)/8, $0x4d8e8ceaa2f36f56
-DATA ·precomputed<>+0xa1e8(SB)/8, $0x4239a6cee3ed4007
-DATA ·precomputed<>+0xa1f0(SB)/8, $0x906b5bdd515e6db
-DATA ·precomputed<>+0xa1f8(SB)/8, $0x536229908ac973d1
-DATA ·precomputed<>+0xa200(SB)/8, $0x48f93fc3f4f966da
-DATA ·precomputed<>+0xa208(SB)/8, $0xf92691a0ed5b6487
-DATA ·precomputed<>+0xa210(SB)/8, $0xc5a93e5dada2c1fc
-DATA ·precomputed<>+0xa218(SB)/8, $0x4a7aca524b7d9243
-DATA ·precomputed<>+0xa220(SB)/8, $0x810aba93d7c5598b
-DATA ·precomputed<>+0xa228(SB)/8, $0x98f9ead225109638
-DATA ·precomputed<>+0xa230(SB)/8, $0xe8c6e893a388481a
-DATA ·precomputed<>+0xa238(SB)/8, $0x56e96b9be91ce74b
-DATA ·precomputed<>+0xa240(SB)/8, $0x8d13b665fc1f9449
-DATA ·precomputed<>+0xa248(SB)/8, $0x6e9932a9dd4bba75
-DATA ·precomputed<>+0xa250(SB)/8, $0xa90ce8e5564083da
-DATA ·precomputed<>+0xa258(SB)/8, $0x8a7cf362bbf7989d
-DATA ·precomputed<>+0xa260(SB)/8, $0x652eccb71b07ee2f
-DATA ·precomputed<>+0xa268(SB)/8, $0xc0dcf1a6857a141
-DATA ·precomputed<>+0xa270(SB)/8, $0xa87ec410b7bfb43e
-DATA ·precomputed<>+0xa278(SB)/8, $0xaebdb7e782b8d179
-DATA ·precomputed<>+0xa280(SB)/8, $0xd3a243100c62c041
-DATA ·precomputed<>+0xa288(SB)/8, $0x4d27344a6c03c747
-DATA ·precomputed<>+0xa290(SB)/8, $0xb19e4a67d3ee9d1
-DATA ·precomputed<>+0xa298(SB)/8, $0x9cf2eccdcd90de33
-DATA ·precomputed<>+0xa2a0(SB)/8, $0x673a9d1ffda636a9
-DATA ·precomputed<>+0xa2a8(SB)/8, $0xb7349981a86ee501
-DATA ·precomputed<>+0xa2b0(SB)/8, $0x11ca1e49e43766ed
-DATA ·precomputed<>+0xa2b8(SB)/8, $0x806af6fe3ff3b08
-DATA ·precomputed<>+0xa2c0(SB)/8, $0x32551d31579d8a41
-DATA ·precomputed<>+0xa2c8(SB)/8, $0x754c7c2460a5ee33
-DATA ·precomputed<>+0xa2d0(SB)/8, $0x2c53fbff6a88f85f
-DATA ·precomputed<>+0xa2d8(SB)/8, $0x6ad0bda72c7a36a0
-DATA ·precomputed<>+0xa2e0(SB)/8, $0x8b3674f815724d6c
-DATA ·precomputed<>+0xa2e8(SB)/8, $0x614727ceb9b7b34a
-DATA ·precomputed<>+0xa2f0(SB)/8, $0x384fba9882ca9cd7
-DATA ·precomputed<>+0xa2f8(SB)/8, $0x8ef4343c0c566025
-DATA ·precomputed<>+0xa300(SB)/8, $0xc68450cb4bc7dccb
-DATA ·precomputed<>+0xa308(SB)/8, $0xb5f11b269e5f2400
-DATA ·precomputed<>+0xa310(SB)/8, $0x2af58e9e9c3a833b
-DATA ·precomputed<>+0xa318(SB)/8, $0xb60e2672a058abaa
-DATA ·precomputed<>+0xa320(SB)/8, $0xe891bf8c75b080c0
-DATA ·precomputed<>+0xa328(SB)/8, $0x5b09b2762434bf38
-DATA ·precomputed<>+0xa330(SB)/8, $0xd90a040700b8739
-DATA ·precomputed<>+0xa338(SB)/8, $0x995cb042e69f4a0b
-DATA ·precomputed<>+0xa340(SB)/8, $0x6bb34768240c7897
-DATA ·precomputed<>+0xa348(SB)/8, $0x80ff54ea7b45473e
-DATA ·precomputed<>+0xa350(SB)/8, $0x16acd40f82fe5aac
-DATA ·precomputed<>+0xa358(SB)/8, $0xa3e76f524350449f
-DATA ·precomputed<>+0xa360(SB)/8, $0xf7a3579eacacbeb9
-DATA ·precomputed<>+0xa368(SB)/8, $0x9791e0e07bc40732
-DATA ·precomputed<>+0xa370(SB)/8, $0xb26da7b5bc58cb9d
-DATA ·precomputed<>+0xa378(SB)/8, $0x11d9fc80987e18f4
-DATA ·precomputed<>+0xa380(SB)/8, $0xee5f526d12ac8df5
-DATA ·precomputed<>+0xa388(SB)/8, $0xf1dd23f73bf94c65
-DATA ·precomputed<>+0xa390(SB)/8, $0x594ceaac81a3cb0e
-DATA ·precomputed<>+0xa398(SB)/8, $0x7d41d3b9757cc8b
-DATA ·precomputed<>+0xa3a0(SB)/8, $0x9eb0081dfc5603d5
-DATA ·precomputed<>+0xa3a8(SB)/8, $0xfb5d329857bd230c
-DATA ·precomputed<>+0xa3b0(SB)/8, $0xf2c0402ecde3f845
-DATA ·precomputed<>+0xa3b8(SB)/8, $0xa2defd6741e8aba6
-DATA ·precomputed<>+0xa3c0(SB)/8, $0x648d667432efbbce
-DATA ·precomputed<>+0xa3c8(SB)/8, $0x64a6c2b3e9639719
-DATA ·precomputed<>+0xa3d0(SB)/8, $0x38c0465730662e7d
-DATA ·precomputed<>+0xa3d8(SB)/8, $0x15d1d7ca352c9184
-DATA ·precomputed<>+0xa3e0(SB)/8, $0x70e8630ccc3020cc
-DATA ·precomputed<>+0xa3e8(SB)/8, $0xe4b56c9cb09f038f
-DATA ·precomputed<>+0xa3f0(SB)/8, $0xdb9cb5edfe76a744
-DATA ·precomputed<>+0xa3f8(SB)/8, $0x4c85f0206947b988
-DATA ·precomputed<>+0xa400(SB)/8, $0x41f7ac85ba588a5e
-DATA ·precomputed<>+0xa408(SB)/8, $0x5425fa00ccdb9687
-DATA ·precomputed<>+0xa410(SB)/8, $0x12fbce76ec9398f0
-DATA ·precomputed<>+0xa418(SB)/8, $0x2ad692514f550b9b
-DATA ·precomputed<>+0xa420(SB)/8, $0x120ff0f2bb318636
-DATA ·precomputed<>+0xa428(SB)/8, $0x9378346c01ecd90b
-DATA ·precomputed<>+0xa430(SB)/8, $0x1b98fe99d0ba149b
-DATA ·precomputed<>+0xa438(SB)/8, $0xd69d5462c9c481c8
-DATA ·precomputed<>+0xa440(SB)/8, $0xff65189c32b4d371
-DATA ·precomputed<>+0xa448(SB)/8, $0x22fecca0faf5ba7
-DATA ·precomputed<>+0xa450(SB)/8, $0xd08fe9bf414707b4
-DATA ·precomputed<>+0xa458(SB)/8, $0xef8af2b722d5fd2
-DATA ·precomputed<>+0xa460(SB)/8, $0xbef060634e6fa14a
-DATA ·precomputed<>+0xa468(SB)/8, $0x1c41f752cca29177
-DATA ·precomputed<>+0xa470(SB)/8, $0x17dc7e1865091fe1
-DATA ·precomputed<>+0xa478(SB)/8, $0x693d72d223f13c18
-DATA ·precomputed<>+0xa480(SB)/8, $0xcd392b3ca77c1767
-DATA ·precomputed<>+0xa488(SB)/8, $0x14471fab7c4e6bd9
-DATA ·precomputed<>+0xa490(SB)/8, $0x312e154775c905ff
-DATA ·precomputed<>+0xa498(SB)/8, $0x45764654ace47038
-DATA ·precomputed<>+0xa4a0(SB)/8, $0xa34a0b0e8fc3d697
-DATA ·precomputed<>+0xa4a8(SB)/8, $0x5d9ad31ad309ed3a
-DATA ·precomputed<>+0xa4b0(SB)/8, $0xbba340c00918f957
-DATA ·precomputed<>+0xa4b8(SB)/8, $0x768e06e831fd72a1
-DATA ·precomputed<>+0xa4c0(SB)/8, $0x4551a8c7eed95e91
-DATA ·precomputed<>+0xa4c8(SB)/8, $0x8de8988832bcfb03
-DATA ·precomputed<>+0xa4d0(SB)/8, $0x25da4f5f2eac5c3a
-DATA ·precomputed<>+0xa4d8(SB)/8, $0x6d0b2e255f88d63f
-DATA ·precomputed<>+0xa4e0(SB)/8, $0x8d158d14575d6145
-DATA ·precomputed<>+0xa4e8(SB)/8, $0xe5601a6b345f62b0
-DATA ·precomputed<>+0xa4f0(SB)/8, $0x6f951699113c6895
-DATA ·precomputed<>+0xa4f8(SB)/8, $0x79e29fd5b87e50ef
-DATA ·precomputed<>+0xa500(SB)/8, $0xa46e8a55533b4d09
-DATA ·precomputed<>+0xa508(SB)/8, $0xfc8039984e073af1
-DATA ·precomputed<>+0xa510(SB)/8, $0x8e3825c8e0d589c3
-DATA ·precomputed<>+0xa518(SB)/8, $0x505e8e5d4c1daef3
-DATA ·precomputed<>+0xa520(SB)/8, $0x9f8363b1c5f3db12
-DATA ·precomputed<>+0xa528(SB)/8, $0xe7d4670074f569e2
-DATA ·precomputed<>+0xa530(SB)/8, $0x551fd2ed4d68461a
-DATA ·precomputed<>+0xa538(SB)/8, $0x26248da5a8bbe83d
-DATA ·precomputed<>+0xa540(SB)/8, $0xcb89a1e845e1c230
-DATA ·precomputed<>+0xa548(SB)/8, $0xee014c2350a15304
-DATA ·precomputed<>+0xa550(SB)/8, $0xf25d8ffa2bab57e1
-DATA ·precomputed<>+0xa558(SB)/8, $0x8a92068026223c6e
-DATA ·precomputed<>+0xa560(SB)/8, $0xc5abb7afaadf7e6a
-DATA ·precomputed<>+0xa568(SB)/8, $0xcb57c8939e7d8da5
-DATA ·precomputed<>+0xa570(SB)/8, $0x839bcda07d589a91
-DATA ·precomputed<>+0xa578(SB)/8, $0x1fa774c077e82027
-DATA ·precomputed<>+0xa580(SB)/8, $0xfa5a6c46ce419462
-DATA ·precomputed<>+0xa588(SB)/8, $0x29336dc99cce80e9
-DATA ·precomputed<>+0xa590(SB)/8, $0x9e9054b9eee7617f
-DATA ·precomputed<>+0xa598(SB)/8, $0xcea9a100f3d51cba
-DATA ·precomputed<>+0xa5a0(SB)/8, $0xc3cce5e813267ec6
-DATA ·precomputed<>+0xa5a8(SB)/8, $0x650c8383a4e807e7
-DATA ·precomputed<>+0xa5b0(SB)/8, $0x1338e22e9b2726dc
-DATA ·precomputed<>+0xa5b8(SB)/8, $0x220c50b2bf79b47a
-DATA ·precomputed<>+0xa5c0(SB)/8, $0x948e036668da20d2
-DATA ·precomputed<>+0xa5c8(SB)/8, $0x36998a244108fe36
-DATA ·precomputed<>+0xa5d0(SB)/8, $0x7606e6edf9d6563b
-DATA ·precomputed<>+0xa5d8(SB)/8, $0xcf7cbdd3e42172ba
-DATA ·precomputed<>+0xa5e0(SB)/8, $0x2335a9a4a1265b99
-DATA ·precomputed<>+0xa5e8(SB)/8, $0x64776cdc30ac3f69
-DATA ·precomputed<>+0xa5f0(SB)/8, $0x4040362a59b595e
-DATA ·precomputed<>+0xa5f8(SB)/8, $0x82df96b92cbc03cd
-DATA ·precomputed<>+0xa600(SB)/8, $0x61dc7318aa04152e
-DATA ·precomputed<>+0xa608(SB)/8, $0xdf636db195e5ec9f
-DATA ·precomputed<>+0xa610(SB)/8, $0x64a80d4648090850
-DATA ·precomputed<>+0xa618(SB)/8, $0x2b37679ece890a30
-DATA ·precomputed<>+0xa620(SB)/8, $0x9f46d5b9ff6025e3
-DATA ·precomputed<>+0xa628(SB)/8, $0x6eed5a44f24534dd
-DATA ·precomputed<>+0xa630(SB)/8, $0xc56b5cb1f740a84b
-DATA ·precomputed<>+0xa638(SB)/8, $0xb4641c28228cc826
-DATA ·precomputed<>+0xa640(SB)/8, $0x1de7bd1c64457331
-DATA ·precomputed<>+0xa648(SB)/8, $0xa03a06b43bb1380
-DATA ·precomputed<>+0xa650(SB)/8, $0x6720cc3d8bf17419
-DATA ·precomputed<>+0xa658(SB)/8, $0x2627c7da33938d5a
-DATA ·precomputed<>+0xa660(SB)/8, $0x204da0588d98f34c
-DATA ·precomputed<>+0xa668(SB)/8, $0x80e29f4651cbd284
-DATA ·precomputed<>+0xa670(SB)/8, $0x11b22dd4a46f93d5
-DATA ·precomputed<>+0xa678(SB)/8, $0xd7341655e971a61a
-DATA ·precomputed<>+0xa680(SB)/8, $0x82879cb04ae1103c
-DATA ·precomputed<>+0xa688(SB)/8, $0x61cd6ca894385be6
-DATA ·precomputed<>+0xa690(SB)/8, $0x7c551809d85d4a62
-DATA ·precomputed<>+0xa698(SB)/8, $0x9632ac5fb318d965
-DATA ·precomputed<>+0xa6a0(SB)/8, $0x67baad2ce1340605
-DATA ·precomputed<>+0xa6a8(SB)/8, $0x39c2c8c7ac6ed4f7
-DATA ·precomputed<>+0xa6b0(SB)/8, $0x42c4a7b171211c2f
-DATA ·precomputed<>+0xa6b8(SB)/8, $0x43c778bb9bf758f6
-DATA ·precomputed<>+0xa6c0(SB)/8, $0xb13f6abd9c36dd9e
-DATA ·precomputed<>+0xa6c8(SB)/8, $0x4baaef529b3aa9f5
-DATA ·precomputed<>+0xa6d0(SB)/8, $0xa4fa929cd156392
-DATA ·precomputed<>+0xa6d8(SB)/8, $0xde0f19566506f42f
-DATA ·precomputed<>+0xa6e0(SB)/8, $0xe43dd7f0150d4ee7
-DATA ·precomputed<>+0xa6e8(SB)/8, $0xf7afe7db7247f552
-DATA ·precomputed<>+0xa6f0(SB)/8, $0x9abc8d1c9072b450
-DATA ·precomputed<>+0xa6f8(SB)/8, $0x5d0645d5c9a8e159
-DATA ·precomputed<>+0xa700(SB)/8, $0xaf049c5ce9acac7a
-DATA ·precomputed<>+0xa708(SB)/8, $0x7efec06c261dd422
-DATA ·precomputed<>+0xa710(SB)/8, $0xa530fbfd095564c4
-DATA ·precomputed<>+0xa718(SB)/8, $0xc0c822a57af79
-DATA ·precomputed<>+0xa720(SB)/8, $0x9f79868f2ce1315c
-DATA ·precomputed<>+0xa728(SB)/8, $0xdd994531b5d575e
-DATA ·precomputed<>+0xa730(SB)/8, $0xf1a494191e534cfd
-DATA ·precomputed<>+0xa738(SB)/8, $0xc7de8756ed7e8b39
-DATA ·precomputed<>+0xa740(SB)/8, $0x62628fdf8819505b
-DATA ·precomputed<>+0xa748(SB)/8, $0x3cefd86c004ba54e
-DATA ·precomputed<>+0xa750(SB)/8, $0xa17bed74c571da3d
-DATA ·precomputed<>+0xa758(SB)/8, $0x362dfef693a5faa5
-DATA ·precomputed<>+0xa760(SB)/8, $0x1bee6899f8aeea05
-DATA ·precomputed<>+0xa768(SB)/8, $0xd7bf7e3116f18b7a
-DATA ·precomputed<>+0xa770(SB)/8, $0x3f3cf39d1cb7685c
-DATA ·precomputed<>+0xa778(SB)/8, $0x1df41f23e2e57c8e
-DATA ·precomputed<>+0xa780(SB)/8, $0xda188361e71d029f
-DATA ·precomputed<>+0xa788(SB)/8, $0x3e3e19dab5def631
-DATA ·precomputed<>+0xa790(SB)/8, $0x7431f513087ad30b
-DATA ·precomputed<>+0xa798(SB)/8, $0x2537887e9f27c84e
-DATA ·precomputed<>+0xa7a0(SB)/8, $0xc228c62ac9df89d
-DATA ·precomputed<>+0xa7a8(SB)/8, $0xdcd2c5e910031289
-DATA ·precomputed<>+0xa7b0(SB)/8, $0x5cc767820321d1b6
-DATA ·precomputed<>+0xa7b8(SB)/8, $0x4e460bdf6cb3d600
-DATA ·precomputed<>+0xa7c0(SB)/8, $0x349e4873ec83c953
-DATA ·precomputed<>+0xa7c8(SB)/8, $0xb4f59fb33a21ef0a
-DATA ·precomputed<>+0xa7d0(SB)/8, $0x3872d31440f7d93e
-DATA ·precomputed<>+0xa7d8(SB)/8, $0x479e1d02c2568c82
-DATA ·precomputed<>+0xa7e0(SB)/8, $0xd7e4dc9a65d43d22
-DATA ·precomputed<>+0xa7e8(SB)/8, $0xcc068e81e775efa8
-DATA ·precomputed<>+0xa7f0(SB)/8, $0xb78ccae9326defa6
-DATA ·precomputed<>+0xa7f8(SB)/8, $0x8f92b2962da64956
-DATA ·precomputed<>+0xa800(SB)/8, $0xb721f8d5dea227ee
-DATA ·precomputed<>+0xa808(SB)/8, $0xf48c766c3dda8ba0
-DATA ·precomputed<>+0xa810(SB)/8, $0x583d94be43e3520
-DATA ·precomputed<>+0xa818(SB)/8, $0xebda36c9e1d898b6
-DATA ·precomputed<>+0xa820(SB)/8, $0x1808286a6627adaa
-DATA ·precomputed<>+0xa828(SB)/8, $0x19c4c6209938368e
-DATA ·precomputed<>+0xa830(SB)/8, $0xe0dbd707f750949f
-DATA ·precomputed<>+0xa838(SB)/8, $0xcadf4bea0cf356d9
-DATA ·precomputed<>+0xa840(SB)/8, $0xf5de21262dc890a7
-DATA ·precomputed<>+0xa848(SB)/8, $0x76b7b67595aa75a3
-DATA ·precomputed<>+0xa850(SB)/8, $0x475fc1432a070b32
-DATA ·precomputed<>+0xa858(SB)/8, $0x7429a6468e31d68f
-DATA ·precomputed<>+0xa860(SB)/8, $0xec3a9aaa09be3dca
-DATA ·precomputed<>+0xa868(SB)/8, $0x7e119a9af780ed7
-DATA ·precomputed<>+0xa870(SB)/8, $0x6212562564fd96c4
-DATA ·precomputed<>+0xa878(SB)/8, $0xb571494fe8e80577
-DATA ·precomputed<>+0xa880(SB)/8, $0x955ee3495228d735
-DATA ·precomputed<>+0xa888(SB)/8, $0xa04ef2bb8fc5d4b6
-DATA ·precomputed<>+0xa890(SB)/8, $0xc5328913600814f
-DATA ·precomputed<>+0xa898(SB)/8, $0x41f1f63759f85bd4
-DATA ·precomputed<>+0xa8a0(SB)/8, $0x72f1d731e3dcdfb4
-DATA ·precomputed<>+0xa8a8(SB)/8, $0x28a4ddb93aa5edb3
-DATA ·precomputed<>+0xa8b0(SB)/8, $0x116a68e1f702dcdb
-DATA ·precomputed<>+0xa8b8(SB)/8, $0x1975bc423bde657e
-DATA ·precomputed<>+0xa8c0(SB)/8, $0x7b9f561a8a914b50
-DATA ·precomputed<>+0xa8c8(SB)/8, $0x2bf7130e9154d377
-DATA ·precomputed<>+0xa8d0(SB)/8, $0x6800f696519b4c35
-DATA ·precomputed<>+0xa8d8(SB)/8, $0xc9e65040568b4c56
-DATA ·precomputed<>+0xa8e0(SB)/8, $0x30706e006d98a331
-DATA ·precomputed<>+0xa8e8(SB)/8, $0x781a12f6e211ce1e
-DATA ·precomputed<>+0xa8f0(SB)/8, $0x1fff9e3d40562e5f
-DATA ·precomputed<>+0xa8f8(SB)/8, $0x6356cf468c166747
-DATA ·precomputed<>+0xa900(SB)/8, $0x80e87329429945a7
-DATA ·precomputed<>+0xa908(SB)/8, $0xc619fe17b7ab06ad
-DATA ·precomputed<>+0xa910(SB)/8, $0x9116bc2e6fd86b17
-DATA ·precomputed<>+0xa918(SB)/8, $0x64a41877b9116aac
-DATA ·precomputed<>+0xa920(SB)/8, $0xe3ed867e32ba4f3b
-DATA ·precomputed<>+0xa928(SB)/8, $0x13e263b68b4ebe6
-DATA ·precomputed<>+0xa930(SB)/8, $0x305ebfe7e779e4ec
-DATA ·precomputed<>+0xa938(SB)/8, $0x5536d45d50178251
-DATA ·precomputed<>+0xa940(SB)/8, $0x5abb939f8873a93d
-DATA ·precomputed<>+0xa948(SB)/8, $0x263ba488c4c9cb1
-DATA ·precomputed<>+0xa950(SB)/8, $0x36764b8d6b78a4b5
-DATA ·precomputed<>+0xa958(SB)/8, $0x205bb45d28bebc1e
-DATA ·precomputed<>+0xa960(SB)/8, $0x16df4bb0ae89dcd5
-DATA ·precomputed<>+0xa968(SB)/8, $0x85994670316fadb7
-DATA ·precomputed<>+0xa970(SB)/8, $0x71f756643af3c724
-DATA ·precomputed<>+0xa978(SB)/8, $0x43e30313e8520c9c
-DATA ·precomputed<>+0xa980(SB)/8, $0x3ab9ec5429e91921
-DATA ·precomputed<>+0xa988(SB)/8, $0xd931436ee3299f47
-DATA ·precomputed<>+0xa990(SB)/8, $0xb56da7bfb89cd49f
-DATA ·precomputed<>+0xa998(SB)/8, $0x90623412cff7f637
-DATA ·precomputed<>+0xa9a0(SB)/8, $0x751e7944714022de
-DATA ·precomputed<>+0xa9a8(SB)/8, $0x86bcc3422c338262
-DATA ·precomputed<>+0xa9b0(SB)/8, $0x85f6a9bc314c23bb
-DATA ·precomputed<>+0xa9b8(SB)/8, $0xedbe8e741f0a3991
-DATA ·precomputed<>+0xa9c0(SB)/8, $0x7a748d63003b40dd
-DATA ·precomputed<>+0xa9c8(SB)/8, $0x8a6824023951b7ae
-DATA ·precomputed<>+0xa9d0(SB)/8, $0x41e92dd9704a91b0
-DATA ·precomputed<>+0xa9d8(SB)/8, $0x2dfb3eb9858cd3ea
-DATA ·precomputed<>+0xa9e0(SB)/8, $0xc3c2af35f5094667
-DATA ·precomputed<>+0xa9e8(SB)/8, $0xffa287dc7435aa2d
-DATA ·precomputed<>+0xa9f0(SB)/8, $0xd03f39797462714f
-DATA ·precomputed<>+0xa9f8(SB)/8, $0xdb550f67203e5b0e
-DATA ·precomputed<>+0xaa00(SB)/8, $0x6df7801be241ed0c
-DATA ·precomputed<>+0xaa08(SB)/8, $0xb52c0a3fb642fd3a
-DATA ·precomputed<>+0xaa10(SB)/8, $0xdd35e1cf1977a29d
-DATA ·precomputed<>+0xaa18(SB)/8, $0x8e793d60a661404c
-DATA ·precomputed<>+0xaa20(SB)/8, $0x393e2b876b9442ae
-DATA ·precomputed<>+0xaa28(SB)/8, $0x123b893a2aa6b707
-DATA ·precomputed<>+0xaa30(SB)/8, $0xeec88682db8d306a
-DATA ·precomputed<>+0xaa38(SB)/8, $0x92c2d93dce847879
-DATA ·precomputed<>+0xaa40(SB)/8, $0x725f1e7d80ec63b4
-DATA ·precomputed<>+0xaa48(SB)/8, $0xcb8f53d974113de0
-DATA ·precomputed<>+0xaa50(SB)/8, $0x2132a072b819f730
-DATA ·precomputed<>+0xaa58(SB)/8, $0xfabf3c47b4c61f06
-DATA ·precomputed<>+0xaa60(SB)/8, $0x79c1bc862cb243d8
-DATA ·precomputed<>+0xaa68(SB)/8, $0x442833c5757e3600
-DATA ·precomputed<>+0xaa70(SB)/8, $0xfa4f69ad4e918b8a
-DATA ·precomputed<>+0xaa78(SB)/8, $0x5816f3f373bc193e
-DATA ·precomputed<>+0xaa80(SB)/8, $0xc671c7a430f40e93
-DATA ·precomputed<>+0xaa88(SB)/8, $0x6041aa035c51cfa4
-DATA ·precomputed<>+0xaa90(SB)/8, $0x3a7135492fac25d7
-DATA ·precomputed<>+0xaa98(SB)/8, $0xf505323724a7df01
-DATA ·precomputed<>+0xaaa0(SB)/8, $0x99efb34ad29f4ec5
-DATA ·precomputed<>+0xaaa8(SB)/8, $0x7481052371d2cb1b
-DATA ·precomputed<>+0xaab0(SB)/8, $0xacefaf8ff3a029ab
-DATA ·precomputed<>+0xaab8(SB)/8, $0xc82e4f5a069d9545
-DATA ·precomputed<>+0xaac0(SB)/8, $0xd759549dd3341d80
-DATA ·precomputed<>+0xaac8(SB)/8, $0x79e9fa731a2a0a4
-DATA ·precomputed<>+0xaad0(SB)/8, $0x75da56c72a164f75
-DATA ·precomputed<>+0xaad8(SB)/8, $0x9313ef5abeefc182
-DATA ·precomputed<>+0xaae0(SB)/8, $0xaa365b6bde130ad
-DATA ·precomputed<>+0xaae8(SB)/8, $0x4426597798411180
-DATA ·precomputed<>+0xaaf0(SB)/8, $0xa65373f7aa26466a
-DATA ·precomputed<>+0xaaf8(SB)/8, $0x1a43bee62e2cf404
-DATA ·precomputed<>+0xab00(SB)/8, $0xe029ed6db37a9390
-DATA ·precomputed<>+0xab08(SB)/8, $0x5c2351ca34970065
-DATA ·precomputed<>+0xab10(SB)/8, $0x7c4f3c301c46d72c
-DATA ·precomputed<>+0xab18(SB)/8, $0x9ce770a7262ce20
-DATA ·precomputed<>+0xab20(SB)/8, $0xcfeefaddd58a9f8
-DATA ·precomputed<>+0xab28(SB)/8, $0x6797d79408addaa
-DATA ·precomputed<>+0xab30(SB)/8, $0x76a87c0605aed325
-DATA ·precomputed<>+0xab38(SB)/8, $0xe002b6728a46d0c6
-DATA ·precomputed<>+0xab40(SB)/8, $0xcf77ea3105b6e1a4
-DATA ·precomputed<>+0xab48(SB)/8, $0x3bf900bca5d92b00
-DATA ·precomputed<>+0xab50(SB)/8, $0x5996d8cdccfe144
-DATA ·precomputed<>+0xab58(SB)/8, $0x73d4dfd7951a602c
-DATA ·precomputed<>+0xab60(SB)/8, $0x33f39590ed8885d
-DATA ·precomputed<>+0xab68(SB)/8, $0x8332dc7336400817
-DATA ·precomputed<>+0xab70(SB)/8, $0x963722952d8ebda7
-DATA ·precomputed<>+0xab78(SB)/8, $0x3fb32cf6b5da0c67
-DATA ·precomputed<>+0xab80(SB)/8, $0xcb521d653e36defc
-DATA ·precomputed<>+0xab88(SB)/8, $0xc293d170a67f00f0
-DATA ·precomputed<>+0xab90(SB)/8, $0x6a3a2fd4fb35bd06
-DATA ·precomputed<>+0xab98(SB)/8, $0x537937dd0bd490a5
-DATA ·precomputed<>+0xaba0(SB)/8, $0x898d94bcc274ee5a
-DATA ·precomputed<>+0xaba8(SB)/8, $0xdc70f9bd7515b5e7
-DATA ·precomputed<>+0xabb0(SB)/8, $0xa94673db3749900f
-DATA ·precomputed<>+0xabb8(SB)/8, $0x3e6e2af049ad3b04
-DATA ·precomputed<>+0xabc0(SB)/8, $0xb9dae1b8207eecd9
-DATA ·precomputed<>+0xabc8(SB)/8, $0xd3f50d63ec07b47c
-DATA ·precomputed<>+0xabd0(SB)/8, $0x2b4d909364292da
-DATA ·precomputed<>+0xabd8(SB)/8, $0x919a6df3fc35975b
-DATA ·precomputed<>+0xabe0(SB)/8, $0xb41ed4aab616452e
-DATA ·precomputed<>+0xabe8(SB)/8, $0xe58689cd5cfc6abb
-DATA ·precomputed<>+0xabf0(SB)/8, $0xeac325d9f389b025
-DATA ·precomputed<>+0xabf8(SB)/8, $0x45ceb1e68f255de5
-DATA ·precomputed<>+0xac00(SB)/8, $0xda4a07155e46cdff
-DATA ·precomputed<>+0xac08(SB)/8, $0x8a860a550f6c761c
-DATA ·precomputed<>+0xac10(SB)/8, $0xe13952745fe1eef1
-DATA ·precomputed<>+0xac18(SB)/8, $0x256e296af7bc535f
-DATA ·precomputed<>+0xac20(SB)/8, $0xf3d4b06c2755dd27
-DATA ·precomputed<>+0xac28(SB)/8, $0x3ced6ee5bb530c26
-DATA ·precomputed<>+0xac30(SB)/8, $0x73249ad796ba599d
-DATA ·precomputed<>+0xac38(SB)/8, $0x5de8dab3e8a66027
-DATA ·precomputed<>+0xac40(SB)/8, $0xa4892840c2f97e01
-DATA ·precomputed<>+0xac48(SB)/8, $0xbe0dbe49427945be
-DATA ·precomputed<>+0xac50(SB)/8, $0x6fd86a7ba57d4e4f
-DATA ·precomputed<>+0xac58(SB)/8, $0x7f56c3e004a2e778
-DATA ·precomputed<>+0xac60(SB)/8, $0x734708ccffc13d49
-DATA ·precomputed<>+0xac68(SB)/8, $0x3c1d9413788d31fe
-DATA ·precomputed<>+0xac70(SB)/8, $0xfe85545b8d3e4c36
-DATA ·precomputed<>+0xac78(SB)/8, $0xcca441fc8815129c
-DATA ·precomputed<>+0xac80(SB)/8, $0x2e2095e215e3d172
-DATA ·precomputed<>+0xac88(SB)/8, $0xc0c8d3c464b43e81
-DATA ·precomputed<>+0xac90(SB)/8, $0x84557abc68e802e
-DATA ·precomputed<>+0xac98(SB)/8, $0xa6b7359030d239b9
-DATA ·precomputed<>+0xaca0(SB)/8, $0x61ec00a9b67b0548
-DATA ·precomputed<>+0xaca8(SB)/8, $0x630059deb8ab138d
-DATA ·precomputed<>+0xacb0(SB)/8, $0x800abf0136ca9888
-DATA ·precomputed<>+0xacb8(SB)/8, $0xe26d644a9517149e
-DATA ·precomputed<>+0xacc0(SB)/8, $0x775d5a9858bf21d9
-DATA ·precomputed<>+0xacc8(SB)/8, $0xeb6846dbeab706
-DATA ·precomputed<>+0xacd0(SB)/8, $0x9d714c9f8232d477
-DATA ·precomputed<>+0xacd8(SB)/8, $0x7cde2c3eb70f91c2
-DATA ·precomputed<>+0xace0(SB)/8, $0xe6d0a8cee9871f0c
-DATA ·precomputed<>+0xace8(SB)/8, $0x902bc60b19e8444a
-DATA ·precomputed<>+0xacf0(SB)/8, $0x8651ed57ff0cd43a
-DATA ·precomputed<>+0xacf8(SB)/8, $0x4418cc07d480d222
-DATA ·precomputed<>+0xad00(SB)/8, $0xb5e0c7e3f3cbe01d
-DATA ·precomputed<>+0xad08(SB)/8, $0xbf4a899fe43adcdf
-DATA ·precomputed<>+0xad10(SB)/8, $0xb89b022c78f8f79d
-DATA ·precomputed<>+0xad18(SB)/8, $0x79cbbf97f42c797c
-DATA ·precomputed<>+0xad20(SB)/8, $0x46d73cc559d53cc1
-DATA ·precomputed<>+0xad28(SB)/8, $0x99f683e64ffca67c
-DATA ·precomputed<>+0xad30(SB)/8, $0x527c16ec98865e5b
-DATA ·precomputed<>+0xad38(SB)/8, $0xc844b70ff68f8ee0
-DATA ·precomputed<>+0xad40(SB)/8, $0xcffcccc0c9854994
-DATA ·precomputed<>+0xad48(SB)/8, $0x4aafcc1574926d5d
-DATA ·precomputed<>+0xad50(SB)/8, $0xeb084832835aea59
-DATA ·precomputed<>+0xad58(SB)/8, $0xcb317b5f20df21cf
-DATA ·precomputed<>+0xad60(SB)/8, $0x3c45b084e43d1853
-DATA ·precomputed<>+0xad68(SB)/8, $0xd12c9411b93b9167
-DATA ·precomputed<>+0xad70(SB)/8, $0xb090198219316bdf
-DATA ·precomputed<>+0xad78(SB)/8, $0x76bfa2acd11ab5e2
-DATA ·precomputed<>+0xad80(SB)/8, $0x22bf23cb4e84d3e9
-DATA ·precomputed<>+0xad88(SB)/8, $0x96ec9f8ed1572d4a
-DATA ·precomputed<>+0xad90(SB)/8, $0x31594ae4080ba39a
-DATA ·precomputed<>+0xad98(SB)/8, $0x105b5677adc6bae4
-DATA ·precomputed<>+0xada0(SB)/8, $0x501e45dda644e230
-DATA ·precomputed<>+0xada8(SB)/8, $0xeb571f2764573126
-DATA ·precomputed<>+0xadb0(SB)/8, $0x1fc3d478a36ac1ef
-DATA ·precomputed<>+0xadb8(SB)/8, $0xbd5fcee8327c7da7
-DATA ·precomputed<>+0xadc0(SB)/8, $0x1b2b188534a70bfe
-DATA ·precomputed<>+0xadc8(SB)/8, $0xcfa421f7a36345c5
-DATA ·precomputed<>+0xadd0(SB)/8, $0x2f33f4cc6f322ae9
-DATA ·precomputed<>+0xadd8(SB)/8, $0xdac0bb754dabb7a0
-DATA ·precomputed<>+0xade0(SB)/8, $0xfba35536923cea0a
-DATA ·precomputed<>+0xade8(SB)/8, $0xc16f73e56d9cb50c
-DATA ·precomputed<>+0xadf0(SB)/8, $0x23216dc625812c96
-DATA ·precomputed<>+0xadf8(SB)/8, $0x82945e673d7ab424
-DATA ·precomputed<>+0xae00(SB)/8, $0x829577b20796605d
-DATA ·precomputed<>+0xae08(SB)/8, $0x47fa09785026907f
-DATA ·precomputed<>+0xae10(SB)/8, $0x997011692d0f68b2
-DATA ·precomputed<>+0xae18(SB)/8, $0xa0d24be4bc1e46db
-DATA ·precomputed<>+0xae20(SB)/8, $0xcf409c2e2eb2ac98
-DATA ·precomputed<>+0xae28(SB)/8, $0x7b59c3c597f3ff5c
-DATA ·precomputed<>+0xae30(SB)/8, $0x2f4576bd81ed7f02
-DATA ·precomputed<>+0xae38(SB)/8, $0xe41339e510399c22
-DATA ·precomputed<>+0xae40(SB)/8, $0x562d77442ecce0e6
-DATA ·precomputed<>+0xae48(SB)/8, $0x1afc38699a1656c2
-DATA ·precomputed<>+0xae50(SB)/8, $0x5714820e86200621
-DATA ·precomputed<>+0xae58(SB)/8, $0xee36f7b6566da805
-DATA ·precomputed<>+0xae60(SB)/8, $0xe66941046e5a2a06
-DATA ·precomputed<>+0xae68(SB)/8, $0xd4390b748caabaab
-DATA ·precomputed<>+0xae70(SB)/8, $0x9db2099893b0d142
-DATA ·precomputed<>+0xae78(SB)/8, $0xe1811b817926baf3
-DATA ·precomputed<>+0xae80(SB)/8, $0xd578f2ed08bc1965
-DATA ·precomputed<>+0xae88(SB)/8, $0x9a7e31e235f00d5d
-DATA ·precomputed<>+0xae90(SB)/8, $0x3725b65cc9007327
-DATA ·precomputed<>+0xae98(SB)/8, $0x157cfe9529c36f38
-DATA ·precomputed<>+0xaea0(SB)/8, $0xb1c3d0f123a521d7
-DATA ·precomputed<>+0xaea8(SB)/8, $0x3e65fb7cb8a9ae08
-DATA ·precomputed<>+0xaeb0(SB)/8, $0xed48bcf9690b8f78
-DATA ·precomputed<>+0xaeb8(SB)/8, $0xe5f46b2c90d5dfde
-DATA ·precomputed<>+0xaec0(SB)/8, $0x14aebb350b6da2b6
-DATA ·precomputed<>+0xaec8(SB)/8, $0x91fef3367b65ee55
-DATA ·precomputed<>+0xaed0(SB)/8, $0xdb77b57b1a0a004d
-DATA ·precomputed<>+0xaed8(SB)/8, $0x1c59b62823aef1f7
-DATA ·precomputed<>+0xaee0(SB)/8, $0xa79c8c893ec88d18
-DATA ·precomputed<>+0xaee8(SB)/8, $0x52cca38a4fde31f1
-DATA ·precomputed<>+0xaef0(SB)/8, $0xe2f64a94cf4e30b6
-DATA ·precomputed<>+0xaef8(SB)/8, $0x2b4cdbd737ff1cbb
-DATA ·precomputed<>+0xaf00(SB)/8, $0xcb542f680b566632
-DATA ·precomputed<>+0xaf08(SB)/8, $0xedab69a6676fae9f
-DATA ·precomputed<>+0xaf10(SB)/8, $0xc4531e0bc45cb6f0
-DATA ·precomputed<>+0xaf18(SB)/8, $0xf967ec6eb88fe4a5
-DATA ·precomputed<>+0xaf20(SB)/8, $0x4ab4e6452919785d
-DATA ·precomputed<>+0xaf28(SB)/8, $0x2dcaefca7a17b20f
-DATA ·precomputed<>+0xaf30(SB)/8, $0x65c89c05da7afaa0
-DATA ·precomputed<>+0xaf38(SB)/8, $0x59ea00e94dafc6a2
-DATA ·precomputed<>+0xaf40(SB)/8, $0xa6362bf88eb43733
-DATA ·precomputed<>+0xaf48(SB)/8, $0xae2dddc112011803
-DATA ·precomputed<>+0xaf50(SB)/8, $0xbbf516b10bb2aaf8
-DATA ·precomputed<>+0xaf58(SB)/8, $0x9f2627e9d8de21a3
-DATA ·precomputed<>+0xaf60(SB)/8, $0xaf30439a43a20b74
-DATA ·precomputed<>+0xaf68(SB)/8, $0xac7e07b04ce86408
-DATA ·precomputed<>+0xaf70(SB)/8, $0xc54cdff27c10566b
-DATA ·precomputed<>+0xaf78(SB)/8, $0xe3ee06226279af0a
-DATA ·precomputed<>+0xaf80(SB)/8, $0x57d09708f7770f95
-DATA ·precomputed<>+0xaf88(SB)/8, $0x6f0ba223123e020b
-DATA ·precomputed<>+0xaf90(SB)/8, $0x6c123fb96cd41559
-DATA ·precomputed<>+0xaf98(SB)/8, $0xc54f5c656fb30f58
-DATA ·precomputed<>+0xafa0(SB)/8, $0x5e168af3bbf7101c
-DATA ·precomputed<>+0xafa8(SB)/8, $0xf6d6dbdbce974455
-DATA ·precomputed<>+0xafb0(SB)/8, $0xa001f3b988313516
-DATA ·precomputed<>+0xafb8(SB)/8, $0xe6e4a26ddfb4ac20
-DATA ·precomputed<>+0xafc0(SB)/8, $0x74e7b7fc506f7dcd
-DATA ·precomputed<>+0xafc8(SB)/8, $0x985e85465d037d69
-DATA ·precomputed<>+0xafd0(SB)/8, $0xff00a4da1ec8d374
-DATA ·precomputed<>+0xafd8(SB)/8, $0x8c339ae3505b4180
-DATA ·precomputed<>+0xafe0(SB)/8, $0x78bcd4f23a5f71c4
-DATA ·precomputed<>+0xafe8(SB)/8, $0x2fb4d99f67ac3e9f
-DATA ·precomputed<>+0xaff0(SB)/8, $0x7dd25aa6ee65dad1
-DATA ·precomputed<>+0xaff8(SB)/8, $0x2fd63fc2b62c34dc
-DATA ·precomputed<>+0xb000(SB)/8, $0x43e0671fe43332ef
-DATA ·precomputed<>+0xb008(SB)/8, $0x71c5dd5b441c2218
-DATA ·precomputed<>+0xb010(SB)/8, $0x4c1d2c1fe922ba18
-DATA ·precomputed<>+0xb018(SB)/8, $0x558e9c2fd619cb67
-DATA ·precomputed<>+0xb020(SB)/8, $0xd04acde01ec51255
-DATA ·precomputed<>+0xb028(SB)/8, $0x824b3740af824507
-DATA ·precomputed<>+0xb030(SB)/8, $0x62d1b9de744c6afe
-DATA ·precomputed<>+0xb038(SB)/8, $0xb99616dbab0d52e3
-DATA ·precomputed<>+0xb040(SB)/8, $0x5ec9c0847f6a1cda
-DATA ·precomputed<>+0xb048(SB)/8, $0x68839c14823d6350
-DATA ·precomputed<>+0xb050(SB)/8, $0xcbbb678b03bad007
-DATA ·precomputed<>+0xb058(SB)/8, $0x6a7272554788854e
-DATA ·precomputed<>+0xb060(SB)/8, $0xc747fea2ef5c7294
-DATA ·precomputed<>+0xb068(SB)/8, $0x748527784875e775
-DATA ·precomputed<>+0xb070(SB)/8, $0xad7b8e8baa61a893
-DATA ·precomputed<>+0xb078(SB)/8, $0x18ff333540da98b1
-DATA ·precomputed<>+0xb080(SB)/8, $0xf4396ca48d15f612
-DATA ·precomputed<>+0xb088(SB)/8, $0xdc27609571a8a910
-DATA ·precomputed<>+0xb090(SB)/8, $0xb388a29a0de4801e
-DATA ·precomputed<>+0xb098(SB)/8, $0xf1786468f2330765
-DATA ·precomputed<>+0xb0a0(SB)/8, $0xe63e153bbefdde3b
-DATA ·precomputed<>+0xb0a8(SB)/8, $0xecffc16c46b8ce1a
-DATA ·precomputed<>+0xb0b0(SB)/8, $0xab70c46999313e17
-DATA ·precomputed<>+0xb0b8(SB)/8, $0x263b28a2da50ffc5
-DATA ·precomputed<>+0xb0c0(SB)/8, $0xa51e9f4f5529ec80
-DATA ·precomputed<>+0xb0c8(SB)/8, $0x420274a6fd146d1
-DATA ·precomputed<>+0xb0d0(SB)/8, $0xbbf1ab668e300c2c
-DATA ·precomputed<>+0xb0d8(SB)/8, $0x2d0b3a9d41653fea
-DATA ·precomputed<>+0xb0e0(SB)/8, $0x2be2180f23a495b9
-DATA ·precomputed<>+0xb0e8(SB)/8, $0x6ef3c3745415d73b
-DATA ·precomputed<>+0xb0f0(SB)/8, $0x1d3e1ec8c67ae4fc
-DATA ·precomputed<>+0xb0f8(SB)/8, $0xa5839e9c98d31f5f
-DATA ·precomputed<>+0xb100(SB)/8, $0xc4c8c8b9c3fb6779
-DATA ·precomputed<>+0xb108(SB)/8, $0x54fc9c6ca57ee680
-DATA ·precomputed<>+0xb110(SB)/8, $0x99003261b7673efc
-DATA ·precomputed<>+0xb118(SB)/8, $0x67670079b11779e
-DATA ·precomputed<>+0xb120(SB)/8, $0x3b151da6250891c3
-DATA ·precomputed<>+0xb128(SB)/8, $0x4720decb5d785ab4
-DATA ·precomputed<>+0xb130(SB)/8, $0x872b351ffa6759c6
-DATA ·precomputed<>+0xb138(SB)/8, $0x4c7ad29aa4f1008e
-DATA ·precomputed<>+0xb140(SB)/8, $0xf54114d637d77c01
-DATA ·precomputed<>+0xb148(SB)/8, $0xc2e18a4b41023c87
-DATA ·precomputed<>+0xb150(SB)/8, $0x6fa6c3d39e6e1221
-DATA ·precomputed<>+0xb158(SB)/8, $0x9a6cf4e2410e48f9
-DATA ·precomputed<>+0xb160(SB)/8, $0xe0881140b181828f
-DATA ·precomputed<>+0xb168(SB)/8, $0x17c6df2978cb7833
-DATA ·precomputed<>+0xb170(SB)/8, $0xc1eb8df1a7cd2367
-DATA ·precomputed<>+0xb178(SB)/8, $0xb78f1c8dca89f922
-DATA ·precomputed<>+0xb180(SB)/8, $0x98bbcacbd862cef
-DATA ·precomputed<>+0xb188(SB)/8, $0x58f4234d0f8f23c3
-DATA ·precomputed<>+0xb190(SB)/8, $0x8bce359f8145228f
-DATA ·precomputed<>+0xb198(SB)/8, $0xb605d0f79812c368
-DATA ·precomputed<>+0xb1a0(SB)/8, $0x58b4dddfe4018fcd
-DATA ·precomputed<>+0xb1a8(SB)/8, $0xb8139ddeff797961
-DATA ·precomputed<>+0xb1b0(SB)/8, $0x631ee83dd55d8257
-DATA ·precomputed<>+0xb1b8(SB)/8, $0xac8b3932245719bf
-DATA ·precomputed<>+0xb1c0(SB)/8, $0xf25d4777d0d42887
-DATA ·precomputed<>+0xb1c8(SB)/8, $0x4b4892182b7a2707
-DATA ·precomputed<>+0xb1d0(SB)/8, $0x1b4dbf9b2d3966fe
-DATA ·precomputed<>+0xb1d8(SB)/8, $0x4bac5f4841ae2bec
-DATA ·precomputed<>+0xb1e0(SB)/8, $0x68db27331733964e
-DATA ·precomputed<>+0xb1e8(SB)/8, $0xa10c5dff6a814a69
-DATA ·precomputed<>+0xb1f0(SB)/8, $0x84ebdaf0a9898348
-DATA ·precomputed<>+0xb1f8(SB)/8, $0x60e46823a74da3f4
-DATA ·precomputed<>+0xb200(SB)/8, $0x66bf006dd1cd7733
-DATA ·precomputed<>+0xb208(SB)/8, $0xd6f2830b4f6d119b
-DATA ·precomputed<>+0xb210(SB)/8, $0x3c74814518f87cbe
-DATA ·precomputed<>+0xb218(SB)/8, $0x6eb4b80846fb653c
-DATA ·precomputed<>+0xb220(SB)/8, $0x4541f60effd843d8
-DATA ·precomputed<>+0xb228(SB)/8, $0x40a30774c8068fe1
-DATA ·precomputed<>+0xb230(SB)/8, $0xef7ba561a8c0e3e8
-DATA ·precomputed<>+0xb238(SB)/8, $0xe50885160c8b52
-DATA ·precomputed<>+0xb240(SB)/8, $0x452b6b1d93420649
-DATA ·precomputed<>+0xb248(SB)/8, $0x9dd6452b6ed5d7f6
-DATA ·precomputed<>+0xb250(SB)/8, $0x4a9b8fa1e687b577
-DATA ·precomputed<>+0xb258(SB)/8, $0x1e203166854c49d7
-DATA ·precomputed<>+0xb260(SB)/8, $0xf523667ea45feba8
-DATA ·precomputed<>+0xb268(SB)/8, $0x9ecb4d445f9f4a56
-DATA ·precomputed<>+0xb270(SB)/8, $0xb8655a5f7fb1c641
-DATA ·precomputed<>+0xb278(SB)/8, $0x5516401a87c26201
-DATA ·precomputed<>+0xb280(SB)/8, $0x1ccb81d5656ea9db
-DATA ·precomputed<>+0xb288(SB)/8, $0xad4a317281e94681
-DATA ·precomputed<>+0xb290(SB)/8, $0x200fa8e2504e1bf1
-DATA ·precomputed<>+0xb298(SB)/8, $0x9156004f4f37c463
-DATA ·precomputed<>+0xb2a0(SB)/8, $0x42cdf4f12a489301
-DATA ·precomputed<>+0xb2a8(SB)/8, $0x60508af06530ec7e
-DATA ·precomputed<>+0xb2b0(SB)/8, $0x1d48f3cf8b025fe9
-DATA ·precomputed<>+0xb2b8(SB)/8, $0xbdf7cf89fe96dfa5
-DATA ·precomputed<>+0xb2c0(SB)/8, $0x246777540d2face6
-DATA ·precomputed<>+0xb2c8(SB)/8, $0xd9f7da7fa8ade59c
-DATA ·precomputed<>+0xb2d0(SB)/8, $0x27e3ad777fa7df06
-DATA ·precomputed<>+0xb2d8(SB)/8, $0x35a4caf0f60395ad
-DATA ·precomputed<>+0xb2e0(SB)/8, $0xfaef231ce4e701ac
-DATA ·precomputed<>+0xb2e8(SB)/8, $0x9e13597623755489
-DATA ·precomputed<>+0xb2f0(SB)/8, $0x7caa73ab43554ad3
-DATA ·precomputed<>+0xb2f8(SB)/8, $0x9d8554d994f0d878
-DATA ·precomputed<>+0xb300(SB)/8, $0xc95ef59ca62d1fca
-DATA ·precomputed<>+0xb308(SB)/8, $0xcddb82f1e4b43f27
-DATA ·precomputed<>+0xb310(SB)/8, $0x5329584c0aef7948
-DATA ·precomputed<>+0xb318(SB)/8, $0xe2712507de3650b8
-DATA ·precomputed<>+0xb320(SB)/8, $0x6402c2c169bb4b71
-DATA ·precomputed<>+0xb328(SB)/8, $0xad47b3010a01c709
-DATA ·precomputed<>+0xb330(SB)/8, $0x6d014bcdbc6395c1
-DATA ·precomputed<>+0xb338(SB)/8, $0x3920d77e73df37cf
-DATA ·precomputed<>+0xb340(SB)/8, $0xe42040cea85b81d5
-DATA ·precomputed<>+0xb348(SB)/8, $0x4d28aca740fa9631
-DATA ·precomputed<>+0xb350(SB)/8, $0x76fed3d7e04b755
-DATA ·precomputed<>+0xb358(SB)/8, $0xdde3d3471129ce4c
-DATA ·precomputed<>+0xb360(SB)/8, $0x77f785d71179af95
-DATA ·precomputed<>+0xb368(SB)/8, $0x4782f842f74e0672
-DATA ·precomputed<>+0xb370(SB)/8, $0xbd068cc10b4597cb
-DATA ·precomputed<>+0xb378(SB)/8, $0x3d6d4b2a8f4c65b7
-DATA ·precomputed<>+0xb380(SB)/8, $0x9894db4b3371c24
-DATA ·precomputed<>+0xb388(SB)/8, $0x1a93b35ed4e058e2
-DATA ·precomputed<>+0xb390(SB)/8, $0xf877724139ad7d54
-DATA ·precomputed<>+0xb398(SB)/8, $0x14e4bce3c1288384
-DATA ·precomputed<>+0xb3a0(SB)/8, $0xdc6aac1e46b442cd
-DATA ·precomputed<>+0xb3a8(SB)/8, $0x2e61204d2bf89765
-DATA ·precomputed<>+0xb3b0(SB)/8, $0x6eac466aef97708e
-DATA ·precomputed<>+0xb3b8(SB)/8, $0xfef9b79d703c84d4
-DATA ·precomputed<>+0xb3c0(SB)/8, $0xe0642d18f9066d73
-DATA ·precomputed<>+0xb3c8(SB)/8, $0xbe1d2ec3a098b3bf
-DATA ·precomputed<>+0xb3d0(SB)/8, $0xefee860c21b4954c
-DATA ·precomputed<>+0xb3d8(SB)/8, $0x4d7c4e6d27b629bb
-DATA ·precomputed<>+0xb3e0(SB)/8, $0xcd8f1e038e8b81b0
-DATA ·precomputed<>+0xb3e8(SB)/8, $0x4a80168e7fe77eb0
-DATA ·precomputed<>+0xb3f0(SB)/8, $0x4d977591ce247c73
-DATA ·precomputed<>+0xb3f8(SB)/8, $0x9b30c9f2857e0356
-DATA ·precomputed<>+0xb400(SB)/8, $0x795c5e31a66f75f
-DATA ·precomputed<>+0xb408(SB)/8, $0x948cf0fc07fe9318
-DATA ·precomputed<>+0xb410(SB)/8, $0x1e92632716f59bd5
-DATA ·precomputed<>+0xb418(SB)/8, $0x5b5d127d7f571475
-DATA ·precomputed<>+0xb420(SB)/8, $0x7928a5fec8cd574a
-DATA ·precomputed<>+0xb428(SB)/8, $0x3944b93ae00da2df
-DATA ·precomputed<>+0xb430(SB)/8, $0x9f2238761ebafb12
-DATA ·precomputed<>+0xb438(SB)/8, $0x471cf88e4a09f5a2
-DATA ·precomputed<>+0xb440(SB)/8, $0xc02495ba2940e9de
-DATA ·precomputed<>+0xb448(SB)/8, $0x357299f5b6d2b72c
-DATA ·precomputed<>+0xb450(SB)/8, $0x132b4c6306a9c2e4
-DATA ·precomputed<>+0xb458(SB)/8, $0xe90a90c5084d8c67
-DATA ·precomputed<>+0xb460(SB)/8, $0xf0c9e94ace1b471
-DATA ·precomputed<>+0xb468(SB)/8, $0x769457e1f1e3d8f6
-DATA ·precomputed<>+0xb470(SB)/8, $0xc4c30ce3d71118c6
-DATA ·precomputed<>+0xb478(SB)/8, $0xdb5fd8d66b652a3d
-DATA ·precomputed<>+0xb480(SB)/8, $0x8bf6a810bc4f1092
-DATA ·precomputed<>+0xb488(SB)/8, $0x8891d4a2a9e80945
-DATA ·precomputed<>+0xb490(SB)/8, $0xb8fbdfecd1ef16cb
-DATA ·precomputed<>+0xb498(SB)/8, $0xd17f1dd9c08848bb
-DATA ·precomputed<>+0xb4a0(SB)/8, $0xf4e292722ac9ab25
-DATA ·precomputed<>+0xb4a8(SB)/8, $0xeb6e20c2953a962d
-DATA ·precomputed<>+0xb4b0(SB)/8, $0x5d38e8c2531cb255
-DATA ·precomputed<>+0xb4b8(SB)/8, $0xe2a5b7241aeef81b
-DATA ·precomputed<>+0xb4c0(SB)/8, $0x90df1074def5978
-DATA ·precomputed<>+0xb4c8(SB)/8, $0x1abcfa322d8a5f3a
-DATA ·precomputed<>+0xb4d0(SB)/8, $0x2976b012a34b70db
-DATA ·precomputed<>+0xb4d8(SB)/8, $0x90f541d4fa5e75b9
-DATA ·precomputed<>+0xb4e0(SB)/8, $0x50c991a937a6e9a0
-DATA ·precomputed<>+0xb4e8(SB)/8, $0xf51e8693903bffda
-DATA ·precomputed<>+0xb4f0(SB)/8, $0xa2697ab48d344776
-DATA ·precomputed<>+0xb4f8(SB)/8, $0x77134fe8e34a7850
-DATA ·precomputed<>+0xb500(SB)/8, $0xaf91a9e0786e6978
-DATA ·precomputed<>+0xb508(SB)/8, $0x544784f048d23727
-DATA ·precomputed<>+0xb510(SB)/8, $0x727ef6459df519f
-DATA ·precomputed<>+0xb518(SB)/8, $0xb39ea1adceb9d7db
-DATA ·precomputed<>+0xb520(SB)/8, $0x9dd7e5284438a4ac
-DATA ·precomputed<>+0xb528(SB)/8, $0xc55263e8fc8e214b
-DATA ·precomputed<>+0xb530(SB)/8, $0xdb0ccbe489cb0310
-DATA ·precomputed<>+0xb538(SB)/8, $0x6477983814edd54d
-DATA ·precomputed<>+0xb540(SB)/8, $0x723e5d3da72597ac
-DATA ·precomputed<>+0xb548(SB)/8, $0x4a49847a4269aff7
-DATA ·precomputed<>+0xb550(SB)/8, $0x75ad9088443b8db6
-DATA ·precomputed<>+0xb558(SB)/8, $0x9b7d00d5a51d80a1
-DATA ·precomputed<>+0xb560(SB)/8, $0xce1c7049e5e04ac2
-DATA ·precomputed<>+0xb568(SB)/8, $0xb8c2793c2a792bde
-DATA ·precomputed<>+0xb570(SB)/8, $0xde9220a0e410e175
-DATA ·precomputed<>+0xb578(SB)/8, $0x4b3a9b859401bc2a
-DATA ·precomputed<>+0xb580(SB)/8, $0xac3d04b9c3da5cb9
-DATA ·precomputed<>+0xb588(SB)/8, $0x7299730150578bb8
-DATA ·precomputed<>+0xb590(SB)/8, $0x33395812e586f32e
-DATA ·precomputed<>+0xb598(SB)/8, $0x22ef702748be766e
-DATA ·precomputed<>+0xb5a0(SB)/8, $0xe175bd48b98dd0c6
-DATA ·precomputed<>+0xb5a8(SB)/8, $0x49ae7c06b075b217
-DATA ·precomputed<>+0xb5b0(SB)/8, $0x9ad3090fd1632bd0
-DATA ·precomputed<>+0xb5b8(SB)/8, $0x57c20a5bcf0285e5
-DATA ·precomputed<>+0xb5c0(SB)/8, $0xc7eaf2c5f037d15f
-DATA ·precomputed<>+0xb5c8(SB)/8, $0x410b627ec7afbf8b
-DATA ·precomputed<>+0xb5d0(SB)/8, $0x243cdb79d7bedf50
-DATA ·precomputed<>+0xb5d8(SB)/8, $0x4813b51be6512d0
-DATA ·precomputed<>+0xb5e0(SB)/8, $0x2fb77cab26beca2f
-DATA ·precomputed<>+0xb5e8(SB)/8, $0xbb6019757baa3099
-DATA ·precomputed<>+0xb5f0(SB)/8, $0x8c327e5940bda4d0
-DATA ·precomputed<>+0xb5f8(SB)/8, $0x85b9c76413c23444
-DATA ·precomputed<>+0xb600(SB)/8, $0xf556512c0bb820c6
-DATA ·precomputed<>+0xb608(SB)/8, $0xca0a927fb9a9d590
-DATA ·precomputed<>+0xb610(SB)/8, $0xf1bc66248324037b
-DATA ·precomputed<>+0xb618(SB)/8, $0x7ab642afd28372e
-DATA ·precomputed<>+0xb620(SB)/8, $0xecb0fc7afd34f6e
-DATA ·precomputed<>+0xb628(SB)/8, $0x1d13333f0fdd5dd9
-DATA ·precomputed<>+0xb630(SB)/8, $0x2d0de3356556295
-DATA ·precomputed<>+0xb638(SB)/8, $0x8ba8bd15e17b31f2
-DATA ·precomputed<>+0xb640(SB)/8, $0x26960d9c08ed59d8
-DATA ·precomputed<>+0xb648(SB)/8, $0x9b76dced4a72854d
-DATA ·precomputed<>+0xb650(SB)/8, $0xca2f579afdc3b7f5
-DATA ·precomputed<>+0xb658(SB)/8, $0xac27028a6cae8b4f
-DATA ·precomputed<>+0xb660(SB)/8, $0x48fd1a4942326aa5
-DATA ·precomputed<>+0xb668(SB)/8, $0xb95fdb4f5759c63f
-DATA ·precomputed<>+0xb670(SB)/8, $0x27655358e0a96abf
-DATA ·precomputed<>+0xb678(SB)/8, $0x26d38b6436ed53b0
-DATA ·precomputed<>+0xb680(SB)/8, $0x9849bb9d230c0ab9
-DATA ·precomputed<>+0xb688(SB)/8, $0x1e34a04cc23f96cc
-DATA ·precomputed<>+0xb690(SB)/8, $0x710dc1fb9b52cf24
-DATA ·precomputed<>+0xb698(SB)/8, $0x4504d71615122bad
-DATA ·precomputed<>+0xb6a0(SB)/8, $0xe537f78c2849b6f
-DATA ·precomputed<>+0xb6a8(SB)/8, $0x2310059233ad0648
-DATA ·precomputed<>+0xb6b0(SB)/8, $0xaa76d87be574dca4
-DATA ·precomputed<>+0xb6b8(SB)/8, $0xc6ce3cc70cde2e9a
-DATA ·precomputed<>+0xb6c0(SB)/8, $0x3cfdd49fc6d1f3e
-DATA ·precomputed<>+0xb6c8(SB)/8, $0x20af588615adaba0
-DATA ·precomputed<>+0xb6d0(SB)/8, $0x74c6c943754dd268
-DATA ·precomputed<>+0xb6d8(SB)/8, $0xe7d52cdf7977717e
-DATA ·precomputed<>+0xb6e0(SB)/8, $0x9a81d4403b414dd2
-DATA ·precomputed<>+0xb6e8(SB)/8, $0x697c7b4ad790a4c7
-DATA ·precomputed<>+0xb6f0(SB)/8, $0xb1b7735fedbce1f2
-DATA ·precomputed<>+0xb6f8(SB)/8, $0xbd90e63fbefa7210
-DATA ·precomputed<>+0xb700(SB)/8, $0x888466f6bb2adcb3
-DATA ·precomputed<>+0xb708(SB)/8, $0x44d1881719d880e3
-DATA ·precomputed<>+0xb710(SB)/8, $0xb7b5a733f9fac392
-DATA ·precomputed<>+0xb718(SB)/8, $0x941367900ea4f235
-DATA ·precomputed<>+0xb720(SB)/8, $0xcbbc6ec50836d8f6
-DATA ·precomputed<>+0xb728(SB)/8, $0x6f03c9b7bc9fb999
-DATA ·precomputed<>+0xb730(SB)/8, $0x819b50f06b07b5b9
-DATA ·precomputed<>+0xb738(SB)/8, $0x73bad4678ea69a8f
-DATA ·precomputed<>+0xb740(SB)/8, $0x2e2b0dad7ab207d1
-DATA ·precomputed<>+0xb748(SB)/8, $0x89abbd839b373211
-DATA ·precomputed<>+0xb750(SB)/8, $0x45d34ebc8e35e2bb
-DATA ·precomputed<>+0xb758(SB)/8, $0x67ba3ac5064856f6
-DATA ·precomputed<>+0xb760(SB)/8, $0xb5527dbea52c7676
-DATA ·precomputed<>+0xb768(SB)/8, $0x906fb21771294012
-DATA ·precomputed<>+0xb770(SB)/8, $0x65fca552ab305260
-DATA ·precomputed<>+0xb778(SB)/8, $0x89ac52a314ee193b
-DATA ·precomputed<>+0xb780(SB)/8, $0xca82efc454f1221a
-DATA ·precomputed<>+0xb788(SB)/8, $0x5aabdbec8d89b572
-DATA ·precomputed<>+0xb790(SB)/8, $0x99362f84b4628aee
-DATA ·precomputed<>+0xb798(SB)/8, $0x88939064da2832e4
-DATA ·precomputed<>+0xb7a0(SB)/8, $0x1ee8cddc1616dbe0
-DATA ·precomputed<>+0xb7a8(SB)/8, $0x2a4d6ec7d568b976
-DATA ·precomputed<>+0xb7b0(SB)/8, $0xbfec68cd627a376a
-DATA ·precomputed<>+0xb7b8(SB)/8, $0xefc51894383e3b72
-DATA ·precomputed<>+0xb7c0(SB)/8, $0x673aead488c06b1c
-DATA ·precomputed<>+0xb7c8(SB)/8, $0xea8af42049d9d4e8
-DATA ·precomputed<>+0xb7d0(SB)/8, $0xa7b4409acb9e86bf
-DATA ·precomputed<>+0xb7d8(SB)/8, $0x49f76f715414aa56
-DATA ·precomputed<>+0xb7e0(SB)/8, $0x6603c8018c13857a
-DATA ·precomputed<>+0xb7e8(SB)/8, $0x7c26f1c2ce742384
-DATA ·precomputed<>+0xb7f0(SB)/8, $0x42fb2242a665719
-DATA ·precomputed<>+0xb7f8(SB)/8, $0x2619f254e175b0c6
-DATA ·precomputed<>+0xb800(SB)/8, $0x8d6fbcc7d9b45206
-DATA ·precomputed<>+0xb808(SB)/8, $0xab735deabc4640
-DATA ·precomputed<>+0xb810(SB)/8, $0x428c7017810e37d1
-DATA ·precomputed<>+0xb818(SB)/8, $0xa436587227af5718
-DATA ·precomputed<>+0xb820(SB)/8, $0x8f1958230a910146
-DATA ·precomputed<>+0xb828(SB)/8, $0xc13ccdd70ff76704
-DATA ·precomputed<>+0xb830(SB)/8, $0x59d34ad644d6f1c8
-DATA ·precomputed<>+0xb838(SB)/8, $0xd3dfa6b2795b61b4
-DATA ·precomputed<>+0xb840(SB)/8, $0x698ec2c96fd8b309
-DATA ·precomputed<>+0xb848(SB)/8, $0x512ea17aa055809f
-DATA ·precomputed<>+0xb850(SB)/8, $0x28cb44e78822943c
-DATA ·precomputed<>+0xb858(SB)/8, $0x434dc709deb7d3e6
-DATA ·precomputed<>+0xb860(SB)/8, $0xb8b324d11be76434
-DATA ·precomputed<>+0xb868(SB)/8, $0x7cf24ed3382ff0b1
-DATA ·precomputed<>+0xb870(SB)/8, $0xda8265fe2905e726
-DATA ·precomputed<>+0xb878(SB)/8, $0xd57b3915ee6c3abc
-DATA ·precomputed<>+0xb880(SB)/8, $0xab53ceb8cc810f2b
-DATA ·precomputed<>+0xb888(SB)/8, $0xf2a2ef5633e82405
-DATA ·precomputed<>+0xb890(SB)/8, $0x1f3b805aadd58299
-DATA ·precomputed<>+0xb898(SB)/8, $0x755fdd9817c68b9e
-DATA ·precomputed<>+0xb8a0(SB)/8, $0xe7245945a9680fbe
-DATA ·precomputed<>+0xb8a8(SB)/8, $0x5649327b9d04aef
-DATA ·precomputed<>+0xb8b0(SB)/8, $0xa14419ab4cca6be9
-DATA ·precomputed<>+0xb8b8(SB)/8, $0x4ef9a8a3913cda8d
-DATA ·precomputed<>+0xb8c0(SB)/8, $0xf9010095d2a819b5
-DATA ·precomputed<>+0xb8c8(SB)/8, $0x5291aaf948f2f653
-DATA ·precomputed<>+0xb8d0(SB)/8, $0xfa533907f0afe366
-DATA ·precomputed<>+0xb8d8(SB)/8, $0x88a58ecf8e279e27
-DATA ·precomputed<>+0xb8e0(SB)/8, $0xf077127fae130bc
-DATA ·precomputed<>+0xb8e8(SB)/8, $0xee9ccf1af8a54c75
-DATA ·precomputed<>+0xb8f0(SB)/8, $0x38a6783ebed82b6a
-DATA ·precomputed<>+0xb8f8(SB)/8, $0x9a1acb3ded414524
-DATA ·precomputed<>+0xb900(SB)/8, $0x74be252c73db2e04
-DATA ·precomputed<>+0xb908(SB)/8, $0x9ae8e815172b0309
-DATA ·precomputed<>+0xb910(SB)/8, $0xbed6c9b94c8db0e3
-DATA ·precomputed<>+0xb918(SB)/8, $0x4f7349503e5de340
-DATA ·precomputed<>+0xb920(SB)/8, $0xea00058cdc16a4db
-DATA ·precomputed<>+0xb928(SB)/8, $0x942f2cd6d40e71bf
-DATA ·precomputed<>+0xb930(SB)/8, $0x740f3799a1fc884d
-DATA ·precomputed<>+0xb938(SB)/8, $0x2274cfe4feffbac
-DATA ·precomputed<>+0xb940(SB)/8, $0x6b87df2e6fab80d9
-DATA ·precomputed<>+0xb948(SB)/8, $0xbb0b79380f6dbf8f
-DATA ·precomputed<>+0xb950(SB)/8, $0x857be9ef37a51a59
-DATA ·precomputed<>+0xb958(SB)/8, $0xc6e1e5ed21f6d539
-DATA ·precomputed<>+0xb960(SB)/8, $0x6127f88765c85bb7
-DATA ·precomputed<>+0xb968(SB)/8, $0xa2927659fead7944
-DATA ·precomputed<>+0xb970(SB)/8, $0xfceaedc86ca68b15
-DATA ·precomputed<>+0xb978(SB)/8, $0x85cb4fe1518aa0ac
-DATA ·precomputed<>+0xb980(SB)/8, $0x8281ae11cc83e714
-DATA ·precomputed<>+0xb988(SB)/8, $0xe66f54f174d151f0
-DATA ·precomputed<>+0xb990(SB)/8, $0x18fd9a92f53583f8
-DATA ·precomputed<>+0xb998(SB)/8, $0x79b86e0d7a7640e3
-DATA ·precomputed<>+0xb9a0(SB)/8, $0xb93345e018c18b35
-DATA ·precomputed<>+0xb9a8(SB)/8, $0x5c5a64b62a704008
-DATA ·precomputed<>+0xb9b0(SB)/8, $0x56186bff0b8f055d
-DATA ·precomputed<>+0xb9b8(SB)/8, $0xf8e26eba71bd3194
-DATA ·precomputed<>+0xb9c0(SB)/8, $0xe4e53ceed9c12e2a
-DATA ·precomputed<>+0xb9c8(SB)/8, $0x11983fc17fc1308f
-DATA ·precomputed<>+0xb9d0(SB)/8, $0x3eb4d84d892c2d0f
-DATA ·precomputed<>+0xb9d8(SB)/8, $0xa0bfc1ca74499723
-DATA ·precomputed<>+0xb9e0(SB)/8, $0x708344d90145176b
-DATA ·precomputed<>+0xb9e8(SB)/8, $0xbb2988e06f12e75b
-DATA ·precomputed<>+0xb9f0(SB)/8, $0xdf73ceadada67545
-DATA ·precomputed<>+0xb9f8(SB)/8, $0xf37069d12bb8f989
-DATA ·precomputed<>+0xba00(SB)/8, $0x2f9f06922dba550f
-DATA ·precomputed<>+0xba08(SB)/8, $0xcfa0edc31d66ae73
-DATA ·precomputed<>+0xba10(SB)/8, $0x78e227d0c5950701
-DATA ·precomputed<>+0xba18(SB)/8, $0x55992112228623f7
-DATA ·precomputed<>+0xba20(SB)/8, $0x78022bfdc2ae5c76
-DATA ·precomputed<>+0xba28(SB)/8, $0x55554ddaea3707b1
-DATA ·precomputed<>+0xba30(SB)/8, $0x762fc836e4a18473
-DATA ·precomputed<>+0xba38(SB)/8, $0x4b0a610895b38cf6
-DATA ·precomputed<>+0xba40(SB)/8, $0xa971792064f28fd
-DATA ·precomputed<>+0xba48(SB)/8, $0x6df6f9c9c8ad827c
-DATA ·precomputed<>+0xba50(SB)/8, $0x885c5c3b428167a0
-DATA ·precomputed<>+0xba58(SB)/8, $0xc1d8af03f25b359
-DATA ·precomputed<>+0xba60(SB)/8, $0x9dc49c7f2a6389e5
-DATA ·precomputed<>+0xba68(SB)/8, $0x8ce954d67b43cafb
-DATA ·precomputed<>+0xba70(SB)/8, $0xf2a60c3bca8f5f27
-DATA ·precomputed<>+0xba78(SB)/8, $0xf5a1f61e3aa5bf
-DATA ·precomputed<>+0xba80(SB)/8, $0xc723a26345ac4cfb
-DATA ·precomputed<>+0xba88(SB)/8, $0x82cff1d5353b2590
-DATA ·precomputed<>+0xba90(SB)/8, $0xe221e21a90b23430
-DATA ·precomputed<>+0xba98(SB)/8, $0x1fbac2a4d4a62143
-DATA ·precomputed<>+0xbaa0(SB)/8, $0x869e78b58db1c440
-DATA ·precomputed<>+0xbaa8(SB)/8, $0x97bc0e82516ae9c8
-DATA ·precomputed<>+0xbab0(SB)/8, $0x43665e02b2105ed
-DATA ·precomputed<>+0xbab8(SB)/8, $0xaf275ba2f9785e5e
-DATA ·precomputed<>+0xbac0(SB)/8, $0xa24a35e69cc17f65
-DATA ·precomputed<>+0xbac8(SB)/8, $0xc49b3e9a89d9abe0
-DATA ·precomputed<>+0xbad0(SB)/8, $0x82f403032fc09ae3
-DATA ·precomputed<>+0xbad8(SB)/8, $0xbffe7d4d002cc587
-DATA ·precomputed<>+0xbae0(SB)/8, $0x5511f4e6424ef713
-DATA ·precomputed<>+0xbae8(SB)/8, $0xb86bf654a658f660
-DATA ·precomputed<>+0xbaf0(SB)/8, $0x623388d91c8baea2
-DATA ·precomputed<>+0xbaf8(SB)/8, $0x60664a7133656759
-DATA ·precomputed<>+0xbb00(SB)/8, $0xf50559e854b9a737
-DATA ·precomputed<>+0xbb08(SB)/8, $0x3e60e80714d790cf
-DATA ·precomputed<>+0xbb10(SB)/8, $0xb0efaff2e3531c93
-DATA ·precomputed<>+0xbb18(SB)/8, $0xc3698d04ed7f71f1
-DATA ·precomputed<>+0xbb20(SB)/8, $0x2588b266d6751563
-DATA ·precomputed<>+0xbb28(SB)/8, $0x2b695a5dc22258f5
-DATA ·precomputed<>+0xbb30(SB)/8, $0x434177be2e77cb1c
-DATA ·precomputed<>+0xbb38(SB)/8, $0x915462ab518cabed
-DATA ·precomputed<>+0xbb40(SB)/8, $0xe7ab037d56bc0372
-DATA ·precomputed<>+0xbb48(SB)/8, $0x88f86b8a6711bd94
-DATA ·precomputed<>+0xbb50(SB)/8, $0xd15176233518c2ea
-DATA ·precomputed<>+0xbb58(SB)/8, $0x4aed8959c2f3f80a
-DATA ·precomputed<>+0xbb60(SB)/8, $0x2746a180a427c606
-DATA ·precomputed<>+0xbb68(SB)/8, $0x869c7ee34e4ba8da
-DATA ·precomputed<>+0xbb70(SB)/8, $0xd95b77cbdb1d6c9b
-DATA ·precomputed<>+0xbb78(SB)/8, $0xcd07db95eca9156
-DATA ·precomputed<>+0xbb80(SB)/8, $0x280a9940ff0b9400
-DATA ·precomputed<>+0xbb88(SB)/8, $0x9ce45dc2f2ad99c9
-DATA ·precomputed<>+0xbb90(SB)/8, $0x65f6d89bca6ba717
-DATA ·precomputed<>+0xbb98(SB)/8, $0xcf6a53554b3e3c1b
-DATA ·precomputed<>+0xbba0(SB)/8, $0xad250c3093d138c4
-DATA ·precomputed<>+0xbba8(SB)/8, $0x5bc6a2009bd8add7
-DATA ·precomputed<>+0xbbb0(SB)/8, $0x3ffce3ce0027041f
-DATA ·precomputed<>+0xbbb8(SB)/8, $0x8b9cc632e0aee0a1
-DATA ·precomputed<>+0xbbc0(SB)/8, $0x18996198d8447e16
-DATA ·precomputed<>+0xbbc8(SB)/8, $0x17195d76662171dd
-DATA ·precomputed<>+0xbbd0(SB)/8, $0x28cfe6a1f448b8e6
-DATA ·precomputed<>+0xbbd8(SB)/8, $0x8a3c28230658c923
-DATA ·precomputed<>+0xbbe0(SB)/8, $0xc548d899c35e852
-DATA ·precomputed<>+0xbbe8(SB)/8, $0xadf1cd2f2b378157
-DATA ·precomputed<>+0xbbf0(SB)/8, $0x999e41aff30113b6
-DATA ·precomputed<>+0xbbf8(SB)/8, $0xf87515a59cf4696f
-DATA ·precomputed<>+0xbc00(SB)/8, $0x8bfda3c0c1ac6b0a
-DATA ·precomputed<>+0xbc08(SB)/8, $0x52d291b5e5f22499
-DATA ·precomputed<>+0xbc10(SB)/8, $0xc96868e0350897ac
-DATA ·precomputed<>+0xbc18(SB)/8, $0xf8ca3b5df88814c3
-DATA ·precomputed<>+0xbc20(SB)/8, $0x88351eb49860902
-DATA ·precomputed<>+0xbc28(SB)/8, $0x66c547e7919f71d1
-DATA ·precomputed<>+0xbc30(SB)/8, $0xa4dd2416f7cc9dda
-DATA ·precomputed<>+0xbc38(SB)/8, $0xf1c2dd669d08bb49
-DATA ·precomputed<>+0xbc40(SB)/8, $0xda057a198184b81a
-DATA ·precomputed<>+0xbc48(SB)/8, $0xe2ff164ddcee64b6
-DATA ·precomputed<>+0xbc50(SB)/8, $0x456fa3714aad36ea
-DATA ·precomputed<>+0xbc58(SB)/8, $0x4f5eed2824cc0e41
-DATA ·precomputed<>+0xbc60(SB)/8, $0xf2c3de64f431797f
-DATA ·precomputed<>+0xbc68(SB)/8, $0xb45f253890b21441
-DATA ·precomputed<>+0xbc70(SB)/8, $0x320652f5d38d60d0
-DATA ·precomputed<>+0xbc78(SB)/8, $0xd54dfd3f3ad33169
-DATA ·precomputed<>+0xbc80(SB)/8, $0xa8b97cbf4c73e8cc
-DATA ·precomputed<>+0xbc88(SB)/8, $0x44be1c24d00148e1
-DATA ·precomputed<>+0xbc90(SB)/8, $0x67e64a430e69ffab
-DATA ·precomputed<>+0xbc98(SB)/8, $0x7fd3571981b3e664
-DATA ·precomputed<>+0xbca0(SB)/8, $0xef835633938f6e19
-DATA ·precomputed<>+0xbca8(SB)/8, $0xc64856a5cb8c1a6f
-DATA ·precomputed<>+0xbcb0(SB)/8, $0x4d38ef478bba8ca2
-DATA ·precomputed<>+0xbcb8(SB)/8, $0x5e0887660e17b0c0
-DATA ·precomputed<>+0xbcc0(SB)/8, $0x6c332c559778aa8e
-DATA ·precomputed<>+0xbcc8(SB)/8, $0x290ae3ead1b8d8b2
-DATA ·precomputed<>+0xbcd0(SB)/8, $0x3e2bfa0bbf533883
-DATA ·precomputed<>+0xbcd8(SB)/8, $0xe48e37471a523ee2
-DATA ·precomputed<>+0xbce0(SB)/8, $0x4d40f1d550fde3ed
-DATA ·precomputed<>+0xbce8(SB)/8, $0xb57e695b48710433
-DATA ·precomputed<>+0xbcf0(SB)/8, $0xa41012581b241f3f
-DATA ·precomputed<>+0xbcf8(SB)/8, $0xa0cabf7b3042cabc
-DATA ·precomputed<>+0xbd00(SB)/8, $0x70def5165d505356
-DATA ·precomputed<>+0xbd08(SB)/8, $0x8dc93c6f7cff9c1b
-DATA ·precomputed<>+0xbd10(SB)/8, $0x71aba8310bd97da0
-DATA ·precomputed<>+0xbd18(SB)/8, $0xf4575858fae10149
-DATA ·precomputed<>+0xbd20(SB)/8, $0xc2ce7f3131685cd3
-DATA ·precomputed<>+0xbd28(SB)/8, $0x4579e24dd454d2d4
-DATA ·precomputed<>+0xbd30(SB)/8, $0xb1f963a74e6a7229
-DATA ·precomputed<>+0xbd38(SB)/8, $0x50007b3569ad17d3
-DATA ·precomputed<>+0xbd40(SB)/8, $0x45a1fca8a62aa025
-DATA ·precomputed<>+0xbd48(SB)/8, $0xe7e1aca17677df7c
-DATA ·precomputed<>+0xbd50(SB)/8, $0x5b868b0d06580207
-DATA ·precomputed<>+0xbd58(SB)/8, $0x73f4c51290ebb41c
-DATA ·precomputed<>+0xbd60(SB)/8, $0xbe2a6422deae5d65
-DATA ·precomputed<>+0xbd68(SB)/8, $0x859a0e58aba554a8
-DATA ·precomputed<>+0xbd70(SB)/8, $0xaf70d0de9d6e0868
-DATA ·precomputed<>+0xbd78(SB)/8, $0x8f843154dbb6f296
-DATA ·precomputed<>+0xbd80(SB)/8, $0xff4a003e23a26b30
-DATA ·precomputed<>+0xbd88(SB)/8, $0xb895e3b024ab66ed
-DATA ·precomputed<>+0xbd90(SB)/8, $0x752242bd36b608f3
-DATA ·precomputed<>+0xbd98(SB)/8, $0x86505ee536596824
-DATA ·precomputed<>+0xbda0(SB)/8, $0xe6b1fc8d65410146
-DATA ·precomputed<>+0xbda8(SB)/8, $0xabd0ecf1a8b43a59
-DATA ·precomputed<>+0xbdb0(SB)/8, $0x35c42bc1c545b792
-DATA ·precomputed<>+0xbdb8(SB)/8, $0x7bc9d1b8c354fe26
-DATA ·precomputed<>+0xbdc0(SB)/8, $0x68eb19c7edfea522
-DATA ·precomputed<>+0xbdc8(SB)/8, $0x68e028b8a400db7b
-DATA ·precomputed<>+0xbdd0(SB)/8, $0x6cd97bf7a8f03a08
-DATA ·precomputed<>+0xbdd8(SB)/8, $0x9f4d266f442fe36
-DATA ·precomputed<>+0xbde0(SB)/8, $0x1ac77f915d713a1f
-DATA ·precomputed<>+0xbde8(SB)/8, $0x356e3a352f58ccb5
-DATA ·precomputed<>+0xbdf0(SB)/8, $0x31d657f1f8ddc47d
-DATA ·precomputed<>+0xbdf8(SB)/8, $0xfea7aede90092115
-DATA ·precomputed<>+0xbe00(SB)/8, $0xef958780fa25a61b
-DATA ·precomputed<>+0xbe08(SB)/8, $0x7b6fd1a6dad7b08c
-DATA ·precomputed<>+0xbe10(SB)/8, $0x94aa069f23b32c70
-DATA ·precomputed<>+0xbe18(SB)/8, $0x94eadb3c158a6eaf
-DATA ·precomputed<>+0xbe20(SB)/8, $0x79011eff33c34e20
-DATA ·precomputed<>+0xbe28(SB)/8, $0xc31879c10e2b5939
-DATA ·precomputed<>+0xbe30(SB)/8, $0x229749450058ea6d
-DATA ·precomputed<>+0xbe38(SB)/8, $0x60407f29f98d8d2e
-DATA ·precomputed<>+0xbe40(SB)/8, $0x5adaf93a0c42cb73
-DATA ·precomputed<>+0xbe48(SB)/8, $0xf5c2e5b33c9b0402
-DATA ·precomputed<>+0xbe50(SB)/8, $0xf7b53c8d44cc4bd
-DATA ·precomputed<>+0xbe58(SB)/8, $0xa7992c199b6cbf65
-DATA ·precomputed<>+0xbe60(SB)/8, $0x433f3111fe2d830e
-DATA ·precomputed<>+0xbe68(SB)/8, $0xb3953779e2b315d6
-DATA ·precomputed<>+0xbe70(SB)/8, $0x3d0ce04089e1f663
-DATA ·precomputed<>+0xbe78(SB)/8, $0xafeee0cdf7c35af1
-DATA ·precomputed<>+0xbe80(SB)/8, $0x9993dada4ba835b8
-DATA ·precomputed<>+0xbe88(SB)/8, $0xb72afe44ef8c7247
-DATA ·precomputed<>+0xbe90(SB)/8, $0xac54b5a0ca6880f4
-DATA ·precomputed<>+0xbe98(SB)/8, $0x3fb5b5dbec675070
-DATA ·precomputed<>+0xbea0(SB)/8, $0xf6cf8dec7c38973a
-DATA ·precomputed<>+0xbea8(SB)/8, $0x4eca5931db4cb6c6
-DATA ·precomputed<>+0xbeb0(SB)/8, $0xe43efef367cbed33
-DATA ·precomputed<>+0xbeb8(SB)/8, $0xba0309e4d43dfef8
-DATA ·precomputed<>+0xbec0(SB)/8, $0x2aeba1d24ad49f66
-DATA ·precomputed<>+0xbec8(SB)/8, $0x16ff2bad9d40861b
-DATA ·precomputed<>+0xbed0(SB)/8, $0x25464f377da225f5
-DATA ·precomputed<>+0xbed8(SB)/8, $0xa2fe66cc1ffc3f0b
-DATA ·precomputed<>+0xbee0(SB)/8, $0x74074d7fac757f41
-DATA ·precomputed<>+0xbee8(SB)/8, $0x5c85d3d1cd0a2c20
-DATA ·precomputed<>+0xbef0(SB)/8, $0xccda2a05c974b266
-DATA ·precomputed<>+0xbef8(SB)/8, $0x5c2e2511cc10a04f
-DATA ·precomputed<>+0xbf00(SB)/8, $0xcb9ffffb121b032b
-DATA ·precomputed<>+0xbf08(SB)/8, $0xe619ed5e2f4568a7
-DATA ·precomputed<>+0xbf10(SB)/8, $0x76594ba51dc0f50e
-DATA ·precomputed<>+0xbf18(SB)/8, $0x5339395e68070cdd
-DATA ·precomputed<>+0xbf20(SB)/8, $0x87f42fab24bb000d
-DATA ·precomputed<>+0xbf28(SB)/8, $0xf689bb9323a4c3fa
-DATA ·precomputed<>+0xbf30(SB)/8, $0xffa17b62778ac137
-DATA ·precomputed<>+0xbf38(SB)/8, $0xf105741fd89ed331
-DATA ·precomputed<>+0xbf40(SB)/8, $0x3e81634f14920ade
-DATA ·precomputed<>+0xbf48(SB)/8, $0x17ac9f438d649e9c
-DATA ·precomputed<>+0xbf50(SB)/8, $0x4554f5519e5d3e1d
-DATA ·precomputed<>+0xbf58(SB)/8, $0x75ca130b862b1733
-DATA ·precomputed<>+0xbf60(SB)/8, $0xa4c7c4d78ecf8dac
-DATA ·precomputed<>+0xbf68(SB)/8, $0x26e8d518987edb65
-DATA ·precomputed<>+0xbf70(SB)/8, $0x4955e1fc36af8ba0
-DATA ·precomputed<>+0xbf78(SB)/8, $0xa16dcf229470bf58
-DATA ·precomputed<>+0xbf80(SB)/8, $0x39737e249df68141
-DATA ·precomputed<>+0xbf88(SB)/8, $0x15594ec62bc17c2
-DATA ·precomputed<>+0xbf90(SB)/8, $0x3390784ffb2b184a
-DATA ·precomputed<>+0xbf98(SB)/8, $0x66e8a7b5594a7d7f
-DATA ·precomputed<>+0xbfa0(SB)/8, $0x3ecd666825c52376
-DATA ·precomputed<>+0xbfa8(SB)/8, $0xf77cc8c4f8b0ca6f
-DATA ·precomputed<>+0xbfb0(SB)/8, $0x5b78693d0092abce
-DATA ·precomputed<>+0xbfb8(SB)/8, $0xa671541752eaab5a
-DATA ·precomputed<>+0xbfc0(SB)/8, $0x1ea2535510d515f
-DATA ·precomputed<>+0xbfc8(SB)/8, $0xc861c54c489e7856
-DATA ·precomputed<>+0xbfd0(SB)/8, $0x9bc8485b680d17bc
-DATA ·precomputed<>+0xbfd8(SB)/8, $0x71472c11819ccc86
-DATA ·precomputed<>+0xbfe0(SB)/8, $0xa7ef94850e9b5d8b
-DATA ·precomputed<>+0xbfe8(SB)/8, $0x698c9fe8d029720d
-DATA ·precomputed<>+0xbff0(SB)/8, $0x6ce987d161f50161
-DATA ·precomputed<>+0xbff8(SB)/8, $0x35f6f329d240bf6
-DATA ·precomputed<>+0xc000(SB)/8, $0xee0315879c34971b
-DATA ·precomputed<>+0xc008(SB)/8, $0x5829eb07e76545cf
-DATA ·precomputed<>+0xc010(SB)/8, $0xb7a3a6ae33a81bb9
-DATA ·precomputed<>+0xc018(SB)/8, $0xff42daff49c9f710
-DATA ·precomputed<>+0xc020(SB)/8, $0x894eae85bffb951b
-DATA ·precomputed<>+0xc028(SB)/8, $0x815fe3e2ce70f324
-DATA ·precomputed<>+0xc030(SB)/8, $0x636564cb428b1f12
-DATA ·precomputed<>+0xc038(SB)/8, $0x722e0050a029b0bd
-DATA ·precomputed<>+0xc040(SB)/8, $0xdfc9578b41aeb39f
-DATA ·precomputed<>+0xc048(SB)/8, $0xeeda86fe7dd55c1f
-DATA ·precomputed<>+0xc050(SB)/8, $0xd4b8fc54fb814075
-DATA ·precomputed<>+0xc058(SB)/8, $0x12e32a7833a1317c
-DATA ·precomputed<>+0xc060(SB)/8, $0xeb79cd2b2fd217d1
-DATA ·precomputed<>+0xc068(SB)/8, $0x5f5f20c0dbd07638
-DATA ·precomputed<>+0xc070(SB)/8, $0xfc57643a53dc7d8b
-DATA ·precomputed<>+0xc078(SB)/8, $0x65126014f08350e2
-DATA ·precomputed<>+0xc080(SB)/8, $0x305b7479d0058ffa
-DATA ·precomputed<>+0xc088(SB)/8, $0xe459ecff180b0de6
-DATA ·precomputed<>+0xc090(SB)/8, $0xfbe00c088ca0585f
-DATA ·precomputed<>+0xc098(SB)/8, $0xc169e23ac3dd4fa0
-DATA ·precomputed<>+0xc0a0(SB)/8, $0x65d9009a44026f6e
-DATA ·precomputed<>+0xc0a8(SB)/8, $0xbbc258c31d96fe58
-DATA ·precomputed<>+0xc0b0(SB)/8, $0xd7ed379c328ed1e0
-DATA ·precomputed<>+0xc0b8(SB)/8, $0xe8b4574423970884
-DATA ·precomputed<>+0xc0c0(SB)/8, $0xd63e91e139bb481a
-DATA ·precomputed<>+0xc0c8(SB)/8, $0xdcdc072a99984155
-DATA ·precomputed<>+0xc0d0(SB)/8, $0x9fce6e38d2d8e622
-DATA ·precomputed<>+0xc0d8(SB)/8, $0xbf6978b68e8c8034
-DATA ·precomputed<>+0xc0e0(SB)/8, $0xaa1ae8748c37990a
-DATA ·precomputed<>+0xc0e8(SB)/8, $0xd1f0e0680e749b86
-DATA ·precomputed<>+0xc0f0(SB)/8, $0x5aa303b1cbdc7c12
-DATA ·precomputed<>+0xc0f8(SB)/8, $0x9a78baafc9130211
-DATA ·precomputed<>+0xc100(SB)/8, $0x46010e8c48790aac
-DATA ·precomputed<>+0xc108(SB)/8, $0xac7a52d8254ad24f
-DATA ·precomputed<>+0xc110(SB)/8, $0xbca462bd2d2090b8
-DATA ·precomputed<>+0xc118(SB)/8, $0xfcd84ef4b2f8d7df
-DATA ·precomputed<>+0xc120(SB)/8, $0x301755b07ec8e074
-DATA ·precomputed<>+0xc128(SB)/8, $0xe4205e37a9b88dc
-DATA ·precomputed<>+0xc130(SB)/8, $0x170f7d14d7e09a0
-DATA ·precomputed<>+0xc138(SB)/8, $0x47f7d48cce9d8913
-DATA ·precomputed<>+0xc140(SB)/8, $0x3b270b8acc130e8b
-DATA ·precomputed<>+0xc148(SB)/8, $0x17f5f78fdc6f0872
-DATA ·precomputed<>+0xc150(SB)/8, $0x9683bd22e50d3f34
-DATA ·precomputed<>+0xc158(SB)/8, $0x6d5852af62699031
-DATA ·precomputed<>+0xc160(SB)/8, $0x83d554cd8f15058a
-DATA ·precomputed<>+0xc168(SB)/8, $0x24038deab4cfe9dd
-DATA ·precomputed<>+0xc170(SB)/8, $0xb19336ecc89479d6
-DATA ·precomputed<>+0xc178(SB)/8, $0xcaa9ed54f03eb59b
-DATA ·precomputed<>+0xc180(SB)/8, $0x71abc5c0e0b77ebf
-DATA ·precomputed<>+0xc188(SB)/8, $0xdc41d926a45a471d
-DATA ·precomputed<>+0xc190(SB)/8, $0x28f162333ce535e9
-DATA ·precomputed<>+0xc198(SB)/8, $0x35db2f52bbbd0a85
-DATA ·precomputed<>+0xc1a0(SB)/8, $0x3c0e5bf4eaec8559
-DATA ·precomputed<>+0xc1a8(SB)/8, $0x7be9434612b31f5f
-DATA ·precomputed<>+0xc1b0(SB)/8, $0xc2b24a8e89d337b8
-DATA ·precomputed<>+0xc1b8(SB)/8, $0xf564495847b34280
-DATA ·precomputed<>+0xc1c0(SB)/8, $0x5eeba910b3e2087b
-DATA ·precomputed<>+0xc1c8(SB)/8, $0xbd016dd344a61a33
-DATA ·precomputed<>+0xc1d0(SB)/8, $0xffd1f08148cd5c0f
-DATA ·precomputed<>+0xc1d8(SB)/8, $0x41c6aa02e6a8e94
-DATA ·precomputed<>+0xc1e0(SB)/8, $0xe09c35c5c4ac3d91
-DATA ·precomputed<>+0xc1e8(SB)/8, $0x58298105634767a4
-DATA ·precomputed<>+0xc1f0(SB)/8, $0x6120e7cb1040c2b5
-DATA ·precomputed<>+0xc1f8(SB)/8, $0x32a661efa713589f
-DATA ·precomputed<>+0xc200(SB)/8, $0x9a3908c723a9775d
-DATA ·precomputed<>+0xc208(SB)/8, $0x52f02c69abe0dc49
-DATA ·precomputed<>+0xc210(SB)/8, $0x9a41078a460ec56d
-DATA ·precomputed<>+0xc218(SB)/8, $0xcb97fe9d399d2853
-DATA ·precomputed<>+0xc220(SB)/8, $0xd5c483489eacff4a
-DATA ·precomputed<>+0xc228(SB)/8, $0x244ff3b9ffc1c2eb
-DATA ·precomputed<>+0xc230(SB)/8, $0x563c021608edb002
-DATA ·precomputed<>+0xc238(SB)/8, $0x823a2ec2144a0b1a
-DATA ·precomputed<>+0xc240(SB)/8, $0x654e5b3e5ca9cad0
-DATA ·precomputed<>+0xc248(SB)/8, $0x1c377e65294aa685
-DATA ·precomputed<>+0xc250(SB)/8, $0x94dd8d571cb6dafb
-DATA ·precomputed<>+0xc258(SB)/8, $0x52a5366c307555c9
-DATA ·precomputed<>+0xc260(SB)/8, $0x279f681dedc3d1d
-DATA ·precomputed<>+0xc268(SB)/8, $0xc7c15ac6a38b3f52
-DATA ·precomputed<>+0xc270(SB)/8, $0x97d7ebddd59ebd9b
-DATA ·precomputed<>+0xc278(SB)/8, $0x4ea646a4e161ef42
-DATA ·precomputed<>+0xc280(SB)/8, $0x33c1dccb6350fee2
-DATA ·precomputed<>+0xc288(SB)/8, $0xddc2a98cec20ab13
-DATA ·precomputed<>+0xc290(SB)/8, $0x2c63d9bf55465405
-DATA ·precomputed<>+0xc298(SB)/8, $0x45cf5272f52392
-DATA ·precomputed<>+0xc2a0(SB)/8, $0x9b505c2930b9e549
-DATA ·precomputed<>+0xc2a8(SB)/8, $0x863c3e645d05126d
-DATA ·precomputed<>+0xc2b0(SB)/8, $0xb898e2da03741fc
-DATA ·precomputed<>+0xc2b8(SB)/8, $0x88faf8814b1139ed
-DATA ·precomputed<>+0xc2c0(SB)/8, $0xf6210d3e146ae490
-DATA ·precomputed<>+0xc2c8(SB)/8, $0xb6938803dccbfc53
-DATA ·precomputed<>+0xc2d0(SB)/8, $0xd5fd88f90da92436
-DATA ·precomputed<>+0xc2d8(SB)/8, $0x8bd018d5aa4bca68
-DATA ·precomputed<>+0xc2e0(SB)/8, $0x7544976bbd7106ee
-DATA ·precomputed<>+0xc2e8(SB)/8, $0xcfc7ab32da20c622
-DATA ·precomputed<>+0xc2f0(SB)/8, $0x739aa7c858adb4fb
-DATA ·precomputed<>+0xc2f8(SB)/8, $0x1d951a9d5f643b4
-DATA ·precomputed<>+0xc300(SB)/8, $0xb460c6fbd060ed8c
-DATA ·precomputed<>+0xc308(SB)/8, $0x9d86c387b94f2868
-DATA ·precomputed<>+0xc310(SB)/8, $0xd445bf06ed0f1212
-DATA ·precomputed<>+0xc318(SB)/8, $0xb817603506d9b530
-DATA ·precomputed<>+0xc320(SB)/8, $0x72b331e3d89ab0ed
-DATA ·precomputed<>+0xc328(SB)/8, $0x23d6c1c6dc4d0273
-DATA ·precomputed<>+0xc330(SB)/8, $0xa9ed580b2364b69
-DATA ·precomputed<>+0xc338(SB)/8, $0x60a4fc81793d3f81
-DATA ·precomputed<>+0xc340(SB)/8, $0x899319537b61e72c
-DATA ·precomputed<>+0xc348(SB)/8, $0x826b913587437617
-DATA ·precomputed<>+0xc350(SB)/8, $0x22aa37b6e729ce3b
-DATA ·precomputed<>+0xc358(SB)/8, $0xb97ee729a273013e
-DATA ·precomputed<>+0xc360(SB)/8, $0xd6dad8bfd617c0c4
-DATA ·precomputed<>+0xc368(SB)/8, $0x5214a04ff17f44c5
-DATA ·precomputed<>+0xc370(SB)/8, $0x89bb23b509462793
-DATA ·precomputed<>+0xc378(SB)/8, $0x1880d7742d03b48e
-DATA ·precomputed<>+0xc380(SB)/8, $0x7da6c85c639520f1
-DATA ·precomputed<>+0xc388(SB)/8, $0x3ee0aa6c4a235ae9
-DATA ·precomputed<>+0xc390(SB)/8, $0xcbc5dc2b1cb05a79
-DATA ·precomputed<>+0xc398(SB)/8, $0x67c4b17acae819c
-DATA ·precomputed<>+0xc3a0(SB)/8, $0xc1dd29d717ec9f0a
-DATA ·precomputed<>+0xc3a8(SB)/8, $0xfce4905666e5afaf
-DATA ·precomputed<>+0xc3b0(SB)/8, $0x991c62f1049a10f4
-DATA ·precomputed<>+0xc3b8(SB)/8, $0x5f2d285c7d643c5e
-DATA ·precomputed<>+0xc3c0(SB)/8, $0x5a056a90bd74c70e
-DATA ·precomputed<>+0xc3c8(SB)/8, $0x44f7d00d8af672f3
-DATA ·precomputed<>+0xc3d0(SB)/8, $0xdc25ab68ef4e9a48
-DATA ·precomputed<>+0xc3d8(SB)/8, $0xadd15cc3fdfb1069
-DATA ·precomputed<>+0xc3e0(SB)/8, $0xb1f4fd289f3033bf
-DATA ·precomputed<>+0xc3e8(SB)/8, $0x88a49bbebb8b8a7
-DATA ·precomputed<>+0xc3f0(SB)/8, $0xea485869a8d2861c
-DATA ·precomputed<>+0xc3f8(SB)/8, $0x46dbfdaf6b977fb5
-DATA ·precomputed<>+0xc400(SB)/8, $0x889da1683f36ea0d
-DATA ·precomputed<>+0xc408(SB)/8, $0x49408d6955c48e40
-DATA ·precomputed<>+0xc410(SB)/8, $0xc9aa777f201a40aa
-DATA ·precomputed<>+0xc418(SB)/8, $0x4a37188e89cf5126
-DATA ·precomputed<>+0xc420(SB)/8, $0x335d0fb5db42791d
-DATA ·precomputed<>+0xc428(SB)/8, $0x1b9ce4b2da5804ab
-DATA ·precomputed<>+0xc430(SB)/8, $0x9e5fa17ccdb11050
-DATA ·precomputed<>+0xc438(SB)/8, $0x414bdf4a4507683
-DATA ·precomputed<>+0xc440(SB)/8, $0xf95912ca6509834
-DATA ·precomputed<>+0xc448(SB)/8, $0xe00f5c0800d061fe
-DATA ·precomputed<>+0xc450(SB)/8, $0x87fd6a8297e4abac
-DATA ·precomputed<>+0xc458(SB)/8, $0xf312dc3d3a04d876
-DATA ·precomputed<>+0xc460(SB)/8, $0xa11aa9f9e0db26a7
-DATA ·precomputed<>+0xc468(SB)/8, $0x8994f335539cd8b3
-DATA ·precomputed<>+0xc470(SB)/8, $0x5fa6ab2fc9f8ef51
-DATA ·precomputed<>+0xc478(SB)/8, $0x7d9f0b5eb42e59b8
-DATA ·precomputed<>+0xc480(SB)/8, $0x822d1f82de5d258f
-DATA ·precomputed<>+0xc488(SB)/8, $0xe9f2e15fbf930c48
-DATA ·precomputed<>+0xc490(SB)/8, $0xa19631e1db30f8e8
-DATA ·precomputed<>+0xc498(SB)/8, $0xf9e38a966b9fbab7
-DATA ·precomputed<>+0xc4a0(SB)/8, $0x133fbdacfeb8db30
-DATA ·precomputed<>+0xc4a8(SB)/8, $0x8f9af1a58fbecef0
-DATA ·precomputed<>+0xc4b0(SB)/8, $0x347c5aba69b936df
-DATA ·precomputed<>+0xc4b8(SB)/8, $0x227beb81fa54db27
-DATA ·precomputed<>+0xc4c0(SB)/8, $0x7c2227d242f5b260
-DATA ·precomputed<>+0xc4c8(SB)/8, $0x44ccd23e990bd5b4
-DATA ·precomputed<>+0xc4d0(SB)/8, $0x6c3ded963753229f
-DATA ·precomputed<>+0xc4d8(SB)/8, $0xbc01508a316214b8
-DATA ·precomputed<>+0xc4e0(SB)/8, $0x5bdc9d8a0479c1da
-DATA ·precomputed<>+0xc4e8(SB)/8, $0x236e0ab669881007
-DATA ·precomputed<>+0xc4f0(SB)/8, $0x5b348f6e2ada75a6
-DATA ·precomputed<>+0xc4f8(SB)/8, $0x45da17ac148e90e5
-DATA ·precomputed<>+0xc500(SB)/8, $0x5bf7d960d0c72cde
-DATA ·precomputed<>+0xc508(SB)/8, $0xba6d785ce79d1659
-DATA ·precomputed<>+0xc510(SB)/8, $0x7337a370e72bbff9
-DATA ·precomputed<>+0xc518(SB)/8, $0x51feca2677bd05d0
-DATA ·precomputed<>+0xc520(SB)/8, $0x9f54bc690c84dda2
-DATA ·precomputed<>+0xc528(SB)/8, $0xdd2282e0bb95398c
-DATA ·precomputed<>+0xc530(SB)/8, $0xe809c8903b4ec11e
-DATA ·precomputed<>+0xc538(SB)/8, $0xecf695639f39402d
-DATA ·precomputed<>+0xc540(SB)/8, $0xdbbc2fa143c0b238
-DATA ·precomputed<>+0xc548(SB)/8, $0x7f32f2e5fe130ebb
-DATA ·precomputed<>+0xc550(SB)/8, $0xefbd73b7bf5d7a18
-DATA ·precomputed<>+0xc558(SB)/8, $0x713bc55ee3b4688d
-DATA ·precomputed<>+0xc560(SB)/8, $0x7e01fbab9e68a55f
-DATA ·precomputed<>+0xc568(SB)/8, $0x1743ee998e6a1130
-DATA ·precomputed<>+0xc570(SB)/8, $0x8fd5e2c2fafd59c
-DATA ·precomputed<>+0xc578(SB)/8, $0xea6bbc44f3dbfe59
-DATA ·precomputed<>+0xc580(SB)/8, $0x351654cba3f54dc9
-DATA ·precomputed<>+0xc588(SB)/8, $0x2a9d79152c2c5e6
-DATA ·precomputed<>+0xc590(SB)/8, $0x3501b3fa9e2c15ec
-DATA ·precomputed<>+0xc598(SB)/8, $0x19ad052f9c58a4f1
-DATA ·precomputed<>+0xc5a0(SB)/8, $0x9938a7342709306c
-DATA ·precomputed<>+0xc5a8(SB)/8, $0xba227acc49631c23
-DATA ·precomputed<>+0xc5b0(SB)/8, $0x74405b6eab67fff9
-DATA ·precomputed<>+0xc5b8(SB)/8, $0x9fc8fb40847df763
-DATA ·precomputed<>+0xc5c0(SB)/8, $0x4e6461ed88ae888
-DATA ·precomputed<>+0xc5c8(SB)/8, $0x112d204a9be2d7ad
-DATA ·precomputed<>+0xc5d0(SB)/8, $0x952dc813db558427
-DATA ·precomputed<>+0xc5d8(SB)/8, $0x39b0165227e35200
-DATA ·precomputed<>+0xc5e0(SB)/8, $0x8b89bfceff02cdbb
-DATA ·precomputed<>+0xc5e8(SB)/8, $0x1381a99b3e854e4c
-DATA ·precomputed<>+0xc5f0(SB)/8, $0x68185218999efd2b
-DATA ·precomputed<>+0xc5f8(SB)/8, $0xeb41e1bb86dc62e1
-DATA ·precomputed<>+0xc600(SB)/8, $0x53b57af3ec2e6c03
-DATA ·precomputed<>+0xc608(SB)/8, $0xd0c4ed9f10e07da6
-DATA ·precomputed<>+0xc610(SB)/8, $0x798b053aec28b45f
-DATA ·precomputed<>+0xc618(SB)/8, $0x83b535915fa44476
-DATA ·precomputed<>+0xc620(SB)/8, $0xcbd88640dcdc7c8f
-DATA ·precomputed<>+0xc628(SB)/8, $0x9200c96f1f8f16ce
-DATA ·precomputed<>+0xc630(SB)/8, $0x457a5c792ae8b2b
-DATA ·precomputed<>+0xc638(SB)/8, $0xc3d3258b27836190
-DATA ·precomputed<>+0xc640(SB)/8, $0x1195bbcb46d5d1d6
-DATA ·precomputed<>+0xc648(SB)/8, $0x583fcb4fa25755b6
-DATA ·precomputed<>+0xc650(SB)/8, $0x8ec3c3614a8a11ff
-DATA ·precomputed<>+0xc658(SB)/8, $0xc0d9886057cec2f3
-DATA ·precomputed<>+0xc660(SB)/8, $0xf64b3ffd0dad2aad
-DATA ·precomputed<>+0xc668(SB)/8, $0x30b548ca5f34f78f
-DATA ·precomputed<>+0xc670(SB)/8, $0x6aba9873338441ed
-DATA ·precomputed<>+0xc678(SB)/8, $0x98dd0efd1fe23f45
-DATA ·precomputed<>+0xc680(SB)/8, $0x8c554cf7bb20ede1
-DATA ·precomputed<>+0xc688(SB)/8, $0x131742e866d05fdf
-DATA ·precomputed<>+0xc690(SB)/8, $0x1d608d2f38e6b51c
-DATA ·precomputed<>+0xc698(SB)/8, $0xd97129f43c764a51
-DATA ·precomputed<>+0xc6a0(SB)/8, $0x5c8510507721ad2c
-DATA ·precomputed<>+0xc6a8(SB)/8, $0x2b2e909911e86e34
-DATA ·precomputed<>+0xc6b0(SB)/8, $0xe475cf81ec67442f
-DATA ·precomputed<>+0xc6b8(SB)/8, $0x358d5829a35fefa1
-DATA ·precomputed<>+0xc6c0(SB)/8, $0xe2f4302363257c29
-DATA ·precomputed<>+0xc6c8(SB)/8, $0xcd309787c030b2f4
-DATA ·precomputed<>+0xc6d0(SB)/8, $0x40a8892ec32fd8c2
-DATA ·precomputed<>+0xc6d8(SB)/8, $0x9a26bc89f6b05bdc
-DATA ·precomputed<>+0xc6e0(SB)/8, $0x37408cc211208b8d
-DATA ·precomputed<>+0xc6e8(SB)/8, $0x6e59678da8ea9d82
-DATA ·precomputed<>+0xc6f0(SB)/8, $0xe53f1837d915d485
-DATA ·precomputed<>+0xc6f8(SB)/8, $0xe0b615fed070e65d
-DATA ·precomputed<>+0xc700(SB)/8, $0x81bad4a0a950e622
-DATA ·precomputed<>+0xc708(SB)/8, $0x7c0b1629bead19e
-DATA ·precomputed<>+0xc710(SB)/8, $0x451edd0bdf5b0bbe
-DATA ·precomputed<>+0xc718(SB)/8, $0xd85471f2c9eb85cd
-DATA ·precomputed<>+0xc720(SB)/8, $0x3afbf49330dab8b4
-DATA ·precomputed<>+0xc728(SB)/8, $0xa91c12c1a450cbcc
-DATA ·precomputed<>+0xc730(SB)/8, $0x78373691d486955
-DATA ·precomputed<>+0xc738(SB)/8, $0x4b95e8d891dd7841
-DATA ·precomputed<>+0xc740(SB)/8, $0x212fa1295f64ee8e
-DATA ·precomputed<>+0xc748(SB)/8, $0x946a391eba08042a
-DATA ·precomputed<>+0xc750(SB)/8, $0xa1260a2a1cdcb929
-DATA ·precomputed<>+0xc758(SB)/8, $0xfd45b902aa2b0b01
-DATA ·precomputed<>+0xc760(SB)/8, $0x15cf9e20961108e
-DATA ·precomputed<>+0xc768(SB)/8, $0x3910393bd5317a2b
-DATA ·precomputed<>+0xc770(SB)/8, $0xd3ce83bc11cd3b
-DATA ·precomputed<>+0xc778(SB)/8, $0xfd62048495ea5031
-DATA ·precomputed<>+0xc780(SB)/8, $0x9ab8d97fd7bcb5af
-DATA ·precomputed<>+0xc788(SB)/8, $0xf48a1f04b17666b1
-DATA ·precomputed<>+0xc790(SB)/8, $0xbb964c2a8908be61
-DATA ·precomputed<>+0xc798(SB)/8, $0xeb58fb833d81e068
-DATA ·precomputed<>+0xc7a0(SB)/8, $0xe0ac0a5e92b6cd11
-DATA ·precomputed<>+0xc7a8(SB)/8, $0xc47c40877e71a1ec
-DATA ·precomputed<>+0xc7b0(SB)/8, $0xb71dfa1769f2cc01
-DATA ·precomputed<>+0xc7b8(SB)/8, $0x7212aafd723be49f
-DATA ·precomputed<>+0xc7c0(SB)/8, $0xa264ef4002d0aaff
-DATA ·precomputed<>+0xc7c8(SB)/8, $0xa678c07d6e679fe2
-DATA ·precomputed<>+0xc7d0(SB)/8, $0xcff13be7fd88bdce
-DATA ·precomputed<>+0xc7d8(SB)/8, $0x9a8efe8d617badb8
-DATA ·precomputed<>+0xc7e0(SB)/8, $0x1388a815ad5a22f4
-DATA ·precomputed<>+0xc7e8(SB)/8, $0x8f821400fec398b3
-DATA ·precomputed<>+0xc7f0(SB)/8, $0x85a6a565ff4fc2da
-DATA ·precomputed<>+0xc7f8(SB)/8, $0x681f0181858dd4f3
-DATA ·precomputed<>+0xc800(SB)/8, $0x44168fbc4e933b19
-DATA ·precomputed<>+0xc808(SB)/8, $0xaf2db74ce54ea969
-DATA ·precomputed<>+0xc810(SB)/8, $0x36fade13aeacbb56
-DATA ·precomputed<>+0xc818(SB)/8, $0x2970866584e6cd1d
-DATA ·precomputed<>+0xc820(SB)/8, $0x6f7ff1e1b692df97
-DATA ·precomputed<>+0xc828(SB)/8, $0x5a68c1a26ae66307
-DATA ·precomputed<>+0xc830(SB)/8, $0x85bc544ce7685f20
-DATA ·precomputed<>+0xc838(SB)/8, $0xb3f42e6d0f65eeec
-DATA ·precomputed<>+0xc840(SB)/8, $0xfbf7033a675b47a0
-DATA ·precomputed<>+0xc848(SB)/8, $0xcb3669c46542378f
-DATA ·precomputed<>+0xc850(SB)/8, $0x96abb0f7125ec248
-DATA ·precomputed<>+0xc858(SB)/8, $0x6d5d2047795fc346
-DATA ·precomputed<>+0xc860(SB)/8, $0xa6c6c9e88f5cffb6
-DATA ·precomputed<>+0xc868(SB)/8, $0xb968f2c7bea5ee09
-DATA ·precomputed<>+0xc870(SB)/8, $0x2f2ce735844ffd6f
-DATA ·precomputed<>+0xc878(SB)/8, $0x7931b87727e40ac8
-DATA ·precomputed<>+0xc880(SB)/8, $0x113f094b89b781c2
-DATA ·precomputed<>+0xc888(SB)/8, $0x996bf893013833a5
-DATA ·precomputed<>+0xc890(SB)/8, $0x26bc6210c0b9cf6d
-DATA ·precomputed<>+0xc898(SB)/8, $0x18e2d3ac6a88f1cf
-DATA ·precomputed<>+0xc8a0(SB)/8, $0xc0ff2b3ca21a2d35
-DATA ·precomputed<>+0xc8a8(SB)/8, $0x409c2598a79e918e
-DATA ·precomputed<>+0xc8b0(SB)/8, $0xffcf65a0b6917e22
-DATA ·precomputed<>+0xc8b8(SB)/8, $0x8036897fbb4f8f3c
-DATA ·precomputed<>+0xc8c0(SB)/8, $0x98bb15ecfe73e699
-DATA ·precomputed<>+0xc8c8(SB)/8, $0x7533abdc47526489
-DATA ·precomputed<>+0xc8d0(SB)/8, $0x4b269607491dcc6d
-DATA ·precomputed<>+0xc8d8(SB)/8, $0x325ec2a077187363
-DATA ·precomputed<>+0xc8e0(SB)/8, $0x766faa197e9ab865
-DATA ·precomputed<>+0xc8e8(SB)/8, $0x1c105b4ac25a9448
-DATA ·precomputed<>+0xc8f0(SB)/8, $0xb6b89630531b5ba
-DATA ·precomputed<>+0xc8f8(SB)/8, $0x32691f112db1a579
-DATA ·precomputed<>+0xc900(SB)/8, $0x5de3e119224c152
-DATA ·precomputed<>+0xc908(SB)/8, $0x2fa9a474ea8fda4e
-DATA ·precomputed<>+0xc910(SB)/8, $0xf5c8df05f48055ec
-DATA ·precomputed<>+0xc918(SB)/8, $0x48bbf43a9e23a599
-DATA ·precomputed<>+0xc920(SB)/8, $0xf593f034148086db
-DATA ·precomputed<>+0xc928(SB)/8, $0x173a87aef0a2b62
-DATA ·precomputed<>+0xc930(SB)/8, $0x90ef01323fbabb6f
-DATA ·precomputed<>+0xc938(SB)/8, $0x56ced09a21ade107
-DATA ·precomputed<>+0xc940(SB)/8, $0x41e0aff9f102fb3
-DATA ·precomputed<>+0xc948(SB)/8, $0x91b3a87c106ae748
-DATA ·precomputed<>+0xc950(SB)/8, $0xfd969804c426fa5d
-DATA ·precomputed<>+0xc958(SB)/8, $0xe624f1cd28f95b76
-DATA ·precomputed<>+0xc960(SB)/8, $0x6fe28cce34f2ea56
-DATA ·precomputed<>+0xc968(SB)/8, $0xdea55947d230f37c
-DATA ·precomputed<>+0xc970(SB)/8, $0xd5e336f2f92f2742
-DATA ·precomputed<>+0xc978(SB)/8, $0x86852e3c1899c751
-DATA ·precomputed<>+0xc980(SB)/8, $0xe70af958bf881f9e
-DATA ·precomputed<>+0xc988(SB)/8, $0xd4cd35adc4e58ec4
-DATA ·precomputed<>+0xc990(SB)/8, $0x3889d3d95a531924
-DATA ·precomputed<>+0xc998(SB)/8, $0xac657424b4ce15ec
-DATA ·precomputed<>+0xc9a0(SB)/8, $0xdbe384caf41e1344
-DATA ·precomputed<>+0xc9a8(SB)/8, $0x9a1aed235ab8bb08
-DATA ·precomputed<>+0xc9b0(SB)/8, $0x375a041f8561df1d
-DATA ·precomputed<>+0xc9b8(SB)/8, $0x19f7a238b7685c1c
-DATA ·precomputed<>+0xc9c0(SB)/8, $0xabdf5f7341690d1c
-DATA ·precomputed<>+0xc9c8(SB)/8, $0xe857a78f0edac8c
-DATA ·precomputed<>+0xc9d0(SB)/8, $0x59f40fcf8238cfb0
-DATA ·precomputed<>+0xc9d8(SB)/8, $0xdcb54f67511d41d2
-DATA ·precomputed<>+0xc9e0(SB)/8, $0x3f036ac80e645117
-DATA ·precomputed<>+0xc9e8(SB)/8, $0xdc4e833e7af5fdcc
-DATA ·precomputed<>+0xc9f0(SB)/8, $0x67d859b23d7bab2c
-DATA ·precomputed<>+0xc9f8(SB)/8, $0x92489b235f8b32bd
-DATA ·precomputed<>+0xca00(SB)/8, $0xa65f8abbf775b3cd
-DATA ·precomputed<>+0xca08(SB)/8, $0xe4f2d7a5a359eff
-DATA ·precomputed<>+0xca10(SB)/8, $0xd49b5fcc9d544d4
-DATA ·precomputed<>+0xca18(SB)/8, $0xa19d250041090016
-DATA ·precomputed<>+0xca20(SB)/8, $0xcda20aebd606e6f2
-DATA ·precomputed<>+0xca28(SB)/8, $0x293e2834fe0ad0a3
-DATA ·precomputed<>+0xca30(SB)/8, $0x2a2ff9d2ecb50ff0
-DATA ·precomputed<>+0xca38(SB)/8, $0x50c3beab87cb135c
-DATA ·precomputed<>+0xca40(SB)/8, $0x8fde5c070a6e6b06
-DATA ·precomputed<>+0xca48(SB)/8, $0x8f32f546b194cefd
-DATA ·precomputed<>+0xca50(SB)/8, $0xda91c27ca9b9aa2b
-DATA ·precomputed<>+0xca58(SB)/8, $0xcb3560e2e1031aac
-DATA ·precomputed<>+0xca60(SB)/8, $0x17f61cd273ddc8eb
-DATA ·precomputed<>+0xca68(SB)/8, $0x721fe021ee97864e
-DATA ·precomputed<>+0xca70(SB)/8, $0xa1dba31448cf5810
-DATA ·precomputed<>+0xca78(SB)/8, $0xe4ec77a1fe4d140d
-DATA ·precomputed<>+0xca80(SB)/8, $0x4da8af788fe3e8c
-DATA ·precomputed<>+0xca88(SB)/8, $0xc01d91758d81b777
-DATA ·precomputed<>+0xca90(SB)/8, $0x357a956d37cdd45e
-DATA ·precomputed<>+0xca98(SB)/8, $0xe08c989ec603bad3
-DATA ·precomputed<>+0xcaa0(SB)/8, $0x668a0f3542462a38
-DATA ·precomputed<>+0xcaa8(SB)/8, $0x52e98b0e327a2913
-DATA ·precomputed<>+0xcab0(SB)/8, $0x896231942b97f9c6
-DATA ·precomputed<>+0xcab8(SB)/8, $0x2d4d5cf6bf6976d0
-DATA ·precomputed<>+0xcac0(SB)/8, $0x59ef34b4c22eb7ff
-DATA ·precomputed<>+0xcac8(SB)/8, $0xfc26171add66596b
-DATA ·precomputed<>+0xcad0(SB)/8, $0x6ce57d2c0eb3f3e1
-DATA ·precomputed<>+0xcad8(SB)/8, $0xde4177dc0587bde0
-DATA ·precomputed<>+0xcae0(SB)/8, $0x22f0f7eb6ebf95ff
-DATA ·precomputed<>+0xcae8(SB)/8, $0xefde86941f55b874
-DATA ·precomputed<>+0xcaf0(SB)/8, $0xb699bdb3080cbc46
-DATA ·precomputed<>+0xcaf8(SB)/8, $0xe32804f350cd4190
-DATA ·precomputed<>+0xcb00(SB)/8, $0x736c7133189147cb
-DATA ·precomputed<>+0xcb08(SB)/8, $0x684da8e244e93b86
-DATA ·precomputed<>+0xcb10(SB)/8, $0x6702ff68e1d90d17
-DATA ·precomputed<>+0xcb18(SB)/8, $0x77f227884858bfe2
-DATA ·precomputed<>+0xcb20(SB)/8, $0x33ba22ea0fd2a199
-DATA ·precomputed<>+0xcb28(SB)/8, $0x33b3a0c297091a37
-DATA ·precomputed<>+0xcb30(SB)/8, $0x3929122f7370454e
-DATA ·precomputed<>+0xcb38(SB)/8, $0x85cb71be2bfab714
-DATA ·precomputed<>+0xcb40(SB)/8, $0xb14d742d27bb142b
-DATA ·precomputed<>+0xcb48(SB)/8, $0x1166be60214efeae
-DATA ·precomputed<>+0xcb50(SB)/8, $0xbae30e9a2bd18cab
-DATA ·precomputed<>+0xcb58(SB)/8, $0x3ea2b819fcb7eeac
-DATA ·precomputed<>+0xcb60(SB)/8, $0xec8fd89cdd468b5f
-DATA ·precomputed<>+0xcb68(SB)/8, $0x7654d5438ef32c9b
-DATA ·precomputed<>+0xcb70(SB)/8, $0x66464f754e4935c5
-DATA ·precomputed<>+0xcb78(SB)/8, $0xc7c9ae1d1636e13c
-DATA ·precomputed<>+0xcb80(SB)/8, $0x90826ca9526915a8
-DATA ·precomputed<>+0xcb88(SB)/8, $0x13901028d77c7cc8
-DATA ·precomputed<>+0xcb90(SB)/8, $0xd490d2e1574dc7fa
-DATA ·precomputed<>+0xcb98(SB)/8, $0x7bf5b001773c8cfa
-DATA ·precomputed<>+0xcba0(SB)/8, $0xa986424647f383d4
-DATA ·precomputed<>+0xcba8(SB)/8, $0x8b017944a282b62d
-DATA ·precomputed<>+0xcbb0(SB)/8, $0x9a53246db972469d
-DATA ·precomputed<>+0xcbb8(SB)/8, $0xb009b4e1a2a4675
-DATA ·precomputed<>+0xcbc0(SB)/8, $0xe412aaf7b9e2f9f8
-DATA ·precomputed<>+0xcbc8(SB)/8, $0x484c1aa0ff419ac
-DATA ·precomputed<>+0xcbd0(SB)/8, $0x9d944989417bcb90
-DATA ·precomputed<>+0xcbd8(SB)/8, $0x2b73dbe3fe7318ca
-DATA ·precomputed<>+0xcbe0(SB)/8, $0xb91b71e552dd7b0a
-DATA ·precomputed<>+0xcbe8(SB)/8, $0xd61f8eea3954afeb
-DATA ·precomputed<>+0xcbf0(SB)/8, $0xaaeab13ca07e3958
-DATA ·precomputed<>+0xcbf8(SB)/8, $0xde44203202a1ff49
-DATA ·precomputed<>+0xcc00(SB)/8, $0xd42bf63d8ad05831
-DATA ·precomputed<>+0xcc08(SB)/8, $0xca1334321a469ea4
-DATA ·precomputed<>+0xcc10(SB)/8, $0xb97f5fc861006620
-DATA ·precomputed<>+0xcc18(SB)/8, $0x1877c783b24c455
-DATA ·precomputed<>+0xcc20(SB)/8, $0x872787d308ed567e
-DATA ·precomputed<>+0xcc28(SB)/8, $0x6765f28267c3283d
-DATA ·precomputed<>+0xcc30(SB)/8, $0x83b0a28a297e63af
-DATA ·precomputed<>+0xcc38(SB)/8, $0xaa777818c6cc240
-DATA ·precomputed<>+0xcc40(SB)/8, $0xcd5b2e486f77582a
-DATA ·precomputed<>+0xcc48(SB)/8, $0x84eb38a5f0449c61
-DATA ·precomputed<>+0xcc50(SB)/8, $0xe3a3714591388eed
-DATA ·precomputed<>+0xcc58(SB)/8, $0xbfa2b1c908159212
-DATA ·precomputed<>+0xcc60(SB)/8, $0xc80c923b076edd34
-DATA ·precomputed<>+0xcc68(SB)/8, $0xe4cf30733d6ce630
-DATA ·precomputed<>+0xcc70(SB)/8, $0xb1ca654d41e63d42
-DATA ·precomputed<>+0xcc78(SB)/8, $0xe29762c3f5941c22
-DATA ·precomputed<>+0xcc80(SB)/8, $0xca186baa6bd4a3b7
-DATA ·precomputed<>+0xcc88(SB)/8, $0x1528046d4fbf0e27
-DATA ·precomputed<>+0xcc90(SB)/8, $0x6264528202e7465
-DATA ·precomputed<>+0xcc98(SB)/8, $0xb870d8e8b42c225
-DATA ·precomputed<>+0xcca0(SB)/8, $0x6d7df6d33217ea68
-DATA ·precomputed<>+0xcca8(SB)/8, $0x7dc091246c65748e
-DATA ·precomputed<>+0xccb0(SB)/8, $0x88a06d8769d0e47e
-DATA ·precomputed<>+0xccb8(SB)/8, $0xa3ed9bce1683bc79
-DATA ·precomputed<>+0xccc0(SB)/8, $0x9bc6f06ac55c0828
-DATA ·precomputed<>+0xccc8(SB)/8, $0x62a74300767e2248
-DATA ·precomputed<>+0xccd0(SB)/8, $0xe42b4c8984c49d4d
-DATA ·precomputed<>+0xccd8(SB)/8, $0xf7a8dd4652091b34
-DATA ·precomputed<>+0xcce0(SB)/8, $0x2ffbb96540a8295b
-DATA ·precomputed<>+0xcce8(SB)/8, $0x36db01cb69e900ec
-DATA ·precomputed<>+0xccf0(SB)/8, $0xd9b90987de42ae0a
-DATA ·precomputed<>+0xccf8(SB)/8, $0xd51f12e41fc539eb
-DATA ·precomputed<>+0xcd00(SB)/8, $0xca06604f2f95342b
-DATA ·precomputed<>+0xcd08(SB)/8, $0xede7cf52d1fbfd27
-DATA ·precomputed<>+0xcd10(SB)/8, $0x914ad39a2d0783cd
-DATA ·precomputed<>+0xcd18(SB)/8, $0x6eafeb98b2868b0
-DATA ·precomputed<>+0xcd20(SB)/8, $0x6382da45bf43aad6
-DATA ·precomputed<>+0xcd28(SB)/8, $0x3b05bd507ae2fa96
-DATA ·precomputed<>+0xcd30(SB)/8, $0xb7231b00fe4e9c5b
-DATA ·precomputed<>+0xcd38(SB)/8, $0x5de5701d512d397e
-DATA ·precomputed<>+0xcd40(SB)/8, $0x66d7fe7e4059866d
-DATA ·precomputed<>+0xcd48(SB)/8, $0x6b970393aa0772c3
-DATA ·precomputed<>+0xcd50(SB)/8, $0xc827d97d77ff5008
-DATA ·precomputed<>+0xcd58(SB)/8, $0x874521a0310e09f
-DATA ·precomputed<>+0xcd60(SB)/8, $0x1b18cd8186f7c7a9
-DATA ·precomputed<>+0xcd68(SB)/8, $0x466d0cd15d1e06cc
-DATA ·precomputed<>+0xcd70(SB)/8, $0xd71c49d0994e3474
-DATA ·precomputed<>+0xcd78(SB)/8, $0x5a1dc91c682587b8
-DATA ·precomputed<>+0xcd80(SB)/8, $0x7e997af28ffe88f4
-DATA ·precomputed<>+0xcd88(SB)/8, $0xe5c283822ddd8728
-DATA ·precomputed<>+0xcd90(SB)/8, $0x49e50cdd9df3e8da
-DATA ·precomputed<>+0xcd98(SB)/8, $0x691b37c390115a2e
-DATA ·precomputed<>+0xcda0(SB)/8, $0x920723c57daa346a
-DATA ·precomputed<>+0xcda8(SB)/8, $0x35c86b556abb5f9f
-DATA ·precomputed<>+0xcdb0(SB)/8, $0xf9e475a434b3ea32
-DATA ·precomputed<>+0xcdb8(SB)/8, $0xf12a2b47b1c352a1
-DATA ·precomputed<>+0xcdc0(SB)/8, $0x8581fbd4a3b4ab0f
-DATA ·precomputed<>+0xcdc8(SB)/8, $0x55d3bb13e07bdb61
-DATA ·precomputed<>+0xcdd0(SB)/8, $0xe65507f786ae79b3
-DATA ·precomputed<>+0xcdd8(SB)/8, $0xb85fa584336d824e
-DATA ·precomputed<>+0xcde0(SB)/8, $0x711d4492bc0d8c26
-DATA ·precomputed<>+0xcde8(SB)/8, $0xbbdbeb6399b3e8d4
-DATA ·precomputed<>+0xcdf0(SB)/8, $0xf201f709e44029cf
-DATA ·precomputed<>+0xcdf8(SB)/8, $0x18a99397b6748bf2
-DATA ·precomputed<>+0xce00(SB)/8, $0x460e4672485c9678
-DATA ·precomputed<>+0xce08(SB)/8, $0x8688b6b52240311c
-DATA ·precomputed<>+0xce10(SB)/8, $0x644cee9cac529d9d
-DATA ·precomputed<>+0xce18(SB)/8, $0x5982e9a5efd1ad8c
-DATA ·precomputed<>+0xce20(SB)/8, $0xa07bc868d7b7240d
-DATA ·precomputed<>+0xce28(SB)/8, $0x53080953eecbe386
-DATA ·precomputed<>+0xce30(SB)/8, $0x38a9c292a8ed4158
-DATA ·precomputed<>+0xce38(SB)/8, $0xb92e7785242e3c08
-DATA ·precomputed<>+0xce40(SB)/8, $0xd35ea6007069619d
-DATA ·precomputed<>+0xce48(SB)/8, $0xe1a2b588c32c1cb2
-DATA ·precomputed<>+0xce50(SB)/8, $0x5c5eb901ec460e90
-DATA ·precomputed<>+0xce58(SB)/8, $0x4e5cbc8b7303a48c
-DATA ·precomputed<>+0xce60(SB)/8, $0xce88a15bf7254dd0
-DATA ·precomputed<>+0xce68(SB)/8, $0xbb2835972dafe413
-DATA ·precomputed<>+0xce70(SB)/8, $0x997fe869fa1230af
-DATA ·precomputed<>+0xce78(SB)/8, $0x93bb055a51148a56
-DATA ·precomputed<>+0xce80(SB)/8, $0x413f8f4955a64822
-DATA ·precomputed<>+0xce88(SB)/8, $0xa34bdc20c6fdebb9
-DATA ·precomputed<>+0xce90(SB)/8, $0xfca1a14eea0d507e
-DATA ·precomputed<>+0xce98(SB)/8, $0xb2f9b82a41fbd0b1
-DATA ·precomputed<>+0xcea0(SB)/8, $0xdea3032c51d7e753
-DATA ·precomputed<>+0xcea8(SB)/8, $0x3318df3942104f87
-DATA ·precomputed<>+0xceb0(SB)/8, $0x2d1d96de75d442c2
-DATA ·precomputed<>+0xceb8(SB)/8, $0xd810e86c91e79fbd
-DATA ·precomputed<>+0xcec0(SB)/8, $0x6a9520b3838c4ab9
-DATA ·precomputed<>+0xcec8(SB)/8, $0x526b695c7736cae3
-DATA ·precomputed<>+0xced0(SB)/8, $0xf8eab84d239f308
-DATA ·precomputed<>+0xced8(SB)/8, $0x409b53e4908c517f
-DATA ·precomputed<>+0xcee0(SB)/8, $0x93781bcad169e4b4
-DATA ·precomputed<>+0xcee8(SB)/8, $0xa91f1076a7a467b8
-DATA ·precomputed<>+0xcef0(SB)/8, $0xe885579f3cc9436
-DATA ·precomputed<>+0xcef8(SB)/8, $0xc0d1f7923e123ec7
-DATA ·precomputed<>+0xcf00(SB)/8, $0xa8fd078a3cf761f5
-DATA ·precomputed<>+0xcf08(SB)/8, $0x9e2696222fd3d895
-DATA ·precomputed<>+0xcf10(SB)/8, $0xfc40229dfacb72d9
-DATA ·precomputed<>+0xcf18(SB)/8, $0x10f67b99050d8e3e
-DATA ·precomputed<>+0xcf20(SB)/8, $0x698a567351354679
-DATA ·precomputed<>+0xcf28(SB)/8, $0xc1ab4a645d75f8b5
-DATA ·precomputed<>+0xcf30(SB)/8, $0xc52e011dd4bd901e
-DATA ·precomputed<>+0xcf38(SB)/8, $0xa06eb24ade1e76d3
-DATA ·precomputed<>+0xcf40(SB)/8, $0x106b893b11b458be
-DATA ·precomputed<>+0xcf48(SB)/8, $0xa948d09153ff09ad
-DATA ·precomputed<>+0xcf50(SB)/8, $0x2caa2adf5b3a3f94
-DATA ·precomputed<>+0xcf58(SB)/8, $0xc2a2340bb75d48e3
-DATA ·precomputed<>+0xcf60(SB)/8, $0xd156a7cd7d2ab47c
-DATA ·precomputed<>+0xcf68(SB)/8, $0x3472d2a329a047f2
-DATA ·precomputed<>+0xcf70(SB)/8, $0x900ea7e8bd726b83
-DATA ·precomputed<>+0xcf78(SB)/8, $0xb9163bf060b521f0
-DATA ·precomputed<>+0xcf80(SB)/8, $0x3445d51047a5d39a
-DATA ·precomputed<>+0xcf88(SB)/8, $0x201b17592b7b860f
-DATA ·precomputed<>+0xcf90(SB)/8, $0xd9dd1f38bea2bf22
-DATA ·precomputed<>+0xcf98(SB)/8, $0x9991a9bfba7198b2
-DATA ·precomputed<>+0xcfa0(SB)/8, $0x1d9a016f30e9da67
-DATA ·precomputed<>+0xcfa8(SB)/8, $0xc9b17d24f1b0edd1
-DATA ·precomputed<>+0xcfb0(SB)/8, $0xcaee5f265c038ddd
-DATA ·precomputed<>+0xcfb8(SB)/8, $0xffc83ed12e240606
-DATA ·precomputed<>+0xcfc0(SB)/8, $0x8292d96d0b054a0f
-DATA ·precomputed<>+0xcfc8(SB)/8, $0xa978af8874b9077a
-DATA ·precomputed<>+0xcfd0(SB)/8, $0x70bd185bfff1d49f
-DATA ·precomputed<>+0xcfd8(SB)/8, $0xbe6d08440279eab1
-DATA ·precomputed<>+0xcfe0(SB)/8, $0xa8fffe45b8ed07e9
-DATA ·precomputed<>+0xcfe8(SB)/8, $0x714824a1cb920e55
-DATA ·precomputed<>+0xcff0(SB)/8, $0xcd5c628aaf1bb143
-DATA ·precomputed<>+0xcff8(SB)/8, $0xd151afcd7637dbb7
-DATA ·precomputed<>+0xd000(SB)/8, $0xa433bd2e5a26a1d4
-DATA ·precomputed<>+0xd008(SB)/8, $0x1148bb1dd1c2d78c
-DATA ·precomputed<>+0xd010(SB)/8, $0x4aae419e64102515
-DATA ·precomputed<>+0xd018(SB)/8, $0xd03b993966489384
-DATA ·precomputed<>+0xd020(SB)/8, $0xe21d58b1d61a9919
-DATA ·precomputed<>+0xd028(SB)/8, $0x17618c364a0ef3d4
-DATA ·precomputed<>+0xd030(SB)/8, $0x2519020d6fe8c0dd
-DATA ·precomputed<>+0xd038(SB)/8, $0x48d837d600b87a75
-DATA ·precomputed<>+0xd040(SB)/8, $0x5cfd3a693f11fc00
-DATA ·precomputed<>+0xd048(SB)/8, $0x1f2b5d018bc8eade
-DATA ·precomputed<>+0xd050(SB)/8, $0x5160359ba6b9f7ae
-DATA ·precomputed<>+0xd058(SB)/8, $0x1e2601dcfa696463
-DATA ·precomputed<>+0xd060(SB)/8, $0x7f5ac6d2915f6084
-DATA ·precomputed<>+0xd068(SB)/8, $0x6e387789679176d5
-DATA ·precomputed<>+0xd070(SB)/8, $0x7fb99f4bae26abee
-DATA ·precomputed<>+0xd078(SB)/8, $0x4798a2fcaa409d22
-DATA ·precomputed<>+0xd080(SB)/8, $0xc192342d86b383b5
-DATA ·precomputed<>+0xd088(SB)/8, $0xe85f303f706b01d5
-DATA ·precomputed<>+0xd090(SB)/8, $0x19e1921388cdcb89
-DATA ·precomputed<>+0xd098(SB)/8, $0xe88f19432ce0de2a
-DATA ·precomputed<>+0xd0a0(SB)/8, $0xf6fcf8cfe453aecc
-DATA ·precomputed<>+0xd0a8(SB)/8, $0xdcd10b89a67b49f
-DATA ·precomputed<>+0xd0b0(SB)/8, $0xb93d5b4dafece961
-DATA ·precomputed<>+0xd0b8(SB)/8, $0xe232f34ac39d0b53
-DATA ·precomputed<>+0xd0c0(SB)/8, $0x799acd7c64ca2efc
-DATA ·precomputed<>+0xd0c8(SB)/8, $0x3397a15b4e0bcb6c
-DATA ·precomputed<>+0xd0d0(SB)/8, $0xb9b10ced0358a26c
-DATA ·precomputed<>+0xd0d8(SB)/8, $0xa30dbbe4b8ddfaa
-DATA ·precomputed<>+0xd0e0(SB)/8, $0xa70e9712e20f6fac
-DATA ·precomputed<>+0xd0e8(SB)/8, $0x87c7f732d11451b0
-DATA ·precomputed<>+0xd0f0(SB)/8, $0xf0c967b1d5eece8f
-DATA ·precomputed<>+0xd0f8(SB)/8, $0xbc62882aab370e2d
-DATA ·precomputed<>+0xd100(SB)/8, $0xe9c0ba8516a010bc
-DATA ·precomputed<>+0xd108(SB)/8, $0x2fd90fbaea4f3e8e
-DATA ·precomputed<>+0xd110(SB)/8, $0x8af183714570a1e5
-DATA ·precomputed<>+0xd118(SB)/8, $0xe869e8f77cca9004
-DATA ·precomputed<>+0xd120(SB)/8, $0xe2c8afb72dd83019
-DATA ·precomputed<>+0xd128(SB)/8, $0xb877995dfd99b386
-DATA ·precomputed<>+0xd130(SB)/8, $0x1e3efc16f5adab87
-DATA ·precomputed<>+0xd138(SB)/8, $0x93105fe4aa3b191a
-DATA ·precomputed<>+0xd140(SB)/8, $0x305165d10a020be7
-DATA ·precomputed<>+0xd148(SB)/8, $0x48560411f66eaf8b
-DATA ·precomputed<>+0xd150(SB)/8, $0x5ff898ddffd2380e
-DATA ·precomputed<>+0xd158(SB)/8, $0x7da35f08784b4b11
-DATA ·precomputed<>+0xd160(SB)/8, $0x50f53e2c38fd05c7
-DATA ·precomputed<>+0xd168(SB)/8, $0x64b3ee8247ada3a5
-DATA ·precomputed<>+0xd170(SB)/8, $0x672ae316678995de
-DATA ·precomputed<>+0xd178(SB)/8, $0x74707460dfe96605
-DATA ·precomputed<>+0xd180(SB)/8, $0x70cbce8b62a0b0ed
-DATA ·precomputed<>+0xd188(SB)/8, $0x92f5dc330abbc9be
-DATA ·precomputed<>+0xd190(SB)/8, $0xbb92b7d3f369c2d6
-DATA ·precomputed<>+0xd198(SB)/8, $0x70dd90c879ef83e1
-DATA ·precomputed<>+0xd1a0(SB)/8, $0xe0b331537937ab45
-DATA ·precomputed<>+0xd1a8(SB)/8, $0x3a8d1f74c054af6d
-DATA ·precomputed<>+0xd1b0(SB)/8, $0x35cf7380b05ebfc4
-DATA ·precomputed<>+0xd1b8(SB)/8, $0xefb8dac258c2cd0c
-DATA ·precomputed<>+0xd1c0(SB)/8, $0x7722cb400c11e65a
-DATA ·precomputed<>+0xd1c8(SB)/8, $0xc94a7f5268ff2be5
-DATA ·precomputed<>+0xd1d0(SB)/8, $0x420085cc8d9f9352
-DATA ·precomputed<>+0xd1d8(SB)/8, $0x4addb986ca4b2544
-DATA ·precomputed<>+0xd1e0(SB)/8, $0x3c6ceac006264a47
-DATA ·precomputed<>+0xd1e8(SB)/8, $0xebc01a03e2b48ccc
-DATA ·precomputed<>+0xd1f0(SB)/8, $0xc430e7abea94fef2
-DATA ·precomputed<>+0xd1f8(SB)/8, $0x973bb6f0bd94aa8a
-DATA ·precomputed<>+0xd200(SB)/8, $0xa90f77ca20d12c92
-DATA ·precomputed<>+0xd208(SB)/8, $0x2e278e0e69d1739c
-DATA ·precomputed<>+0xd210(SB)/8, $0x29d24b445c1f9e82
-DATA ·precomputed<>+0xd218(SB)/8, $0xbf4fb4cb647c59b1
-DATA ·precomputed<>+0xd220(SB)/8, $0x9c8ea39d90ffd733
-DATA ·precomputed<>+0xd228(SB)/8, $0xe37a1352f14db3fc
-DATA ·precomputed<>+0xd230(SB)/8, $0x3c9164a28f3e1dca
-DATA ·precomputed<>+0xd238(SB)/8, $0x515c16f2aec86440
-DATA ·precomputed<>+0xd240(SB)/8, $0xc93cd7c1da9dd111
-DATA ·precomputed<>+0xd248(SB)/8, $0x56b625ab28c9c1b4
-DATA ·precomputed<>+0xd250(SB)/8, $0xeff436ae3769f3a2
-DATA ·precomputed<>+0xd258(SB)/8, $0xa0d8d46bcbd31a17
-DATA ·precomputed<>+0xd260(SB)/8, $0x241693fac80dc873
-DATA ·precomputed<>+0xd268(SB)/8, $0x56083f643cd579ab
-DATA ·precomputed<>+0xd270(SB)/8, $0x12ee753b33fbd431
-DATA ·precomputed<>+0xd278(SB)/8, $0x1bde60add66c283a
-DATA ·precomputed<>+0xd280(SB)/8, $0xd5f023a3113890f6
-DATA ·precomputed<>+0xd288(SB)/8, $0xaa4f9058a9d2491b
-DATA ·precomputed<>+0xd290(SB)/8, $0x6d82393e16d175a3
-DATA ·precomputed<>+0xd298(SB)/8, $0x1d1e00b2671e2aed
-DATA ·precomputed<>+0xd2a0(SB)/8, $0xd47c4f2840018bae
-DATA ·precomputed<>+0xd2a8(SB)/8, $0xd08eac837b30838f
-DATA ·precomputed<>+0xd2b0(SB)/8, $0xa0fde6315dfe910d
-DATA ·precomputed<>+0xd2b8(SB)/8, $0xfc16adf75c66d5c6
-DATA ·precomputed<>+0xd2c0(SB)/8, $0xc9ed0cf8d5b13b2e
-DATA ·precomputed<>+0xd2c8(SB)/8, $0xdbd541bbd18d5a28
-DATA ·precomputed<>+0xd2d0(SB)/8, $0x6b78c887754de9d2
-DATA ·precomputed<>+0xd2d8(SB)/8, $0x7d32fedb54651568
-DATA ·precomputed<>+0xd2e0(SB)/8, $0x7f3196800d37c339
-DATA ·precomputed<>+0xd2e8(SB)/8, $0x22304d1f37d70b76
-DATA ·precomputed<>+0xd2f0(SB)/8, $0x1b2709e6fb5e555
-DATA ·precomputed<>+0xd2f8(SB)/8, $0x978b0d3efd5d1708
-DATA ·precomputed<>+0xd300(SB)/8, $0xa6c7b0c55ae301b4
-DATA ·precomputed<>+0xd308(SB)/8, $0x6f5d42b1bd2d3f1d
-DATA ·precomputed<>+0xd310(SB)/8, $0x4eb12c0915c0c94b
-DATA ·precomputed<>+0xd318(SB)/8, $0xf1c4038628618c41
-DATA ·precomputed<>+0xd320(SB)/8, $0x30302333c0f55c25
-DATA ·precomputed<>+0xd328(SB)/8, $0xa5e41426bd1c19f0
-DATA ·precomputed<>+0xd330(SB)/8, $0xd5d4d4d7cfcc66f8
-DATA ·precomputed<>+0xd338(SB)/8, $0xcfdf3039449253c5
-DATA ·precomputed<>+0xd340(SB)/8, $0x78d559bf3832d713
-DATA ·precomputed<>+0xd348(SB)/8, $0x4f0b57bb6e00e15
-DATA ·precomputed<>+0xd350(SB)/8, $0xd6c9cb16e80add3a
-DATA ·precomputed<>+0xd358(SB)/8, $0xeabfabc55c7b1d70
-DATA ·precomputed<>+0xd360(SB)/8, $0x4057086698a62cc3
-DATA ·precomputed<>+0xd368(SB)/8, $0x39ef8ff14abb2b1a
-DATA ·precomputed<>+0xd370(SB)/8, $0xadb405480c19959c
-DATA ·precomputed<>+0xd378(SB)/8, $0xd61632d7388b1f7c
-DATA ·precomputed<>+0xd380(SB)/8, $0xfee82fab30fcdfd2
-DATA ·precomputed<>+0xd388(SB)/8, $0xf36185be36a6990b
-DATA ·precomputed<>+0xd390(SB)/8, $0x88f343f63d33027b
-DATA ·precomputed<>+0xd398(SB)/8, $0xb775dcbb38ae16c6
-DATA ·precomputed<>+0xd3a0(SB)/8, $0xa107b9f085a82e45
-DATA ·precomputed<>+0xd3a8(SB)/8, $0xaff8b0aede6b9806
-DATA ·precomputed<>+0xd3b0(SB)/8, $0x3cd3980f0392fad0
-DATA ·precomputed<>+0xd3b8(SB)/8, $0xdd829fc6f3cf7650
-DATA ·precomputed<>+0xd3c0(SB)/8, $0x11cf4c2e24424a48
-DATA ·precomputed<>+0xd3c8(SB)/8, $0x843c73ee37d4471c
-DATA ·precomputed<>+0xd3d0(SB)/8, $0xb3047fc5617a488b
-DATA ·precomputed<>+0xd3d8(SB)/8, $0xf2a91709e3cf861c
-DATA ·precomputed<>+0xd3e0(SB)/8, $0x844444211c3a60f7
-DATA ·precomputed<>+0xd3e8(SB)/8, $0x74787a3626679148
-DATA ·precomputed<>+0xd3f0(SB)/8, $0x115fbd0653d9404b
-DATA ·precomputed<>+0xd3f8(SB)/8, $0x70fd33656244cef0
-DATA ·precomputed<>+0xd400(SB)/8, $0x59b9443f636cb34d
-DATA ·precomputed<>+0xd408(SB)/8, $0xe51f5dfad9c99db4
-DATA ·precomputed<>+0xd410(SB)/8, $0x3820b1e72785f842
-DATA ·precomputed<>+0xd418(SB)/8, $0x4973d6f84c562cb
-DATA ·precomputed<>+0xd420(SB)/8, $0x23cbd001b7e22ac8
-DATA ·precomputed<>+0xd428(SB)/8, $0x4bae2995539dab6a
-DATA ·precomputed<>+0xd430(SB)/8, $0x688a33702602da0c
-DATA ·precomputed<>+0xd438(SB)/8, $0xcf0e9017675bd65a
-DATA ·precomputed<>+0xd440(SB)/8, $0x61793b9820e42c96
-DATA ·precomputed<>+0xd448(SB)/8, $0x80915696d2fbbd13
-DATA ·precomputed<>+0xd450(SB)/8, $0x5283a5ab5f68a357
-DATA ·precomputed<>+0xd458(SB)/8, $0x92b5f7d8b7e67ac1
-DATA ·precomputed<>+0xd460(SB)/8, $0xe6180b163cbdb54
-DATA ·precomputed<>+0xd468(SB)/8, $0xe587b84fe34412d2
-DATA ·precomputed<>+0xd470(SB)/8, $0xbe4d57ed35dec343
-DATA ·precomputed<>+0xd478(SB)/8, $0xc24836015faa619d
-DATA ·precomputed<>+0xd480(SB)/8, $0x107869ab2d696e14
-DATA ·precomputed<>+0xd488(SB)/8, $0x950795bbdebddda5
-DATA ·precomputed<>+0xd490(SB)/8, $0x25419f81ca44306a
-DATA ·precomputed<>+0xd498(SB)/8, $0x27e206ccf7033d61
-DATA ·precomputed<>+0xd4a0(SB)/8, $0xa463c19c2ce7ce
-DATA ·precomputed<>+0xd4a8(SB)/8, $0xf39fd69bcbaf243a
-DATA ·precomputed<>+0xd4b0(SB)/8, $0x7d948e2002b1c07
-DATA ·precomputed<>+0xd4b8(SB)/8, $0xc0b214767fa2e0b6
-DATA ·precomputed<>+0xd4c0(SB)/8, $0x2a83246d3531894b
-DATA ·precomputed<>+0xd4c8(SB)/8, $0x6fb7bec6a130ed2e
-DATA ·precomputed<>+0xd4d0(SB)/8, $0x8e23bb0616ba2ac0
-DATA ·precomputed<>+0xd4d8(SB)/8, $0xa66613ff1146ee2b
-DATA ·precomputed<>+0xd4e0(SB)/8, $0x3f4d051caffe5a85
-DATA ·precomputed<>+0xd4e8(SB)/8, $0xea9cc9c0a2602320
-DATA ·precomputed<>+0xd4f0(SB)/8, $0x72d74f39f6c9e62a
-DATA ·precomputed<>+0xd4f8(SB)/8, $0x47ecdc27535d00a
-DATA ·precomputed<>+0xd500(SB)/8, $0x3bc0e0f5b980ae5d
-DATA ·precomputed<>+0xd508(SB)/8, $0x531aa512cd348a60
-DATA ·precomputed<>+0xd510(SB)/8, $0x206d53cb39844f38
-DATA ·precomputed<>+0xd518(SB)/8, $0xfbacbdc8315b897
-DATA ·precomputed<>+0xd520(SB)/8, $0x67426f3a99f75d19
-DATA ·precomputed<>+0xd528(SB)/8, $0x1bc8734350bced6d
-DATA ·precomputed<>+0xd530(SB)/8, $0x44b7030955ad6887
-DATA ·precomputed<>+0xd538(SB)/8, $0xdfa465130f2d5ecc
-DATA ·precomputed<>+0xd540(SB)/8, $0xb2fe445472043e77
-DATA ·precomputed<>+0xd548(SB)/8, $0xda943612beef1aa0
-DATA ·precomputed<>+0xd550(SB)/8, $0x9c8f0504d9b92b21
-DATA ·precomputed<>+0xd558(SB)/8, $0xfc3e8a6ca85a9f14
-DATA ·precomputed<>+0xd560(SB)/8, $0x15d2615d9e3828c3
-DATA ·precomputed<>+0xd568(SB)/8, $0xf18dabe746f5b5d6
-DATA ·precomputed<>+0xd570(SB)/8, $0x4c42cadc1f9821d0
-DATA ·precomputed<>+0xd578(SB)/8, $0xaa5e4c8ba29c3e51
-DATA ·precomputed<>+0xd580(SB)/8, $0x7b1464227ff4b63
-DATA ·precomputed<>+0xd588(SB)/8, $0x6c4ad543a3c96bad
-DATA ·precomputed<>+0xd590(SB)/8, $0xdcb24ba3a7b0d3a9
-DATA ·precomputed<>+0xd598(SB)/8, $0x7f058870dcb8df0a
-DATA ·precomputed<>+0xd5a0(SB)/8, $0xd9524d4dc00d8490
-DATA ·precomputed<>+0xd5a8(SB)/8, $0xb17a9324e1a9c8c2
-DATA ·precomputed<>+0xd5b0(SB)/8, $0x5f209a7259ed870a
-DATA ·precomputed<>+0xd5b8(SB)/8, $0x105502a3c3beef06
-DATA ·precomputed<>+0xd5c0(SB)/8, $0x386a40f25745e7ef
-DATA ·precomputed<>+0xd5c8(SB)/8, $0xb8908ca97f2eacd6
-DATA ·precomputed<>+0xd5d0(SB)/8, $0x1ad0d7625af62450
-DATA ·precomputed<>+0xd5d8(SB)/8, $0x8bd8dbdf8db16cba
-DATA ·precomputed<>+0xd5e0(SB)/8, $0xeb59f504805ba3c7
-DATA ·precomputed<>+0xd5e8(SB)/8, $0x91d93b2defac60bb
-DATA ·precomputed<>+0xd5f0(SB)/8, $0x449201f571ef92bc
-DATA ·precomputed<>+0xd5f8(SB)/8, $0x10d8cc125cd3f6aa
-DATA ·precomputed<>+0xd600(SB)/8, $0xa8d83477b986c5dd
-DATA ·precomputed<>+0xd608(SB)/8, $0x52215214b1bf39d7
-DATA ·precomputed<>+0xd610(SB)/8, $0x7701da4f6f89df6e
-DATA ·precomputed<>+0xd618(SB)/8, $0xa1db3cfb64ba1063
-DATA ·precomputed<>+0xd620(SB)/8, $0x27bcf9ba15beb919
-DATA ·precomputed<>+0xd628(SB)/8, $0xb63ddc5a29c8336e
-DATA ·precomputed<>+0xd630(SB)/8, $0x8453654e1ff96e7b
-DATA ·precomputed<>+0xd638(SB)/8, $0x4da66246916382a6
-DATA ·precomputed<>+0xd640(SB)/8, $0xa3873e3fb6e890d1
-DATA ·precomputed<>+0xd648(SB)/8, $0xd325466f49cb248c
-DATA ·precomputed<>+0xd650(SB)/8, $0x89361ccf90c24ddb
-DATA ·precomputed<>+0xd658(SB)/8, $0x3ae653799b69e3b
-DATA ·precomputed<>+0xd660(SB)/8, $0xe8cb7933703823ff
-DATA ·precomputed<>+0xd668(SB)/8, $0x755d0ae933698d1f
-DATA ·precomputed<>+0xd670(SB)/8, $0x24f3ce6e4d045fd0
-DATA ·precomputed<>+0xd678(SB)/8, $0x47b33c6c91b591fd
-DATA ·precomputed<>+0xd680(SB)/8, $0x1143bf43d7963ec3
-DATA ·precomputed<>+0xd688(SB)/8, $0x4f94eede2b1bc12e
-DATA ·precomputed<>+0xd690(SB)/8, $0x64431df3dbf8cc74
-DATA ·precomputed<>+0xd698(SB)/8, $0xac1a562f525a1f56
-DATA ·precomputed<>+0xd6a0(SB)/8, $0x90e7e9281a2d968d
-DATA ·precomputed<>+0xd6a8(SB)/8, $0xd69b7e8810267045
-DATA ·precomputed<>+0xd6b0(SB)/8, $0x9b42d5462c035fbb
-DATA ·precomputed<>+0xd6b8(SB)/8, $0x4fdfc2f8171e9df1
-DATA ·precomputed<>+0xd6c0(SB)/8, $0xaff71109146d2543
-DATA ·precomputed<>+0xd6c8(SB)/8, $0xeb7e51f8a0eed285
-DATA ·precomputed<>+0xd6d0(SB)/8, $0x3ebb5bd92119cc05
-DATA ·precomputed<>+0xd6d8(SB)/8, $0x334ed486ab11676e
-DATA ·precomputed<>+0xd6e0(SB)/8, $0x98b1639864d3dd6b
-DATA ·precomputed<>+0xd6e8(SB)/8, $0xe88a0b1783a59f0
-DATA ·precomputed<>+0xd6f0(SB)/8, $0x4c8507f1ac648d71
-DATA ·precomputed<>+0xd6f8(SB)/8, $0x4665fc32ccd9238e
-DATA ·precomputed<>+0xd700(SB)/8, $0x7e83903a72763f2f
-DATA ·precomputed<>+0xd708(SB)/8, $0xde42ff2fd152f103
-DATA ·precomputed<>+0xd710(SB)/8, $0x9bc6d3c59d83ce93
-DATA ·precomputed<>+0xd718(SB)/8, $0x7b7b09fefd9a0207
-DATA ·precomputed<>+0xd720(SB)/8, $0xdf5b85369b35e89a
-DATA ·precomputed<>+0xd728(SB)/8, $0xf1f24256e56e75bf
-DATA ·precomputed<>+0xd730(SB)/8, $0x34cc5d50524a06ca
-DATA ·precomputed<>+0xd738(SB)/8, $0xa373c1cdc199d17c
-DATA ·precomputed<>+0xd740(SB)/8, $0x55131345a5289271
-DATA ·precomputed<>+0xd748(SB)/8, $0x6a4db39a5cc22b3
-DATA ·precomputed<>+0xd750(SB)/8, $0x37c75d5ae71a94c2
-DATA ·precomputed<>+0xd758(SB)/8, $0x2cf208b0ac76ed97
-DATA ·precomputed<>+0xd760(SB)/8, $0xb8ab980b9c9161fa
-DATA ·precomputed<>+0xd768(SB)/8, $0x3b19cee7d43178d2
-DATA ·precomputed<>+0xd770(SB)/8, $0xa50c2b346337ca24
-DATA ·precomputed<>+0xd778(SB)/8, $0x259c2e8510abfd31
-DATA ·precomputed<>+0xd780(SB)/8, $0xfa52923e1f89212b
-DATA ·precomputed<>+0xd788(SB)/8, $0xfae99339bf58ae0d
-DATA ·precomputed<>+0xd790(SB)/8, $0x8dea2037b0f6bba4
-DATA ·precomputed<>+0xd798(SB)/8, $0xf6f42cb8a6985f87
-DATA ·precomputed<>+0xd7a0(SB)/8, $0x58981ecd1520bbc9
-DATA ·precomputed<>+0xd7a8(SB)/8, $0xd1de7f22be44c3a6
-DATA ·precomputed<>+0xd7b0(SB)/8, $0x10fa2140283170bc
-DATA ·precomputed<>+0xd7b8(SB)/8, $0x325661fa591b06bb
-DATA ·precomputed<>+0xd7c0(SB)/8, $0x76695c9b2b574b7f
-DATA ·precomputed<>+0xd7c8(SB)/8, $0xcca80405c369b6be
-DATA ·precomputed<>+0xd7d0(SB)/8, $0x1f4bae99e3108ded
-DATA ·precomputed<>+0xd7d8(SB)/8, $0x9e715ce2ea133fce
-DATA ·precomputed<>+0xd7e0(SB)/8, $0x60d5205554c2ee1c
-DATA ·precomputed<>+0xd7e8(SB)/8, $0x56bab3011680742e
-DATA ·precomputed<>+0xd7f0(SB)/8, $0xa409b5f63fe438b9
-DATA ·precomputed<>+0xd7f8(SB)/8, $0xe3a8e4d08036f7ce
-DATA ·precomputed<>+0xd800(SB)/8, $0xe1d7ec0f247fdfdf
-DATA ·precomputed<>+0xd808(SB)/8, $0xfb9d90e74a23d1dc
-DATA ·precomputed<>+0xd810(SB)/8, $0x7012eb2c190fdc41
-DATA ·precomputed<>+0xd818(SB)/8, $0x5c2bbff6ddced48c
-DATA ·precomputed<>+0xd820(SB)/8, $0x8a93426a68cd7feb
-DATA ·precomputed<>+0xd828(SB)/8, $0xb59639626b4854e1
-DATA ·precomputed<>+0xd830(SB)/8, $0x8ac72b8ee772bbd8
-DATA ·precomputed<>+0xd838(SB)/8, $0xc10d24d2a6b3040a
-DATA ·precomputed<>+0xd840(SB)/8, $0xb9a92d78fbfcf1b5
-DATA ·precomputed<>+0xd848(SB)/8, $0x17ce4fabe8427d74
-DATA ·precomputed<>+0xd850(SB)/8, $0xbae98ffdac66e74e
-DATA ·precomputed<>+0xd858(SB)/8, $0x6d548304145bb5e5
-DATA ·precomputed<>+0xd860(SB)/8, $0xbf3dc6030992abe1
-DATA ·precomputed<>+0xd868(SB)/8, $0x318cfbdabefdc5c5
-DATA ·precomputed<>+0xd870(SB)/8, $0xbb5fa37d59f8efb8
-DATA ·precomputed<>+0xd878(SB)/8, $0x347874a04ef5bef8
-DATA ·precomputed<>+0xd880(SB)/8, $0x59b33c78af5bcab5
-DATA ·precomputed<>+0xd888(SB)/8, $0x53d789496fbcdf
-DATA ·precomputed<>+0xd890(SB)/8, $0x5a5afe02d7883bc1
-DATA ·precomputed<>+0xd898(SB)/8, $0xec9afe78fa66951d
-DATA ·precomputed<>+0xd8a0(SB)/8, $0x38f28b83728e56a6
-DATA ·precomputed<>+0xd8a8(SB)/8, $0x21d0b6ac78cafb9d
-DATA ·precomputed<>+0xd8b0(SB)/8, $0xd43996bc7042e327
-DATA ·precomputed<>+0xd8b8(SB)/8, $0x606866377c31c145
-DATA ·precomputed<>+0xd8c0(SB)/8, $0x6f5fcb614b4558fb
-DATA ·precomputed<>+0xd8c8(SB)/8, $0x9602597b1f2545aa
-DATA ·precomputed<>+0xd8d0(SB)/8, $0xfd89ab3fabe5e469
-DATA ·precomputed<>+0xd8d8(SB)/8, $0xf1daeea2fb2e16bc
-DATA ·precomputed<>+0xd8e0(SB)/8, $0xe699acd73a12940f
-DATA ·precomputed<>+0xd8e8(SB)/8, $0x24980f6c4d7c7311
-DATA ·precomputed<>+0xd8f0(SB)/8, $0x4a5cf975336c8ec6
-DATA ·precomputed<>+0xd8f8(SB)/8, $0x8e180e328c27d3dc
-DATA ·precomputed<>+0xd900(SB)/8, $0xb258cd5ffc01efaa
-DATA ·precomputed<>+0xd908(SB)/8, $0x861688b10775588e
-DATA ·precomputed<>+0xd910(SB)/8, $0x72184b18fa46eae0
-DATA ·precomputed<>+0xd918(SB)/8, $0xd17c9dea5003404a
-DATA ·precomputed<>+0xd920(SB)/8, $0xa879196692e7bf9e
-DATA ·precomputed<>+0xd928(SB)/8, $0x49c63cb7891ac50
-DATA ·precomputed<>+0xd930(SB)/8, $0x2ed329285d46b33d
-DATA ·precomputed<>+0xd938(SB)/8, $0x49d1bfbf0623595a
-DATA ·precomputed<>+0xd940(SB)/8, $0xcc4c31d0bf033733
-DATA ·precomputed<>+0xd948(SB)/8, $0xf37d0072ef211c17
-DATA ·precomputed<>+0xd950(SB)/8, $0x8967fe49ae35b246
-DATA ·precomputed<>+0xd958(SB)/8, $0x8c4cbd665cb1aa9b
-DATA ·precomputed<>+0xd960(SB)/8, $0xab0097db04840da3
-DATA ·precomputed<>+0xd968(SB)/8, $0x3946faec5828733e
-DATA ·precomputed<>+0xd970(SB)/8, $0x96c6531e87d64045
-DATA ·precomputed<>+0xd978(SB)/8, $0x893d378083bc0d0e
-DATA ·precomputed<>+0xd980(SB)/8, $0xdedab3515cb18391
-DATA ·precomputed<>+0xd988(SB)/8, $0xd3126ffc769ae948
-DATA ·precomputed<>+0xd990(SB)/8, $0x6c2f9ba8d3546ad9
-DATA ·precomputed<>+0xd998(SB)/8, $0x4567e48a69aabfb7
-DATA ·precomputed<>+0xd9a0(SB)/8, $0x6fbe29b0aa284747
-DATA ·precomputed<>+0xd9a8(SB)/8, $0x3185f0db98af4f2f
-DATA ·precomputed<>+0xd9b0(SB)/8, $0xf2a958a25b4c14e3
-DATA ·precomputed<>+0xd9b8(SB)/8, $0x106150c527d04855
-DATA ·precomputed<>+0xd9c0(SB)/8, $0x4f02ece400e25a95
-DATA ·precomputed<>+0xd9c8(SB)/8, $0xef9474027ac1732e
-DATA ·precomputed<>+0xd9d0(SB)/8, $0xecdb65ac51149260
-DATA ·precomputed<>+0xd9d8(SB)/8, $0x6043aa29a9180d51
-DATA ·precomputed<>+0xd9e0(SB)/8, $0x7fc92bd852deca0
-DATA ·precomputed<>+0xd9e8(SB)/8, $0xf333829715237c8d
-DATA ·precomputed<>+0xd9f0(SB)/8, $0xecfb0e76e84b3f38
-DATA ·precomputed<>+0xd9f8(SB)/8, $0x21f2f5c56b89af17
-DATA ·precomputed<>+0xda00(SB)/8, $0xee06dd39037ef2d3
-DATA ·precomputed<>+0xda08(SB)/8, $0x790d1b0fd522f762
-DATA ·precomputed<>+0xda10(SB)/8, $0xf0659106f30c47d0
-DATA ·precomputed<>+0xda18(SB)/8, $0xcd83214bb5fdc6b5
-DATA ·precomputed<>+0xda20(SB)/8, $0xc86216606593b717
-DATA ·precomputed<>+0xda28(SB)/8, $0xb10a6d99fe3fa381
-DATA ·precomputed<>+0xda30(SB)/8, $0xa5c3224cab254244
-DATA ·precomputed<>+0xda38(SB)/8, $0xd15287e65854b18e
-DATA ·precomputed<>+0xda40(SB)/8, $0x6e90b6a52528ec51
-DATA ·precomputed<>+0xda48(SB)/8, $0x9196a7c90548389e
-DATA ·precomputed<>+0xda50(SB)/8, $0xf7e285c17b5b5dde
-DATA ·precomputed<>+0xda58(SB)/8, $0x6335a624223d4837
-DATA ·precomputed<>+0xda60(SB)/8, $0x8acef5af412d19c4
-DATA ·precomputed<>+0xda68(SB)/8, $0xb22808a59783256b
-DATA ·precomputed<>+0xda70(SB)/8, $0x6ea3daaaf53e4b62
-DATA ·precomputed<>+0xda78(SB)/8, $0x7ca4c51bfa7bada4
-DATA ·precomputed<>+0xda80(SB)/8, $0x26542e2aa294dac9
-DATA ·precomputed<>+0xda88(SB)/8, $0xefab45af2a5dcfe8
-DATA ·precomputed<>+0xda90(SB)/8, $0x6166857de642bbe8
-DATA ·precomputed<>+0xda98(SB)/8, $0x3f3ad480ff6290a8
-DATA ·precomputed<>+0xdaa0(SB)/8, $0x435d4c2b5f50633f
-DATA ·precomputed<>+0xdaa8(SB)/8, $0x36da60a784451c8b
-DATA ·precomputed<>+0xdab0(SB)/8, $0xf5e2e4261612e4
-DATA ·precomputed<>+0xdab8(SB)/8, $0xe43182732d04786a
-DATA ·precomputed<>+0xdac0(SB)/8, $0x2c687134ca9bf60f
-DATA ·precomputed<>+0xdac8(SB)/8, $0x2473ea8fbc7da3a5
-DATA ·precomputed<>+0xdad0(SB)/8, $0xf54ef685b45fb57e
-DATA ·precomputed<>+0xdad8(SB)/8, $0x594e84453383cadb
-DATA ·precomputed<>+0xdae0(SB)/8, $0xe1edd3fb4a7df4bb
-DATA ·precomputed<>+0xdae8(SB)/8, $0xa783d987c17c2c92
-DATA ·precomputed<>+0xdaf0(SB)/8, $0xd498637cf8fcba8
-DATA ·precomputed<>+0xdaf8(SB)/8, $0xdebd801d3acd6e4c
-DATA ·precomputed<>+0xdb00(SB)/8, $0x39d542aba83a8450
-DATA ·precomputed<>+0xdb08(SB)/8, $0x634b9198dacb7c65
-DATA ·precomputed<>+0xdb10(SB)/8, $0x680cef7882486a05
-DATA ·precomputed<>+0xdb18(SB)/8, $0xab1d4d7716eaf88b
-DATA ·precomputed<>+0xdb20(SB)/8, $0x5e605279699c7aa5
-DATA ·precomputed<>+0xdb28(SB)/8, $0x7e37906f3c40a07f
-DATA ·precomputed<>+0xdb30(SB)/8, $0x4ae84ad8fb6926e3
-DATA ·precomputed<>+0xdb38(SB)/8, $0x236b5f07e2ebc73b
-DATA ·precomputed<>+0xdb40(SB)/8, $0x2fcdaf9bd77386cc
-DATA ·precomputed<>+0xdb48(SB)/8, $0x30f9f5a4e32d2633
-DATA ·precomputed<>+0xdb50(SB)/8, $0xa4fc8189382e7298
-DATA ·precomputed<>+0xdb58(SB)/8, $0x946923a1588af205
-DATA ·precomputed<>+0xdb60(SB)/8, $0x2c527a79114f2beb
-DATA ·precomputed<>+0xdb68(SB)/8, $0xa2ca55d3077762eb
-DATA ·precomputed<>+0xdb70(SB)/8, $0xe4b2eb7ccc85e41e
-DATA ·precomputed<>+0xdb78(SB)/8, $0x4b5938d289346ada
-DATA ·precomputed<>+0xdb80(SB)/8, $0xfaf0a1a5d52033b1
-DATA ·precomputed<>+0xdb88(SB)/8, $0x7967d3f4b8626432
-DATA ·precomputed<>+0xdb90(SB)/8, $0xe837ca4b5574dc0e
-DATA ·precomputed<>+0xdb98(SB)/8, $0xf7eae2372c11d8ff
-DATA ·precomputed<>+0xdba0(SB)/8, $0xc0f2f1fa87dc4007
-DATA ·precomputed<>+0xdba8(SB)/8, $0xf5f1f1538dfb51f7
-DATA ·precomputed<>+0xdbb0(SB)/8, $0xa64b10ae5bd9ac7f
-DATA ·precomputed<>+0xdbb8(SB)/8, $0xb3c2ba37a2198841
-DATA ·precomputed<>+0xdbc0(SB)/8, $0x88abaa5c7fd181e7
-DATA ·precomputed<>+0xdbc8(SB)/8, $0x136a0c9fca3ad1eb
-DATA ·precomputed<>+0xdbd0(SB)/8, $0xe6e5e6c2f394aab5
-DATA ·precomputed<>+0xdbd8(SB)/8, $0x84d697d49349e4a5
-DATA ·precomputed<>+0xdbe0(SB)/8, $0x8215578bf76f4b3b
-DATA ·precomputed<>+0xdbe8(SB)/8, $0x81a1cec612feeb5f
-DATA ·precomputed<>+0xdbf0(SB)/8, $0x5d336eb73e876bc3
-DATA ·precomputed<>+0xdbf8(SB)/8, $0xe8afdcb5071892ca
-DATA ·precomputed<>+0xdc00(SB)/8, $0x1738a83b34a00e27
-DATA ·precomputed<>+0xdc08(SB)/8, $0xe7591d15bf58ce70
-DATA ·precomputed<>+0xdc10(SB)/8, $0xde2ace5a57d806d8
-DATA ·precomputed<>+0xdc18(SB)/8, $0xe89e8110d0338020
-DATA ·precomputed<>+0xdc20(SB)/8, $0x935ed5de4e25756c
-DATA ·precomputed<>+0xdc28(SB)/8, $0x7ef8c2f46d0f00b
-DATA ·precomputed<>+0xdc30(SB)/8, $0xa28e5fb4a659592a
-DATA ·precomputed<>+0xdc38(SB)/8, $0xcb45c6227fa4986a
-DATA ·precomputed<>+0xdc40(SB)/8, $0xb94d547823ddd51c
-DATA ·precomputed<>+0xdc48(SB)/8, $0x7c215c91130e78e3
-DATA ·precomputed<>+0xdc50(SB)/8, $0x556b92e0ed547bce
-DATA ·precomputed<>+0xdc58(SB)/8, $0x72da6b909f5c6f
-DATA ·precomputed<>+0xdc60(SB)/8, $0x4ec71b11f0dc846b
-DATA ·precomputed<>+0xdc68(SB)/8, $0xd0f3b3b4bf7baaa1
-DATA ·precomputed<>+0xdc70(SB)/8, $0x896391c547770705
-DATA ·precomputed<>+0xdc78(SB)/8, $0x41fe573666732587
-DATA ·precomputed<>+0xdc80(SB)/8, $0x7dad21d4968268ae
-DATA ·precomputed<>+0xdc88(SB)/8, $0x7378e90be9c6fc0
-DATA ·precomputed<>+0xdc90(SB)/8, $0x9406a8722b329579
-DATA ·precomputed<>+0xdc98(SB)/8, $0xb27b5c51761f10ae
-DATA ·precomputed<>+0xdca0(SB)/8, $0xf5dad2f9d04cf60b
-DATA ·precomputed<>+0xdca8(SB)/8, $0x3154dff5df950997
-DATA ·precomputed<>+0xdcb0(SB)/8, $0xaaec9d30d8534a9a
-DATA ·precomputed<>+0xdcb8(SB)/8, $0x4ac722f5ac43f212
-DATA ·precomputed<>+0xdcc0(SB)/8, $0x708abc8cc051e9f6
-DATA ·precomputed<>+0xdcc8(SB)/8, $0x75194e9f4be2d9ca
-DATA ·precomputed<>+0xdcd0(SB)/8, $0x31d69c1d6ab5348
-DATA ·precomputed<>+0xdcd8(SB)/8, $0x1785990e78b0e490
-DATA ·precomputed<>+0xdce0(SB)/8, $0xd825f125f6c41f8e
-DATA ·precomputed<>+0xdce8(SB)/8, $0x429924ea0fbf2fe6
-DATA ·precomputed<>+0xdcf0(SB)/8, $0x53c044befb87161e
-DATA ·precomputed<>+0xdcf8(SB)/8, $0xa3bbdf1b0651d153
-DATA ·precomputed<>+0xdd00(SB)/8, $0x2efa3bb43ead6eef
-DATA ·precomputed<>+0xdd08(SB)/8, $0xbd76b425d1b9fe65
-DATA ·precomputed<>+0xdd10(SB)/8, $0x95f006cd5e527201
-DATA ·precomputed<>+0xdd18(SB)/8, $0x890f3b38a7dc3f
-DATA ·precomputed<>+0xdd20(SB)/8, $0x84ffa0143a7ce6be
-DATA ·precomputed<>+0xdd28(SB)/8, $0x3406aaa089541c2e
-DATA ·precomputed<>+0xdd30(SB)/8, $0x430542b69559d989
-DATA ·precomputed<>+0xdd38(SB)/8, $0x9b427b08b53bddd8
-DATA ·precomputed<>+0xdd40(SB)/8, $0xb8cd4f408f14decc
-DATA ·precomputed<>+0xdd48(SB)/8, $0x95e90d8769e16a6b
-DATA ·precomputed<>+0xdd50(SB)/8, $0x85dcf163c3c38fd3
-DATA ·precomputed<>+0xdd58(SB)/8, $0xf4fb87ba0c01f90a
-DATA ·precomputed<>+0xdd60(SB)/8, $0x8274f825dcd0f994
-DATA ·precomputed<>+0xdd68(SB)/8, $0x4c685fa52e6bf7d8
-DATA ·precomputed<>+0xdd70(SB)/8, $0xc87d88473d928011
-DATA ·precomputed<>+0xdd78(SB)/8, $0x9add0105f9efa96a
-DATA ·precomputed<>+0xdd80(SB)/8, $0x3e957b36b57b9742
-DATA ·precomputed<>+0xdd88(SB)/8, $0x92bfd61e82b72110
-DATA ·precomputed<>+0xdd90(SB)/8, $0x108b450bfdce7ec4
-DATA ·precomputed<>+0xdd98(SB)/8, $0xd8af107acc29c494
-DATA ·precomputed<>+0xdda0(SB)/8, $0x8d67ff7047688c92
-DATA ·precomputed<>+0xdda8(SB)/8, $0x57f4293328b9b681
-DATA ·precomputed<>+0xddb0(SB)/8, $0xbbc98ef3aaf8a48d
-DATA ·precomputed<>+0xddb8(SB)/8, $0x14113b1ae2d549b6
-DATA ·precomputed<>+0xddc0(SB)/8, $0x791b2085894f7f16
-DATA ·precomputed<>+0xddc8(SB)/8, $0x42eb80f2b5c353fb
-DATA ·precomputed<>+0xddd0(SB)/8, $0x377777f7df8db0d4
-DATA ·precomputed<>+0xddd8(SB)/8, $0x23c096334c42ef2
-DATA ·precomputed<>+0xdde0(SB)/8, $0xba05eb5ea34cb6d0
-DATA ·precomputed<>+0xdde8(SB)/8, $0xffb8b01e55cd1242
-DATA ·precomputed<>+0xddf0(SB)/8, $0xeab6ff7d87cd9f24
-DATA ·precomputed<>+0xddf8(SB)/8, $0x175e94c9ab3c09fc
-DATA ·precomputed<>+0xde00(SB)/8, $0xd945a28b16205f2a
-DATA ·precomputed<>+0xde08(SB)/8, $0xfe9112a7abadde7a
-DATA ·precomputed<>+0xde10(SB)/8, $0x7db6c5ee2bbf97c2
-DATA ·precomputed<>+0xde18(SB)/8, $0x3eb84a8fb5b54833
-DATA ·precomputed<>+0xde20(SB)/8, $0x9732a49f273007d9
-DATA ·precomputed<>+0xde28(SB)/8, $0xe61431c0c6a2e3ef
-DATA ·precomputed<>+0xde30(SB)/8, $0x88aa1a0610a101da
-DATA ·precomputed<>+0xde38(SB)/8, $0x64b94de3b972cc61
-DATA ·precomputed<>+0xde40(SB)/8, $0xe21d5b8f9c4da5e3
-DATA ·precomputed<>+0xde48(SB)/8, $0xb5e2220910bf3df1
-DATA ·precomputed<>+0xde50(SB)/8, $0xb04dd106437bf2c6
-DATA ·precomputed<>+0xde58(SB)/8, $0x807c5d041d055404
-DATA ·precomputed<>+0xde60(SB)/8, $0x6e9832062c06fd15
-DATA ·precomputed<>+0xde68(SB)/8, $0x773450afed63ea25
-DATA ·precomputed<>+0xde70(SB)/8, $0xc2dae10695c8dca3
-DATA ·precomputed<>+0xde78(SB)/8, $0x5323f6bad82229e8
-DATA ·precomputed<>+0xde80(SB)/8, $0x58917f6723d1206a
-DATA ·precomputed<>+0xde88(SB)/8, $0xc04601ce11062b8d
-DATA ·precomputed<>+0xde90(SB)/8, $0xdcc60fb6f31f7326
-DATA ·precomputed<>+0xde98(SB)/8, $0xc5aeef464b071708
-DATA ·precomputed<>+0xdea0(SB)/8, $0x5364069edc6939eb
-DATA ·precomputed<>+0xdea8(SB)/8, $0x44bd15a2034a1052
-DATA ·precomputed<>+0xdeb0(SB)/8, $0x8177eeb162a307fe
-DATA ·precomputed<>+0xdeb8(SB)/8, $0x451ae4e71907ad16
-DATA ·precomputed<>+0xdec0(SB)/8, $0x738967251454f76e
-DATA ·precomputed<>+0xdec8(SB)/8, $0x52772de4425c87a9
-DATA ·precomputed<>+0xded0(SB)/8, $0xe59e4516c6efb7d6
-DATA ·precomputed<>+0xded8(SB)/8, $0xdddb8bf3d76bbc11
-DATA ·precomputed<>+0xdee0(SB)/8, $0x1acbebd9c6fd2066
-DATA ·precomputed<>+0xdee8(SB)/8, $0x88c3b5251d7082ea
-DATA ·precomputed<>+0xdef0(SB)/8, $0x6a3b3d626d69cea3
-DATA ·precomputed<>+0xdef8(SB)/8, $0xdbf73dfa8d065405
-DATA ·precomputed<>+0xdf00(SB)/8, $0x5647310d93a9f5ac
-DATA ·precomputed<>+0xdf08(SB)/8, $0xa67858616c05eedb
-DATA ·precomputed<>+0xdf10(SB)/8, $0x2f5aa7c843950b68
-DATA ·precomputed<>+0xdf18(SB)/8, $0x57580907a9d03b3a
-DATA ·precomputed<>+0xdf20(SB)/8, $0xd581049b42e15fe3
-DATA ·precomputed<>+0xdf28(SB)/8, $0x55dcf9d2916c4e88
-DATA ·precomputed<>+0xdf30(SB)/8, $0x87ebfd1327d1a183
-DATA ·precomputed<>+0xdf38(SB)/8, $0x13aee909f5aaa51e
-DATA ·precomputed<>+0xdf40(SB)/8, $0xbd46937a3d19de1c
-DATA ·precomputed<>+0xdf48(SB)/8, $0x71feede46f0be84d
-DATA ·precomputed<>+0xdf50(SB)/8, $0xca2053667c4dc4b3
-DATA ·precomputed<>+0xdf58(SB)/8, $0xfbb97d0de3e851cb
-DATA ·precomputed<>+0xdf60(SB)/8, $0x270b5ea2066e9a4
-DATA ·precomputed<>+0xdf68(SB)/8, $0xeade87ff42ae150b
-DATA ·precomputed<>+0xdf70(SB)/8, $0x9a7f9e818eb1bafa
-DATA ·precomputed<>+0xdf78(SB)/8, $0xcb374aaf0eb5f68e
-DATA ·precomputed<>+0xdf80(SB)/8, $0x1b3d50331314fc73
-DATA ·precomputed<>+0xdf88(SB)/8, $0x2cf4cd42e710aded
-DATA ·precomputed<>+0xdf90(SB)/8, $0x9159bc5d6f4026b7
-DATA ·precomputed<>+0xdf98(SB)/8, $0x403f947b2e62cc45
-DATA ·precomputed<>+0xdfa0(SB)/8, $0x18d6ac7047d97843
-DATA ·precomputed<>+0xdfa8(SB)/8, $0x69d5faaa0694f7eb
-DATA ·precomputed<>+0xdfb0(SB)/8, $0x7711535c6932e0f0
-DATA ·precomputed<>+0xdfb8(SB)/8, $0xc85c96166ebd1488
-DATA ·precomputed<>+0xdfc0(SB)/8, $0x95792f06978f92cc
-DATA ·precomputed<>+0xdfc8(SB)/8, $0xb11574d323196752
-DATA ·precomputed<>+0xdfd0(SB)/8, $0xc3249711b8cfcac1
-DATA ·precomputed<>+0xdfd8(SB)/8, $0x2061c767cf93af67
-DATA ·precomputed<>+0xdfe0(SB)/8, $0xeff09a1b2f63dbe7
-DATA ·precomputed<>+0xdfe8(SB)/8, $0x527776b648091edd
-DATA ·precomputed<>+0xdff0(SB)/8, $0xf0fa985e19bba5a9
-DATA ·precomputed<>+0xdff8(SB)/8, $0xc54f89f366ae3221
-DATA ·precomputed<>+0xe000(SB)/8, $0xbc5a62846a436476
-DATA ·precomputed<>+0xe008(SB)/8, $0x6fcc231335dbb9cb
-DATA ·precomputed<>+0xe010(SB)/8, $0xa77d2d9f5012ffbf
-DATA ·precomputed<>+0xe018(SB)/8, $0xcc25e9f44ae4bd14
-DATA ·precomputed<>+0xe020(SB)/8, $0xd17fcfc41a5e40c6
-DATA ·precomputed<>+0xe028(SB)/8, $0x7d716a5fff085322
-DATA ·precomputed<>+0xe030(SB)/8, $0x9dcbc50bee3077c4
-DATA ·precomputed<>+0xe038(SB)/8, $0xebfe953cdb4a6e61
-DATA ·precomputed<>+0xe040(SB)/8, $0xe7e66f2fd3d777d7
-DATA ·precomputed<>+0xe048(SB)/8, $0x3519dc64cf1a6b09
-DATA ·precomputed<>+0xe050(SB)/8, $0xdf07bebdbf88dcf
-DATA ·precomputed<>+0xe058(SB)/8, $0x17b09654acd4e105
-DATA ·precomputed<>+0xe060(SB)/8, $0xcbd7acd04e70c783
-DATA ·precomputed<>+0xe068(SB)/8, $0xda66e74796b9d577
-DATA ·precomputed<>+0xe070(SB)/8, $0x6d0488a1e3e52f8a
-DATA ·precomputed<>+0xe078(SB)/8, $0x3ec0fd116ff71c1b
-DATA ·precomputed<>+0xe080(SB)/8, $0x75474cb6be4f2782
-DATA ·precomputed<>+0xe088(SB)/8, $0x10ef5e6b41c2c0cd
-DATA ·precomputed<>+0xe090(SB)/8, $0x592c6b066a65e29c
-DATA ·precomputed<>+0xe098(SB)/8, $0x4d424662d12d0608
-DATA ·precomputed<>+0xe0a0(SB)/8, $0xf5280949b1a714fe
-DATA ·precomputed<>+0xe0a8(SB)/8, $0x52697bcc1199f802
-DATA ·precomputed<>+0xe0b0(SB)/8, $0xc68ba4f8e6a4ff3a
-DATA ·precomputed<>+0xe0b8(SB)/8, $0x25a5380f351849ce
-DATA ·precomputed<>+0xe0c0(SB)/8, $0x33207f69573ec6f5
-DATA ·precomputed<>+0xe0c8(SB)/8, $0x7ecc4bbe67bd2e8b
-DATA ·precomputed<>+0xe0d0(SB)/8, $0xa07acd348ffe2420
-DATA ·precomputed<>+0xe0d8(SB)/8, $0xa957eb8a13f9cdd
-DATA ·precomputed<>+0xe0e0(SB)/8, $0xbc7f95b9ec9c0c5
-DATA ·precomputed<>+0xe0e8(SB)/8, $0xd82147cc6a8578cd
-DATA ·precomputed<>+0xe0f0(SB)/8, $0x7a2e7c59e61923c
-DATA ·precomputed<>+0xe0f8(SB)/8, $0x591eb06632e83f25
-DATA ·precomputed<>+0xe100(SB)/8, $0xaaa61588957c94fa
-DATA ·precomputed<>+0xe108(SB)/8, $0x6a2bc707364911fb
-DATA ·precomputed<>+0xe110(SB)/8, $0x9771450c4907b19
-DATA ·precomputed<>+0xe118(SB)/8, $0x4cc487739694ccc4
-DATA ·precomputed<>+0xe120(SB)/8, $0x9db6216e50c878ac
-DATA ·precomputed<>+0xe128(SB)/8, $0x6e89210c6f3031f1
-DATA ·precomputed<>+0xe130(SB)/8, $0xb711dcbfced0d41e
-DATA ·precomputed<>+0xe138(SB)/8, $0xe39bfe3e0fbf9751
-DATA ·precomputed<>+0xe140(SB)/8, $0x18fd7a45764636b5
-DATA ·precomputed<>+0xe148(SB)/8, $0xe437ee86b75d48f3
-DATA ·precomputed<>+0xe150(SB)/8, $0xe323bb1860a80177
-DATA ·precomputed<>+0xe158(SB)/8, $0xedc3c8f3bc94c0ea
-DATA ·precomputed<>+0xe160(SB)/8, $0xd8351164ec8cb0cf
-DATA ·precomputed<>+0xe168(SB)/8, $0xccdd88292472936d
-DATA ·precomputed<>+0xe170(SB)/8, $0xa8db1b8558059756
-DATA ·precomputed<>+0xe178(SB)/8, $0x4eda8cf8d55c184a
-DATA ·precomputed<>+0xe180(SB)/8, $0xdfb5727d2923b8cb
-DATA ·precomputed<>+0xe188(SB)/8, $0x6e793e5ce6773d5e
-DATA ·precomputed<>+0xe190(SB)/8, $0x8ecc901ba0641165
-DATA ·precomputed<>+0xe198(SB)/8, $0x6077ab26d6da5095
-DATA ·precomputed<>+0xe1a0(SB)/8, $0x669b0c6b127d9d
-DATA ·precomputed<>+0xe1a8(SB)/8, $0x8140e4e0d63e2e1f
-DATA ·precomputed<>+0xe1b0(SB)/8, $0x1ad5b03c9641b6a2
-DATA ·precomputed<>+0xe1b8(SB)/8, $0x44299f889baed7b0
-DATA ·precomputed<>+0xe1c0(SB)/8, $0x1736296d1ea4a056
-DATA ·precomputed<>+0xe1c8(SB)/8, $0x6f74702cd77811ba
-DATA ·precomputed<>+0xe1d0(SB)/8, $0x5c927548432dd74b
-DATA ·precomputed<>+0xe1d8(SB)/8, $0x9cc73271e7a194ab
-DATA ·precomputed<>+0xe1e0(SB)/8, $0xf035eded6328dca
-DATA ·precomputed<>+0xe1e8(SB)/8, $0x5292aa3928db755e
-DATA ·precomputed<>+0xe1f0(SB)/8, $0xb5488385a0192a4a
-DATA ·precomputed<>+0xe1f8(SB)/8, $0x6e7d2fa8dfc6895c
-DATA ·precomputed<>+0xe200(SB)/8, $0xfa912a0a5d8bbec9
-DATA ·precomputed<>+0xe208(SB)/8, $0x7051140a0087edb3
-DATA ·precomputed<>+0xe210(SB)/8, $0x5293672b64865e5b
-DATA ·precomputed<>+0xe218(SB)/8, $0x6e8448c9c82c48d5
-DATA ·precomputed<>+0xe220(SB)/8, $0xeece41cba2c437b3
-DATA ·precomputed<>+0xe228(SB)/8, $0x148967d221ce1ef4
-DATA ·precomputed<>+0xe230(SB)/8, $0xf14391fa6b05c2a5
-DATA ·precomputed<>+0xe238(SB)/8, $0x15ff5fc98fed2f1f
-DATA ·precomputed<>+0xe240(SB)/8, $0x18ae5e744557b49f
-DATA ·precomputed<>+0xe248(SB)/8, $0xe33760c63db266b2
-DATA ·precomputed<>+0xe250(SB)/8, $0xd5d830c7b1b249b5
-DATA ·precomputed<>+0xe258(SB)/8, $0x24c665b9c5fff531
-DATA ·precomputed<>+0xe260(SB)/8, $0x6b304406c57df7c0
-DATA ·precomputed<>+0xe268(SB)/8, $0x59706667c3958e89
-DATA ·precomputed<>+0xe270(SB)/8, $0xbf590ff2790a5483
-DATA ·precomputed<>+0xe278(SB)/8, $0xbcaea5a55ce77aaa
-DATA ·precomputed<>+0xe280(SB)/8, $0x8578a00280ceb559
-DATA ·precomputed<>+0xe288(SB)/8, $0x3639aadfd8d61946
-DATA ·precomputed<>+0xe290(SB)/8, $0x3fd52d94add3bb00
-DATA ·precomputed<>+0xe298(SB)/8, $0x16c27846e09a8ce3
-DATA ·precomputed<>+0xe2a0(SB)/8, $0x75cfd6c6294c7967
-DATA ·precomputed<>+0xe2a8(SB)/8, $0xfb9b7f3759195034
-DATA ·precomputed<>+0xe2b0(SB)/8, $0xae687a99aa972a86
-DATA ·precomputed<>+0xe2b8(SB)/8, $0x4bdefdbebd2394e
-DATA ·precomputed<>+0xe2c0(SB)/8, $0x8e245a192f96144d
-DATA ·precomputed<>+0xe2c8(SB)/8, $0xc740d3483b61e5ab
-DATA ·precomputed<>+0xe2d0(SB)/8, $0x8703710e293ddb25
-DATA ·precomputed<>+0xe2d8(SB)/8, $0xf4bb6ac02bbf8f63
-DATA ·precomputed<>+0xe2e0(SB)/8, $0x86396457de3b5805
-DATA ·precomputed<>+0xe2e8(SB)/8, $0x607022db65d29e63
-DATA ·precomputed<>+0xe2f0(SB)/8, $0xad0a0cdccc930fe3
-DATA ·precomputed<>+0xe2f8(SB)/8, $0xd9997ebb1626abf6
-DATA ·precomputed<>+0xe300(SB)/8, $0x2d872d172a510565
-DATA ·precomputed<>+0xe308(SB)/8, $0x3e6820790357ba07
-DATA ·precomputed<>+0xe310(SB)/8, $0x49edd962ebfaf203
-DATA ·precomputed<>+0xe318(SB)/8, $0x3a13edfbf81eda20
-DATA ·precomputed<>+0xe320(SB)/8, $0x87b5b5e17a75f2d5
-DATA ·precomputed<>+0xe328(SB)/8, $0xf04de2b8ddfd9511
-DATA ·precomputed<>+0xe330(SB)/8, $0xf29a1569cfc5c5ff
-DATA ·precomputed<>+0xe338(SB)/8, $0xa399553207160ed3
-DATA ·precomputed<>+0xe340(SB)/8, $0xb6247469cb2b061b
-DATA ·precomputed<>+0xe348(SB)/8, $0xe75c53512f10fe1e
-DATA ·precomputed<>+0xe350(SB)/8, $0xbaf44963d20e1bf7
-DATA ·precomputed<>+0xe358(SB)/8, $0x216cb6ab2d93babf
-DATA ·precomputed<>+0xe360(SB)/8, $0x7e0b655cf5109e45
-DATA ·precomputed<>+0xe368(SB)/8, $0xdcc712fc6657450d
-DATA ·precomputed<>+0xe370(SB)/8, $0xe06c408ed51fc733
-DATA ·precomputed<>+0xe378(SB)/8, $0x85b11f96ed9c0912
-DATA ·precomputed<>+0xe380(SB)/8, $0x954cb91c37365c9b
-DATA ·precomputed<>+0xe388(SB)/8, $0xe0eaa047b2f74fe7
-DATA ·precomputed<>+0xe390(SB)/8, $0x9af74b8615716541
-DATA ·precomputed<>+0xe398(SB)/8, $0x4da06207f73dc7bd
-DATA ·precomputed<>+0xe3a0(SB)/8, $0xdb0d089ee07890a1
-DATA ·precomputed<>+0xe3a8(SB)/8, $0x5bf0968173902f91
-DATA ·precomputed<>+0xe3b0(SB)/8, $0x14e1710ca897f0fe
-DATA ·precomputed<>+0xe3b8(SB)/8, $0x191ec9a13605b1c2
-DATA ·precomputed<>+0xe3c0(SB)/8, $0x271b2e2a0133903b
-DATA ·precomputed<>+0xe3c8(SB)/8, $0x5b3686f2e495ee32
-DATA ·precomputed<>+0xe3d0(SB)/8, $0x89bcc9740c991f28
-DATA ·precomputed<>+0xe3d8(SB)/8, $0xadd20cce34f93b8a
-DATA ·precomputed<>+0xe3e0(SB)/8, $0x5f5a1768680b65b6
-DATA ·precomputed<>+0xe3e8(SB)/8, $0xc453ab8aad41c40
-DATA ·precomputed<>+0xe3f0(SB)/8, $0xd479630fa7fb4269
-DATA ·precomputed<>+0xe3f8(SB)/8, $0x60039d0152c4e929
-DATA ·precomputed<>+0xe400(SB)/8, $0xd8d112cff860883
-DATA ·precomputed<>+0xe408(SB)/8, $0xe1dce5c9723c6e29
-DATA ·precomputed<>+0xe410(SB)/8, $0xc19eadae191ad70e
-DATA ·precomputed<>+0xe418(SB)/8, $0x4af8194d62ce0e64
-DATA ·precomputed<>+0xe420(SB)/8, $0xf207bfb0cc81415c
-DATA ·precomputed<>+0xe428(SB)/8, $0x3ab92f3b008495c8
-DATA ·precomputed<>+0xe430(SB)/8, $0xe7250e17fdb9534b
-DATA ·precomputed<>+0xe438(SB)/8, $0xba67e9b86c0c1d1c
-DATA ·precomputed<>+0xe440(SB)/8, $0x117ae3ff072c793f
-DATA ·precomputed<>+0xe448(SB)/8, $0x5243e6ea9fb3091e
-DATA ·precomputed<>+0xe450(SB)/8, $0xf93ad51431a59e39
-DATA ·precomputed<>+0xe458(SB)/8, $0x8ce9cfb0c93c1891
-DATA ·precomputed<>+0xe460(SB)/8, $0xbfcbf9011ed08b0e
-DATA ·precomputed<>+0xe468(SB)/8, $0x4d13cf2ab53d687d
-DATA ·precomputed<>+0xe470(SB)/8, $0x25aa82db5d81e4ad
-DATA ·precomputed<>+0xe478(SB)/8, $0xd12f01f563c3cb41
-DATA ·precomputed<>+0xe480(SB)/8, $0x1e799084f8d1333a
-DATA ·precomputed<>+0xe488(SB)/8, $0x30c96c55653bcd0a
-DATA ·precomputed<>+0xe490(SB)/8, $0x9cf130fd44b5195c
-DATA ·precomputed<>+0xe498(SB)/8, $0x4cffc53113c77763
-DATA ·precomputed<>+0xe4a0(SB)/8, $0x82287f89430619f
-DATA ·precomputed<>+0xe4a8(SB)/8, $0x78bb037db08ce0d9
-DATA ·precomputed<>+0xe4b0(SB)/8, $0x2e69d5123affe8e8
-DATA ·precomputed<>+0xe4b8(SB)/8, $0xe9dbb263ba9ec693
-DATA ·precomputed<>+0xe4c0(SB)/8, $0x67b66ad862f132b5
-DATA ·precomputed<>+0xe4c8(SB)/8, $0x70318d2bbeb47184
-DATA ·precomputed<>+0xe4d0(SB)/8, $0x46c429eaf50a0e98
-DATA ·precomputed<>+0xe4d8(SB)/8, $0xd7e32ebae2b3542c
-DATA ·precomputed<>+0xe4e0(SB)/8, $0x625c1ce9e096b4b7
-DATA ·precomputed<>+0xe4e8(SB)/8, $0x9221351389fd4dd
-DATA ·precomputed<>+0xe4f0(SB)/8, $0x8dc02d2fb0ee85a
-DATA ·precomputed<>+0xe4f8(SB)/8, $0x98c0ba7d853cd901
-DATA ·precomputed<>+0xe500(SB)/8, $0x88a0cd6d0deb1d99
-DATA ·precomputed<>+0xe508(SB)/8, $0x989e496279a6b90c
-DATA ·precomputed<>+0xe510(SB)/8, $0xf5d19b9524dd89d5
-DATA ·precomputed<>+0xe518(SB)/8, $0x189e5230b37cf19e
-DATA ·precomputed<>+0xe520(SB)/8, $0x84a607b8b0c5fefa
-DATA ·precomputed<>+0xe528(SB)/8, $0xe48450c9d8c7fbd1
-DATA ·precomputed<>+0xe530(SB)/8, $0x178f9b5646479ad7
-DATA ·precomputed<>+0xe538(SB)/8, $0x7d6a36c6cbcd2ae5
-DATA ·precomputed<>+0xe540(SB)/8, $0x95a4d51f71ae6516
-DATA ·precomputed<>+0xe548(SB)/8, $0x363349f566e2171
-DATA ·precomputed<>+0xe550(SB)/8, $0x4d4bb4b0ed1f2fc7
-DATA ·precomputed<>+0xe558(SB)/8, $0xde435aaff10fa10c
-DATA ·precomputed<>+0xe560(SB)/8, $0x711258a9b76e3b6e
-DATA ·precomputed<>+0xe568(SB)/8, $0x9a640eeb2792e0b3
-DATA ·precomputed<>+0xe570(SB)/8, $0x7953ead85fab8617
-DATA ·precomputed<>+0xe578(SB)/8, $0xd4b6d248dd64702a
-DATA ·precomputed<>+0xe580(SB)/8, $0x95bbe5282d672209
-DATA ·precomputed<>+0xe588(SB)/8, $0xfcc53cfcb6926b8a
-DATA ·precomputed<>+0xe590(SB)/8, $0x581419057659f87
-DATA ·precomputed<>+0xe598(SB)/8, $0x4836e93b08d25069
-DATA ·precomputed<>+0xe5a0(SB)/8, $0xd1eb20066a5ad81e
-DATA ·precomputed<>+0xe5a8(SB)/8, $0x4bee145aaf0d37f8
-DATA ·precomputed<>+0xe5b0(SB)/8, $0xd44362add31ce6cb
-DATA ·precomputed<>+0xe5b8(SB)/8, $0xdc03e581936c1060
-DATA ·precomputed<>+0xe5c0(SB)/8, $0x13cffce916fcb889
-DATA ·precomputed<>+0xe5c8(SB)/8, $0xed7e6683ac7e709a
-DATA ·precomputed<>+0xe5d0(SB)/8, $0xb655d0985896e541
-DATA ·precomputed<>+0xe5d8(SB)/8, $0x7124356b92a6204
-DATA ·precomputed<>+0xe5e0(SB)/8, $0xa2ae43c8a8f50043
-DATA ·precomputed<>+0xe5e8(SB)/8, $0xeb39255c68731891
-DATA ·precomputed<>+0xe5f0(SB)/8, $0xe07be0ad3d9c408b
-DATA ·precomputed<>+0xe5f8(SB)/8, $0xdb7904f0b4f5c3a
-DATA ·precomputed<>+0xe600(SB)/8, $0x7ddc02354d70bb81
-DATA ·precomputed<>+0xe608(SB)/8, $0xe3b323c35347797a
-DATA ·precomputed<>+0xe610(SB)/8, $0x3536cd9d3536deee
-DATA ·precomputed<>+0xe618(SB)/8, $0x579b6894001bfd25
-DATA ·precomputed<>+0xe620(SB)/8, $0x58ad5301ebe2922e
-DATA ·precomputed<>+0xe628(SB)/8, $0xe0aa2cae92a88d43
-DATA ·precomputed<>+0xe630(SB)/8, $0x24567a3b4409e205
-DATA ·precomputed<>+0xe638(SB)/8, $0x3cece61a2258f0cb
-DATA ·precomputed<>+0xe640(SB)/8, $0x8da5cf463babf4f6
-DATA ·precomputed<>+0xe648(SB)/8, $0xb37428d981fff8e6
-DATA ·precomputed<>+0xe650(SB)/8, $0xcda1ff7748495d23
-DATA ·precomputed<>+0xe658(SB)/8, $0x98f9208f34f392ad
-DATA ·precomputed<>+0xe660(SB)/8, $0x931f5b375bc88514
-DATA ·precomputed<>+0xe668(SB)/8, $0xd49971becb375921
-DATA ·precomputed<>+0xe670(SB)/8, $0x9dcd4986b5c01fab
-DATA ·precomputed<>+0xe678(SB)/8, $0xcc26ec02c1ab1c94
-DATA ·precomputed<>+0xe680(SB)/8, $0x34e8087db4b874d6
-DATA ·precomputed<>+0xe688(SB)/8, $0x224fc2779d0a3761
-DATA ·precomputed<>+0xe690(SB)/8, $0xacc1f2583f7e5159
-DATA ·precomputed<>+0xe698(SB)/8, $0xc82d71ec8966d593
-DATA ·precomputed<>+0xe6a0(SB)/8, $0x5b1f9f407dcd691a
-DATA ·precomputed<>+0xe6a8(SB)/8, $0xd8fafdaeba28f416
-DATA ·precomputed<>+0xe6b0(SB)/8, $0xe8622ae643b6d90f
-DATA ·precomputed<>+0xe6b8(SB)/8, $0xec13fce79ec71d5b
-DATA ·precomputed<>+0xe6c0(SB)/8, $0x7b6aeb8fd2e8214
-DATA ·precomputed<>+0xe6c8(SB)/8, $0x813e718e4cbc297a
-DATA ·precomputed<>+0xe6d0(SB)/8, $0xfac0dfab81fd6931
-DATA ·precomputed<>+0xe6d8(SB)/8, $0xa1fe88213c48ffd7
-DATA ·precomputed<>+0xe6e0(SB)/8, $0xd2715c1885e03c08
-DATA ·precomputed<>+0xe6e8(SB)/8, $0xb6e4418a977c57f0
-DATA ·precomputed<>+0xe6f0(SB)/8, $0xfaa79ea473418cde
-DATA ·precomputed<>+0xe6f8(SB)/8, $0x6ab8c25b171e2a89
-DATA ·precomputed<>+0xe700(SB)/8, $0x2800445c4ec7cf05
-DATA ·precomputed<>+0xe708(SB)/8, $0x8e74a7b0b66c6200
-DATA ·precomputed<>+0xe710(SB)/8, $0x81b1177481db950
-DATA ·precomputed<>+0xe718(SB)/8, $0x526d051cb89f7c02
-DATA ·precomputed<>+0xe720(SB)/8, $0x3c8309425c29c905
-DATA ·precomputed<>+0xe728(SB)/8, $0xbfbd9e3e44c15ce5
-DATA ·precomputed<>+0xe730(SB)/8, $0x6055c949a29472e6
-DATA ·precomputed<>+0xe738(SB)/8, $0xab0010c7a37c4912
-DATA ·precomputed<>+0xe740(SB)/8, $0xeb8492be5b7d3647
-DATA ·precomputed<>+0xe748(SB)/8, $0xb4cfd7b1ee31caf
-DATA ·precomputed<>+0xe750(SB)/8, $0x81cfcde24b46304b
-DATA ·precomputed<>+0xe758(SB)/8, $0x968df75dc554a5bc
-DATA ·precomputed<>+0xe760(SB)/8, $0x7ce788068d0e043c
-DATA ·precomputed<>+0xe768(SB)/8, $0x1e896819345ea27c
-DATA ·precomputed<>+0xe770(SB)/8, $0xe040c19c6e287603
-DATA ·precomputed<>+0xe778(SB)/8, $0xa581856f138e8ece
-DATA ·precomputed<>+0xe780(SB)/8, $0xe49f6558c354a9d6
-DATA ·precomputed<>+0xe788(SB)/8, $0xc4ad763ac0cfb2d3
-DATA ·precomputed<>+0xe790(SB)/8, $0x4be2143b1b76b8f3
-DATA ·precomputed<>+0xe798(SB)/8, $0xa8caae14d0ad0247
-DATA ·precomputed<>+0xe7a0(SB)/8, $0xcfe96bd5928b0ae5
-DATA ·precomputed<>+0xe7a8(SB)/8, $0xcf5051f77724f8e4
-DATA ·precomputed<>+0xe7b0(SB)/8, $0x9128916fec4af64a
-DATA ·precomputed<>+0xe7b8(SB)/8, $0xc211ff4bcb437bfb
-DATA ·precomputed<>+0xe7c0(SB)/8, $0xee6e8134bce59c0f
-DATA ·precomputed<>+0xe7c8(SB)/8, $0x3d068b4cd59f7f86
-DATA ·precomputed<>+0xe7d0(SB)/8, $0xafa2753c96283457
-DATA ·precomputed<>+0xe7d8(SB)/8, $0x453fe33c1aedcbf0
-DATA ·precomputed<>+0xe7e0(SB)/8, $0x781294c8483c0b1a
-DATA ·precomputed<>+0xe7e8(SB)/8, $0x9e6f51335c2ad1ee
-DATA ·precomputed<>+0xe7f0(SB)/8, $0x2a77b6ce69383e0b
-DATA ·precomputed<>+0xe7f8(SB)/8, $0xcb5a83abfa9f0142
-DATA ·precomputed<>+0xe800(SB)/8, $0x19915b26c3d8ed98
-DATA ·precomputed<>+0xe808(SB)/8, $0xf451e57a9a66a6e5
-DATA ·precomputed<>+0xe810(SB)/8, $0x2984360730dab6a3
-DATA ·precomputed<>+0xe818(SB)/8, $0x1710267c3d1a1ebb
-DATA ·precomputed<>+0xe820(SB)/8, $0xce4ecfd4e11d88c0
-DATA ·precomputed<>+0xe828(SB)/8, $0x12fc278711ce026a
-DATA ·precomputed<>+0xe830(SB)/8, $0x9801cecd691227de
-DATA ·precomputed<>+0xe838(SB)/8, $0x517a92f376ce6dae
-DATA ·precomputed<>+0xe840(SB)/8, $0x821b0fdf648c48e5
-DATA ·precomputed<>+0xe848(SB)/8, $0x689e6d569f45a433
-DATA ·precomputed<>+0xe850(SB)/8, $0xa5a9dca82e572855
-DATA ·precomputed<>+0xe858(SB)/8, $0xb0f07eb78adfb547
-DATA ·precomputed<>+0xe860(SB)/8, $0x48ecb166552c8d55
-DATA ·precomputed<>+0xe868(SB)/8, $0xfe3fc268ce854171
-DATA ·precomputed<>+0xe870(SB)/8, $0x323af5ebeeee9bc0
-DATA ·precomputed<>+0xe878(SB)/8, $0x666a2a341ae1c80
-DATA ·precomputed<>+0xe880(SB)/8, $0x29cbad2248abc2fd
-DATA ·precomputed<>+0xe888(SB)/8, $0xbb79d93bccdfe1e6
-DATA ·precomputed<>+0xe890(SB)/8, $0xbd4d63e3299f8a9c
-DATA ·precomputed<>+0xe898(SB)/8, $0xfd677d051d550bc8
-DATA ·precomputed<>+0xe8a0(SB)/8, $0x4a7ed1b164cea408
-DATA ·precomputed<>+0xe8a8(SB)/8, $0xbb7d1a79e6ec4f0
-DATA ·precomputed<>+0xe8b0(SB)/8, $0xb26dc619bc683e7f
-DATA ·precomputed<>+0xe8b8(SB)/8, $0x655e51a3d624b2f5
-DATA ·precomputed<>+0xe8c0(SB)/8, $0xa06d20bc9ff262fb
-DATA ·precomputed<>+0xe8c8(SB)/8, $0xcba032fdd075868b
-DATA ·precomputed<>+0xe8d0(SB)/8, $0x70376026943fd973
-DATA ·precomputed<>+0xe8d8(SB)/8, $0x81c57cbae35c5e02
-DATA ·precomputed<>+0xe8e0(SB)/8, $0x1964e700ba871f1b
-DATA ·precomputed<>+0xe8e8(SB)/8, $0xf03a8c046b265f57
-DATA ·precomputed<>+0xe8f0(SB)/8, $0xc8ebc9120b950259
-DATA ·precomputed<>+0xe8f8(SB)/8, $0xd2b0ee30ad32ca8b
-DATA ·precomputed<>+0xe900(SB)/8, $0x840153f8c18cc45f
-DATA ·precomputed<>+0xe908(SB)/8, $0x9f53202449ac415c
-DATA ·precomputed<>+0xe910(SB)/8, $0x348203aa0751cc86
-DATA ·precomputed<>+0xe918(SB)/8, $0x285234309a44004e
-DATA ·precomputed<>+0xe920(SB)/8, $0x5553a2a07021c02e
-DATA ·precomputed<>+0xe928(SB)/8, $0xb55b79be9c5620de
-DATA ·precomputed<>+0xe930(SB)/8, $0x2c9a36c247eab3be
-DATA ·precomputed<>+0xe938(SB)/8, $0xe99a2b68a8746e9b
-DATA ·precomputed<>+0xe940(SB)/8, $0xe01bf0c289c8e719
-DATA ·precomputed<>+0xe948(SB)/8, $0xbce1e20fb2f4cdb0
-DATA ·precomputed<>+0xe950(SB)/8, $0x8c38eeafa1053ca5
-DATA ·precomputed<>+0xe958(SB)/8, $0x8c2d85ef7cd633a5
-DATA ·precomputed<>+0xe960(SB)/8, $0x756953649b53cdb1
-DATA ·precomputed<>+0xe968(SB)/8, $0x5e999741447eb1a5
-DATA ·precomputed<>+0xe970(SB)/8, $0x6d6b2d88dbd88626
-DATA ·precomputed<>+0xe978(SB)/8, $0x87eaf04521876357
-DATA ·precomputed<>+0xe980(SB)/8, $0xe768ffa24cbf0511
-DATA ·precomputed<>+0xe988(SB)/8, $0xd4ecca695ddaae7c
-DATA ·precomputed<>+0xe990(SB)/8, $0x6c3d58043c60d4ce
-DATA ·precomputed<>+0xe998(SB)/8, $0xe0e2e3e3839d6e77
-DATA ·precomputed<>+0xe9a0(SB)/8, $0x97ab4a1e86ee7c1e
-DATA ·precomputed<>+0xe9a8(SB)/8, $0x95ea47be6ed4f11f
-DATA ·precomputed<>+0xe9b0(SB)/8, $0x999922d1d63c8eac
-DATA ·precomputed<>+0xe9b8(SB)/8, $0x501190d4e116ea32
-DATA ·precomputed<>+0xe9c0(SB)/8, $0x2c88f1ffdeec441e
-DATA ·precomputed<>+0xe9c8(SB)/8, $0xab52096bd01b2157
-DATA ·precomputed<>+0xe9d0(SB)/8, $0x37eee2756c45cf5c
-DATA ·precomputed<>+0xe9d8(SB)/8, $0xa070d24e0520ecaa
-DATA ·precomputed<>+0xe9e0(SB)/8, $0x61d15bd1546b9fd3
-DATA ·precomputed<>+0xe9e8(SB)/8, $0x3276fb742c96db1c
-DATA ·precomputed<>+0xe9f0(SB)/8, $0xc5c1b041b95b29b7
-DATA ·precomputed<>+0xe9f8(SB)/8, $0xe18008dbbd7d3254
-DATA ·precomputed<>+0xea00(SB)/8, $0x2f1c07276d12b9d2
-DATA ·precomputed<>+0xea08(SB)/8, $0xe0d28a14d11af74c
-DATA ·precomputed<>+0xea10(SB)/8, $0x941b47e50619db3f
-DATA ·precomputed<>+0xea18(SB)/8, $0xd0726e8da759130a
-DATA ·precomputed<>+0xea20(SB)/8, $0x54a2b2b6838c8320
-DATA ·precomputed<>+0xea28(SB)/8, $0x1679939aa33239f3
-DATA ·precomputed<>+0xea30(SB)/8, $0x5cdd660b0742d468
-DATA ·precomputed<>+0xea38(SB)/8, $0x9cae614c26c8b9eb
-DATA ·precomputed<>+0xea40(SB)/8, $0xd56ae44f98dfb69a
-DATA ·precomputed<>+0xea48(SB)/8, $0xd5f66b0b609d941c
-DATA ·precomputed<>+0xea50(SB)/8, $0xca6b6d35b91b5245
-DATA ·precomputed<>+0xea58(SB)/8, $0x98e3a4e37b3f98a6
-DATA ·precomputed<>+0xea60(SB)/8, $0x715dfa6f358c56a
-DATA ·precomputed<>+0xea68(SB)/8, $0x3b02ff2136a66c64
-DATA ·precomputed<>+0xea70(SB)/8, $0x737b1401cb22cbd3
-DATA ·precomputed<>+0xea78(SB)/8, $0x9dd15f5b6b8e9624
-DATA ·precomputed<>+0xea80(SB)/8, $0x52330de731f8be93
-DATA ·precomputed<>+0xea88(SB)/8, $0x33c1af38c9a2a0ab
-DATA ·precomputed<>+0xea90(SB)/8, $0xa5a9d43d35971497
-DATA ·precomputed<>+0xea98(SB)/8, $0x307122b2cef2b72d
-DATA ·precomputed<>+0xeaa0(SB)/8, $0x7a49776044a816a5
-DATA ·precomputed<>+0xeaa8(SB)/8, $0xb6e1327505b961f2
-DATA ·precomputed<>+0xeab0(SB)/8, $0x1327b05626eca596
-DATA ·precomputed<>+0xeab8(SB)/8, $0x1510d624998747ae
-DATA ·precomputed<>+0xeac0(SB)/8, $0x25f5a71dd360d017
-DATA ·precomputed<>+0xeac8(SB)/8, $0x4c0779b529b0ed73
-DATA ·precomputed<>+0xead0(SB)/8, $0xc662fedc9825a018
-DATA ·precomputed<>+0xead8(SB)/8, $0xeee8912561d4add0
-DATA ·precomputed<>+0xeae0(SB)/8, $0x1543814d92163d14
-DATA ·precomputed<>+0xeae8(SB)/8, $0x79f2376f27370d3c
-DATA ·precomputed<>+0xeaf0(SB)/8, $0xf80c6963cbe1af7a
-DATA ·precomputed<>+0xeaf8(SB)/8, $0xf2d521bceb9e41f7
-DATA ·precomputed<>+0xeb00(SB)/8, $0xe3a49a0f26fbbf2e
-DATA ·precomputed<>+0xeb08(SB)/8, $0xe1ec7ee1a2579517
-DATA ·precomputed<>+0xeb10(SB)/8, $0x8eb9ce607451c6bb
-DATA ·precomputed<>+0xeb18(SB)/8, $0x77b0da603d86da01
-DATA ·precomputed<>+0xeb20(SB)/8, $0xe621e943b6f83ba9
-DATA ·precomputed<>+0xeb28(SB)/8, $0x15214d33f19d3a44
-DATA ·precomputed<>+0xeb30(SB)/8, $0x719eec5d0c85d8e6
-DATA ·precomputed<>+0xeb38(SB)/8, $0x8515d5dccd6021e2
-DATA ·precomputed<>+0xeb40(SB)/8, $0xe241619fc1805864
-DATA ·precomputed<>+0xeb48(SB)/8, $0x6f1d6166b2de204a
-DATA ·precomputed<>+0xeb50(SB)/8, $0x13c3f91250e68d0b
-DATA ·precomputed<>+0xeb58(SB)/8, $0x32eb021dc4a24f5a
-DATA ·precomputed<>+0xeb60(SB)/8, $0x3f1452f50e78c588
-DATA ·precomputed<>+0xeb68(SB)/8, $0xa267bf19c9971e98
-DATA ·precomputed<>+0xeb70(SB)/8, $0x77a231a7e801c021
-DATA ·precomputed<>+0xeb78(SB)/8, $0xf363c9b3c2666e80
-DATA ·precomputed<>+0xeb80(SB)/8, $0xbb40d8452a3427d
-DATA ·precomputed<>+0xeb88(SB)/8, $0x90b397d8e7ca5638
-DATA ·precomputed<>+0xeb90(SB)/8, $0xd874e0cd80a03b7b
-DATA ·precomputed<>+0xeb98(SB)/8, $0x296fa6d2cca359d5
-DATA ·precomputed<>+0xeba0(SB)/8, $0xc4a693433dbb1b3f
-DATA ·precomputed<>+0xeba8(SB)/8, $0x817bd1f852ab44ec
-DATA ·precomputed<>+0xebb0(SB)/8, $0xbbb7169a3166cdc8
-DATA ·precomputed<>+0xebb8(SB)/8, $0x4c31423203ab02e1
-DATA ·precomputed<>+0xebc0(SB)/8, $0xb8eb0bf0ae309a0a
-DATA ·precomputed<>+0xebc8(SB)/8, $0xa9f52f58375b8fbc
-DATA ·precomputed<>+0xebd0(SB)/8, $0xb8e4f9481a4993b7
-DATA ·precomputed<>+0xebd8(SB)/8, $0x50ce578e8f73c292
-DATA ·precomputed<>+0xebe0(SB)/8, $0x2437a4a602e503d6
-DATA ·precomputed<>+0xebe8(SB)/8, $0x20cdfc50e4c68ea3
-DATA ·precomputed<>+0xebf0(SB)/8, $0xfec5993b3616f348
-DATA ·precomputed<>+0xebf8(SB)/8, $0x5d96b4c5c0c420df
-DATA ·precomputed<>+0xec00(SB)/8, $0xdd4b8a19445990f7
-DATA ·precomputed<>+0xec08(SB)/8, $0xd5a051c7287d385b
-DATA ·precomputed<>+0xec10(SB)/8, $0xee19fe744c181270
-DATA ·precomputed<>+0xec18(SB)/8, $0xe19ab52b97b43992
-DATA ·precomputed<>+0xec20(SB)/8, $0xad47ac44d783afa9
-DATA ·precomputed<>+0xec28(SB)/8, $0xe925fffa33b90f35
-DATA ·precomputed<>+0xec30(SB)/8, $0x93e1c909edfa7293
-DATA ·precomputed<>+0xec38(SB)/8, $0xdf1562cc2b595151
-DATA ·precomputed<>+0xec40(SB)/8, $0x6b71cdf0cca0c48a
-DATA ·precomputed<>+0xec48(SB)/8, $0xe71b54c1aea033
-DATA ·precomputed<>+0xec50(SB)/8, $0x247f5baa69f6d107
-DATA ·precomputed<>+0xec58(SB)/8, $0x4e3ec504050c3704
-DATA ·precomputed<>+0xec60(SB)/8, $0xf2b2be8a7a438e93
-DATA ·precomputed<>+0xec68(SB)/8, $0x240b632da331e01e
-DATA ·precomputed<>+0xec70(SB)/8, $0x61e6655791150675
-DATA ·precomputed<>+0xec78(SB)/8, $0x3236413495a99a28
-DATA ·precomputed<>+0xec80(SB)/8, $0x9dff57620e2fb149
-DATA ·precomputed<>+0xec88(SB)/8, $0x524a978b801b580f
-DATA ·precomputed<>+0xec90(SB)/8, $0xb15ff177530baac
-DATA ·precomputed<>+0xec98(SB)/8, $0x1be58832f89b2f56
-DATA ·precomputed<>+0xeca0(SB)/8, $0xd3dadb63685183d2
-DATA ·precomputed<>+0xeca8(SB)/8, $0x9e84bbfa97d5cb26
-DATA ·precomputed<>+0xecb0(SB)/8, $0x179a83aba5250ecb
-DATA ·precomputed<>+0xecb8(SB)/8, $0x5399fac4102d2b6f
-DATA ·precomputed<>+0xecc0(SB)/8, $0x5e5de136d3399e1e
-DATA ·precomputed<>+0xecc8(SB)/8, $0xe38bab00fe2f8b75
-DATA ·precomputed<>+0xecd0(SB)/8, $0x736126de3a77db29
-DATA ·precomputed<>+0xecd8(SB)/8, $0x7b0d1865f2aa121e
-DATA ·precomputed<>+0xece0(SB)/8, $0x5545e45edecf9cde
-DATA ·precomputed<>+0xece8(SB)/8, $0x9608ebce2318be70
-DATA ·precomputed<>+0xecf0(SB)/8, $0xe6596006fa55b0e5
-DATA ·precomputed<>+0xecf8(SB)/8, $0xc8c2f41bc4b6ca0
-DATA ·precomputed<>+0xed00(SB)/8, $0xf21568665dc4ea78
-DATA ·precomputed<>+0xed08(SB)/8, $0xec007b8e38b6456e
-DATA ·precomputed<>+0xed10(SB)/8, $0x74f959ff866a5860
-DATA ·precomputed<>+0xed18(SB)/8, $0xb29f3148566bdac7
-DATA ·precomputed<>+0xed20(SB)/8, $0xb2700af5c2194abf
-DATA ·precomputed<>+0xed28(SB)/8, $0x1adea3f4100732a2
-DATA ·precomputed<>+0xed30(SB)/8, $0x3fe6e15e3063cd34
-DATA ·precomputed<>+0xed38(SB)/8, $0xd0cc338e2264f07
-DATA ·precomputed<>+0xed40(SB)/8, $0xda1c5c7a92025945
-DATA ·precomputed<>+0xed48(SB)/8, $0xb114ba225d3b0775
-DATA ·precomputed<>+0xed50(SB)/8, $0x11cc6888cedb69a0
-DATA ·precomputed<>+0xed58(SB)/8, $0x4365bea80f83c772
-DATA ·precomputed<>+0xed60(SB)/8, $0x6fe80bbda8dbe3
-DATA ·precomputed<>+0xed68(SB)/8, $0x334adcb6c2d3d266
-DATA ·precomputed<>+0xed70(SB)/8, $0x8c92c0841521de1c
-DATA ·precomputed<>+0xed78(SB)/8, $0x57873ef978d8f72c
-DATA ·precomputed<>+0xed80(SB)/8, $0x62c45d9dba47c894
-DATA ·precomputed<>+0xed88(SB)/8, $0xb1d9b901c8614fb8
-DATA ·precomputed<>+0xed90(SB)/8, $0x148569853006f1b0
-DATA ·precomputed<>+0xed98(SB)/8, $0x85a55c42ee27882f
-DATA ·precomputed<>+0xeda0(SB)/8, $0x617430471b537274
-DATA ·precomputed<>+0xeda8(SB)/8, $0x41c6e5e349283279
-DATA ·precomputed<>+0xedb0(SB)/8, $0x75e349e92e2ab1d3
-DATA ·precomputed<>+0xedb8(SB)/8, $0x64a829560bfcfb4d
-DATA ·precomputed<>+0xedc0(SB)/8, $0xcfb0a7d03b64dcd7
-DATA ·precomputed<>+0xedc8(SB)/8, $0xf4c2f1fc558c9d55
-DATA ·precomputed<>+0xedd0(SB)/8, $0x110c2db2a0fbc656
-DATA ·precomputed<>+0xedd8(SB)/8, $0x3cad85caef5b6bea
-DATA ·precomputed<>+0xede0(SB)/8, $0x7099dd0e4e0b1230
-DATA ·precomputed<>+0xede8(SB)/8, $0xc769b937098a2fcd
-DATA ·precomputed<>+0xedf0(SB)/8, $0x9209f5501e1e7407
-DATA ·precomputed<>+0xedf8(SB)/8, $0x1b47255d1ba7cb47
-DATA ·precomputed<>+0xee00(SB)/8, $0xc170da3ff085d532
-DATA ·precomputed<>+0xee08(SB)/8, $0xf5e7aa0bee477eb1
-DATA ·precomputed<>+0xee10(SB)/8, $0x3870ecfce04d9749
-DATA ·precomputed<>+0xee18(SB)/8, $0xa5d4438d179e54db
-DATA ·precomputed<>+0xee20(SB)/8, $0x564ef6c797469df7
-DATA ·precomputed<>+0xee28(SB)/8, $0x504b28b3c16fe904
-DATA ·precomputed<>+0xee30(SB)/8, $0x1df08cd00b4b24dc
-DATA ·precomputed<>+0xee38(SB)/8, $0x4cd0621e21f0e1df
-DATA ·precomputed<>+0xee40(SB)/8, $0xd8aed0cd2c01b596
-DATA ·precomputed<>+0xee48(SB)/8, $0x1a1a2e1130efcda3
-DATA ·precomputed<>+0xee50(SB)/8, $0xf771f93b36b1a5b5
-DATA ·precomputed<>+0xee58(SB)/8, $0x2ea34e3d14fcd251
-DATA ·precomputed<>+0xee60(SB)/8, $0x6895cb31fd893979
-DATA ·precomputed<>+0xee68(SB)/8, $0x10b1d2c914f556b4
-DATA ·precomputed<>+0xee70(SB)/8, $0x835fdf7e6430bfa8
-DATA ·precomputed<>+0xee78(SB)/8, $0x1f4bbef524bf4ba5
-DATA ·precomputed<>+0xee80(SB)/8, $0x371b96c465b11c8d
-DATA ·precomputed<>+0xee88(SB)/8, $0x7f5bde5ebb973131
-DATA ·precomputed<>+0xee90(SB)/8, $0x1a77235cc9406d53
-DATA ·precomputed<>+0xee98(SB)/8, $0xf6ee0946f75a1764
-DATA ·precomputed<>+0xeea0(SB)/8, $0x27e7507b3131a6e1
-DATA ·precomputed<>+0xeea8(SB)/8, $0x452bf76c78324830
-DATA ·precomputed<>+0xeeb0(SB)/8, $0x8f47b64590be32be
-DATA ·precomputed<>+0xeeb8(SB)/8, $0x5cf871cf7bb5fa35
-DATA ·precomputed<>+0xeec0(SB)/8, $0xbc805aa5d562b5f1
-DATA ·precomputed<>+0xeec8(SB)/8, $0x7101b9da35dac658
-DATA ·precomputed<>+0xeed0(SB)/8, $0x5b7f211dddc28e5a
-DATA ·precomputed<>+0xeed8(SB)/8, $0xea89f24cd3d1cd0a
-DATA ·precomputed<>+0xeee0(SB)/8, $0xbaaa9ef57567c80d
-DATA ·precomputed<>+0xeee8(SB)/8, $0xe0d1f26d9a60c5ee
-DATA ·precomputed<>+0xeef0(SB)/8, $0xc88a044cab36cd64
-DATA ·precomputed<>+0xeef8(SB)/8, $0xb8e03d021b125de6
-DATA ·precomputed<>+0xef00(SB)/8, $0xba695e4aa96de7cf
-DATA ·precomputed<>+0xef08(SB)/8, $0xf5e79743cce25c14
-DATA ·precomputed<>+0xef10(SB)/8, $0xd2f39ef265e94ed9
-DATA ·precomputed<>+0xef18(SB)/8, $0x730f4ef4d8d6b39e
-DATA ·precomputed<>+0xef20(SB)/8, $0x84d318cfede6e737
-DATA ·precomputed<>+0xef28(SB)/8, $0x5107d353ab84abec
-DATA ·precomputed<>+0xef30(SB)/8, $0xee61bc09980e9c2b
-DATA ·precomputed<>+0xef38(SB)/8, $0xdaaaea3baa81f57
-DATA ·precomputed<>+0xef40(SB)/8, $0xda0c10473a707a66
-DATA ·precomputed<>+0xef48(SB)/8, $0x76ddb98f0c692d44
-DATA ·precomputed<>+0xef50(SB)/8, $0xeccae586b15b7254
-DATA ·precomputed<>+0xef58(SB)/8, $0xeadc1b51e7e82423
-DATA ·precomputed<>+0xef60(SB)/8, $0xd6779ff27c3cb698
-DATA ·precomputed<>+0xef68(SB)/8, $0xe271cb4df6e7da6
-DATA ·precomputed<>+0xef70(SB)/8, $0xeacf34c345900023
-DATA ·precomputed<>+0xef78(SB)/8, $0xafd017ad03da2ba5
-DATA ·precomputed<>+0xef80(SB)/8, $0x2a21a6409464196c
-DATA ·precomputed<>+0xef88(SB)/8, $0x6f3de7bdebcdaaba
-DATA ·precomputed<>+0xef90(SB)/8, $0x1f36cc4ab01bd45
-DATA ·precomputed<>+0xef98(SB)/8, $0x9259d1737f9bf0aa
-DATA ·precomputed<>+0xefa0(SB)/8, $0x5a7f784fcd48cb44
-DATA ·precomputed<>+0xefa8(SB)/8, $0xb2f526585bc03c2e
-DATA ·precomputed<>+0xefb0(SB)/8, $0xba3be3fe90c02e9e
-DATA ·precomputed<>+0xefb8(SB)/8, $0x82d5681e5350f19d
-DATA ·precomputed<>+0xefc0(SB)/8, $0x4926699827c7e6eb
-DATA ·precomputed<>+0xefc8(SB)/8, $0x84ffa3726625bc7f
-DATA ·precomputed<>+0xefd0(SB)/8, $0xedec924705c9cb15
-DATA ·precomputed<>+0xefd8(SB)/8, $0xcfad0b908075b84e
-DATA ·precomputed<>+0xefe0(SB)/8, $0x94bed316bc0898d3
-DATA ·precomputed<>+0xefe8(SB)/8, $0x2481eec11f92525
-DATA ·precomputed<>+0xeff0(SB)/8, $0x19896e1b0d7e59d7
-DATA ·precomputed<>+0xeff8(SB)/8, $0xa06adb6cf2bb3129
-DATA ·precomputed<>+0xf000(SB)/8, $0xc35f6950fe94629d
-DATA ·precomputed<>+0xf008(SB)/8, $0x1cbaa9359f860b15
-DATA ·precomputed<>+0xf010(SB)/8, $0x29b4bcd3f24f8f15
-DATA ·precomputed<>+0xf018(SB)/8, $0xae5b06ad29c8458
-DATA ·precomputed<>+0xf020(SB)/8, $0xa645c31d1b6c2df1
-DATA ·precomputed<>+0xf028(SB)/8, $0x640b0990d804facc
-DATA ·precomputed<>+0xf030(SB)/8, $0x7a4a7f59122b33e6
-DATA ·precomputed<>+0xf038(SB)/8, $0x94bb0b2b7479b828
-DATA ·precomputed<>+0xf040(SB)/8, $0xf460aed34397ee7c
-DATA ·precomputed<>+0xf048(SB)/8, $0xf249e4cc36fbc7fe
-DATA ·precomputed<>+0xf050(SB)/8, $0xc42d9da8aab03dfe
-DATA ·precomputed<>+0xf058(SB)/8, $0xa3d73ce3435ab9f3
-DATA ·precomputed<>+0xf060(SB)/8, $0x86dddbc33813a3f3
-DATA ·precomputed<>+0xf068(SB)/8, $0x86d5779ab79c32a3
-DATA ·precomputed<>+0xf070(SB)/8, $0x7c3d9aff028a2c3f
-DATA ·precomputed<>+0xf078(SB)/8, $0xc687e71bb1add2bf
-DATA ·precomputed<>+0xf080(SB)/8, $0xa23b3d6ce2a7da5
-DATA ·precomputed<>+0xf088(SB)/8, $0x7efbfc9bc6d3650a
-DATA ·precomputed<>+0xf090(SB)/8, $0xdddc5f43e49d79cd
-DATA ·precomputed<>+0xf098(SB)/8, $0xc642b12041334529
-DATA ·precomputed<>+0xf0a0(SB)/8, $0xb73d52b60eb07da3
-DATA ·precomputed<>+0xf0a8(SB)/8, $0xe0c9a6e79623cceb
-DATA ·precomputed<>+0xf0b0(SB)/8, $0x65e4f274a8ccb9c3
-DATA ·precomputed<>+0xf0b8(SB)/8, $0xbc6dacb9d214d40e
-DATA ·precomputed<>+0xf0c0(SB)/8, $0x4612a56209b3fed3
-DATA ·precomputed<>+0xf0c8(SB)/8, $0xf296c17a3579966a
-DATA ·precomputed<>+0xf0d0(SB)/8, $0xa124a36f07960971
-DATA ·precomputed<>+0xf0d8(SB)/8, $0x6d03b214380c4a05
-DATA ·precomputed<>+0xf0e0(SB)/8, $0xcb0746e270f1f268
-DATA ·precomputed<>+0xf0e8(SB)/8, $0xcc9b47ff9341aea4
-DATA ·precomputed<>+0xf0f0(SB)/8, $0x1b3662d56d2f59cc
-DATA ·precomputed<>+0xf0f8(SB)/8, $0xa6c65b2dd4b1a15d
-DATA ·precomputed<>+0xf100(SB)/8, $0x9ce66d5faba617ca
-DATA ·precomputed<>+0xf108(SB)/8, $0xf5bb17dab788f1e7
-DATA ·precomputed<>+0xf110(SB)/8, $0x3ea09fdffbd2024
-DATA ·precomputed<>+0xf118(SB)/8, $0x553dd7092abd4aae
-DATA ·precomputed<>+0xf120(SB)/8, $0x2c1458bc19c4561
-DATA ·precomputed<>+0xf128(SB)/8, $0x4ecc53bd0b2676dd
-DATA ·precomputed<>+0xf130(SB)/8, $0x161461025274f7de
-DATA ·precomputed<>+0xf138(SB)/8, $0xcf5fa73809ebc51b
-DATA ·precomputed<>+0xf140(SB)/8, $0x92df0f5608a104d4
-DATA ·precomputed<>+0xf148(SB)/8, $0xfa16cf8eb317079a
-DATA ·precomputed<>+0xf150(SB)/8, $0x425bed53031eaa8
-DATA ·precomputed<>+0xf158(SB)/8, $0x7f7c45cb344f3c54
-DATA ·precomputed<>+0xf160(SB)/8, $0x51574e5f9db182a9
-DATA ·precomputed<>+0xf168(SB)/8, $0xcae923fe59f23a6e
-DATA ·precomputed<>+0xf170(SB)/8, $0x4b19b78d03b6efa6
-DATA ·precomputed<>+0xf178(SB)/8, $0xffa74d2fbf8c3517
-DATA ·precomputed<>+0xf180(SB)/8, $0xbafb0568ac0e00ce
-DATA ·precomputed<>+0xf188(SB)/8, $0xff4bc59801c05e34
-DATA ·precomputed<>+0xf190(SB)/8, $0xa94e0be1f6a524f0
-DATA ·precomputed<>+0xf198(SB)/8, $0x2995e87828303539
-DATA ·precomputed<>+0xf1a0(SB)/8, $0xa6cbf99cde02dc40
-DATA ·precomputed<>+0xf1a8(SB)/8, $0xbe180692de00fcad
-DATA ·precomputed<>+0xf1b0(SB)/8, $0x7a71994d9130a510
-DATA ·precomputed<>+0xf1b8(SB)/8, $0xa72b500709e07732
-DATA ·precomputed<>+0xf1c0(SB)/8, $0xf96c113acccb0a4b
-DATA ·precomputed<>+0xf1c8(SB)/8, $0x24c26bba3615f016
-DATA ·precomputed<>+0xf1d0(SB)/8, $0x52fe115aeead2f5b
-DATA ·precomputed<>+0xf1d8(SB)/8, $0x85623d260d7aaabb
-DATA ·precomputed<>+0xf1e0(SB)/8, $0x50791fd031a2564f
-DATA ·precomputed<>+0xf1e8(SB)/8, $0x3659974dcd0d59a4
-DATA ·precomputed<>+0xf1f0(SB)/8, $0x2cffdb747a8b7466
-DATA ·precomputed<>+0xf1f8(SB)/8, $0xcf6b36e5514787b0
-DATA ·precomputed<>+0xf200(SB)/8, $0xf547010211df43e5
-DATA ·precomputed<>+0xf208(SB)/8, $0x8409364062ab34b6
-DATA ·precomputed<>+0xf210(SB)/8, $0x5bbca2318c917f44
-DATA ·precomputed<>+0xf218(SB)/8, $0xe72de1f63bad0a3e
-DATA ·precomputed<>+0xf220(SB)/8, $0x7f9f020a03e1109c
-DATA ·precomputed<>+0xf228(SB)/8, $0x1763a1ebea389820
-DATA ·precomputed<>+0xf230(SB)/8, $0x28793a51ffc618b4
-DATA ·precomputed<>+0xf238(SB)/8, $0x753910dc9858e06f
-DATA ·precomputed<>+0xf240(SB)/8, $0xee3e34ef8fe0a7b9
-DATA ·precomputed<>+0xf248(SB)/8, $0x4a824a82a579b94
-DATA ·precomputed<>+0xf250(SB)/8, $0x9fbfe3c0a67a2a9
-DATA ·precomputed<>+0xf258(SB)/8, $0xe0ea14c9bb11fe8e
-DATA ·precomputed<>+0xf260(SB)/8, $0xdc9dc426b28c8ad
-DATA ·precomputed<>+0xf268(SB)/8, $0x945e5c6517efdebb
-DATA ·precomputed<>+0xf270(SB)/8, $0xc71ac5d3d0ee475a
-DATA ·precomputed<>+0xf278(SB)/8, $0xf1a9b180287af442
-DATA ·precomputed<>+0xf280(SB)/8, $0x4b1b0fe2c03f687e
-DATA ·precomputed<>+0xf288(SB)/8, $0xcd876515a08809e2
-DATA ·precomputed<>+0xf290(SB)/8, $0x1c9e24dbec77db73
-DATA ·precomputed<>+0xf298(SB)/8, $0x60cc28b77f6e8d3e
-DATA ·precomputed<>+0xf2a0(SB)/8, $0x85fc30fa59e4912a
-DATA ·precomputed<>+0xf2a8(SB)/8, $0x720e01188324abc5
-DATA ·precomputed<>+0xf2b0(SB)/8, $0x23dfd0bc7948537e
-DATA ·precomputed<>+0xf2b8(SB)/8, $0x1b7da98ca6b12acd
-DATA ·precomputed<>+0xf2c0(SB)/8, $0x8afccd364ab1ccd2
-DATA ·precomputed<>+0xf2c8(SB)/8, $0x673146351c03ab29
-DATA ·precomputed<>+0xf2d0(SB)/8, $0x458f36bfd7ff3a32
-DATA ·precomputed<>+0xf2d8(SB)/8, $0x70e9e789fcf71648
-DATA ·precomputed<>+0xf2e0(SB)/8, $0xf3764534a6e904cf
-DATA ·precomputed<>+0xf2e8(SB)/8, $0x2d6130b1f4bdd378
-DATA ·precomputed<>+0xf2f0(SB)/8, $0xc61c98fb1ca5ce34
-DATA ·precomputed<>+0xf2f8(SB)/8, $0xda11f502a4a684f5
-DATA ·precomputed<>+0xf300(SB)/8, $0x5db08a6f979e22b8
-DATA ·precomputed<>+0xf308(SB)/8, $0xc7258fb6073e0d78
-DATA ·precomputed<>+0xf310(SB)/8, $0x92c4165b63c5cc94
-DATA ·precomputed<>+0xf318(SB)/8, $0x822e0c784a612c2f
-DATA ·precomputed<>+0xf320(SB)/8, $0x54008a05131fdeec
-DATA ·precomputed<>+0xf328(SB)/8, $0x569cc746b15d6480
-DATA ·precomputed<>+0xf330(SB)/8, $0x215621de0b213871
-DATA ·precomputed<>+0xf338(SB)/8, $0xe6d2f669513f5bd9
-DATA ·precomputed<>+0xf340(SB)/8, $0x92d61591badf6b6e
-DATA ·precomputed<>+0xf348(SB)/8, $0xca647a3f5bdcedd4
-DATA ·precomputed<>+0xf350(SB)/8, $0x1d44f1d747de4395
-DATA ·precomputed<>+0xf358(SB)/8, $0xff4740840f733e8f
-DATA ·precomputed<>+0xf360(SB)/8, $0x4fa28a1265c87b34
-DATA ·precomputed<>+0xf368(SB)/8, $0xe2d042bf1c1adc8c
-DATA ·precomputed<>+0xf370(SB)/8, $0xe75ad92bc5c965f
-DATA ·precomputed<>+0xf378(SB)/8, $0x79c1467f7bda6470
-DATA ·precomputed<>+0xf380(SB)/8, $0x23a5da551ac55d35
-DATA ·precomputed<>+0xf388(SB)/8, $0xc3296c776a32fe3c
-DATA ·precomputed<>+0xf390(SB)/8, $0x411677aa9a08cae6
-DATA ·precomputed<>+0xf398(SB)/8, $0x2cc047c5c8f8e821
-DATA ·precomputed<>+0xf3a0(SB)/8, $0xeb36b1fb34b29765
-DATA ·precomputed<>+0xf3a8(SB)/8, $0x5762c902def02a34
-DATA ·precomputed<>+0xf3b0(SB)/8, $0xb4129ce736b49960
-DATA ·precomputed<>+0xf3b8(SB)/8, $0x524f43ba3fa40915
-DATA ·precomputed<>+0xf3c0(SB)/8, $0x8d9daa41b6223f04
-DATA ·precomputed<>+0xf3c8(SB)/8, $0x803c9c0e841c3fab
-DATA ·precomputed<>+0xf3d0(SB)/8, $0x60eee3f9c50b42cf
-DATA ·precomputed<>+0xf3d8(SB)/8, $0xaf4a7a5a77298193
-DATA ·precomputed<>+0xf3e0(SB)/8, $0xd379c2e1bf809ad6
-DATA ·precomputed<>+0xf3e8(SB)/8, $0x903ab4b1f67c0ff2
-DATA ·precomputed<>+0xf3f0(SB)/8, $0xc779d7ed90f8e637
-DATA ·precomputed<>+0xf3f8(SB)/8, $0x968b0cc02cf3d363
-DATA ·precomputed<>+0xf400(SB)/8, $0xaa39a2d904db13b5
-DATA ·precomputed<>+0xf408(SB)/8, $0xb698275aeb8541eb
-DATA ·precomputed<>+0xf410(SB)/8, $0x2645de5f4d731f1c
-DATA ·precomputed<>+0xf418(SB)/8, $0xe9c3844eb71ea4aa
-DATA ·precomputed<>+0xf420(SB)/8, $0xd1570896d7cedf64
-DATA ·precomputed<>+0xf428(SB)/8, $0x8c4b105830cd6231
-DATA ·precomputed<>+0xf430(SB)/8, $0x6a999095349356aa
-DATA ·precomputed<>+0xf438(SB)/8, $0x1c9523f84bc52441
-DATA ·precomputed<>+0xf440(SB)/8, $0x6c0e039d25d8ed9e
-DATA ·precomputed<>+0xf448(SB)/8, $0x9f1127e705df060f
-DATA ·precomputed<>+0xf450(SB)/8, $0xda1fd5f8ae76dd07
-DATA ·precomputed<>+0xf458(SB)/8, $0xa3ee2de4df4dbf16
-DATA ·precomputed<>+0xf460(SB)/8, $0x22c7576b51b13d32
-DATA ·precomputed<>+0xf468(SB)/8, $0x44f9cad6a2285889
-DATA ·precomputed<>+0xf470(SB)/8, $0x4e3e8f68e364085c
-DATA ·precomputed<>+0xf478(SB)/8, $0x519c6e59185d5934
-DATA ·precomputed<>+0xf480(SB)/8, $0xcdc4d1094ce66217
-DATA ·precomputed<>+0xf488(SB)/8, $0xaf545de787232729
-DATA ·precomputed<>+0xf490(SB)/8, $0x212a8598dee47fa9
-DATA ·precomputed<>+0xf498(SB)/8, $0xe0feabb828a1605d
-DATA ·precomputed<>+0xf4a0(SB)/8, $0x3f111b28c7577b1e
-DATA ·precomputed<>+0xf4a8(SB)/8, $0xccd227fafb4f5a83
-DATA ·precomputed<>+0xf4b0(SB)/8, $0xfc7416ac8248157e
-DATA ·precomputed<>+0xf4b8(SB)/8, $0x5c251ab1c931b148
-DATA ·precomputed<>+0xf4c0(SB)/8, $0xaadfa857acf51940
-DATA ·precomputed<>+0xf4c8(SB)/8, $0xc789d1e50156581
-DATA ·precomputed<>+0xf4d0(SB)/8, $0x5e79cef762cff8f4
-DATA ·precomputed<>+0xf4d8(SB)/8, $0x54cdaba965eb0d49
-DATA ·precomputed<>+0xf4e0(SB)/8, $0xdf7a58283321c57e
-DATA ·precomputed<>+0xf4e8(SB)/8, $0x8571e6e2a21a51a6
-DATA ·precomputed<>+0xf4f0(SB)/8, $0xb9b482bc3726e69
-DATA ·precomputed<>+0xf4f8(SB)/8, $0x3bc201e31d92b657
-DATA ·precomputed<>+0xf500(SB)/8, $0xe023b2b50f44c411
-DATA ·precomputed<>+0xf508(SB)/8, $0x6515cbfe7329b336
-DATA ·precomputed<>+0xf510(SB)/8, $0xd17afc303873c7bd
-DATA ·precomputed<>+0xf518(SB)/8, $0x85a0c2e0ba618ac9
-DATA ·precomputed<>+0xf520(SB)/8, $0xe145e449859c574b
-DATA ·precomputed<>+0xf528(SB)/8, $0x2521755162db7281
-DATA ·precomputed<>+0xf530(SB)/8, $0xbbc96397983577c4
-DATA ·precomputed<>+0xf538(SB)/8, $0xd051176245ff1d2c
-DATA ·precomputed<>+0xf540(SB)/8, $0x84fe8adcecffeb7d
-DATA ·precomputed<>+0xf548(SB)/8, $0x1dfb28cd5c58e2b9
-DATA ·precomputed<>+0xf550(SB)/8, $0xdafc5cd08eaac7b6
-DATA ·precomputed<>+0xf558(SB)/8, $0x23294577520ac37a
-DATA ·precomputed<>+0xf560(SB)/8, $0x91dc2e41e80c3c71
-DATA ·precomputed<>+0xf568(SB)/8, $0x5a3e3f1c940ca889
-DATA ·precomputed<>+0xf570(SB)/8, $0x6e9da8fde47c20bb
-DATA ·precomputed<>+0xf578(SB)/8, $0xb0b04278f3e84249
-DATA ·precomputed<>+0xf580(SB)/8, $0x30cef95492a9815d
-DATA ·precomputed<>+0xf588(SB)/8, $0xbbd4474d0444e187
-DATA ·precomputed<>+0xf590(SB)/8, $0xf325ab28d7c9b1a3
-DATA ·precomputed<>+0xf598(SB)/8, $0x2fb02fc4e8eb5c8e
-DATA ·precomputed<>+0xf5a0(SB)/8, $0x17a7dfbe58a9e27
-DATA ·precomputed<>+0xf5a8(SB)/8, $0xb9cb3ae5643e7047
-DATA ·precomputed<>+0xf5b0(SB)/8, $0xbb8567319f975461
-DATA ·precomputed<>+0xf5b8(SB)/8, $0xe53c3c303c9a286b
-DATA ·precomputed<>+0xf5c0(SB)/8, $0x271c58bb8a3b4cf8
-DATA ·precomputed<>+0xf5c8(SB)/8, $0x269fc422717eb539
-DATA ·precomputed<>+0xf5d0(SB)/8, $0xe82644e95b381fe1
-DATA ·precomputed<>+0xf5d8(SB)/8, $0x27fb0822cb62982f
-DATA ·precomputed<>+0xf5e0(SB)/8, $0xb0acd51c5b5ec726
-DATA ·precomputed<>+0xf5e8(SB)/8, $0xfd01053dea4eff73
-DATA ·precomputed<>+0xf5f0(SB)/8, $0x465311dd00b11c80
-DATA ·precomputed<>+0xf5f8(SB)/8, $0xe50a86152ed8460c
-DATA ·precomputed<>+0xf600(SB)/8, $0x2c91df1e43e23746
-DATA ·precomputed<>+0xf608(SB)/8, $0x4856b273348a8ea
-DATA ·precomputed<>+0xf610(SB)/8, $0x6c10781958ad820e
-DATA ·precomputed<>+0xf618(SB)/8, $0x485f57373b73feff
-DATA ·precomputed<>+0xf620(SB)/8, $0x6870ac5e1f93e2c7
-DATA ·precomputed<>+0xf628(SB)/8, $0x8debe4477d109d43
-DATA ·precomputed<>+0xf630(SB)/8, $0x494f479c6ceac80f
-DATA ·precomputed<>+0xf638(SB)/8, $0x7f8b02583913d7a1
-DATA ·precomputed<>+0xf640(SB)/8, $0x3d886716b3b782ed
-DATA ·precomputed<>+0xf648(SB)/8, $0x6ad8c9364637eadf
-DATA ·precomputed<>+0xf650(SB)/8, $0x7c80d2ed53389866
-DATA ·precomputed<>+0xf658(SB)/8, $0xb80687d18b632e6c
-DATA ·precomputed<>+0xf660(SB)/8, $0xa9e1b7c9f49f6eb5
-DATA ·precomputed<>+0xf668(SB)/8, $0x31d61f5a5999c3bd
-DATA ·precomputed<>+0xf670(SB)/8, $0xa3f7455e040ead49
-DATA ·precomputed<>+0xf678(SB)/8, $0x97d3873d05e4da36
-DATA ·precomputed<>+0xf680(SB)/8, $0x1b13fbc2aec03da7
-DATA ·precomputed<>+0xf688(SB)/8, $0x35a909a4a7f577bf
-DATA ·precomputed<>+0xf690(SB)/8, $0xdf717c8750f5db61
-DATA ·precomputed<>+0xf698(SB)/8, $0x6be1dab4c16e9eb
-DATA ·precomputed<>+0xf6a0(SB)/8, $0x21280299c94c3cba
-DATA ·precomputed<>+0xf6a8(SB)/8, $0x79c0d7b0b501148
-DATA ·precomputed<>+0xf6b0(SB)/8, $0x80c9526a8de19c5e
-DATA ·precomputed<>+0xf6b8(SB)/8, $0xff138f78c51005f8
-DATA ·precomputed<>+0xf6c0(SB)/8, $0x3eade5eb7b2243a0
-DATA ·precomputed<>+0xf6c8(SB)/8, $0xa59ec93377424d11
-DATA ·precomputed<>+0xf6d0(SB)/8, $0x65a8e1aaf5c7c3b7
-DATA ·precomputed<>+0xf6d8(SB)/8, $0x8399fa0c1db536
-DATA ·precomputed<>+0xf6e0(SB)/8, $0x80b20e97fb194a74
-DATA ·precomputed<>+0xf6e8(SB)/8, $0x2316fb9b43be90dc
-DATA ·precomputed<>+0xf6f0(SB)/8, $0xb2773b230da4d913
-DATA ·precomputed<>+0xf6f8(SB)/8, $0x945d0671ce973d27
-DATA ·precomputed<>+0xf700(SB)/8, $0xaf3c65a6efc7d2a4
-DATA ·precomputed<>+0xf708(SB)/8, $0xcbb642931082a34b
-DATA ·precomputed<>+0xf710(SB)/8, $0xb404e7c44e353270
-DATA ·precomputed<>+0xf718(SB)/8, $0x73d60e553780ce4d
-DATA ·precomputed<>+0xf720(SB)/8, $0x9dce11c2d75a0f9d
-DATA ·precomputed<>+0xf728(SB)/8, $0x78cb63fc7d8da518
-DATA ·precomputed<>+0xf730(SB)/8, $0xbe376ae87da04d57
-DATA ·precomputed<>+0xf738(SB)/8, $0x865fd0ed9d7508ae
-DATA ·precomputed<>+0xf740(SB)/8, $0x6abbc1ccc029f63d
-DATA ·precomputed<>+0xf748(SB)/8, $0x1d50cbbf78e0dd5a
-DATA ·precomputed<>+0xf750(SB)/8, $0xd7029e302f34bce1
-DATA ·precomputed<>+0xf758(SB)/8, $0x486632e9fab77294
-DATA ·precomputed<>+0xf760(SB)/8, $0xa545f583f6bc0595
-DATA ·precomputed<>+0xf768(SB)/8, $0x508214f12d53cab
-DATA ·precomputed<>+0xf770(SB)/8, $0x4a76a4c6c5379cfd
-DATA ·precomputed<>+0xf778(SB)/8, $0x6d7d30165e712724
-DATA ·precomputed<>+0xf780(SB)/8, $0x573db0bd069745db
-DATA ·precomputed<>+0xf788(SB)/8, $0xc0fbaf05aa3836cb
-DATA ·precomputed<>+0xf790(SB)/8, $0xe0c3e05c321174ae
-DATA ·precomputed<>+0xf798(SB)/8, $0xc77a34135dba11ce
-DATA ·precomputed<>+0xf7a0(SB)/8, $0xc12964252229d8c8
-DATA ·precomputed<>+0xf7a8(SB)/8, $0x665df604b1dcbdf8
-DATA ·precomputed<>+0xf7b0(SB)/8, $0x7e1cf63b5d5401e0
-DATA ·precomputed<>+0xf7b8(SB)/8, $0x27b454e2240ff4c1
-DATA ·precomputed<>+0xf7c0(SB)/8, $0x64ca871cb79f82af
-DATA ·precomputed<>+0xf7c8(SB)/8, $0x31304b022dab52f6
-DATA ·precomputed<>+0xf7d0(SB)/8, $0x1825ab54928239a7
-DATA ·precomputed<>+0xf7d8(SB)/8, $0x740413b28e4ad736
-DATA ·precomputed<>+0xf7e0(SB)/8, $0xc5c5d3fa44071d19
-DATA ·precomputed<>+0xf7e8(SB)/8, $0x83e438f13f0b2da8
-DATA ·precomputed<>+0xf7f0(SB)/8, $0xfd759448c70a1981
-DATA ·precomputed<>+0xf7f8(SB)/8, $0x13e0c7ee565ebae3
-DATA ·precomputed<>+0xf800(SB)/8, $0x34dd314ef7750f0
-DATA ·precomputed<>+0xf808(SB)/8, $0x2ceaa70522da84d7
-DATA ·precomputed<>+0xf810(SB)/8, $0x4561a254fc0d647b
-DATA ·precomputed<>+0xf818(SB)/8, $0x81cf0915bbe01157
-DATA ·precomputed<>+0xf820(SB)/8, $0x547a3d4e34b798eb
-DATA ·precomputed<>+0xf828(SB)/8, $0xbb5dd6258b1c7544
-DATA ·precomputed<>+0xf830(SB)/8, $0x94fee01bc8194168
-DATA ·precomputed<>+0xf838(SB)/8, $0xdb4c25fffaeb010b
-DATA ·precomputed<>+0xf840(SB)/8, $0x1fee0f771e33b2a3
-DATA ·precomputed<>+0xf848(SB)/8, $0xd4bed81f9f789870
-DATA ·precomputed<>+0xf850(SB)/8, $0x6396feea6ef05b7e
-DATA ·precomputed<>+0xf858(SB)/8, $0x9c5d6a012640b62a
-DATA ·precomputed<>+0xf860(SB)/8, $0x170cfec96834bea4
-DATA ·precomputed<>+0xf868(SB)/8, $0x68d16728e131feca
-DATA ·precomputed<>+0xf870(SB)/8, $0x4be9c5d600affb4d
-DATA ·precomputed<>+0xf878(SB)/8, $0xe34a423c99a6f256
-DATA ·precomputed<>+0xf880(SB)/8, $0x4929e89fe0aff809
-DATA ·precomputed<>+0xf888(SB)/8, $0x19755ec21769a00a
-DATA ·precomputed<>+0xf890(SB)/8, $0x3b6a207bc242f335
-DATA ·precomputed<>+0xf898(SB)/8, $0xeca054ef090edab0
-DATA ·precomputed<>+0xf8a0(SB)/8, $0x217e9c8bcd9e1c26
-DATA ·precomputed<>+0xf8a8(SB)/8, $0x917c2ecd35d4ac57
-DATA ·precomputed<>+0xf8b0(SB)/8, $0xdc869d5dad33911d
-DATA ·precomputed<>+0xf8b8(SB)/8, $0x22d9d8602e828bd7
-DATA ·precomputed<>+0xf8c0(SB)/8, $0x5dfc2874d8247f13
-DATA ·precomputed<>+0xf8c8(SB)/8, $0xc211a7a1e3c11f56
-DATA ·precomputed<>+0xf8d0(SB)/8, $0x7512563fa2503b97
-DATA ·precomputed<>+0xf8d8(SB)/8, $0x124cd9845c007c82
-DATA ·precomputed<>+0xf8e0(SB)/8, $0x4f6eb682491cd249
-DATA ·precomputed<>+0xf8e8(SB)/8, $0xaf4f70a3a683359d
-DATA ·precomputed<>+0xf8f0(SB)/8, $0x2f1dfe71cc302b62
-DATA ·precomputed<>+0xf8f8(SB)/8, $0x83c474bbe57fbf56
-DATA ·precomputed<>+0xf900(SB)/8, $0xd5fcfb9f9c501ab7
-DATA ·precomputed<>+0xf908(SB)/8, $0xc8a0adee21b09b61
-DATA ·precomputed<>+0xf910(SB)/8, $0xfadc14a10fc2099e
-DATA ·precomputed<>+0xf918(SB)/8, $0xd77b9e00a0bb08b8
-DATA ·precomputed<>+0xf920(SB)/8, $0xb3822ff8fa83031e
-DATA ·precomputed<>+0xf928(SB)/8, $0x5ce27aa99cb73c1e
-DATA ·precomputed<>+0xf930(SB)/8, $0xac1b14cb8b467f6d
-DATA ·precomputed<>+0xf938(SB)/8, $0xbf205949f926c940
-DATA ·precomputed<>+0xf940(SB)/8, $0xdb68abb770b4b128
-DATA ·precomputed<>+0xf948(SB)/8, $0x3d41473bc77e34e4
-DATA ·precomputed<>+0xf950(SB)/8, $0xb5a244e62ce86953
-DATA ·precomputed<>+0xf958(SB)/8, $0x8b05ea05b4c2620c
-DATA ·precomputed<>+0xf960(SB)/8, $0x880b4c89ab8f5de0
-DATA ·precomputed<>+0xf968(SB)/8, $0xe76da1bac1bc6de4
-DATA ·precomputed<>+0xf970(SB)/8, $0x442a15e547cf32a3
-DATA ·precomputed<>+0xf978(SB)/8, $0xe6dbe4600b1cdbef
-DATA ·precomputed<>+0xf980(SB)/8, $0xc3af09714a3dbbb7
-DATA ·precomputed<>+0xf988(SB)/8, $0xf283557b1b03a8e2
-DATA ·precomputed<>+0xf990(SB)/8, $0x24cf2d30e230bdbf
-DATA ·precomputed<>+0xf998(SB)/8, $0xb8bdfd76ae06c865
-DATA ·precomputed<>+0xf9a0(SB)/8, $0x3bfd169aab4e479c
-DATA ·precomputed<>+0xf9a8(SB)/8, $0xf5d7ff46c01bc388
-DATA ·precomputed<>+0xf9b0(SB)/8, $0xf3c9bcbbb1b2dd5a
-DATA ·precomputed<>+0xf9b8(SB)/8, $0x77cd5f187b4a8694
-DATA ·precomputed<>+0xf9c0(SB)/8, $0x43af7ab7916a8016
-DATA ·precomputed<>+0xf9c8(SB)/8, $0xf93d487f532bfb9c
-DATA ·precomputed<>+0xf9d0(SB)/8, $0xa5f9af3ce2174971
-DATA ·precomputed<>+0xf9d8(SB)/8, $0xd1b9cf1f2d59b4d4
-DATA ·precomputed<>+0xf9e0(SB)/8, $0x4a77941844f4eb91
-DATA ·precomputed<>+0xf9e8(SB)/8, $0x6a131facc226edc5
-DATA ·precomputed<>+0xf9f0(SB)/8, $0x472ab89780d4bb33
-DATA ·precomputed<>+0xf9f8(SB)/8, $0xb69687a52f6ca1fe
-DATA ·precomputed<>+0xfa00(SB)/8, $0xe453e9124c8daaee
-DATA ·precomputed<>+0xfa08(SB)/8, $0xc239eb9a6bda6320
-DATA ·precomputed<>+0xfa10(SB)/8, $0xc774dc32d3ccf0a0
-DATA ·precomputed<>+0xfa18(SB)/8, $0xfa675d22d636ea3f
-DATA ·precomputed<>+0xfa20(SB)/8, $0x3a8aef207cc2e927
-DATA ·precomputed<>+0xfa28(SB)/8, $0x57446a2f77784048
-DATA ·precomputed<>+0xfa30(SB)/8, $0x926ca95ad0f4dcae
-DATA ·precomputed<>+0xfa38(SB)/8, $0x655db6367d6435fc
-DATA ·precomputed<>+0xfa40(SB)/8, $0x7b8fb7033c44e46f
-DATA ·precomputed<>+0xfa48(SB)/8, $0x874a98f4cd4340e7
-DATA ·precomputed<>+0xfa50(SB)/8, $0xd2feb0bffd907d39
-DATA ·precomputed<>+0xfa58(SB)/8, $0x38a0dae60f117b50
-DATA ·precomputed<>+0xfa60(SB)/8, $0x2694b4e67095787b
-DATA ·precomputed<>+0xfa68(SB)/8, $0x138dee81d56e3463
-DATA ·precomputed<>+0xfa70(SB)/8, $0xef9f93da5972b703
-DATA ·precomputed<>+0xfa78(SB)/8, $0x3f87cc38dd0d073e
-DATA ·precomputed<>+0xfa80(SB)/8, $0x465f48270130cde
-DATA ·precomputed<>+0xfa88(SB)/8, $0xc693dbb24e3ae3d7
-DATA ·precomputed<>+0xfa90(SB)/8, $0xd83d7d5dfe379759
-DATA ·precomputed<>+0xfa98(SB)/8, $0x8356cd6584807933
-DATA ·precomputed<>+0xfaa0(SB)/8, $0xfcb14339ffd38230
-DATA ·precomputed<>+0xfaa8(SB)/8, $0x9463e0b75b9f3c5f
-DATA ·precomputed<>+0xfab0(SB)/8, $0x61769db9c6f62eb9
-DATA ·precomputed<>+0xfab8(SB)/8, $0xf3c075a15a7e54f4
-DATA ·precomputed<>+0xfac0(SB)/8, $0x6c99cc72520cd267
-DATA ·precomputed<>+0xfac8(SB)/8, $0x9b83c2d313d37cec
-DATA ·precomputed<>+0xfad0(SB)/8, $0xc2b56ed68cc45cd3
-DATA ·precomputed<>+0xfad8(SB)/8, $0xf61a49adad3ff1ef
-DATA ·precomputed<>+0xfae0(SB)/8, $0xe5896205a05677aa
-DATA ·precomputed<>+0xfae8(SB)/8, $0xc87845d8911b22bc
-DATA ·precomputed<>+0xfaf0(SB)/8, $0x99ef1336bfbeaef3
-DATA ·precomputed<>+0xfaf8(SB)/8, $0xa54290699b1a9921
-DATA ·precomputed<>+0xfb00(SB)/8, $0x67a400f3ae06c9dc
-DATA ·precomputed<>+0xfb08(SB)/8, $0x4a754061ca943855
-DATA ·precomputed<>+0xfb10(SB)/8, $0xbb4494f40389a2f6
-DATA ·precomputed<>+0xfb18(SB)/8, $0xd343bb7c73af1e8
-DATA ·precomputed<>+0xfb20(SB)/8, $0x4216009d94e78d2
-DATA ·precomputed<>+0xfb28(SB)/8, $0xf7757f264029db8
-DATA ·precomputed<>+0xfb30(SB)/8, $0x5258da65f5c3f88
-DATA ·precomputed<>+0xfb38(SB)/8, $0x165e5fc97b575e72
-DATA ·precomputed<>+0xfb40(SB)/8, $0xd8e5ad50a8e5131e
-DATA ·precomputed<>+0xfb48(SB)/8, $0xa36b11c932a0cd6c
-DATA ·precomputed<>+0xfb50(SB)/8, $0xcc8bfcbc88a660a3
-DATA ·precomputed<>+0xfb58(SB)/8, $0xf79a1374441eae69
-DATA ·precomputed<>+0xfb60(SB)/8, $0x8ea90e50b76a7a1d
-DATA ·precomputed<>+0xfb68(SB)/8, $0xcf875a13dd62aeed
-DATA ·precomputed<>+0xfb70(SB)/8, $0x3e5387be5506b19d
-DATA ·precomputed<>+0xfb78(SB)/8, $0x4394103a9d1745ab
-DATA ·precomputed<>+0xfb80(SB)/8, $0x585821fbba89bac6
-DATA ·precomputed<>+0xfb88(SB)/8, $0x3020b42633f057f0
-DATA ·precomputed<>+0xfb90(SB)/8, $0xd264dd4632aff660
-DATA ·precomputed<>+0xfb98(SB)/8, $0x4a9a06443df612ce
-DATA ·precomputed<>+0xfba0(SB)/8, $0x9d4b1775a89c4151
-DATA ·precomputed<>+0xfba8(SB)/8, $0x872642bf5550c1ad
-DATA ·precomputed<>+0xfbb0(SB)/8, $0x47e9c253be7dc440
-DATA ·precomputed<>+0xfbb8(SB)/8, $0x57dc95605409bfc1
-DATA ·precomputed<>+0xfbc0(SB)/8, $0xffa73ca2fabd066a
-DATA ·precomputed<>+0xfbc8(SB)/8, $0x494e03a8f9c78bfd
-DATA ·precomputed<>+0xfbd0(SB)/8, $0xe585a878ff55cfef
-DATA ·precomputed<>+0xfbd8(SB)/8, $0x770b1fd7053784
-DATA ·precomputed<>+0xfbe0(SB)/8, $0xdec4da4a056fe70b
-DATA ·precomputed<>+0xfbe8(SB)/8, $0xe37395d857bd444f
-DATA ·precomputed<>+0xfbf0(SB)/8, $0x666250d4685df668
-DATA ·precomputed<>+0xfbf8(SB)/8, $0x549569ebe6cc583
-DATA ·precomputed<>+0xfc00(SB)/8, $0x98467a92fc1b58df
-DATA ·precomputed<>+0xfc08(SB)/8, $0xbf76548f0cd579f1
-DATA ·precomputed<>+0xfc10(SB)/8, $0xdfe869b0d7b7ab99
-DATA ·precomputed<>+0xfc18(SB)/8, $0xb7b0908e32024cf7
-DATA ·precomputed<>+0xfc20(SB)/8, $0x8de1b2cb3b353ca6
-DATA ·precomputed<>+0xfc28(SB)/8, $0x135c62b7cd7e2129
-DATA ·precomputed<>+0xfc30(SB)/8, $0x3cb061d118ac24fb
-DATA ·precomputed<>+0xfc38(SB)/8, $0x180fd559bc9123f1
-DATA ·precomputed<>+0xfc40(SB)/8, $0x95716f598cc0805e
-DATA ·precomputed<>+0xfc48(SB)/8, $0xc76e029a198af8c3
-DATA ·precomputed<>+0xfc50(SB)/8, $0xe1b38b2a43a639ee
-DATA ·precomputed<>+0xfc58(SB)/8, $0x5004142e5ba2f593
-DATA ·precomputed<>+0xfc60(SB)/8, $0xc1539b81cbee6f26
-DATA ·precomputed<>+0xfc68(SB)/8, $0x13677ed6527c4fa3
-DATA ·precomputed<>+0xfc70(SB)/8, $0x6a0faad41baaf5ca
-DATA ·precomputed<>+0xfc78(SB)/8, $0xc0d90c1a02fcf4a7
-DATA ·precomputed<>+0xfc80(SB)/8, $0x5efea8179070cc6d
-DATA ·precomputed<>+0xfc88(SB)/8, $0xa8abc931876a8c47
-DATA ·precomputed<>+0xfc90(SB)/8, $0x64b62ecf144d27cc
-DATA ·precomputed<>+0xfc98(SB)/8, $0x2b84b555538f3aaa
-DATA ·precomputed<>+0xfca0(SB)/8, $0x3f2bb2ba6b919411
-DATA ·precomputed<>+0xfca8(SB)/8, $0xe928f18f0f71f63f
-DATA ·precomputed<>+0xfcb0(SB)/8, $0xcfc6d125e77a302e
-DATA ·precomputed<>+0xfcb8(SB)/8, $0x80622bfb238a3fe6
-DATA ·precomputed<>+0xfcc0(SB)/8, $0x224aaac0ba9b5ca4
-DATA ·precomputed<>+0xfcc8(SB)/8, $0xf556feab7512a152
-DATA ·precomputed<>+0xfcd0(SB)/8, $0x6cff93f3590680f2
-DATA ·precomputed<>+0xfcd8(SB)/8, $0xd33c25c55921241a
-DATA ·precomputed<>+0xfce0(SB)/8, $0x853f7a1300ee2808
-DATA ·precomputed<>+0xfce8(SB)/8, $0xd83e2c60b96afc73
-DATA ·precomputed<>+0xfcf0(SB)/8, $0xa0abf470eaf030ea
-DATA ·precomputed<>+0xfcf8(SB)/8, $0xd6c67508ba456f23
-DATA ·precomputed<>+0xfd00(SB)/8, $0x596adc3af69002a7
-DATA ·precomputed<>+0xfd08(SB)/8, $0x43dfba54ec8d80f
-DATA ·precomputed<>+0xfd10(SB)/8, $0x9d7a7d1c0a69da11
-DATA ·precomputed<>+0xfd18(SB)/8, $0x5c42d491ac1a92d3
-DATA ·precomputed<>+0xfd20(SB)/8, $0x49191670572ea4dc
-DATA ·precomputed<>+0xfd28(SB)/8, $0xd396e50dba994c7c
-DATA ·precomputed<>+0xfd30(SB)/8, $0x8e20083e5438656d
-DATA ·precomputed<>+0xfd38(SB)/8, $0xad538e0911d84f90
-DATA ·precomputed<>+0xfd40(SB)/8, $0x5ea7f023f4ca8162
-DATA ·precomputed<>+0xfd48(SB)/8, $0xc4030a6d3140fe3
-DATA ·precomputed<>+0xfd50(SB)/8, $0x868f20d23fefb98d
-DATA ·precomputed<>+0xfd58(SB)/8, $0xb6dd5866db66fd4f
-DATA ·precomputed<>+0xfd60(SB)/8, $0x3946d7908954d283
-DATA ·precomputed<>+0xfd68(SB)/8, $0x2a9f357b90758c02
-DATA ·precomputed<>+0xfd70(SB)/8, $0x97fb77412f5c4138
-DATA ·precomputed<>+0xfd78(SB)/8, $0xc8dc2f45a14ad806
-DATA ·precomputed<>+0xfd80(SB)/8, $0x2c0f1b48c04d910c
-DATA ·precomputed<>+0xfd88(SB)/8, $0x5ba12413af2a1384
-DATA ·precomputed<>+0xfd90(SB)/8, $0x489550c9f67b218b
-DATA ·precomputed<>+0xfd98(SB)/8, $0x5c1e08471ac90243
-DATA ·precomputed<>+0xfda0(SB)/8, $0x9c739e78adec3b89
-DATA ·precomputed<>+0xfda8(SB)/8, $0x7f64d01a1176b105
-DATA ·precomputed<>+0xfdb0(SB)/8, $0x5360c6562fd2d8dc
-DATA ·precomputed<>+0xfdb8(SB)/8, $0xa1b02812169c1b01
-DATA ·precomputed<>+0xfdc0(SB)/8, $0x87629830ab11639e
-DATA ·precomputed<>+0xfdc8(SB)/8, $0x869dd3baa4488d53
-DATA ·precomputed<>+0xfdd0(SB)/8, $0x10fe1c0bbaf06eb6
-DATA ·precomputed<>+0xfdd8(SB)/8, $0x990348391687ac37
-DATA ·precomputed<>+0xfde0(SB)/8, $0x384183777f1ffe7b
-DATA ·precomputed<>+0xfde8(SB)/8, $0x3334a74c25bd7c57
-DATA ·precomputed<>+0xfdf0(SB)/8, $0xc57cb7ed7008ba67
-DATA ·precomputed<>+0xfdf8(SB)/8, $0x384c12d0c1e4e12d
-DATA ·precomputed<>+0xfe00(SB)/8, $0x7db59878959982d2
-DATA ·precomputed<>+0xfe08(SB)/8, $0x397bea2611ed907c
-DATA ·precomputed<>+0xfe10(SB)/8, $0xdb29a29a63fd66c7
-DATA ·precomputed<>+0xfe18(SB)/8, $0x6e74d79f9c98d2fb
-DATA ·precomputed<>+0xfe20(SB)/8, $0xecf505b1b6691fc4
-DATA ·precomputed<>+0xfe28(SB)/8, $0xb4033b71e624aa1
-DATA ·precomputed<>+0xfe30(SB)/8, $0x78e0c322302e6b0c
-DATA ·precomputed<>+0xfe38(SB)/8, $0x642d68b5879f24c7
-DATA ·precomputed<>+0xfe40(SB)/8, $0x7ab7462a30a1a50a
-DATA ·precomputed<>+0xfe48(SB)/8, $0xa06f70eae103e750
-DATA ·precomputed<>+0xfe50(SB)/8, $0x64c6878bce52b559
-DATA ·precomputed<>+0xfe58(SB)/8, $0xc76da2df1c33e160
-DATA ·precomputed<>+0xfe60(SB)/8, $0xc33c92f37708737b
-DATA ·precomputed<>+0xfe68(SB)/8, $0x19ae8bb69e0e869b
-DATA ·precomputed<>+0xfe70(SB)/8, $0xae20559d1aa723ef
-DATA ·precomputed<>+0xfe78(SB)/8, $0xc7c4b23283ad0e62
-DATA ·precomputed<>+0xfe80(SB)/8, $0x172ef6b1c7cf298f
-DATA ·precomputed<>+0xfe88(SB)/8, $0xd021bdd6e203370a
-DATA ·precomputed<>+0xfe90(SB)/8, $0x939ac65afb96949
-DATA ·precomputed<>+0xfe98(SB)/8, $0xa89a1e9684d84c2d
-DATA ·precomputed<>+0xfea0(SB)/8, $0x96d255542d75f407
-DATA ·precomputed<>+0xfea8(SB)/8, $0x6d316a615770561
-DATA ·precomputed<>+0xfeb0(SB)/8, $0xa829b83089c1ba05
-DATA ·precomputed<>+0xfeb8(SB)/8, $0xaf02875e7badce5f
-DATA ·precomputed<>+0xfec0(SB)/8, $0x266e8690fb57459b
-DATA ·precomputed<>+0xfec8(SB)/8, $0x14bee7662b878007
-DATA ·precomputed<>+0xfed0(SB)/8, $0x9b5267dd2c7ea63d
-DATA ·precomputed<>+0xfed8(SB)/8, $0x5e8f2a4c03e012bc
-DATA ·precomputed<>+0xfee0(SB)/8, $0x1c3fc64aca5e0727
-DATA ·precomputed<>+0xfee8(SB)/8, $0x3dd2f104e875bdcd
-DATA ·precomputed<>+0xfef0(SB)/8, $0x2a09337b76619438
-DATA ·precomputed<>+0xfef8(SB)/8, $0x55415071ae1e9d01
-DATA ·precomputed<>+0xff00(SB)/8, $0x89e7d24b22c8bbcd
-DATA ·precomputed<>+0xff08(SB)/8, $0x5135e06dc787055d
-DATA ·precomputed<>+0xff10(SB)/8, $0xe2b086fd1a699ea2
-DATA ·precomputed<>+0xff18(SB)/8, $0xbb457d7b6d1b7202
-DATA ·precomputed<>+0xff20(SB)/8, $0x1858a9b100bcdf08
-DATA ·precomputed<>+0xff28(SB)/8, $0x918579940f67141d
-DATA ·precomputed<>+0xff30(SB)/8, $0x11f168f6856b2c2
-DATA ·precomputed<>+0xff38(SB)/8, $0xafae1f5dc4b461c2
-DATA ·precomputed<>+0xff40(SB)/8, $0xd43ed6eda46ea8c7
-DATA ·precomputed<>+0xff48(SB)/8, $0x9588a102590ab15f
-DATA ·precomputed<>+0xff50(SB)/8, $0xa636ac230b195b24
-DATA ·precomputed<>+0xff58(SB)/8, $0x5cb321d692c0a553
-DATA ·precomputed<>+0xff60(SB)/8, $0x207f6ba439825b3d
-DATA ·precomputed<>+0xff68(SB)/8, $0x42f52fc5bd5ed693
-DATA ·precomputed<>+0xff70(SB)/8, $0x10ee03e965853d4b
-DATA ·precomputed<>+0xff78(SB)/8, $0xac36b488ee0b2d63
-DATA ·precomputed<>+0xff80(SB)/8, $0xbfd1017186e83dc0
-DATA ·precomputed<>+0xff88(SB)/8, $0xa44b9df3e5b6adda
-DATA ·precomputed<>+0xff90(SB)/8, $0x847f94426485d4bf
-DATA ·precomputed<>+0xff98(SB)/8, $0x76daa1b938164791
-DATA ·precomputed<>+0xffa0(SB)/8, $0x6ae4b7de5b235bcc
-DATA ·precomputed<>+0xffa8(SB)/8, $0x535177607c80af58
-DATA ·precomputed<>+0xffb0(SB)/8, $0xd0a1fcbda8f32ed
-DATA ·precomputed<>+0xffb8(SB)/8, $0x32ac2d7077be6d5f
-DATA ·precomputed<>+0xffc0(SB)/8, $0xf48cdca6db4bdb35
-DATA ·precomputed<>+0xffc8(SB)/8, $0x6bc23aec74d913a7
-DATA ·precomputed<>+0xffd0(SB)/8, $0x8f0ccd9d12ed94d5
-DATA ·precomputed<>+0xffd8(SB)/8, $0xe4aabd1286db09e7
-DATA ·precomputed<>+0xffe0(SB)/8, $0xcbff31a1e948326
-DATA ·precomputed<>+0xffe8(SB)/8, $0xcf68c47c17a479a2
-DATA ·precomputed<>+0xfff0(SB)/8, $0x3cced8e2ca7686f1
-DATA ·precomputed<>+0xfff8(SB)/8, $0x15ed1e994eb62669
-DATA ·precomputed<>+0x10000(SB)/8, $0xfb3992a4202bde39
-DATA ·precomputed<>+0x10008(SB)/8, $0x2549f5643d6bab98
-DATA ·precomputed<>+0x10010(SB)/8, $0xb56464287712512
-DATA ·precomputed<>+0x10018(SB)/8, $0xd52442b47fde7e50
-DATA ·precomputed<>+0x10020(SB)/8, $0xa6cefd08a3d3e16e
-DATA ·precomputed<>+0x10028(SB)/8, $0x5b194f0ac83b29bd
-DATA ·precomputed<>+0x10030(SB)/8, $0x6db0edd8906dec8c
-DATA ·precomputed<>+0x10038(SB)/8, $0x7a09095902570c1e
-DATA ·precomputed<>+0x10040(SB)/8, $0x310695d0eb10175e
-DATA ·precomputed<>+0x10048(SB)/8, $0x79aaa6eacd236aa1
-DATA ·precomputed<>+0x10050(SB)/8, $0xf78539ff3edfff40
-DATA ·precomputed<>+0x10058(SB)/8, $0x2369c51702cd6063
-DATA ·precomputed<>+0x10060(SB)/8, $0x81e43ae55c8631ff
-DATA ·precomputed<>+0x10068(SB)/8, $0x65e8212216a60bd
-DATA ·precomputed<>+0x10070(SB)/8, $0x225cb473e761a5f9
-DATA ·precomputed<>+0x10078(SB)/8, $0x695ef860ab6de6fa
-DATA ·precomputed<>+0x10080(SB)/8, $0xa6b9e6c9f60ee3e5
-DATA ·precomputed<>+0x10088(SB)/8, $0xb397af7fa236377f
-DATA ·precomputed<>+0x10090(SB)/8, $0xb7a318ac7da4af71
-DATA ·precomputed<>+0x10098(SB)/8, $0xae64b6130a9d39fb
-DATA ·precomputed<>+0x100a0(SB)/8, $0x66ce6c74902b3411
-DATA ·precomputed<>+0x100a8(SB)/8, $0xea256a705a199e53
-DATA ·precomputed<>+0x100b0(SB)/8, $0x8dcddd89550fb76f
-DATA ·precomputed<>+0x100b8(SB)/8, $0x9443b47703e70f9c
-DATA ·precomputed<>+0x100c0(SB)/8, $0x396cfe2d95298003
-DATA ·precomputed<>+0x100c8(SB)/8, $0xcaa66550ad8412fc
-DATA ·precomputed<>+0x100d0(SB)/8, $0xf41d021583997dd3
-DATA ·precomputed<>+0x100d8(SB)/8, $0x7066e35645534587
-DATA ·precomputed<>+0x100e0(SB)/8, $0xd5b5c3e5b6de0d7
-DATA ·precomputed<>+0x100e8(SB)/8, $0x8ead45d0cecd5f26
-DATA ·precomputed<>+0x100f0(SB)/8, $0xe2f24e2cd252ae50
-DATA ·precomputed<>+0x100f8(SB)/8, $0xf71e5d4f815150bf
-DATA ·precomputed<>+0x10100(SB)/8, $0xa27a4735e1d88c25
-DATA ·precomputed<>+0x10108(SB)/8, $0x339905e19bd66b67
-DATA ·precomputed<>+0x10110(SB)/8, $0xa9bfa0ed62af9942
-DATA ·precomputed<>+0x10118(SB)/8, $0xd94dd9e02e2cb83c
-DATA ·precomputed<>+0x10120(SB)/8, $0x279d8fdaab28e268
-DATA ·precomputed<>+0x10128(SB)/8, $0xf28ab69b51a97518
-DATA ·precomputed<>+0x10130(SB)/8, $0xce9bd2ea9691f33e
-DATA ·precomputed<>+0x10138(SB)/8, $0xb9e8b2fe74be3d64
-DATA ·precomputed<>+0x10140(SB)/8, $0x9274c0908b9f8e8c
-DATA ·precomputed<>+0x10148(SB)/8, $0xa375761024e94ce1
-DATA ·precomputed<>+0x10150(SB)/8, $0x8f2b1f2c4f0f3ec1
-DATA ·precomputed<>+0x10158(SB)/8, $0x2e53bb23938d26f
-DATA ·precomputed<>+0x10160(SB)/8, $0x90a3da2c701e5ae8
-DATA ·precomputed<>+0x10168(SB)/8, $0x60c2eacaa271dcca
-DATA ·precomputed<>+0x10170(SB)/8, $0xc9c08e3931fb2372
-DATA ·precomputed<>+0x10178(SB)/8, $0xcaa3245eb838908a
-DATA ·precomputed<>+0x10180(SB)/8, $0xb100f3382b02578b
-DATA ·precomputed<>+0x10188(SB)/8, $0x4716339e64b2c607
-DATA ·precomputed<>+0x10190(SB)/8, $0x92c923ae5b161179
-DATA ·precomputed<>+0x10198(SB)/8, $0xada2e4da0df442a0
-DATA ·precomputed<>+0x101a0(SB)/8, $0x4d4b90c547f85474
-DATA ·precomputed<>+0x101a8(SB)/8, $0xa378bf79824e3195
-DATA ·precomputed<>+0x101b0(SB)/8, $0x4469339d2478a0d4
-DATA ·precomputed<>+0x101b8(SB)/8, $0x972e7880c1e69e2
-DATA ·precomputed<>+0x101c0(SB)/8, $0x79d0585b36a1f3a8
-DATA ·precomputed<>+0x101c8(SB)/8, $0xa3d8f17f913ba5f2
-DATA ·precomputed<>+0x101d0(SB)/8, $0x1eaee5d6225acf11
-DATA ·precomputed<>+0x101d8(SB)/8, $0xd4dfd0a20d32de79
-DATA ·precomputed<>+0x101e0(SB)/8, $0xcec324b6b3ceff3
-DATA ·precomputed<>+0x101e8(SB)/8, $0x3acc6decab447870
-DATA ·precomputed<>+0x101f0(SB)/8, $0xabbf7e6db9c759ac
-DATA ·precomputed<>+0x101f8(SB)/8, $0xd5c1f47a5196938
-DATA ·precomputed<>+0x10200(SB)/8, $0x4ac65a79ba007132
-DATA ·precomputed<>+0x10208(SB)/8, $0x9ca8ebc7fffa243e
-DATA ·precomputed<>+0x10210(SB)/8, $0x2acbb1ead9c41fed
-DATA ·precomputed<>+0x10218(SB)/8, $0x7fc74fd9fc70c1a4
-DATA ·precomputed<>+0x10220(SB)/8, $0x9efc8f657d6901ca
-DATA ·precomputed<>+0x10228(SB)/8, $0xfd88e48c90375685
-DATA ·precomputed<>+0x10230(SB)/8, $0x6d78d892d5657459
-DATA ·precomputed<>+0x10238(SB)/8, $0x3ebeda76b000d7b3
-DATA ·precomputed<>+0x10240(SB)/8, $0x66773535b44a13e
-DATA ·precomputed<>+0x10248(SB)/8, $0xe14de7ad9738074d
-DATA ·precomputed<>+0x10250(SB)/8, $0xa95812ffdec32e08
-DATA ·precomputed<>+0x10258(SB)/8, $0x7040d9c608f9b445
-DATA ·precomputed<>+0x10260(SB)/8, $0x360519fda215a6d0
-DATA ·precomputed<>+0x10268(SB)/8, $0x7425cc8a705851e
-DATA ·precomputed<>+0x10270(SB)/8, $0x84c51641827de55c
-DATA ·precomputed<>+0x10278(SB)/8, $0xfbbeddfc02175b65
-DATA ·precomputed<>+0x10280(SB)/8, $0xfc9bcb2b00cf1d9a
-DATA ·precomputed<>+0x10288(SB)/8, $0x4ae2919a0a1a0de7
-DATA ·precomputed<>+0x10290(SB)/8, $0xedd714c31695670a
-DATA ·precomputed<>+0x10298(SB)/8, $0x18f9aa59e136c4b7
-DATA ·precomputed<>+0x102a0(SB)/8, $0xa0380c726203f854
-DATA ·precomputed<>+0x102a8(SB)/8, $0x2772cad33cd0afa2
-DATA ·precomputed<>+0x102b0(SB)/8, $0x1a85c49bfb69ff50
-DATA ·precomputed<>+0x102b8(SB)/8, $0x7ea27452be91a2b8
-DATA ·precomputed<>+0x102c0(SB)/8, $0xc8a94fcb9e095f90
-DATA ·precomputed<>+0x102c8(SB)/8, $0x473891f7cdffac8c
-DATA ·precomputed<>+0x102d0(SB)/8, $0xab410748b3b8aafe
-DATA ·precomputed<>+0x102d8(SB)/8, $0x3374f97d85872cf2
-DATA ·precomputed<>+0x102e0(SB)/8, $0x6b3e640ba20739fa
-DATA ·precomputed<>+0x102e8(SB)/8, $0x1ad0ee6e4dea1a0
-DATA ·precomputed<>+0x102f0(SB)/8, $0xa09a1c5db0c7f622
-DATA ·precomputed<>+0x102f8(SB)/8, $0xaba6f808380f3a55
-DATA ·precomputed<>+0x10300(SB)/8, $0x8e85e24d625a3234
-DATA ·precomputed<>+0x10308(SB)/8, $0xd2e3441b2b4cb6d3
-DATA ·precomputed<>+0x10310(SB)/8, $0xe2395bb8fc4cf650
-DATA ·precomputed<>+0x10318(SB)/8, $0x4a85fe6c39c947f8
-DATA ·precomputed<>+0x10320(SB)/8, $0xbf2c5a596767d483
-DATA ·precomputed<>+0x10328(SB)/8, $0xac425d3b2c77f9a7
-DATA ·precomputed<>+0x10330(SB)/8, $0xbc7485fe826df715
-DATA ·precomputed<>+0x10338(SB)/8, $0x250ab4883dd29901
-DATA ·precomputed<>+0x10340(SB)/8, $0xc4fbc65a1919e4eb
-DATA ·precomputed<>+0x10348(SB)/8, $0xd10be1e2ce24bf18
-DATA ·precomputed<>+0x10350(SB)/8, $0xd0b6d0a6ab891d83
-DATA ·precomputed<>+0x10358(SB)/8, $0xbe0d67936e345eeb
-DATA ·precomputed<>+0x10360(SB)/8, $0x2a53ee44119220c8
-DATA ·precomputed<>+0x10368(SB)/8, $0x904ecbff93f96f57
-DATA ·precomputed<>+0x10370(SB)/8, $0xbf8c4a0f04255dd5
-DATA ·precomputed<>+0x10378(SB)/8, $0x2ab19fb114a97874
-DATA ·precomputed<>+0x10380(SB)/8, $0xf7521b816b7bd3d3
-DATA ·precomputed<>+0x10388(SB)/8, $0xc220a69c3d654a80
-DATA ·precomputed<>+0x10390(SB)/8, $0x7d53a8110e0d18f9
-DATA ·precomputed<>+0x10398(SB)/8, $0xe14e1c04b1ebd92b
-DATA ·precomputed<>+0x103a0(SB)/8, $0xe2614c1eac92e10d
-DATA ·precomputed<>+0x103a8(SB)/8, $0x52339024c2ea9a11
-DATA ·precomputed<>+0x103b0(SB)/8, $0x1a18e2baea81fe7b
-DATA ·precomputed<>+0x103b8(SB)/8, $0xf7ab42eac761117a
-DATA ·precomputed<>+0x103c0(SB)/8, $0x781a215745e7ea91
-DATA ·precomputed<>+0x103c8(SB)/8, $0x4da3f86dacadfc40
-DATA ·precomputed<>+0x103d0(SB)/8, $0xc81d6c7d162cd393
-DATA ·precomputed<>+0x103d8(SB)/8, $0x2c38a2a1ad6e60fc
-DATA ·precomputed<>+0x103e0(SB)/8, $0x575b25d6f753479d
-DATA ·precomputed<>+0x103e8(SB)/8, $0xc914e08ebdec6025
-DATA ·precomputed<>+0x103f0(SB)/8, $0xf81cea34492d5547
-DATA ·precomputed<>+0x103f8(SB)/8, $0x6bbb8bb1fb1b6969
-DATA ·precomputed<>+0x10400(SB)/8, $0xa106eaa8be22cbc2
-DATA ·precomputed<>+0x10408(SB)/8, $0x69ecae1a492b4d84
-DATA ·precomputed<>+0x10410(SB)/8, $0xcb60e20e475d9289
-DATA ·precomputed<>+0x10418(SB)/8, $0x31dfafc2f6c1639c
-DATA ·precomputed<>+0x10420(SB)/8, $0xb70ab7ab46a815ca
-DATA ·precomputed<>+0x10428(SB)/8, $0x2b4a3f7453829fa5
-DATA ·precomputed<>+0x10430(SB)/8, $0x5b67cd1ff06909d1
-DATA ·precomputed<>+0x10438(SB)/8, $0x9a4062fafe7f27ac
-DATA ·precomputed<>+0x10440(SB)/8, $0x313b61fce2fee313
-DATA ·precomputed<>+0x10448(SB)/8, $0x83410685680e5780
-DATA ·precomputed<>+0x10450(SB)/8, $0x83860563a6162fff
-DATA ·precomputed<>+0x10458(SB)/8, $0x410c9ac1115739f2
-DATA ·precomputed<>+0x10460(SB)/8, $0x83b745a66822c603
-DATA ·precomputed<>+0x10468(SB)/8, $0x88f29802143125e
-DATA ·precomputed<>+0x10470(SB)/8, $0x63ed0f7463e4bf40
-DATA ·precomputed<>+0x10478(SB)/8, $0xa09a0ea2c91a99ce
-DATA ·precomputed<>+0x10480(SB)/8, $0x35edc6d8d97ba94a
-DATA ·precomputed<>+0x10488(SB)/8, $0x356d56e703bbc7be
-DATA ·precomputed<>+0x10490(SB)/8, $0xe6f84bec2ac0a80c
-DATA ·precomputed<>+0x10498(SB)/8, $0xeeb2aca485130baf
-DATA ·precomputed<>+0x104a0(SB)/8, $0x2d6e05ba288609fb
-DATA ·precomputed<>+0x104a8(SB)/8, $0xdef3e1e6bcc300ff
-DATA ·precomputed<>+0x104b0(SB)/8, $0x38bc876fbf802a55
-DATA ·precomputed<>+0x104b8(SB)/8, $0x564a6898e1d59b30
-DATA ·precomputed<>+0x104c0(SB)/8, $0xe01090b996f1769d
-DATA ·precomputed<>+0x104c8(SB)/8, $0xe13338f1f5afc005
-DATA ·precomputed<>+0x104d0(SB)/8, $0x7f976d02b3909d0d
-DATA ·precomputed<>+0x104d8(SB)/8, $0x7cb370755192f305
-DATA ·precomputed<>+0x104e0(SB)/8, $0x4fbe26bc310d282c
-DATA ·precomputed<>+0x104e8(SB)/8, $0x1de854359fcdc9c3
-DATA ·precomputed<>+0x104f0(SB)/8, $0x229ca012704304ba
-DATA ·precomputed<>+0x104f8(SB)/8, $0x2a082cc4982fc95b
-DATA ·precomputed<>+0x10500(SB)/8, $0x6b62d9aff1f04ace
-DATA ·precomputed<>+0x10508(SB)/8, $0x13faf850b73cb523
-DATA ·precomputed<>+0x10510(SB)/8, $0xc711454f738c6c87
-DATA ·precomputed<>+0x10518(SB)/8, $0xf11fea267f51e6d5
-DATA ·precomputed<>+0x10520(SB)/8, $0x5e539e38efc51a1
-DATA ·precomputed<>+0x10528(SB)/8, $0x9bb968234a6359ee
-DATA ·precomputed<>+0x10530(SB)/8, $0x8caa6745162c86a
-DATA ·precomputed<>+0x10538(SB)/8, $0xfc878f60b6016610
-DATA ·precomputed<>+0x10540(SB)/8, $0x4be4d2eb3bf411a8
-DATA ·precomputed<>+0x10548(SB)/8, $0x58b88a38b2bf50a4
-DATA ·precomputed<>+0x10550(SB)/8, $0x76d4fb880a492dce
-DATA ·precomputed<>+0x10558(SB)/8, $0x8345c2b8050a80f2
-DATA ·precomputed<>+0x10560(SB)/8, $0x2e8d79ceb410b05e
-DATA ·precomputed<>+0x10568(SB)/8, $0xcacb716db1d80780
-DATA ·precomputed<>+0x10570(SB)/8, $0x52df6c6074ec7655
-DATA ·precomputed<>+0x10578(SB)/8, $0x4f6269a90277f1bb
-DATA ·precomputed<>+0x10580(SB)/8, $0xe68207ac27aed6b9
-DATA ·precomputed<>+0x10588(SB)/8, $0x75b284eb887b24b1
-DATA ·precomputed<>+0x10590(SB)/8, $0x337533784f7f9f95
-DATA ·precomputed<>+0x10598(SB)/8, $0x90c69bd11609dc06
-DATA ·precomputed<>+0x105a0(SB)/8, $0xea7b91d84636e50a
-DATA ·precomputed<>+0x105a8(SB)/8, $0x7e3fd91f4573e06d
-DATA ·precomputed<>+0x105b0(SB)/8, $0x6d4754377d81cda3
-DATA ·precomputed<>+0x105b8(SB)/8, $0x97b58051381ae126
-DATA ·precomputed<>+0x105c0(SB)/8, $0xad2ac5e7f7fd374d
-DATA ·precomputed<>+0x105c8(SB)/8, $0xac8bf5ed4a86ccee
-DATA ·precomputed<>+0x105d0(SB)/8, $0x8632ca40d0a23522
-DATA ·precomputed<>+0x105d8(SB)/8, $0xed0a13f8e9eff7f9
-DATA ·precomputed<>+0x105e0(SB)/8, $0xaaa2267921ff44d9
-DATA ·precomputed<>+0x105e8(SB)/8, $0x1ffc20b8091258fb
-DATA ·precomputed<>+0x105f0(SB)/8, $0xbc71460e06d470a4
-DATA ·precomputed<>+0x105f8(SB)/8, $0xb83c85b6a1f910db
-DATA ·precomputed<>+0x10600(SB)/8, $0x64444d40543a77c8
-DATA ·precomputed<>+0x10608(SB)/8, $0xe4d6ff1a73eed99d
-DATA ·precomputed<>+0x10610(SB)/8, $0x6e8245eebe6d11bc
-DATA ·precomputed<>+0x10618(SB)/8, $0xa1dd8bcbea68ecd8
-DATA ·precomputed<>+0x10620(SB)/8, $0x1602786928bb1e79
-DATA ·precomputed<>+0x10628(SB)/8, $0xbadad21bbd24f48f
-DATA ·precomputed<>+0x10630(SB)/8, $0x8373eaf5b9302939
-DATA ·precomputed<>+0x10638(SB)/8, $0x613c8a4e568408af
-DATA ·precomputed<>+0x10640(SB)/8, $0x910fbbd3acf40595
-DATA ·precomputed<>+0x10648(SB)/8, $0x3324f1f55c6d2137
-DATA ·precomputed<>+0x10650(SB)/8, $0x2b9d969f77d6f323
-DATA ·precomputed<>+0x10658(SB)/8, $0xfaa69a95edff88e2
-DATA ·precomputed<>+0x10660(SB)/8, $0x7301fa456e9ec5d7
-DATA ·precomputed<>+0x10668(SB)/8, $0xf7bf17eb9e7c295d
-DATA ·precomputed<>+0x10670(SB)/8, $0x9c478f41a54dd131
-DATA ·precomputed<>+0x10678(SB)/8, $0x1daf93149fd3c16d
-DATA ·precomputed<>+0x10680(SB)/8, $0xf7524375e8fec87b
-DATA ·precomputed<>+0x10688(SB)/8, $0x143b2cf74b709084
-DATA ·precomputed<>+0x10690(SB)/8, $0x3ae2e7896ca7379b
-DATA ·precomputed<>+0x10698(SB)/8, $0x78e18dd69bba72f5
-DATA ·precomputed<>+0x106a0(SB)/8, $0x97b1d862f9330301
-DATA ·precomputed<>+0x106a8(SB)/8, $0x754a799e52b6ddd1
-DATA ·precomputed<>+0x106b0(SB)/8, $0x2fcc3e054d4344a8
-DATA ·precomputed<>+0x106b8(SB)/8, $0xd3abb8d49a7a2937
-DATA ·precomputed<>+0x106c0(SB)/8, $0x73278aca99c2c9e0
-DATA ·precomputed<>+0x106c8(SB)/8, $0x8309d71db638c670
-DATA ·precomputed<>+0x106d0(SB)/8, $0xa1ee0874dca8a497
-DATA ·precomputed<>+0x106d8(SB)/8, $0xa549cc583423dad6
-DATA ·precomputed<>+0x106e0(SB)/8, $0x9073621f64806f2
-DATA ·precomputed<>+0x106e8(SB)/8, $0xc9cd8ed75d017859
-DATA ·precomputed<>+0x106f0(SB)/8, $0x3ffac140e124f648
-DATA ·precomputed<>+0x106f8(SB)/8, $0xf68ab5c7424bda
-DATA ·precomputed<>+0x10700(SB)/8, $0x57741a75cf03e7b1
-DATA ·precomputed<>+0x10708(SB)/8, $0x4044bb0ab5b13545
-DATA ·precomputed<>+0x10710(SB)/8, $0x3abd3b19303e0d76
-DATA ·precomputed<>+0x10718(SB)/8, $0x53e5b9919c203bd6
-DATA ·precomputed<>+0x10720(SB)/8, $0x9dfa64acec5f9323
-DATA ·precomputed<>+0x10728(SB)/8, $0xb0899901dca5026f
-DATA ·precomputed<>+0x10730(SB)/8, $0x813ead9ed595b62
-DATA ·precomputed<>+0x10738(SB)/8, $0x3203c76c1b9bddc3
-DATA ·precomputed<>+0x10740(SB)/8, $0x41cb0ce303013874
-DATA ·precomputed<>+0x10748(SB)/8, $0xd22e979dead31113
-DATA ·precomputed<>+0x10750(SB)/8, $0x64dae8aa1cdc9ed7
-DATA ·precomputed<>+0x10758(SB)/8, $0x266252717ef6a770
-DATA ·precomputed<>+0x10760(SB)/8, $0x992d6ec0eaa01a62
-DATA ·precomputed<>+0x10768(SB)/8, $0x6817b065e58872a7
-DATA ·precomputed<>+0x10770(SB)/8, $0xb1a1d98ce73b190
-DATA ·precomputed<>+0x10778(SB)/8, $0xe131d40bb7509452
-DATA ·precomputed<>+0x10780(SB)/8, $0x45c48a36c1767dfb
-DATA ·precomputed<>+0x10788(SB)/8, $0x27b231fa18aa8b5
-DATA ·precomputed<>+0x10790(SB)/8, $0x817af384fc02b457
-DATA ·precomputed<>+0x10798(SB)/8, $0x4da46c0c7c37e95a
-DATA ·precomputed<>+0x107a0(SB)/8, $0x862f6c0444f18191
-DATA ·precomputed<>+0x107a8(SB)/8, $0x2a5676289db8183b
-DATA ·precomputed<>+0x107b0(SB)/8, $0x960f205150df7399
-DATA ·precomputed<>+0x107b8(SB)/8, $0x72d23feec510ae44
-DATA ·precomputed<>+0x107c0(SB)/8, $0x1ee8082c1279504c
-DATA ·precomputed<>+0x107c8(SB)/8, $0xa466abb22c92ffb7
-DATA ·precomputed<>+0x107d0(SB)/8, $0x4118b26a3e81c7e2
-DATA ·precomputed<>+0x107d8(SB)/8, $0x1a76cc50fc60e33a
-DATA ·precomputed<>+0x107e0(SB)/8, $0x34998bc25736d7ae
-DATA ·precomputed<>+0x107e8(SB)/8, $0x20b39558bd1ef993
-DATA ·precomputed<>+0x107f0(SB)/8, $0xd669e2ae5fbf2525
-DATA ·precomputed<>+0x107f8(SB)/8, $0xbf956ec601cc7626
-DATA ·precomputed<>+0x10800(SB)/8, $0xdc424508eecede3d
-DATA ·precomputed<>+0x10808(SB)/8, $0x11889b35386440d0
-DATA ·precomputed<>+0x10810(SB)/8, $0x7b229f9398de0d77
-DATA ·precomputed<>+0x10818(SB)/8, $0x73fced8a300a7447
-DATA ·precomputed<>+0x10820(SB)/8, $0xf75e1c79e31c8f88
-DATA ·precomputed<>+0x10828(SB)/8, $0x8db20bddbb277e4f
-DATA ·precomputed<>+0x10830(SB)/8, $0x8ded0a702b87c02c
-DATA ·precomputed<>+0x10838(SB)/8, $0x166281b54d164c1a
-DATA ·precomputed<>+0x10840(SB)/8, $0xbd140bd8e6ad29dc
-DATA ·precomputed<>+0x10848(SB)/8, $0x7dca4b106a04da28
-DATA ·precomputed<>+0x10850(SB)/8, $0xa84feafcade05b33
-DATA ·precomputed<>+0x10858(SB)/8, $0x44d031f87630aacf
-DATA ·precomputed<>+0x10860(SB)/8, $0x18af2fa6cdee269c
-DATA ·precomputed<>+0x10868(SB)/8, $0x1e40571b8697a40b
-DATA ·precomputed<>+0x10870(SB)/8, $0xf71d44adf0e5f826
-DATA ·precomputed<>+0x10878(SB)/8, $0x2a47ddf9a434cfe6
-DATA ·precomputed<>+0x10880(SB)/8, $0x2471546ae0bd7ef2
-DATA ·precomputed<>+0x10888(SB)/8, $0x27d310dc656a62a3
-DATA ·precomputed<>+0x10890(SB)/8, $0xb8014ecaad35da30
-DATA ·precomputed<>+0x10898(SB)/8, $0xbdfdcd827f35cd7a
-DATA ·precomputed<>+0x108a0(SB)/8, $0xf1e4d51f040ae645
-DATA ·precomputed<>+0x108a8(SB)/8, $0x672ffadff42a4d9b
-DATA ·precomputed<>+0x108b0(SB)/8, $0x9d8743702d0be1c0
-DATA ·precomputed<>+0x108b8(SB)/8, $0xcc3671c6c6e55471
-DATA ·precomputed<>+0x108c0(SB)/8, $0x3b3892d346410cb1
-DATA ·precomputed<>+0x108c8(SB)/8, $0x72097f2203a5a136
-DATA ·precomputed<>+0x108d0(SB)/8, $0xdb3a1b8098de068d
-DATA ·precomputed<>+0x108d8(SB)/8, $0xfb7438e44b1a3890
-DATA ·precomputed<>+0x108e0(SB)/8, $0x8a10d5ea3839d3d9
-DATA ·precomputed<>+0x108e8(SB)/8, $0xd9ad034df4bd8126
-DATA ·precomputed<>+0x108f0(SB)/8, $0x7d108efd4800261
-DATA ·precomputed<>+0x108f8(SB)/8, $0x978d98ba9c5d6c52
-DATA ·precomputed<>+0x10900(SB)/8, $0xea9d35527c2343a
-DATA ·precomputed<>+0x10908(SB)/8, $0xe21c75e44b32e339
-DATA ·precomputed<>+0x10910(SB)/8, $0x1438785ea7fc353a
-DATA ·precomputed<>+0x10918(SB)/8, $0xb8d64bae9a1dd56
-DATA ·precomputed<>+0x10920(SB)/8, $0xcacf9b6475347c02
-DATA ·precomputed<>+0x10928(SB)/8, $0xf788c83ecaad57aa
-DATA ·precomputed<>+0x10930(SB)/8, $0x90df1ab836ecf2e0
-DATA ·precomputed<>+0x10938(SB)/8, $0x4db604a3f45070ac
-DATA ·precomputed<>+0x10940(SB)/8, $0xcb952e4109c7b43c
-DATA ·precomputed<>+0x10948(SB)/8, $0x7f7a0ae31c1b8fb6
-DATA ·precomputed<>+0x10950(SB)/8, $0xbca8a9cf331dfb05
-DATA ·precomputed<>+0x10958(SB)/8, $0x4a1db4a1e0db9d7d
-DATA ·precomputed<>+0x10960(SB)/8, $0x988d36a3e5b9c892
-DATA ·precomputed<>+0x10968(SB)/8, $0x64640e55010ad00e
-DATA ·precomputed<>+0x10970(SB)/8, $0x4c33c7e8c011bffd
-DATA ·precomputed<>+0x10978(SB)/8, $0x5d7cf370a0ad217b
-DATA ·precomputed<>+0x10980(SB)/8, $0x3a804dd86af5f580
-DATA ·precomputed<>+0x10988(SB)/8, $0x724a756bd74ea5ce
-DATA ·precomputed<>+0x10990(SB)/8, $0xc2968d00432d854
-DATA ·precomputed<>+0x10998(SB)/8, $0xe3be03f3a4f262fe
-DATA ·precomputed<>+0x109a0(SB)/8, $0xe446692ac84c22bb
-DATA ·precomputed<>+0x109a8(SB)/8, $0x156b31689647650d
-DATA ·precomputed<>+0x109b0(SB)/8, $0x4e06bc39b5d3d62a
-DATA ·precomputed<>+0x109b8(SB)/8, $0xf99d4fec80eea174
-DATA ·precomputed<>+0x109c0(SB)/8, $0x4f942d017375f2de
-DATA ·precomputed<>+0x109c8(SB)/8, $0x968a76088aa1523a
-DATA ·precomputed<>+0x109d0(SB)/8, $0x55dc7da6377e5c4c
-DATA ·precomputed<>+0x109d8(SB)/8, $0xb75fff53282b540e
-DATA ·precomputed<>+0x109e0(SB)/8, $0xfee35c15fd4b6951
-DATA ·precomputed<>+0x109e8(SB)/8, $0x6d1d64f6f04ddfae
-DATA ·precomputed<>+0x109f0(SB)/8, $0x320f1769af7c8714
-DATA ·precomputed<>+0x109f8(SB)/8, $0x2b5f86a4482ba6fd
-DATA ·precomputed<>+0x10a00(SB)/8, $0x6ba93d3a6ac9757c
-DATA ·precomputed<>+0x10a08(SB)/8, $0xff09b546ec2c92a0
-DATA ·precomputed<>+0x10a10(SB)/8, $0x95d3436cc5960be8
-DATA ·precomputed<>+0x10a18(SB)/8, $0x90b7e8cb69029082
-DATA ·precomputed<>+0x10a20(SB)/8, $0xbdd1e2b9db6b32e5
-DATA ·precomputed<>+0x10a28(SB)/8, $0xf4d2e43bfd47ad85
-DATA ·precomputed<>+0x10a30(SB)/8, $0x8923251ecb005dbe
-DATA ·precomputed<>+0x10a38(SB)/8, $0xc21368a0662912e7
-DATA ·precomputed<>+0x10a40(SB)/8, $0xb392d49b6355564c
-DATA ·precomputed<>+0x10a48(SB)/8, $0x57e2f166887c5a18
-DATA ·precomputed<>+0x10a50(SB)/8, $0x88b3a014230a278a
-DATA ·precomputed<>+0x10a58(SB)/8, $0x88e49084c194449
-DATA ·precomputed<>+0x10a60(SB)/8, $0xc6cd309f43d6af69
-DATA ·precomputed<>+0x10a68(SB)/8, $0x394445e3589a7f7e
-DATA ·precomputed<>+0x10a70(SB)/8, $0x610077a031e7c08
-DATA ·precomputed<>+0x10a78(SB)/8, $0xd05547cca3519f78
-DATA ·precomputed<>+0x10a80(SB)/8, $0x375673066a2c47d1
-DATA ·precomputed<>+0x10a88(SB)/8, $0xf66df9b862feb54a
-DATA ·precomputed<>+0x10a90(SB)/8, $0xf734ee373e07ce56
-DATA ·precomputed<>+0x10a98(SB)/8, $0x50c4982d659809fd
-DATA ·precomputed<>+0x10aa0(SB)/8, $0xe2fa768f9daf8faa
-DATA ·precomputed<>+0x10aa8(SB)/8, $0x66088ddc8b9fd7c3
-DATA ·precomputed<>+0x10ab0(SB)/8, $0xb8265661333683c6
-DATA ·precomputed<>+0x10ab8(SB)/8, $0xe7dacf81dff2a0a7
-DATA ·precomputed<>+0x10ac0(SB)/8, $0xc01e1f3bdd857b31
-DATA ·precomputed<>+0x10ac8(SB)/8, $0x92c2263ac9513734
-DATA ·precomputed<>+0x10ad0(SB)/8, $0x562652d5589327b8
-DATA ·precomputed<>+0x10ad8(SB)/8, $0xa8edd06596a1c164
-DATA ·precomputed<>+0x10ae0(SB)/8, $0x2cbf8f9879f8df8d
-DATA ·precomputed<>+0x10ae8(SB)/8, $0x3d5cf77140847dde
-DATA ·precomputed<>+0x10af0(SB)/8, $0x69b08ee4597c0622
-DATA ·precomputed<>+0x10af8(SB)/8, $0xfff18c4d8a868f2a
-DATA ·precomputed<>+0x10b00(SB)/8, $0xb78a5b742bca0ae1
-DATA ·precomputed<>+0x10b08(SB)/8, $0x35180deccccdc3d3
-DATA ·precomputed<>+0x10b10(SB)/8, $0x15e4fba5a97e519f
-DATA ·precomputed<>+0x10b18(SB)/8, $0xe49dac9df5b8340e
-DATA ·precomputed<>+0x10b20(SB)/8, $0xdbd8ed3ae5978024
-DATA ·precomputed<>+0x10b28(SB)/8, $0xd181f26aeb105505
-DATA ·precomputed<>+0x10b30(SB)/8, $0x3836481829f57098
-DATA ·precomputed<>+0x10b38(SB)/8, $0xd674fe1f3900171a
-DATA ·precomputed<>+0x10b40(SB)/8, $0x958d7a5cfb9ba6dd
-DATA ·precomputed<>+0x10b48(SB)/8, $0xa0052032e673275d
-DATA ·precomputed<>+0x10b50(SB)/8, $0x514ffd9d7f978d07
-DATA ·precomputed<>+0x10b58(SB)/8, $0x544bbce9450b76e1
-DATA ·precomputed<>+0x10b60(SB)/8, $0xeaa25d746b5201b6
-DATA ·precomputed<>+0x10b68(SB)/8, $0x7528a4ea74d082a5
-DATA ·precomputed<>+0x10b70(SB)/8, $0xa08c8d3166609e27
-DATA ·precomputed<>+0x10b78(SB)/8, $0x5150d1beda7c6fd9
-DATA ·precomputed<>+0x10b80(SB)/8, $0x16445a64141c8b35
-DATA ·precomputed<>+0x10b88(SB)/8, $0xc11c310173c61779
-DATA ·precomputed<>+0x10b90(SB)/8, $0xa5aa0d18485300ee
-DATA ·precomputed<>+0x10b98(SB)/8, $0x531b6de11cc02bf0
-DATA ·precomputed<>+0x10ba0(SB)/8, $0xf8b94155c4efeb2c
-DATA ·precomputed<>+0x10ba8(SB)/8, $0x83632663d015a9c8
-DATA ·precomputed<>+0x10bb0(SB)/8, $0xc369b3cecba18b7f
-DATA ·precomputed<>+0x10bb8(SB)/8, $0xe11b3ef6c29e0f9b
-DATA ·precomputed<>+0x10bc0(SB)/8, $0x1c6fd7a99107901b
-DATA ·precomputed<>+0x10bc8(SB)/8, $0x5dc4a41ea211d116
-DATA ·precomputed<>+0x10bd0(SB)/8, $0x597e94e7af1b78a8
-DATA ·precomputed<>+0x10bd8(SB)/8, $0xe72da34d53afcb6a
-DATA ·precomputed<>+0x10be0(SB)/8, $0xbc364db774512c24
-DATA ·precomputed<>+0x10be8(SB)/8, $0xc26a8fb9b2811e91
-DATA ·precomputed<>+0x10bf0(SB)/8, $0xfdd39d7f290469b1
-DATA ·precomputed<>+0x10bf8(SB)/8, $0x8451539220612535
-DATA ·precomputed<>+0x10c00(SB)/8, $0x36002a8440314a03
-DATA ·precomputed<>+0x10c08(SB)/8, $0x746eedd4a46781d3
-DATA ·precomputed<>+0x10c10(SB)/8, $0xce141e44d9beaf9d
-DATA ·precomputed<>+0x10c18(SB)/8, $0x12222c88b8ae42ad
-DATA ·precomputed<>+0x10c20(SB)/8, $0xf1328cfa68292dc9
-DATA ·precomputed<>+0x10c28(SB)/8, $0x736a1455322ecfea
-DATA ·precomputed<>+0x10c30(SB)/8, $0xfd66be3d217aac25
-DATA ·precomputed<>+0x10c38(SB)/8, $0xdcc22b8ef2a45347
-DATA ·precomputed<>+0x10c40(SB)/8, $0x1e4632ca71d2edc
-DATA ·precomputed<>+0x10c48(SB)/8, $0x730e6b52037d4920
-DATA ·precomputed<>+0x10c50(SB)/8, $0xcd991260e080d73
-DATA ·precomputed<>+0x10c58(SB)/8, $0x79021fdc9aed2ee7
-DATA ·precomputed<>+0x10c60(SB)/8, $0x3c0735bf0cdb71c7
-DATA ·precomputed<>+0x10c68(SB)/8, $0x4274d8763e385f1
-DATA ·precomputed<>+0x10c70(SB)/8, $0x4f0ab45b4890a217
-DATA ·precomputed<>+0x10c78(SB)/8, $0x35f9cace7519e39b
-DATA ·precomputed<>+0x10c80(SB)/8, $0x4138feaa758ff814
-DATA ·precomputed<>+0x10c88(SB)/8, $0xc6744c3925ec3137
-DATA ·precomputed<>+0x10c90(SB)/8, $0xfd228e65813ddff3
-DATA ·precomputed<>+0x10c98(SB)/8, $0xe93b2d80ff02e81c
-DATA ·precomputed<>+0x10ca0(SB)/8, $0xe4ba6f4f48d7c835
-DATA ·precomputed<>+0x10ca8(SB)/8, $0x65cd079a5e56c963
-DATA ·precomputed<>+0x10cb0(SB)/8, $0x2802ab331ac00904
-DATA ·precomputed<>+0x10cb8(SB)/8, $0x4acf41b67dbcd9b2
-DATA ·precomputed<>+0x10cc0(SB)/8, $0x57ef606a4a98f93d
-DATA ·precomputed<>+0x10cc8(SB)/8, $0x41b9190df3475d72
-DATA ·precomputed<>+0x10cd0(SB)/8, $0x7ec792db91e3f8cf
-DATA ·precomputed<>+0x10cd8(SB)/8, $0xca37ec1022396a4f
-DATA ·precomputed<>+0x10ce0(SB)/8, $0xf0b9c219d0879e17
-DATA ·precomputed<>+0x10ce8(SB)/8, $0x61303af3eac57d3f
-DATA ·precomputed<>+0x10cf0(SB)/8, $0x13293c3be1037ea7
-DATA ·precomputed<>+0x10cf8(SB)/8, $0x61557e04a058f1ec
-DATA ·precomputed<>+0x10d00(SB)/8, $0x8b03017432b3cfd2
-DATA ·precomputed<>+0x10d08(SB)/8, $0xa73b3f01921e0283
-DATA ·precomputed<>+0x10d10(SB)/8, $0xc9d31fbdceea6d6b
-DATA ·precomputed<>+0x10d18(SB)/8, $0xc4e1b9ed60de28ae
-DATA ·precomputed<>+0x10d20(SB)/8, $0x34e4110202a1121c
-DATA ·precomputed<>+0x10d28(SB)/8, $0xf82607ef07adaf95
-DATA ·precomputed<>+0x10d30(SB)/8, $0x924e0de1210e7246
-DATA ·precomputed<>+0x10d38(SB)/8, $0x497bfb60ba8560b0
-DATA ·precomputed<>+0x10d40(SB)/8, $0x85b8ea357326961
-DATA ·precomputed<>+0x10d48(SB)/8, $0x51e0b0340b7135c2
-DATA ·precomputed<>+0x10d50(SB)/8, $0x5432bd37c4a78441
-DATA ·precomputed<>+0x10d58(SB)/8, $0x6f62fe25f214aa50
-DATA ·precomputed<>+0x10d60(SB)/8, $0xb060c676fd550faa
-DATA ·precomputed<>+0x10d68(SB)/8, $0x1a9448b645cfded2
-DATA ·precomputed<>+0x10d70(SB)/8, $0xc2a068c587dc6558
-DATA ·precomputed<>+0x10d78(SB)/8, $0xa11045331fb0b9cb
-DATA ·precomputed<>+0x10d80(SB)/8, $0x483ee1fd53d0a667
-DATA ·precomputed<>+0x10d88(SB)/8, $0x695b39210a06ca3d
-DATA ·precomputed<>+0x10d90(SB)/8, $0xdcafb3473c4d78eb
-DATA ·precomputed<>+0x10d98(SB)/8, $0xf15ca1f1d0257a6f
-DATA ·precomputed<>+0x10da0(SB)/8, $0xfc8e96e3a2386347
-DATA ·precomputed<>+0x10da8(SB)/8, $0x605aab965b1c030
-DATA ·precomputed<>+0x10db0(SB)/8, $0x4d8b2441035a4eca
-DATA ·precomputed<>+0x10db8(SB)/8, $0x58e67bb72e5cf335
-DATA ·precomputed<>+0x10dc0(SB)/8, $0xab997cd15c5d384f
-DATA ·precomputed<>+0x10dc8(SB)/8, $0x36884aeff74de833
-DATA ·precomputed<>+0x10dd0(SB)/8, $0x6be21274d8fb7ce3
-DATA ·precomputed<>+0x10dd8(SB)/8, $0xe0bb298ea6608ef7
-DATA ·precomputed<>+0x10de0(SB)/8, $0xe27c1a0cd53bd426
-DATA ·precomputed<>+0x10de8(SB)/8, $0x78035a206eb3293b
-DATA ·precomputed<>+0x10df0(SB)/8, $0xd7689eb2a6fc1ac
-DATA ·precomputed<>+0x10df8(SB)/8, $0x9a38274a4f2c4fed
-DATA ·precomputed<>+0x10e00(SB)/8, $0x69b96f7797918e7
-DATA ·precomputed<>+0x10e08(SB)/8, $0xb99f5977c4387641
-DATA ·precomputed<>+0x10e10(SB)/8, $0x7f5fdcbfff8580ca
-DATA ·precomputed<>+0x10e18(SB)/8, $0xabd7fc65c8ddf735
-DATA ·precomputed<>+0x10e20(SB)/8, $0x6331b29543f53e56
-DATA ·precomputed<>+0x10e28(SB)/8, $0x2577214640f8a1b7
-DATA ·precomputed<>+0x10e30(SB)/8, $0xc78648a9f5d1da57
-DATA ·precomputed<>+0x10e38(SB)/8, $0x6f9116db61b6352c
-DATA ·precomputed<>+0x10e40(SB)/8, $0xfc2b476301407c53
-DATA ·precomputed<>+0x10e48(SB)/8, $0x76568730adc349bb
-DATA ·precomputed<>+0x10e50(SB)/8, $0x274e126eddb0733d
-DATA ·precomputed<>+0x10e58(SB)/8, $0x5b58dd4ec5e818c3
-DATA ·precomputed<>+0x10e60(SB)/8, $0xedb1d445552c70af
-DATA ·precomputed<>+0x10e68(SB)/8, $0xfe63815b36e295be
-DATA ·precomputed<>+0x10e70(SB)/8, $0x92a02e06f64030e1
-DATA ·precomputed<>+0x10e78(SB)/8, $0xf288d8d4981b888e
-DATA ·precomputed<>+0x10e80(SB)/8, $0xdd00c279588c64ff
-DATA ·precomputed<>+0x10e88(SB)/8, $0xd2c8506c7571ddf6
-DATA ·precomputed<>+0x10e90(SB)/8, $0x8af6c7d3f0b9b475
-DATA ·precomputed<>+0x10e98(SB)/8, $0x6fe8a7be6ec1b935
-DATA ·precomputed<>+0x10ea0(SB)/8, $0xe678e01f820f82f3
-DATA ·precomputed<>+0x10ea8(SB)/8, $0x5130242691983bc4
-DATA ·precomputed<>+0x10eb0(SB)/8, $0x1713a28e841d38b6
-DATA ·precomputed<>+0x10eb8(SB)/8, $0x99ee8d1f28004bd2
-DATA ·precomputed<>+0x10ec0(SB)/8, $0xce7f1c4eccf60d0b
-DATA ·precomputed<>+0x10ec8(SB)/8, $0xc7918e140cab95d8
-DATA ·precomputed<>+0x10ed0(SB)/8, $0x7af4761e1425a060
-DATA ·precomputed<>+0x10ed8(SB)/8, $0xbfa0bf6ef8e80f35
-DATA ·precomputed<>+0x10ee0(SB)/8, $0x88e7629801e11b3e
-DATA ·precomputed<>+0x10ee8(SB)/8, $0x4b7ca488d92d8a4f
-DATA ·precomputed<>+0x10ef0(SB)/8, $0x247db98bcf4a9e6f
-DATA ·precomputed<>+0x10ef8(SB)/8, $0x8745cd1c9705b1f
-DATA ·precomputed<>+0x10f00(SB)/8, $0xa222a6407babeedd
-DATA ·precomputed<>+0x10f08(SB)/8, $0xa160535a5eee40b6
-DATA ·precomputed<>+0x10f10(SB)/8, $0x1a25996ecb4d7c34
-DATA ·precomputed<>+0x10f18(SB)/8, $0xdbedde0cb449ff6f
-DATA ·precomputed<>+0x10f20(SB)/8, $0xcb5cdf71f0a2fb03
-DATA ·precomputed<>+0x10f28(SB)/8, $0xf088d31a1bcc6a3a
-DATA ·precomputed<>+0x10f30(SB)/8, $0x7ca8258ca6b8041b
-DATA ·precomputed<>+0x10f38(SB)/8, $0x674cd073f4ebe238
-DATA ·precomputed<>+0x10f40(SB)/8, $0x8eb21025416b837c
-DATA ·precomputed<>+0x10f48(SB)/8, $0x1846f85d5b1eca6a
-DATA ·precomputed<>+0x10f50(SB)/8, $0x3140e350a4e259b1
-DATA ·precomputed<>+0x10f58(SB)/8, $0x44a91f37abcfc05b
-DATA ·precomputed<>+0x10f60(SB)/8, $0x81dd972f087d45df
-DATA ·precomputed<>+0x10f68(SB)/8, $0x5c250648a63e1a12
-DATA ·precomputed<>+0x10f70(SB)/8, $0xbdd55af6b48d94d6
-DATA ·precomputed<>+0x10f78(SB)/8, $0xaa609300a0aa44d3
-DATA ·precomputed<>+0x10f80(SB)/8, $0x9668049d94818be0
-DATA ·precomputed<>+0x10f88(SB)/8, $0x67cd01060173b5e0
-DATA ·precomputed<>+0x10f90(SB)/8, $0x32482fe8f0f79275
-DATA ·precomputed<>+0x10f98(SB)/8, $0x2a1ac27b27cc4b65
-DATA ·precomputed<>+0x10fa0(SB)/8, $0x82b1d2ec659e3d1d
-DATA ·precomputed<>+0x10fa8(SB)/8, $0xed5a8619c96c53b1
-DATA ·precomputed<>+0x10fb0(SB)/8, $0xf1d852bdf6ca5f56
-DATA ·precomputed<>+0x10fb8(SB)/8, $0x3d179bf31e3829f4
-DATA ·precomputed<>+0x10fc0(SB)/8, $0x7fe996a01539cf31
-DATA ·precomputed<>+0x10fc8(SB)/8, $0x4a3f729a0ded7c6e
-DATA ·precomputed<>+0x10fd0(SB)/8, $0x86f1f2993016f614
-DATA ·precomputed<>+0x10fd8(SB)/8, $0xc3d44e1886cb9163
-DATA ·precomputed<>+0x10fe0(SB)/8, $0x96984531558fa36c
-DATA ·precomputed<>+0x10fe8(SB)/8, $0x58e8bf05369c89d6
-DATA ·precomputed<>+0x10ff0(SB)/8, $0x287da114f9ee923f
-DATA ·precomputed<>+0x10ff8(SB)/8, $0x2032e984ec271fbc
-DATA ·precomputed<>+0x11000(SB)/8, $0x91b8579dd39207ad
-DATA ·precomputed<>+0x11008(SB)/8, $0x6f62c7250b1fe916
-DATA ·precomputed<>+0x11010(SB)/8, $0xf1599acd89e01bf
-DATA ·precomputed<>+0x11018(SB)/8, $0x8d9bb86d4d1e5843
-DATA ·precomputed<>+0x11020(SB)/8, $0x348b90d4726e38d1
-DATA ·precomputed<>+0x11028(SB)/8, $0xb824a1ca52a8c6b9
-DATA ·precomputed<>+0x11030(SB)/8, $0x984d9309b1d2f6f4
-DATA ·precomputed<>+0x11038(SB)/8, $0xefa485b7431ec12e
-DATA ·precomputed<>+0x11040(SB)/8, $0x9972560776ac1925
-DATA ·precomputed<>+0x11048(SB)/8, $0x86449db3442fc58
-DATA ·precomputed<>+0x11050(SB)/8, $0x8dbab9202e311e74
-DATA ·precomputed<>+0x11058(SB)/8, $0x29dee69b7ea25561
-DATA ·precomputed<>+0x11060(SB)/8, $0x5a62b6ee19a7cd6c
-DATA ·precomputed<>+0x11068(SB)/8, $0xba38cc4c0d0dd5a0
-DATA ·precomputed<>+0x11070(SB)/8, $0x779279e5166d0ff1
-DATA ·precomputed<>+0x11078(SB)/8, $0xeef53ccf48b3dae
-DATA ·precomputed<>+0x11080(SB)/8, $0x955b78408c7c6d89
-DATA ·precomputed<>+0x11088(SB)/8, $0xbfa78bc842c2555f
-DATA ·precomputed<>+0x11090(SB)/8, $0x8c56ae3da69c3165
-DATA ·precomputed<>+0x11098(SB)/8, $0x72b20e72e33bb1bc
-DATA ·precomputed<>+0x110a0(SB)/8, $0x686870b4d1aa6416
-DATA ·precomputed<>+0x110a8(SB)/8, $0xf000040b7db03cdd
-DATA ·precomputed<>+0x110b0(SB)/8, $0xd25b16a9b0a0fd40
-DATA ·precomputed<>+0x110b8(SB)/8, $0xeb89e93236815f1f
-DATA ·precomputed<>+0x110c0(SB)/8, $0x39b4393579f34a8a
-DATA ·precomputed<>+0x110c8(SB)/8, $0x61acf55f30046435
-DATA ·precomputed<>+0x110d0(SB)/8, $0xf0a23fe63f05fdb1
-DATA ·precomputed<>+0x110d8(SB)/8, $0x7d6baee10c4fa7ff
-DATA ·precomputed<>+0x110e0(SB)/8, $0x253f62b6e2daf735
-DATA ·precomputed<>+0x110e8(SB)/8, $0xe370ead82948637b
-DATA ·precomputed<>+0x110f0(SB)/8, $0xda57c16ad84e6206
-DATA ·precomputed<>+0x110f8(SB)/8, $0xf19ffe090dd22ad3
-DATA ·precomputed<>+0x11100(SB)/8, $0xfe64ed1e4263a566
-DATA ·precomputed<>+0x11108(SB)/8, $0x735bbee41d5e8f55
-DATA ·precomputed<>+0x11110(SB)/8, $0x9ac619158294342c
-DATA ·precomputed<>+0x11118(SB)/8, $0xf522e5ad4904935
-DATA ·precomputed<>+0x11120(SB)/8, $0x2ee883b57c1e11f4
-DATA ·precomputed<>+0x11128(SB)/8, $0xa2ce30ff0c237f4
-DATA ·precomputed<>+0x11130(SB)/8, $0xf4a7157b8d955086
-DATA ·precomputed<>+0x11138(SB)/8, $0x7ec0462e022dc2db
-DATA ·precomputed<>+0x11140(SB)/8, $0xae65a5c739620daa
-DATA ·precomputed<>+0x11148(SB)/8, $0x8ef6f93f6f7c078f
-DATA ·precomputed<>+0x11150(SB)/8, $0xb06d52bcb0177db8
-DATA ·precomputed<>+0x11158(SB)/8, $0x915cdd0868fdf535
-DATA ·precomputed<>+0x11160(SB)/8, $0x70d150c5183222
-DATA ·precomputed<>+0x11168(SB)/8, $0x2b6495cd7817a2ae
-DATA ·precomputed<>+0x11170(SB)/8, $0x3ce476140b194f0b
-DATA ·precomputed<>+0x11178(SB)/8, $0x2eec6acf513bfdfb
-DATA ·precomputed<>+0x11180(SB)/8, $0x2cd2814fd7daf836
-DATA ·precomputed<>+0x11188(SB)/8, $0x877b72b70d616922
-DATA ·precomputed<>+0x11190(SB)/8, $0xea73ca1bdb066012
-DATA ·precomputed<>+0x11198(SB)/8, $0xbe336c7bb0d4159d
-DATA ·precomputed<>+0x111a0(SB)/8, $0xb993b07f0f8fcd76
-DATA ·precomputed<>+0x111a8(SB)/8, $0x5fdceaba8a593562
-DATA ·precomputed<>+0x111b0(SB)/8, $0x716595fbf691ec19
-DATA ·precomputed<>+0x111b8(SB)/8, $0x51a77f618e68e3c0
-DATA ·precomputed<>+0x111c0(SB)/8, $0xd80f45a51d63aa7f
-DATA ·precomputed<>+0x111c8(SB)/8, $0x8d5eba75b3b32da2
-DATA ·precomputed<>+0x111d0(SB)/8, $0xef233dfa4708858
-DATA ·precomputed<>+0x111d8(SB)/8, $0x74c3f4f752161c61
-DATA ·precomputed<>+0x111e0(SB)/8, $0xfa9bfe6be6420de4
-DATA ·precomputed<>+0x111e8(SB)/8, $0x96c0c50197dd86d5
-DATA ·precomputed<>+0x111f0(SB)/8, $0x28e6827bcfce233b
-DATA ·precomputed<>+0x111f8(SB)/8, $0x35cc9a958e74d63
-DATA ·precomputed<>+0x11200(SB)/8, $0xe7e06ab7509a0a50
-DATA ·precomputed<>+0x11208(SB)/8, $0xbdf63778e4539885
-DATA ·precomputed<>+0x11210(SB)/8, $0xf888320f16ddb433
-DATA ·precomputed<>+0x11218(SB)/8, $0xf10830418e18998
-DATA ·precomputed<>+0x11220(SB)/8, $0x27e7ffd6fa834b14
-DATA ·precomputed<>+0x11228(SB)/8, $0x16de9a71c68b9434
-DATA ·precomputed<>+0x11230(SB)/8, $0x53a12e2c4d360436
-DATA ·precomputed<>+0x11238(SB)/8, $0x5ad2c9865e110b02
-DATA ·precomputed<>+0x11240(SB)/8, $0xbd3360d88b884857
-DATA ·precomputed<>+0x11248(SB)/8, $0x419041331b7c579
-DATA ·precomputed<>+0x11250(SB)/8, $0x40dd9229142cc145
-DATA ·precomputed<>+0x11258(SB)/8, $0xb5faab94dad0b2df
-DATA ·precomputed<>+0x11260(SB)/8, $0x3e7d792152df4831
-DATA ·precomputed<>+0x11268(SB)/8, $0xcf5bd1ed228bf67d
-DATA ·precomputed<>+0x11270(SB)/8, $0xd8669635c4980085
-DATA ·precomputed<>+0x11278(SB)/8, $0x94b89731c71903d
-DATA ·precomputed<>+0x11280(SB)/8, $0xcc02304de1c00144
-DATA ·precomputed<>+0x11288(SB)/8, $0x6269dfb9754734b2
-DATA ·precomputed<>+0x11290(SB)/8, $0x72e7a183f14fbc81
-DATA ·precomputed<>+0x11298(SB)/8, $0xd92a5b1c2a05caa2
-DATA ·precomputed<>+0x112a0(SB)/8, $0xd593492e15efc2fb
-DATA ·precomputed<>+0x112a8(SB)/8, $0x1ace7dcad8dd458b
-DATA ·precomputed<>+0x112b0(SB)/8, $0x576b4bc8aef2ae81
-DATA ·precomputed<>+0x112b8(SB)/8, $0x6de6a2db351b397e
-DATA ·precomputed<>+0x112c0(SB)/8, $0xf7ec89e335607bc4
-DATA ·precomputed<>+0x112c8(SB)/8, $0x17ca00ca9946bf52
-DATA ·precomputed<>+0x112d0(SB)/8, $0xee46be5b180c8bd8
-DATA ·precomputed<>+0x112d8(SB)/8, $0xd29d5eb0b2873893
-DATA ·precomputed<>+0x112e0(SB)/8, $0x348ac93997487b3c
-DATA ·precomputed<>+0x112e8(SB)/8, $0xc18f0cebfeef78ce
-DATA ·precomputed<>+0x112f0(SB)/8, $0xfc648dcaf797cce5
-DATA ·precomputed<>+0x112f8(SB)/8, $0xe2467e0c442148d7
-DATA ·precomputed<>+0x11300(SB)/8, $0x5fd84efd25282da8
-DATA ·precomputed<>+0x11308(SB)/8, $0xc1fc84c17682db7c
-DATA ·precomputed<>+0x11310(SB)/8, $0x8422b56a4bb291e6
-DATA ·precomputed<>+0x11318(SB)/8, $0xce379feb66a56078
-DATA ·precomputed<>+0x11320(SB)/8, $0x1c3c581ee7f045a0
-DATA ·precomputed<>+0x11328(SB)/8, $0x8f42985d6b3f89ac
-DATA ·precomputed<>+0x11330(SB)/8, $0x112839e9a6b2ba59
-DATA ·precomputed<>+0x11338(SB)/8, $0x3f0c7269c2a7b29a
-DATA ·precomputed<>+0x11340(SB)/8, $0x4bf367597cfb37cd
-DATA ·precomputed<>+0x11348(SB)/8, $0x94b0c7f0db7af2ed
-DATA ·precomputed<>+0x11350(SB)/8, $0x2f1bebf6ebf8b461
-DATA ·precomputed<>+0x11358(SB)/8, $0x90ebc9c7073e5c18
-DATA ·precomputed<>+0x11360(SB)/8, $0xe431c7933774e905
-DATA ·precomputed<>+0x11368(SB)/8, $0xb8a4bc2fe03265bb
-DATA ·precomputed<>+0x11370(SB)/8, $0xbee0825fabb629e
-DATA ·precomputed<>+0x11378(SB)/8, $0xbd1481dc84557170
-DATA ·precomputed<>+0x11380(SB)/8, $0xed8d840f51b07812
-DATA ·precomputed<>+0x11388(SB)/8, $0xd414a4a276530691
-DATA ·precomputed<>+0x11390(SB)/8, $0x9db9d1381468ef8d
-DATA ·precomputed<>+0x11398(SB)/8, $0xfc6b7434292b3870
-DATA ·precomputed<>+0x113a0(SB)/8, $0x80b66797c9d7ad96
-DATA ·precomputed<>+0x113a8(SB)/8, $0x81e74eb62a9c1e99
-DATA ·precomputed<>+0x113b0(SB)/8, $0x48657d9a9e92f64b
-DATA ·precomputed<>+0x113b8(SB)/8, $0xf5c600754c851ddd
-DATA ·precomputed<>+0x113c0(SB)/8, $0x22de997917a86e18
-DATA ·precomputed<>+0x113c8(SB)/8, $0xe2ac2321be029111
-DATA ·precomputed<>+0x113d0(SB)/8, $0xbfd3439735cc5a17
-DATA ·precomputed<>+0x113d8(SB)/8, $0x7a93461f525e13cf
-DATA ·precomputed<>+0x113e0(SB)/8, $0xd433542c5122d6f1
-DATA ·precomputed<>+0x113e8(SB)/8, $0x41d2d9de833982c7
-DATA ·precomputed<>+0x113f0(SB)/8, $0xe9f1f29a8ec24d27
-DATA ·precomputed<>+0x113f8(SB)/8, $0x4ae251f3f3b99d58
-DATA ·precomputed<>+0x11400(SB)/8, $0xbe13611903705145
-DATA ·precomputed<>+0x11408(SB)/8, $0xe6d1f720f94aadc7
-DATA ·precomputed<>+0x11410(SB)/8, $0x38ce1872255f5297
-DATA ·precomputed<>+0x11418(SB)/8, $0xbbba4793c3143f58
-DATA ·precomputed<>+0x11420(SB)/8, $0xda5345fe0984e265
-DATA ·precomputed<>+0x11428(SB)/8, $0xe93989d6d895e0d7
-DATA ·precomputed<>+0x11430(SB)/8, $0xb7392b18caab40a3
-DATA ·precomputed<>+0x11438(SB)/8, $0x4a58696365e754fc
-DATA ·precomputed<>+0x11440(SB)/8, $0x3b47563c175b4039
-DATA ·precomputed<>+0x11448(SB)/8, $0x53521dfd116b2025
-DATA ·precomputed<>+0x11450(SB)/8, $0xe4f3aa891a9f1cca
-DATA ·precomputed<>+0x11458(SB)/8, $0xcc73485ce7cb1d2b
-DATA ·precomputed<>+0x11460(SB)/8, $0xa6ca61efbf58fe30
-DATA ·precomputed<>+0x11468(SB)/8, $0x5d50e15d531a2b6a
-DATA ·precomputed<>+0x11470(SB)/8, $0x71cfdb4122611c31
-DATA ·precomputed<>+0x11478(SB)/8, $0xdc1553361e3d46a
-DATA ·precomputed<>+0x11480(SB)/8, $0x3572faaca5003eaa
-DATA ·precomputed<>+0x11488(SB)/8, $0x1e36500abf54df1
-DATA ·precomputed<>+0x11490(SB)/8, $0x6622f12fac6f3af7
-DATA ·precomputed<>+0x11498(SB)/8, $0xb5d7c17f0a8bb122
-DATA ·precomputed<>+0x114a0(SB)/8, $0xd1fc1b99240286b1
-DATA ·precomputed<>+0x114a8(SB)/8, $0x519d52cead968ede
-DATA ·precomputed<>+0x114b0(SB)/8, $0xcd1e7d0cece28bb4
-DATA ·precomputed<>+0x114b8(SB)/8, $0x64ffc69993f0b097
-DATA ·precomputed<>+0x114c0(SB)/8, $0x74c2696b7d8a042a
-DATA ·precomputed<>+0x114c8(SB)/8, $0xcf4908c354230ded
-DATA ·precomputed<>+0x114d0(SB)/8, $0x98a870d8db32943b
-DATA ·precomputed<>+0x114d8(SB)/8, $0x181cbe5c52f33e07
-DATA ·precomputed<>+0x114e0(SB)/8, $0x4d9d117293709d8b
-DATA ·precomputed<>+0x114e8(SB)/8, $0xb800c2922b2b7939
-DATA ·precomputed<>+0x114f0(SB)/8, $0xd5a1fb7aa8920f60
-DATA ·precomputed<>+0x114f8(SB)/8, $0x8d0a7725bf7df622
-DATA ·precomputed<>+0x11500(SB)/8, $0x13594ae5720a5b3f
-DATA ·precomputed<>+0x11508(SB)/8, $0x38c4e04a2e65454c
-DATA ·precomputed<>+0x11510(SB)/8, $0xc5b55d9855d0d903
-DATA ·precomputed<>+0x11518(SB)/8, $0xfabeb890f72db301
-DATA ·precomputed<>+0x11520(SB)/8, $0xe471f1d52f3deaa2
-DATA ·precomputed<>+0x11528(SB)/8, $0x3f8d39f5c5ade08d
-DATA ·precomputed<>+0x11530(SB)/8, $0xe08486a46baf9d1a
-DATA ·precomputed<>+0x11538(SB)/8, $0x77c6d30ee5140d3b
-DATA ·precomputed<>+0x11540(SB)/8, $0x1016908024108055
-DATA ·precomputed<>+0x11548(SB)/8, $0xfe0d9cb038961403
-DATA ·precomputed<>+0x11550(SB)/8, $0xf88e6a48ceb87d29
-DATA ·precomputed<>+0x11558(SB)/8, $0x365ca2f69618944
-DATA ·precomputed<>+0x11560(SB)/8, $0x1504647e9fb59ec9
-DATA ·precomputed<>+0x11568(SB)/8, $0xb6486b3ba4aadbb7
-DATA ·precomputed<>+0x11570(SB)/8, $0xfe8701af22ef3214
-DATA ·precomputed<>+0x11578(SB)/8, $0x4c895bc15f56d985
-DATA ·precomputed<>+0x11580(SB)/8, $0x401cfb896842ab16
-DATA ·precomputed<>+0x11588(SB)/8, $0x440fb52d5b2eb304
-DATA ·precomputed<>+0x11590(SB)/8, $0x3245fd38d22eaa61
-DATA ·precomputed<>+0x11598(SB)/8, $0x252120e8373f201e
-DATA ·precomputed<>+0x115a0(SB)/8, $0x4d253f5cb2e724c9
-DATA ·precomputed<>+0x115a8(SB)/8, $0x9428d6be27e5b5e4
-DATA ·precomputed<>+0x115b0(SB)/8, $0xd4c5986785ee9c
-DATA ·precomputed<>+0x115b8(SB)/8, $0xb7fc5f956208d4b
-DATA ·precomputed<>+0x115c0(SB)/8, $0x3553633abfb6a2fd
-DATA ·precomputed<>+0x115c8(SB)/8, $0x6533515a0c9ce9a
-DATA ·precomputed<>+0x115d0(SB)/8, $0x6decd9e804c71728
-DATA ·precomputed<>+0x115d8(SB)/8, $0xcbc0df55980b29bd
-DATA ·precomputed<>+0x115e0(SB)/8, $0x17b1b55931826d15
-DATA ·precomputed<>+0x115e8(SB)/8, $0xc96ed7d71c5cae17
-DATA ·precomputed<>+0x115f0(SB)/8, $0x24f5874088cda83e
-DATA ·precomputed<>+0x115f8(SB)/8, $0x9e2ee1bc0c69f40f
-DATA ·precomputed<>+0x11600(SB)/8, $0x21701393fb778d2f
-DATA ·precomputed<>+0x11608(SB)/8, $0xd46bc61e6441fd75
-DATA ·precomputed<>+0x11610(SB)/8, $0x466671de135b55bc
-DATA ·precomputed<>+0x11618(SB)/8, $0xee1d9cbb51c0f145
-DATA ·precomputed<>+0x11620(SB)/8, $0x7a7bce676d9ce27c
-DATA ·precomputed<>+0x11628(SB)/8, $0xa8c9b1e026d82b1d
-DATA ·precomputed<>+0x11630(SB)/8, $0x250bee034c87bd6e
-DATA ·precomputed<>+0x11638(SB)/8, $0xd6b02f71d3829702
-DATA ·precomputed<>+0x11640(SB)/8, $0x6b9341388d601e5
-DATA ·precomputed<>+0x11648(SB)/8, $0xe996e8776c4483c
-DATA ·precomputed<>+0x11650(SB)/8, $0xe2ff3a6d00eb0c21
-DATA ·precomputed<>+0x11658(SB)/8, $0x86ec3a73f4690674
-DATA ·precomputed<>+0x11660(SB)/8, $0x673d123ee9f82ca7
-DATA ·precomputed<>+0x11668(SB)/8, $0x952c2765bf611d0c
-DATA ·precomputed<>+0x11670(SB)/8, $0x26ed9a683676497d
-DATA ·precomputed<>+0x11678(SB)/8, $0x2c9c00499d29cefc
-DATA ·precomputed<>+0x11680(SB)/8, $0xc7774c121374972a
-DATA ·precomputed<>+0x11688(SB)/8, $0xc735596692764b41
-DATA ·precomputed<>+0x11690(SB)/8, $0x31c10ea523143092
-DATA ·precomputed<>+0x11698(SB)/8, $0xe89d9f889070137f
-DATA ·precomputed<>+0x116a0(SB)/8, $0x7d074406a504d91e
-DATA ·precomputed<>+0x116a8(SB)/8, $0xc521037970b7aa8c
-DATA ·precomputed<>+0x116b0(SB)/8, $0xa67904f8ea799dd8
-DATA ·precomputed<>+0x116b8(SB)/8, $0x81e6516be7b02c04
-DATA ·precomputed<>+0x116c0(SB)/8, $0x4adec20ab616aa15
-DATA ·precomputed<>+0x116c8(SB)/8, $0x99f77e49ea085548
-DATA ·precomputed<>+0x116d0(SB)/8, $0x9108c205c01b9a33
-DATA ·precomputed<>+0x116d8(SB)/8, $0x298fbeb16ef3bcef
-DATA ·precomputed<>+0x116e0(SB)/8, $0xdf1a8d2eefd8ba0e
-DATA ·precomputed<>+0x116e8(SB)/8, $0xf0ec9492e9756e7b
-DATA ·precomputed<>+0x116f0(SB)/8, $0x4fd333897ff5fbc3
-DATA ·precomputed<>+0x116f8(SB)/8, $0x122a6bfb03ac8371
-DATA ·precomputed<>+0x11700(SB)/8, $0xa55cee46a7fabcf5
-DATA ·precomputed<>+0x11708(SB)/8, $0xd16a8b9279c8fbce
-DATA ·precomputed<>+0x11710(SB)/8, $0x26ad700bcbf048bf
-DATA ·precomputed<>+0x11718(SB)/8, $0x83b3ce1147bb5f1d
-DATA ·precomputed<>+0x11720(SB)/8, $0x31a48f466b310318
-DATA ·precomputed<>+0x11728(SB)/8, $0x13a7f78100612ef3
-DATA ·precomputed<>+0x11730(SB)/8, $0xcd840f2aa18db234
-DATA ·precomputed<>+0x11738(SB)/8, $0x3be2a7a830611c74
-DATA ·precomputed<>+0x11740(SB)/8, $0xc9e6ca97483baf0f
-DATA ·precomputed<>+0x11748(SB)/8, $0x9b063bff9bf5055
-DATA ·precomputed<>+0x11750(SB)/8, $0x8c4c6b2afc5a407f
-DATA ·precomputed<>+0x11758(SB)/8, $0xe29cb48799a6f540
-DATA ·precomputed<>+0x11760(SB)/8, $0x18b72239cb9a2758
-DATA ·precomputed<>+0x11768(SB)/8, $0xa0ae9f108a5ed308
-DATA ·precomputed<>+0x11770(SB)/8, $0x2a2cb6036e402657
-DATA ·precomputed<>+0x11778(SB)/8, $0x9c7f52cfaf6f4d14
-DATA ·precomputed<>+0x11780(SB)/8, $0x35537f86aece96b6
-DATA ·precomputed<>+0x11788(SB)/8, $0x83aa1df963f7e1fa
-DATA ·precomputed<>+0x11790(SB)/8, $0xa39ab4aa7ac4aaf2
-DATA ·precomputed<>+0x11798(SB)/8, $0xb8d0ffa68a202375
-DATA ·precomputed<>+0x117a0(SB)/8, $0xd916df0986514cd8
-DATA ·precomputed<>+0x117a8(SB)/8, $0x71f905b311902747
-DATA ·precomputed<>+0x117b0(SB)/8, $0x6388c2ee8c744f32
-DATA ·precomputed<>+0x117b8(SB)/8, $0x6282e1f5a5431096
-DATA ·precomputed<>+0x117c0(SB)/8, $0x402da46047920742
-DATA ·precomputed<>+0x117c8(SB)/8, $0xb142d6efb45f94cc
-DATA ·precomputed<>+0x117d0(SB)/8, $0xc2d613e876608dd4
-DATA ·precomputed<>+0x117d8(SB)/8, $0xa2c06cdd5d75d4b5
-DATA ·precomputed<>+0x117e0(SB)/8, $0xa1951bc53c564ff4
-DATA ·precomputed<>+0x117e8(SB)/8, $0xe60f126bad1d5ecd
-DATA ·precomputed<>+0x117f0(SB)/8, $0xa634e765702135ad
-DATA ·precomputed<>+0x117f8(SB)/8, $0xa5a56a6e8df44695
-DATA ·precomputed<>+0x11800(SB)/8, $0x234b8c7a9e2207b4
-DATA ·precomputed<>+0x11808(SB)/8, $0x1f724f30f7ee9f62
-DATA ·precomputed<>+0x11810(SB)/8, $0xfa908ca2c58e21b6
-DATA ·precomputed<>+0x11818(SB)/8, $0x55587744a74296ae
-DATA ·precomputed<>+0x11820(SB)/8, $0x7dbe913002911ae1
-DATA ·precomputed<>+0x11828(SB)/8, $0xc20754339d3af02e
-DATA ·precomputed<>+0x11830(SB)/8, $0x505b724b0f3955a1
-DATA ·precomputed<>+0x11838(SB)/8, $0x480e1a92caeced66
-DATA ·precomputed<>+0x11840(SB)/8, $0xb20f6128446d9f66
-DATA ·precomputed<>+0x11848(SB)/8, $0xd6e06b14c354b5a1
-DATA ·precomputed<>+0x11850(SB)/8, $0xa72d287d63558aac
-DATA ·precomputed<>+0x11858(SB)/8, $0x4819be29ae68a8fd
-DATA ·precomputed<>+0x11860(SB)/8, $0xb024c324205fbdf2
-DATA ·precomputed<>+0x11868(SB)/8, $0x2fca94e7210927f9
-DATA ·precomputed<>+0x11870(SB)/8, $0x74798be7be658f80
-DATA ·precomputed<>+0x11878(SB)/8, $0x618e07f1ef07c046
-DATA ·precomputed<>+0x11880(SB)/8, $0xfba715fcb35a8c3d
-DATA ·precomputed<>+0x11888(SB)/8, $0xc2548193ed1beba8
-DATA ·precomputed<>+0x11890(SB)/8, $0xb956c6dd2ceb663c
-DATA ·precomputed<>+0x11898(SB)/8, $0x13d4ddbeaacafe85
-DATA ·precomputed<>+0x118a0(SB)/8, $0x2f8275b530a29cc3
-DATA ·precomputed<>+0x118a8(SB)/8, $0x10432e15f51b39ef
-DATA ·precomputed<>+0x118b0(SB)/8, $0xd6c9277c2509b2d0
-DATA ·precomputed<>+0x118b8(SB)/8, $0x4ee0d4c3849b946c
-DATA ·precomputed<>+0x118c0(SB)/8, $0x547ba94654b01bbc
-DATA ·precomputed<>+0x118c8(SB)/8, $0x7c56c36d055d4821
-DATA ·precomputed<>+0x118d0(SB)/8, $0x8e93362005575f20
-DATA ·precomputed<>+0x118d8(SB)/8, $0xaec65be93a621cf4
-DATA ·precomputed<>+0x118e0(SB)/8, $0x820b96df46287937
-DATA ·precomputed<>+0x118e8(SB)/8, $0x35cea883733c67e7
-DATA ·precomputed<>+0x118f0(SB)/8, $0x30366a3a58cf3e05
-DATA ·precomputed<>+0x118f8(SB)/8, $0x2580d5652da39773
-DATA ·precomputed<>+0x11900(SB)/8, $0x7717c42fba4417ed
-DATA ·precomputed<>+0x11908(SB)/8, $0xb2d66fc7654c1086
-DATA ·precomputed<>+0x11910(SB)/8, $0x7fe918e57503cd8
-DATA ·precomputed<>+0x11918(SB)/8, $0xf93851593cacf74f
-DATA ·precomputed<>+0x11920(SB)/8, $0x157d908163063029
-DATA ·precomputed<>+0x11928(SB)/8, $0x79c84c08659034cf
-DATA ·precomputed<>+0x11930(SB)/8, $0x2976610a8048cb9
-DATA ·precomputed<>+0x11938(SB)/8, $0xef82200603e81417
-DATA ·precomputed<>+0x11940(SB)/8, $0x5fb5dd4d22e489c6
-DATA ·precomputed<>+0x11948(SB)/8, $0x9a06d9c281e167e9
-DATA ·precomputed<>+0x11950(SB)/8, $0x83fc248f6b974c90
-DATA ·precomputed<>+0x11958(SB)/8, $0xb78cab727110dca6
-DATA ·precomputed<>+0x11960(SB)/8, $0x73f8f311370ff66a
-DATA ·precomputed<>+0x11968(SB)/8, $0x8c5049eb3b61d20f
-DATA ·precomputed<>+0x11970(SB)/8, $0xaac47edbc8516e05
-DATA ·precomputed<>+0x11978(SB)/8, $0x2ceba50d53f0201b
-DATA ·precomputed<>+0x11980(SB)/8, $0x6679dc5e0b93fbc7
-DATA ·precomputed<>+0x11988(SB)/8, $0xf4457919a560bd27
-DATA ·precomputed<>+0x11990(SB)/8, $0x2561bfcab1acadc9
-DATA ·precomputed<>+0x11998(SB)/8, $0x338fbb6d46708164
-DATA ·precomputed<>+0x119a0(SB)/8, $0x9f4076218b9cfd27
-DATA ·precomputed<>+0x119a8(SB)/8, $0xe806c1e6d3123732
-DATA ·precomputed<>+0x119b0(SB)/8, $0xaa1eafc47f24a161
-DATA ·precomputed<>+0x119b8(SB)/8, $0xbee3f4a168e6650b
-DATA ·precomputed<>+0x119c0(SB)/8, $0x453b61815832cd6c
-DATA ·precomputed<>+0x119c8(SB)/8, $0xc002e337985e90ba
-DATA ·precomputed<>+0x119d0(SB)/8, $0x4b33afde6414f686
-DATA ·precomputed<>+0x119d8(SB)/8, $0xf9ab29e98511fd45
-DATA ·precomputed<>+0x119e0(SB)/8, $0x67f09726fb9a688
-DATA ·precomputed<>+0x119e8(SB)/8, $0x7db6e14c7202a1b3
-DATA ·precomputed<>+0x119f0(SB)/8, $0xc15b6e973a881ab
-DATA ·precomputed<>+0x119f8(SB)/8, $0xc8c324e0fad10660
-DATA ·precomputed<>+0x11a00(SB)/8, $0xa997a6d287d9f927
-DATA ·precomputed<>+0x11a08(SB)/8, $0x62307f24acd2f107
-DATA ·precomputed<>+0x11a10(SB)/8, $0xed7b48a59c80a742
-DATA ·precomputed<>+0x11a18(SB)/8, $0xecd33ae5a7c81e7e
-DATA ·precomputed<>+0x11a20(SB)/8, $0xcf05c763efa00a94
-DATA ·precomputed<>+0x11a28(SB)/8, $0x38844b0dd9ee5aa7
-DATA ·precomputed<>+0x11a30(SB)/8, $0x2e0b05d214b293f
-DATA ·precomputed<>+0x11a38(SB)/8, $0x732e776b8a8a510e
-DATA ·precomputed<>+0x11a40(SB)/8, $0x784cd9096c929e53
-DATA ·precomputed<>+0x11a48(SB)/8, $0xe436e29456a33da2
-DATA ·precomputed<>+0x11a50(SB)/8, $0x68eeb727ce9e06d2
-DATA ·precomputed<>+0x11a58(SB)/8, $0x637890b3fce7e2f0
-DATA ·precomputed<>+0x11a60(SB)/8, $0xc0681a1cc3fde38c
-DATA ·precomputed<>+0x11a68(SB)/8, $0x9cb729d976dda134
-DATA ·precomputed<>+0x11a70(SB)/8, $0xaa69eb975c333ece
-DATA ·precomputed<>+0x11a78(SB)/8, $0xe138a68048eed8a6
-DATA ·precomputed<>+0x11a80(SB)/8, $0xd53cbd01505dc701
-DATA ·precomputed<>+0x11a88(SB)/8, $0x413de3466a64c3d1
-DATA ·precomputed<>+0x11a90(SB)/8, $0x91f6cde93170a5bf
-DATA ·precomputed<>+0x11a98(SB)/8, $0x58ffdfd98489b5fa
-DATA ·precomputed<>+0x11aa0(SB)/8, $0xcc0b89d75c584a48
-DATA ·precomputed<>+0x11aa8(SB)/8, $0x74f8ceed167f28de
-DATA ·precomputed<>+0x11ab0(SB)/8, $0x250fa9f78c878c91
-DATA ·precomputed<>+0x11ab8(SB)/8, $0xeb960a79630adfdb
-DATA ·precomputed<>+0x11ac0(SB)/8, $0xe43ed412c9489dcb
-DATA ·precomputed<>+0x11ac8(SB)/8, $0xcec053a5112d084b
-DATA ·precomputed<>+0x11ad0(SB)/8, $0xfd4fe42664c7cd2
-DATA ·precomputed<>+0x11ad8(SB)/8, $0x48ee06f482a917f7
-DATA ·precomputed<>+0x11ae0(SB)/8, $0xc5de197083cd65f5
-DATA ·precomputed<>+0x11ae8(SB)/8, $0x3a1765785569c42f
-DATA ·precomputed<>+0x11af0(SB)/8, $0xf24508f34f876110
-DATA ·precomputed<>+0x11af8(SB)/8, $0xf350374b0a415bc4
-DATA ·precomputed<>+0x11b00(SB)/8, $0x9c2b11c2c63aa8ad
-DATA ·precomputed<>+0x11b08(SB)/8, $0x6ac1ae127a51c0cc
-DATA ·precomputed<>+0x11b10(SB)/8, $0x75acd0d7e1db428f
-DATA ·precomputed<>+0x11b18(SB)/8, $0x9e39122719800684
-DATA ·precomputed<>+0x11b20(SB)/8, $0x4f89e9c6b1050426
-DATA ·precomputed<>+0x11b28(SB)/8, $0x99d97ccdaf99eee
-DATA ·precomputed<>+0x11b30(SB)/8, $0x27a19ad01ffce97f
-DATA ·precomputed<>+0x11b38(SB)/8, $0x5fad0573c038d77
-DATA ·precomputed<>+0x11b40(SB)/8, $0x96d6c6788597d09c
-DATA ·precomputed<>+0x11b48(SB)/8, $0x38f6336c1e1d8b57
-DATA ·precomputed<>+0x11b50(SB)/8, $0x1f945bef6330ace7
-DATA ·precomputed<>+0x11b58(SB)/8, $0x9d627bbb613f9faf
-DATA ·precomputed<>+0x11b60(SB)/8, $0xc0e7f21b19176cb7
-DATA ·precomputed<>+0x11b68(SB)/8, $0xf0e09be3bb9db710
-DATA ·precomputed<>+0x11b70(SB)/8, $0x16b06011c650133c
-DATA ·precomputed<>+0x11b78(SB)/8, $0x8629b975f3673d7c
-DATA ·precomputed<>+0x11b80(SB)/8, $0x8c8230b267115575
-DATA ·precomputed<>+0x11b88(SB)/8, $0x9bfc55b19c9f30c0
-DATA ·precomputed<>+0x11b90(SB)/8, $0x132d0e07ce93fd71
-DATA ·precomputed<>+0x11b98(SB)/8, $0x8e4736e511c5947
-DATA ·precomputed<>+0x11ba0(SB)/8, $0xd54a098efe881630
-DATA ·precomputed<>+0x11ba8(SB)/8, $0x8ec67a8598efa501
-DATA ·precomputed<>+0x11bb0(SB)/8, $0x72975dc72267db00
-DATA ·precomputed<>+0x11bb8(SB)/8, $0x3d6fc706a338290d
-DATA ·precomputed<>+0x11bc0(SB)/8, $0xc89108255ade88b
-DATA ·precomputed<>+0x11bc8(SB)/8, $0x525b5014b212e85
-DATA ·precomputed<>+0x11bd0(SB)/8, $0x9ede010bb61362fa
-DATA ·precomputed<>+0x11bd8(SB)/8, $0x52f3d088881eecac
-DATA ·precomputed<>+0x11be0(SB)/8, $0x49957b6ebc6f0ae4
-DATA ·precomputed<>+0x11be8(SB)/8, $0x25fe72631659701d
-DATA ·precomputed<>+0x11bf0(SB)/8, $0x41e9b7f507b76f45
-DATA ·precomputed<>+0x11bf8(SB)/8, $0x5f2ad664bda77d42
-DATA ·precomputed<>+0x11c00(SB)/8, $0x5bdcb490a9c418c4
-DATA ·precomputed<>+0x11c08(SB)/8, $0xd0e2c38de500a527
-DATA ·precomputed<>+0x11c10(SB)/8, $0xaf29f6bca83fada
-DATA ·precomputed<>+0x11c18(SB)/8, $0x1f75b1f262273db6
-DATA ·precomputed<>+0x11c20(SB)/8, $0x8454f7519e857e57
-DATA ·precomputed<>+0x11c28(SB)/8, $0x3fb816d1b9e79612
-DATA ·precomputed<>+0x11c30(SB)/8, $0xbe3040ae6412b5f3
-DATA ·precomputed<>+0x11c38(SB)/8, $0x99535136843ca984
-DATA ·precomputed<>+0x11c40(SB)/8, $0xb26ec8a83257f19d
-DATA ·precomputed<>+0x11c48(SB)/8, $0xd32dc622e54dd785
-DATA ·precomputed<>+0x11c50(SB)/8, $0xc52e874f8698ab5
-DATA ·precomputed<>+0x11c58(SB)/8, $0xf9a60af579183316
-DATA ·precomputed<>+0x11c60(SB)/8, $0x38575d53f7f4496a
-DATA ·precomputed<>+0x11c68(SB)/8, $0x33adfd1e3d5cd0de
-DATA ·precomputed<>+0x11c70(SB)/8, $0x2133f4a17f502017
-DATA ·precomputed<>+0x11c78(SB)/8, $0x46c093933e8676f8
-DATA ·precomputed<>+0x11c80(SB)/8, $0xca8a5a583fb4c7fe
-DATA ·precomputed<>+0x11c88(SB)/8, $0x2ad58826328ff257
-DATA ·precomputed<>+0x11c90(SB)/8, $0xd926487513b8d08d
-DATA ·precomputed<>+0x11c98(SB)/8, $0x661ae2b2dc5a845a
-DATA ·precomputed<>+0x11ca0(SB)/8, $0xd2dcaa0649a408d3
-DATA ·precomputed<>+0x11ca8(SB)/8, $0x9ef164f885c21e84
-DATA ·precomputed<>+0x11cb0(SB)/8, $0x55efaf85b7819b61
-DATA ·precomputed<>+0x11cb8(SB)/8, $0x9488bb1cf504c32a
-DATA ·precomputed<>+0x11cc0(SB)/8, $0xb571518c9bb777fc
-DATA ·precomputed<>+0x11cc8(SB)/8, $0xf369c39182415187
-DATA ·precomputed<>+0x11cd0(SB)/8, $0x2d7c5dd92720284b
-DATA ·precomputed<>+0x11cd8(SB)/8, $0x6feab6344eec41cc
-DATA ·precomputed<>+0x11ce0(SB)/8, $0x2522d5db24ecd0be
-DATA ·precomputed<>+0x11ce8(SB)/8, $0x1fca0d9700338736
-DATA ·precomputed<>+0x11cf0(SB)/8, $0x7441610520145279
-DATA ·precomputed<>+0x11cf8(SB)/8, $0xf4492e1e0496e925
-DATA ·precomputed<>+0x11d00(SB)/8, $0xa62e09fcbc33b547
-DATA ·precomputed<>+0x11d08(SB)/8, $0x9434475aae063835
-DATA ·precomputed<>+0x11d10(SB)/8, $0x51edd69fa139b769
-DATA ·precomputed<>+0x11d18(SB)/8, $0x17bbe224e5166a9d
-DATA ·precomputed<>+0x11d20(SB)/8, $0x6ecb0a021b4b6c84
-DATA ·precomputed<>+0x11d28(SB)/8, $0x1643949097968c70
-DATA ·precomputed<>+0x11d30(SB)/8, $0x75af0456bc8aa671
-DATA ·precomputed<>+0x11d38(SB)/8, $0xaef056ab3b4411ff
-DATA ·precomputed<>+0x11d40(SB)/8, $0x686b771405cef121
-DATA ·precomputed<>+0x11d48(SB)/8, $0x5ad6bdf3078f4500
-DATA ·precomputed<>+0x11d50(SB)/8, $0x56df858c072e70eb
-DATA ·precomputed<>+0x11d58(SB)/8, $0xa0fc5e6f254c0917
-DATA ·precomputed<>+0x11d60(SB)/8, $0x1a99de09c15bf9cf
-DATA ·precomputed<>+0x11d68(SB)/8, $0x8aeb587ad008aacb
-DATA ·precomputed<>+0x11d70(SB)/8, $0xba2d8c53b900d652
-DATA ·precomputed<>+0x11d78(SB)/8, $0x60eb5d0cad0f69b6
-DATA ·precomputed<>+0x11d80(SB)/8, $0x27098ff810b27762
-DATA ·precomputed<>+0x11d88(SB)/8, $0x33329ca913264ed3
-DATA ·precomputed<>+0x11d90(SB)/8, $0xffceaf40887e3f40
-DATA ·precomputed<>+0x11d98(SB)/8, $0x854b8284930df9ef
-DATA ·precomputed<>+0x11da0(SB)/8, $0xdda913a87d5627be
-DATA ·precomputed<>+0x11da8(SB)/8, $0x8eb94d6435e9823b
-DATA ·precomputed<>+0x11db0(SB)/8, $0x94c527fd2eb9e9bd
-DATA ·precomputed<>+0x11db8(SB)/8, $0x18335b1bf57b9f74
-DATA ·precomputed<>+0x11dc0(SB)/8, $0x3c44dac0d193a526
-DATA ·precomputed<>+0x11dc8(SB)/8, $0xd0717099e2b2d54f
-DATA ·precomputed<>+0x11dd0(SB)/8, $0x65624fb4c4c67d11
-DATA ·precomputed<>+0x11dd8(SB)/8, $0x4aa7033bccedad8
-DATA ·precomputed<>+0x11de0(SB)/8, $0xc522fac31470c52
-DATA ·precomputed<>+0x11de8(SB)/8, $0x8eb33ca33b05d54
-DATA ·precomputed<>+0x11df0(SB)/8, $0x940e0693b4565e57
-DATA ·precomputed<>+0x11df8(SB)/8, $0x7e2fd5537be56370
-DATA ·precomputed<>+0x11e00(SB)/8, $0xf722793c12e206ff
-DATA ·precomputed<>+0x11e08(SB)/8, $0xb57e1f23155d3d02
-DATA ·precomputed<>+0x11e10(SB)/8, $0xd68be19394fc6ce3
-DATA ·precomputed<>+0x11e18(SB)/8, $0xb0f3606c22d4815b
-DATA ·precomputed<>+0x11e20(SB)/8, $0xdaf995a9b62d5741
-DATA ·precomputed<>+0x11e28(SB)/8, $0xa7d199803e8f5df5
-DATA ·precomputed<>+0x11e30(SB)/8, $0x7bcdb661e4631583
-DATA ·precomputed<>+0x11e38(SB)/8, $0x13193e35a97dc7e
-DATA ·precomputed<>+0x11e40(SB)/8, $0x8210be46fc73494b
-DATA ·precomputed<>+0x11e48(SB)/8, $0x508817ee57e10efc
-DATA ·precomputed<>+0x11e50(SB)/8, $0x7b6b8da2126f2466
-DATA ·precomputed<>+0x11e58(SB)/8, $0x2f3b0ec6177bee35
-DATA ·precomputed<>+0x11e60(SB)/8, $0x5ceb71e048db1eef
-DATA ·precomputed<>+0x11e68(SB)/8, $0xd989d9c3dc62b6bc
-DATA ·precomputed<>+0x11e70(SB)/8, $0x2cc38cb9f78fac92
-DATA ·precomputed<>+0x11e78(SB)/8, $0xcd2a009f955ba5f4
-DATA ·precomputed<>+0x11e80(SB)/8, $0x65a74191453b668e
-DATA ·precomputed<>+0x11e88(SB)/8, $0x40e9dc38c081b447
-DATA ·precomputed<>+0x11e90(SB)/8, $0x48eb63bf8c3fdf2c
-DATA ·precomputed<>+0x11e98(SB)/8, $0x7845cf665763071a
-DATA ·precomputed<>+0x11ea0(SB)/8, $0x30d9b771787754ca
-DATA ·precomputed<>+0x11ea8(SB)/8, $0x10b3729f8783a05a
-DATA ·precomputed<>+0x11eb0(SB)/8, $0xf8090b3b6ab570d9
-DATA ·precomputed<>+0x11eb8(SB)/8, $0xc1dfbde1502b3558
-DATA ·precomputed<>+0x11ec0(SB)/8, $0xa568f5d0be4d36ec
-DATA ·precomputed<>+0x11ec8(SB)/8, $0x1e9e539328e952fe
-DATA ·precomputed<>+0x11ed0(SB)/8, $0x768113f9eaad6b24
-DATA ·precomputed<>+0x11ed8(SB)/8, $0x2bc798fca8fbede9
-DATA ·precomputed<>+0x11ee0(SB)/8, $0x2c787f9baaa9010d
-DATA ·precomputed<>+0x11ee8(SB)/8, $0x32cbc77a761834ca
-DATA ·precomputed<>+0x11ef0(SB)/8, $0x449e55f7032c25d8
-DATA ·precomputed<>+0x11ef8(SB)/8, $0xe824a5bfca6216dd
-DATA ·precomputed<>+0x11f00(SB)/8, $0x3beaec5217ac1130
-DATA ·precomputed<>+0x11f08(SB)/8, $0xcc28c64b5e9d1aa8
-DATA ·precomputed<>+0x11f10(SB)/8, $0x355d68bf3af8c635
-DATA ·precomputed<>+0x11f18(SB)/8, $0xcd12e443d3d1adaa
-DATA ·precomputed<>+0x11f20(SB)/8, $0xa456daca6c2030d8
-DATA ·precomputed<>+0x11f28(SB)/8, $0xdfe5bbb62427eff
-DATA ·precomputed<>+0x11f30(SB)/8, $0xae45325df69e2484
-DATA ·precomputed<>+0x11f38(SB)/8, $0xfc2a90ab7245c979
-DATA ·precomputed<>+0x11f40(SB)/8, $0xc34f38e9a008b446
-DATA ·precomputed<>+0x11f48(SB)/8, $0x5e86163daac355e0
-DATA ·precomputed<>+0x11f50(SB)/8, $0x61432646d586a2fa
-DATA ·precomputed<>+0x11f58(SB)/8, $0xc68c7c8e3d92e0c3
-DATA ·precomputed<>+0x11f60(SB)/8, $0xbfa8c268020c1dd6
-DATA ·precomputed<>+0x11f68(SB)/8, $0x257887418bbcc223
-DATA ·precomputed<>+0x11f70(SB)/8, $0xbaf8c9a3ef62642c
-DATA ·precomputed<>+0x11f78(SB)/8, $0x6d2f1ae5a8c496d2
-DATA ·precomputed<>+0x11f80(SB)/8, $0x92d1c80544e57ce4
-DATA ·precomputed<>+0x11f88(SB)/8, $0x34cdf4a3aacd2100
-DATA ·precomputed<>+0x11f90(SB)/8, $0xd5b89e4d31e9c649
-DATA ·precomputed<>+0x11f98(SB)/8, $0x558a6e26232cfc85
-DATA ·precomputed<>+0x11fa0(SB)/8, $0xb40f3e4acea31a31
-DATA ·precomputed<>+0x11fa8(SB)/8, $0x346c1e0735c5c924
-DATA ·precomputed<>+0x11fb0(SB)/8, $0x8ffedd8e3fcede81
-DATA ·precomputed<>+0x11fb8(SB)/8, $0x35269ba33b55d143
-DATA ·precomputed<>+0x11fc0(SB)/8, $0x366065a848bdc53
-DATA ·precomputed<>+0x11fc8(SB)/8, $0xba2af074078554dd
-DATA ·precomputed<>+0x11fd0(SB)/8, $0x3c755fba19ff3b4d
-DATA ·precomputed<>+0x11fd8(SB)/8, $0x5ea9337235a22cbb
-DATA ·precomputed<>+0x11fe0(SB)/8, $0xe55fe021eb3e23b
-DATA ·precomputed<>+0x11fe8(SB)/8, $0x2626ecca765dede4
-DATA ·precomputed<>+0x11ff0(SB)/8, $0x187bf09481f445da
-DATA ·precomputed<>+0x11ff8(SB)/8, $0xba0110179df30578
-DATA ·precomputed<>+0x12000(SB)/8, $0x1ae4ee9a1baea574
-DATA ·precomputed<>+0x12008(SB)/8, $0xd922f2803ae5168
-DATA ·precomputed<>+0x12010(SB)/8, $0x7df28fdca691124
-DATA ·precomputed<>+0x12018(SB)/8, $0x5aad2f318dd457c4
-DATA ·precomputed<>+0x12020(SB)/8, $0xe0d4f443137384ca
-DATA ·precomputed<>+0x12028(SB)/8, $0xd93d424a6620ea8c
-DATA ·precomputed<>+0x12030(SB)/8, $0x21d544d35067797a
-DATA ·precomputed<>+0x12038(SB)/8, $0xc8a8cc999d8a15bc
-DATA ·precomputed<>+0x12040(SB)/8, $0x610f0e26941d80a3
-DATA ·precomputed<>+0x12048(SB)/8, $0x30927879ad36514e
-DATA ·precomputed<>+0x12050(SB)/8, $0xaa2dfd4898f22601
-DATA ·precomputed<>+0x12058(SB)/8, $0xbc5b31b788c8b0f6
-DATA ·precomputed<>+0x12060(SB)/8, $0xb1281f376c841cc8
-DATA ·precomputed<>+0x12068(SB)/8, $0xdae167195a412b84
-DATA ·precomputed<>+0x12070(SB)/8, $0x9ec1f6c8828f210d
-DATA ·precomputed<>+0x12078(SB)/8, $0x1935d576e8d92901
-DATA ·precomputed<>+0x12080(SB)/8, $0x72c89be8f731e66e
-DATA ·precomputed<>+0x12088(SB)/8, $0x5c65a3bfc7c9ac67
-DATA ·precomputed<>+0x12090(SB)/8, $0x621746f622778847
-DATA ·precomputed<>+0x12098(SB)/8, $0x3e389821294495a7
-DATA ·precomputed<>+0x120a0(SB)/8, $0xdb0c4aab948c9496
-DATA ·precomputed<>+0x120a8(SB)/8, $0xdfdec518041e7947
-DATA ·precomputed<>+0x120b0(SB)/8, $0x91420e3a605283ab
-DATA ·precomputed<>+0x120b8(SB)/8, $0x799ec46f34c7dbc0
-DATA ·precomputed<>+0x120c0(SB)/8, $0x47247921af3840f2
-DATA ·precomputed<>+0x120c8(SB)/8, $0x348325d2df3fcdfc
-DATA ·precomputed<>+0x120d0(SB)/8, $0xef578508c43961bd
-DATA ·precomputed<>+0x120d8(SB)/8, $0x7d5e8ccd1bd98c29
-DATA ·precomputed<>+0x120e0(SB)/8, $0x59cdba10f8a30164
-DATA ·precomputed<>+0x120e8(SB)/8, $0x757408f7cb8c653
-DATA ·precomputed<>+0x120f0(SB)/8, $0xcd7ed73fc3056ef4
-DATA ·precomputed<>+0x120f8(SB)/8, $0xd28e7cc1fb99cd1b
-DATA ·precomputed<>+0x12100(SB)/8, $0xf1b7c0f95182958d
-DATA ·precomputed<>+0x12108(SB)/8, $0xee0c40775749c24b
-DATA ·precomputed<>+0x12110(SB)/8, $0x32f35fcff3638c77
-DATA ·precomputed<>+0x12118(SB)/8, $0xa46c52952773cee
-DATA ·precomputed<>+0x12120(SB)/8, $0x7ae3d4f7e2fb9042
-DATA ·precomputed<>+0x12128(SB)/8, $0x9855249952ce916e
-DATA ·precomputed<>+0x12130(SB)/8, $0xe48a640b04bf7ae8
-DATA ·precomputed<>+0x12138(SB)/8, $0xe3eb60409c9cb2b7
-DATA ·precomputed<>+0x12140(SB)/8, $0xa8a674946bb62286
-DATA ·precomputed<>+0x12148(SB)/8, $0x8d6ef816e7d87467
-DATA ·precomputed<>+0x12150(SB)/8, $0x3529f938f673b6d5
-DATA ·precomputed<>+0x12158(SB)/8, $0xf5c0ee768bbf101b
-DATA ·precomputed<>+0x12160(SB)/8, $0x3768ed6149fdc949
-DATA ·precomputed<>+0x12168(SB)/8, $0x53b6999ecf405ee0
-DATA ·precomputed<>+0x12170(SB)/8, $0xbf0108a01718e51a
-DATA ·precomputed<>+0x12178(SB)/8, $0x38e609ec5181ebd7
-DATA ·precomputed<>+0x12180(SB)/8, $0x228879083a6a2a00
-DATA ·precomputed<>+0x12188(SB)/8, $0x82df7fb78e549a6e
-DATA ·precomputed<>+0x12190(SB)/8, $0x8f1d62b44e5facfa
-DATA ·precomputed<>+0x12198(SB)/8, $0x65566a01000cade3
-DATA ·precomputed<>+0x121a0(SB)/8, $0x8672bcf5b7620135
-DATA ·precomputed<>+0x121a8(SB)/8, $0xcf00849d6b75df8e
-DATA ·precomputed<>+0x121b0(SB)/8, $0x8f5d3e0fe12fd615
-DATA ·precomputed<>+0x121b8(SB)/8, $0x7fb3f788df727a8a
-DATA ·precomputed<>+0x121c0(SB)/8, $0x82051640d8595159
-DATA ·precomputed<>+0x121c8(SB)/8, $0x30e1c706579a3876
-DATA ·precomputed<>+0x121d0(SB)/8, $0x91154c60298a67c
-DATA ·precomputed<>+0x121d8(SB)/8, $0x76d9a9c951132d27
-DATA ·precomputed<>+0x121e0(SB)/8, $0xe41de8b75c661a4d
-DATA ·precomputed<>+0x121e8(SB)/8, $0xf24b5e962a6dcbaf
-DATA ·precomputed<>+0x121f0(SB)/8, $0x1ed4cb0cd714e045
-DATA ·precomputed<>+0x121f8(SB)/8, $0x605926a40c187c70
-DATA ·precomputed<>+0x12200(SB)/8, $0xd1e1e1612d6f455b
-DATA ·precomputed<>+0x12208(SB)/8, $0x47f2eee6d209ff54
-DATA ·precomputed<>+0x12210(SB)/8, $0xccb36814e84145e2
-DATA ·precomputed<>+0x12218(SB)/8, $0x2710230cf50dcd81
-DATA ·precomputed<>+0x12220(SB)/8, $0x91ac45e75aa43bc4
-DATA ·precomputed<>+0x12228(SB)/8, $0x59fd73be520acd80
-DATA ·precomputed<>+0x12230(SB)/8, $0xefed9a566c7fd6b9
-DATA ·precomputed<>+0x12238(SB)/8, $0xc25445d6f3021eae
-DATA ·precomputed<>+0x12240(SB)/8, $0x97672559f34b1c93
-DATA ·precomputed<>+0x12248(SB)/8, $0xa256be98e0b43506
-DATA ·precomputed<>+0x12250(SB)/8, $0x7fcdd412e1a98eb3
-DATA ·precomputed<>+0x12258(SB)/8, $0x7fcfcd84295a061b
-DATA ·precomputed<>+0x12260(SB)/8, $0xddbac22bcc2386cf
-DATA ·precomputed<>+0x12268(SB)/8, $0x7741adb7928c2556
-DATA ·precomputed<>+0x12270(SB)/8, $0x3a2bb8694e6e1288
-DATA ·precomputed<>+0x12278(SB)/8, $0x53ed11da025bb4a1
-DATA ·precomputed<>+0x12280(SB)/8, $0xa6000fd10d43a76f
-DATA ·precomputed<>+0x12288(SB)/8, $0xf5b84a47c3fd4f02
-DATA ·precomputed<>+0x12290(SB)/8, $0x32c3562fad9fe216
-DATA ·precomputed<>+0x12298(SB)/8, $0xd75b05e6cce9960e
-DATA ·precomputed<>+0x122a0(SB)/8, $0x9d708421782a4d78
-DATA ·precomputed<>+0x122a8(SB)/8, $0x5c72b0cec51252d5
-DATA ·precomputed<>+0x122b0(SB)/8, $0x34f50f0cf756cdef
-DATA ·precomputed<>+0x122b8(SB)/8, $0x460024f2cd63c3f6
-DATA ·precomputed<>+0x122c0(SB)/8, $0xb114bd674108c8e2
-DATA ·precomputed<>+0x122c8(SB)/8, $0x85948c6b3deb8e23
-DATA ·precomputed<>+0x122d0(SB)/8, $0x6a9e05d9d0e9434c
-DATA ·precomputed<>+0x122d8(SB)/8, $0x395060b7b9c4fd70
-DATA ·precomputed<>+0x122e0(SB)/8, $0xa0ccfd1618893751
-DATA ·precomputed<>+0x122e8(SB)/8, $0xa941ff60bbf65477
-DATA ·precomputed<>+0x122f0(SB)/8, $0x34ada23859423e35
-DATA ·precomputed<>+0x122f8(SB)/8, $0x4ba7a7d17e570058
-DATA ·precomputed<>+0x12300(SB)/8, $0x246f1508b241f747
-DATA ·precomputed<>+0x12308(SB)/8, $0x944816cf958dc709
-DATA ·precomputed<>+0x12310(SB)/8, $0xe67c7fb041bff9bc
-DATA ·precomputed<>+0x12318(SB)/8, $0xb6364dd6514a1376
-DATA ·precomputed<>+0x12320(SB)/8, $0x1937eac0a6670e16
-DATA ·precomputed<>+0x12328(SB)/8, $0x4a95b2704cc97c6
-DATA ·precomputed<>+0x12330(SB)/8, $0xcc46fe5d66c529bc
-DATA ·precomputed<>+0x12338(SB)/8, $0xb3cb88ed0e16ae91
-DATA ·precomputed<>+0x12340(SB)/8, $0x3d05d455869ae596
-DATA ·precomputed<>+0x12348(SB)/8, $0x92a1540093368325
-DATA ·precomputed<>+0x12350(SB)/8, $0xbd64ae0a62eb7ffa
-DATA ·precomputed<>+0x12358(SB)/8, $0x3f263a7ad34b2c59
-DATA ·precomputed<>+0x12360(SB)/8, $0xe95eece3a22244e1
-DATA ·precomputed<>+0x12368(SB)/8, $0x39ccef58706fc500
-DATA ·precomputed<>+0x12370(SB)/8, $0x39a88f9322f1d2e6
-DATA ·precomputed<>+0x12378(SB)/8, $0x1ec796b36d47c670
-DATA ·precomputed<>+0x12380(SB)/8, $0xf956f9f2fa58c0e3
-DATA ·precomputed<>+0x12388(SB)/8, $0xe018a0fbef605a0c
-DATA ·precomputed<>+0x12390(SB)/8, $0xca09caed334348f6
-DATA ·precomputed<>+0x12398(SB)/8, $0x6ad284d9e180c2f9
-DATA ·precomputed<>+0x123a0(SB)/8, $0xaeff9f66d1357d19
-DATA ·precomputed<>+0x123a8(SB)/8, $0xf8da113c1b490fc6
-DATA ·precomputed<>+0x123b0(SB)/8, $0x2ca8a2089e48069b
-DATA ·precomputed<>+0x123b8(SB)/8, $0x355e4e777a4be64b
-DATA ·precomputed<>+0x123c0(SB)/8, $0x1558e0f2aa1ff4af
-DATA ·precomputed<>+0x123c8(SB)/8, $0x61f43487390503a4
-DATA ·precomputed<>+0x123d0(SB)/8, $0x661647323c4f76f1
-DATA ·precomputed<>+0x123d8(SB)/8, $0x50d0706e9e13432e
-DATA ·precomputed<>+0x123e0(SB)/8, $0x5f1a87caf5eba0fb
-DATA ·precomputed<>+0x123e8(SB)/8, $0x28a95c0f80bda2c5
-DATA ·precomputed<>+0x123f0(SB)/8, $0x10d693e012ae6462
-DATA ·precomputed<>+0x123f8(SB)/8, $0x79871340f45e6ba6
-DATA ·precomputed<>+0x12400(SB)/8, $0x1e02730dba625684
-DATA ·precomputed<>+0x12408(SB)/8, $0x9416e0e5fc792bf1
-DATA ·precomputed<>+0x12410(SB)/8, $0x203728773d2a0786
-DATA ·precomputed<>+0x12418(SB)/8, $0xc653d8cf5fb89834
-DATA ·precomputed<>+0x12420(SB)/8, $0x22208c1443743e83
-DATA ·precomputed<>+0x12428(SB)/8, $0xb1acec5d5cad1ff7
-DATA ·precomputed<>+0x12430(SB)/8, $0xf7a059f2627929d7
-DATA ·precomputed<>+0x12438(SB)/8, $0xf8f68bd10bce8a18
-DATA ·precomputed<>+0x12440(SB)/8, $0x8abf0cad887051c0
-DATA ·precomputed<>+0x12448(SB)/8, $0xd09f571db3c6b540
-DATA ·precomputed<>+0x12450(SB)/8, $0x3fb2e16be30ab25d
-DATA ·precomputed<>+0x12458(SB)/8, $0x12e057a7539e8bc4
-DATA ·precomputed<>+0x12460(SB)/8, $0x6efe71ce733c2597
-DATA ·precomputed<>+0x12468(SB)/8, $0x72fa0df5e71058ac
-DATA ·precomputed<>+0x12470(SB)/8, $0x49f14d06ccc037bf
-DATA ·precomputed<>+0x12478(SB)/8, $0x9a3ceb03ceb3eb6c
-DATA ·precomputed<>+0x12480(SB)/8, $0xd3a068e01bec3926
-DATA ·precomputed<>+0x12488(SB)/8, $0xdabe7460723a874b
-DATA ·precomputed<>+0x12490(SB)/8, $0xcb41d0d76aa6fd37
-DATA ·precomputed<>+0x12498(SB)/8, $0x53fd7f9d962ff514
-DATA ·precomputed<>+0x124a0(SB)/8, $0x9fae8ad66994240c
-DATA ·precomputed<>+0x124a8(SB)/8, $0x50a7915c2063084
-DATA ·precomputed<>+0x124b0(SB)/8, $0x1ee0d67afc234ed3
-DATA ·precomputed<>+0x124b8(SB)/8, $0xe4f8b1209521e5c
-DATA ·precomputed<>+0x124c0(SB)/8, $0x9895e9084195370a
-DATA ·precomputed<>+0x124c8(SB)/8, $0xa75007e5927345e7
-DATA ·precomputed<>+0x124d0(SB)/8, $0xb4c212d3850711
-DATA ·precomputed<>+0x124d8(SB)/8, $0x76e4060ddfee8b34
-DATA ·precomputed<>+0x124e0(SB)/8, $0x15801862184c1d07
-DATA ·precomputed<>+0x124e8(SB)/8, $0x234e39c0302f5711
-DATA ·precomputed<>+0x124f0(SB)/8, $0x4c0bd3876aa74204
-DATA ·precomputed<>+0x124f8(SB)/8, $0x515eddc6f496836
-DATA ·precomputed<>+0x12500(SB)/8, $0x198794ef91cdecb6
-DATA ·precomputed<>+0x12508(SB)/8, $0xac9e303802597997
-DATA ·precomputed<>+0x12510(SB)/8, $0xd198a0bf245db23f
-DATA ·precomputed<>+0x12518(SB)/8, $0xff1d80757106cf25
-DATA ·precomputed<>+0x12520(SB)/8, $0xb35b23b1e3b6de0c
-DATA ·precomputed<>+0x12528(SB)/8, $0xa63aea7b31bde91c
-DATA ·precomputed<>+0x12530(SB)/8, $0xc9debb4a79c57780
-DATA ·precomputed<>+0x12538(SB)/8, $0xac9c28e8a3fa0d6b
-DATA ·precomputed<>+0x12540(SB)/8, $0xa9fd0cb5c849afbe
-DATA ·precomputed<>+0x12548(SB)/8, $0x41df5bacad5c0aa
-DATA ·precomputed<>+0x12550(SB)/8, $0x9a54af37ddff259e
-DATA ·precomputed<>+0x12558(SB)/8, $0xa3f156bf9b550a8e
-DATA ·precomputed<>+0x12560(SB)/8, $0x4df2d33d7e3298d4
-DATA ·precomputed<>+0x12568(SB)/8, $0x957a0a065ff0e1a
-DATA ·precomputed<>+0x12570(SB)/8, $0xff7fb43d1e2b3a45
-DATA ·precomputed<>+0x12578(SB)/8, $0xb86d386a1a73285a
-DATA ·precomputed<>+0x12580(SB)/8, $0xce6b05ab0468bd80
-DATA ·precomputed<>+0x12588(SB)/8, $0x40d3583c0430355f
-DATA ·precomputed<>+0x12590(SB)/8, $0x51f54e2a7e5f2e5a
-DATA ·precomputed<>+0x12598(SB)/8, $0x9929dd193584920b
-DATA ·precomputed<>+0x125a0(SB)/8, $0xe3757515795d42d5
-DATA ·precomputed<>+0x125a8(SB)/8, $0xfd6dac3b30512abe
-DATA ·precomputed<>+0x125b0(SB)/8, $0x9a86f367c51b2aa
-DATA ·precomputed<>+0x125b8(SB)/8, $0x94c8d63044b553f6
-DATA ·precomputed<>+0x125c0(SB)/8, $0x6e283c4728b18e93
-DATA ·precomputed<>+0x125c8(SB)/8, $0x5458b92f4b4132ed
-DATA ·precomputed<>+0x125d0(SB)/8, $0x7026345eba674332
-DATA ·precomputed<>+0x125d8(SB)/8, $0xc8e381515c9fc32d
-DATA ·precomputed<>+0x125e0(SB)/8, $0xd6aaf8e158e7b4fe
-DATA ·precomputed<>+0x125e8(SB)/8, $0x3e77a5c94267253a
-DATA ·precomputed<>+0x125f0(SB)/8, $0x6441cba2a0949607
-DATA ·precomputed<>+0x125f8(SB)/8, $0xfa205185dee20b2e
-DATA ·precomputed<>+0x12600(SB)/8, $0xa15f148405c9b2f4
-DATA ·precomputed<>+0x12608(SB)/8, $0x10fe364cf5938caf
-DATA ·precomputed<>+0x12610(SB)/8, $0xfc9a4f7909347960
-DATA ·precomputed<>+0x12618(SB)/8, $0x7d576fe08cd35cb2
-DATA ·precomputed<>+0x12620(SB)/8, $0x93f0ba00bdbd9d48
-DATA ·precomputed<>+0x12628(SB)/8, $0x5c2c18aa524a5846
-DATA ·precomputed<>+0x12630(SB)/8, $0xd755c78ff39218a0
-DATA ·precomputed<>+0x12638(SB)/8, $0xd26494548688fc48
-DATA ·precomputed<>+0x12640(SB)/8, $0x64f3d576f5b44600
-DATA ·precomputed<>+0x12648(SB)/8, $0xf12125dbef5c8446
-DATA ·precomputed<>+0x12650(SB)/8, $0x1467539b487a0116
-DATA ·precomputed<>+0x12658(SB)/8, $0x3aa0fa49f380229a
-DATA ·precomputed<>+0x12660(SB)/8, $0xcc6586f1d79f36dc
-DATA ·precomputed<>+0x12668(SB)/8, $0xebcf653d1b9a0b42
-DATA ·precomputed<>+0x12670(SB)/8, $0x68af006d9c1df729
-DATA ·precomputed<>+0x12678(SB)/8, $0x507e055aa7903ee6
-DATA ·precomputed<>+0x12680(SB)/8, $0x89b81b3b5e2e497f
-DATA ·precomputed<>+0x12688(SB)/8, $0xdd1fbfbff76a294f
-DATA ·precomputed<>+0x12690(SB)/8, $0xd8d5d167a5f3a51a
-DATA ·precomputed<>+0x12698(SB)/8, $0xe167f36619194ab7
-DATA ·precomputed<>+0x126a0(SB)/8, $0x90ffaf79facaa2c8
-DATA ·precomputed<>+0x126a8(SB)/8, $0x4aead0ce3f41db76
-DATA ·precomputed<>+0x126b0(SB)/8, $0x7feb4c3bb73c730
-DATA ·precomputed<>+0x126b8(SB)/8, $0xdda71baa03404a5c
-DATA ·precomputed<>+0x126c0(SB)/8, $0xd1b48ef8afd8ac9b
-DATA ·precomputed<>+0x126c8(SB)/8, $0xd8920af73b5f6b51
-DATA ·precomputed<>+0x126d0(SB)/8, $0x9629e789983339c8
-DATA ·precomputed<>+0x126d8(SB)/8, $0xbfd2d787fa9248d3
-DATA ·precomputed<>+0x126e0(SB)/8, $0xb50ca3029a078882
-DATA ·precomputed<>+0x126e8(SB)/8, $0x1c34f848f5cb0529
-DATA ·precomputed<>+0x126f0(SB)/8, $0xb9b015191646a9f8
-DATA ·precomputed<>+0x126f8(SB)/8, $0xc9e3679e80d53f9d
-DATA ·precomputed<>+0x12700(SB)/8, $0x10d393cdf03307b8
-DATA ·precomputed<>+0x12708(SB)/8, $0x9e10d030fbbefcc9
-DATA ·precomputed<>+0x12710(SB)/8, $0x7a5d7e8492b1b5c7
-DATA ·precomputed<>+0x12718(SB)/8, $0x864b1e3a104e81ce
-DATA ·precomputed<>+0x12720(SB)/8, $0x5a9b90b3efc086f4
-DATA ·precomputed<>+0x12728(SB)/8, $0x8b62a17df8d3456c
-DATA ·precomputed<>+0x12730(SB)/8, $0xff4dec0b171cbf61
-DATA ·precomputed<>+0x12738(SB)/8, $0xb651bd23a4b385e2
-DATA ·precomputed<>+0x12740(SB)/8, $0x5a63e8221af3e5f2
-DATA ·precomputed<>+0x12748(SB)/8, $0x5480ad8ff58e3d1
-DATA ·precomputed<>+0x12750(SB)/8, $0x2d241643d6b3626b
-DATA ·precomputed<>+0x12758(SB)/8, $0x33b15660c1eda15f
-DATA ·precomputed<>+0x12760(SB)/8, $0x3e74f8558528e5d6
-DATA ·precomputed<>+0x12768(SB)/8, $0xafb6dc9af63188f4
-DATA ·precomputed<>+0x12770(SB)/8, $0xcac44cbaeeb1d32
-DATA ·precomputed<>+0x12778(SB)/8, $0x50661046a627eff8
-DATA ·precomputed<>+0x12780(SB)/8, $0x87ddec9556986326
-DATA ·precomputed<>+0x12788(SB)/8, $0x732de213876ae9a4
-DATA ·precomputed<>+0x12790(SB)/8, $0xaaa0a22ec5d3da63
-DATA ·precomputed<>+0x12798(SB)/8, $0xf401a56d9a1459af
-DATA ·precomputed<>+0x127a0(SB)/8, $0xe8fb52105dfa76c8
-DATA ·precomputed<>+0x127a8(SB)/8, $0x50ec1d688052196d
-DATA ·precomputed<>+0x127b0(SB)/8, $0xdbd08efdc6bd3c5a
-DATA ·precomputed<>+0x127b8(SB)/8, $0xd097db9e7c8554cf
-DATA ·precomputed<>+0x127c0(SB)/8, $0xadc4b01264b49667
-DATA ·precomputed<>+0x127c8(SB)/8, $0xa4bdafa71e05f760
-DATA ·precomputed<>+0x127d0(SB)/8, $0x171b28b3f185d27a
-DATA ·precomputed<>+0x127d8(SB)/8, $0x987e516333425747
-DATA ·precomputed<>+0x127e0(SB)/8, $0x7c42ac4ec3864a65
-DATA ·precomputed<>+0x127e8(SB)/8, $0x2dae1bb8bf449c12
-DATA ·precomputed<>+0x127f0(SB)/8, $0x680d974306965832
-DATA ·precomputed<>+0x127f8(SB)/8, $0x6ac1ef017e31d9f4
-DATA ·precomputed<>+0x12800(SB)/8, $0x745d4f74ea3d4446
-DATA ·precomputed<>+0x12808(SB)/8, $0xa439f17840ad1c7f
-DATA ·precomputed<>+0x12810(SB)/8, $0xc95d951051374e92
-DATA ·precomputed<>+0x12818(SB)/8, $0x75870e9f90229008
-DATA ·precomputed<>+0x12820(SB)/8, $0x3fec98c2c54e7e81
-DATA ·precomputed<>+0x12828(SB)/8, $0xef537ee994b3860b
-DATA ·precomputed<>+0x12830(SB)/8, $0x139dd83440bfc8f6
-DATA ·precomputed<>+0x12838(SB)/8, $0x20b513640f114403
-DATA ·precomputed<>+0x12840(SB)/8, $0xdd9bc2afda6a4a9
-DATA ·precomputed<>+0x12848(SB)/8, $0xdba0178a0106ae0e
-DATA ·precomputed<>+0x12850(SB)/8, $0x3820c9f54969a4bb
-DATA ·precomputed<>+0x12858(SB)/8, $0x5031e9fd99fbc715
-DATA ·precomputed<>+0x12860(SB)/8, $0x642a030ac193d942
-DATA ·precomputed<>+0x12868(SB)/8, $0xdc3d6ab7454cbb39
-DATA ·precomputed<>+0x12870(SB)/8, $0x507c17b91c8fa77c
-DATA ·precomputed<>+0x12878(SB)/8, $0x8465bcc8e3642a95
-DATA ·precomputed<>+0x12880(SB)/8, $0x7dcf4a08ee35e59c
-DATA ·precomputed<>+0x12888(SB)/8, $0xc7eeb5078a5b1e31
-DATA ·precomputed<>+0x12890(SB)/8, $0x635b0eb6f9be2fc5
-DATA ·precomputed<>+0x12898(SB)/8, $0x24924cab9ba556be
-DATA ·precomputed<>+0x128a0(SB)/8, $0x62e57b325a8381c1
-DATA ·precomputed<>+0x128a8(SB)/8, $0x692ac4c0cb82d174
-DATA ·precomputed<>+0x128b0(SB)/8, $0x36da208bfa134f9e
-DATA ·precomputed<>+0x128b8(SB)/8, $0x139b39847805318a
-DATA ·precomputed<>+0x128c0(SB)/8, $0xe74e265bc25dfad3
-DATA ·precomputed<>+0x128c8(SB)/8, $0xd03630b9493f44b6
-DATA ·precomputed<>+0x128d0(SB)/8, $0xb3270892bfd6d473
-DATA ·precomputed<>+0x128d8(SB)/8, $0x5b2d95431c5ee992
-DATA ·precomputed<>+0x128e0(SB)/8, $0xeeb94537a36f7c5f
-DATA ·precomputed<>+0x128e8(SB)/8, $0x9befc01d8ab0b81d
-DATA ·precomputed<>+0x128f0(SB)/8, $0x483cdb08188b45e5
-DATA ·precomputed<>+0x128f8(SB)/8, $0x44c753b701e4648b
-DATA ·precomputed<>+0x12900(SB)/8, $0x3f8eca35313bdfbc
-DATA ·precomputed<>+0x12908(SB)/8, $0x255b3a4c98b21717
-DATA ·precomputed<>+0x12910(SB)/8, $0x12a0c429a731e97e
-DATA ·precomputed<>+0x12918(SB)/8, $0xe6503019e3bbec40
-DATA ·precomputed<>+0x12920(SB)/8, $0x940cb40044239a84
-DATA ·precomputed<>+0x12928(SB)/8, $0xe085c7261b62b842
-DATA ·precomputed<>+0x12930(SB)/8, $0x30b10c6396983858
-DATA ·precomputed<>+0x12938(SB)/8, $0x67ac3e2e13bf8988
-DATA ·precomputed<>+0x12940(SB)/8, $0x38961174e102fed8
-DATA ·precomputed<>+0x12948(SB)/8, $0xb6df23a62e2512e8
-DATA ·precomputed<>+0x12950(SB)/8, $0x716a2abdb917ae15
-DATA ·precomputed<>+0x12958(SB)/8, $0x38c35e4a12a396e6
-DATA ·precomputed<>+0x12960(SB)/8, $0x82e6d7b8e8ebde1a
-DATA ·precomputed<>+0x12968(SB)/8, $0xd982ee725e11a3f2
-DATA ·precomputed<>+0x12970(SB)/8, $0xf815f6ce3df2f139
-DATA ·precomputed<>+0x12978(SB)/8, $0x771826190a499de4
-DATA ·precomputed<>+0x12980(SB)/8, $0xfb880c5486736283
-DATA ·precomputed<>+0x12988(SB)/8, $0xc9b9a53a6b616667
-DATA ·precomputed<>+0x12990(SB)/8, $0x2716bcc26d940bf8
-DATA ·precomputed<>+0x12998(SB)/8, $0xea136993969a3f08
-DATA ·precomputed<>+0x129a0(SB)/8, $0xd6a923f20db46a6c
-DATA ·precomputed<>+0x129a8(SB)/8, $0x2c55ee969dbf3d0b
-DATA ·precomputed<>+0x129b0(SB)/8, $0x25e6b4c5f637e964
-DATA ·precomputed<>+0x129b8(SB)/8, $0x89b95a21c6f0c290
-DATA ·precomputed<>+0x129c0(SB)/8, $0xee43bc87b2411618
-DATA ·precomputed<>+0x129c8(SB)/8, $0x8754bd2f07924c4
-DATA ·precomputed<>+0x129d0(SB)/8, $0xef2050334ac92557
-DATA ·precomputed<>+0x129d8(SB)/8, $0x6e7e4fe6ee0387f4
-DATA ·precomputed<>+0x129e0(SB)/8, $0x51f3e2e276961d0e
-DATA ·precomputed<>+0x129e8(SB)/8, $0x2b69d41737eac10f
-DATA ·precomputed<>+0x129f0(SB)/8, $0x36d0f45f73757a88
-DATA ·precomputed<>+0x129f8(SB)/8, $0x38b967e52b0c7d35
-DATA ·precomputed<>+0x12a00(SB)/8, $0xacd84baed3dfc5c9
-DATA ·precomputed<>+0x12a08(SB)/8, $0x2a7d6b3b97a7b28e
-DATA ·precomputed<>+0x12a10(SB)/8, $0xf3e1a6741040063b
-DATA ·precomputed<>+0x12a18(SB)/8, $0x3fdde5ba18359c6b
-DATA ·precomputed<>+0x12a20(SB)/8, $0xcaeb34b2b20ead12
-DATA ·precomputed<>+0x12a28(SB)/8, $0x2a80c8c562bef6ab
-DATA ·precomputed<>+0x12a30(SB)/8, $0x9329f6e7f520a3b5
-DATA ·precomputed<>+0x12a38(SB)/8, $0x2b2057443a7c599b
-DATA ·precomputed<>+0x12a40(SB)/8, $0xbad310769a5f1925
-DATA ·precomputed<>+0x12a48(SB)/8, $0xda2fc4c03a7ed259
-DATA ·precomputed<>+0x12a50(SB)/8, $0x9c74973f1295cd96
-DATA ·precomputed<>+0x12a58(SB)/8, $0x7777a911049b4621
-DATA ·precomputed<>+0x12a60(SB)/8, $0xfadf9f092affa36d
-DATA ·precomputed<>+0x12a68(SB)/8, $0x9a7fc6f99410dd8c
-DATA ·precomputed<>+0x12a70(SB)/8, $0x6da4c84fd5f432b5
-DATA ·precomputed<>+0x12a78(SB)/8, $0x1fb6560274d3848e
-DATA ·precomputed<>+0x12a80(SB)/8, $0x26221598c142c5ca
-DATA ·precomputed<>+0x12a88(SB)/8, $0xc9dd09cd66f55e3f
-DATA ·precomputed<>+0x12a90(SB)/8, $0x6504983eebecf8bb
-DATA ·precomputed<>+0x12a98(SB)/8, $0x2791a0445f5dce98
-DATA ·precomputed<>+0x12aa0(SB)/8, $0xafe4d56ce4856ba0
-DATA ·precomputed<>+0x12aa8(SB)/8, $0xbb0475c3c332d4f3
-DATA ·precomputed<>+0x12ab0(SB)/8, $0x54c692cf4c143ccd
-DATA ·precomputed<>+0x12ab8(SB)/8, $0x1fde4d0da265265
-DATA ·precomputed<>+0x12ac0(SB)/8, $0x94ba8fc4b31fa779
-DATA ·precomputed<>+0x12ac8(SB)/8, $0x8024dc850f13036e
-DATA ·precomputed<>+0x12ad0(SB)/8, $0xfda2af6382d754b7
-DATA ·precomputed<>+0x12ad8(SB)/8, $0x4a784242ae9ea9ae
-DATA ·precomputed<>+0x12ae0(SB)/8, $0x67dd14abf9887947
-DATA ·precomputed<>+0x12ae8(SB)/8, $0x7f2ecfc4cd555a0a
-DATA ·precomputed<>+0x12af0(SB)/8, $0xb37c4244f63a46aa
-DATA ·precomputed<>+0x12af8(SB)/8, $0xd032cfc1ff71b4b5
-DATA ·precomputed<>+0x12b00(SB)/8, $0x2b2f3fbd1c65fe70
-DATA ·precomputed<>+0x12b08(SB)/8, $0xe800a6c765e9af30
-DATA ·precomputed<>+0x12b10(SB)/8, $0x74ad95042460d3f0
-DATA ·precomputed<>+0x12b18(SB)/8, $0xade47044cab00945
-DATA ·precomputed<>+0x12b20(SB)/8, $0xbbec353f6f9a46c
-DATA ·precomputed<>+0x12b28(SB)/8, $0xd44e3d8d0fdab64d
-DATA ·precomputed<>+0x12b30(SB)/8, $0xf8855b69ebbbef85
-DATA ·precomputed<>+0x12b38(SB)/8, $0xdd11ec3e94d030fd
-DATA ·precomputed<>+0x12b40(SB)/8, $0xbe060e5227dbb964
-DATA ·precomputed<>+0x12b48(SB)/8, $0xebae39b356d3ee9
-DATA ·precomputed<>+0x12b50(SB)/8, $0x4defc15e1a3ee35d
-DATA ·precomputed<>+0x12b58(SB)/8, $0x62896e3af770e27d
-DATA ·precomputed<>+0x12b60(SB)/8, $0x2e24fd359939db9d
-DATA ·precomputed<>+0x12b68(SB)/8, $0x10808ace054d1460
-DATA ·precomputed<>+0x12b70(SB)/8, $0xdafedcda451c6d6e
-DATA ·precomputed<>+0x12b78(SB)/8, $0x89b9d25872cf7747
-DATA ·precomputed<>+0x12b80(SB)/8, $0xcf36408aa13ce82c
-DATA ·precomputed<>+0x12b88(SB)/8, $0xc555460b1cc70def
-DATA ·precomputed<>+0x12b90(SB)/8, $0xcc9e9f59f44226a3
-DATA ·precomputed<>+0x12b98(SB)/8, $0x957d8bc1e33f6983
-DATA ·precomputed<>+0x12ba0(SB)/8, $0x1406b7c9b8230d89
-DATA ·precomputed<>+0x12ba8(SB)/8, $0x8085211705558843
-DATA ·precomputed<>+0x12bb0(SB)/8, $0x88acf8b04c1b1908
-DATA ·precomputed<>+0x12bb8(SB)/8, $0x9637915eba5c879
-DATA ·precomputed<>+0x12bc0(SB)/8, $0xaef84c16b8a6a97
-DATA ·precomputed<>+0x12bc8(SB)/8, $0xd2e7f3de0b2bca36
-DATA ·precomputed<>+0x12bd0(SB)/8, $0x721c6c095b174d43
-DATA ·precomputed<>+0x12bd8(SB)/8, $0x5719cf31d52ccc5b
-DATA ·precomputed<>+0x12be0(SB)/8, $0x6c7361f03adf9517
-DATA ·precomputed<>+0x12be8(SB)/8, $0x1e264169abe20ff5
-DATA ·precomputed<>+0x12bf0(SB)/8, $0x1f9d99769eacc0e
-DATA ·precomputed<>+0x12bf8(SB)/8, $0x721eba63c2e635d2
-DATA ·precomputed<>+0x12c00(SB)/8, $0x746f8691ca907385
-DATA ·precomputed<>+0x12c08(SB)/8, $0x27f54eeba625f984
-DATA ·precomputed<>+0x12c10(SB)/8, $0xacf6aa33de9db588
-DATA ·precomputed<>+0x12c18(SB)/8, $0x79ef142d4036dab4
-DATA ·precomputed<>+0x12c20(SB)/8, $0x3855562fd381644f
-DATA ·precomputed<>+0x12c28(SB)/8, $0x8d6d20550deb30d0
-DATA ·precomputed<>+0x12c30(SB)/8, $0x8e6c26ed0f9db57e
-DATA ·precomputed<>+0x12c38(SB)/8, $0x43f252c3cf72eab9
-DATA ·precomputed<>+0x12c40(SB)/8, $0xd8497e0ed1f6adcf
-DATA ·precomputed<>+0x12c48(SB)/8, $0x233b294473c15830
-DATA ·precomputed<>+0x12c50(SB)/8, $0x78215d6619d148c9
-DATA ·precomputed<>+0x12c58(SB)/8, $0x1b1d9f1ee29f137
-DATA ·precomputed<>+0x12c60(SB)/8, $0x86cdec8b54750e
-DATA ·precomputed<>+0x12c68(SB)/8, $0x150a8e3a4cd32531
-DATA ·precomputed<>+0x12c70(SB)/8, $0x7860e1981f68be56
-DATA ·precomputed<>+0x12c78(SB)/8, $0x9df5213a169fb50
-DATA ·precomputed<>+0x12c80(SB)/8, $0xe43a59537d896295
-DATA ·precomputed<>+0x12c88(SB)/8, $0xf65495ccc325935
-DATA ·precomputed<>+0x12c90(SB)/8, $0x6f6a9b8e9906a19b
-DATA ·precomputed<>+0x12c98(SB)/8, $0x29519154ec20cce8
-DATA ·precomputed<>+0x12ca0(SB)/8, $0xf7aa6736da526e56
-DATA ·precomputed<>+0x12ca8(SB)/8, $0x20640ac87d5bba34
-DATA ·precomputed<>+0x12cb0(SB)/8, $0x33afb8e7348fa31b
-DATA ·precomputed<>+0x12cb8(SB)/8, $0x4d96ea7a89613f82
-DATA ·precomputed<>+0x12cc0(SB)/8, $0x4225e9c825df8bb5
-DATA ·precomputed<>+0x12cc8(SB)/8, $0x931f721eb5752d7e
-DATA ·precomputed<>+0x12cd0(SB)/8, $0x3c4ed4750a3b281d
-DATA ·precomputed<>+0x12cd8(SB)/8, $0xcf9276824a4668be
-DATA ·precomputed<>+0x12ce0(SB)/8, $0x1b7f358e75b7e90c
-DATA ·precomputed<>+0x12ce8(SB)/8, $0x6e5c24db7a29b9a
-DATA ·precomputed<>+0x12cf0(SB)/8, $0x58967aa167f2c8
-DATA ·precomputed<>+0x12cf8(SB)/8, $0x9f1a6fb9a4ee62d3
-DATA ·precomputed<>+0x12d00(SB)/8, $0x3dd0ed6fda919a17
-DATA ·precomputed<>+0x12d08(SB)/8, $0x2b8f860602eb740a
-DATA ·precomputed<>+0x12d10(SB)/8, $0xe6f213fcc2065483
-DATA ·precomputed<>+0x12d18(SB)/8, $0x527de4172891fe95
-DATA ·precomputed<>+0x12d20(SB)/8, $0x6a4938e4f023f6d2
-DATA ·precomputed<>+0x12d28(SB)/8, $0x1e6487adf5e37f2c
-DATA ·precomputed<>+0x12d30(SB)/8, $0xb94ceb1931c200e7
-DATA ·precomputed<>+0x12d38(SB)/8, $0x947cc4035704c555
-DATA ·precomputed<>+0x12d40(SB)/8, $0xa33c1db4f469e664
-DATA ·precomputed<>+0x12d48(SB)/8, $0x22ec046eb6bda072
-DATA ·precomputed<>+0x12d50(SB)/8, $0x571cbf08bf6efc41
-DATA ·precomputed<>+0x12d58(SB)/8, $0x7444037907130d5e
-DATA ·precomputed<>+0x12d60(SB)/8, $0x5b4b72d40919fd23
-DATA ·precomputed<>+0x12d68(SB)/8, $0x3087fd4c8b033f7f
-DATA ·precomputed<>+0x12d70(SB)/8, $0xbc916694ed659a7c
-DATA ·precomputed<>+0x12d78(SB)/8, $0x9a73b8997016d187
-DATA ·precomputed<>+0x12d80(SB)/8, $0x233ce2dae8d0f988
-DATA ·precomputed<>+0x12d88(SB)/8, $0xc1fe83be33d67550
-DATA ·precomputed<>+0x12d90(SB)/8, $0x3c06b30585b12ac1
-DATA ·precomputed<>+0x12d98(SB)/8, $0xe09d27cd3652087b
-DATA ·precomputed<>+0x12da0(SB)/8, $0x3ef62f19e1d6cbeb
-DATA ·precomputed<>+0x12da8(SB)/8, $0x9695d54b9b30019a
-DATA ·precomputed<>+0x12db0(SB)/8, $0x86f2d61d3f25e369
-DATA ·precomputed<>+0x12db8(SB)/8, $0x95192063fc7cf70f
-DATA ·precomputed<>+0x12dc0(SB)/8, $0xca899c4f278291f1
-DATA ·precomputed<>+0x12dc8(SB)/8, $0x69a90324f4e64c1d
-DATA ·precomputed<>+0x12dd0(SB)/8, $0x46cc5d428d62916e
-DATA ·precomputed<>+0x12dd8(SB)/8, $0x3c802e65ec1007cc
-DATA ·precomputed<>+0x12de0(SB)/8, $0xdadcf2aa6219cfbb
-DATA ·precomputed<>+0x12de8(SB)/8, $0x942870dcd10258b2
-DATA ·precomputed<>+0x12df0(SB)/8, $0x77264e68a5e142af
-DATA ·precomputed<>+0x12df8(SB)/8, $0xf25675e2089cc7a3
-DATA ·precomputed<>+0x12e00(SB)/8, $0x69e75326cd4e47bf
-DATA ·precomputed<>+0x12e08(SB)/8, $0x6c1767cfe739187b
-DATA ·precomputed<>+0x12e10(SB)/8, $0xfbdedd2c5e2898f2
-DATA ·precomputed<>+0x12e18(SB)/8, $0x8aaf19285d015137
-DATA ·precomputed<>+0x12e20(SB)/8, $0x3eb3ebff8f5d910a
-DATA ·precomputed<>+0x12e28(SB)/8, $0x3869deef2be82f16
-DATA ·precomputed<>+0x12e30(SB)/8, $0xd9da81bb634622e0
-DATA ·precomputed<>+0x12e38(SB)/8, $0x3d9c9719bb5a0484
-DATA ·precomputed<>+0x12e40(SB)/8, $0x753a7e361a59dd35
-DATA ·precomputed<>+0x12e48(SB)/8, $0xbcaa4e341495abba
-DATA ·precomputed<>+0x12e50(SB)/8, $0xd9d8d384f895ea46
-DATA ·precomputed<>+0x12e58(SB)/8, $0xbbafde3189e58c6c
-DATA ·precomputed<>+0x12e60(SB)/8, $0x8c91444872fda38a
-DATA ·precomputed<>+0x12e68(SB)/8, $0xe535f9135dd48518
-DATA ·precomputed<>+0x12e70(SB)/8, $0xc3bb0d1316d66e01
-DATA ·precomputed<>+0x12e78(SB)/8, $0x7c81da6df2407a5b
-DATA ·precomputed<>+0x12e80(SB)/8, $0xb303f6055cb0a222
-DATA ·precomputed<>+0x12e88(SB)/8, $0xadd3e0a0f90298ff
-DATA ·precomputed<>+0x12e90(SB)/8, $0x95e085e764ac3192
-DATA ·precomputed<>+0x12e98(SB)/8, $0x9b2b0d97f9aa786e
-DATA ·precomputed<>+0x12ea0(SB)/8, $0xd6f0594fe795181e
-DATA ·precomputed<>+0x12ea8(SB)/8, $0xdad4547e75408a01
-DATA ·precomputed<>+0x12eb0(SB)/8, $0x8650af89ad41eefd
-DATA ·precomputed<>+0x12eb8(SB)/8, $0x8d5dff5c325a50cb
-DATA ·precomputed<>+0x12ec0(SB)/8, $0x177e8a3b7336aa16
-DATA ·precomputed<>+0x12ec8(SB)/8, $0x5a92cc2dbc5c622c
-DATA ·precomputed<>+0x12ed0(SB)/8, $0x33a35a2c1789e029
-DATA ·precomputed<>+0x12ed8(SB)/8, $0x6f91306e4e4d5573
-DATA ·precomputed<>+0x12ee0(SB)/8, $0xe5a2a581da0a46f5
-DATA ·precomputed<>+0x12ee8(SB)/8, $0xfb532bed42640bba
-DATA ·precomputed<>+0x12ef0(SB)/8, $0x88ff0f114a7b3ae4
-DATA ·precomputed<>+0x12ef8(SB)/8, $0x2223e7b6b8ff7a71
-DATA ·precomputed<>+0x12f00(SB)/8, $0xd876a0e8d7ef1308
-DATA ·precomputed<>+0x12f08(SB)/8, $0xde76fd5ba49ac00
-DATA ·precomputed<>+0x12f10(SB)/8, $0x4fec343c0076e75e
-DATA ·precomputed<>+0x12f18(SB)/8, $0x1b0e3786c230439d
-DATA ·precomputed<>+0x12f20(SB)/8, $0x15e0b0c7f12ef069
-DATA ·precomputed<>+0x12f28(SB)/8, $0xa81d917a8cb6f5d3
-DATA ·precomputed<>+0x12f30(SB)/8, $0xd3981c4ccbda4399
-DATA ·precomputed<>+0x12f38(SB)/8, $0x5cb22b857802b3e6
-DATA ·precomputed<>+0x12f40(SB)/8, $0x72aa839bffef66c
-DATA ·precomputed<>+0x12f48(SB)/8, $0x13a9deff628480a6
-DATA ·precomputed<>+0x12f50(SB)/8, $0xf3db92dea9d03852
-DATA ·precomputed<>+0x12f58(SB)/8, $0x234f70679865e26b
-DATA ·precomputed<>+0x12f60(SB)/8, $0x383e9223c3096553
-DATA ·precomputed<>+0x12f68(SB)/8, $0x9f920a540ba26ded
-DATA ·precomputed<>+0x12f70(SB)/8, $0x886baf21f3ad0a77
-DATA ·precomputed<>+0x12f78(SB)/8, $0x74d05254c120936c
-DATA ·precomputed<>+0x12f80(SB)/8, $0xec8e8db65fa39ba6
-DATA ·precomputed<>+0x12f88(SB)/8, $0x3638f161f8f9a25b
-DATA ·precomputed<>+0x12f90(SB)/8, $0x923e342b4b3693d7
-DATA ·precomputed<>+0x12f98(SB)/8, $0x7f6e010dfa5f38ff
-DATA ·precomputed<>+0x12fa0(SB)/8, $0x5fc61a5624a20d80
-DATA ·precomputed<>+0x12fa8(SB)/8, $0x30c579c26b012cda
-DATA ·precomputed<>+0x12fb0(SB)/8, $0xeecdc90e32179d7e
-DATA ·precomputed<>+0x12fb8(SB)/8, $0xf5f2eab9b66a3c5f
-DATA ·precomputed<>+0x12fc0(SB)/8, $0x759331335d21d261
-DATA ·precomputed<>+0x12fc8(SB)/8, $0xa336289acabb1fbd
-DATA ·precomputed<>+0x12fd0(SB)/8, $0x797db2f3631b3b61
-DATA ·precomputed<>+0x12fd8(SB)/8, $0xc2cedb25d7e6a511
-DATA ·precomputed<>+0x12fe0(SB)/8, $0xb8806f3410355332
-DATA ·precomputed<>+0x12fe8(SB)/8, $0xe5f1fb4a5d0ae37f
-DATA ·precomputed<>+0x12ff0(SB)/8, $0x57cf26a55d17c5c7
-DATA ·precomputed<>+0x12ff8(SB)/8, $0x82e8df4768c43ec3
-DATA ·precomputed<>+0x13000(SB)/8, $0x60d41128ffb5e7ce
-DATA ·precomputed<>+0x13008(SB)/8, $0xdbd8b542aecb96c2
-DATA ·precomputed<>+0x13010(SB)/8, $0x29ab3dd0b5ca788
-DATA ·precomputed<>+0x13018(SB)/8, $0x8b1148a2190eb38c
-DATA ·precomputed<>+0x13020(SB)/8, $0x59048db869fb1924
-DATA ·precomputed<>+0x13028(SB)/8, $0xcd2149f0b18391a8
-DATA ·precomputed<>+0x13030(SB)/8, $0x6bece5b6fed311b9
-DATA ·precomputed<>+0x13038(SB)/8, $0x5edbe9b99ffd29b9
-DATA ·precomputed<>+0x13040(SB)/8, $0xeb5593e7532e8d6a
-DATA ·precomputed<>+0x13048(SB)/8, $0x94092904f02a1ee4
-DATA ·precomputed<>+0x13050(SB)/8, $0x379b32e8200496c0
-DATA ·precomputed<>+0x13058(SB)/8, $0x46fb6e9e360a27c8
-DATA ·precomputed<>+0x13060(SB)/8, $0x8a3377ba62005158
-DATA ·precomputed<>+0x13068(SB)/8, $0x1a3266c10de3f191
-DATA ·precomputed<>+0x13070(SB)/8, $0xe60fad96c94d2127
-DATA ·precomputed<>+0x13078(SB)/8, $0x41553dd1646302f3
-DATA ·precomputed<>+0x13080(SB)/8, $0x670431a2a6ec0fe4
-DATA ·precomputed<>+0x13088(SB)/8, $0x49da0b4235964572
-DATA ·precomputed<>+0x13090(SB)/8, $0xbb12d1b09dda5c39
-DATA ·precomputed<>+0x13098(SB)/8, $0x64170fe172d3de51
-DATA ·precomputed<>+0x130a0(SB)/8, $0xea8b2b16a4a2f5d9
-DATA ·precomputed<>+0x130a8(SB)/8, $0xde1bad64e590be92
-DATA ·precomputed<>+0x130b0(SB)/8, $0xb7f93581f0b9b0b5
-DATA ·precomputed<>+0x130b8(SB)/8, $0xb007f4dde115d67e
-DATA ·precomputed<>+0x130c0(SB)/8, $0x3b0cd36fe12a94ab
-DATA ·precomputed<>+0x130c8(SB)/8, $0xf364b3b9b5ad7c48
-DATA ·precomputed<>+0x130d0(SB)/8, $0x96a7a2a78e768469
-DATA ·precomputed<>+0x130d8(SB)/8, $0xccc31c7e1bbc7cc5
-DATA ·precomputed<>+0x130e0(SB)/8, $0xe70ad5d0080dbb92
-DATA ·precomputed<>+0x130e8(SB)/8, $0xfb201e9256fb0f1f
-DATA ·precomputed<>+0x130f0(SB)/8, $0xdfce7a1e29d99f57
-DATA ·precomputed<>+0x130f8(SB)/8, $0xc12a02b006457da5
-DATA ·precomputed<>+0x13100(SB)/8, $0xf4685482a048530b
-DATA ·precomputed<>+0x13108(SB)/8, $0xb4a31203a467f15d
-DATA ·precomputed<>+0x13110(SB)/8, $0xb5f8a2605b1b7d84
-DATA ·precomputed<>+0x13118(SB)/8, $0x61ceef975f45295d
-DATA ·precomputed<>+0x13120(SB)/8, $0x348b832a07e7578a
-DATA ·precomputed<>+0x13128(SB)/8, $0x72496336915eb7d
-DATA ·precomputed<>+0x13130(SB)/8, $0x95bbad2d0fcc5834
-DATA ·precomputed<>+0x13138(SB)/8, $0x7fdeed649da95289
-DATA ·precomputed<>+0x13140(SB)/8, $0x5d9fb56d84457fa1
-DATA ·precomputed<>+0x13148(SB)/8, $0x1cddb6c5be11055e
-DATA ·precomputed<>+0x13150(SB)/8, $0xd4173a6b13d8d3cf
-DATA ·precomputed<>+0x13158(SB)/8, $0x6e1b2d83161d2b08
-DATA ·precomputed<>+0x13160(SB)/8, $0x4887efe79a7aed4b
-DATA ·precomputed<>+0x13168(SB)/8, $0x51b67cc99c67b13d
-DATA ·precomputed<>+0x13170(SB)/8, $0xffd958d4f0a8d473
-DATA ·precomputed<>+0x13178(SB)/8, $0x62c566ab5452ecf5
-DATA ·precomputed<>+0x13180(SB)/8, $0xd6a02367962f3f09
-DATA ·precomputed<>+0x13188(SB)/8, $0x5d1b73aa9784e6f4
-DATA ·precomputed<>+0x13190(SB)/8, $0xb82f0e3c6efc496b
-DATA ·precomputed<>+0x13198(SB)/8, $0x85b2fe3751f96d88
-DATA ·precomputed<>+0x131a0(SB)/8, $0x5aebde1a58c6ee49
-DATA ·precomputed<>+0x131a8(SB)/8, $0xd3903b035e7abc0c
-DATA ·precomputed<>+0x131b0(SB)/8, $0x33f3381ecc075de7
-DATA ·precomputed<>+0x131b8(SB)/8, $0x59c9d481b6fa7516
-DATA ·precomputed<>+0x131c0(SB)/8, $0xdea72ba62a80f39c
-DATA ·precomputed<>+0x131c8(SB)/8, $0xcb2174b168cbe088
-DATA ·precomputed<>+0x131d0(SB)/8, $0x9c73ec69d6bd1cc1
-DATA ·precomputed<>+0x131d8(SB)/8, $0x6a2dbe20f20dcce6
-DATA ·precomputed<>+0x131e0(SB)/8, $0x20a5150beeaae9da
-DATA ·precomputed<>+0x131e8(SB)/8, $0xc935e85d9df630da
-DATA ·precomputed<>+0x131f0(SB)/8, $0x2147144fa1634cd8
-DATA ·precomputed<>+0x131f8(SB)/8, $0x5eccb56c44f3af02
-DATA ·precomputed<>+0x13200(SB)/8, $0x606b8dd13d8f0f15
-DATA ·precomputed<>+0x13208(SB)/8, $0x9bd706ad67b1b0e6
-DATA ·precomputed<>+0x13210(SB)/8, $0xa073b2b2961a5b6a
-DATA ·precomputed<>+0x13218(SB)/8, $0x55f14f9b24266dca
-DATA ·precomputed<>+0x13220(SB)/8, $0x656c2e92bbcd2f03
-DATA ·precomputed<>+0x13228(SB)/8, $0x10d8f1d47d40c854
-DATA ·precomputed<>+0x13230(SB)/8, $0x551d4c9dfaeea060
-DATA ·precomputed<>+0x13238(SB)/8, $0x474d6ccabce5f748
-DATA ·precomputed<>+0x13240(SB)/8, $0x85049d31ab05de1f
-DATA ·precomputed<>+0x13248(SB)/8, $0x2e09b44b41b506aa
-DATA ·precomputed<>+0x13250(SB)/8, $0x5ab9e9b97afb137c
-DATA ·precomputed<>+0x13258(SB)/8, $0x4d229ad94ec04f08
-DATA ·precomputed<>+0x13260(SB)/8, $0xbf139d1342a85d5f
-DATA ·precomputed<>+0x13268(SB)/8, $0x8f654d9261442482
-DATA ·precomputed<>+0x13270(SB)/8, $0x13e016a3697ca423
-DATA ·precomputed<>+0x13278(SB)/8, $0xe717f8e7e9c48669
-DATA ·precomputed<>+0x13280(SB)/8, $0xecc82b4eca1264f1
-DATA ·precomputed<>+0x13288(SB)/8, $0x63ecf49421621b68
-DATA ·precomputed<>+0x13290(SB)/8, $0x594ab6023b41f530
-DATA ·precomputed<>+0x13298(SB)/8, $0x824a43422b7028c
-DATA ·precomputed<>+0x132a0(SB)/8, $0xc3b0293487e8d090
-DATA ·precomputed<>+0x132a8(SB)/8, $0x4df221ceab4200f9
-DATA ·precomputed<>+0x132b0(SB)/8, $0x484954bd5037adb7
-DATA ·precomputed<>+0x132b8(SB)/8, $0xf8fccea9b31e0d16
-DATA ·precomputed<>+0x132c0(SB)/8, $0xb72e04b7f28d4c1f
-DATA ·precomputed<>+0x132c8(SB)/8, $0xb2576c49fce0002f
-DATA ·precomputed<>+0x132d0(SB)/8, $0xb5058b9addc1fbd0
-DATA ·precomputed<>+0x132d8(SB)/8, $0xbb2f37c2e120fa27
-DATA ·precomputed<>+0x132e0(SB)/8, $0x9c46bfe2e0c972ee
-DATA ·precomputed<>+0x132e8(SB)/8, $0xf0fc801b16ba8942
-DATA ·precomputed<>+0x132f0(SB)/8, $0x4c3e0393ba84d20a
-DATA ·precomputed<>+0x132f8(SB)/8, $0x4f74f456b26a009a
-DATA ·precomputed<>+0x13300(SB)/8, $0x565548cd20494027
-DATA ·precomputed<>+0x13308(SB)/8, $0x9f8c5e1aa0cfd7a0
-DATA ·precomputed<>+0x13310(SB)/8, $0xe2f2b29f912d70d5
-DATA ·precomputed<>+0x13318(SB)/8, $0x4216b58823e43459
-DATA ·precomputed<>+0x13320(SB)/8, $0x795c9fee094e5f96
-DATA ·precomputed<>+0x13328(SB)/8, $0xa3f60490a86fb54
-DATA ·precomputed<>+0x13330(SB)/8, $0x1ca1141ee2780501
-DATA ·precomputed<>+0x13338(SB)/8, $0xa986ef6ac070c5e
-DATA ·precomputed<>+0x13340(SB)/8, $0x17a6ae349e710087
-DATA ·precomputed<>+0x13348(SB)/8, $0xb7bc5e93e2cb9a15
-DATA ·precomputed<>+0x13350(SB)/8, $0x1a79cd21468a6c08
-DATA ·precomputed<>+0x13358(SB)/8, $0xdc5a3bc95a87f94c
-DATA ·precomputed<>+0x13360(SB)/8, $0xdd64800ba93f183
-DATA ·precomputed<>+0x13368(SB)/8, $0x859159b42c0d386b
-DATA ·precomputed<>+0x13370(SB)/8, $0x974d4cac06b12e46
-DATA ·precomputed<>+0x13378(SB)/8, $0xe45bf7837f9fac28
-DATA ·precomputed<>+0x13380(SB)/8, $0xed20ac0de3b819b5
-DATA ·precomputed<>+0x13388(SB)/8, $0x9740ccd1fe651816
-DATA ·precomputed<>+0x13390(SB)/8, $0x989aa5a5774d7f74
-DATA ·precomputed<>+0x13398(SB)/8, $0x32ff5f8030e61bc2
-DATA ·precomputed<>+0x133a0(SB)/8, $0x92a8a8893098ed97
-DATA ·precomputed<>+0x133a8(SB)/8, $0x8d783b21298f2fe2
-DATA ·precomputed<>+0x133b0(SB)/8, $0x511366278d20f66b
-DATA ·precomputed<>+0x133b8(SB)/8, $0xc8119ed2b0448746
-DATA ·precomputed<>+0x133c0(SB)/8, $0xf77a79cfc0e2b70a
-DATA ·precomputed<>+0x133c8(SB)/8, $0x2569c8bcee8cbae7
-DATA ·precomputed<>+0x133d0(SB)/8, $0x392a5dbefadb18fc
-DATA ·precomputed<>+0x133d8(SB)/8, $0x59bc96b43ce6a0ff
-DATA ·precomputed<>+0x133e0(SB)/8, $0x287f04f48b551005
-DATA ·precomputed<>+0x133e8(SB)/8, $0x7efe3aa5a44b2bd8
-DATA ·precomputed<>+0x133f0(SB)/8, $0xe9cb8ed6ac447d7
-DATA ·precomputed<>+0x133f8(SB)/8, $0x9b4eb10a7783bdd0
-DATA ·precomputed<>+0x13400(SB)/8, $0xe02952cebe77cf6
-DATA ·precomputed<>+0x13408(SB)/8, $0x18fb90f5521b9e31
-DATA ·precomputed<>+0x13410(SB)/8, $0xe3e610a11b0bd506
-DATA ·precomputed<>+0x13418(SB)/8, $0xb578dedbaa7d3da6
-DATA ·precomputed<>+0x13420(SB)/8, $0x7f09e51ab45acfdd
-DATA ·precomputed<>+0x13428(SB)/8, $0xf8f6fd0f55bfddb5
-DATA ·precomputed<>+0x13430(SB)/8, $0x1fdc4abd1678d2a4
-DATA ·precomputed<>+0x13438(SB)/8, $0xe0fed4b325123e12
-DATA ·precomputed<>+0x13440(SB)/8, $0x6065affc9810517c
-DATA ·precomputed<>+0x13448(SB)/8, $0x82bdc00e145d01a2
-DATA ·precomputed<>+0x13450(SB)/8, $0x98133947856b2f13
-DATA ·precomputed<>+0x13458(SB)/8, $0x412daf1de3e2e5fa
-DATA ·precomputed<>+0x13460(SB)/8, $0x7db5a05bac39a279
-DATA ·precomputed<>+0x13468(SB)/8, $0x30bf4a530c3e40db
-DATA ·precomputed<>+0x13470(SB)/8, $0x4fbea5c6f7c34559
-DATA ·precomputed<>+0x13478(SB)/8, $0x7b911d2b47e6b934
-DATA ·precomputed<>+0x13480(SB)/8, $0xf2e6d1b4d21047b5
-DATA ·precomputed<>+0x13488(SB)/8, $0x1e133ac08b5a62c3
-DATA ·precomputed<>+0x13490(SB)/8, $0x8675ee54172035d4
-DATA ·precomputed<>+0x13498(SB)/8, $0x7d92a16cfd946a70
-DATA ·precomputed<>+0x134a0(SB)/8, $0x50be8f1881839d5f
-DATA ·precomputed<>+0x134a8(SB)/8, $0xfa6490484c7aff15
-DATA ·precomputed<>+0x134b0(SB)/8, $0xf5e17a0959b81cd2
-DATA ·precomputed<>+0x134b8(SB)/8, $0x47cd25a054461ef2
-DATA ·precomputed<>+0x134c0(SB)/8, $0x8daae193112f44be
-DATA ·precomputed<>+0x134c8(SB)/8, $0xd8fc9f4160cfdc75
-DATA ·precomputed<>+0x134d0(SB)/8, $0x16d35f0c5de8a247
-DATA ·precomputed<>+0x134d8(SB)/8, $0x272a6eb345d8977d
-DATA ·precomputed<>+0x134e0(SB)/8, $0x18ffd96e30bf0d59
-DATA ·precomputed<>+0x134e8(SB)/8, $0x3fac65233bb1098c
-DATA ·precomputed<>+0x134f0(SB)/8, $0x58ed64c6d6751ccb
-DATA ·precomputed<>+0x134f8(SB)/8, $0x43bd6a2d435d2311
-DATA ·precomputed<>+0x13500(SB)/8, $0xffb85365ab41c5b5
-DATA ·precomputed<>+0x13508(SB)/8, $0x197b2f7ffa4e293a
-DATA ·precomputed<>+0x13510(SB)/8, $0xab829c8fa2f2e39d
-DATA ·precomputed<>+0x13518(SB)/8, $0xa4639ba2ee4d84bf
-DATA ·precomputed<>+0x13520(SB)/8, $0x106f8b44bfae3164
-DATA ·precomputed<>+0x13528(SB)/8, $0x9dd29821ea7c319e
-DATA ·precomputed<>+0x13530(SB)/8, $0xe421cc1e0eecadee
-DATA ·precomputed<>+0x13538(SB)/8, $0x27ccd239e18bd887
-DATA ·precomputed<>+0x13540(SB)/8, $0x103e2969cac99c3c
-DATA ·precomputed<>+0x13548(SB)/8, $0x7d3847569efb7e7c
-DATA ·precomputed<>+0x13550(SB)/8, $0xd87694809dfd52b9
-DATA ·precomputed<>+0x13558(SB)/8, $0x3c79e484a21df9d7
-DATA ·precomputed<>+0x13560(SB)/8, $0x6b84b588101b225b
-DATA ·precomputed<>+0x13568(SB)/8, $0x5cf6eff07d3d3028
-DATA ·precomputed<>+0x13570(SB)/8, $0x28211d8f37b45a19
-DATA ·precomputed<>+0x13578(SB)/8, $0xc14547b0419e15d7
-DATA ·precomputed<>+0x13580(SB)/8, $0x485e646ffaa8cf98
-DATA ·precomputed<>+0x13588(SB)/8, $0xd8568e3d91863520
-DATA ·precomputed<>+0x13590(SB)/8, $0x38be3e34eddeaae4
-DATA ·precomputed<>+0x13598(SB)/8, $0x9b0c23127aa31d25
-DATA ·precomputed<>+0x135a0(SB)/8, $0x7a6a595f4daeac53
-DATA ·precomputed<>+0x135a8(SB)/8, $0x8f7bdc038105f214
-DATA ·precomputed<>+0x135b0(SB)/8, $0xadc75e16711db462
-DATA ·precomputed<>+0x135b8(SB)/8, $0xf6fee4245b2555cb
-DATA ·precomputed<>+0x135c0(SB)/8, $0x793c4880b981d96f
-DATA ·precomputed<>+0x135c8(SB)/8, $0xf719d828d56fb2a6
-DATA ·precomputed<>+0x135d0(SB)/8, $0x9fcc236f8149057e
-DATA ·precomputed<>+0x135d8(SB)/8, $0x318c63ecb4d65c2b
-DATA ·precomputed<>+0x135e0(SB)/8, $0x5269c1d75f95c5de
-DATA ·precomputed<>+0x135e8(SB)/8, $0x33b3745fd0efd6bc
-DATA ·precomputed<>+0x135f0(SB)/8, $0xace344d54ae87c64
-DATA ·precomputed<>+0x135f8(SB)/8, $0x238809d6dd30ba2c
-DATA ·precomputed<>+0x13600(SB)/8, $0xfc6af05f13bc6d5d
-DATA ·precomputed<>+0x13608(SB)/8, $0xe3206e39b99c1462
-DATA ·precomputed<>+0x13610(SB)/8, $0xcc47c87cacfc63f1
-DATA ·precomputed<>+0x13618(SB)/8, $0x9f2c75677b94cb3a
-DATA ·precomputed<>+0x13620(SB)/8, $0x43bd1e9e77faa425
-DATA ·precomputed<>+0x13628(SB)/8, $0xb80127d11f2b8968
-DATA ·precomputed<>+0x13630(SB)/8, $0x41d33362aaff1b0f
-DATA ·precomputed<>+0x13638(SB)/8, $0x923f361c67f03e16
-DATA ·precomputed<>+0x13640(SB)/8, $0x306c538a7b74634e
-DATA ·precomputed<>+0x13648(SB)/8, $0xaa0ba0e33a25d8ad
-DATA ·precomputed<>+0x13650(SB)/8, $0x5060fedeab2e744f
-DATA ·precomputed<>+0x13658(SB)/8, $0x94d211c1ab9c7db5
-DATA ·precomputed<>+0x13660(SB)/8, $0x46e1c16d5bfc38f7
-DATA ·precomputed<>+0x13668(SB)/8, $0xb4f740e500fd4cce
-DATA ·precomputed<>+0x13670(SB)/8, $0x727f079e1bf1f0e4
-DATA ·precomputed<>+0x13678(SB)/8, $0x1c6b6c2f544cb125
-DATA ·precomputed<>+0x13680(SB)/8, $0xd3781946ccbfb3ba
-DATA ·precomputed<>+0x13688(SB)/8, $0x68aa023c5906ef37
-DATA ·precomputed<>+0x13690(SB)/8, $0xebeb36bb14a42e9f
-DATA ·precomputed<>+0x13698(SB)/8, $0x8fcd2100de307d6e
-DATA ·precomputed<>+0x136a0(SB)/8, $0x785b01917cfc49bd
-DATA ·precomputed<>+0x136a8(SB)/8, $0x3f76cfd96b376d72
-DATA ·precomputed<>+0x136b0(SB)/8, $0xa9fb33eb39043b4c
-DATA ·precomputed<>+0x136b8(SB)/8, $0x9644b6abbd15ced3
-DATA ·precomputed<>+0x136c0(SB)/8, $0xf3c17c36ce7b203d
-DATA ·precomputed<>+0x136c8(SB)/8, $0x5958dcdb1deebe78
-DATA ·precomputed<>+0x136d0(SB)/8, $0xa9569dc51a37559a
-DATA ·precomputed<>+0x136d8(SB)/8, $0x5b1d94e6cc2e1e6d
-DATA ·precomputed<>+0x136e0(SB)/8, $0x2429c79674bc06b8
-DATA ·precomputed<>+0x136e8(SB)/8, $0x98f65fb878cfbf44
-DATA ·precomputed<>+0x136f0(SB)/8, $0x6bc2cbbadc143faa
-DATA ·precomputed<>+0x136f8(SB)/8, $0x406c92ba6d8cf80d
-DATA ·precomputed<>+0x13700(SB)/8, $0x3a89fd54a585637d
-DATA ·precomputed<>+0x13708(SB)/8, $0xc0af32fbe7f83314
-DATA ·precomputed<>+0x13710(SB)/8, $0x6553543c3599bc2b
-DATA ·precomputed<>+0x13718(SB)/8, $0x1d3d40103fd2df8e
-DATA ·precomputed<>+0x13720(SB)/8, $0x8cf1732ebb7a430f
-DATA ·precomputed<>+0x13728(SB)/8, $0x919ce84e95102bfe
-DATA ·precomputed<>+0x13730(SB)/8, $0x49ebdb88d5d6dab
-DATA ·precomputed<>+0x13738(SB)/8, $0x371481c599ccc49c
-DATA ·precomputed<>+0x13740(SB)/8, $0xc9a9b467a0813fcd
-DATA ·precomputed<>+0x13748(SB)/8, $0x6500c523b1319657
-DATA ·precomputed<>+0x13750(SB)/8, $0xc09d99fd24ad03a0
-DATA ·precomputed<>+0x13758(SB)/8, $0x6c792b16c0825c4c
-DATA ·precomputed<>+0x13760(SB)/8, $0x2306b6b7819d2647
-DATA ·precomputed<>+0x13768(SB)/8, $0xfbe90234f6e90caa
-DATA ·precomputed<>+0x13770(SB)/8, $0xa59cb4bae8a99449
-DATA ·precomputed<>+0x13778(SB)/8, $0x284e92f7665c4893
-DATA ·precomputed<>+0x13780(SB)/8, $0xeb0054581026d3cf
-DATA ·precomputed<>+0x13788(SB)/8, $0xe4f2c647cf95b6f8
-DATA ·precomputed<>+0x13790(SB)/8, $0xede6f315f3480ea4
-DATA ·precomputed<>+0x13798(SB)/8, $0x498da8b1aaaab6a6
-DATA ·precomputed<>+0x137a0(SB)/8, $0xd89021ef5d7687b6
-DATA ·precomputed<>+0x137a8(SB)/8, $0x8436d8030ddcd6c1
-DATA ·precomputed<>+0x137b0(SB)/8, $0xc80b0da9dbd4adc4
-DATA ·precomputed<>+0x137b8(SB)/8, $0x282cb4143328db5c
-DATA ·precomputed<>+0x137c0(SB)/8, $0x3cc32acc71192348
-DATA ·precomputed<>+0x137c8(SB)/8, $0x545401bf3f17ef60
-DATA ·precomputed<>+0x137d0(SB)/8, $0xe209a493cde25b0e
-DATA ·precomputed<>+0x137d8(SB)/8, $0x5c11886b663abab9
-DATA ·precomputed<>+0x137e0(SB)/8, $0xe61a81b128ec7c90
-DATA ·precomputed<>+0x137e8(SB)/8, $0x18b125a675b57f5c
-DATA ·precomputed<>+0x137f0(SB)/8, $0x86d1b45afad91696
-DATA ·precomputed<>+0x137f8(SB)/8, $0xb31a786da4c3f7ff
-DATA ·precomputed<>+0x13800(SB)/8, $0x38cf4c6f8d9fceb6
-DATA ·precomputed<>+0x13808(SB)/8, $0x11e85f78ab528f38
-DATA ·precomputed<>+0x13810(SB)/8, $0xe2896d2552303b2b
-DATA ·precomputed<>+0x13818(SB)/8, $0xf929675aed68c605
-DATA ·precomputed<>+0x13820(SB)/8, $0xfbd2237410c708a9
-DATA ·precomputed<>+0x13828(SB)/8, $0x4682ca1740d7e5a7
-DATA ·precomputed<>+0x13830(SB)/8, $0x4242b5c59041047f
-DATA ·precomputed<>+0x13838(SB)/8, $0xaf5710530f9c6840
-DATA ·precomputed<>+0x13840(SB)/8, $0x218e68f9e5137d20
-DATA ·precomputed<>+0x13848(SB)/8, $0x79588cba0f7e70ad
-DATA ·precomputed<>+0x13850(SB)/8, $0xb6d37f5258b86b0a
-DATA ·precomputed<>+0x13858(SB)/8, $0xcb281c987cc591fe
-DATA ·precomputed<>+0x13860(SB)/8, $0x30e03fed8fe40e06
-DATA ·precomputed<>+0x13868(SB)/8, $0x394ded95ed9ca793
-DATA ·precomputed<>+0x13870(SB)/8, $0xf1d22cddbcd3a3da
-DATA ·precomputed<>+0x13878(SB)/8, $0xcb88cb270c591130
-DATA ·precomputed<>+0x13880(SB)/8, $0xb1d858daf4e36023
-DATA ·precomputed<>+0x13888(SB)/8, $0x4a1282ce73e6dee1
-DATA ·precomputed<>+0x13890(SB)/8, $0x6ba8f8bace1d71cc
-DATA ·precomputed<>+0x13898(SB)/8, $0xf5b7d6b4cbbd8eb9
-DATA ·precomputed<>+0x138a0(SB)/8, $0x60f8bd505aed382b
-DATA ·precomputed<>+0x138a8(SB)/8, $0x47b405193f3a46b1
-DATA ·precomputed<>+0x138b0(SB)/8, $0xaed13bb98a997d93
-DATA ·precomputed<>+0x138b8(SB)/8, $0x6cc2260e4dc6e35d
-DATA ·precomputed<>+0x138c0(SB)/8, $0x28c7eb34649966a5
-DATA ·precomputed<>+0x138c8(SB)/8, $0x97587f4f26639e19
-DATA ·precomputed<>+0x138d0(SB)/8, $0x724cc000bce0f38
-DATA ·precomputed<>+0x138d8(SB)/8, $0x63578add4ae6280a
-DATA ·precomputed<>+0x138e0(SB)/8, $0xf1beaa57c7fd6a1a
-DATA ·precomputed<>+0x138e8(SB)/8, $0x83b1a5337b017e35
-DATA ·precomputed<>+0x138f0(SB)/8, $0x1c027e3efdf2ed1
-DATA ·precomputed<>+0x138f8(SB)/8, $0xf373d4ead2d31852
-DATA ·precomputed<>+0x13900(SB)/8, $0x25f7e61a42db4eb8
-DATA ·precomputed<>+0x13908(SB)/8, $0xfdf055753d62869d
-DATA ·precomputed<>+0x13910(SB)/8, $0x8b36a74452b31c23
-DATA ·precomputed<>+0x13918(SB)/8, $0x83b83c891a5e8d4c
-DATA ·precomputed<>+0x13920(SB)/8, $0x72d38dd35d9208bf
-DATA ·precomputed<>+0x13928(SB)/8, $0xbeb8873b8cf7b6f4
-DATA ·precomputed<>+0x13930(SB)/8, $0xa3ec5c36cf90bcb6
-DATA ·precomputed<>+0x13938(SB)/8, $0x35adda6f9a6d5fe7
-DATA ·precomputed<>+0x13940(SB)/8, $0x33f1ef5ee49aebb8
-DATA ·precomputed<>+0x13948(SB)/8, $0x9ead51e40fcea2c1
-DATA ·precomputed<>+0x13950(SB)/8, $0x1f800a68f8503f28
-DATA ·precomputed<>+0x13958(SB)/8, $0x7881853134a75f67
-DATA ·precomputed<>+0x13960(SB)/8, $0x1aeb3760b70ffb27
-DATA ·precomputed<>+0x13968(SB)/8, $0x1cca590acb6309e9
-DATA ·precomputed<>+0x13970(SB)/8, $0x8d09f3607170d241
-DATA ·precomputed<>+0x13978(SB)/8, $0xbc970b5ba0e0d0f8
-DATA ·precomputed<>+0x13980(SB)/8, $0xf94742afb4dcddad
-DATA ·precomputed<>+0x13988(SB)/8, $0xc49cfa2144601959
-DATA ·precomputed<>+0x13990(SB)/8, $0x7b3f1d130c18470
-DATA ·precomputed<>+0x13998(SB)/8, $0x2736cb996e6afc82
-DATA ·precomputed<>+0x139a0(SB)/8, $0x401fb234e24a8785
-DATA ·precomputed<>+0x139a8(SB)/8, $0x9af8ba40074f51ea
-DATA ·precomputed<>+0x139b0(SB)/8, $0xe1acc646a9faed0c
-DATA ·precomputed<>+0x139b8(SB)/8, $0xd5a5f789c9667008
-DATA ·precomputed<>+0x139c0(SB)/8, $0xfe8d546827502203
-DATA ·precomputed<>+0x139c8(SB)/8, $0x985039d458ade316
-DATA ·precomputed<>+0x139d0(SB)/8, $0xefd373f10a687415
-DATA ·precomputed<>+0x139d8(SB)/8, $0xefccb79143526774
-DATA ·precomputed<>+0x139e0(SB)/8, $0xeef8d46e0f4497d9
-DATA ·precomputed<>+0x139e8(SB)/8, $0x4152df711601ab9a
-DATA ·precomputed<>+0x139f0(SB)/8, $0x4250cd2fe47b2ad1
-DATA ·precomputed<>+0x139f8(SB)/8, $0xa2b63fa5fb048180
-DATA ·precomputed<>+0x13a00(SB)/8, $0x16b922d946695a4d
-DATA ·precomputed<>+0x13a08(SB)/8, $0x8aa851a13fa3c91d
-DATA ·precomputed<>+0x13a10(SB)/8, $0xde2d1fd9e055c2a2
-DATA ·precomputed<>+0x13a18(SB)/8, $0x7efc4e4e85493a80
-DATA ·precomputed<>+0x13a20(SB)/8, $0xdfa4e4c3671d6232
-DATA ·precomputed<>+0x13a28(SB)/8, $0xed0e67dd7cab7113
-DATA ·precomputed<>+0x13a30(SB)/8, $0x3d7fe9e11a30ccaa
-DATA ·precomputed<>+0x13a38(SB)/8, $0x84b85c8e54418418
-DATA ·precomputed<>+0x13a40(SB)/8, $0xa45d74f21ceaba59
-DATA ·precomputed<>+0x13a48(SB)/8, $0x613f09b8e94874e
-DATA ·precomputed<>+0x13a50(SB)/8, $0xc281c6877eda11e7
-DATA ·precomputed<>+0x13a58(SB)/8, $0x49018ac6b455e204
-DATA ·precomputed<>+0x13a60(SB)/8, $0x8d4cfe3f5ab41803
-DATA ·precomputed<>+0x13a68(SB)/8, $0xef94de5a17b69547
-DATA ·precomputed<>+0x13a70(SB)/8, $0x77ee24d61fd78468
-DATA ·precomputed<>+0x13a78(SB)/8, $0x86025ade341b1787
-DATA ·precomputed<>+0x13a80(SB)/8, $0x56e9e07c82dd353d
-DATA ·precomputed<>+0x13a88(SB)/8, $0xa6693268cfc51bfa
-DATA ·precomputed<>+0x13a90(SB)/8, $0xc50303f7ace1c83d
-DATA ·precomputed<>+0x13a98(SB)/8, $0x6a520a803b192c6f
-DATA ·precomputed<>+0x13aa0(SB)/8, $0xd31c053357d79399
-DATA ·precomputed<>+0x13aa8(SB)/8, $0x6832af4bab40e6fc
-DATA ·precomputed<>+0x13ab0(SB)/8, $0x528759bf0e22d5b5
-DATA ·precomputed<>+0x13ab8(SB)/8, $0x6272c58d4be67853
-DATA ·precomputed<>+0x13ac0(SB)/8, $0xf72e4ab81e6799c9
-DATA ·precomputed<>+0x13ac8(SB)/8, $0x4c0452b01d148443
-DATA ·precomputed<>+0x13ad0(SB)/8, $0x4f3d6068b1a73a1b
-DATA ·precomputed<>+0x13ad8(SB)/8, $0x53d967d8da9183dc
-DATA ·precomputed<>+0x13ae0(SB)/8, $0xc6ba6305308773b3
-DATA ·precomputed<>+0x13ae8(SB)/8, $0xc5535c5db107d1c4
-DATA ·precomputed<>+0x13af0(SB)/8, $0x82492b6d5d2936f7
-DATA ·precomputed<>+0x13af8(SB)/8, $0x2207d5e4ba0f0e72
-DATA ·precomputed<>+0x13b00(SB)/8, $0x303bbe9846917ce
-DATA ·precomputed<>+0x13b08(SB)/8, $0x466c0d030a5a356
-DATA ·precomputed<>+0x13b10(SB)/8, $0xa99a5c8aec2c0d0a
-DATA ·precomputed<>+0x13b18(SB)/8, $0x3b9174bc94e67cc6
-DATA ·precomputed<>+0x13b20(SB)/8, $0x2ab8c16897d997f5
-DATA ·precomputed<>+0x13b28(SB)/8, $0x7b99f262f879a4d
-DATA ·precomputed<>+0x13b30(SB)/8, $0x35e55b17c94538ac
-DATA ·precomputed<>+0x13b38(SB)/8, $0x4be7c0a261ddb5c5
-DATA ·precomputed<>+0x13b40(SB)/8, $0xc5859192235222af
-DATA ·precomputed<>+0x13b48(SB)/8, $0xa5542f04f921f899
-DATA ·precomputed<>+0x13b50(SB)/8, $0x5d4a90a7db5f8318
-DATA ·precomputed<>+0x13b58(SB)/8, $0x3d021272659b888a
-DATA ·precomputed<>+0x13b60(SB)/8, $0x8ffd64e3a77e15c9
-DATA ·precomputed<>+0x13b68(SB)/8, $0x6aebea290654524c
-DATA ·precomputed<>+0x13b70(SB)/8, $0x94203fd9f0af7e6a
-DATA ·precomputed<>+0x13b78(SB)/8, $0x8049cde950a3042f
-DATA ·precomputed<>+0x13b80(SB)/8, $0x71dab97d05c02b9c
-DATA ·precomputed<>+0x13b88(SB)/8, $0xc7c1da2992dbcfa4
-DATA ·precomputed<>+0x13b90(SB)/8, $0xa8e98836eb9af1f1
-DATA ·precomputed<>+0x13b98(SB)/8, $0xb64ef430faafa857
-DATA ·precomputed<>+0x13ba0(SB)/8, $0x7f27bab1b251b6ee
-DATA ·precomputed<>+0x13ba8(SB)/8, $0xf50c283d6725368c
-DATA ·precomputed<>+0x13bb0(SB)/8, $0x321ad760015ae43d
-DATA ·precomputed<>+0x13bb8(SB)/8, $0xedd4dfea37ced551
-DATA ·precomputed<>+0x13bc0(SB)/8, $0xd8a6cb6f787d1f1c
-DATA ·precomputed<>+0x13bc8(SB)/8, $0x427bac943d219a66
-DATA ·precomputed<>+0x13bd0(SB)/8, $0x51d7d49f383146b0
-DATA ·precomputed<>+0x13bd8(SB)/8, $0x8164b77f7863d781
-DATA ·precomputed<>+0x13be0(SB)/8, $0x1646b0842f9631b8
-DATA ·precomputed<>+0x13be8(SB)/8, $0xef5b3aa8849388df
-DATA ·precomputed<>+0x13bf0(SB)/8, $0x60536422e58cd383
-DATA ·precomputed<>+0x13bf8(SB)/8, $0xb079d911f43ea3a0
-DATA ·precomputed<>+0x13c00(SB)/8, $0x29f1dbeee157db09
-DATA ·precomputed<>+0x13c08(SB)/8, $0xe58fa12caca6d944
-DATA ·precomputed<>+0x13c10(SB)/8, $0xa80f7c684c657bad
-DATA ·precomputed<>+0x13c18(SB)/8, $0x90bb6583e96a0e2f
-DATA ·precomputed<>+0x13c20(SB)/8, $0xe90e585e3906242c
-DATA ·precomputed<>+0x13c28(SB)/8, $0xbf3fd645bbb5f7b5
-DATA ·precomputed<>+0x13c30(SB)/8, $0xdd4ca3f94458dc7
-DATA ·precomputed<>+0x13c38(SB)/8, $0x1485d004651c09ec
-DATA ·precomputed<>+0x13c40(SB)/8, $0xa148f295bab9e98f
-DATA ·precomputed<>+0x13c48(SB)/8, $0x72c441a03d4db5cd
-DATA ·precomputed<>+0x13c50(SB)/8, $0xae26e1b8e1f0c093
-DATA ·precomputed<>+0x13c58(SB)/8, $0x2ed402dff50b0c3d
-DATA ·precomputed<>+0x13c60(SB)/8, $0x52b84999db77e357
-DATA ·precomputed<>+0x13c68(SB)/8, $0x184a672dd2c3d2e4
-DATA ·precomputed<>+0x13c70(SB)/8, $0xc894bcab666c01e7
-DATA ·precomputed<>+0x13c78(SB)/8, $0x536e30805538e5dd
-DATA ·precomputed<>+0x13c80(SB)/8, $0xbc8d07c1cf4ac935
-DATA ·precomputed<>+0x13c88(SB)/8, $0x793c35a3540158e6
-DATA ·precomputed<>+0x13c90(SB)/8, $0xebaf3f5cf93c57da
-DATA ·precomputed<>+0x13c98(SB)/8, $0x5dfb88ff5e372c8d
-DATA ·precomputed<>+0x13ca0(SB)/8, $0x14f26cd7c7b117f4
-DATA ·precomputed<>+0x13ca8(SB)/8, $0xdff7499f9524c29b
-DATA ·precomputed<>+0x13cb0(SB)/8, $0x7495567158ebea59
-DATA ·precomputed<>+0x13cb8(SB)/8, $0xdc31bb9976faaad8
-DATA ·precomputed<>+0x13cc0(SB)/8, $0x82c1234ea69b16e8
-DATA ·precomputed<>+0x13cc8(SB)/8, $0xcef457bb31d5e5a7
-DATA ·precomputed<>+0x13cd0(SB)/8, $0x906b1d3021b57835
-DATA ·precomputed<>+0x13cd8(SB)/8, $0x98fccf88af260ddf
-DATA ·precomputed<>+0x13ce0(SB)/8, $0x47baf0d389fa01e
-DATA ·precomputed<>+0x13ce8(SB)/8, $0x920c8f4980e60cca
-DATA ·precomputed<>+0x13cf0(SB)/8, $0xf9674c7ce3e5dd23
-DATA ·precomputed<>+0x13cf8(SB)/8, $0x63ba7e5efab841cd
-DATA ·precomputed<>+0x13d00(SB)/8, $0x1fa5187c56cc33bd
-DATA ·precomputed<>+0x13d08(SB)/8, $0x57122220a75cbe3d
-DATA ·precomputed<>+0x13d10(SB)/8, $0x2243e9f76439a8e9
-DATA ·precomputed<>+0x13d18(SB)/8, $0x8a7eb28dc57fbfc9
-DATA ·precomputed<>+0x13d20(SB)/8, $0xf2293f3bb848d5a1
-DATA ·precomputed<>+0x13d28(SB)/8, $0xf8bebaa943739ec1
-DATA ·precomputed<>+0x13d30(SB)/8, $0x7bd0142ddbfd61cf
-DATA ·precomputed<>+0x13d38(SB)/8, $0x68e7784b5a6bbb70
-DATA ·precomputed<>+0x13d40(SB)/8, $0x86df7966ca57872
-DATA ·precomputed<>+0x13d48(SB)/8, $0x5de899f753520019
-DATA ·precomputed<>+0x13d50(SB)/8, $0x65bc6619434e4866
-DATA ·precomputed<>+0x13d58(SB)/8, $0x5a38072b5a76d591
-DATA ·precomputed<>+0x13d60(SB)/8, $0x48726dbacb49a296
-DATA ·precomputed<>+0x13d68(SB)/8, $0x5e30d9be6b64a240
-DATA ·precomputed<>+0x13d70(SB)/8, $0x4098fcdba69b448
-DATA ·precomputed<>+0x13d78(SB)/8, $0x6d5af00ab26c16db
-DATA ·precomputed<>+0x13d80(SB)/8, $0xfbf11cd0499e7c46
-DATA ·precomputed<>+0x13d88(SB)/8, $0x5fdd1298c5e07b70
-DATA ·precomputed<>+0x13d90(SB)/8, $0x7d6f30d4a97e42d4
-DATA ·precomputed<>+0x13d98(SB)/8, $0x7de6cc3ad1e909b4
-DATA ·precomputed<>+0x13da0(SB)/8, $0xbae76d0198df1628
-DATA ·precomputed<>+0x13da8(SB)/8, $0x9289ff6382216f58
-DATA ·precomputed<>+0x13db0(SB)/8, $0x19b963e1691b8c29
-DATA ·precomputed<>+0x13db8(SB)/8, $0xd6ab21687e0a6c7b
-DATA ·precomputed<>+0x13dc0(SB)/8, $0x3d55329398388469
-DATA ·precomputed<>+0x13dc8(SB)/8, $0x92a97796c5520813
-DATA ·precomputed<>+0x13dd0(SB)/8, $0xc2bb329ebfc8b1b2
-DATA ·precomputed<>+0x13dd8(SB)/8, $0x3c6f15321e83e8e2
-DATA ·precomputed<>+0x13de0(SB)/8, $0xbe61d963e4290a26
-DATA ·precomputed<>+0x13de8(SB)/8, $0xd35af4d09cf40c05
-DATA ·precomputed<>+0x13df0(SB)/8, $0xfac154d763e829f7
-DATA ·precomputed<>+0x13df8(SB)/8, $0xe1d9debb36dc9a8e
-DATA ·precomputed<>+0x13e00(SB)/8, $0x482368fe0858df4
-DATA ·precomputed<>+0x13e08(SB)/8, $0x46b71358516663ae
-DATA ·precomputed<>+0x13e10(SB)/8, $0xfa4fa557392e147f
-DATA ·precomputed<>+0x13e18(SB)/8, $0x6176ccd9bcbf3e84
-DATA ·precomputed<>+0x13e20(SB)/8, $0x641a57e68e4a5483
-DATA ·precomputed<>+0x13e28(SB)/8, $0x554ea1bca8baa6c7
-DATA ·precomputed<>+0x13e30(SB)/8, $0xc5923c383346f418
-DATA ·precomputed<>+0x13e38(SB)/8, $0x40c894a42f3a9351
-DATA ·precomputed<>+0x13e40(SB)/8, $0xd8469c0f0148b5ef
-DATA ·precomputed<>+0x13e48(SB)/8, $0x66f29f7099813e17
-DATA ·precomputed<>+0x13e50(SB)/8, $0xef57d8717b3d942f
-DATA ·precomputed<>+0x13e58(SB)/8, $0xb554825592d17983
-DATA ·precomputed<>+0x13e60(SB)/8, $0xe42bbda0d97650bf
-DATA ·precomputed<>+0x13e68(SB)/8, $0x396a6143a2eb0e1d
-DATA ·precomputed<>+0x13e70(SB)/8, $0xcfe01eb094b9644a
-DATA ·precomputed<>+0x13e78(SB)/8, $0xb4147accbe0d659e
-DATA ·precomputed<>+0x13e80(SB)/8, $0x3884727960c4cb95
-DATA ·precomputed<>+0x13e88(SB)/8, $0x8d34e6f7f3d4a41d
-DATA ·precomputed<>+0x13e90(SB)/8, $0xc93476473e7a9853
-DATA ·precomputed<>+0x13e98(SB)/8, $0x84b7179df33b4678
-DATA ·precomputed<>+0x13ea0(SB)/8, $0x29d9a7bbc43f4f57
-DATA ·precomputed<>+0x13ea8(SB)/8, $0xbf40a6212e2385e6
-DATA ·precomputed<>+0x13eb0(SB)/8, $0xff79032d4e840462
-DATA ·precomputed<>+0x13eb8(SB)/8, $0x2ecd1d4ec4a8fb56
-DATA ·precomputed<>+0x13ec0(SB)/8, $0x151363e677bca788
-DATA ·precomputed<>+0x13ec8(SB)/8, $0xbe8d0aee27d39458
-DATA ·precomputed<>+0x13ed0(SB)/8, $0x4dbde8185b24e144
-DATA ·precomputed<>+0x13ed8(SB)/8, $0x123188f4d65e528e
-DATA ·precomputed<>+0x13ee0(SB)/8, $0xa4f8b9cefe44eb7
-DATA ·precomputed<>+0x13ee8(SB)/8, $0x31f9f1a13e76be29
-DATA ·precomputed<>+0x13ef0(SB)/8, $0x1910bc8e462f744
-DATA ·precomputed<>+0x13ef8(SB)/8, $0xf2e6f9dae037a737
-DATA ·precomputed<>+0x13f00(SB)/8, $0x77ad38c3d47ff23f
-DATA ·precomputed<>+0x13f08(SB)/8, $0x67dffac8db777a64
-DATA ·precomputed<>+0x13f10(SB)/8, $0x8cafa3da343035ce
-DATA ·precomputed<>+0x13f18(SB)/8, $0xd91f36a2c032f182
-DATA ·precomputed<>+0x13f20(SB)/8, $0xcaf2a8f1eecca69a
-DATA ·precomputed<>+0x13f28(SB)/8, $0x266fd9c3bf0da6f8
-DATA ·precomputed<>+0x13f30(SB)/8, $0x21edf04bdaee752e
-DATA ·precomputed<>+0x13f38(SB)/8, $0xf72fb44d8a5cae2d
-DATA ·precomputed<>+0x13f40(SB)/8, $0xbd1d65cb8825c654
-DATA ·precomputed<>+0x13f48(SB)/8, $0xbd0a4bbb62d5765b
-DATA ·precomputed<>+0x13f50(SB)/8, $0x23594350380b921d
-DATA ·precomputed<>+0x13f58(SB)/8, $0x1c91c8f3130afc2d
-DATA ·precomputed<>+0x13f60(SB)/8, $0xd25c2dc2533d6f36
-DATA ·precomputed<>+0x13f68(SB)/8, $0x57cf6b702133b998
-DATA ·precomputed<>+0x13f70(SB)/8, $0xc1b1c5d1174657d1
-DATA ·precomputed<>+0x13f78(SB)/8, $0x917b052f52e2ebdd
-DATA ·precomputed<>+0x13f80(SB)/8, $0x34bdd04702ae4232
-DATA ·precomputed<>+0x13f88(SB)/8, $0x2eebe0fd982b4a15
-DATA ·precomputed<>+0x13f90(SB)/8, $0xb6d91a8aff6136f0
-DATA ·precomputed<>+0x13f98(SB)/8, $0x97ce475a1acf9f49
-DATA ·precomputed<>+0x13fa0(SB)/8, $0x8e876a34b2324d52
-DATA ·precomputed<>+0x13fa8(SB)/8, $0x9c4316f150aad8d
-DATA ·precomputed<>+0x13fb0(SB)/8, $0x9bc76a1cc1dc08f0
-DATA ·precomputed<>+0x13fb8(SB)/8, $0x8feaceb460a902c2
-DATA ·precomputed<>+0x13fc0(SB)/8, $0x504ac041cb73887e
-DATA ·precomputed<>+0x13fc8(SB)/8, $0xf878b618c3ce3a33
-DATA ·precomputed<>+0x13fd0(SB)/8, $0x57ef73d556393e75
-DATA ·precomputed<>+0x13fd8(SB)/8, $0xe4372d2ed276c08c
-DATA ·precomputed<>+0x13fe0(SB)/8, $0xfd9bc8940924cf58
-DATA ·precomputed<>+0x13fe8(SB)/8, $0xfa2a4debaaa317e2
-DATA ·precomputed<>+0x13ff0(SB)/8, $0xe51edccc79608da5
-DATA ·precomputed<>+0x13ff8(SB)/8, $0xadcc68fa8cd4b960
-DATA ·precomputed<>+0x14000(SB)/8, $0x6f154f09a9e0eeae
-DATA ·precomputed<>+0x14008(SB)/8, $0x2246e6feab05a657
-DATA ·precomputed<>+0x14010(SB)/8, $0x4d7c1c811045b85d
-DATA ·precomputed<>+0x14018(SB)/8, $0xde99ea37d3bb7432
-DATA ·precomputed<>+0x14020(SB)/8, $0x58f818763184ff4
-DATA ·precomputed<>+0x14028(SB)/8, $0x2a223421d134bfc3
-DATA ·precomputed<>+0x14030(SB)/8, $0x1560dbed23120320
-DATA ·precomputed<>+0x14038(SB)/8, $0x37243c9576a3de9c
-DATA ·precomputed<>+0x14040(SB)/8, $0x9f116c8fe256b02b
-DATA ·precomputed<>+0x14048(SB)/8, $0x71495693fa5946e0
-DATA ·precomputed<>+0x14050(SB)/8, $0xeb9696ffc335452a
-DATA ·precomputed<>+0x14058(SB)/8, $0x1ca59294971162e
-DATA ·precomputed<>+0x14060(SB)/8, $0xee0a1f50c0f28e72
-DATA ·precomputed<>+0x14068(SB)/8, $0x2baac62c70d8df1a
-DATA ·precomputed<>+0x14070(SB)/8, $0xcf65d297f49110f8
-DATA ·precomputed<>+0x14078(SB)/8, $0x41dbb019a45e16a
-DATA ·precomputed<>+0x14080(SB)/8, $0x20b19223d39e1ee4
-DATA ·precomputed<>+0x14088(SB)/8, $0xc8832a2c4e3e6c2c
-DATA ·precomputed<>+0x14090(SB)/8, $0x64a8f43da3a45c34
-DATA ·precomputed<>+0x14098(SB)/8, $0x52a05eef21fb291b
-DATA ·precomputed<>+0x140a0(SB)/8, $0x10d3e24ae4b68e38
-DATA ·precomputed<>+0x140a8(SB)/8, $0x5289120aee2d8a40
-DATA ·precomputed<>+0x140b0(SB)/8, $0x33836b98425b7da8
-DATA ·precomputed<>+0x140b8(SB)/8, $0x5bd418f3b00c64e1
-DATA ·precomputed<>+0x140c0(SB)/8, $0x31a02241cedc2c97
-DATA ·precomputed<>+0x140c8(SB)/8, $0xf0f5489b2b632641
-DATA ·precomputed<>+0x140d0(SB)/8, $0xb09091ddcbfb588f
-DATA ·precomputed<>+0x140d8(SB)/8, $0x5ffd0f385d9478e7
-DATA ·precomputed<>+0x140e0(SB)/8, $0x13f141a1dae35eda
-DATA ·precomputed<>+0x140e8(SB)/8, $0xd25563a662f0b26c
-DATA ·precomputed<>+0x140f0(SB)/8, $0x80014b171b9dde18
-DATA ·precomputed<>+0x140f8(SB)/8, $0x9fcf8f817da49b36
-DATA ·precomputed<>+0x14100(SB)/8, $0xbe99380540096f25
-DATA ·precomputed<>+0x14108(SB)/8, $0x900d4bddec820131
-DATA ·precomputed<>+0x14110(SB)/8, $0x2170cfd32a993f9c
-DATA ·precomputed<>+0x14118(SB)/8, $0xa0e3d8942dfe1007
-DATA ·precomputed<>+0x14120(SB)/8, $0x600d0b5a0e7df109
-DATA ·precomputed<>+0x14128(SB)/8, $0xc904985a47fde3dd
-DATA ·precomputed<>+0x14130(SB)/8, $0x15597a84cb89816a
-DATA ·precomputed<>+0x14138(SB)/8, $0x8ac8b027b9dfeb9e
-DATA ·precomputed<>+0x14140(SB)/8, $0x9e3ea4fdf7a2df83
-DATA ·precomputed<>+0x14148(SB)/8, $0x8b68b26b64524d44
-DATA ·precomputed<>+0x14150(SB)/8, $0x74caeeab126aee21
-DATA ·precomputed<>+0x14158(SB)/8, $0x590a00a5915d9e1c
-DATA ·precomputed<>+0x14160(SB)/8, $0x5ae2a6ab49b90eff
-DATA ·precomputed<>+0x14168(SB)/8, $0x74b4cb1e2df4fe51
-DATA ·precomputed<>+0x14170(SB)/8, $0x306ed1107fcb6ed
-DATA ·precomputed<>+0x14178(SB)/8, $0x564ebe2e502f5b30
-DATA ·precomputed<>+0x14180(SB)/8, $0xb0f7a0c3c0a9d128
-DATA ·precomputed<>+0x14188(SB)/8, $0x2adc34d3aed0d3bd
-DATA ·precomputed<>+0x14190(SB)/8, $0x4ebf577813e778e6
-DATA ·precomputed<>+0x14198(SB)/8, $0xd3b89bd0bb8476ba
-DATA ·precomputed<>+0x141a0(SB)/8, $0xe09eb52837413953
-DATA ·precomputed<>+0x141a8(SB)/8, $0x952b705cd8ba3471
-DATA ·precomputed<>+0x141b0(SB)/8, $0xcaa81ade86a79c09
-DATA ·precomputed<>+0x141b8(SB)/8, $0xc08eed3d7e0e7b2a
-DATA ·precomputed<>+0x141c0(SB)/8, $0x2ea0c2bc9a8f42ff
-DATA ·precomputed<>+0x141c8(SB)/8, $0x629742404a1c029a
-DATA ·precomputed<>+0x141d0(SB)/8, $0x5ee5f5f69e4dd41b
-DATA ·precomputed<>+0x141d8(SB)/8, $0x5b1bba802e110249
-DATA ·precomputed<>+0x141e0(SB)/8, $0x5ac4eadb78da8016
-DATA ·precomputed<>+0x141e8(SB)/8, $0xc29787801809f79f
-DATA ·precomputed<>+0x141f0(SB)/8, $0x39d2dbcee3f8c0de
-DATA ·precomputed<>+0x141f8(SB)/8, $0x7fb4b5fc064d3ba9
-DATA ·precomputed<>+0x14200(SB)/8, $0x29f1cb22e1dc01e
-DATA ·precomputed<>+0x14208(SB)/8, $0x7699d92efc429483
-DATA ·precomputed<>+0x14210(SB)/8, $0xee0e425a154560f0
-DATA ·precomputed<>+0x14218(SB)/8, $0x3f5cdfe6787b6641
-DATA ·precomputed<>+0x14220(SB)/8, $0x726d87bbe5f6794e
-DATA ·precomputed<>+0x14228(SB)/8, $0x97d7358823aecad2
-DATA ·precomputed<>+0x14230(SB)/8, $0x47f4f5b909ca351c
-DATA ·precomputed<>+0x14238(SB)/8, $0xd742ef4b57dc5e3b
-DATA ·precomputed<>+0x14240(SB)/8, $0xbf389d37717381ea
-DATA ·precomputed<>+0x14248(SB)/8, $0xefd9e98406bc5bcb
-DATA ·precomputed<>+0x14250(SB)/8, $0xcc8bc96067ff1110
-DATA ·precomputed<>+0x14258(SB)/8, $0xd3414c0bb05612e4
-DATA ·precomputed<>+0x14260(SB)/8, $0x84e5f05927fad1a
-DATA ·precomputed<>+0x14268(SB)/8, $0x999bd581438e241f
-DATA ·precomputed<>+0x14270(SB)/8, $0xc917602faa4fab8
-DATA ·precomputed<>+0x14278(SB)/8, $0xda0520d295080657
-DATA ·precomputed<>+0x14280(SB)/8, $0x3713ac5be8110ef
-DATA ·precomputed<>+0x14288(SB)/8, $0xaab1917d50f989d3
-DATA ·precomputed<>+0x14290(SB)/8, $0xd80fe98358fe8b0
-DATA ·precomputed<>+0x14298(SB)/8, $0xf6e874c5a7a1f8e3
-DATA ·precomputed<>+0x142a0(SB)/8, $0x5650fd8deb42398
-DATA ·precomputed<>+0x142a8(SB)/8, $0xbad3e0851c44de73
-DATA ·precomputed<>+0x142b0(SB)/8, $0x5369135f1c27f3c2
-DATA ·precomputed<>+0x142b8(SB)/8, $0x14bc04f8a7fc74ac
-DATA ·precomputed<>+0x142c0(SB)/8, $0x44892091e3889cb1
-DATA ·precomputed<>+0x142c8(SB)/8, $0x123fc555d45ae338
-DATA ·precomputed<>+0x142d0(SB)/8, $0x2bc4a9ef02a63128
-DATA ·precomputed<>+0x142d8(SB)/8, $0xb72012c9a1dbb436
-DATA ·precomputed<>+0x142e0(SB)/8, $0x8c75f7b3556a0b46
-DATA ·precomputed<>+0x142e8(SB)/8, $0xe4c6f46c5b7608a4
-DATA ·precomputed<>+0x142f0(SB)/8, $0xb36abf6838fce20f
-DATA ·precomputed<>+0x142f8(SB)/8, $0xb5a8e657bf6c21e1
-DATA ·precomputed<>+0x14300(SB)/8, $0x5028a4fd029c247e
-DATA ·precomputed<>+0x14308(SB)/8, $0xd6225a43a5ae3e76
-DATA ·precomputed<>+0x14310(SB)/8, $0xfb3fa71cf970817e
-DATA ·precomputed<>+0x14318(SB)/8, $0x742168099ab4aef1
-DATA ·precomputed<>+0x14320(SB)/8, $0xca81ee99a39c2f13
-DATA ·precomputed<>+0x14328(SB)/8, $0xa8336e4286a97827
-DATA ·precomputed<>+0x14330(SB)/8, $0xb75aff99b6489555
-DATA ·precomputed<>+0x14338(SB)/8, $0x5b2338e565435c
-DATA ·precomputed<>+0x14340(SB)/8, $0xeae495e3394d7a92
-DATA ·precomputed<>+0x14348(SB)/8, $0xcba23153b90faec0
-DATA ·precomputed<>+0x14350(SB)/8, $0xd687c821f6d9d80c
-DATA ·precomputed<>+0x14358(SB)/8, $0x951dada28bff3082
-DATA ·precomputed<>+0x14360(SB)/8, $0x4e74f1f0701708ad
-DATA ·precomputed<>+0x14368(SB)/8, $0xa6333cd1dd2134f2
-DATA ·precomputed<>+0x14370(SB)/8, $0xeea276cf04665f7c
-DATA ·precomputed<>+0x14378(SB)/8, $0x527257fcae74c17a
-DATA ·precomputed<>+0x14380(SB)/8, $0x8392350a7f76462f
-DATA ·precomputed<>+0x14388(SB)/8, $0x659ce7db0c216ccb
-DATA ·precomputed<>+0x14390(SB)/8, $0xe87a78b7047e35d5
-DATA ·precomputed<>+0x14398(SB)/8, $0x307c48616e0862d6
-DATA ·precomputed<>+0x143a0(SB)/8, $0xd444fb86e70741bd
-DATA ·precomputed<>+0x143a8(SB)/8, $0x1138a886fea1abe2
-DATA ·precomputed<>+0x143b0(SB)/8, $0x4695397d62b79c4f
-DATA ·precomputed<>+0x143b8(SB)/8, $0x11aaf588003130ee
-DATA ·precomputed<>+0x143c0(SB)/8, $0x6607179c7f034ff4
-DATA ·precomputed<>+0x143c8(SB)/8, $0xefb8fcd93781eac2
-DATA ·precomputed<>+0x143d0(SB)/8, $0xfa57f8a97b022138
-DATA ·precomputed<>+0x143d8(SB)/8, $0xc5bb9f1d56ab6039
-DATA ·precomputed<>+0x143e0(SB)/8, $0xf9027e24e4d2ab7f
-DATA ·precomputed<>+0x143e8(SB)/8, $0x3d67ad7177a9e364
-DATA ·precomputed<>+0x143f0(SB)/8, $0xc579e70c1f7f487d
-DATA ·precomputed<>+0x143f8(SB)/8, $0x7fefc8942a7e6bd0
-DATA ·precomputed<>+0x14400(SB)/8, $0xde36d36c7d93b046
-DATA ·precomputed<>+0x14408(SB)/8, $0xc83aa67c9d241fb4
-DATA ·precomputed<>+0x14410(SB)/8, $0xdd53e7f2992bc2d5
-DATA ·precomputed<>+0x14418(SB)/8, $0xfd969d3b946b9181
-DATA ·precomputed<>+0x14420(SB)/8, $0xff784861ff44b668
-DATA ·precomputed<>+0x14428(SB)/8, $0x3e505499912be794
-DATA ·precomputed<>+0x14430(SB)/8, $0x6ce807e7815e96a9
-DATA ·precomputed<>+0x14438(SB)/8, $0xe22fde5666e390a5
-DATA ·precomputed<>+0x14440(SB)/8, $0x4569feb3b074bc5a
-DATA ·precomputed<>+0x14448(SB)/8, $0xa09652558e9a35e3
-DATA ·precomputed<>+0x14450(SB)/8, $0x78e799f8410ff809
-DATA ·precomputed<>+0x14458(SB)/8, $0x3ac2fe4e58f75074
-DATA ·precomputed<>+0x14460(SB)/8, $0x58e906cd15f9205c
-DATA ·precomputed<>+0x14468(SB)/8, $0x996b4fa296a2f89f
-DATA ·precomputed<>+0x14470(SB)/8, $0xcca76bc970ab5ce1
-DATA ·precomputed<>+0x14478(SB)/8, $0x1ec3ecc16d537197
-DATA ·precomputed<>+0x14480(SB)/8, $0x852f3e2f6889d2b3
-DATA ·precomputed<>+0x14488(SB)/8, $0x888219ea9c184820
-DATA ·precomputed<>+0x14490(SB)/8, $0x94a5d076d24954b9
-DATA ·precomputed<>+0x14498(SB)/8, $0xabf2bc73d7ba8128
-DATA ·precomputed<>+0x144a0(SB)/8, $0x2ba6a613eba8717c
-DATA ·precomputed<>+0x144a8(SB)/8, $0x11b05bfd6270144c
-DATA ·precomputed<>+0x144b0(SB)/8, $0x92a7ca2822015973
-DATA ·precomputed<>+0x144b8(SB)/8, $0xd05be2b04cb7de3e
-DATA ·precomputed<>+0x144c0(SB)/8, $0x1498e0161b01f265
-DATA ·precomputed<>+0x144c8(SB)/8, $0xde7461a6345482c4
-DATA ·precomputed<>+0x144d0(SB)/8, $0xec57f4ae1317eff4
-DATA ·precomputed<>+0x144d8(SB)/8, $0x12fd9e2d30c9585
-DATA ·precomputed<>+0x144e0(SB)/8, $0xf012d71af9db0c26
-DATA ·precomputed<>+0x144e8(SB)/8, $0x6f1188f2e009bc88
-DATA ·precomputed<>+0x144f0(SB)/8, $0x3537b8d751123940
-DATA ·precomputed<>+0x144f8(SB)/8, $0x6ad276023f49a253
-DATA ·precomputed<>+0x14500(SB)/8, $0x26e74f414b1e3ca9
-DATA ·precomputed<>+0x14508(SB)/8, $0xfc22036f243fc01f
-DATA ·precomputed<>+0x14510(SB)/8, $0x37e74b752ceb803e
-DATA ·precomputed<>+0x14518(SB)/8, $0x9475424ffcd882a
-DATA ·precomputed<>+0x14520(SB)/8, $0x9a9700a6707d207d
-DATA ·precomputed<>+0x14528(SB)/8, $0xbfcc08104adc95d3
-DATA ·precomputed<>+0x14530(SB)/8, $0x5d09d308d6363bd1
-DATA ·precomputed<>+0x14538(SB)/8, $0x374e4e2dff810a77
-DATA ·precomputed<>+0x14540(SB)/8, $0x872c50fd96eff0b7
-DATA ·precomputed<>+0x14548(SB)/8, $0xd8afd7b347a1ddcb
-DATA ·precomputed<>+0x14550(SB)/8, $0x879d60a68f109827
-DATA ·precomputed<>+0x14558(SB)/8, $0x8c549431900a5cea
-DATA ·precomputed<>+0x14560(SB)/8, $0xd06c2e2f2dd6c7f0
-DATA ·precomputed<>+0x14568(SB)/8, $0x9ad5fed4e36eea29
-DATA ·precomputed<>+0x14570(SB)/8, $0x209b0f9c224ba2da
-DATA ·precomputed<>+0x14578(SB)/8, $0x3af758a6a0344fc7
-DATA ·precomputed<>+0x14580(SB)/8, $0x491568d790c7b674
-DATA ·precomputed<>+0x14588(SB)/8, $0xcb1e6ba0b9a1a60b
-DATA ·precomputed<>+0x14590(SB)/8, $0xe60f83a312d3c49c
-DATA ·precomputed<>+0x14598(SB)/8, $0xd0f6c8d24bde0519
-DATA ·precomputed<>+0x145a0(SB)/8, $0xd3ca5c2f5903b13e
-DATA ·precomputed<>+0x145a8(SB)/8, $0x59e3d8e6d4aeb081
-DATA ·precomputed<>+0x145b0(SB)/8, $0x36ac355e4bff1f2a
-DATA ·precomputed<>+0x145b8(SB)/8, $0xcb0954fd1170f2d7
-DATA ·precomputed<>+0x145c0(SB)/8, $0x1a616476cd3aad34
-DATA ·precomputed<>+0x145c8(SB)/8, $0xd65c0e9c2a85f4cd
-DATA ·precomputed<>+0x145d0(SB)/8, $0x8c17c3518aed34bd
-DATA ·precomputed<>+0x145d8(SB)/8, $0x99f10caa81c0d973
-DATA ·precomputed<>+0x145e0(SB)/8, $0xc63a88598a794d5d
-DATA ·precomputed<>+0x145e8(SB)/8, $0xd509fdf16babee69
-DATA ·precomputed<>+0x145f0(SB)/8, $0x2ecebb167f81a0d2
-DATA ·precomputed<>+0x145f8(SB)/8, $0x9d52da29b380cea7
-DATA ·precomputed<>+0x14600(SB)/8, $0x719ca4ce63b62b3f
-DATA ·precomputed<>+0x14608(SB)/8, $0x45da56470ba32d31
-DATA ·precomputed<>+0x14610(SB)/8, $0x190d6302460b9009
-DATA ·precomputed<>+0x14618(SB)/8, $0x693be508ecf35272
-DATA ·precomputed<>+0x14620(SB)/8, $0xe40f4d77510ee4ca
-DATA ·precomputed<>+0x14628(SB)/8, $0x5878bc9ab2d1fe0
-DATA ·precomputed<>+0x14630(SB)/8, $0x46337c012e1ef537
-DATA ·precomputed<>+0x14638(SB)/8, $0x9057903421afb7c7
-DATA ·precomputed<>+0x14640(SB)/8, $0x5b2ebe4d801f711a
-DATA ·precomputed<>+0x14648(SB)/8, $0xa512bdb4cf95986
-DATA ·precomputed<>+0x14650(SB)/8, $0xf152a4cb6e0b7978
-DATA ·precomputed<>+0x14658(SB)/8, $0xf2f6ee4ec71d72e1
-DATA ·precomputed<>+0x14660(SB)/8, $0xb71d1d82f53ed6c3
-DATA ·precomputed<>+0x14668(SB)/8, $0x5f2a724375f9774c
-DATA ·precomputed<>+0x14670(SB)/8, $0x148dbbb371cb751f
-DATA ·precomputed<>+0x14678(SB)/8, $0xbdde8ecc4c1ab5d0
-DATA ·precomputed<>+0x14680(SB)/8, $0x51f251425fe44ae1
-DATA ·precomputed<>+0x14688(SB)/8, $0x4eebbe9a40a9ec0a
-DATA ·precomputed<>+0x14690(SB)/8, $0x602f76dc2030dcfd
-DATA ·precomputed<>+0x14698(SB)/8, $0xc858a91dc6926a2a
-DATA ·precomputed<>+0x146a0(SB)/8, $0x870667a3f9c0a1b7
-DATA ·precomputed<>+0x146a8(SB)/8, $0x16d00a96c2d8bb90
-DATA ·precomputed<>+0x146b0(SB)/8, $0xb72ce88416a35dc6
-DATA ·precomputed<>+0x146b8(SB)/8, $0x82e40d6b2a833443
-DATA ·precomputed<>+0x146c0(SB)/8, $0x1b926ae90b6ed972
-DATA ·precomputed<>+0x146c8(SB)/8, $0x79308c2a93a9b451
-DATA ·precomputed<>+0x146d0(SB)/8, $0x5a60012b3198d51d
-DATA ·precomputed<>+0x146d8(SB)/8, $0xc9063cf089849541
-DATA ·precomputed<>+0x146e0(SB)/8, $0x557d12ed96123a71
-DATA ·precomputed<>+0x146e8(SB)/8, $0x730044fd852ce4d5
-DATA ·precomputed<>+0x146f0(SB)/8, $0xf30cc7ca8f0ff3ad
-DATA ·precomputed<>+0x146f8(SB)/8, $0xe165081cd79108a8
-DATA ·precomputed<>+0x14700(SB)/8, $0xefb713838da5a00
-DATA ·precomputed<>+0x14708(SB)/8, $0xe9171d7ab4603a17
-DATA ·precomputed<>+0x14710(SB)/8, $0x848fab3dae1630d6
-DATA ·precomputed<>+0x14718(SB)/8, $0x867c4a8f1b60c519
-DATA ·precomputed<>+0x14720(SB)/8, $0x8fe8e73d3bffdc11
-DATA ·precomputed<>+0x14728(SB)/8, $0xf53945c686af784a
-DATA ·precomputed<>+0x14730(SB)/8, $0x10725d26725afade
-DATA ·precomputed<>+0x14738(SB)/8, $0xfa8f52ff2744a5b7
-DATA ·precomputed<>+0x14740(SB)/8, $0xa155fb25586f251b
-DATA ·precomputed<>+0x14748(SB)/8, $0xe5b1b8f918cf518e
-DATA ·precomputed<>+0x14750(SB)/8, $0x45f0f0112933916e
-DATA ·precomputed<>+0x14758(SB)/8, $0x2e0866686ff50420
-DATA ·precomputed<>+0x14760(SB)/8, $0x4276803609397b4c
-DATA ·precomputed<>+0x14768(SB)/8, $0x50f3f42ab59bbb28
-DATA ·precomputed<>+0x14770(SB)/8, $0xb0e6b75fb0f9500
-DATA ·precomputed<>+0x14778(SB)/8, $0x2bf7da6c6ea78cda
-DATA ·precomputed<>+0x14780(SB)/8, $0x6589971c4c2ea133
-DATA ·precomputed<>+0x14788(SB)/8, $0x25fd3a7eb83fdb9c
-DATA ·precomputed<>+0x14790(SB)/8, $0x3c8e0743a29c0df
-DATA ·precomputed<>+0x14798(SB)/8, $0x7a8d68d0ed5af009
-DATA ·precomputed<>+0x147a0(SB)/8, $0xf14d35f32d5693e9
-DATA ·precomputed<>+0x147a8(SB)/8, $0xe3f49768cc21f0cf
-DATA ·precomputed<>+0x147b0(SB)/8, $0xced7f46b4a4f1ccc
-DATA ·precomputed<>+0x147b8(SB)/8, $0xad2f587a96b1b3aa
-DATA ·precomputed<>+0x147c0(SB)/8, $0x2cb91662a45cfd31
-DATA ·precomputed<>+0x147c8(SB)/8, $0x9dd56d316f65cfe
-DATA ·precomputed<>+0x147d0(SB)/8, $0x983e005d14f3de51
-DATA ·precomputed<>+0x147d8(SB)/8, $0xb9dc05b0210f64fc
-DATA ·precomputed<>+0x147e0(SB)/8, $0x22790afd885eafe5
-DATA ·precomputed<>+0x147e8(SB)/8, $0xbd5213d37444bdec
-DATA ·precomputed<>+0x147f0(SB)/8, $0x289dca928987300a
-DATA ·precomputed<>+0x147f8(SB)/8, $0x69fb2ac2b3960b76
-DATA ·precomputed<>+0x14800(SB)/8, $0xe32748869ae7540f
-DATA ·precomputed<>+0x14808(SB)/8, $0xd73866316131e921
-DATA ·precomputed<>+0x14810(SB)/8, $0x2e3d4fd8f2a360c8
-DATA ·precomputed<>+0x14818(SB)/8, $0xb20a59b63d9d41e0
-DATA ·precomputed<>+0x14820(SB)/8, $0x72b67eae99082a34
-DATA ·precomputed<>+0x14828(SB)/8, $0xfad6aa7d51819470
-DATA ·precomputed<>+0x14830(SB)/8, $0x7c299b9aa2d1d007
-DATA ·precomputed<>+0x14838(SB)/8, $0xc1f841e08100bed0
-DATA ·precomputed<>+0x14840(SB)/8, $0xb8a414d2151ffc08
-DATA ·precomputed<>+0x14848(SB)/8, $0xaa79acf0740d6b55
-DATA ·precomputed<>+0x14850(SB)/8, $0xeeab0104cdf472ab
-DATA ·precomputed<>+0x14858(SB)/8, $0x5014a8c1a3aa5f1d
-DATA ·precomputed<>+0x14860(SB)/8, $0x8c74340533f13425
-DATA ·precomputed<>+0x14868(SB)/8, $0x2b776b4957eb54d4
-DATA ·precomputed<>+0x14870(SB)/8, $0x3a0cc4ac548a723b
-DATA ·precomputed<>+0x14878(SB)/8, $0x65aae6f3c79fe63a
-DATA ·precomputed<>+0x14880(SB)/8, $0x99421b429984c4c2
-DATA ·precomputed<>+0x14888(SB)/8, $0x1a3bce27d35c7bbd
-DATA ·precomputed<>+0x14890(SB)/8, $0xe51ae6f63563b09e
-DATA ·precomputed<>+0x14898(SB)/8, $0x8e67853b8d9c9fbf
-DATA ·precomputed<>+0x148a0(SB)/8, $0xca8784da6b2100b5
-DATA ·precomputed<>+0x148a8(SB)/8, $0xe89a24f798879bba
-DATA ·precomputed<>+0x148b0(SB)/8, $0xe901b45ce286b039
-DATA ·precomputed<>+0x148b8(SB)/8, $0x23dedbb8f50384bd
-DATA ·precomputed<>+0x148c0(SB)/8, $0x79447ef9dfa782a4
-DATA ·precomputed<>+0x148c8(SB)/8, $0x6dadc8e174cd9500
-DATA ·precomputed<>+0x148d0(SB)/8, $0x574020edc38f762
-DATA ·precomputed<>+0x148d8(SB)/8, $0x17596d7ee2ee7a14
-DATA ·precomputed<>+0x148e0(SB)/8, $0x9ef75af79e1f8adc
-DATA ·precomputed<>+0x148e8(SB)/8, $0x5ac5f216a4791da0
-DATA ·precomputed<>+0x148f0(SB)/8, $0x1583226b7b7b5d80
-DATA ·precomputed<>+0x148f8(SB)/8, $0x59f3f053a21c26cc
-DATA ·precomputed<>+0x14900(SB)/8, $0xeb74554d8c124dff
-DATA ·precomputed<>+0x14908(SB)/8, $0x781a8c4d21be0be0
-DATA ·precomputed<>+0x14910(SB)/8, $0xfaacc154e3510068
-DATA ·precomputed<>+0x14918(SB)/8, $0x16655d65d6238265
-DATA ·precomputed<>+0x14920(SB)/8, $0xba46d27b0466134a
-DATA ·precomputed<>+0x14928(SB)/8, $0x1a3f51b93101e283
-DATA ·precomputed<>+0x14930(SB)/8, $0xc08298a9096ec237
-DATA ·precomputed<>+0x14938(SB)/8, $0x46248627c69cfb5b
-DATA ·precomputed<>+0x14940(SB)/8, $0xf20e163b22f37d65
-DATA ·precomputed<>+0x14948(SB)/8, $0x70fd00c832cf180a
-DATA ·precomputed<>+0x14950(SB)/8, $0xff1a97d20b17244e
-DATA ·precomputed<>+0x14958(SB)/8, $0x9a5a141bacedb33a
-DATA ·precomputed<>+0x14960(SB)/8, $0xf03dd868cc16bbb4
-DATA ·precomputed<>+0x14968(SB)/8, $0x9b15372da40e44e9
-DATA ·precomputed<>+0x14970(SB)/8, $0xd5ba643615ac397f
-DATA ·precomputed<>+0x14978(SB)/8, $0xb1a886d4c821f6b7
-DATA ·precomputed<>+0x14980(SB)/8, $0x92321ee26881e64f
-DATA ·precomputed<>+0x14988(SB)/8, $0xaccab5c85267bdd3
-DATA ·precomputed<>+0x14990(SB)/8, $0xc1f87ea5927647b
-DATA ·precomputed<>+0x14998(SB)/8, $0x93d07e162c6d86
-DATA ·precomputed<>+0x149a0(SB)/8, $0x49868df4389c711d
-DATA ·precomputed<>+0x149a8(SB)/8, $0xe68d69aec11e1f37
-DATA ·precomputed<>+0x149b0(SB)/8, $0xa40e7aa8b4207246
-DATA ·precomputed<>+0x149b8(SB)/8, $0x4ae8d126ce832395
-DATA ·precomputed<>+0x149c0(SB)/8, $0xee16abd049c879a5
-DATA ·precomputed<>+0x149c8(SB)/8, $0x844874a7a47ac42e
-DATA ·precomputed<>+0x149d0(SB)/8, $0xee3f8a203c9c2326
-DATA ·precomputed<>+0x149d8(SB)/8, $0x99a12054deaed33b
-DATA ·precomputed<>+0x149e0(SB)/8, $0x4814a15b63b333ae
-DATA ·precomputed<>+0x149e8(SB)/8, $0xee9f28a59d923fa0
-DATA ·precomputed<>+0x149f0(SB)/8, $0x5b0cd25033b1b1ef
-DATA ·precomputed<>+0x149f8(SB)/8, $0x3ccc39b98346d928
-DATA ·precomputed<>+0x14a00(SB)/8, $0x63cea36c29b526a9
-DATA ·precomputed<>+0x14a08(SB)/8, $0xcdb316139d03f3db
-DATA ·precomputed<>+0x14a10(SB)/8, $0xa3891096d57cca8e
-DATA ·precomputed<>+0x14a18(SB)/8, $0x646418a9a14a8ffc
-DATA ·precomputed<>+0x14a20(SB)/8, $0x10f8906b8075291f
-DATA ·precomputed<>+0x14a28(SB)/8, $0x8221d9412c618bf6
-DATA ·precomputed<>+0x14a30(SB)/8, $0x1dc1ae7a8a5da4df
-DATA ·precomputed<>+0x14a38(SB)/8, $0xb66b96e38a8cc8bc
-DATA ·precomputed<>+0x14a40(SB)/8, $0xf35d5e32bee0d434
-DATA ·precomputed<>+0x14a48(SB)/8, $0x9651f3d90b536161
-DATA ·precomputed<>+0x14a50(SB)/8, $0x42634cc972cb370c
-DATA ·precomputed<>+0x14a58(SB)/8, $0xa7764026406b3457
-DATA ·precomputed<>+0x14a60(SB)/8, $0xec7525bd65d53d80
-DATA ·precomputed<>+0x14a68(SB)/8, $0xf44a1bcaadcc8b05
-DATA ·precomputed<>+0x14a70(SB)/8, $0x12ef8427da112ddc
-DATA ·precomputed<>+0x14a78(SB)/8, $0x796a65b320a0f78f
-DATA ·precomputed<>+0x14a80(SB)/8, $0x20a19834aaf6619e
-DATA ·precomputed<>+0x14a88(SB)/8, $0x633b94e8691a538e
-DATA ·precomputed<>+0x14a90(SB)/8, $0xea1a898592cdf395
-DATA ·precomputed<>+0x14a98(SB)/8, $0xa3a01c574349b971
-DATA ·precomputed<>+0x14aa0(SB)/8, $0xd65192a30822c59
-DATA ·precomputed<>+0x14aa8(SB)/8, $0x93a5152da522ae8c
-DATA ·precomputed<>+0x14ab0(SB)/8, $0x5b09a7a30e1aa4bc
-DATA ·precomputed<>+0x14ab8(SB)/8, $0xdd2588f38d3b97a9
-DATA ·precomputed<>+0x14ac0(SB)/8, $0x4b795e1d161e13e7
-DATA ·precomputed<>+0x14ac8(SB)/8, $0xf9abc20907e7069
-DATA ·precomputed<>+0x14ad0(SB)/8, $0xfb3be61854522fe7
-DATA ·precomputed<>+0x14ad8(SB)/8, $0x9e2d0f371938134e
-DATA ·precomputed<>+0x14ae0(SB)/8, $0xb8dc7c36d292c6b0
-DATA ·precomputed<>+0x14ae8(SB)/8, $0xbafbf59cc1e77895
-DATA ·precomputed<>+0x14af0(SB)/8, $0x7d0132cd1b6c55f3
-DATA ·precomputed<>+0x14af8(SB)/8, $0xefa02ed9f609f087
-DATA ·precomputed<>+0x14b00(SB)/8, $0x6521c92f88d94f69
-DATA ·precomputed<>+0x14b08(SB)/8, $0x3950e9e87b52da8d
-DATA ·precomputed<>+0x14b10(SB)/8, $0xadb817008ee64a88
-DATA ·precomputed<>+0x14b18(SB)/8, $0x8ccbfa3cf73994fe
-DATA ·precomputed<>+0x14b20(SB)/8, $0xb780ab12b048e71e
-DATA ·precomputed<>+0x14b28(SB)/8, $0x52879e7be2aeb607
-DATA ·precomputed<>+0x14b30(SB)/8, $0xef04b1ed3237554f
-DATA ·precomputed<>+0x14b38(SB)/8, $0xaeba6a96e1d5a5ef
-DATA ·precomputed<>+0x14b40(SB)/8, $0x10edbf393b6cd199
-DATA ·precomputed<>+0x14b48(SB)/8, $0xe947922375644d6a
-DATA ·precomputed<>+0x14b50(SB)/8, $0x36cfba92d6e8cc36
-DATA ·precomputed<>+0x14b58(SB)/8, $0xa37b1d91fe00d80a
-DATA ·precomputed<>+0x14b60(SB)/8, $0x3aadf918deb5ef4a
-DATA ·precomputed<>+0x14b68(SB)/8, $0x5bb2ca4dd3893df2
-DATA ·precomputed<>+0x14b70(SB)/8, $0xa776000e6569ab8b
-DATA ·precomputed<>+0x14b78(SB)/8, $0x4fb2308f1cf64905
-DATA ·precomputed<>+0x14b80(SB)/8, $0x9992ce1a0f7d8d12
-DATA ·precomputed<>+0x14b88(SB)/8, $0xa7c46a610e5e4542
-DATA ·precomputed<>+0x14b90(SB)/8, $0x3fcc0647057802ba
-DATA ·precomputed<>+0x14b98(SB)/8, $0xa78f73d8c7dccbe2
-DATA ·precomputed<>+0x14ba0(SB)/8, $0x67f37b94f138dc6d
-DATA ·precomputed<>+0x14ba8(SB)/8, $0x89330885650a9002
-DATA ·precomputed<>+0x14bb0(SB)/8, $0xf661caf268aa24c7
-DATA ·precomputed<>+0x14bb8(SB)/8, $0x47732bcdbf73c435
-DATA ·precomputed<>+0x14bc0(SB)/8, $0xf8ea9359d93f6e1a
-DATA ·precomputed<>+0x14bc8(SB)/8, $0x729005d43d41c118
-DATA ·precomputed<>+0x14bd0(SB)/8, $0x4c2934107cb641af
-DATA ·precomputed<>+0x14bd8(SB)/8, $0x6b2b4671895e8e78
-DATA ·precomputed<>+0x14be0(SB)/8, $0x2a1251d05958fad3
-DATA ·precomputed<>+0x14be8(SB)/8, $0xb69bc2be78619fe4
-DATA ·precomputed<>+0x14bf0(SB)/8, $0xd74df34cd911d318
-DATA ·precomputed<>+0x14bf8(SB)/8, $0x5def837815102704
-DATA ·precomputed<>+0x14c00(SB)/8, $0xee5a99e815f2f638
-DATA ·precomputed<>+0x14c08(SB)/8, $0xb95b008d13979ab6
-DATA ·precomputed<>+0x14c10(SB)/8, $0x7fd03105acfcca6a
-DATA ·precomputed<>+0x14c18(SB)/8, $0x6af914a4e4ced1b3
-DATA ·precomputed<>+0x14c20(SB)/8, $0x8bef3d0fa25f8627
-DATA ·precomputed<>+0x14c28(SB)/8, $0x21bae309f9b2f931
-DATA ·precomputed<>+0x14c30(SB)/8, $0xe85dee2b2195a839
-DATA ·precomputed<>+0x14c38(SB)/8, $0x46ad0ad9a3baeb25
-DATA ·precomputed<>+0x14c40(SB)/8, $0x26bee75ad74472a4
-DATA ·precomputed<>+0x14c48(SB)/8, $0xbf7c4ea02eb6f0d6
-DATA ·precomputed<>+0x14c50(SB)/8, $0x689a5de5608bea48
-DATA ·precomputed<>+0x14c58(SB)/8, $0x5b38389229d513f8
-DATA ·precomputed<>+0x14c60(SB)/8, $0x49fee2c2da457cf9
-DATA ·precomputed<>+0x14c68(SB)/8, $0x7fc0aee762d523d3
-DATA ·precomputed<>+0x14c70(SB)/8, $0x5bf447deb636a46e
-DATA ·precomputed<>+0x14c78(SB)/8, $0xda3efd988194766e
-DATA ·precomputed<>+0x14c80(SB)/8, $0xe346b5aa97923d14
-DATA ·precomputed<>+0x14c88(SB)/8, $0xa8549f619e0bd9c4
-DATA ·precomputed<>+0x14c90(SB)/8, $0x78e59d6b40113a60
-DATA ·precomputed<>+0x14c98(SB)/8, $0xe3015fb2ed8a0fc6
-DATA ·precomputed<>+0x14ca0(SB)/8, $0xfc56a08f8b43288a
-DATA ·precomputed<>+0x14ca8(SB)/8, $0xcbdb8caecae6c18a
-DATA ·precomputed<>+0x14cb0(SB)/8, $0xcb147c445f4423db
-DATA ·precomputed<>+0x14cb8(SB)/8, $0xa6aaa6c910f361c1
-DATA ·precomputed<>+0x14cc0(SB)/8, $0xf9ff58678c673c89
-DATA ·precomputed<>+0x14cc8(SB)/8, $0x4925a046099c7bae
-DATA ·precomputed<>+0x14cd0(SB)/8, $0xb3bf59adbb1e1b6
-DATA ·precomputed<>+0x14cd8(SB)/8, $0xc7e9d9f230ae084f
-DATA ·precomputed<>+0x14ce0(SB)/8, $0x709823960fa1776f
-DATA ·precomputed<>+0x14ce8(SB)/8, $0xb2e1b98f624c897b
-DATA ·precomputed<>+0x14cf0(SB)/8, $0xa9a6407d6c3534d5
-DATA ·precomputed<>+0x14cf8(SB)/8, $0x5e22319ba4dc3f63
-DATA ·precomputed<>+0x14d00(SB)/8, $0x2174c736757b418f
-DATA ·precomputed<>+0x14d08(SB)/8, $0xd904ba433661d54d
-DATA ·precomputed<>+0x14d10(SB)/8, $0x281f91263218ecb
-DATA ·precomputed<>+0x14d18(SB)/8, $0x5fd03ba0c50c8eb6
-DATA ·precomputed<>+0x14d20(SB)/8, $0x29626906896a5aea
-DATA ·precomputed<>+0x14d28(SB)/8, $0xab4d3f27e55ee73f
-DATA ·precomputed<>+0x14d30(SB)/8, $0x3db1c43dedfd1991
-DATA ·precomputed<>+0x14d38(SB)/8, $0x498cc31aa3f9c340
-DATA ·precomputed<>+0x14d40(SB)/8, $0x671a3dd728be2d62
-DATA ·precomputed<>+0x14d48(SB)/8, $0x6f2f4c2050897ff
-DATA ·precomputed<>+0x14d50(SB)/8, $0xd92bdab6b7c4587d
-DATA ·precomputed<>+0x14d58(SB)/8, $0xd2253a16fd8d5160
-DATA ·precomputed<>+0x14d60(SB)/8, $0x64f6e4aef1c098b1
-DATA ·precomputed<>+0x14d68(SB)/8, $0x5a393911ea7255
-DATA ·precomputed<>+0x14d70(SB)/8, $0x2ed4eb92dab542e5
-DATA ·precomputed<>+0x14d78(SB)/8, $0x26920bc150c5e874
-DATA ·precomputed<>+0x14d80(SB)/8, $0x5255e91b65061472
-DATA ·precomputed<>+0x14d88(SB)/8, $0x6ef98d2d5bdbb257
-DATA ·precomputed<>+0x14d90(SB)/8, $0xd1d1ab1c74c7b2c
-DATA ·precomputed<>+0x14d98(SB)/8, $0x9ffb9fdf2e9febde
-DATA ·precomputed<>+0x14da0(SB)/8, $0x853f3b9f6c50bf24
-DATA ·precomputed<>+0x14da8(SB)/8, $0x3d3695946fbd22bd
-DATA ·precomputed<>+0x14db0(SB)/8, $0x4d281126bcdad9a9
-DATA ·precomputed<>+0x14db8(SB)/8, $0x99eb62b6dc46ddc1
-DATA ·precomputed<>+0x14dc0(SB)/8, $0x4d906a2e916ef527
-DATA ·precomputed<>+0x14dc8(SB)/8, $0xadeb94d0293dc085
-DATA ·precomputed<>+0x14dd0(SB)/8, $0x3a078011491da3e
-DATA ·precomputed<>+0x14dd8(SB)/8, $0x177dceae0b84d2eb
-DATA ·precomputed<>+0x14de0(SB)/8, $0x61e5a3c17b691e0c
-DATA ·precomputed<>+0x14de8(SB)/8, $0x47d40bd7d172cea3
-DATA ·precomputed<>+0x14df0(SB)/8, $0x7d0646ad8ca76bce
-DATA ·precomputed<>+0x14df8(SB)/8, $0x90b030a9c64d635f
-DATA ·precomputed<>+0x14e00(SB)/8, $0xe836d537f41ec05d
-DATA ·precomputed<>+0x14e08(SB)/8, $0x1eaf207221b0c32
-DATA ·precomputed<>+0x14e10(SB)/8, $0x1d6a0bb672f8c904
-DATA ·precomputed<>+0x14e18(SB)/8, $0xa6ef58b2dfd74641
-DATA ·precomputed<>+0x14e20(SB)/8, $0xbb855ceb811bd6cb
-DATA ·precomputed<>+0x14e28(SB)/8, $0x7b1c8b7105408eab
-DATA ·precomputed<>+0x14e30(SB)/8, $0xd24d709e4187fb7f
-DATA ·precomputed<>+0x14e38(SB)/8, $0x283d647d8b30a9be
-DATA ·precomputed<>+0x14e40(SB)/8, $0xcd6abab76e412a6a
-DATA ·precomputed<>+0x14e48(SB)/8, $0x82399139b4c58b05
-DATA ·precomputed<>+0x14e50(SB)/8, $0xdf416966a3b55538
-DATA ·precomputed<>+0x14e58(SB)/8, $0x2b2d546f679882d3
-DATA ·precomputed<>+0x14e60(SB)/8, $0x17425cbcf9145657
-DATA ·precomputed<>+0x14e68(SB)/8, $0x3cc6735fe1b8247e
-DATA ·precomputed<>+0x14e70(SB)/8, $0x13e50c5657edd04c
-DATA ·precomputed<>+0x14e78(SB)/8, $0xc87231371b85b7cb
-DATA ·precomputed<>+0x14e80(SB)/8, $0x3ef9bb815b9e88ef
-DATA ·precomputed<>+0x14e88(SB)/8, $0xb85263183a8e51f2
-DATA ·precomputed<>+0x14e90(SB)/8, $0x2e47cb7ff8d744ac
-DATA ·precomputed<>+0x14e98(SB)/8, $0x63d6dc16510aaa7c
-DATA ·precomputed<>+0x14ea0(SB)/8, $0x54da7cdbb40ccc41
-DATA ·precomputed<>+0x14ea8(SB)/8, $0xdecbe5fd402b2ad9
-DATA ·precomputed<>+0x14eb0(SB)/8, $0x14c6f15c34c8f225
-DATA ·precomputed<>+0x14eb8(SB)/8, $0x6d8b2342c6559496
-DATA ·precomputed<>+0x14ec0(SB)/8, $0xb08337b31f6e396c
-DATA ·precomputed<>+0x14ec8(SB)/8, $0x6166b21e49a782f3
-DATA ·precomputed<>+0x14ed0(SB)/8, $0x1ac6198b8ec9b974
-DATA ·precomputed<>+0x14ed8(SB)/8, $0xee2e34460bb44d3d
-DATA ·precomputed<>+0x14ee0(SB)/8, $0xdb28374035039dd9
-DATA ·precomputed<>+0x14ee8(SB)/8, $0x7c708f9529f5c692
-DATA ·precomputed<>+0x14ef0(SB)/8, $0x8914cce098ddb466
-DATA ·precomputed<>+0x14ef8(SB)/8, $0x8bb1b9f1d446f3cf
-DATA ·precomputed<>+0x14f00(SB)/8, $0x1eebe3e918949935
-DATA ·precomputed<>+0x14f08(SB)/8, $0xde8deaa9ba092037
-DATA ·precomputed<>+0x14f10(SB)/8, $0xd43cf4ef93609411
-DATA ·precomputed<>+0x14f18(SB)/8, $0xe0fdb1e4c2d7b76e
-DATA ·precomputed<>+0x14f20(SB)/8, $0x1d3191a54e34b4bd
-DATA ·precomputed<>+0x14f28(SB)/8, $0x106d92f19ccc4c26
-DATA ·precomputed<>+0x14f30(SB)/8, $0x1a404ef629a2a6d1
-DATA ·precomputed<>+0x14f38(SB)/8, $0x3338bc9cc598f481
-DATA ·precomputed<>+0x14f40(SB)/8, $0xde3b885ca11b03cd
-DATA ·precomputed<>+0x14f48(SB)/8, $0x46ef07558df5d64e
-DATA ·precomputed<>+0x14f50(SB)/8, $0x112a49d6bf3f085d
-DATA ·precomputed<>+0x14f58(SB)/8, $0xf6ebf441198ff32f
-DATA ·precomputed<>+0x14f60(SB)/8, $0x581c00d87feae481
-DATA ·precomputed<>+0x14f68(SB)/8, $0xf2b43827cfde5b2f
-DATA ·precomputed<>+0x14f70(SB)/8, $0x3ceb7f8f9b7358f2
-DATA ·precomputed<>+0x14f78(SB)/8, $0x95761fbd55fe7117
-DATA ·precomputed<>+0x14f80(SB)/8, $0xdf86b254c02e49c1
-DATA ·precomputed<>+0x14f88(SB)/8, $0x6bb53867b56d308a
-DATA ·precomputed<>+0x14f90(SB)/8, $0x771dde4b73f29561
-DATA ·precomputed<>+0x14f98(SB)/8, $0x96eaf73e8bf28e5f
-DATA ·precomputed<>+0x14fa0(SB)/8, $0x9b1ee6be06fbb550
-DATA ·precomputed<>+0x14fa8(SB)/8, $0xe09fec7797d4a4e8
-DATA ·precomputed<>+0x14fb0(SB)/8, $0x93bdcd60d5aa84fd
-DATA ·precomputed<>+0x14fb8(SB)/8, $0x3fa8d3a0d457ab9c
-DATA ·precomputed<>+0x14fc0(SB)/8, $0xe31575c2a2c539e4
-DATA ·precomputed<>+0x14fc8(SB)/8, $0xbac5dcda756daf9
-DATA ·precomputed<>+0x14fd0(SB)/8, $0xe917cecf91f55a12
-DATA ·precomputed<>+0x14fd8(SB)/8, $0x1e96433be96f6299
-DATA ·precomputed<>+0x14fe0(SB)/8, $0xeec7c71c3700d8fb
-DATA ·precomputed<>+0x14fe8(SB)/8, $0x9a1d2965dc9b4444
-DATA ·precomputed<>+0x14ff0(SB)/8, $0x3d2c6970cf74f19c
-DATA ·precomputed<>+0x14ff8(SB)/8, $0x3b444c48ac5e0d6b
-DATA ·precomputed<>+0x15000(SB)/8, $0xe563cefd8ccb854c
-DATA ·precomputed<>+0x15008(SB)/8, $0xf5452cdb65b0c45a
-DATA ·precomputed<>+0x15010(SB)/8, $0xb3c787699c37f743
-DATA ·precomputed<>+0x15018(SB)/8, $0x34e9d19295d444ab
-DATA ·precomputed<>+0x15020(SB)/8, $0x2934794652ff26b7
-DATA ·precomputed<>+0x15028(SB)/8, $0x70d6ecfa9b94d642
-DATA ·precomputed<>+0x15030(SB)/8, $0x7d201858fdaffb8f
-DATA ·precomputed<>+0x15038(SB)/8, $0xc288719d45dcdc71
-DATA ·precomputed<>+0x15040(SB)/8, $0xc695469d0728a2eb
-DATA ·precomputed<>+0x15048(SB)/8, $0x7b46244ec433d11c
-DATA ·precomputed<>+0x15050(SB)/8, $0x4a8b99baf106c08e
-DATA ·precomputed<>+0x15058(SB)/8, $0x7989794f63422083
-DATA ·precomputed<>+0x15060(SB)/8, $0x82218867d4fc5696
-DATA ·precomputed<>+0x15068(SB)/8, $0x6b021f283c79cdb8
-DATA ·precomputed<>+0x15070(SB)/8, $0x5ff7bbeab26d5049
-DATA ·precomputed<>+0x15078(SB)/8, $0xb78611caa7261628
-DATA ·precomputed<>+0x15080(SB)/8, $0x5a75f961531313d7
-DATA ·precomputed<>+0x15088(SB)/8, $0x85a1f4db66dcdc9e
-DATA ·precomputed<>+0x15090(SB)/8, $0xae3026b96460e991
-DATA ·precomputed<>+0x15098(SB)/8, $0x7d467bef17ecf7cc
-DATA ·precomputed<>+0x150a0(SB)/8, $0x8a0dbf6705118708
-DATA ·precomputed<>+0x150a8(SB)/8, $0x54bfa368f3b2f1c9
-DATA ·precomputed<>+0x150b0(SB)/8, $0xa9fc9d5cf2c0e4e0
-DATA ·precomputed<>+0x150b8(SB)/8, $0xa8c2ad115e93611b
-DATA ·precomputed<>+0x150c0(SB)/8, $0x3ef1faf0aa1256bd
-DATA ·precomputed<>+0x150c8(SB)/8, $0xf2245459e4631de
-DATA ·precomputed<>+0x150d0(SB)/8, $0x69cb9800de9c2676
-DATA ·precomputed<>+0x150d8(SB)/8, $0x2601981695782b24
-DATA ·precomputed<>+0x150e0(SB)/8, $0x945c172ca66c0ccd
-DATA ·precomputed<>+0x150e8(SB)/8, $0x6c25f635b440719a
-DATA ·precomputed<>+0x150f0(SB)/8, $0x917d5dbaa49f681a
-DATA ·precomputed<>+0x150f8(SB)/8, $0xc0cad047b2dc5df4
-DATA ·precomputed<>+0x15100(SB)/8, $0xd45bcf4c5960ef1c
-DATA ·precomputed<>+0x15108(SB)/8, $0xbabcb16d8c6979d5
-DATA ·precomputed<>+0x15110(SB)/8, $0x8e3be750ae9090d6
-DATA ·precomputed<>+0x15118(SB)/8, $0x9481d261ac0eb728
-DATA ·precomputed<>+0x15120(SB)/8, $0x46b436cd0d6a7d46
-DATA ·precomputed<>+0x15128(SB)/8, $0x6eb1a6a31f976501
-DATA ·precomputed<>+0x15130(SB)/8, $0x5984ffa2dbe1064f
-DATA ·precomputed<>+0x15138(SB)/8, $0xe6575fb1f809dc20
-DATA ·precomputed<>+0x15140(SB)/8, $0xf0426d804d974a81
-DATA ·precomputed<>+0x15148(SB)/8, $0x61304f0f97a74be6
-DATA ·precomputed<>+0x15150(SB)/8, $0x2346ff98a9486531
-DATA ·precomputed<>+0x15158(SB)/8, $0xa1242ccaf53d781a
-DATA ·precomputed<>+0x15160(SB)/8, $0x482f03df97355f15
-DATA ·precomputed<>+0x15168(SB)/8, $0xc607ed33bd6058cf
-DATA ·precomputed<>+0x15170(SB)/8, $0x3bc8cd468aefe28
-DATA ·precomputed<>+0x15178(SB)/8, $0xa6e7de5a851307e4
-DATA ·precomputed<>+0x15180(SB)/8, $0x2c07df0fc6af7d44
-DATA ·precomputed<>+0x15188(SB)/8, $0x310b251fb15a9188
-DATA ·precomputed<>+0x15190(SB)/8, $0xd42661ced3e15c2f
-DATA ·precomputed<>+0x15198(SB)/8, $0x5198fd901b4d8313
-DATA ·precomputed<>+0x151a0(SB)/8, $0x7a6062cdda8368a1
-DATA ·precomputed<>+0x151a8(SB)/8, $0x1a905d115e9c2542
-DATA ·precomputed<>+0x151b0(SB)/8, $0x1d752b70dae37cee
-DATA ·precomputed<>+0x151b8(SB)/8, $0x3ed8c1a516bf84ca
-DATA ·precomputed<>+0x151c0(SB)/8, $0x5190fb0feecc2f22
-DATA ·precomputed<>+0x151c8(SB)/8, $0x3df210f3698d8e60
-DATA ·precomputed<>+0x151d0(SB)/8, $0xcce57d3af5f3ce72
-DATA ·precomputed<>+0x151d8(SB)/8, $0xb2fb6223312b8fc6
-DATA ·precomputed<>+0x151e0(SB)/8, $0x7994700571867c84
-DATA ·precomputed<>+0x151e8(SB)/8, $0x141cd92cbe139ebe
-DATA ·precomputed<>+0x151f0(SB)/8, $0x415efc9e5de7944e
-DATA ·precomputed<>+0x151f8(SB)/8, $0xae9ee91945821058
-DATA ·precomputed<>+0x15200(SB)/8, $0xd696e1d95bf363dc
-DATA ·precomputed<>+0x15208(SB)/8, $0x6a1bcfc08251449c
-DATA ·precomputed<>+0x15210(SB)/8, $0xa1b82dffa5fa53e9
-DATA ·precomputed<>+0x15218(SB)/8, $0x6c56b5beeef05378
-DATA ·precomputed<>+0x15220(SB)/8, $0xaf9efe4cc0e74dc3
-DATA ·precomputed<>+0x15228(SB)/8, $0x3d9a7ae9e5c1f1a0
-DATA ·precomputed<>+0x15230(SB)/8, $0x34b385772823c3e5
-DATA ·precomputed<>+0x15238(SB)/8, $0x69f297dc41fbabac
-DATA ·precomputed<>+0x15240(SB)/8, $0xf01aff98d74c5a65
-DATA ·precomputed<>+0x15248(SB)/8, $0x979931041951a915
-DATA ·precomputed<>+0x15250(SB)/8, $0x8b211915723096a6
-DATA ·precomputed<>+0x15258(SB)/8, $0xf85910c4a769ef1f
-DATA ·precomputed<>+0x15260(SB)/8, $0x30cefb9e8ddc0eb4
-DATA ·precomputed<>+0x15268(SB)/8, $0xd5957eefbb09607b
-DATA ·precomputed<>+0x15270(SB)/8, $0x2e139c9c2355b499
-DATA ·precomputed<>+0x15278(SB)/8, $0x5749531dc1789013
-DATA ·precomputed<>+0x15280(SB)/8, $0x1603ca645475f2d2
-DATA ·precomputed<>+0x15288(SB)/8, $0x57190e0e0a336508
-DATA ·precomputed<>+0x15290(SB)/8, $0x2203b703cea7d558
-DATA ·precomputed<>+0x15298(SB)/8, $0xf16eba4dfb5503e3
-DATA ·precomputed<>+0x152a0(SB)/8, $0x62e2ce3db7344a98
-DATA ·precomputed<>+0x152a8(SB)/8, $0xebf5b2439a4efa7a
-DATA ·precomputed<>+0x152b0(SB)/8, $0x962124551c914064
-DATA ·precomputed<>+0x152b8(SB)/8, $0xd2c5e31cbe5bbc07
-DATA ·precomputed<>+0x152c0(SB)/8, $0x2b5f2f7706c30b28
-DATA ·precomputed<>+0x152c8(SB)/8, $0x931841dbc9823d3
-DATA ·precomputed<>+0x152d0(SB)/8, $0xd16fb08badfa6fdb
-DATA ·precomputed<>+0x152d8(SB)/8, $0x8892bae2d6fd441e
-DATA ·precomputed<>+0x152e0(SB)/8, $0x3fc646302e576983
-DATA ·precomputed<>+0x152e8(SB)/8, $0x8c60fb607b05529
-DATA ·precomputed<>+0x152f0(SB)/8, $0x32b283b17afc1d4d
-DATA ·precomputed<>+0x152f8(SB)/8, $0xc9c56965a2f0e37f
-DATA ·precomputed<>+0x15300(SB)/8, $0x8e7191784644e173
-DATA ·precomputed<>+0x15308(SB)/8, $0x4c2a11ecf88b43ff
-DATA ·precomputed<>+0x15310(SB)/8, $0xb13644e67d3ddbb3
-DATA ·precomputed<>+0x15318(SB)/8, $0xd4746056c3d8703c
-DATA ·precomputed<>+0x15320(SB)/8, $0x6611395f55dca667
-DATA ·precomputed<>+0x15328(SB)/8, $0x6359671227c91d73
-DATA ·precomputed<>+0x15330(SB)/8, $0x4ca68a87ea2ff489
-DATA ·precomputed<>+0x15338(SB)/8, $0x2864a816337adc1d
-DATA ·precomputed<>+0x15340(SB)/8, $0x8aa830ae224d4f21
-DATA ·precomputed<>+0x15348(SB)/8, $0xda6c122e9f7845dc
-DATA ·precomputed<>+0x15350(SB)/8, $0xb0c61ffcfb240793
-DATA ·precomputed<>+0x15358(SB)/8, $0xf4df6842ce8580e9
-DATA ·precomputed<>+0x15360(SB)/8, $0x94372aaa0a990dc7
-DATA ·precomputed<>+0x15368(SB)/8, $0x42968cd35ce1aa24
-DATA ·precomputed<>+0x15370(SB)/8, $0x177c5ff04df363a5
-DATA ·precomputed<>+0x15378(SB)/8, $0xa8c3f73768c4546f
-DATA ·precomputed<>+0x15380(SB)/8, $0xc69750d5bd21c524
-DATA ·precomputed<>+0x15388(SB)/8, $0xbf3b485722a6c4ae
-DATA ·precomputed<>+0x15390(SB)/8, $0xcefcbb98e2883a1d
-DATA ·precomputed<>+0x15398(SB)/8, $0x6ffef743ae13f22b
-DATA ·precomputed<>+0x153a0(SB)/8, $0x6316ba605defea01
-DATA ·precomputed<>+0x153a8(SB)/8, $0xa89e6a74ba63810
-DATA ·precomputed<>+0x153b0(SB)/8, $0x7f9af1de15ab0e11
-DATA ·precomputed<>+0x153b8(SB)/8, $0x6247ca15385911c9
-DATA ·precomputed<>+0x153c0(SB)/8, $0x6f7b1a6a32f9eaf5
-DATA ·precomputed<>+0x153c8(SB)/8, $0x2c440f94acfc13dc
-DATA ·precomputed<>+0x153d0(SB)/8, $0x2cf39bc566b18adf
-DATA ·precomputed<>+0x153d8(SB)/8, $0xb9939fe89f01533f
-DATA ·precomputed<>+0x153e0(SB)/8, $0x31c4553383a6450
-DATA ·precomputed<>+0x153e8(SB)/8, $0x16d96ad3f0102087
-DATA ·precomputed<>+0x153f0(SB)/8, $0xcbd6fa95501f1360
-DATA ·precomputed<>+0x153f8(SB)/8, $0x667d3ea065f96c08
-DATA ·precomputed<>+0x15400(SB)/8, $0xa5a7cbfa68a1a680
-DATA ·precomputed<>+0x15408(SB)/8, $0xf131d77942041db7
-DATA ·precomputed<>+0x15410(SB)/8, $0xbefee3acd85d377f
-DATA ·precomputed<>+0x15418(SB)/8, $0x6d0ed6b73b62dfa2
-DATA ·precomputed<>+0x15420(SB)/8, $0xef683f0f1baacfbd
-DATA ·precomputed<>+0x15428(SB)/8, $0xc586c4f2c976cebd
-DATA ·precomputed<>+0x15430(SB)/8, $0x3a4120dc3b163339
-DATA ·precomputed<>+0x15438(SB)/8, $0x9ac9b950c79e5c1f
-DATA ·precomputed<>+0x15440(SB)/8, $0xaf1ff35fe294926a
-DATA ·precomputed<>+0x15448(SB)/8, $0x2703bab8a2103168
-DATA ·precomputed<>+0x15450(SB)/8, $0xc645560a658a52bf
-DATA ·precomputed<>+0x15458(SB)/8, $0x5ff3ccd9e466fd97
-DATA ·precomputed<>+0x15460(SB)/8, $0xe62fdc0154867f14
-DATA ·precomputed<>+0x15468(SB)/8, $0x435ef9509cdba39e
-DATA ·precomputed<>+0x15470(SB)/8, $0x2a7bbffd92828acc
-DATA ·precomputed<>+0x15478(SB)/8, $0xe7538fdbfe763981
-DATA ·precomputed<>+0x15480(SB)/8, $0xedf451738bfe9773
-DATA ·precomputed<>+0x15488(SB)/8, $0xd187fa01471b8b9c
-DATA ·precomputed<>+0x15490(SB)/8, $0x34506c3578fa54df
-DATA ·precomputed<>+0x15498(SB)/8, $0x73cab9fdc2767589
-DATA ·precomputed<>+0x154a0(SB)/8, $0xf8f76c656726f305
-DATA ·precomputed<>+0x154a8(SB)/8, $0xea45012d8de332b2
-DATA ·precomputed<>+0x154b0(SB)/8, $0xb746f40d87970e03
-DATA ·precomputed<>+0x154b8(SB)/8, $0xb2b2279a1ba8fbd6
-DATA ·precomputed<>+0x154c0(SB)/8, $0x79cdc61021147dbc
-DATA ·precomputed<>+0x154c8(SB)/8, $0x738ef6809939a3cc
-DATA ·precomputed<>+0x154d0(SB)/8, $0xd66d6ce68101bd8b
-DATA ·precomputed<>+0x154d8(SB)/8, $0x65612acb09323caa
-DATA ·precomputed<>+0x154e0(SB)/8, $0x6874b37210310a29
-DATA ·precomputed<>+0x154e8(SB)/8, $0x3cf30f0a5ee9ecfa
-DATA ·precomputed<>+0x154f0(SB)/8, $0x4e1026ad8cfe1df8
-DATA ·precomputed<>+0x154f8(SB)/8, $0x75a153f7d5989af5
-DATA ·precomputed<>+0x15500(SB)/8, $0xc362ccee8b8e0c49
-DATA ·precomputed<>+0x15508(SB)/8, $0x8adfc0d2b533f3dd
-DATA ·precomputed<>+0x15510(SB)/8, $0xe02ab03ba109572e
-DATA ·precomputed<>+0x15518(SB)/8, $0x6efacdcfd3066ec
-DATA ·precomputed<>+0x15520(SB)/8, $0xf136a8ba3fa28700
-DATA ·precomputed<>+0x15528(SB)/8, $0x48a1e987308cceb9
-DATA ·precomputed<>+0x15530(SB)/8, $0xe8ee7c0368253387
-DATA ·precomputed<>+0x15538(SB)/8, $0x47febbe8c2b463c7
-DATA ·precomputed<>+0x15540(SB)/8, $0x485195f239a5c4d3
-DATA ·precomputed<>+0x15548(SB)/8, $0xf42e000ea26241ec
-DATA ·precomputed<>+0x15550(SB)/8, $0x8c64f90cd05368d
-DATA ·precomputed<>+0x15558(SB)/8, $0x46fbd381857cdbdb
-DATA ·precomputed<>+0x15560(SB)/8, $0xf711df8b4c7e16ae
-DATA ·precomputed<>+0x15568(SB)/8, $0x95637e46e4edea42
-DATA ·precomputed<>+0x15570(SB)/8, $0x2df8b206ad171465
-DATA ·precomputed<>+0x15578(SB)/8, $0xa31ea8954bccedce
-DATA ·precomputed<>+0x15580(SB)/8, $0x28dbcb7750743bb6
-DATA ·precomputed<>+0x15588(SB)/8, $0x13d12f8ef9cf84b9
-DATA ·precomputed<>+0x15590(SB)/8, $0x39e3d3afc8f7d408
-DATA ·precomputed<>+0x15598(SB)/8, $0x5824117feba591d4
-DATA ·precomputed<>+0x155a0(SB)/8, $0xd8ef7c9a1bead2d6
-DATA ·precomputed<>+0x155a8(SB)/8, $0x9003a559caf53dd7
-DATA ·precomputed<>+0x155b0(SB)/8, $0x33b2365c174cb9a9
-DATA ·precomputed<>+0x155b8(SB)/8, $0x1149d080adb33afb
-DATA ·precomputed<>+0x155c0(SB)/8, $0x55231d00aea9bd3c
-DATA ·precomputed<>+0x155c8(SB)/8, $0x7e107c9fdf3f015
-DATA ·precomputed<>+0x155d0(SB)/8, $0xf535947dec9d8fce
-DATA ·precomputed<>+0x155d8(SB)/8, $0x8b64ed8abba349a7
-DATA ·precomputed<>+0x155e0(SB)/8, $0xdd5881fd049301df
-DATA ·precomputed<>+0x155e8(SB)/8, $0xefac9c43e6490fd0
-DATA ·precomputed<>+0x155f0(SB)/8, $0xd990285273740a78
-DATA ·precomputed<>+0x155f8(SB)/8, $0x6eef3724942c326c
-DATA ·precomputed<>+0x15600(SB)/8, $0x5671a6e95cfb3c8c
-DATA ·precomputed<>+0x15608(SB)/8, $0x40aabd20ea29721
-DATA ·precomputed<>+0x15610(SB)/8, $0x24e92ca6eac8da18
-DATA ·precomputed<>+0x15618(SB)/8, $0xc34d3d79a31170c3
-DATA ·precomputed<>+0x15620(SB)/8, $0xf81dd15fb061e416
-DATA ·precomputed<>+0x15628(SB)/8, $0xff7be70e85f80af0
-DATA ·precomputed<>+0x15630(SB)/8, $0xa9faba4bade45cd4
-DATA ·precomputed<>+0x15638(SB)/8, $0x42a6ab05505fddd4
-DATA ·precomputed<>+0x15640(SB)/8, $0x17d5821d0a793534
-DATA ·precomputed<>+0x15648(SB)/8, $0x9e094e54ce0ade43
-DATA ·precomputed<>+0x15650(SB)/8, $0xa127fb6dc42cb4d2
-DATA ·precomputed<>+0x15658(SB)/8, $0x43865428db12dc99
-DATA ·precomputed<>+0x15660(SB)/8, $0xb6b1b34759e3bfc1
-DATA ·precomputed<>+0x15668(SB)/8, $0xb0076a91ec5b810
-DATA ·precomputed<>+0x15670(SB)/8, $0xbf2dd17aa6864982
-DATA ·precomputed<>+0x15678(SB)/8, $0xc45947f9d523c87
-DATA ·precomputed<>+0x15680(SB)/8, $0x9f53372f4c5dd59e
-DATA ·precomputed<>+0x15688(SB)/8, $0x3d0ceaeaca5ce09f
-DATA ·precomputed<>+0x15690(SB)/8, $0xf3ff88e87c0337fb
-DATA ·precomputed<>+0x15698(SB)/8, $0xb4fa4593faa022c7
-DATA ·precomputed<>+0x156a0(SB)/8, $0x575240a7d65ea54d
-DATA ·precomputed<>+0x156a8(SB)/8, $0xa4ec0a39adb92fb0
-DATA ·precomputed<>+0x156b0(SB)/8, $0xc20e737c79429eb1
-DATA ·precomputed<>+0x156b8(SB)/8, $0xcea931d169addec4
-DATA ·precomputed<>+0x156c0(SB)/8, $0x7a29011f3e09f46a
-DATA ·precomputed<>+0x156c8(SB)/8, $0x9c36865e0e578a5b
-DATA ·precomputed<>+0x156d0(SB)/8, $0x8746ea5171d805f4
-DATA ·precomputed<>+0x156d8(SB)/8, $0xf024de85e12d3024
-DATA ·precomputed<>+0x156e0(SB)/8, $0xc397b46c15a7f6be
-DATA ·precomputed<>+0x156e8(SB)/8, $0x612db6fb1b0580d7
-DATA ·precomputed<>+0x156f0(SB)/8, $0xe5342f76f736d087
-DATA ·precomputed<>+0x156f8(SB)/8, $0x652768538c1e844c
-DATA ·precomputed<>+0x15700(SB)/8, $0xedf48adc113841a5
-DATA ·precomputed<>+0x15708(SB)/8, $0xc21b67e1e5c482f0
-DATA ·precomputed<>+0x15710(SB)/8, $0xe43b0138684a540b
-DATA ·precomputed<>+0x15718(SB)/8, $0xc4f2782ba5d4b266
-DATA ·precomputed<>+0x15720(SB)/8, $0x184e240c397f3664
-DATA ·precomputed<>+0x15728(SB)/8, $0x968e89e70d8788f8
-DATA ·precomputed<>+0x15730(SB)/8, $0xec3eba1a377e18bf
-DATA ·precomputed<>+0x15738(SB)/8, $0x4d03cbbc36002652
-DATA ·precomputed<>+0x15740(SB)/8, $0x21eedee71005a953
-DATA ·precomputed<>+0x15748(SB)/8, $0xc178ddf175ba987e
-DATA ·precomputed<>+0x15750(SB)/8, $0xd0d577f6c4ba43f6
-DATA ·precomputed<>+0x15758(SB)/8, $0x9486f46c4d6f24fd
-DATA ·precomputed<>+0x15760(SB)/8, $0x3d33c574c5421895
-DATA ·precomputed<>+0x15768(SB)/8, $0x5be6cb4c842e52ab
-DATA ·precomputed<>+0x15770(SB)/8, $0x3809690d9debc9ff
-DATA ·precomputed<>+0x15778(SB)/8, $0xe4b1c692a84a5b6f
-DATA ·precomputed<>+0x15780(SB)/8, $0x58b966add7e18b57
-DATA ·precomputed<>+0x15788(SB)/8, $0x7ff0b61e77c94715
-DATA ·precomputed<>+0x15790(SB)/8, $0xe295883f06add82
-DATA ·precomputed<>+0x15798(SB)/8, $0x7c3c04fd65c7f5a4
-DATA ·precomputed<>+0x157a0(SB)/8, $0x4ea9266060223be5
-DATA ·precomputed<>+0x157a8(SB)/8, $0x5d843a5789262bfd
-DATA ·precomputed<>+0x157b0(SB)/8, $0x35bf4aef36da11c0
-DATA ·precomputed<>+0x157b8(SB)/8, $0xa6692f14af859eb1
-DATA ·precomputed<>+0x157c0(SB)/8, $0xca1fc13ba12fdf41
-DATA ·precomputed<>+0x157c8(SB)/8, $0xd798c04b8224f5d2
-DATA ·precomputed<>+0x157d0(SB)/8, $0x22f4594e1dd5872b
-DATA ·precomputed<>+0x157d8(SB)/8, $0xdee12df51bddfda8
-DATA ·precomputed<>+0x157e0(SB)/8, $0x96473ff0ed83420a
-DATA ·precomputed<>+0x157e8(SB)/8, $0xf41cf1c78daa27f4
-DATA ·precomputed<>+0x157f0(SB)/8, $0x2772cd56aecefd8a
-DATA ·precomputed<>+0x157f8(SB)/8, $0xd5ddaf184902b47f
-GLOBL ·precomputed<>(SB), 8, $88064
@@ -2,6 +2,8 @@ package sm3
 
 import (
 	"encoding/base64"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"hash"
 )
@@ -139,12 +141,14 @@ func (sm3 *SM3) resetWithDefaultID() {
 
 // Sum appends the current hash to in and returns the resulting slice. if cap(in) -len(in) >= 32,
 //otherwise the it will not change ,and you can get hash from return value
+// The state is finalized on a copy, so that writing may go on after Sum.
 func (sm3 *SM3) Sum(in []byte) []byte {
-	msg := make([]byte, sm3.unhandledLength, 128)
-	msg = sm3.pad(msg)
+	d := *sm3
+	msg := make([]byte, d.unhandledLength, 128)
+	msg = d.pad(msg)
 
 	// final
-	update(&sm3.digest, msg, []byte{})
+	update(&d.digest, msg, []byte{})
 
 	var ret []byte
 	if cap(in)-len(in) < 32 {
@@ -152,7 +156,7 @@ func (sm3 *SM3) Sum(in []byte) []byte {
 		copy(ret, in)
 		in = ret
 	}
-	for _, v := range sm3.digest {
+	for _, v := range d.digest {
 		in = append(in, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
 	}
 	return in
@@ -166,6 +170,65 @@ func Hash(data []byte) []byte {
 	return sm3.Sum(nil)
 }
 
+const (
+	// magic identifies a marshaled SM3 state, its last byte is the version
+	// of the format.
+	magic         = "sm3\x01"
+	marshaledSize = len(magic) + 8*4 + blockSize + 8
+)
+
+//MarshalBinary implements encoding.BinaryMarshaler. The state is the magic
+// "sm3" and a version byte, the eight digest words, the unhandled block padded
+// to 64 bytes and the message length in bits, all big endian.
+func (sm3 *SM3) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize)
+	b = append(b, magic...)
+	for _, v := range sm3.digest {
+		b = appendUint32(b, v)
+	}
+	// bytes past unhandledLength are stale, they are written as zeros
+	b = append(b, sm3.unhandled[:sm3.unhandledLength]...)
+	b = append(b, make([]byte, blockSize-sm3.unhandledLength)...)
+	b = appendUint64(b, sm3.length)
+	return b, nil
+}
+
+//UnmarshalBinary implements encoding.BinaryUnmarshaler, it restores a state
+// written by MarshalBinary.
+func (sm3 *SM3) UnmarshalBinary(b []byte) error {
+	if len(b) < len(magic)-1 || string(b[:len(magic)-1]) != magic[:len(magic)-1] {
+		return errors.New("sm3: invalid hash state identifier")
+	}
+	if len(b) < len(magic) || b[len(magic)-1] != magic[len(magic)-1] {
+		return errors.New("sm3: unsupported hash state version")
+	}
+	if len(b) != marshaledSize {
+		return errors.New("sm3: invalid hash state size")
+	}
+	length := binary.BigEndian.Uint64(b[marshaledSize-8:])
+	if length%8 != 0 {
+		return errors.New("sm3: invalid hash state length")
+	}
+
+	b = b[len(magic):]
+	for i := range sm3.digest {
+		sm3.digest[i] = binary.BigEndian.Uint32(b)
+		b = b[4:]
+	}
+	copy(sm3.unhandled[:], b[:blockSize])
+	sm3.length = length
+	sm3.unhandledLength = int((length >> 3) % blockSize)
+	return nil
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return appendUint32(appendUint32(b, uint32(v>>32)), uint32(v))
+}
+
 //SignHashSM3 To verify SM2 signatures, digest should be padded by the follow method
 // X, Y: the byte form of points X, Y in an SM2 public key
 //BenchmarkVerify2-4   	    3000	    405031 ns/op
@@ -2,7 +2,9 @@ package sm3_test
 
 import (
 	"crypto/rand"
+	"encoding"
 	"encoding/hex"
+	"fmt"
 	"github.com/meshplus/crypto-gm/internal/sm3"
 	"github.com/stretchr/testify/assert"
 	"hash"
@@ -109,3 +111,109 @@ func hexTo(src []byte, hr hash.Hash) {
 	hex.Encode(buf[:(len(src)-s)*2], src[s:])
 	_, _ = hr.Write(buf[:(len(src)-s)*2])
 }
+
+func TestSM3_MarshalBinary(t *testing.T) {
+	msg := make([]byte, 300)
+	_, _ = rand.Read(msg)
+	for _, newHash := range []func() hash.Hash{sm3.New, sm3.NewWithID} {
+		one := newHash()
+		_, _ = one.Write(msg)
+		want := one.Sum(nil)
+
+		for _, split := range []int{0, 1, 55, 63, 64, 65, 128, 200, 300} {
+			h := newHash()
+			_, _ = h.Write(msg[:split])
+			state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+			assert.Nil(t, err)
+
+			resumed := newHash()
+			resumed.Reset()
+			assert.Nil(t, resumed.(encoding.BinaryUnmarshaler).UnmarshalBinary(state))
+			_, _ = resumed.Write(msg[split:])
+			assert.Equal(t, want, resumed.Sum(nil), "split at %d", split)
+		}
+	}
+}
+
+func TestSM3_SumKeepsState(t *testing.T) {
+	msg := make([]byte, 300)
+	_, _ = rand.Read(msg)
+	want := sm3.Hash(msg)
+	for _, split := range []int{0, 1, 55, 63, 64, 65, 128, 200, 300} {
+		h := sm3.New()
+		_, _ = h.Write(msg[:split])
+		first := h.Sum(nil)
+		assert.Equal(t, first, h.Sum(nil), "split at %d", split)
+		assert.Equal(t, sm3.Hash(msg[:split]), first, "split at %d", split)
+
+		state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+		assert.Nil(t, err)
+		resumed := sm3.New()
+		assert.Nil(t, resumed.(encoding.BinaryUnmarshaler).UnmarshalBinary(state))
+		_, _ = resumed.Write(msg[split:])
+		assert.Equal(t, want, resumed.Sum(nil), "split at %d", split)
+
+		_, _ = h.Write(msg[split:])
+		assert.Equal(t, want, h.Sum(nil), "split at %d", split)
+	}
+}
+
+func TestSM3_UnmarshalBinaryErrors(t *testing.T) {
+	h := sm3.New()
+	_, _ = h.Write([]byte("abc"))
+	state, _ := h.(encoding.BinaryMarshaler).MarshalBinary()
+	u := &sm3.SM3{}
+
+	assert.EqualError(t, u.UnmarshalBinary(nil), "sm3: invalid hash state identifier")
+	assert.EqualError(t, u.UnmarshalBinary([]byte("sha\x03")), "sm3: invalid hash state identifier")
+	assert.EqualError(t, u.UnmarshalBinary([]byte("sm3\x02")), "sm3: unsupported hash state version")
+	assert.EqualError(t, u.UnmarshalBinary(state[:len(state)-1]), "sm3: invalid hash state size")
+
+	bad := append([]byte(nil), state...)
+	bad[len(bad)-1] |= 1
+	assert.EqualError(t, u.UnmarshalBinary(bad), "sm3: invalid hash state length")
+}
+
+func TestHashMany(t *testing.T) {
+	assert.Len(t, sm3.HashMany(nil), 0)
+	msgs := make([][]byte, 50)
+	for i := range msgs {
+		msgs[i] = make([]byte, i*11)
+		_, _ = rand.Read(msgs[i])
+	}
+	for i, sum := range sm3.HashMany(msgs) {
+		assert.Equal(t, sm3.Hash(msgs[i]), sum[:])
+	}
+	// the standard test vector, GB/T 32905 appendix A
+	sum := sm3.HashMany([][]byte{[]byte("abc")})
+	assert.Equal(t, "66c7f0f462eeedd9d1f2d46bdc10e4e24167c4875cf2f7a2297da02b8f4ba8e0", hex.EncodeToString(sum[0][:]))
+}
+
+func benchmarkMessages(n, size int) [][]byte {
+	msgs := make([][]byte, n)
+	for i := range msgs {
+		msgs[i] = make([]byte, size)
+		_, _ = rand.Read(msgs[i])
+	}
+	return msgs
+}
+
+func BenchmarkHashMany(b *testing.B) {
+	for _, size := range []int{64, 256, 1024} {
+		msgs := benchmarkMessages(1024, size)
+		b.Run(fmt.Sprintf("HashMany/%d", size), func(b *testing.B) {
+			b.SetBytes(int64(len(msgs) * size))
+			for i := 0; i < b.N; i++ {
+				sm3.HashMany(msgs)
+			}
+		})
+		b.Run(fmt.Sprintf("HashLoop/%d", size), func(b *testing.B) {
+			b.SetBytes(int64(len(msgs) * size))
+			for i := 0; i < b.N; i++ {
+				for _, msg := range msgs {
+					sm3.Hash(msg)
+				}
+			}
+		})
+	}
+}
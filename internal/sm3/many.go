@@ -0,0 +1,160 @@
+package sm3
+
+import "encoding/binary"
+
+// lanes is the number of messages hashed in parallel by block8.
+const lanes = 8
+
+// block8 compresses one block of each of the eight lanes, dig[i][l] is the
+// word i of the digest of lane l and w[i][l] the word i of its block, the
+// words 16 to 67 of w are scratch space for the message expansion. It is nil
+// when there is no SIMD implementation for the GOARCH.
+var block8 func(dig *[8][lanes]uint32, w *[68][lanes]uint32)
+
+// block8Kernels lists by name the SIMD implementations of block8 the CPU
+// supports, block8 is the fastest of them.
+var block8Kernels = map[string]func(dig *[8][lanes]uint32, w *[68][lanes]uint32){}
+
+//HashMany returns the SM3 hashes of msgs. On amd64 the messages are hashed
+// eight at a time with AVX2, or four at a time with SSE2, and on arm64 four at
+// a time with NEON, which is much faster than calling Hash in a loop for a
+// large number of small messages. Elsewhere, and with the gmnosam tag, the
+// messages are hashed one by one.
+func HashMany(msgs [][]byte) [][32]byte {
+	out := make([][32]byte, len(msgs))
+	if block8 == nil || len(msgs) < 2 {
+		for i, msg := range msgs {
+			hashTo(&out[i], msg)
+		}
+		return out
+	}
+	hashMany(out, msgs, block8)
+	return out
+}
+
+func hashTo(out *[32]byte, msg []byte) {
+	var sm3 SM3
+	sm3.Reset()
+	_, _ = sm3.Write(msg)
+	sm3.Sum(out[:0])
+}
+
+// lane is a message being hashed in one lane of block8.
+type lane struct {
+	job  int    // index of the message, -1 when the lane is idle
+	body []byte // full blocks of the message still to hash
+	tail []byte // padded last one or two blocks still to hash
+	buf  [2 * blockSize]byte
+}
+
+func (ln *lane) load(job int, msg []byte) {
+	full := len(msg) &^ blockSizeMask
+	ln.job, ln.body = job, msg[:full]
+
+	n := copy(ln.buf[:], msg[full:])
+	ln.buf[n] = 0x80
+	size := blockSize
+	if n >= blockSize-8 {
+		size = 2 * blockSize
+	}
+	for i := n + 1; i < size-8; i++ {
+		ln.buf[i] = 0
+	}
+	binary.BigEndian.PutUint64(ln.buf[size-8:], uint64(len(msg))<<3)
+	ln.tail = ln.buf[:size]
+}
+
+// next returns the next block of the lane.
+func (ln *lane) next() []byte {
+	var b []byte
+	if len(ln.body) > 0 {
+		b, ln.body = ln.body[:blockSize], ln.body[blockSize:]
+	} else {
+		b, ln.tail = ln.tail[:blockSize], ln.tail[blockSize:]
+	}
+	return b
+}
+
+func (ln *lane) done() bool { return len(ln.body) == 0 && len(ln.tail) == 0 }
+
+// minActive is the number of busy lanes below which the last messages are
+// finished one by one, block8 costs more than a few single block updates.
+const minActive = 3
+
+// hashMany hashes msgs into out with block. A lane takes the next message as
+// soon as its own is done, so messages of different lengths keep the lanes
+// busy.
+func hashMany(out [][32]byte, msgs [][]byte, block func(dig *[8][lanes]uint32, w *[68][lanes]uint32)) {
+	var (
+		dig    [8][lanes]uint32
+		w      [68][lanes]uint32
+		ls     [lanes]lane
+		next   int
+		active int
+	)
+	for l := range ls {
+		ls[l].job = -1
+	}
+	iv := [8]uint32{0x7380166f, 0x4914b2b9, 0x172442d7, 0xda8a0600, 0xa96f30bc, 0x163138aa, 0xe38dee4d, 0xb0fb0e4e}
+
+	for {
+		for l := range ls {
+			if ls[l].job < 0 && next < len(msgs) {
+				ls[l].load(next, msgs[next])
+				for i := range iv {
+					dig[i][l] = iv[i]
+				}
+				next++
+				active++
+			}
+		}
+		if active == 0 {
+			return
+		}
+		if next == len(msgs) && active < minActive {
+			break
+		}
+
+		for l := range ls {
+			if ls[l].job < 0 {
+				continue
+			}
+			b := ls[l].next()
+			for i := 0; i < 16; i++ {
+				w[i][l] = binary.BigEndian.Uint32(b[4*i:])
+			}
+		}
+		block(&dig, &w)
+		for l := range ls {
+			if ls[l].job >= 0 && ls[l].done() {
+				putDigest(&out[ls[l].job], &dig, l)
+				ls[l].job = -1
+				active--
+			}
+		}
+	}
+
+	for l := range ls {
+		if ls[l].job < 0 {
+			continue
+		}
+		var d [8]uint32
+		for i := range d {
+			d[i] = dig[i][l]
+		}
+		// the slices are passed separately, update appends its second
+		// argument to the first one
+		update(&d, ls[l].body, nil)
+		update(&d, ls[l].tail, nil)
+		for i := range d {
+			dig[i][l] = d[i]
+		}
+		putDigest(&out[ls[l].job], &dig, l)
+	}
+}
+
+func putDigest(out *[32]byte, dig *[8][lanes]uint32, l int) {
+	for i := range dig {
+		binary.BigEndian.PutUint32(out[4*i:], dig[i][l])
+	}
+}
@@ -0,0 +1,41 @@
+//+build amd64
+//+build !gmnosam
+
+package sm3
+
+func init() {
+	block8, block8Kernels["sse2"] = block8SSE2, block8SSE2
+	if hasAVX2() {
+		block8, block8Kernels["avx2"] = block8AVX2, block8AVX2
+	}
+}
+
+//go:noescape
+func block8AVX2(dig *[8][lanes]uint32, w *[68][lanes]uint32)
+
+//go:noescape
+func block8SSE2(dig *[8][lanes]uint32, w *[68][lanes]uint32)
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+// hasAVX2 reports whether the CPU supports AVX2 and the OS saves the YMM
+// registers.
+func hasAVX2() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	const osxsave, avx = 1 << 27, 1 << 28
+	if ecx1&osxsave == 0 || ecx1&avx == 0 {
+		return false
+	}
+	if xcr0, _ := xgetbv(); xcr0&6 != 6 {
+		return false
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	const avx2 = 1 << 5
+	return ebx7&avx2 != 0
+}
@@ -0,0 +1,581 @@
+//+build amd64
+//+build !gmnosam
+
+#include "textflag.h"
+
+// The eight lanes of block8 are the eight dwords of a YMM register. Y0 to Y7
+// hold the words A to H, Y8 to Y14 are scratch registers.
+
+// r = r <<< n, clobbers t
+#define ROTL(n, r, t) \
+	VPSLLD $(n), r, t;    \
+	VPSRLD $(32-n), r, r; \
+	VPOR   t, r, r
+
+// x = x ^ (x <<< r1) ^ (x <<< r2), the P0 and P1 permutations
+#define PERM(x, r1, r2) \
+	VPSLLD $(r1), x, Y10;    \
+	VPSRLD $(32-r1), x, Y11; \
+	VPXOR  Y11, Y10, Y10;    \
+	VPSLLD $(r2), x, Y11;    \
+	VPXOR  Y11, Y10, Y10;    \
+	VPSRLD $(32-r2), x, Y11; \
+	VPXOR  Y11, Y10, Y10;    \
+	VPXOR  Y10, x, x
+
+// W[i] = P1(W[i-16] ^ W[i-9] ^ (W[i-3] <<< 15)) ^ (W[i-13] <<< 7) ^ W[i-6]
+#define EXPAND(i) \
+	VMOVDQU ((i-3)*32)(DI), Y9;       \
+	ROTL(15, Y9, Y10);                \
+	VPXOR   ((i-16)*32)(DI), Y9, Y9;  \
+	VPXOR   ((i-9)*32)(DI), Y9, Y9;   \
+	PERM(Y9, 15, 23);                 \
+	VMOVDQU ((i-13)*32)(DI), Y8;      \
+	ROTL(7, Y8, Y10);                 \
+	VPXOR   Y8, Y9, Y9;               \
+	VPXOR   ((i-6)*32)(DI), Y9, Y9;   \
+	VMOVDQU Y9, (i*32)(DI)
+
+// Y8 = SS2, Y9 = SS1, Y12 = W[j], Y13 = W[j] ^ W[j+4]
+#define ROUND_PRE(j, a, e) \
+	VPSLLD       $12, a, Y8;              \
+	VPSRLD       $20, a, Y9;              \
+	VPOR         Y9, Y8, Y8;              \
+	VPBROADCASTD (j*4)(SI), Y9;           \
+	VPADDD       Y8, Y9, Y9;              \
+	VPADDD       e, Y9, Y9;               \
+	ROTL(7, Y9, Y10);                     \
+	VPXOR        Y9, Y8, Y8;              \
+	VMOVDQU      (j*32)(DI), Y12;         \
+	VPXOR        ((j+4)*32)(DI), Y12, Y13
+
+// d = TT1, h = P0(TT2), b = b <<< 9, f = f <<< 19 with Y10 = FF and Y11 = GG.
+// The next round renames the registers instead of moving them.
+#define ROUND_POST(b, d, f, h) \
+	VPADDD Y10, d, d;  \
+	VPADDD Y8, d, d;   \
+	VPADDD Y13, d, d;  \
+	VPADDD Y11, h, h;  \
+	VPADDD Y9, h, h;   \
+	VPADDD Y12, h, h;  \
+	PERM(h, 9, 17);    \
+	ROTL(9, b, Y10);   \
+	ROTL(19, f, Y10)
+
+#define ROUND_00_15(j, a, b, c, d, e, f, g, h) \
+	ROUND_PRE(j, a, e);   \
+	VPXOR a, b, Y10;      \
+	VPXOR c, Y10, Y10;    \
+	VPXOR e, f, Y11;      \
+	VPXOR g, Y11, Y11;    \
+	ROUND_POST(b, d, f, h)
+
+#define ROUND_16_63(j, a, b, c, d, e, f, g, h) \
+	ROUND_PRE(j, a, e);   \
+	VPAND a, b, Y10;      \
+	VPOR  a, b, Y11;      \
+	VPAND c, Y11, Y11;    \
+	VPOR  Y11, Y10, Y10;  \
+	VPAND e, f, Y11;      \
+	VPANDN g, e, Y14;     \
+	VPOR  Y14, Y11, Y11;  \
+	ROUND_POST(b, d, f, h)
+
+// func block8AVX2(dig *[8][8]uint32, w *[68][8]uint32)
+TEXT ·block8AVX2(SB), NOSPLIT, $0-16
+	MOVQ dig+0(FP), AX
+	MOVQ w+8(FP), DI
+	LEAQ tj<>(SB), SI
+
+	EXPAND(16)
+	EXPAND(17)
+	EXPAND(18)
+	EXPAND(19)
+	EXPAND(20)
+	EXPAND(21)
+	EXPAND(22)
+	EXPAND(23)
+	EXPAND(24)
+	EXPAND(25)
+	EXPAND(26)
+	EXPAND(27)
+	EXPAND(28)
+	EXPAND(29)
+	EXPAND(30)
+	EXPAND(31)
+	EXPAND(32)
+	EXPAND(33)
+	EXPAND(34)
+	EXPAND(35)
+	EXPAND(36)
+	EXPAND(37)
+	EXPAND(38)
+	EXPAND(39)
+	EXPAND(40)
+	EXPAND(41)
+	EXPAND(42)
+	EXPAND(43)
+	EXPAND(44)
+	EXPAND(45)
+	EXPAND(46)
+	EXPAND(47)
+	EXPAND(48)
+	EXPAND(49)
+	EXPAND(50)
+	EXPAND(51)
+	EXPAND(52)
+	EXPAND(53)
+	EXPAND(54)
+	EXPAND(55)
+	EXPAND(56)
+	EXPAND(57)
+	EXPAND(58)
+	EXPAND(59)
+	EXPAND(60)
+	EXPAND(61)
+	EXPAND(62)
+	EXPAND(63)
+	EXPAND(64)
+	EXPAND(65)
+	EXPAND(66)
+	EXPAND(67)
+
+	VMOVDQU (0*32)(AX), Y0
+	VMOVDQU (1*32)(AX), Y1
+	VMOVDQU (2*32)(AX), Y2
+	VMOVDQU (3*32)(AX), Y3
+	VMOVDQU (4*32)(AX), Y4
+	VMOVDQU (5*32)(AX), Y5
+	VMOVDQU (6*32)(AX), Y6
+	VMOVDQU (7*32)(AX), Y7
+
+	ROUND_00_15(0, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_00_15(1, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_00_15(2, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_00_15(3, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_00_15(4, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_00_15(5, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_00_15(6, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_00_15(7, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_00_15(8, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_00_15(9, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_00_15(10, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_00_15(11, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_00_15(12, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_00_15(13, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_00_15(14, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_00_15(15, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(16, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(17, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(18, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(19, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(20, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(21, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(22, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(23, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(24, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(25, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(26, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(27, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(28, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(29, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(30, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(31, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(32, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(33, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(34, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(35, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(36, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(37, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(38, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(39, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(40, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(41, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(42, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(43, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(44, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(45, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(46, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(47, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(48, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(49, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(50, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(51, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(52, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(53, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(54, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(55, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(56, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(57, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(58, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(59, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+	ROUND_16_63(60, Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7)
+	ROUND_16_63(61, Y3, Y0, Y1, Y2, Y7, Y4, Y5, Y6)
+	ROUND_16_63(62, Y2, Y3, Y0, Y1, Y6, Y7, Y4, Y5)
+	ROUND_16_63(63, Y1, Y2, Y3, Y0, Y5, Y6, Y7, Y4)
+
+	VPXOR   (0*32)(AX), Y0, Y0
+	VMOVDQU Y0, (0*32)(AX)
+	VPXOR   (1*32)(AX), Y1, Y1
+	VMOVDQU Y1, (1*32)(AX)
+	VPXOR   (2*32)(AX), Y2, Y2
+	VMOVDQU Y2, (2*32)(AX)
+	VPXOR   (3*32)(AX), Y3, Y3
+	VMOVDQU Y3, (3*32)(AX)
+	VPXOR   (4*32)(AX), Y4, Y4
+	VMOVDQU Y4, (4*32)(AX)
+	VPXOR   (5*32)(AX), Y5, Y5
+	VMOVDQU Y5, (5*32)(AX)
+	VPXOR   (6*32)(AX), Y6, Y6
+	VMOVDQU Y6, (6*32)(AX)
+	VPXOR   (7*32)(AX), Y7, Y7
+	VMOVDQU Y7, (7*32)(AX)
+	VZEROUPPER
+	RET
+
+// block8SSE2 runs the same rounds on four lanes at a time with the dwords of
+// XMM registers, first on the lanes 0 to 3, then on the lanes 4 to 7. The SSE
+// instructions have two operands and need aligned memory operands, so the
+// words are copied with MOVO and loaded with MOVOU. X0 to X7 hold the words A
+// to H, X8 to X14 are scratch registers.
+
+#define ROTL4(n, r, t) \
+	MOVO  r, t;         \
+	PSLLL $(n), t;      \
+	PSRLL $(32-n), r;   \
+	POR   t, r
+
+#define PERM4(x, r1, r2) \
+	MOVO  x, X10;         \
+	PSLLL $(r1), X10;     \
+	MOVO  x, X11;         \
+	PSRLL $(32-r1), X11;  \
+	PXOR  X11, X10;       \
+	MOVO  x, X11;         \
+	PSLLL $(r2), X11;     \
+	PXOR  X11, X10;       \
+	MOVO  x, X11;         \
+	PSRLL $(32-r2), X11;  \
+	PXOR  X11, X10;       \
+	PXOR  X10, x
+
+#define EXPAND4(i) \
+	MOVOU ((i-3)*32)(DI), X9;   \
+	ROTL4(15, X9, X10);         \
+	MOVOU ((i-16)*32)(DI), X8;  \
+	PXOR  X8, X9;               \
+	MOVOU ((i-9)*32)(DI), X8;   \
+	PXOR  X8, X9;               \
+	PERM4(X9, 15, 23);          \
+	MOVOU ((i-13)*32)(DI), X8;  \
+	ROTL4(7, X8, X10);          \
+	PXOR  X8, X9;               \
+	MOVOU ((i-6)*32)(DI), X8;   \
+	PXOR  X8, X9;               \
+	MOVOU X9, (i*32)(DI)
+
+// X8 = SS2, X9 = SS1, X12 = W[j], X13 = W[j] ^ W[j+4]
+#define ROUND4_PRE(j, a, e) \
+	MOVO   a, X8;               \
+	PSLLL  $12, X8;             \
+	MOVO   a, X9;               \
+	PSRLL  $20, X9;             \
+	POR    X9, X8;              \
+	MOVOU  (j*4)(SI), X9;       \
+	PSHUFD $0, X9, X9;          \
+	PADDL  X8, X9;              \
+	PADDL  e, X9;               \
+	ROTL4(7, X9, X10);          \
+	PXOR   X9, X8;              \
+	MOVOU  (j*32)(DI), X12;     \
+	MOVOU  ((j+4)*32)(DI), X13; \
+	PXOR   X12, X13
+
+#define ROUND4_POST(b, d, f, h) \
+	PADDL X10, d;      \
+	PADDL X8, d;       \
+	PADDL X13, d;      \
+	PADDL X11, h;      \
+	PADDL X9, h;       \
+	PADDL X12, h;      \
+	PERM4(h, 9, 17);   \
+	ROTL4(9, b, X10);  \
+	ROTL4(19, f, X10)
+
+#define ROUND4_00_15(j, a, b, c, d, e, f, g, h) \
+	ROUND4_PRE(j, a, e);   \
+	MOVO a, X10;           \
+	PXOR b, X10;           \
+	PXOR c, X10;           \
+	MOVO e, X11;           \
+	PXOR f, X11;           \
+	PXOR g, X11;           \
+	ROUND4_POST(b, d, f, h)
+
+#define ROUND4_16_63(j, a, b, c, d, e, f, g, h) \
+	ROUND4_PRE(j, a, e);   \
+	MOVO  a, X10;          \
+	PAND  b, X10;          \
+	MOVO  a, X11;          \
+	POR   b, X11;          \
+	PAND  c, X11;          \
+	POR   X11, X10;        \
+	MOVO  e, X11;          \
+	PAND  f, X11;          \
+	MOVO  e, X14;          \
+	PANDN g, X14;          \
+	POR   X14, X11;        \
+	ROUND4_POST(b, d, f, h)
+
+// func block8SSE2(dig *[8][8]uint32, w *[68][8]uint32)
+TEXT ·block8SSE2(SB), NOSPLIT, $0-16
+	MOVQ dig+0(FP), AX
+	MOVQ w+8(FP), DI
+	LEAQ tj<>(SB), SI
+	MOVQ $2, CX
+
+half:
+	EXPAND4(16)
+	EXPAND4(17)
+	EXPAND4(18)
+	EXPAND4(19)
+	EXPAND4(20)
+	EXPAND4(21)
+	EXPAND4(22)
+	EXPAND4(23)
+	EXPAND4(24)
+	EXPAND4(25)
+	EXPAND4(26)
+	EXPAND4(27)
+	EXPAND4(28)
+	EXPAND4(29)
+	EXPAND4(30)
+	EXPAND4(31)
+	EXPAND4(32)
+	EXPAND4(33)
+	EXPAND4(34)
+	EXPAND4(35)
+	EXPAND4(36)
+	EXPAND4(37)
+	EXPAND4(38)
+	EXPAND4(39)
+	EXPAND4(40)
+	EXPAND4(41)
+	EXPAND4(42)
+	EXPAND4(43)
+	EXPAND4(44)
+	EXPAND4(45)
+	EXPAND4(46)
+	EXPAND4(47)
+	EXPAND4(48)
+	EXPAND4(49)
+	EXPAND4(50)
+	EXPAND4(51)
+	EXPAND4(52)
+	EXPAND4(53)
+	EXPAND4(54)
+	EXPAND4(55)
+	EXPAND4(56)
+	EXPAND4(57)
+	EXPAND4(58)
+	EXPAND4(59)
+	EXPAND4(60)
+	EXPAND4(61)
+	EXPAND4(62)
+	EXPAND4(63)
+	EXPAND4(64)
+	EXPAND4(65)
+	EXPAND4(66)
+	EXPAND4(67)
+
+	MOVOU (0*32)(AX), X0
+	MOVOU (1*32)(AX), X1
+	MOVOU (2*32)(AX), X2
+	MOVOU (3*32)(AX), X3
+	MOVOU (4*32)(AX), X4
+	MOVOU (5*32)(AX), X5
+	MOVOU (6*32)(AX), X6
+	MOVOU (7*32)(AX), X7
+
+	ROUND4_00_15(0, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_00_15(1, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_00_15(2, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_00_15(3, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_00_15(4, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_00_15(5, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_00_15(6, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_00_15(7, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_00_15(8, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_00_15(9, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_00_15(10, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_00_15(11, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_00_15(12, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_00_15(13, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_00_15(14, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_00_15(15, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(16, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(17, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(18, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(19, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(20, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(21, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(22, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(23, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(24, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(25, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(26, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(27, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(28, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(29, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(30, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(31, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(32, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(33, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(34, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(35, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(36, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(37, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(38, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(39, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(40, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(41, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(42, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(43, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(44, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(45, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(46, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(47, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(48, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(49, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(50, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(51, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(52, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(53, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(54, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(55, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(56, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(57, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(58, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(59, X1, X2, X3, X0, X5, X6, X7, X4)
+	ROUND4_16_63(60, X0, X1, X2, X3, X4, X5, X6, X7)
+	ROUND4_16_63(61, X3, X0, X1, X2, X7, X4, X5, X6)
+	ROUND4_16_63(62, X2, X3, X0, X1, X6, X7, X4, X5)
+	ROUND4_16_63(63, X1, X2, X3, X0, X5, X6, X7, X4)
+
+	MOVOU (0*32)(AX), X8
+	PXOR  X8, X0
+	MOVOU X0, (0*32)(AX)
+	MOVOU (1*32)(AX), X8
+	PXOR  X8, X1
+	MOVOU X1, (1*32)(AX)
+	MOVOU (2*32)(AX), X8
+	PXOR  X8, X2
+	MOVOU X2, (2*32)(AX)
+	MOVOU (3*32)(AX), X8
+	PXOR  X8, X3
+	MOVOU X3, (3*32)(AX)
+	MOVOU (4*32)(AX), X8
+	PXOR  X8, X4
+	MOVOU X4, (4*32)(AX)
+	MOVOU (5*32)(AX), X8
+	PXOR  X8, X5
+	MOVOU X5, (5*32)(AX)
+	MOVOU (6*32)(AX), X8
+	PXOR  X8, X6
+	MOVOU X6, (6*32)(AX)
+	MOVOU (7*32)(AX), X8
+	PXOR  X8, X7
+	MOVOU X7, (7*32)(AX)
+
+	// the lanes 4 to 7 are 16 bytes further
+	ADDQ $16, AX
+	ADDQ $16, DI
+	DECQ CX
+	JNZ  half
+	RET
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// T[j] <<< j, block8SSE2 loads 16 bytes at T[63]
+DATA tj<>+0x00(SB)/4, $0x79cc4519
+DATA tj<>+0x04(SB)/4, $0xf3988a32
+DATA tj<>+0x08(SB)/4, $0xe7311465
+DATA tj<>+0x0c(SB)/4, $0xce6228cb
+DATA tj<>+0x10(SB)/4, $0x9cc45197
+DATA tj<>+0x14(SB)/4, $0x3988a32f
+DATA tj<>+0x18(SB)/4, $0x7311465e
+DATA tj<>+0x1c(SB)/4, $0xe6228cbc
+DATA tj<>+0x20(SB)/4, $0xcc451979
+DATA tj<>+0x24(SB)/4, $0x988a32f3
+DATA tj<>+0x28(SB)/4, $0x311465e7
+DATA tj<>+0x2c(SB)/4, $0x6228cbce
+DATA tj<>+0x30(SB)/4, $0xc451979c
+DATA tj<>+0x34(SB)/4, $0x88a32f39
+DATA tj<>+0x38(SB)/4, $0x11465e73
+DATA tj<>+0x3c(SB)/4, $0x228cbce6
+DATA tj<>+0x40(SB)/4, $0x9d8a7a87
+DATA tj<>+0x44(SB)/4, $0x3b14f50f
+DATA tj<>+0x48(SB)/4, $0x7629ea1e
+DATA tj<>+0x4c(SB)/4, $0xec53d43c
+DATA tj<>+0x50(SB)/4, $0xd8a7a879
+DATA tj<>+0x54(SB)/4, $0xb14f50f3
+DATA tj<>+0x58(SB)/4, $0x629ea1e7
+DATA tj<>+0x5c(SB)/4, $0xc53d43ce
+DATA tj<>+0x60(SB)/4, $0x8a7a879d
+DATA tj<>+0x64(SB)/4, $0x14f50f3b
+DATA tj<>+0x68(SB)/4, $0x29ea1e76
+DATA tj<>+0x6c(SB)/4, $0x53d43cec
+DATA tj<>+0x70(SB)/4, $0xa7a879d8
+DATA tj<>+0x74(SB)/4, $0x4f50f3b1
+DATA tj<>+0x78(SB)/4, $0x9ea1e762
+DATA tj<>+0x7c(SB)/4, $0x3d43cec5
+DATA tj<>+0x80(SB)/4, $0x7a879d8a
+DATA tj<>+0x84(SB)/4, $0xf50f3b14
+DATA tj<>+0x88(SB)/4, $0xea1e7629
+DATA tj<>+0x8c(SB)/4, $0xd43cec53
+DATA tj<>+0x90(SB)/4, $0xa879d8a7
+DATA tj<>+0x94(SB)/4, $0x50f3b14f
+DATA tj<>+0x98(SB)/4, $0xa1e7629e
+DATA tj<>+0x9c(SB)/4, $0x43cec53d
+DATA tj<>+0xa0(SB)/4, $0x879d8a7a
+DATA tj<>+0xa4(SB)/4, $0x0f3b14f5
+DATA tj<>+0xa8(SB)/4, $0x1e7629ea
+DATA tj<>+0xac(SB)/4, $0x3cec53d4
+DATA tj<>+0xb0(SB)/4, $0x79d8a7a8
+DATA tj<>+0xb4(SB)/4, $0xf3b14f50
+DATA tj<>+0xb8(SB)/4, $0xe7629ea1
+DATA tj<>+0xbc(SB)/4, $0xcec53d43
+DATA tj<>+0xc0(SB)/4, $0x9d8a7a87
+DATA tj<>+0xc4(SB)/4, $0x3b14f50f
+DATA tj<>+0xc8(SB)/4, $0x7629ea1e
+DATA tj<>+0xcc(SB)/4, $0xec53d43c
+DATA tj<>+0xd0(SB)/4, $0xd8a7a879
+DATA tj<>+0xd4(SB)/4, $0xb14f50f3
+DATA tj<>+0xd8(SB)/4, $0x629ea1e7
+DATA tj<>+0xdc(SB)/4, $0xc53d43ce
+DATA tj<>+0xe0(SB)/4, $0x8a7a879d
+DATA tj<>+0xe4(SB)/4, $0x14f50f3b
+DATA tj<>+0xe8(SB)/4, $0x29ea1e76
+DATA tj<>+0xec(SB)/4, $0x53d43cec
+DATA tj<>+0xf0(SB)/4, $0xa7a879d8
+DATA tj<>+0xf4(SB)/4, $0x4f50f3b1
+DATA tj<>+0xf8(SB)/4, $0x9ea1e762
+DATA tj<>+0xfc(SB)/4, $0x3d43cec5
+GLOBL tj<>(SB), RODATA, $272
@@ -0,0 +1,11 @@
+//+build arm64
+//+build !gmnosam
+
+package sm3
+
+func init() {
+	block8, block8Kernels["neon"] = block8NEON, block8NEON
+}
+
+//go:noescape
+func block8NEON(dig *[8][lanes]uint32, w *[68][lanes]uint32)
@@ -0,0 +1,340 @@
+//+build arm64
+//+build !gmnosam
+
+#include "textflag.h"
+
+// block8NEON runs the rounds of block8 on four lanes at a time with the words
+// of NEON registers, first on the lanes 0 to 3, then on the lanes 4 to 7,
+// which are 16 bytes further. V0 to V7 hold the words A to H, V16 to V24 are
+// scratch registers. R0 points to dig, R1 to w and R3 to tj.
+
+// d = x <<< n, d must not be x
+#define ROTL(n, x, d) \
+	VSHL $(n), x.S4, d.S4; \
+	VSRI $(32-n), x.S4, d.S4
+
+// v = the four words at off(R1), clobbers R4
+#define LOADW(off, v) \
+	ADD  $(off), R1, R4; \
+	VLD1 (R4), [v.S4]
+
+// x = x ^ (x <<< r1) ^ (x <<< r2), the P0 and P1 permutations
+#define PERM(x, r1, r2) \
+	ROTL(r1, x, V23);                \
+	ROTL(r2, x, V24);                \
+	VEOR V24.B16, V23.B16, V23.B16;  \
+	VEOR V23.B16, x.B16, x.B16
+
+// W[i] = P1(W[i-16] ^ W[i-9] ^ (W[i-3] <<< 15)) ^ (W[i-13] <<< 7) ^ W[i-6]
+#define EXPAND(i) \
+	LOADW((i-3)*32, V16);            \
+	ROTL(15, V16, V17);              \
+	LOADW((i-16)*32, V18);           \
+	VEOR V18.B16, V17.B16, V17.B16;  \
+	LOADW((i-9)*32, V18);            \
+	VEOR V18.B16, V17.B16, V17.B16;  \
+	PERM(V17, 15, 23);               \
+	LOADW((i-13)*32, V18);           \
+	ROTL(7, V18, V19);               \
+	VEOR V19.B16, V17.B16, V17.B16;  \
+	LOADW((i-6)*32, V18);            \
+	VEOR V18.B16, V17.B16, V17.B16;  \
+	ADD  $(i*32), R1, R4;            \
+	VST1 [V17.S4], (R4)
+
+// V16 = SS2, V18 = SS1, V19 = W[j], V20 = W[j] ^ W[j+4]
+#define ROUND_PRE(j, a, e) \
+	ROTL(12, a, V16);                \
+	MOVWU (j*4)(R3), R5;             \
+	VDUP R5, V17.S4;                 \
+	VADD V16.S4, V17.S4, V17.S4;     \
+	VADD e.S4, V17.S4, V17.S4;       \
+	ROTL(7, V17, V18);               \
+	VEOR V18.B16, V16.B16, V16.B16;  \
+	LOADW(j*32, V19);                \
+	LOADW((j+4)*32, V20);            \
+	VEOR V19.B16, V20.B16, V20.B16
+
+// d = TT1, h = P0(TT2), b = b <<< 9, f = f <<< 19 with V21 = FF and V22 = GG.
+// The next round renames the registers instead of moving them.
+#define ROUND_POST(b, d, f, h) \
+	VADD V21.S4, d.S4, d.S4;         \
+	VADD V16.S4, d.S4, d.S4;         \
+	VADD V20.S4, d.S4, d.S4;         \
+	VADD V22.S4, h.S4, h.S4;         \
+	VADD V18.S4, h.S4, h.S4;         \
+	VADD V19.S4, h.S4, h.S4;         \
+	PERM(h, 9, 17);                  \
+	ROTL(9, b, V23);                 \
+	VORR V23.B16, V23.B16, b.B16;    \
+	ROTL(19, f, V23);                \
+	VORR V23.B16, V23.B16, f.B16
+
+#define ROUND_00_15(j, a, b, c, d, e, f, g, h) \
+	ROUND_PRE(j, a, e);              \
+	VEOR a.B16, b.B16, V21.B16;      \
+	VEOR c.B16, V21.B16, V21.B16;    \
+	VEOR e.B16, f.B16, V22.B16;      \
+	VEOR g.B16, V22.B16, V22.B16;    \
+	ROUND_POST(b, d, f, h)
+
+// FF = (a & b) | ((a | b) & c), GG = (e & f) | (^e & g) = g ^ (e & (f ^ g))
+#define ROUND_16_63(j, a, b, c, d, e, f, g, h) \
+	ROUND_PRE(j, a, e);              \
+	VAND a.B16, b.B16, V21.B16;      \
+	VORR a.B16, b.B16, V22.B16;      \
+	VAND c.B16, V22.B16, V22.B16;    \
+	VORR V22.B16, V21.B16, V21.B16;  \
+	VEOR f.B16, g.B16, V22.B16;      \
+	VAND e.B16, V22.B16, V22.B16;    \
+	VEOR g.B16, V22.B16, V22.B16;    \
+	ROUND_POST(b, d, f, h)
+
+// func block8NEON(dig *[8][8]uint32, w *[68][8]uint32)
+TEXT ·block8NEON(SB), NOSPLIT, $0-16
+	MOVD dig+0(FP), R0
+	MOVD w+8(FP), R1
+	MOVD $tj<>(SB), R3
+	MOVD $2, R6
+
+half:
+	EXPAND(16)
+	EXPAND(17)
+	EXPAND(18)
+	EXPAND(19)
+	EXPAND(20)
+	EXPAND(21)
+	EXPAND(22)
+	EXPAND(23)
+	EXPAND(24)
+	EXPAND(25)
+	EXPAND(26)
+	EXPAND(27)
+	EXPAND(28)
+	EXPAND(29)
+	EXPAND(30)
+	EXPAND(31)
+	EXPAND(32)
+	EXPAND(33)
+	EXPAND(34)
+	EXPAND(35)
+	EXPAND(36)
+	EXPAND(37)
+	EXPAND(38)
+	EXPAND(39)
+	EXPAND(40)
+	EXPAND(41)
+	EXPAND(42)
+	EXPAND(43)
+	EXPAND(44)
+	EXPAND(45)
+	EXPAND(46)
+	EXPAND(47)
+	EXPAND(48)
+	EXPAND(49)
+	EXPAND(50)
+	EXPAND(51)
+	EXPAND(52)
+	EXPAND(53)
+	EXPAND(54)
+	EXPAND(55)
+	EXPAND(56)
+	EXPAND(57)
+	EXPAND(58)
+	EXPAND(59)
+	EXPAND(60)
+	EXPAND(61)
+	EXPAND(62)
+	EXPAND(63)
+	EXPAND(64)
+	EXPAND(65)
+	EXPAND(66)
+	EXPAND(67)
+
+	ADD  $(0*32), R0, R4
+	VLD1 (R4), [V0.S4]
+	ADD  $(1*32), R0, R4
+	VLD1 (R4), [V1.S4]
+	ADD  $(2*32), R0, R4
+	VLD1 (R4), [V2.S4]
+	ADD  $(3*32), R0, R4
+	VLD1 (R4), [V3.S4]
+	ADD  $(4*32), R0, R4
+	VLD1 (R4), [V4.S4]
+	ADD  $(5*32), R0, R4
+	VLD1 (R4), [V5.S4]
+	ADD  $(6*32), R0, R4
+	VLD1 (R4), [V6.S4]
+	ADD  $(7*32), R0, R4
+	VLD1 (R4), [V7.S4]
+
+	ROUND_00_15(0, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_00_15(1, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_00_15(2, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_00_15(3, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_00_15(4, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_00_15(5, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_00_15(6, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_00_15(7, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_00_15(8, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_00_15(9, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_00_15(10, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_00_15(11, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_00_15(12, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_00_15(13, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_00_15(14, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_00_15(15, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(16, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(17, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(18, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(19, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(20, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(21, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(22, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(23, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(24, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(25, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(26, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(27, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(28, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(29, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(30, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(31, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(32, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(33, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(34, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(35, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(36, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(37, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(38, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(39, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(40, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(41, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(42, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(43, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(44, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(45, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(46, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(47, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(48, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(49, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(50, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(51, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(52, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(53, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(54, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(55, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(56, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(57, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(58, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(59, V1, V2, V3, V0, V5, V6, V7, V4)
+	ROUND_16_63(60, V0, V1, V2, V3, V4, V5, V6, V7)
+	ROUND_16_63(61, V3, V0, V1, V2, V7, V4, V5, V6)
+	ROUND_16_63(62, V2, V3, V0, V1, V6, V7, V4, V5)
+	ROUND_16_63(63, V1, V2, V3, V0, V5, V6, V7, V4)
+
+	ADD  $(0*32), R0, R4
+	VLD1 (R4), [V16.S4]
+	VEOR V16.B16, V0.B16, V0.B16
+	VST1 [V0.S4], (R4)
+	ADD  $(1*32), R0, R4
+	VLD1 (R4), [V16.S4]
+	VEOR V16.B16, V1.B16, V1.B16
+	VST1 [V1.S4], (R4)
+	ADD  $(2*32), R0, R4
+	VLD1 (R4), [V16.S4]
+	VEOR V16.B16, V2.B16, V2.B16
+	VST1 [V2.S4], (R4)
+	ADD  $(3*32), R0, R4
+	VLD1 (R4), [V16.S4]
+	VEOR V16.B16, V3.B16, V3.B16
+	VST1 [V3.S4], (R4)
+	ADD  $(4*32), R0, R4
+	VLD1 (R4), [V16.S4]
+	VEOR V16.B16, V4.B16, V4.B16
+	VST1 [V4.S4], (R4)
+	ADD  $(5*32), R0, R4
+	VLD1 (R4), [V16.S4]
+	VEOR V16.B16, V5.B16, V5.B16
+	VST1 [V5.S4], (R4)
+	ADD  $(6*32), R0, R4
+	VLD1 (R4), [V16.S4]
+	VEOR V16.B16, V6.B16, V6.B16
+	VST1 [V6.S4], (R4)
+	ADD  $(7*32), R0, R4
+	VLD1 (R4), [V16.S4]
+	VEOR V16.B16, V7.B16, V7.B16
+	VST1 [V7.S4], (R4)
+
+	ADD  $16, R0
+	ADD  $16, R1
+	SUBS $1, R6
+	BNE  half
+	RET
+
+// T[j] <<< j
+DATA tj<>+0x00(SB)/4, $0x79cc4519
+DATA tj<>+0x04(SB)/4, $0xf3988a32
+DATA tj<>+0x08(SB)/4, $0xe7311465
+DATA tj<>+0x0c(SB)/4, $0xce6228cb
+DATA tj<>+0x10(SB)/4, $0x9cc45197
+DATA tj<>+0x14(SB)/4, $0x3988a32f
+DATA tj<>+0x18(SB)/4, $0x7311465e
+DATA tj<>+0x1c(SB)/4, $0xe6228cbc
+DATA tj<>+0x20(SB)/4, $0xcc451979
+DATA tj<>+0x24(SB)/4, $0x988a32f3
+DATA tj<>+0x28(SB)/4, $0x311465e7
+DATA tj<>+0x2c(SB)/4, $0x6228cbce
+DATA tj<>+0x30(SB)/4, $0xc451979c
+DATA tj<>+0x34(SB)/4, $0x88a32f39
+DATA tj<>+0x38(SB)/4, $0x11465e73
+DATA tj<>+0x3c(SB)/4, $0x228cbce6
+DATA tj<>+0x40(SB)/4, $0x9d8a7a87
+DATA tj<>+0x44(SB)/4, $0x3b14f50f
+DATA tj<>+0x48(SB)/4, $0x7629ea1e
+DATA tj<>+0x4c(SB)/4, $0xec53d43c
+DATA tj<>+0x50(SB)/4, $0xd8a7a879
+DATA tj<>+0x54(SB)/4, $0xb14f50f3
+DATA tj<>+0x58(SB)/4, $0x629ea1e7
+DATA tj<>+0x5c(SB)/4, $0xc53d43ce
+DATA tj<>+0x60(SB)/4, $0x8a7a879d
+DATA tj<>+0x64(SB)/4, $0x14f50f3b
+DATA tj<>+0x68(SB)/4, $0x29ea1e76
+DATA tj<>+0x6c(SB)/4, $0x53d43cec
+DATA tj<>+0x70(SB)/4, $0xa7a879d8
+DATA tj<>+0x74(SB)/4, $0x4f50f3b1
+DATA tj<>+0x78(SB)/4, $0x9ea1e762
+DATA tj<>+0x7c(SB)/4, $0x3d43cec5
+DATA tj<>+0x80(SB)/4, $0x7a879d8a
+DATA tj<>+0x84(SB)/4, $0xf50f3b14
+DATA tj<>+0x88(SB)/4, $0xea1e7629
+DATA tj<>+0x8c(SB)/4, $0xd43cec53
+DATA tj<>+0x90(SB)/4, $0xa879d8a7
+DATA tj<>+0x94(SB)/4, $0x50f3b14f
+DATA tj<>+0x98(SB)/4, $0xa1e7629e
+DATA tj<>+0x9c(SB)/4, $0x43cec53d
+DATA tj<>+0xa0(SB)/4, $0x879d8a7a
+DATA tj<>+0xa4(SB)/4, $0x0f3b14f5
+DATA tj<>+0xa8(SB)/4, $0x1e7629ea
+DATA tj<>+0xac(SB)/4, $0x3cec53d4
+DATA tj<>+0xb0(SB)/4, $0x79d8a7a8
+DATA tj<>+0xb4(SB)/4, $0xf3b14f50
+DATA tj<>+0xb8(SB)/4, $0xe7629ea1
+DATA tj<>+0xbc(SB)/4, $0xcec53d43
+DATA tj<>+0xc0(SB)/4, $0x9d8a7a87
+DATA tj<>+0xc4(SB)/4, $0x3b14f50f
+DATA tj<>+0xc8(SB)/4, $0x7629ea1e
+DATA tj<>+0xcc(SB)/4, $0xec53d43c
+DATA tj<>+0xd0(SB)/4, $0xd8a7a879
+DATA tj<>+0xd4(SB)/4, $0xb14f50f3
+DATA tj<>+0xd8(SB)/4, $0x629ea1e7
+DATA tj<>+0xdc(SB)/4, $0xc53d43ce
+DATA tj<>+0xe0(SB)/4, $0x8a7a879d
+DATA tj<>+0xe4(SB)/4, $0x14f50f3b
+DATA tj<>+0xe8(SB)/4, $0x29ea1e76
+DATA tj<>+0xec(SB)/4, $0x53d43cec
+DATA tj<>+0xf0(SB)/4, $0xa7a879d8
+DATA tj<>+0xf4(SB)/4, $0x4f50f3b1
+DATA tj<>+0xf8(SB)/4, $0x9ea1e762
+DATA tj<>+0xfc(SB)/4, $0x3d43cec5
+GLOBL tj<>(SB), RODATA, $256
@@ -0,0 +1,120 @@
+package sm3
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// block8Generic is block8 in Go, one lane after the other.
+func block8Generic(dig *[8][lanes]uint32, w *[68][lanes]uint32) {
+	for l := 0; l < lanes; l++ {
+		var d [8]uint32
+		var b [blockSize]byte
+		for i := range d {
+			d[i] = dig[i][l]
+		}
+		for i := 0; i < 16; i++ {
+			v := w[i][l]
+			b[4*i], b[4*i+1], b[4*i+2], b[4*i+3] = byte(v>>24), byte(v>>16), byte(v>>8), byte(v)
+		}
+		update_32bit(&d, b[:], nil)
+		for i := range d {
+			dig[i][l] = d[i]
+		}
+	}
+}
+
+func testMessages(n int) [][]byte {
+	msgs := make([][]byte, n)
+	for i := range msgs {
+		// lengths around the padding boundaries and a few long ones
+		size := (i * 7) % 200
+		if i%13 == 0 {
+			size = 1000 + i
+		}
+		msgs[i] = make([]byte, size)
+		_, _ = rand.Read(msgs[i])
+	}
+	return msgs
+}
+
+func TestHashManyScheduler(t *testing.T) {
+	blocks := map[string]func(*[8][lanes]uint32, *[68][lanes]uint32){"generic": block8Generic}
+	for name, block := range block8Kernels {
+		blocks[name] = block
+	}
+	for name, block := range blocks {
+		for _, n := range []int{1, 2, 3, 8, 9, 100} {
+			msgs := testMessages(n)
+			out := make([][32]byte, n)
+			hashMany(out, msgs, block)
+			for i, msg := range msgs {
+				assert.Equal(t, Hash(msg), out[i][:], "%s: message %d of %d", name, i, n)
+			}
+		}
+	}
+}
+
+func TestHashManyKeepsInput(t *testing.T) {
+	// the body of a message is a subslice whose capacity reaches past it
+	buf := make([]byte, 200)
+	_, _ = rand.Read(buf)
+	want := append([]byte(nil), buf...)
+	msgs := [][]byte{buf[:64], buf[64:128], buf[:130]}
+	out := make([][32]byte, len(msgs))
+	hashMany(out, msgs, block8Generic)
+	assert.Equal(t, want, buf)
+	for i, msg := range msgs {
+		assert.Equal(t, Hash(msg), out[i][:])
+	}
+}
+
+func TestBlock8Kernels(t *testing.T) {
+	var dig [8][lanes]uint32
+	var w [68][lanes]uint32
+	buf := make([]byte, 4*len(dig)*lanes+4*16*lanes)
+	for name, block := range block8Kernels {
+		for n := 0; n < 16; n++ {
+			_, _ = rand.Read(buf)
+			for i := range dig {
+				for l := range dig[i] {
+					dig[i][l] = binary.LittleEndian.Uint32(buf[4*(i*lanes+l):])
+				}
+			}
+			for i := 0; i < 16; i++ {
+				for l := range w[i] {
+					w[i][l] = binary.LittleEndian.Uint32(buf[4*(len(dig)*lanes+i*lanes+l):])
+				}
+			}
+			want, wantW := dig, w
+			block8Generic(&want, &wantW)
+			block(&dig, &w)
+			assert.Equal(t, want, dig, name)
+			assert.Equal(t, wantW[:16], w[:16], "%s: the block was modified", name)
+		}
+	}
+}
+
+func BenchmarkBlock8Kernels(b *testing.B) {
+	blocks := map[string]func(*[8][lanes]uint32, *[68][lanes]uint32){"generic": block8Generic}
+	for name, block := range block8Kernels {
+		blocks[name] = block
+	}
+	msgs := testMessages(1024)
+	size := 0
+	for _, msg := range msgs {
+		size += len(msg)
+	}
+	out := make([][32]byte, len(msgs))
+	for name, block := range blocks {
+		block := block
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				hashMany(out, msgs, block)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+//Package jose implements JWK (RFC 7517), JWS (RFC 7515) and JWE (RFC 7516)
+// with the Guomi algorithms.
+//
+// SM2 keys are JWKs of kty "EC" and crv "SM2", SM4 keys are JWKs of kty
+// "oct". The JWS algorithm "SM2SM3" signs SM3(Z || signing input) where Z is
+// computed from the public key and the default user id, as HashBeforeSM2;
+// the signature is r || s, 64 bytes, like the ECDSA algorithms of RFC 7518.
+//
+// JWE encrypts the content with "SM4GCM", SM4 in GCM mode with a 128-bit key,
+// a 96-bit IV and a 128-bit tag. The content encryption key is either
+// encrypted for each recipient with the key management algorithm "SM2", SM2
+// public key encryption with the ciphertext ordered C1 || C3 || C2 as in
+// GB/T 32918.4, or is the shared SM4 key itself with "dir".
+//
+// Both are read and written in the compact and the JSON serializations; the
+// JSON serialization written is the general one, the flattened one is also
+// read.
+package jose
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Algorithm names.
+const (
+	SM2SM3 = "SM2SM3" // JWS with SM2 and SM3
+	SM2    = "SM2"    // JWE key encryption with SM2
+	Direct = "dir"    // JWE with a shared SM4 key
+	SM4GCM = "SM4GCM" // JWE content encryption with SM4-GCM
+)
+
+//Header is a JOSE header. Parameters which it does not hold are ignored when
+// parsing, except crit, which is rejected since no extension is understood.
+type Header struct {
+	Algorithm   string   `json:"alg,omitempty"`
+	Encryption  string   `json:"enc,omitempty"`
+	KeyID       string   `json:"kid,omitempty"`
+	Type        string   `json:"typ,omitempty"`
+	ContentType string   `json:"cty,omitempty"`
+	JWK         *JWK     `json:"jwk,omitempty"`
+	Critical    []string `json:"crit,omitempty"`
+}
+
+var (
+	//ErrMalformed is returned when parsing an object which is not valid
+	// JOSE.
+	ErrMalformed = errors.New("jose: malformed object")
+	//ErrVerification is returned when no signature of a JWS verifies.
+	ErrVerification = errors.New("jose: signature verification failed")
+	//ErrDecryption is returned when a JWE cannot be decrypted with the key.
+	ErrDecryption = errors.New("jose: decryption failed")
+)
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decode(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	return b, nil
+}
+
+// encodeHeader returns the base64url encoding of the JSON of h.
+func encodeHeader(h *Header) (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+	return encode(b), nil
+}
+
+// decodeHeader parses a base64url encoded header.
+func decodeHeader(s string) (*Header, error) {
+	b, err := decode(s)
+	if err != nil {
+		return nil, err
+	}
+	h := new(Header)
+	if err := json.Unmarshal(b, h); err != nil {
+		return nil, ErrMalformed
+	}
+	if len(h.Critical) != 0 {
+		return nil, errors.New("jose: unsupported critical header parameters")
+	}
+	return h, nil
+}
+
+// merge returns the union of headers, a parameter may appear only once.
+func merge(headers ...*Header) (*Header, error) {
+	m := make(map[string]json.RawMessage)
+	for _, h := range headers {
+		if h == nil {
+			continue
+		}
+		b, err := json.Marshal(h)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return nil, err
+		}
+		for k, v := range fields {
+			if _, ok := m[k]; ok {
+				return nil, ErrMalformed
+			}
+			m[k] = v
+		}
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	h := new(Header)
+	return h, json.Unmarshal(b, h)
+}
+
+func isJSON(s string) bool {
+	return strings.HasPrefix(strings.TrimSpace(s), "{")
+}
@@ -0,0 +1,264 @@
+package jose
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"io"
+	"strings"
+)
+
+const (
+	cekSize = 16
+	ivSize  = 12
+)
+
+//JSONWebEncryption is a JWE: content encrypted with SM4-GCM under a content
+// encryption key, itself encrypted for each recipient.
+type JSONWebEncryption struct {
+	Protected   *Header
+	Unprotected *Header // JSON serialization only
+	Recipients  []Recipient
+	AAD         []byte // JSON serialization only
+	IV          []byte
+	Ciphertext  []byte
+	Tag         []byte
+
+	protected string
+}
+
+//Recipient is the encrypted key of a recipient with its header.
+type Recipient struct {
+	Header       *Header
+	EncryptedKey []byte
+}
+
+//Encrypt encrypts plaintext for each SM2 public key of recipients, with the
+// algorithms of h set to SM2 and SM4GCM.
+func Encrypt(plaintext []byte, h *Header, recipients ...*gm.SM2PublicKey) (*JSONWebEncryption, error) {
+	return EncryptWithAAD(plaintext, nil, h, recipients...)
+}
+
+//EncryptWithAAD is Encrypt with additional authenticated data, which only
+// the JSON serialization carries.
+func EncryptWithAAD(plaintext, aad []byte, h *Header, recipients ...*gm.SM2PublicKey) (*JSONWebEncryption, error) {
+	if len(recipients) == 0 {
+		return nil, errors.New("jose: no recipient")
+	}
+	cek := make(gm.SM4Key, cekSize)
+	defer cek.Destroy()
+	if _, err := io.ReadFull(rand.Reader, cek); err != nil {
+		return nil, err
+	}
+	var rs []Recipient
+	for _, pub := range recipients {
+		c, err := gm.Encrypt(pub, cek, rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		k, err := gm.CiphertextToC1C3C2(c)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, Recipient{EncryptedKey: k})
+	}
+	return seal(plaintext, aad, h, SM2, cek, rs)
+}
+
+//EncryptDirect encrypts plaintext with the shared SM4 key, with the
+// algorithms of h set to dir and SM4GCM.
+func EncryptDirect(plaintext []byte, h *Header, key gm.SM4Key) (*JSONWebEncryption, error) {
+	if len(key) != cekSize {
+		return nil, errors.New("jose: an SM4 key is 16 bytes")
+	}
+	return seal(plaintext, nil, h, Direct, key, []Recipient{{}})
+}
+
+func newGCM(key gm.SM4Key) (cipher.AEAD, error) {
+	b, err := gm.GetSm4Cipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(b)
+}
+
+// additionalData returns the additional authenticated data of the content.
+func additionalData(protected string, aad []byte) []byte {
+	if len(aad) == 0 {
+		return []byte(protected)
+	}
+	return []byte(protected + "." + encode(aad))
+}
+
+func seal(plaintext, aad []byte, h *Header, alg string, cek gm.SM4Key, rs []Recipient) (*JSONWebEncryption, error) {
+	protected := Header{}
+	if h != nil {
+		protected = *h
+	}
+	protected.Algorithm, protected.Encryption = alg, SM4GCM
+	p, err := encodeHeader(&protected)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(cek)
+	if err != nil {
+		return nil, err
+	}
+	e := &JSONWebEncryption{Protected: &protected, Recipients: rs, AAD: aad, IV: make([]byte, ivSize), protected: p}
+	if _, err := io.ReadFull(rand.Reader, e.IV); err != nil {
+		return nil, err
+	}
+	out := aead.Seal(nil, e.IV, plaintext, additionalData(p, aad))
+	e.Ciphertext, e.Tag = out[:len(out)-aead.Overhead()], out[len(out)-aead.Overhead():]
+	return e, nil
+}
+
+//Decrypt returns the plaintext, decrypted with an SM2 private key of a
+// recipient or with the shared SM4 key.
+func (e *JSONWebEncryption) Decrypt(key interface{}) ([]byte, error) {
+	for _, r := range e.Recipients {
+		h, err := merge(e.Protected, e.Unprotected, r.Header)
+		if err != nil {
+			return nil, err
+		}
+		if h.Encryption != SM4GCM || len(e.IV) != ivSize || len(e.Tag) != 16 {
+			return nil, ErrDecryption
+		}
+		var cek gm.SM4Key
+		switch k := key.(type) {
+		case *gm.SM2PrivateKey:
+			if h.Algorithm != SM2 || len(r.EncryptedKey) != 1+64+32+cekSize {
+				continue
+			}
+			c, err := gm.CiphertextFromC1C3C2(r.EncryptedKey)
+			if err != nil {
+				continue
+			}
+			if cek, err = gm.Decrypt(k, c); err != nil {
+				continue
+			}
+			defer cek.Destroy()
+		case gm.SM4Key:
+			if h.Algorithm != Direct || len(r.EncryptedKey) != 0 {
+				continue
+			}
+			cek = k
+		default:
+			return nil, errors.New("jose: unsupported decryption key")
+		}
+		aead, err := newGCM(cek)
+		if err != nil {
+			continue
+		}
+		ct := append(append([]byte(nil), e.Ciphertext...), e.Tag...)
+		if plaintext, err := aead.Open(nil, e.IV, ct, additionalData(e.protected, e.AAD)); err == nil {
+			return plaintext, nil
+		}
+	}
+	return nil, ErrDecryption
+}
+
+//CompactSerialize returns the compact serialization of a JWE with a single
+// recipient, no unprotected header and no additional authenticated data.
+func (e *JSONWebEncryption) CompactSerialize() (string, error) {
+	if len(e.Recipients) != 1 || e.Recipients[0].Header != nil || e.Unprotected != nil || len(e.AAD) != 0 {
+		return "", errors.New("jose: compact serialization needs a single recipient without unprotected header or aad")
+	}
+	return strings.Join([]string{e.protected, encode(e.Recipients[0].EncryptedKey),
+		encode(e.IV), encode(e.Ciphertext), encode(e.Tag)}, "."), nil
+}
+
+type jsonRecipient struct {
+	Header       *Header `json:"header,omitempty"`
+	EncryptedKey string  `json:"encrypted_key,omitempty"`
+}
+
+type jsonJWE struct {
+	Protected   string          `json:"protected,omitempty"`
+	Unprotected *Header         `json:"unprotected,omitempty"`
+	Recipients  []jsonRecipient `json:"recipients,omitempty"`
+	AAD         string          `json:"aad,omitempty"`
+	IV          string          `json:"iv"`
+	Ciphertext  string          `json:"ciphertext"`
+	Tag         string          `json:"tag"`
+	// flattened serialization
+	Header       *Header `json:"header,omitempty"`
+	EncryptedKey string  `json:"encrypted_key,omitempty"`
+}
+
+//FullSerialize returns the general JSON serialization of the JWE.
+func (e *JSONWebEncryption) FullSerialize() string {
+	raw := jsonJWE{
+		Protected:   e.protected,
+		Unprotected: e.Unprotected,
+		IV:          encode(e.IV),
+		Ciphertext:  encode(e.Ciphertext),
+		Tag:         encode(e.Tag),
+	}
+	if len(e.AAD) != 0 {
+		raw.AAD = encode(e.AAD)
+	}
+	for _, r := range e.Recipients {
+		raw.Recipients = append(raw.Recipients, jsonRecipient{Header: r.Header, EncryptedKey: encode(r.EncryptedKey)})
+	}
+	b, _ := json.Marshal(raw)
+	return string(b)
+}
+
+//ParseEncrypted parses a JWE in the compact or a JSON serialization.
+func ParseEncrypted(input string) (*JSONWebEncryption, error) {
+	var raw jsonJWE
+	if isJSON(input) {
+		if err := json.Unmarshal([]byte(input), &raw); err != nil {
+			return nil, ErrMalformed
+		}
+		if raw.Header != nil || raw.EncryptedKey != "" {
+			if len(raw.Recipients) != 0 {
+				return nil, ErrMalformed
+			}
+			raw.Recipients = []jsonRecipient{{Header: raw.Header, EncryptedKey: raw.EncryptedKey}}
+		}
+		if len(raw.Recipients) == 0 {
+			raw.Recipients = []jsonRecipient{{}}
+		}
+	} else {
+		parts := strings.Split(input, ".")
+		if len(parts) != 5 {
+			return nil, ErrMalformed
+		}
+		raw.Protected, raw.IV, raw.Ciphertext, raw.Tag = parts[0], parts[2], parts[3], parts[4]
+		raw.Recipients = []jsonRecipient{{EncryptedKey: parts[1]}}
+	}
+
+	e := &JSONWebEncryption{Unprotected: raw.Unprotected, protected: raw.Protected}
+	var err error
+	if raw.Protected != "" {
+		if e.Protected, err = decodeHeader(raw.Protected); err != nil {
+			return nil, err
+		}
+	}
+	if raw.AAD != "" {
+		if e.AAD, err = decode(raw.AAD); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range []struct {
+		s string
+		b *[]byte
+	}{{raw.IV, &e.IV}, {raw.Ciphertext, &e.Ciphertext}, {raw.Tag, &e.Tag}} {
+		if *f.b, err = decode(f.s); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range raw.Recipients {
+		k, err := decode(r.EncryptedKey)
+		if err != nil {
+			return nil, err
+		}
+		e.Recipients = append(e.Recipients, Recipient{Header: r.Header, EncryptedKey: k})
+	}
+	return e, nil
+}
+
@@ -0,0 +1,88 @@
+package jose
+
+import (
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestJWECompact(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	e, err := Encrypt([]byte("secret"), &Header{ContentType: "text/plain"}, key.Public().(*gm.SM2PublicKey))
+	assert.Nil(t, err)
+	compact, err := e.CompactSerialize()
+	assert.Nil(t, err)
+
+	parsed, err := ParseEncrypted(compact)
+	assert.Nil(t, err)
+	assert.Equal(t, SM2, parsed.Protected.Algorithm)
+	assert.Equal(t, SM4GCM, parsed.Protected.Encryption)
+	plaintext, err := parsed.Decrypt(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	other, _ := gm.GenerateSM2Key()
+	_, err = parsed.Decrypt(other)
+	assert.Equal(t, ErrDecryption, err)
+
+	parts := strings.Split(compact, ".")
+	ct, _ := decode(parts[3])
+	ct[0] ^= 1
+	parts[3] = encode(ct)
+	parsed, err = ParseEncrypted(strings.Join(parts, "."))
+	assert.Nil(t, err)
+	_, err = parsed.Decrypt(key)
+	assert.Equal(t, ErrDecryption, err)
+
+	_, err = ParseEncrypted("a.b.c.d")
+	assert.Equal(t, ErrMalformed, err)
+}
+
+func TestJWEJSON(t *testing.T) {
+	k1, _ := gm.GenerateSM2Key()
+	k2, _ := gm.GenerateSM2Key()
+	e, err := EncryptWithAAD([]byte("secret"), []byte("aad"), nil,
+		k1.Public().(*gm.SM2PublicKey), k2.Public().(*gm.SM2PublicKey))
+	assert.Nil(t, err)
+	e.Recipients[1].Header = &Header{KeyID: "k2"}
+	_, err = e.CompactSerialize()
+	assert.NotNil(t, err)
+
+	parsed, err := ParseEncrypted(e.FullSerialize())
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("aad"), parsed.AAD)
+	for _, k := range []*gm.SM2PrivateKey{k1, k2} {
+		plaintext, err := parsed.Decrypt(k)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("secret"), plaintext)
+	}
+
+	parsed.AAD = []byte("aaD")
+	_, err = parsed.Decrypt(k1)
+	assert.Equal(t, ErrDecryption, err)
+}
+
+func TestJWEDirect(t *testing.T) {
+	key := gm.SM4Key("0123456789abcdef")
+	e, err := EncryptDirect([]byte("secret"), nil, key)
+	assert.Nil(t, err)
+	compact, err := e.CompactSerialize()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, strings.Count(compact, ".."))
+
+	parsed, err := ParseEncrypted(compact)
+	assert.Nil(t, err)
+	plaintext, err := parsed.Decrypt(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	_, err = parsed.Decrypt(gm.SM4Key("fedcba9876543210"))
+	assert.Equal(t, ErrDecryption, err)
+	sm2Key, _ := gm.GenerateSM2Key()
+	_, err = parsed.Decrypt(sm2Key)
+	assert.Equal(t, ErrDecryption, err)
+	_, err = parsed.Decrypt([]byte("0123456789abcdef"))
+	assert.NotNil(t, err)
+}
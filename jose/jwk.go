@@ -0,0 +1,144 @@
+package jose
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"math/big"
+)
+
+//JWK is a JSON Web Key holding an SM2 key, *gm.SM2PrivateKey or
+// *gm.SM2PublicKey, or an SM4 key, gm.SM4Key.
+type JWK struct {
+	Key       interface{}
+	KeyID     string
+	Use       string
+	Algorithm string
+}
+
+type rawJWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	D   string `json:"d,omitempty"`
+	K   string `json:"k,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+}
+
+//MarshalJSON returns the JSON of k.
+func (k *JWK) MarshalJSON() ([]byte, error) {
+	raw := rawJWK{Kid: k.KeyID, Use: k.Use, Alg: k.Algorithm}
+	switch key := k.Key.(type) {
+	case *gm.SM2PrivateKey:
+		pub := key.Public().(*gm.SM2PublicKey)
+		raw.Kty, raw.Crv, raw.X, raw.Y = "EC", "SM2", encode(pub.X[:]), encode(pub.Y[:])
+		raw.D = encode(key.K[:])
+	case *gm.SM2PublicKey:
+		raw.Kty, raw.Crv, raw.X, raw.Y = "EC", "SM2", encode(key.X[:]), encode(key.Y[:])
+	case gm.SM4Key:
+		if len(key) != 16 {
+			return nil, errors.New("jose: an SM4 key is 16 bytes")
+		}
+		raw.Kty, raw.K = "oct", encode(key)
+	default:
+		return nil, fmt.Errorf("jose: unsupported key type %T", k.Key)
+	}
+	return json.Marshal(raw)
+}
+
+// decodeCoordinate decodes a base64url field of exactly 32 bytes.
+func decodeCoordinate(s string) ([]byte, error) {
+	b, err := decode(s)
+	if err != nil || len(b) != 32 {
+		return nil, errors.New("jose: invalid SM2 key coordinate")
+	}
+	return b, nil
+}
+
+//UnmarshalJSON parses a JWK, checking that an SM2 public key is on the curve
+// and matches the private key.
+func (k *JWK) UnmarshalJSON(data []byte) error {
+	var raw rawJWK
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var key interface{}
+	switch {
+	case raw.Kty == "EC" && raw.Crv == "SM2":
+		x, err := decodeCoordinate(raw.X)
+		if err != nil {
+			return err
+		}
+		y, err := decodeCoordinate(raw.Y)
+		if err != nil {
+			return err
+		}
+		pub := &gm.SM2PublicKey{Curve: gm.GetSm2Curve()}
+		copy(pub.X[:], x)
+		copy(pub.Y[:], y)
+		if !gm.GetSm2Curve().IsOnCurve(new(big.Int).SetBytes(x), new(big.Int).SetBytes(y)) {
+			return errors.New("jose: SM2 public key not on the curve")
+		}
+		key = pub
+		if raw.D != "" {
+			d, err := decodeCoordinate(raw.D)
+			if err != nil {
+				return err
+			}
+			priv := new(gm.SM2PrivateKey)
+			copy(priv.K[:], d)
+			dInt := new(big.Int).SetBytes(d)
+			if dInt.Sign() == 0 || dInt.Cmp(gm.GetSm2Curve().Params().N) >= 0 {
+				return errors.New("jose: SM2 private key out of range")
+			}
+			priv.PublicKey.Curve = gm.GetSm2Curve()
+			priv.CalculatePublicKey()
+			if priv.PublicKey.X != pub.X || priv.PublicKey.Y != pub.Y {
+				priv.Destroy()
+				return errors.New("jose: SM2 private key does not match the public key")
+			}
+			key = priv
+		}
+	case raw.Kty == "oct":
+		b, err := decode(raw.K)
+		if err != nil || len(b) != 16 {
+			return errors.New("jose: an SM4 key is 16 bytes")
+		}
+		key = gm.SM4Key(b)
+	default:
+		return fmt.Errorf("jose: unsupported key type %q, curve %q", raw.Kty, raw.Crv)
+	}
+	*k = JWK{Key: key, KeyID: raw.Kid, Use: raw.Use, Algorithm: raw.Alg}
+	return nil
+}
+
+//Public returns the public JWK of an SM2 private JWK, and k otherwise.
+func (k *JWK) Public() *JWK {
+	key, ok := k.Key.(*gm.SM2PrivateKey)
+	if !ok {
+		return k
+	}
+	pub := *k
+	pub.Key = key.Public()
+	return &pub
+}
+
+//Thumbprint returns the JWK thumbprint of RFC 7638 computed with SM3.
+func (k *JWK) Thumbprint() ([]byte, error) {
+	var input string
+	switch key := k.Public().Key.(type) {
+	case *gm.SM2PublicKey:
+		input = fmt.Sprintf(`{"crv":"SM2","kty":"EC","x":"%s","y":"%s"}`, encode(key.X[:]), encode(key.Y[:]))
+	case gm.SM4Key:
+		input = fmt.Sprintf(`{"k":"%s","kty":"oct"}`, encode(key))
+	default:
+		return nil, fmt.Errorf("jose: unsupported key type %T", k.Key)
+	}
+	h := gm.GetSM3Hasher()
+	h.Write([]byte(input))
+	return h.Sum(nil), nil
+}
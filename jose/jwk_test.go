@@ -0,0 +1,60 @@
+package jose
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestJWK(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	b, err := json.Marshal(&JWK{Key: key, KeyID: "k1", Use: "sig"})
+	assert.Nil(t, err)
+
+	var k JWK
+	assert.Nil(t, json.Unmarshal(b, &k))
+	assert.Equal(t, "k1", k.KeyID)
+	assert.Equal(t, "sig", k.Use)
+	assert.Equal(t, key.K, k.Key.(*gm.SM2PrivateKey).K)
+
+	b, err = json.Marshal(k.Public())
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(b), `"d"`))
+	assert.Nil(t, json.Unmarshal(b, &k))
+	assert.Equal(t, key.PublicKey.X, k.Key.(*gm.SM2PublicKey).X)
+
+	other, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	var raw map[string]string
+	b, _ = json.Marshal(&JWK{Key: key})
+	assert.Nil(t, json.Unmarshal(b, &raw))
+	raw["d"] = encode(other.K[:])
+	b, _ = json.Marshal(raw)
+	assert.NotNil(t, json.Unmarshal(b, &k))
+	raw["y"] = raw["x"]
+	delete(raw, "d")
+	b, _ = json.Marshal(raw)
+	assert.NotNil(t, json.Unmarshal(b, &k))
+
+	b, err = json.Marshal(&JWK{Key: gm.SM4Key("0123456789abcdef")})
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal(b, &k))
+	assert.Equal(t, gm.SM4Key("0123456789abcdef"), k.Key)
+	assert.NotNil(t, json.Unmarshal([]byte(`{"kty":"EC","crv":"P-256"}`), &k))
+}
+
+func TestThumbprint(t *testing.T) {
+	// the public key of d = 1 is the base point
+	var k JWK
+	assert.Nil(t, json.Unmarshal([]byte(`{"kty":"EC","crv":"SM2",`+
+		`"x":"MsSuLB8ZgRlfmQRGajnJlI_jC7_yZgvhcVpFiTNMdMc",`+
+		`"y":"vDc2ovT2d5xZvc7ja2khU9Cph3zGKkdAAt8y5SE58KA",`+
+		`"d":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE","kid":"ignored"}`), &k))
+	tp, err := k.Thumbprint()
+	assert.Nil(t, err)
+	assert.Equal(t, "370fcca227d3379079d768a46133c9d1901bd5e5a45abd96bc6eadfa3dd224ec", hex.EncodeToString(tp))
+}
@@ -0,0 +1,174 @@
+package jose
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"strings"
+)
+
+//JSONWebSignature is a JWS: a payload with one or more signatures.
+type JSONWebSignature struct {
+	Payload    []byte
+	Signatures []Signature
+}
+
+//Signature is a signature of a JWS with its headers.
+type Signature struct {
+	Protected *Header
+	Header    *Header // unprotected, JSON serialization only
+	Signature []byte
+
+	protected string // as serialized, the input of the signature
+}
+
+// signingInput returns the JWS signing input of a header and a payload.
+func signingInput(protected string, payload []byte) []byte {
+	return []byte(protected + "." + encode(payload))
+}
+
+//Sign signs payload with key, the algorithm of h is set to SM2SM3.
+func Sign(payload []byte, key *gm.SM2PrivateKey, h *Header) (*JSONWebSignature, error) {
+	s := &JSONWebSignature{Payload: payload}
+	if err := s.AddSignature(key, h); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+//AddSignature adds a signature by key, the algorithm of h is set to SM2SM3.
+// A JWS with several signatures only has a JSON serialization.
+func (s *JSONWebSignature) AddSignature(key *gm.SM2PrivateKey, h *Header) error {
+	protected := Header{}
+	if h != nil {
+		protected = *h
+	}
+	protected.Algorithm = SM2SM3
+	p, err := encodeHeader(&protected)
+	if err != nil {
+		return err
+	}
+	pub := key.Public().(*gm.SM2PublicKey)
+	der, err := key.Sign(nil, gm.HashBeforeSM2(pub, signingInput(p, s.Payload)), rand.Reader)
+	if err != nil {
+		return err
+	}
+	sig, err := gm.SignatureToRaw(der)
+	if err != nil {
+		return err
+	}
+	s.Signatures = append(s.Signatures, Signature{Protected: &protected, Signature: sig, protected: p})
+	return nil
+}
+
+//Verify returns the payload if a signature verifies with pub.
+func (s *JSONWebSignature) Verify(pub *gm.SM2PublicKey) ([]byte, error) {
+	for _, sig := range s.Signatures {
+		if sig.Protected == nil || sig.Protected.Algorithm != SM2SM3 {
+			continue
+		}
+		der, err := gm.SignatureFromRaw(sig.Signature)
+		if err != nil {
+			continue
+		}
+		digest := gm.HashBeforeSM2(pub, signingInput(sig.protected, s.Payload))
+		if ok, err := pub.Verify(nil, der, digest); err == nil && ok {
+			return s.Payload, nil
+		}
+	}
+	return nil, ErrVerification
+}
+
+//CompactSerialize returns the compact serialization of a JWS with a single
+// signature and no unprotected header.
+func (s *JSONWebSignature) CompactSerialize() (string, error) {
+	if len(s.Signatures) != 1 || s.Signatures[0].Header != nil {
+		return "", errors.New("jose: compact serialization needs a single signature without unprotected header")
+	}
+	sig := s.Signatures[0]
+	return sig.protected + "." + encode(s.Payload) + "." + encode(sig.Signature), nil
+}
+
+type jsonSignature struct {
+	Protected string  `json:"protected,omitempty"`
+	Header    *Header `json:"header,omitempty"`
+	Signature string  `json:"signature"`
+}
+
+type jsonJWS struct {
+	Payload    string          `json:"payload"`
+	Signatures []jsonSignature `json:"signatures,omitempty"`
+	// flattened serialization
+	Protected string  `json:"protected,omitempty"`
+	Header    *Header `json:"header,omitempty"`
+	Signature string  `json:"signature,omitempty"`
+}
+
+//FullSerialize returns the general JSON serialization of the JWS.
+func (s *JSONWebSignature) FullSerialize() string {
+	raw := jsonJWS{Payload: encode(s.Payload)}
+	for _, sig := range s.Signatures {
+		raw.Signatures = append(raw.Signatures, jsonSignature{
+			Protected: sig.protected,
+			Header:    sig.Header,
+			Signature: encode(sig.Signature),
+		})
+	}
+	b, _ := json.Marshal(raw)
+	return string(b)
+}
+
+//ParseSigned parses a JWS in the compact or a JSON serialization.
+func ParseSigned(input string) (*JSONWebSignature, error) {
+	if isJSON(input) {
+		return parseSignedJSON(input)
+	}
+	parts := strings.Split(input, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+	return parseSigned(parts[1], []jsonSignature{{Protected: parts[0], Signature: parts[2]}})
+}
+
+func parseSignedJSON(input string) (*JSONWebSignature, error) {
+	var raw jsonJWS
+	if err := json.Unmarshal([]byte(input), &raw); err != nil {
+		return nil, ErrMalformed
+	}
+	sigs := raw.Signatures
+	if raw.Signature != "" {
+		if len(sigs) != 0 {
+			return nil, ErrMalformed
+		}
+		sigs = []jsonSignature{{Protected: raw.Protected, Header: raw.Header, Signature: raw.Signature}}
+	}
+	return parseSigned(raw.Payload, sigs)
+}
+
+func parseSigned(payload string, sigs []jsonSignature) (*JSONWebSignature, error) {
+	if len(sigs) == 0 {
+		return nil, ErrMalformed
+	}
+	p, err := decode(payload)
+	if err != nil {
+		return nil, err
+	}
+	s := &JSONWebSignature{Payload: p}
+	for _, raw := range sigs {
+		sig := Signature{Header: raw.Header, protected: raw.Protected}
+		if raw.Protected != "" {
+			if sig.Protected, err = decodeHeader(raw.Protected); err != nil {
+				return nil, err
+			}
+			if _, err := merge(sig.Protected, sig.Header); err != nil {
+				return nil, err
+			}
+		}
+		if sig.Signature, err = decode(raw.Signature); err != nil {
+			return nil, err
+		}
+		s.Signatures = append(s.Signatures, sig)
+	}
+	return s, nil
+}
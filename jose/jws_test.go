@@ -0,0 +1,83 @@
+package jose
+
+import (
+	"bytes"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestJWSCompact(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	pub := key.Public().(*gm.SM2PublicKey)
+	s, err := Sign([]byte("hello"), key, &Header{KeyID: "k1", Algorithm: "none"})
+	assert.Nil(t, err)
+	compact, err := s.CompactSerialize()
+	assert.Nil(t, err)
+
+	parsed, err := ParseSigned(compact)
+	assert.Nil(t, err)
+	assert.Equal(t, SM2SM3, parsed.Signatures[0].Protected.Algorithm)
+	assert.Equal(t, "k1", parsed.Signatures[0].Protected.KeyID)
+	payload, err := parsed.Verify(pub)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hello"), payload)
+
+	parts := strings.Split(compact, ".")
+	parsed, err = ParseSigned(parts[0] + "." + encode([]byte("hellO")) + "." + parts[2])
+	assert.Nil(t, err)
+	_, err = parsed.Verify(pub)
+	assert.Equal(t, ErrVerification, err)
+
+	other, _ := gm.GenerateSM2Key()
+	_, err = s.Verify(other.Public().(*gm.SM2PublicKey))
+	assert.Equal(t, ErrVerification, err)
+
+	// r out of range
+	parsed, err = ParseSigned(parts[0] + "." + parts[1] + "." + encode(append(bytes.Repeat([]byte{0xff}, 32), s.Signatures[0].Signature[32:]...)))
+	assert.Nil(t, err)
+	_, err = parsed.Verify(pub)
+	assert.Equal(t, ErrVerification, err)
+
+	_, err = ParseSigned("a.b")
+	assert.Equal(t, ErrMalformed, err)
+	_, err = ParseSigned(encode([]byte(`{"alg":"SM2SM3","crit":["exp"]}`)) + "." + parts[1] + "." + parts[2])
+	assert.NotNil(t, err)
+}
+
+func TestJWSJSON(t *testing.T) {
+	k1, _ := gm.GenerateSM2Key()
+	k2, _ := gm.GenerateSM2Key()
+	s, err := Sign([]byte("payload"), k1, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, s.AddSignature(k2, &Header{KeyID: "k2"}))
+	s.Signatures[1].Header = &Header{Type: "JOSE+JSON"}
+	_, err = s.CompactSerialize()
+	assert.NotNil(t, err)
+
+	parsed, err := ParseSigned(s.FullSerialize())
+	assert.Nil(t, err)
+	assert.Len(t, parsed.Signatures, 2)
+	assert.Equal(t, "JOSE+JSON", parsed.Signatures[1].Header.Type)
+	for _, k := range []*gm.SM2PrivateKey{k1, k2} {
+		payload, err := parsed.Verify(k.Public().(*gm.SM2PublicKey))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("payload"), payload)
+	}
+
+	sig := parsed.Signatures[0]
+	flattened := `{"payload":"` + encode(parsed.Payload) + `","protected":"` + sig.protected +
+		`","signature":"` + encode(sig.Signature) + `"}`
+	parsed, err = ParseSigned(flattened)
+	assert.Nil(t, err)
+	_, err = parsed.Verify(k1.Public().(*gm.SM2PublicKey))
+	assert.Nil(t, err)
+
+	// a parameter in both the protected and the unprotected header
+	dup := `{"payload":"` + encode(parsed.Payload) + `","protected":"` + sig.protected +
+		`","header":{"alg":"SM2SM3"},"signature":"` + encode(sig.Signature) + `"}`
+	_, err = ParseSigned(dup)
+	assert.Equal(t, ErrMalformed, err)
+}
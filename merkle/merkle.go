@@ -0,0 +1,200 @@
+//Package merkle implements SM3 Merkle trees in the style of RFC 6962: leaves
+// and interior nodes are hashed with different prefixes, the tree of n leaves
+// splits at the largest power of two smaller than n, and there are inclusion
+// and consistency proofs for any earlier size of the tree.
+package merkle
+
+import (
+	gm "github.com/meshplus/crypto-gm"
+	"runtime"
+	"sync"
+)
+
+//HashSize is the size of every hash of the tree.
+const HashSize = 32
+
+// RFC 6962 section 2.1 domain separation prefixes.
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+//LeafHash returns the hash of a leaf, SM3(0x00 || data).
+func LeafHash(data []byte) []byte {
+	h, _ := gm.NewSM3Hasher().BatchHash([][]byte{{leafPrefix}, data})
+	return h
+}
+
+//NodeHash returns the hash of an interior node, SM3(0x01 || left || right).
+func NodeHash(left, right []byte) []byte {
+	h, _ := gm.NewSM3Hasher().BatchHash([][]byte{{nodePrefix}, left, right})
+	return h
+}
+
+//EmptyRoot returns the root of the tree without leaves, the SM3 hash of the
+// empty string.
+func EmptyRoot() []byte {
+	h, _ := gm.NewSM3Hasher().Hash(nil)
+	return h
+}
+
+//Root returns the root of the tree of leaves.
+func Root(leaves [][]byte) []byte {
+	return Build(leaves).Root()
+}
+
+//Tree is a Merkle tree which keeps every level, so that proofs for any size
+// up to the number of its leaves can be generated without rehashing leaves.
+type Tree struct {
+	// levels[0] holds the leaf hashes, levels[i+1] the hashes of the pairs
+	// of levels[i]; an odd last node is carried up unchanged.
+	levels [][][HashSize]byte
+}
+
+// parallelThreshold is the number of hashes of a level below which Build
+// does not spread the work over goroutines.
+const parallelThreshold = 4096
+
+//Build returns the tree of leaves. The hashes of large levels are computed
+// on all CPUs with gm.SM3HashMany.
+func Build(leaves [][]byte) *Tree {
+	msgs := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		msg := make([]byte, 1+len(leaf))
+		msg[0] = leafPrefix
+		copy(msg[1:], leaf)
+		msgs[i] = msg
+	}
+	return buildFromMessages(msgs)
+}
+
+//BuildFromLeafHashes returns the tree whose leaves have the given hashes, as
+// returned by LeafHash.
+func BuildFromLeafHashes(hashes [][]byte) (*Tree, error) {
+	level := make([][HashSize]byte, len(hashes))
+	for i, h := range hashes {
+		if len(h) != HashSize {
+			return nil, errInvalidHash
+		}
+		copy(level[i][:], h)
+	}
+	t := &Tree{levels: [][][HashSize]byte{level}}
+	t.buildLevels()
+	return t, nil
+}
+
+func buildFromMessages(msgs [][]byte) *Tree {
+	level := make([][HashSize]byte, len(msgs))
+	hashAll(level, msgs)
+	t := &Tree{levels: [][][HashSize]byte{level}}
+	t.buildLevels()
+	return t
+}
+
+func (t *Tree) buildLevels() {
+	for level := t.levels[0]; len(level) > 1; level = t.levels[len(t.levels)-1] {
+		pairs := len(level) / 2
+		buf := make([]byte, pairs*(1+2*HashSize))
+		msgs := make([][]byte, pairs)
+		for i := range msgs {
+			msg := buf[i*(1+2*HashSize) : (i+1)*(1+2*HashSize)]
+			msg[0] = nodePrefix
+			copy(msg[1:], level[2*i][:])
+			copy(msg[1+HashSize:], level[2*i+1][:])
+			msgs[i] = msg
+		}
+		next := make([][HashSize]byte, pairs, pairs+1)
+		hashAll(next, msgs)
+		if len(level)%2 == 1 {
+			next = append(next, level[len(level)-1])
+		}
+		t.levels = append(t.levels, next)
+	}
+}
+
+// hashAll sets out[i] to the SM3 hash of msgs[i].
+func hashAll(out [][HashSize]byte, msgs [][]byte) {
+	workers := runtime.GOMAXPROCS(0)
+	if len(msgs) < parallelThreshold || workers == 1 {
+		copy(out, gm.SM3HashMany(msgs))
+		return
+	}
+	chunk := (len(msgs) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for lo := 0; lo < len(msgs); lo += chunk {
+		hi := lo + chunk
+		if hi > len(msgs) {
+			hi = len(msgs)
+		}
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			copy(out[lo:hi], gm.SM3HashMany(msgs[lo:hi]))
+		}(lo, hi)
+	}
+	wg.Wait()
+}
+
+//Size returns the number of leaves of the tree.
+func (t *Tree) Size() int {
+	return len(t.levels[0])
+}
+
+//LeafHash returns the hash of the leaf at index.
+func (t *Tree) LeafHash(index int) ([]byte, error) {
+	if index < 0 || index >= t.Size() {
+		return nil, errIndexOutOfRange
+	}
+	return append([]byte(nil), t.levels[0][index][:]...), nil
+}
+
+//Root returns the root of the tree.
+func (t *Tree) Root() []byte {
+	if t.Size() == 0 {
+		return EmptyRoot()
+	}
+	return append([]byte(nil), t.levels[len(t.levels)-1][0][:]...)
+}
+
+//RootAt returns the root the tree had when it had size leaves.
+func (t *Tree) RootAt(size int) ([]byte, error) {
+	if size < 0 || size > t.Size() {
+		return nil, errSizeOutOfRange
+	}
+	if size == 0 {
+		return EmptyRoot(), nil
+	}
+	return t.subtreeHash(0, size), nil
+}
+
+// subtreeHash returns MTH(D[lo:hi]) of RFC 6962 section 2.1, hi > lo.
+// Complete subtrees are read from the levels, the others are split as the
+// RFC does and the right part, the only incomplete one, is recursed into.
+func (t *Tree) subtreeHash(lo, hi int) []byte {
+	n := hi - lo
+	if n&(n-1) == 0 && lo%n == 0 {
+		k := log2(n)
+		return append([]byte(nil), t.levels[k][lo>>k][:]...)
+	}
+	k := splitPoint(n)
+	return NodeHash(t.subtreeHash(lo, lo+k), t.subtreeHash(lo+k, hi))
+}
+
+// splitPoint returns the largest power of two smaller than n, n > 1.
+func splitPoint(n int) int {
+	k := 1
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
+
+// log2 returns the base 2 logarithm of n, a power of two.
+func log2(n int) int {
+	k := 0
+	for n > 1 {
+		n >>= 1
+		k++
+	}
+	return k
+}
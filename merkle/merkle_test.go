@@ -0,0 +1,94 @@
+package merkle
+
+import (
+	"encoding/hex"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+)
+
+// referenceRoot is MTH of RFC 6962 section 2.1, computed recursively.
+func referenceRoot(leaves [][]byte) []byte {
+	switch len(leaves) {
+	case 0:
+		return EmptyRoot()
+	case 1:
+		return LeafHash(leaves[0])
+	}
+	k := splitPoint(len(leaves))
+	return NodeHash(referenceRoot(leaves[:k]), referenceRoot(leaves[k:]))
+}
+
+func testLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = []byte(fmt.Sprintf("leaf %d", i))
+	}
+	return leaves
+}
+
+func TestHashes(t *testing.T) {
+	assert.Equal(t, "1ab21d8355cfa17f8e61194831e81a8f22bec8c728fefb747ed035eb5082aa2b", hex.EncodeToString(EmptyRoot()))
+
+	h, _ := gm.NewSM3Hasher().Hash([]byte("\x00abc"))
+	assert.Equal(t, h, LeafHash([]byte("abc")))
+	left, right := LeafHash([]byte("a")), LeafHash([]byte("b"))
+	h, _ = gm.NewSM3Hasher().Hash(append(append([]byte{1}, left...), right...))
+	assert.Equal(t, h, NodeHash(left, right))
+
+	// a leaf can't pass for a node
+	assert.NotEqual(t, LeafHash(append(left, right...)), NodeHash(left, right))
+}
+
+func TestRoot(t *testing.T) {
+	for n := 0; n <= 70; n++ {
+		leaves := testLeaves(n)
+		want := referenceRoot(leaves)
+		tree := Build(leaves)
+		assert.Equal(t, n, tree.Size())
+		assert.Equal(t, want, tree.Root(), "size %d", n)
+		assert.Equal(t, want, Root(leaves), "size %d", n)
+	}
+
+	tree := Build(testLeaves(37))
+	for size := 0; size <= 37; size++ {
+		root, err := tree.RootAt(size)
+		assert.Nil(t, err)
+		assert.Equal(t, referenceRoot(testLeaves(size)), root, "size %d", size)
+	}
+	_, err := tree.RootAt(38)
+	assert.NotNil(t, err)
+}
+
+func TestBuildFromLeafHashes(t *testing.T) {
+	leaves := testLeaves(11)
+	hashes := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		hashes[i] = LeafHash(leaf)
+	}
+	tree, err := BuildFromLeafHashes(hashes)
+	assert.Nil(t, err)
+	assert.Equal(t, Root(leaves), tree.Root())
+	h, err := tree.LeafHash(3)
+	assert.Nil(t, err)
+	assert.Equal(t, hashes[3], h)
+
+	_, err = BuildFromLeafHashes([][]byte{make([]byte, 31)})
+	assert.NotNil(t, err)
+}
+
+func TestBuildParallel(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	leaves := testLeaves(3*parallelThreshold + 5)
+	assert.Equal(t, referenceRoot(leaves), Root(leaves))
+}
+
+func BenchmarkBuild(b *testing.B) {
+	leaves := testLeaves(1 << 16)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Build(leaves)
+	}
+}
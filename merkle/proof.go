@@ -0,0 +1,161 @@
+package merkle
+
+import (
+	"bytes"
+	"errors"
+)
+
+var (
+	errInvalidHash     = errors.New("merkle: hash must be 32 bytes long")
+	errIndexOutOfRange = errors.New("merkle: leaf index out of range")
+	errSizeOutOfRange  = errors.New("merkle: tree size out of range")
+	//ErrInvalidProof is returned when a proof does not match the root.
+	ErrInvalidProof = errors.New("merkle: invalid proof")
+)
+
+//InclusionProof returns the audit path of the leaf at index in the tree of
+// the first size leaves, RFC 6962 section 2.1.1. The hashes go from the leaf
+// up to the root.
+func (t *Tree) InclusionProof(index, size int) ([][]byte, error) {
+	if size <= 0 || size > t.Size() {
+		return nil, errSizeOutOfRange
+	}
+	if index < 0 || index >= size {
+		return nil, errIndexOutOfRange
+	}
+	return t.path(index, 0, size), nil
+}
+
+// path is PATH(m, D[lo:hi]).
+func (t *Tree) path(m, lo, hi int) [][]byte {
+	n := hi - lo
+	if n == 1 {
+		return nil
+	}
+	k := splitPoint(n)
+	if m < k {
+		return append(t.path(m, lo, lo+k), t.subtreeHash(lo+k, hi))
+	}
+	return append(t.path(m-k, lo+k, hi), t.subtreeHash(lo, lo+k))
+}
+
+//ConsistencyProof returns the proof that the tree of the first newSize
+// leaves extends the tree of the first oldSize ones, RFC 6962 section 2.1.2.
+func (t *Tree) ConsistencyProof(oldSize, newSize int) ([][]byte, error) {
+	if newSize < 0 || newSize > t.Size() {
+		return nil, errSizeOutOfRange
+	}
+	if oldSize <= 0 || oldSize > newSize {
+		return nil, errSizeOutOfRange
+	}
+	return t.subproof(oldSize, 0, newSize, true), nil
+}
+
+// subproof is SUBPROOF(m, D[lo:hi], b).
+func (t *Tree) subproof(m, lo, hi int, b bool) [][]byte {
+	n := hi - lo
+	if m == n {
+		if b {
+			return nil
+		}
+		return [][]byte{t.subtreeHash(lo, hi)}
+	}
+	k := splitPoint(n)
+	if m <= k {
+		return append(t.subproof(m, lo, lo+k, b), t.subtreeHash(lo+k, hi))
+	}
+	return append(t.subproof(m-k, lo+k, hi, false), t.subtreeHash(lo, lo+k))
+}
+
+//VerifyInclusion checks that the leaf with leafHash is at index in the tree
+// of size leaves with root, RFC 9162 section 2.1.3.2.
+func VerifyInclusion(leafHash []byte, index, size int, proof [][]byte, root []byte) error {
+	if index < 0 || index >= size {
+		return errIndexOutOfRange
+	}
+	if err := checkHashes(proof); err != nil {
+		return err
+	}
+	fn, sn := index, size-1
+	r := leafHash
+	for _, p := range proof {
+		if sn == 0 {
+			return ErrInvalidProof
+		}
+		if fn&1 == 1 || fn == sn {
+			r = NodeHash(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = NodeHash(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 || !bytes.Equal(r, root) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+//VerifyConsistency checks that the tree of newSize leaves with newRoot
+// extends the tree of oldSize leaves with oldRoot, RFC 9162 section 2.1.4.2.
+func VerifyConsistency(oldSize, newSize int, oldRoot, newRoot []byte, proof [][]byte) error {
+	if oldSize <= 0 || oldSize > newSize {
+		return errSizeOutOfRange
+	}
+	if err := checkHashes(proof); err != nil {
+		return err
+	}
+	if oldSize == newSize {
+		if len(proof) != 0 || !bytes.Equal(oldRoot, newRoot) {
+			return ErrInvalidProof
+		}
+		return nil
+	}
+	if oldSize&(oldSize-1) == 0 {
+		proof = append([][]byte{oldRoot}, proof...)
+	}
+	if len(proof) == 0 {
+		return ErrInvalidProof
+	}
+
+	fn, sn := oldSize-1, newSize-1
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+	fr, sr := proof[0], proof[0]
+	for _, c := range proof[1:] {
+		if sn == 0 {
+			return ErrInvalidProof
+		}
+		if fn&1 == 1 || fn == sn {
+			fr = NodeHash(c, fr)
+			sr = NodeHash(c, sr)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			sr = NodeHash(sr, c)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 || !bytes.Equal(fr, oldRoot) || !bytes.Equal(sr, newRoot) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+func checkHashes(proof [][]byte) error {
+	for _, p := range proof {
+		if len(p) != HashSize {
+			return errInvalidHash
+		}
+	}
+	return nil
+}
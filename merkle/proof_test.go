@@ -0,0 +1,63 @@
+package merkle
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestInclusionProof(t *testing.T) {
+	leaves := testLeaves(33)
+	tree := Build(leaves)
+	for size := 1; size <= len(leaves); size++ {
+		root, _ := tree.RootAt(size)
+		for index := 0; index < size; index++ {
+			proof, err := tree.InclusionProof(index, size)
+			assert.Nil(t, err)
+			assert.Nil(t, VerifyInclusion(LeafHash(leaves[index]), index, size, proof, root), "leaf %d of %d", index, size)
+
+			// another leaf or index must fail
+			assert.Equal(t, ErrInvalidProof, VerifyInclusion(LeafHash([]byte("other")), index, size, proof, root))
+			if index+1 < size {
+				assert.NotNil(t, VerifyInclusion(LeafHash(leaves[index]), index+1, size, proof, root))
+			}
+			if len(proof) > 0 {
+				assert.NotNil(t, VerifyInclusion(LeafHash(leaves[index]), index, size, proof[1:], root))
+			}
+		}
+	}
+
+	_, err := tree.InclusionProof(5, 5)
+	assert.NotNil(t, err)
+	_, err = tree.InclusionProof(0, 34)
+	assert.NotNil(t, err)
+	assert.NotNil(t, VerifyInclusion(LeafHash(leaves[0]), 0, 2, [][]byte{{1, 2}}, tree.Root()))
+}
+
+func TestConsistencyProof(t *testing.T) {
+	tree := Build(testLeaves(33))
+	for newSize := 1; newSize <= tree.Size(); newSize++ {
+		newRoot, _ := tree.RootAt(newSize)
+		for oldSize := 1; oldSize <= newSize; oldSize++ {
+			oldRoot, _ := tree.RootAt(oldSize)
+			proof, err := tree.ConsistencyProof(oldSize, newSize)
+			assert.Nil(t, err)
+			assert.Nil(t, VerifyConsistency(oldSize, newSize, oldRoot, newRoot, proof), "%d to %d", oldSize, newSize)
+
+			if oldSize < newSize {
+				wrong, _ := tree.RootAt(oldSize - 1)
+				assert.NotNil(t, VerifyConsistency(oldSize, newSize, wrong, newRoot, proof))
+				assert.NotNil(t, VerifyConsistency(oldSize, newSize, oldRoot, oldRoot, proof))
+				if len(proof) > 0 {
+					assert.NotNil(t, VerifyConsistency(oldSize, newSize, oldRoot, newRoot, proof[:len(proof)-1]))
+				}
+			}
+		}
+	}
+
+	_, err := tree.ConsistencyProof(0, 3)
+	assert.NotNil(t, err)
+	_, err = tree.ConsistencyProof(4, 3)
+	assert.NotNil(t, err)
+	_, err = tree.ConsistencyProof(3, 34)
+	assert.NotNil(t, err)
+}
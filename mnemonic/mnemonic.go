@@ -0,0 +1,198 @@
+//Package mnemonic implements BIP39-style mnemonic phrases with SM3: the
+// checksum of the entropy is the first ENT/32 bits of its SM3 hash, and the
+// seed is PBKDF2-HMAC-SM3 of the phrase with the salt "mnemonic" followed by
+// the passphrase, 2048 iterations and 64 bytes.
+//
+// A 32-byte SM2 private key is itself valid entropy, so FromPrivateKey and
+// ToPrivateKey back up a key of GenerateSM2Key as 24 words; a seed from
+// NewSeed is the input of hdkey.NewMaster.
+//
+// BIP39 normalizes phrases and passphrases to NFKD, which the words of the
+// English and Chinese lists already are; callers using other characters in
+// a passphrase must normalize it themselves.
+package mnemonic
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"hash"
+	"hash/crc32"
+	"math/big"
+	"strings"
+)
+
+//Wordlist is a list of 2048 words.
+type Wordlist struct {
+	name  string
+	words []string
+	index map[string]int
+}
+
+func newWordlist(name, words string, checksum uint32) *Wordlist {
+	if crc32.ChecksumIEEE([]byte(words)) != checksum {
+		panic("mnemonic: corrupted " + name + " wordlist")
+	}
+	w := &Wordlist{name: name, words: strings.Split(strings.TrimSpace(words), "\n")}
+	w.index = make(map[string]int, len(w.words))
+	for i, word := range w.words {
+		w.index[word] = i
+	}
+	return w
+}
+
+//Word returns the word of index i, in [0, 2047].
+func (w *Wordlist) Word(i int) string {
+	return w.words[i]
+}
+
+//ErrInvalidMnemonic is returned for a phrase with an unknown word, a wrong
+// number of words or a wrong checksum.
+var ErrInvalidMnemonic = errors.New("mnemonic: invalid mnemonic")
+
+//NewEntropy returns bits of random entropy, bits is 128, 160, 192, 224 or
+// 256.
+func NewEntropy(bits int) ([]byte, error) {
+	if err := checkEntropySize(bits / 8); err != nil || bits%8 != 0 {
+		return nil, errors.New("mnemonic: entropy must be 128 to 256 bits, a multiple of 32")
+	}
+	b := make([]byte, bits/8)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func checkEntropySize(n int) error {
+	if n < 16 || n > 32 || n%4 != 0 {
+		return fmt.Errorf("mnemonic: invalid entropy size %d", n)
+	}
+	return nil
+}
+
+func checksum(entropy []byte) byte {
+	h := gm.GetSM3Hasher()
+	h.Write(entropy)
+	return h.Sum(nil)[0]
+}
+
+//NewMnemonic returns the phrase of the entropy in the words of w, separated
+// by spaces.
+func NewMnemonic(entropy []byte, w *Wordlist) (string, error) {
+	if err := checkEntropySize(len(entropy)); err != nil {
+		return "", err
+	}
+	cs := uint(len(entropy) / 4)
+	// entropy || checksum, read 11 bits at a time
+	x := new(big.Int).SetBytes(entropy)
+	x.Lsh(x, cs)
+	x.Or(x, big.NewInt(int64(checksum(entropy)>>(8-cs))))
+
+	n := (len(entropy)*8 + int(cs)) / 11
+	words := make([]string, n)
+	mask := big.NewInt(2047)
+	for i := n - 1; i >= 0; i-- {
+		words[i] = w.words[new(big.Int).And(x, mask).Int64()]
+		x.Rsh(x, 11)
+	}
+	return strings.Join(words, " "), nil
+}
+
+//EntropyFromMnemonic returns the entropy of a phrase in the words of w,
+// after checking its checksum.
+func EntropyFromMnemonic(mnemonic string, w *Wordlist) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	n := len(words)
+	if n < 12 || n > 24 || n%3 != 0 {
+		return nil, ErrInvalidMnemonic
+	}
+	x := new(big.Int)
+	for _, word := range words {
+		i, ok := w.index[word]
+		if !ok {
+			return nil, ErrInvalidMnemonic
+		}
+		x.Lsh(x, 11)
+		x.Or(x, big.NewInt(int64(i)))
+	}
+	cs := uint(n / 3)
+	sum := byte(new(big.Int).And(x, big.NewInt(1<<cs-1)).Int64())
+	x.Rsh(x, cs)
+	entropy := x.FillBytes(make([]byte, int(cs)*4))
+	if checksum(entropy)>>(8-cs) != sum {
+		return nil, ErrInvalidMnemonic
+	}
+	return entropy, nil
+}
+
+//IsValid reports whether mnemonic is a valid phrase in the words of w.
+func IsValid(mnemonic string, w *Wordlist) bool {
+	_, err := EntropyFromMnemonic(mnemonic, w)
+	return err == nil
+}
+
+//NewSeed returns the 64-byte seed of a phrase and a passphrase. It does not
+// check the phrase, see IsValid.
+func NewSeed(mnemonic, passphrase string) []byte {
+	phrase := strings.Join(strings.Fields(mnemonic), " ")
+	return pbkdf2SM3([]byte(phrase), []byte("mnemonic"+passphrase), 2048, 64)
+}
+
+//FromPrivateKey returns the 24-word phrase of the private key.
+func FromPrivateKey(key *gm.SM2PrivateKey, w *Wordlist) (string, error) {
+	k, err := key.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return NewMnemonic(k, w)
+}
+
+//ToPrivateKey returns the private key of a phrase made by FromPrivateKey,
+// with its public key.
+func ToPrivateKey(mnemonic string, w *Wordlist) (*gm.SM2PrivateKey, error) {
+	k, err := EntropyFromMnemonic(mnemonic, w)
+	if err != nil {
+		return nil, err
+	}
+	if len(k) != 32 {
+		return nil, errors.New("mnemonic: a private key phrase has 24 words")
+	}
+	if !ec.ValidPrivate(new(big.Int).SetBytes(k)) {
+		return nil, errors.New("mnemonic: the phrase is not an SM2 private key")
+	}
+	key := new(gm.SM2PrivateKey)
+	if err := key.FromBytes(k, 0); err != nil {
+		return nil, err
+	}
+	key.PublicKey.Curve = gm.GetSm2Curve()
+	return key.CalculatePublicKey(), nil
+}
+
+// pbkdf2SM3 is PBKDF2 of RFC 8018 with HMAC-SM3.
+func pbkdf2SM3(password, salt []byte, iter, keyLen int) []byte {
+	prf := hmac.New(func() hash.Hash { return gm.GetSM3Hasher() }, password)
+	size := prf.Size()
+	var out []byte
+	u := make([]byte, 0, size)
+	t := make([]byte, size)
+	for block := uint32(1); len(out) < keyLen; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		prf.Write([]byte{byte(block >> 24), byte(block >> 16), byte(block >> 8), byte(block)})
+		u = prf.Sum(u[:0])
+		copy(t, u)
+		for i := 1; i < iter; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		out = append(out, t...)
+	}
+	return out[:keyLen]
+}
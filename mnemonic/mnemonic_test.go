@@ -0,0 +1,102 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// The vectors were computed independently with the SM3 checksum and
+// PBKDF2-HMAC-SM3; the entropies are taken from the BIP39 vectors.
+var vectors = []struct {
+	entropy    string
+	wordlist   *Wordlist
+	mnemonic   string
+	passphrase string
+	seed       string
+}{
+	{"00000000000000000000000000000000", English, "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon ability", "TREZOR", "a5d92f99b265d6abb16642efebba04a467411c96d7acbb5141514bb01089b39476a3feb5e81e35daab931389b7f3e9f75b425f1839108dfa493a6b7c561c8450"},
+	{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f", English, "legal winner thank year wave sausage worth useful legal winner thank youth", "TREZOR", "61e8be2e5e815ccd797f1ef6ad9a227e1382f067a20c1fae281beb9ef36513267fdd63e8e31be16f9453ea6f8cb7822eeb6d0e0a508727edb47175f90974942b"},
+	{"ffffffffffffffffffffffffffffffffffffffffffffffff", English, "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo word", "", "1137804ec20638f4375de637f27dac6b8f6a8392169ab5a7581d2756bef8b8d818c815a1da73531d37c6355ec0d4603b36a9fb438c518b5f2ed0621ae8902ad0"},
+	{"9e885d952ad362caeb4efe34a8e91bd2", ChineseSimplified, "蒙 台 脱 纪 构 硫 浆 霉 感 仅 鱼 汤", "", "d8972c909801a11d7e168aeb721bc279108d0dbb4f9fb26710e3c0109301e34bc1ed452deb9e79b42e2ebc19708a485bd814afe9362917be23975c62462c22ae"},
+	{"68a79eaca2324873eacc50cb9c6eca8cc68ea5d936f98787c60c7ebc74e6ce7c", English, "hamster diagram private dutch cause delay private meat slide toddler razor book happy fancy gospel tennis maple dilemma loan word shrug inflict delay march", "SM2", "9e3a31628a4f8912beba777b55976719af35cf85cc8f9cf661d0d0f8c9f398b58321d49700c6f28daa1e34513c15911bd6e4f047589e9235ef2d3ba290e1f059"},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		entropy, _ := hex.DecodeString(v.entropy)
+		m, err := NewMnemonic(entropy, v.wordlist)
+		assert.Nil(t, err)
+		assert.Equal(t, v.mnemonic, m)
+		got, err := EntropyFromMnemonic(m, v.wordlist)
+		assert.Nil(t, err)
+		assert.Equal(t, entropy, got)
+		assert.Equal(t, v.seed, hex.EncodeToString(NewSeed(m, v.passphrase)))
+	}
+}
+
+func TestInvalidMnemonic(t *testing.T) {
+	m := vectors[1].mnemonic
+	assert.True(t, IsValid(m, English))
+	assert.False(t, IsValid(m, ChineseSimplified))
+
+	words := strings.Fields(m)
+	words[3] = "abandon"
+	assert.False(t, IsValid(strings.Join(words, " "), English))
+	assert.False(t, IsValid(strings.Join(words[1:], " "), English))
+	words[3] = "notaword"
+	assert.False(t, IsValid(strings.Join(words, " "), English))
+
+	_, err := NewMnemonic(make([]byte, 15), English)
+	assert.NotNil(t, err)
+	_, err = NewEntropy(100)
+	assert.NotNil(t, err)
+}
+
+func TestRandomEntropy(t *testing.T) {
+	for _, bits := range []int{128, 160, 192, 224, 256} {
+		entropy, err := NewEntropy(bits)
+		assert.Nil(t, err)
+		for _, w := range []*Wordlist{English, ChineseSimplified} {
+			m, err := NewMnemonic(entropy, w)
+			assert.Nil(t, err)
+			assert.Len(t, strings.Fields(m), bits/32*3)
+			got, err := EntropyFromMnemonic(m, w)
+			assert.Nil(t, err)
+			assert.Equal(t, entropy, got)
+		}
+	}
+}
+
+func TestPrivateKey(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	m, err := FromPrivateKey(key, ChineseSimplified)
+	assert.Nil(t, err)
+	assert.Len(t, strings.Fields(m), 24)
+	restored, err := ToPrivateKey(m, ChineseSimplified)
+	assert.Nil(t, err)
+	assert.Equal(t, key.K, restored.K)
+	assert.Equal(t, key.PublicKey.X, restored.PublicKey.X)
+	assert.Equal(t, key.PublicKey.Y, restored.PublicKey.Y)
+
+	_, err = ToPrivateKey(vectors[0].mnemonic, English)
+	assert.NotNil(t, err)
+
+	// n-1, the private key of -G, cannot sign
+	nMinus1 := new(big.Int).Sub(gm.GetSm2Curve().Params().N, big.NewInt(1))
+	m, err = NewMnemonic(nMinus1.Bytes(), English)
+	assert.Nil(t, err)
+	_, err = ToPrivateKey(m, English)
+	assert.NotNil(t, err)
+}
+
+func TestPBKDF2(t *testing.T) {
+	// PBKDF2-HMAC-SM3 over two blocks with a truncated last block
+	assert.Equal(t,
+		"f2a075c888a5188892c4860f9b33ad24a7fd5c5685b6f7a6bc12f76e2cd732e26b04243840242693",
+		hex.EncodeToString(pbkdf2SM3([]byte("password"), []byte("salt"), 3, 40)))
+}
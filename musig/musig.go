@@ -0,0 +1,250 @@
+//Package musig implements MuSig2 multi-signatures over the SM2 group with
+// SM3 as the hash: n signers aggregate their public keys into a single key
+// and produce, in two rounds, a single Schnorr signature of constant size
+// under it.
+//
+// Key aggregation computes L = H(P_1..P_n), a_i = H(L, P_i) and
+// X = sum a_i * P_i. Each signer publishes two nonces R_i1, R_i2 (the first
+// round, which can be done before the message is known); with the
+// aggregated nonces R_1, R_2, b = H(R_1, R_2, X, m), R = R_1 + b * R_2 and
+// c = H(R, X, m), each signer sends s_i = r_i1 + b * r_i2 + c * a_i * x_i
+// and s = sum s_i. A signature (R, s) is valid if s * G = R + c * X.
+package musig
+
+import (
+	"crypto/rand"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"io"
+	"math/big"
+)
+
+const (
+	//PublicNonceSize is the size of the public nonce of a signer.
+	PublicNonceSize = 130
+	//PartialSignatureSize is the size of a partial signature.
+	PartialSignatureSize = 32
+	//SignatureSize is the size of a signature, R || s.
+	SignatureSize = 97
+)
+
+//AggregateKey is the aggregation of the public keys of the signers.
+type AggregateKey struct {
+	keys []ec.Point
+	coef []*big.Int
+	x    ec.Point
+}
+
+//Aggregate aggregates the public keys of the signers. The order of keys
+// matters: every signer must use the same order.
+func Aggregate(keys []*gm.SM2PublicKey) (*AggregateKey, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("musig: no public keys")
+	}
+	agg := &AggregateKey{keys: make([]ec.Point, len(keys)), coef: make([]*big.Int, len(keys))}
+	var list []byte
+	for i, pub := range keys {
+		p, err := ec.FromPublicKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		agg.keys[i] = p
+		list = append(list, p.Marshal()...)
+	}
+	l := ec.HashToScalar("MuSig/keyagg list", list)
+	lb := ec.ScalarBytes(l)
+	for i, p := range agg.keys {
+		agg.coef[i] = ec.HashToScalar("MuSig/keyagg coef", lb, p.Marshal())
+		agg.x = ec.Add(agg.x, ec.Mul(p, agg.coef[i]))
+	}
+	if agg.x.IsInfinity() {
+		return nil, errors.New("musig: the aggregate key is the point at infinity")
+	}
+	return agg, nil
+}
+
+//PublicKey returns the aggregate public key.
+func (k *AggregateKey) PublicKey() *gm.SM2PublicKey {
+	return k.x.PublicKey()
+}
+
+// coefficient returns the aggregation coefficient of pub, or nil if it is
+// not one of the keys.
+func (k *AggregateKey) coefficient(pub ec.Point) *big.Int {
+	for i, p := range k.keys {
+		if p.Equal(pub) {
+			return k.coef[i]
+		}
+	}
+	return nil
+}
+
+//Verify checks a signature of msg under the aggregate key, so the first
+// parameter will be ignored.
+func (k *AggregateKey) Verify(_, signature, msg []byte) (bool, error) {
+	if len(signature) != SignatureSize {
+		return false, errors.New("musig: wrong signature size")
+	}
+	r, err := ec.Unmarshal(signature[:65])
+	if err != nil {
+		return false, err
+	}
+	s, err := ec.ParseScalar(signature[65:])
+	if err != nil {
+		return false, err
+	}
+	c := challenge(r, k.x, msg)
+	return ec.BaseMul(s).Equal(ec.Add(r, ec.Mul(k.x, c))), nil
+}
+
+func challenge(r, x ec.Point, msg []byte) *big.Int {
+	return ec.HashToScalar("MuSig/challenge", r.Marshal(), x.Marshal(), msg)
+}
+
+//SecretNonce is the secret nonce pair of a signer, it signs at most once.
+type SecretNonce struct {
+	r1, r2 *big.Int
+	pub    ec.Point
+}
+
+//NewNonce draws a nonce pair for key and returns it with the public nonce to
+// send to the other signers. A nil reader uses crypto/rand.
+func NewNonce(key *gm.SM2PrivateKey, reader io.Reader) (*SecretNonce, []byte, error) {
+	if reader == nil {
+		reader = rand.Reader
+	}
+	x, err := ec.PrivateScalar(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	r1, err := ec.RandScalar(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	r2, err := ec.RandScalar(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	pub := append(ec.BaseMul(r1).Marshal(), ec.BaseMul(r2).Marshal()...)
+	return &SecretNonce{r1: r1, r2: r2, pub: ec.BaseMul(x)}, pub, nil
+}
+
+func parseNonce(b []byte) (ec.Point, ec.Point, error) {
+	if len(b) != PublicNonceSize {
+		return ec.Point{}, ec.Point{}, errors.New("musig: wrong public nonce size")
+	}
+	r1, err := ec.Unmarshal(b[:65])
+	if err != nil {
+		return ec.Point{}, ec.Point{}, err
+	}
+	r2, err := ec.Unmarshal(b[65:])
+	if err != nil {
+		return ec.Point{}, ec.Point{}, err
+	}
+	return r1, r2, nil
+}
+
+//AggregateNonces sums the public nonces of all signers.
+func AggregateNonces(nonces [][]byte) ([]byte, error) {
+	var r1, r2 ec.Point
+	for _, b := range nonces {
+		p1, p2, err := parseNonce(b)
+		if err != nil {
+			return nil, err
+		}
+		r1, r2 = ec.Add(r1, p1), ec.Add(r2, p2)
+	}
+	if r1.IsInfinity() || r2.IsInfinity() {
+		return nil, errors.New("musig: degenerate aggregate nonce")
+	}
+	return append(r1.Marshal(), r2.Marshal()...), nil
+}
+
+//Session is the signing of one message with an aggregate key and the
+// aggregate nonce of the signers.
+type Session struct {
+	key *AggregateKey
+	b   *big.Int
+	c   *big.Int
+	r   ec.Point
+}
+
+//NewSession returns the signing session of msg.
+func NewSession(key *AggregateKey, aggNonce, msg []byte) (*Session, error) {
+	r1, r2, err := parseNonce(aggNonce)
+	if err != nil {
+		return nil, err
+	}
+	b := ec.HashToScalar("MuSig/noncecoef", aggNonce, key.x.Marshal(), msg)
+	r := ec.Add(r1, ec.Mul(r2, b))
+	if r.IsInfinity() {
+		return nil, errors.New("musig: degenerate nonce, sign again")
+	}
+	return &Session{key: key, b: b, c: challenge(r, key.x, msg), r: r}, nil
+}
+
+//Sign returns the partial signature of key with its secret nonce, which is
+// erased so that it cannot be used again.
+func (s *Session) Sign(key *gm.SM2PrivateKey, nonce *SecretNonce) ([]byte, error) {
+	if nonce.r1 == nil {
+		return nil, errors.New("musig: secret nonce already used")
+	}
+	x, err := ec.PrivateScalar(key)
+	if err != nil {
+		return nil, err
+	}
+	if !ec.BaseMul(x).Equal(nonce.pub) {
+		return nil, errors.New("musig: the nonce was drawn for another key")
+	}
+	a := s.key.coefficient(nonce.pub)
+	if a == nil {
+		return nil, errors.New("musig: the key is not one of the aggregated keys")
+	}
+	r1, r2 := nonce.r1, nonce.r2
+	nonce.r1, nonce.r2 = nil, nil
+	defer r1.SetInt64(0)
+	defer r2.SetInt64(0)
+
+	si := new(big.Int).Mul(s.c, a)
+	si.Mul(si, x)
+	si.Add(si, new(big.Int).Mul(s.b, r2))
+	si.Add(si, r1)
+	return ec.ScalarBytes(si.Mod(si, ec.N)), nil
+}
+
+//VerifyPartial checks the partial signature of the signer pub with public
+// nonce pubNonce, it identifies the signer of an invalid signature.
+func (s *Session) VerifyPartial(pub *gm.SM2PublicKey, pubNonce, partial []byte) bool {
+	p, err := ec.FromPublicKey(pub)
+	if err != nil {
+		return false
+	}
+	a := s.key.coefficient(p)
+	if a == nil {
+		return false
+	}
+	r1, r2, err := parseNonce(pubNonce)
+	if err != nil {
+		return false
+	}
+	si, err := ec.ParseScalar(partial)
+	if err != nil {
+		return false
+	}
+	want := ec.Add(ec.Add(r1, ec.Mul(r2, s.b)), ec.Mul(p, new(big.Int).Mul(s.c, a)))
+	return ec.BaseMul(si).Equal(want)
+}
+
+//Combine sums the partial signatures of all signers into the signature.
+func (s *Session) Combine(partials [][]byte) ([]byte, error) {
+	sum := new(big.Int)
+	for _, b := range partials {
+		si, err := ec.ParseScalar(b)
+		if err != nil {
+			return nil, err
+		}
+		sum.Add(sum, si)
+	}
+	return append(s.r.Marshal(), ec.ScalarBytes(sum.Mod(sum, ec.N))...), nil
+}
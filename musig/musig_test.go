@@ -0,0 +1,116 @@
+package musig
+
+import (
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func signers(t *testing.T, n int) ([]*gm.SM2PrivateKey, []*gm.SM2PublicKey) {
+	var keys []*gm.SM2PrivateKey
+	var pubs []*gm.SM2PublicKey
+	for i := 0; i < n; i++ {
+		key, err := gm.GenerateSM2Key()
+		assert.Nil(t, err)
+		keys = append(keys, key)
+		pubs = append(pubs, &key.PublicKey)
+	}
+	return keys, pubs
+}
+
+func TestMultiSignature(t *testing.T) {
+	for _, n := range []int{1, 2, 5} {
+		keys, pubs := signers(t, n)
+		agg, err := Aggregate(pubs)
+		assert.Nil(t, err)
+		msg := []byte("consensus certificate")
+
+		var secrets []*SecretNonce
+		var nonces [][]byte
+		for _, key := range keys {
+			sn, pn, err := NewNonce(key, nil)
+			assert.Nil(t, err)
+			assert.Len(t, pn, PublicNonceSize)
+			secrets = append(secrets, sn)
+			nonces = append(nonces, pn)
+		}
+		aggNonce, err := AggregateNonces(nonces)
+		assert.Nil(t, err)
+
+		var partials [][]byte
+		for i, key := range keys {
+			session, err := NewSession(agg, aggNonce, msg)
+			assert.Nil(t, err)
+			partial, err := session.Sign(key, secrets[i])
+			assert.Nil(t, err)
+			assert.True(t, session.VerifyPartial(pubs[i], nonces[i], partial))
+			partials = append(partials, partial)
+
+			_, err = session.Sign(key, secrets[i])
+			assert.NotNil(t, err)
+		}
+		session, _ := NewSession(agg, aggNonce, msg)
+		sig, err := session.Combine(partials)
+		assert.Nil(t, err)
+		assert.Len(t, sig, SignatureSize)
+
+		ok, err := agg.Verify(nil, sig, msg)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		ok, _ = agg.Verify(nil, sig, []byte("other"))
+		assert.False(t, ok)
+
+		// the signature is under the aggregate key, independent of the signers
+		again, _ := Aggregate(pubs)
+		assert.Equal(t, agg.PublicKey(), again.PublicKey())
+		ok, _ = again.Verify(nil, sig, msg)
+		assert.True(t, ok)
+	}
+}
+
+func TestKeyAggregationOrder(t *testing.T) {
+	_, pubs := signers(t, 3)
+	a, _ := Aggregate(pubs)
+	b, _ := Aggregate([]*gm.SM2PublicKey{pubs[1], pubs[0], pubs[2]})
+	assert.NotEqual(t, a.PublicKey(), b.PublicKey())
+}
+
+func TestBadPartialSignature(t *testing.T) {
+	keys, pubs := signers(t, 3)
+	agg, _ := Aggregate(pubs)
+	msg := []byte("msg")
+	var secrets []*SecretNonce
+	var nonces [][]byte
+	for _, key := range keys {
+		sn, pn, _ := NewNonce(key, nil)
+		secrets = append(secrets, sn)
+		nonces = append(nonces, pn)
+	}
+	aggNonce, _ := AggregateNonces(nonces)
+	session, err := NewSession(agg, aggNonce, msg)
+	assert.Nil(t, err)
+
+	var partials [][]byte
+	for i, key := range keys {
+		partial, err := session.Sign(key, secrets[i])
+		assert.Nil(t, err)
+		partials = append(partials, partial)
+	}
+	partials[1][31] ^= 1
+	assert.True(t, session.VerifyPartial(pubs[0], nonces[0], partials[0]))
+	assert.False(t, session.VerifyPartial(pubs[1], nonces[1], partials[1]))
+	assert.False(t, session.VerifyPartial(pubs[2], nonces[0], partials[2]))
+	sig, err := session.Combine(partials)
+	assert.Nil(t, err)
+	ok, _ := agg.Verify(nil, sig, msg)
+	assert.False(t, ok)
+
+	// a nonce drawn for another key or a key outside the aggregate is refused
+	outsider, _ := gm.GenerateSM2Key()
+	sn, _, _ := NewNonce(outsider, nil)
+	_, err = session.Sign(outsider, sn)
+	assert.NotNil(t, err)
+	sn, _, _ = NewNonce(keys[0], nil)
+	_, err = session.Sign(keys[1], sn)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,162 @@
+package gm
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// crossEnv lists the targets of the cross tests, separated by spaces, as
+// goos/goarch or goos/goarch/tags. The CI sets it, one target per job.
+const crossEnv = "GMCRYPTO_CROSS"
+
+type crossTarget struct{ goos, goarch, tags string }
+
+func (c crossTarget) String() string {
+	s := c.goos + "/" + c.goarch
+	if c.tags != "" {
+		s += "/" + c.tags
+	}
+	return s
+}
+
+func (c crossTarget) env() []string {
+	return append(childEnv(), "GOOS="+c.goos, "GOARCH="+c.goarch, "CGO_ENABLED=0")
+}
+
+// childEnv returns the environment without crossEnv, so that the test
+// binaries run by TestCrossRun do not start the cross tests again.
+func childEnv() []string {
+	var env []string
+	for _, kv := range os.Environ() {
+		if !strings.HasPrefix(kv, crossEnv+"=") {
+			env = append(env, kv)
+		}
+	}
+	return env
+}
+
+// crossTargets returns the targets of crossEnv and the go command, it skips
+// the test when there is none.
+func crossTargets(t *testing.T) ([]crossTarget, string) {
+	list := strings.Fields(os.Getenv(crossEnv))
+	if len(list) == 0 {
+		t.Skipf("set %s to goos/goarch[/tags] targets to cross test", crossEnv)
+	}
+	var targets []crossTarget
+	for _, s := range list {
+		parts := strings.SplitN(s, "/", 3)
+		if len(parts) < 2 {
+			t.Fatalf("%s: invalid target %q", crossEnv, s)
+		}
+		target := crossTarget{goos: parts[0], goarch: parts[1]}
+		if len(parts) == 3 {
+			target.tags = parts[2]
+		}
+		targets = append(targets, target)
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	return targets, gobin
+}
+
+// TestCrossCompile builds and vets the module, tests included, for the
+// targets of GMCRYPTO_CROSS.
+func TestCrossCompile(t *testing.T) {
+	targets, gobin := crossTargets(t)
+	for _, target := range targets {
+		for _, args := range [][]string{{"build"}, {"vet"}} {
+			args = append(args, "-tags="+target.tags, "./...")
+			cmd := exec.Command(gobin, args...)
+			cmd.Env = target.env()
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("go %s for %s: %v\n%s", args[0], target, err, out)
+			}
+		}
+	}
+}
+
+// TestCrossRun runs the tests of every package, in short mode, for the
+// targets of GMCRYPTO_CROSS this host can execute: 386 on amd64 and the host
+// GOARCH natively, js/wasm with go_js_wasm_exec and node, and the other
+// GOARCHes with qemu user emulation. Targets without a runner are skipped.
+func TestCrossRun(t *testing.T) {
+	targets, gobin := crossTargets(t)
+	out, err := exec.Command(gobin, "list", "-f", "{{if or .TestGoFiles .XTestGoFiles}}{{.Dir}}{{end}}", "./...").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dirs := strings.Fields(string(out))
+	goroot, err := exec.Command(gobin, "env", "GOROOT").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, target := range targets {
+		target := target
+		t.Run(target.String(), func(t *testing.T) {
+			runner, ok := crossRunner(strings.TrimSpace(string(goroot)), target.goos, target.goarch)
+			if !ok {
+				t.Skipf("no way to run %s binaries on this host", target)
+			}
+			bin := t.TempDir()
+			for i, dir := range dirs {
+				test := filepath.Join(bin, strconv.Itoa(i)+".test")
+				cmd := exec.Command(gobin, "test", "-c", "-tags="+target.tags, "-o", test, ".")
+				cmd.Dir = dir
+				cmd.Env = target.env()
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Errorf("go test -c %s: %v\n%s", dir, err, out)
+					continue
+				}
+				args := append(runner, test, "-test.short")
+				cmd = exec.Command(args[0], args[1:]...)
+				cmd.Dir = dir
+				cmd.Env = childEnv()
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Errorf("%s: %v\n%s", dir, err, out)
+				}
+			}
+			t.Logf("ran the tests of %d packages", len(dirs))
+		})
+	}
+}
+
+// crossRunner returns the command that runs goos/goarch binaries on this
+// host, empty when they run natively.
+func crossRunner(goroot, goos, goarch string) ([]string, bool) {
+	if goos == "js" && goarch == "wasm" {
+		if _, err := exec.LookPath("node"); err != nil {
+			return nil, false
+		}
+		for _, dir := range []string{"lib", "misc"} {
+			exe := filepath.Join(goroot, dir, "wasm", "go_js_wasm_exec")
+			if _, err := os.Stat(exe); err == nil {
+				return []string{exe}, true
+			}
+		}
+		return nil, false
+	}
+	if goos != runtime.GOOS {
+		return nil, false
+	}
+	if goarch == runtime.GOARCH || goarch == "386" && runtime.GOARCH == "amd64" {
+		return []string{}, true
+	}
+	arch := goarch
+	if goarch == "arm64" {
+		arch = "aarch64"
+	}
+	for _, name := range []string{"qemu-" + arch, "qemu-" + arch + "-static"} {
+		if exe, err := exec.LookPath(name); err == nil {
+			return []string{exe}, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,145 @@
+//Package ring implements AOS ring signatures over the SM2 group with SM3 as
+// the hash: a signature shows that the holder of one of the private keys of
+// a ring signed the message, without revealing which one.
+//
+// For a ring P_0..P_{n-1} and the signer at index j with key x, the signer
+// picks alpha and starts the chain with c_{j+1} = H(L, m, alpha * G), fills
+// c_{i+1} = H(L, m, s_i * G + c_i * P_i) with random s_i for the other
+// members and closes it with s_j = alpha - c_j * x. The signature is
+// (c_0, s_0..s_{n-1}), checked by recomputing the chain around the ring.
+package ring
+
+import (
+	"crypto/rand"
+	"encoding/asn1"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"io"
+	"math/big"
+)
+
+const hashTag = "SM2-RING-AOS"
+
+//Ring is an ordered set of public keys, the ring of a signature.
+type Ring []*gm.SM2PublicKey
+
+type signature struct {
+	C *big.Int
+	S []*big.Int
+}
+
+func (r Ring) points() ([]ec.Point, []byte, error) {
+	if len(r) < 2 {
+		return nil, nil, errors.New("ring: a ring needs at least 2 members")
+	}
+	points := make([]ec.Point, len(r))
+	var encoded []byte
+	for i, pub := range r {
+		p, err := ec.FromPublicKey(pub)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, q := range points[:i] {
+			if q.Equal(p) {
+				return nil, nil, errors.New("ring: duplicate ring member")
+			}
+		}
+		points[i] = p
+		encoded = append(encoded, p.Marshal()...)
+	}
+	return points, encoded, nil
+}
+
+func challenge(ring, msg []byte, p ec.Point) *big.Int {
+	return ec.HashToScalar(hashTag, ring, msg, p.Marshal())
+}
+
+//Signer signs for a ring with the private key of one of its members.
+type Signer struct {
+	Key  *gm.SM2PrivateKey
+	Ring Ring
+}
+
+//Sign signs msg for the ring, so the first parameter will be ignored. A nil
+// reader uses crypto/rand.
+func (s *Signer) Sign(_, msg []byte, reader io.Reader) ([]byte, error) {
+	if reader == nil {
+		reader = rand.Reader
+	}
+	points, encoded, err := s.Ring.points()
+	if err != nil {
+		return nil, err
+	}
+	x, err := ec.PrivateScalar(s.Key)
+	if err != nil {
+		return nil, err
+	}
+	pub := ec.BaseMul(x)
+	j := -1
+	for i, p := range points {
+		if p.Equal(pub) {
+			j = i
+		}
+	}
+	if j < 0 {
+		return nil, errors.New("ring: the key is not a member of the ring")
+	}
+
+	n := len(points)
+	c := make([]*big.Int, n)
+	sig := make([]*big.Int, n)
+	alpha, err := ec.RandScalar(reader)
+	if err != nil {
+		return nil, err
+	}
+	c[(j+1)%n] = challenge(encoded, msg, ec.BaseMul(alpha))
+	for k := 1; k < n; k++ {
+		i := (j + k) % n
+		if sig[i], err = ec.RandScalar(reader); err != nil {
+			return nil, err
+		}
+		p := ec.Add(ec.BaseMul(sig[i]), ec.Mul(points[i], c[i]))
+		c[(i+1)%n] = challenge(encoded, msg, p)
+	}
+	sj := new(big.Int).Mul(c[j], x)
+	sj.Sub(alpha, sj)
+	sig[j] = sj.Mod(sj, ec.N)
+	alpha.SetInt64(0)
+	return asn1.Marshal(signature{C: c[0], S: sig})
+}
+
+//Verify checks a ring signature of msg, so the first parameter will be
+// ignored.
+func (r Ring) Verify(_, sig, msg []byte) (bool, error) {
+	points, encoded, err := r.points()
+	if err != nil {
+		return false, err
+	}
+	var v signature
+	rest, err := asn1.Unmarshal(sig, &v)
+	if err != nil {
+		return false, errors.New("ring: malformed signature: " + err.Error())
+	}
+	if len(rest) != 0 {
+		return false, errors.New("ring: trailing data after signature")
+	}
+	if len(v.S) != len(points) {
+		return false, errors.New("ring: signature does not match the ring size")
+	}
+	if !inRange(v.C) {
+		return false, nil
+	}
+	c := v.C
+	for i, p := range points {
+		if !inRange(v.S[i]) {
+			return false, nil
+		}
+		c = challenge(encoded, msg, ec.Add(ec.BaseMul(v.S[i]), ec.Mul(p, c)))
+	}
+	return c.Cmp(v.C) == 0, nil
+}
+
+func inRange(k *big.Int) bool {
+	return k.Sign() >= 0 && k.Cmp(ec.N) < 0
+}
@@ -0,0 +1,66 @@
+package ring
+
+import (
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newRing(t *testing.T, n int) ([]*gm.SM2PrivateKey, Ring) {
+	var keys []*gm.SM2PrivateKey
+	var ring Ring
+	for i := 0; i < n; i++ {
+		key, err := gm.GenerateSM2Key()
+		assert.Nil(t, err)
+		keys = append(keys, key)
+		ring = append(ring, &key.PublicKey)
+	}
+	return keys, ring
+}
+
+func TestRingSignature(t *testing.T) {
+	keys, ring := newRing(t, 5)
+	msg := []byte("one of these validators signed")
+	for _, key := range keys {
+		sig, err := (&Signer{Key: key, Ring: ring}).Sign(nil, msg, nil)
+		assert.Nil(t, err)
+		ok, err := ring.Verify(nil, sig, msg)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+
+		ok, err = ring.Verify(nil, sig, []byte("another message"))
+		assert.Nil(t, err)
+		assert.False(t, ok)
+		ok, _ = ring[1:].Verify(nil, sig, msg)
+		assert.False(t, ok)
+		reordered := append(Ring{ring[1], ring[0]}, ring[2:]...)
+		ok, _ = reordered.Verify(nil, sig, msg)
+		assert.False(t, ok)
+	}
+}
+
+func TestRingSignatureTampered(t *testing.T) {
+	keys, ring := newRing(t, 3)
+	msg := []byte("msg")
+	sig, err := (&Signer{Key: keys[2], Ring: ring}).Sign(nil, msg, nil)
+	assert.Nil(t, err)
+	for i := range sig {
+		bad := append([]byte(nil), sig...)
+		bad[i] ^= 0x10
+		ok, _ := ring.Verify(nil, bad, msg)
+		assert.False(t, ok, "byte %d", i)
+	}
+}
+
+func TestRingSignerNotMember(t *testing.T) {
+	_, ring := newRing(t, 3)
+	outsider, _ := gm.GenerateSM2Key()
+	_, err := (&Signer{Key: outsider, Ring: ring}).Sign(nil, []byte("msg"), nil)
+	assert.NotNil(t, err)
+
+	keys, _ := newRing(t, 1)
+	_, err = (&Signer{Key: keys[0], Ring: Ring{&keys[0].PublicKey}}).Sign(nil, []byte("msg"), nil)
+	assert.NotNil(t, err)
+	_, err = (&Signer{Key: keys[0], Ring: Ring{&keys[0].PublicKey, &keys[0].PublicKey}}).Sign(nil, []byte("msg"), nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,185 @@
+//Package sdf is the device interface of GM/T 0018, the application interface
+// of cipher machines, with SoftDevice, an implementation in process memory.
+//
+// Services written against Device and Session keep their keys inside the
+// device: private keys are designated by an index, session keys by a
+// KeyHandle, and neither leaves the device in clear. A driver of a real cipher
+// machine implements the same interfaces, usually by calling its SDF library,
+// and returns the GM/T 0018 error codes as an Error.
+//
+// Signatures are DER encoded and SM2 ciphertexts are in the format of
+// gm.Encrypt, as everywhere in this module; a driver converts them from and
+// to the ECCSignature and ECCCipher structures of its library.
+package sdf
+
+import (
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+)
+
+//Algorithm is an algorithm identifier of GM/T 0006, also used by the skf
+// package.
+type Algorithm uint32
+
+// Algorithm identifiers used by the interface.
+const (
+	SM4ECB     Algorithm = 0x00000401 // SGD_SM4_ECB
+	SM4CBC     Algorithm = 0x00000402 // SGD_SM4_CBC
+	SM4MAC     Algorithm = 0x00000410 // SGD_SM4_MAC
+	SM3        Algorithm = 0x00000001 // SGD_SM3
+	SM2Sign    Algorithm = 0x00020100 // SGD_SM2_1
+	SM2Encrypt Algorithm = 0x00020400 // SGD_SM2_3
+)
+
+//KeyHandle designates a session key inside a device. It is valid in the
+// session which created it, until DestroyKey or the end of the session.
+type KeyHandle uint64
+
+//DeviceInfo describes a device, as the DEVICEINFO structure.
+type DeviceInfo struct {
+	IssuerName      string
+	DeviceName      string
+	DeviceSerial    string
+	DeviceVersion   uint32
+	StandardVersion uint32
+	AsymAlgAbility  [2]uint32
+	SymAlgAbility   uint32
+	HashAlgAbility  uint32
+	BufferSize      uint32
+}
+
+//Device is an opened cipher machine, as SDF_OpenDevice returns.
+type Device interface {
+	//OpenSession opens a session, SDF_OpenSession.
+	OpenSession() (Session, error)
+	//Info returns the description of the device, SDF_GetDeviceInfo.
+	Info() (*DeviceInfo, error)
+	//Close closes the device and its sessions, SDF_CloseDevice.
+	Close() error
+}
+
+//Session is a session of a device, as SDF_OpenSession returns. The index of
+// an internal key pair starts at 1; each index holds a signing key pair and an
+// encryption key pair.
+type Session interface {
+	//GenerateRandom returns n random bytes, SDF_GenerateRandom.
+	GenerateRandom(n int) ([]byte, error)
+
+	//GetPrivateKeyAccessRight grants the session the use of the private keys
+	// of index, SDF_GetPrivateKeyAccessRight.
+	GetPrivateKeyAccessRight(index uint, password []byte) error
+	//ReleasePrivateKeyAccessRight revokes the grant of
+	// GetPrivateKeyAccessRight, SDF_ReleasePrivateKeyAccessRight.
+	ReleasePrivateKeyAccessRight(index uint) error
+
+	//ExportSignPublicKeyECC returns the signing public key of index,
+	// SDF_ExportSignPublicKey_ECC.
+	ExportSignPublicKeyECC(index uint) (*gm.SM2PublicKey, error)
+	//ExportEncPublicKeyECC returns the encryption public key of index,
+	// SDF_ExportEncPublicKey_ECC.
+	ExportEncPublicKeyECC(index uint) (*gm.SM2PublicKey, error)
+
+	//GenerateKeyWithIPKECC generates a session key of bits bits and returns it
+	// encrypted with the encryption public key of index, with its handle,
+	// SDF_GenerateKeyWithIPK_ECC.
+	GenerateKeyWithIPKECC(index uint, bits int) ([]byte, KeyHandle, error)
+	//GenerateKeyWithEPKECC generates a session key of bits bits and returns
+	// it encrypted with pub by the algorithm alg, with its handle,
+	// SDF_GenerateKeyWithEPK_ECC.
+	GenerateKeyWithEPKECC(bits int, alg Algorithm, pub *gm.SM2PublicKey) ([]byte, KeyHandle, error)
+	//ImportKeyWithISKECC imports a session key encrypted with the encryption
+	// public key of index, SDF_ImportKeyWithISK_ECC. The session needs the
+	// access right of index.
+	ImportKeyWithISKECC(index uint, cipher []byte) (KeyHandle, error)
+	//DestroyKey destroys a session key, SDF_DestroyKey.
+	DestroyKey(h KeyHandle) error
+
+	//InternalSignECC signs a digest with the signing private key of index,
+	// SDF_InternalSign_ECC. The session needs the access right of index.
+	InternalSignECC(index uint, digest []byte) ([]byte, error)
+	//InternalVerifyECC verifies a signature with the signing public key of
+	// index, SDF_InternalVerify_ECC.
+	InternalVerifyECC(index uint, digest, signature []byte) (bool, error)
+	//ExternalVerifyECC verifies a signature with pub, SDF_ExternalVerify_ECC.
+	ExternalVerifyECC(alg Algorithm, pub *gm.SM2PublicKey, digest, signature []byte) (bool, error)
+	//ExternalEncryptECC encrypts data with pub, SDF_ExternalEncrypt_ECC.
+	ExternalEncryptECC(alg Algorithm, pub *gm.SM2PublicKey, data []byte) ([]byte, error)
+
+	//Encrypt encrypts data, a multiple of the block size, with a session key,
+	// SDF_Encrypt. In CBC mode iv is updated to the last ciphertext block, so
+	// that a message can be encrypted in several calls.
+	Encrypt(h KeyHandle, alg Algorithm, iv, data []byte) ([]byte, error)
+	//Decrypt decrypts data with a session key and updates iv as Encrypt,
+	// SDF_Decrypt.
+	Decrypt(h KeyHandle, alg Algorithm, iv, data []byte) ([]byte, error)
+	//CalculateMAC returns the CBC-MAC of data, a multiple of the block size,
+	// with a session key, SDF_CalculateMAC.
+	CalculateMAC(h KeyHandle, alg Algorithm, iv, data []byte) ([]byte, error)
+
+	//HashInit starts an SM3 hash, SDF_HashInit. With a public key, the hash
+	// starts with Z of the public key and id, as gm.HashBeforeSM2WithID.
+	HashInit(alg Algorithm, pub *gm.SM2PublicKey, id []byte) error
+	//HashUpdate hashes data, SDF_HashUpdate.
+	HashUpdate(data []byte) error
+	//HashFinal returns the hash, SDF_HashFinal.
+	HashFinal() ([]byte, error)
+
+	//Close closes the session and destroys its session keys,
+	// SDF_CloseSession.
+	Close() error
+}
+
+//Error is an error code of GM/T 0018.
+type Error uint32
+
+// Error codes of GM/T 0018.
+const (
+	ErrUnknown           Error = 0x01000001 // SDR_UNKNOWERR
+	ErrNotSupported      Error = 0x01000002 // SDR_NOTSUPPORT
+	ErrOpenDevice        Error = 0x01000005 // SDR_OPENDEVICE
+	ErrOpenSession       Error = 0x01000006 // SDR_OPENSESSION
+	ErrPermissionDenied  Error = 0x01000007 // SDR_PARDENY
+	ErrKeyNotExist       Error = 0x01000008 // SDR_KEYNOTEXIST
+	ErrAlgNotSupported   Error = 0x01000009 // SDR_ALGNOTSUPPORT
+	ErrPublicKeyOp       Error = 0x0100000B // SDR_PKOPERR
+	ErrPrivateKeyOp      Error = 0x0100000C // SDR_SKOPERR
+	ErrSign              Error = 0x0100000D // SDR_SIGNERR
+	ErrSymmetricOp       Error = 0x0100000F // SDR_SYMOPERR
+	ErrStep              Error = 0x01000010 // SDR_STEPERR
+	ErrKeyType           Error = 0x01000014 // SDR_KEYTYPEERR
+	ErrKey               Error = 0x01000015 // SDR_KEYERR
+	ErrEncryptedData     Error = 0x01000016 // SDR_ENCDATAERR
+	ErrRandom            Error = 0x01000017 // SDR_RANDERR
+	ErrPrivateKeyRight   Error = 0x01000018 // SDR_PRKRERR
+	ErrInvalidInput      Error = 0x0100001D // SDR_INARGERR
+	ErrInvalidOutput     Error = 0x0100001E // SDR_OUTARGERR
+)
+
+var errorNames = map[Error]string{
+	ErrUnknown:           "unknown error",
+	ErrNotSupported:      "not supported",
+	ErrOpenDevice:        "device not opened",
+	ErrOpenSession:       "session not opened",
+	ErrPermissionDenied:  "permission denied",
+	ErrKeyNotExist:       "key does not exist",
+	ErrAlgNotSupported:   "algorithm not supported",
+	ErrPublicKeyOp:       "public key operation failed",
+	ErrPrivateKeyOp:      "private key operation failed",
+	ErrSign:              "signing failed",
+	ErrSymmetricOp:       "symmetric operation failed",
+	ErrStep:              "operation out of sequence",
+	ErrKeyType:           "wrong key type",
+	ErrKey:               "wrong key",
+	ErrEncryptedData:     "invalid encrypted data",
+	ErrRandom:            "random number generation failed",
+	ErrPrivateKeyRight:   "no access right to the private key",
+	ErrInvalidInput:      "invalid input",
+	ErrInvalidOutput:     "invalid output",
+}
+
+func (e Error) Error() string {
+	if name, ok := errorNames[e]; ok {
+		return "sdf: " + name
+	}
+	return fmt.Sprintf("sdf: error 0x%08x", uint32(e))
+}
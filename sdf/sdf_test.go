@@ -0,0 +1,187 @@
+package sdf
+
+import (
+	"bytes"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var password = []byte("11111111")
+
+func openSession(t *testing.T) (*SoftDevice, Session) {
+	d := NewSoftDevice()
+	assert.Nil(t, d.GenerateKeyPair(1, password))
+	var dev Device = d
+	s, err := dev.OpenSession()
+	assert.Nil(t, err)
+	return d, s
+}
+
+func TestInternalSign(t *testing.T) {
+	d, s := openSession(t)
+	defer d.Close()
+	pub, err := s.ExportSignPublicKeyECC(1)
+	assert.Nil(t, err)
+	digest := gm.HashBeforeSM2(pub, []byte("msg"))
+
+	_, err = s.InternalSignECC(1, digest)
+	assert.Equal(t, ErrPermissionDenied, err)
+	assert.Equal(t, ErrPrivateKeyRight, s.GetPrivateKeyAccessRight(1, []byte("wrong")))
+	assert.Equal(t, ErrKeyNotExist, s.GetPrivateKeyAccessRight(2, password))
+	assert.Nil(t, s.GetPrivateKeyAccessRight(1, password))
+
+	sig, err := s.InternalSignECC(1, digest)
+	assert.Nil(t, err)
+	ok, err := pub.Verify(nil, sig, digest)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = s.InternalVerifyECC(1, digest, sig)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = s.ExternalVerifyECC(SM2Sign, pub, digest, sig)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	sig[len(sig)-1] ^= 1
+	ok, err = s.ExternalVerifyECC(SM2Sign, pub, digest, sig)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	ok, _ = s.ExternalVerifyECC(SM2Sign, pub, digest, []byte{1, 2, 3})
+	assert.False(t, ok)
+
+	// the access right belongs to the session
+	other, _ := d.OpenSession()
+	_, err = other.InternalSignECC(1, digest)
+	assert.Equal(t, ErrPermissionDenied, err)
+	assert.Nil(t, s.ReleasePrivateKeyAccessRight(1))
+	_, err = s.InternalSignECC(1, digest)
+	assert.Equal(t, ErrPermissionDenied, err)
+}
+
+func TestSessionKeys(t *testing.T) {
+	d, s := openSession(t)
+	defer d.Close()
+	peer, _ := gm.GenerateSM2Key()
+	c, h, err := s.GenerateKeyWithEPKECC(128, SM2Encrypt, &peer.PublicKey)
+	assert.Nil(t, err)
+	key, err := gm.Decrypt(peer, c)
+	assert.Nil(t, err)
+	assert.Len(t, key, 16)
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 3)
+	iv := make([]byte, 16)
+	ct, err := s.Encrypt(h, SM4CBC, iv, data)
+	assert.Nil(t, err)
+	assert.Equal(t, ct[32:], iv)
+	block, _ := gm.GetSm4Cipher(key)
+	first := make([]byte, 16)
+	block.Encrypt(first, data[:16])
+	assert.Equal(t, first, ct[:16])
+
+	// encrypting in two calls chains the iv
+	iv = make([]byte, 16)
+	part1, _ := s.Encrypt(h, SM4CBC, iv, data[:16])
+	part2, _ := s.Encrypt(h, SM4CBC, iv, data[16:])
+	assert.Equal(t, ct, append(part1, part2...))
+
+	iv = make([]byte, 16)
+	pt, err := s.Decrypt(h, SM4CBC, iv, ct)
+	assert.Nil(t, err)
+	assert.Equal(t, data, pt)
+
+	ecb, err := s.Encrypt(h, SM4ECB, nil, data)
+	assert.Nil(t, err)
+	assert.Equal(t, first, ecb[:16])
+	pt, _ = s.Decrypt(h, SM4ECB, nil, ecb)
+	assert.Equal(t, data, pt)
+
+	mac, err := s.CalculateMAC(h, SM4MAC, make([]byte, 16), data)
+	assert.Nil(t, err)
+	assert.Equal(t, ct[32:], mac)
+
+	_, err = s.Encrypt(h, SM4CBC, make([]byte, 16), data[:10])
+	assert.Equal(t, ErrInvalidInput, err)
+	_, err = s.Encrypt(h, SM2Encrypt, nil, data)
+	assert.Equal(t, ErrAlgNotSupported, err)
+
+	assert.Nil(t, s.DestroyKey(h))
+	_, err = s.Encrypt(h, SM4ECB, nil, data)
+	assert.Equal(t, ErrKeyNotExist, err)
+	assert.Equal(t, ErrKeyNotExist, s.DestroyKey(h))
+}
+
+func TestInternalKeyExchange(t *testing.T) {
+	d, s := openSession(t)
+	defer d.Close()
+	c, h1, err := s.GenerateKeyWithIPKECC(1, 128)
+	assert.Nil(t, err)
+
+	other, _ := d.OpenSession()
+	_, err = other.ImportKeyWithISKECC(1, c)
+	assert.Equal(t, ErrPermissionDenied, err)
+	assert.Nil(t, other.GetPrivateKeyAccessRight(1, password))
+	h2, err := other.ImportKeyWithISKECC(1, c)
+	assert.Nil(t, err)
+
+	data := []byte("0123456789abcdef")
+	ct, _ := s.Encrypt(h1, SM4ECB, nil, data)
+	pt, err := other.Decrypt(h2, SM4ECB, nil, ct)
+	assert.Nil(t, err)
+	assert.Equal(t, data, pt)
+
+	c[len(c)-1] ^= 1
+	_, err = other.ImportKeyWithISKECC(1, c)
+	assert.Equal(t, ErrEncryptedData, err)
+	_, err = other.ImportKeyWithISKECC(1, c[:50])
+	assert.Equal(t, ErrEncryptedData, err)
+}
+
+func TestHash(t *testing.T) {
+	d, s := openSession(t)
+	defer d.Close()
+	pub, _ := s.ExportSignPublicKeyECC(1)
+	msg := []byte("message to sign")
+
+	_, err := s.HashFinal()
+	assert.Equal(t, ErrStep, err)
+	assert.Nil(t, s.HashInit(SM3, pub, nil))
+	assert.Nil(t, s.HashUpdate(msg[:5]))
+	assert.Nil(t, s.HashUpdate(msg[5:]))
+	sum, err := s.HashFinal()
+	assert.Nil(t, err)
+	assert.Equal(t, gm.HashBeforeSM2(pub, msg), sum)
+
+	id := []byte("alice@example.com")
+	assert.Nil(t, s.HashInit(SM3, pub, id))
+	assert.Nil(t, s.HashUpdate(msg))
+	sum, _ = s.HashFinal()
+	want, _ := gm.HashBeforeSM2WithID(pub, id, msg)
+	assert.Equal(t, want, sum)
+
+	assert.Nil(t, s.HashInit(SM3, nil, nil))
+	assert.Nil(t, s.HashUpdate(msg))
+	sum, _ = s.HashFinal()
+	h := gm.GetSM3Hasher()
+	h.Write(msg)
+	assert.Equal(t, h.Sum(nil), sum)
+}
+
+func TestClose(t *testing.T) {
+	d, s := openSession(t)
+	assert.Nil(t, s.GetPrivateKeyAccessRight(1, password))
+	_, h, _ := s.GenerateKeyWithIPKECC(1, 128)
+	other, _ := d.OpenSession()
+	assert.Nil(t, other.Close())
+	_, err := other.GenerateRandom(16)
+	assert.Equal(t, ErrOpenSession, err)
+	b, err := s.GenerateRandom(16)
+	assert.Nil(t, err)
+	assert.Len(t, b, 16)
+
+	assert.Nil(t, d.Close())
+	_, err = s.Encrypt(h, SM4ECB, nil, make([]byte, 16))
+	assert.Equal(t, ErrOpenSession, err)
+	_, err = d.OpenSession()
+	assert.Equal(t, ErrOpenDevice, err)
+	assert.Equal(t, ErrOpenDevice, d.GenerateKeyPair(1, password))
+}
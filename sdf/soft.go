@@ -0,0 +1,569 @@
+package sdf
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	gm "github.com/meshplus/crypto-gm"
+	"hash"
+	"math/big"
+	"sync"
+)
+
+const (
+	sessionKeyBits = 128
+	blockSize      = 16
+)
+
+var defaultID = []byte("1234567812345678")
+
+//SoftDevice is a Device computing in process memory with this module. It
+// holds the internal key pairs, created by GenerateKeyPair or ImportKeyPair
+// as the management tool of a cipher machine does; Close wipes them.
+type SoftDevice struct {
+	mu       sync.RWMutex
+	keys     map[uint]*keyPair
+	sessions map[*softSession]struct{}
+	closed   bool
+}
+
+type keyPair struct {
+	sign, enc *gm.SM2PrivateKey
+	salt      [16]byte
+	password  []byte // SM3 of salt || password
+}
+
+//NewSoftDevice returns an opened software device without key pairs.
+func NewSoftDevice() *SoftDevice {
+	return &SoftDevice{
+		keys:     make(map[uint]*keyPair),
+		sessions: make(map[*softSession]struct{}),
+	}
+}
+
+func hashPassword(salt, password []byte) []byte {
+	h := gm.GetSM3Hasher()
+	h.Write(salt)
+	h.Write(password)
+	return h.Sum(nil)
+}
+
+//GenerateKeyPair generates the signing and the encryption key pairs of
+// index, whose private keys are used with password.
+func (d *SoftDevice) GenerateKeyPair(index uint, password []byte) error {
+	sign, err := gm.GenerateSM2Key()
+	if err != nil {
+		return err
+	}
+	enc, err := gm.GenerateSM2Key()
+	if err != nil {
+		sign.Destroy()
+		return err
+	}
+	err = d.ImportKeyPair(index, password, sign, enc)
+	sign.Destroy()
+	enc.Destroy()
+	return err
+}
+
+//ImportKeyPair stores copies of the signing and the encryption private keys
+// of index, replacing those it held, whose private keys are used with
+// password.
+func (d *SoftDevice) ImportKeyPair(index uint, password []byte, sign, enc *gm.SM2PrivateKey) error {
+	if index == 0 || len(password) == 0 {
+		return ErrInvalidInput
+	}
+	kp := &keyPair{sign: copyKey(sign), enc: copyKey(enc)}
+	if _, err := rand.Read(kp.salt[:]); err != nil {
+		return ErrRandom
+	}
+	kp.password = hashPassword(kp.salt[:], password)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		kp.destroy()
+		return ErrOpenDevice
+	}
+	if old, ok := d.keys[index]; ok {
+		old.destroy()
+	}
+	d.keys[index] = kp
+	return nil
+}
+
+func copyKey(key *gm.SM2PrivateKey) *gm.SM2PrivateKey {
+	c := new(gm.SM2PrivateKey)
+	c.K = key.K
+	c.PublicKey.Curve = gm.GetSm2Curve()
+	return c.CalculatePublicKey()
+}
+
+func (kp *keyPair) destroy() {
+	kp.sign.Destroy()
+	kp.enc.Destroy()
+}
+
+//OpenSession opens a session.
+func (d *SoftDevice) OpenSession() (Session, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return nil, ErrOpenDevice
+	}
+	s := &softSession{
+		d:      d,
+		rights: make(map[uint]bool),
+		keys:   make(map[KeyHandle]gm.SM4Key),
+	}
+	d.sessions[s] = struct{}{}
+	return s, nil
+}
+
+//Info returns the description of the device.
+func (d *SoftDevice) Info() (*DeviceInfo, error) {
+	return &DeviceInfo{
+		IssuerName:      "meshplus",
+		DeviceName:      "crypto-gm soft device",
+		DeviceSerial:    "0",
+		DeviceVersion:   1,
+		StandardVersion: 1,
+		AsymAlgAbility:  [2]uint32{uint32(SM2Sign | SM2Encrypt), 256},
+		SymAlgAbility:   uint32(SM4ECB | SM4CBC | SM4MAC),
+		HashAlgAbility:  uint32(SM3),
+		BufferSize:      0,
+	}, nil
+}
+
+//Close closes the sessions and wipes the key pairs.
+func (d *SoftDevice) Close() error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil
+	}
+	d.closed = true
+	for _, kp := range d.keys {
+		kp.destroy()
+	}
+	d.keys = nil
+	sessions := d.sessions
+	d.sessions = nil
+	d.mu.Unlock()
+
+	for s := range sessions {
+		s.close()
+	}
+	return nil
+}
+
+// withKeyPair calls f with the key pair of index, which Close does not wipe
+// before f returns.
+func (d *SoftDevice) withKeyPair(index uint, f func(*keyPair) error) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return ErrOpenDevice
+	}
+	kp, ok := d.keys[index]
+	if !ok {
+		return ErrKeyNotExist
+	}
+	return f(kp)
+}
+
+type softSession struct {
+	d *SoftDevice
+
+	mu     sync.Mutex
+	rights map[uint]bool
+	keys   map[KeyHandle]gm.SM4Key
+	next   KeyHandle
+	hash   hash.Hash
+	closed bool
+}
+
+// lock locks the session, failing once it is closed.
+func (s *softSession) lock() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrOpenSession
+	}
+	return nil
+}
+
+func (s *softSession) GenerateRandom(n int) ([]byte, error) {
+	if err := s.lock(); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+	if n <= 0 {
+		return nil, ErrInvalidInput
+	}
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, ErrRandom
+	}
+	return b, nil
+}
+
+func (s *softSession) GetPrivateKeyAccessRight(index uint, password []byte) error {
+	if err := s.lock(); err != nil {
+		return err
+	}
+	defer s.mu.Unlock()
+	return s.d.withKeyPair(index, func(kp *keyPair) error {
+		if subtle.ConstantTimeCompare(hashPassword(kp.salt[:], password), kp.password) != 1 {
+			return ErrPrivateKeyRight
+		}
+		s.rights[index] = true
+		return nil
+	})
+}
+
+func (s *softSession) ReleasePrivateKeyAccessRight(index uint) error {
+	if err := s.lock(); err != nil {
+		return err
+	}
+	defer s.mu.Unlock()
+	delete(s.rights, index)
+	return nil
+}
+
+// private calls f with the key pair of index if the session has its access
+// right.
+func (s *softSession) private(index uint, f func(*keyPair) error) error {
+	if !s.rights[index] {
+		return ErrPermissionDenied
+	}
+	return s.d.withKeyPair(index, f)
+}
+
+func (s *softSession) exportPublicKey(index uint, enc bool) (*gm.SM2PublicKey, error) {
+	if err := s.lock(); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+	pub := new(gm.SM2PublicKey)
+	err := s.d.withKeyPair(index, func(kp *keyPair) error {
+		if enc {
+			*pub = kp.enc.PublicKey
+		} else {
+			*pub = kp.sign.PublicKey
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+func (s *softSession) ExportSignPublicKeyECC(index uint) (*gm.SM2PublicKey, error) {
+	return s.exportPublicKey(index, false)
+}
+
+func (s *softSession) ExportEncPublicKeyECC(index uint) (*gm.SM2PublicKey, error) {
+	return s.exportPublicKey(index, true)
+}
+
+// newKey stores a session key and returns its handle, the session is
+// locked.
+func (s *softSession) newKey(key gm.SM4Key) KeyHandle {
+	s.next++
+	s.keys[s.next] = key
+	return s.next
+}
+
+// generateKey returns a new session key encrypted with pub, the session is
+// locked.
+func (s *softSession) generateKey(bits int, pub *gm.SM2PublicKey) ([]byte, KeyHandle, error) {
+	if bits != sessionKeyBits {
+		return nil, 0, ErrInvalidInput
+	}
+	key := make(gm.SM4Key, bits/8)
+	if _, err := rand.Read(key); err != nil {
+		return nil, 0, ErrRandom
+	}
+	c, err := gm.Encrypt(pub, key, rand.Reader)
+	if err != nil {
+		key.Destroy()
+		return nil, 0, ErrPublicKeyOp
+	}
+	return c, s.newKey(key), nil
+}
+
+func (s *softSession) GenerateKeyWithIPKECC(index uint, bits int) ([]byte, KeyHandle, error) {
+	pub, err := s.ExportEncPublicKeyECC(index)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.lock(); err != nil {
+		return nil, 0, err
+	}
+	defer s.mu.Unlock()
+	return s.generateKey(bits, pub)
+}
+
+func (s *softSession) GenerateKeyWithEPKECC(bits int, alg Algorithm, pub *gm.SM2PublicKey) ([]byte, KeyHandle, error) {
+	if err := s.lock(); err != nil {
+		return nil, 0, err
+	}
+	defer s.mu.Unlock()
+	if alg != SM2Encrypt {
+		return nil, 0, ErrAlgNotSupported
+	}
+	if !validPublicKey(pub) {
+		return nil, 0, ErrInvalidInput
+	}
+	return s.generateKey(bits, pub)
+}
+
+func (s *softSession) ImportKeyWithISKECC(index uint, c []byte) (KeyHandle, error) {
+	if err := s.lock(); err != nil {
+		return 0, err
+	}
+	defer s.mu.Unlock()
+	// 0x04 || C1 || C2 || C3 with a 16-byte C2
+	if len(c) != 1+64+sessionKeyBits/8+32 || c[0] != 4 {
+		return 0, ErrEncryptedData
+	}
+	var key gm.SM4Key
+	err := s.private(index, func(kp *keyPair) error {
+		k, err := gm.Decrypt(kp.enc, c)
+		if err != nil {
+			gm.SM4Key(k).Destroy()
+			return ErrEncryptedData
+		}
+		key = k
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return s.newKey(key), nil
+}
+
+func (s *softSession) DestroyKey(h KeyHandle) error {
+	if err := s.lock(); err != nil {
+		return err
+	}
+	defer s.mu.Unlock()
+	key, ok := s.keys[h]
+	if !ok {
+		return ErrKeyNotExist
+	}
+	key.Destroy()
+	delete(s.keys, h)
+	return nil
+}
+
+func (s *softSession) InternalSignECC(index uint, digest []byte) ([]byte, error) {
+	if err := s.lock(); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+	if len(digest) != 32 {
+		return nil, ErrInvalidInput
+	}
+	var sig []byte
+	err := s.private(index, func(kp *keyPair) error {
+		var err error
+		if sig, err = kp.sign.Sign(nil, digest, rand.Reader); err != nil {
+			return ErrSign
+		}
+		return nil
+	})
+	return sig, err
+}
+
+func (s *softSession) InternalVerifyECC(index uint, digest, signature []byte) (bool, error) {
+	pub, err := s.ExportSignPublicKeyECC(index)
+	if err != nil {
+		return false, err
+	}
+	return s.ExternalVerifyECC(SM2Sign, pub, digest, signature)
+}
+
+func (s *softSession) ExternalVerifyECC(alg Algorithm, pub *gm.SM2PublicKey, digest, signature []byte) (bool, error) {
+	if err := s.lock(); err != nil {
+		return false, err
+	}
+	defer s.mu.Unlock()
+	if alg != SM2Sign {
+		return false, ErrAlgNotSupported
+	}
+	if !validPublicKey(pub) || len(digest) != 32 {
+		return false, ErrInvalidInput
+	}
+	ok, err := pub.Verify(nil, signature, digest)
+	return ok && err == nil, nil
+}
+
+func (s *softSession) ExternalEncryptECC(alg Algorithm, pub *gm.SM2PublicKey, data []byte) ([]byte, error) {
+	if err := s.lock(); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+	if alg != SM2Encrypt {
+		return nil, ErrAlgNotSupported
+	}
+	if !validPublicKey(pub) || len(data) == 0 {
+		return nil, ErrInvalidInput
+	}
+	c, err := gm.Encrypt(pub, data, rand.Reader)
+	if err != nil {
+		return nil, ErrPublicKeyOp
+	}
+	return c, nil
+}
+
+// block returns the cipher of a session key, the session is locked.
+func (s *softSession) block(h KeyHandle, iv, data []byte, needIV bool) (cipher.Block, error) {
+	key, ok := s.keys[h]
+	if !ok {
+		return nil, ErrKeyNotExist
+	}
+	if len(data) == 0 || len(data)%blockSize != 0 || needIV && len(iv) != blockSize {
+		return nil, ErrInvalidInput
+	}
+	b, err := gm.GetSm4Cipher(key)
+	if err != nil {
+		return nil, ErrKey
+	}
+	return b, nil
+}
+
+func (s *softSession) crypt(h KeyHandle, alg Algorithm, iv, data []byte, decrypt bool) ([]byte, error) {
+	if err := s.lock(); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+	if alg != SM4ECB && alg != SM4CBC {
+		return nil, ErrAlgNotSupported
+	}
+	b, err := s.block(h, iv, data, alg == SM4CBC)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(data))
+	switch {
+	case alg == SM4ECB && decrypt:
+		for i := 0; i < len(data); i += blockSize {
+			b.Decrypt(out[i:], data[i:])
+		}
+	case alg == SM4ECB:
+		for i := 0; i < len(data); i += blockSize {
+			b.Encrypt(out[i:], data[i:])
+		}
+	case decrypt:
+		cipher.NewCBCDecrypter(b, iv).CryptBlocks(out, data)
+		copy(iv, data[len(data)-blockSize:])
+	default:
+		cipher.NewCBCEncrypter(b, iv).CryptBlocks(out, data)
+		copy(iv, out[len(out)-blockSize:])
+	}
+	return out, nil
+}
+
+func (s *softSession) Encrypt(h KeyHandle, alg Algorithm, iv, data []byte) ([]byte, error) {
+	return s.crypt(h, alg, iv, data, false)
+}
+
+func (s *softSession) Decrypt(h KeyHandle, alg Algorithm, iv, data []byte) ([]byte, error) {
+	return s.crypt(h, alg, iv, data, true)
+}
+
+func (s *softSession) CalculateMAC(h KeyHandle, alg Algorithm, iv, data []byte) ([]byte, error) {
+	if err := s.lock(); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+	if alg != SM4MAC {
+		return nil, ErrAlgNotSupported
+	}
+	b, err := s.block(h, iv, data, true)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(data))
+	cipher.NewCBCEncrypter(b, iv).CryptBlocks(out, data)
+	copy(iv, out[len(out)-blockSize:])
+	return out[len(out)-blockSize:], nil
+}
+
+func (s *softSession) HashInit(alg Algorithm, pub *gm.SM2PublicKey, id []byte) error {
+	if err := s.lock(); err != nil {
+		return err
+	}
+	defer s.mu.Unlock()
+	if alg != SM3 {
+		return ErrAlgNotSupported
+	}
+	h := gm.GetSM3Hasher()
+	if pub != nil {
+		if len(id) == 0 {
+			id = defaultID
+		}
+		z, err := gm.ComputeZ(pub, id)
+		if err != nil || !validPublicKey(pub) {
+			return ErrInvalidInput
+		}
+		h.Write(z)
+	}
+	s.hash = h
+	return nil
+}
+
+func (s *softSession) HashUpdate(data []byte) error {
+	if err := s.lock(); err != nil {
+		return err
+	}
+	defer s.mu.Unlock()
+	if s.hash == nil {
+		return ErrStep
+	}
+	s.hash.Write(data)
+	return nil
+}
+
+func (s *softSession) HashFinal() ([]byte, error) {
+	if err := s.lock(); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+	if s.hash == nil {
+		return nil, ErrStep
+	}
+	sum := s.hash.Sum(nil)
+	s.hash = nil
+	return sum, nil
+}
+
+func (s *softSession) Close() error {
+	s.d.mu.Lock()
+	delete(s.d.sessions, s)
+	s.d.mu.Unlock()
+	s.close()
+	return nil
+}
+
+func (s *softSession) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range s.keys {
+		key.Destroy()
+	}
+	s.keys, s.rights, s.hash = nil, nil, nil
+	s.closed = true
+}
+
+func validPublicKey(pub *gm.SM2PublicKey) bool {
+	if pub == nil {
+		return false
+	}
+	x, y := new(big.Int).SetBytes(pub.X[:]), new(big.Int).SetBytes(pub.Y[:])
+	return gm.GetSm2Curve().IsOnCurve(x, y)
+}
+
@@ -0,0 +1,51 @@
+package gm
+
+import (
+	"errors"
+	"runtime"
+)
+
+// wipe zeroes b.
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+	runtime.KeepAlive(b)
+}
+
+//SecretBuffer is memory for secrets, such as an SM4Key. On Linux it is
+// locked in RAM so that it is never swapped, and excluded from core dumps;
+// elsewhere it is ordinary memory. Destroy wipes and releases it.
+type SecretBuffer struct {
+	b      []byte
+	locked bool
+}
+
+//NewSecretBuffer returns a zeroed buffer of size bytes. On Linux it fails
+// when the memory cannot be locked, e.g. above RLIMIT_MEMLOCK.
+func NewSecretBuffer(size int) (*SecretBuffer, error) {
+	if size <= 0 {
+		return nil, errors.New("secret buffer size must be positive")
+	}
+	return newSecretBuffer(size)
+}
+
+//Bytes returns the memory of the buffer, nil once destroyed.
+func (s *SecretBuffer) Bytes() []byte {
+	return s.b
+}
+
+//Locked reports whether the memory is locked in RAM.
+func (s *SecretBuffer) Locked() bool {
+	return s.locked
+}
+
+//Destroy wipes and releases the buffer.
+func (s *SecretBuffer) Destroy() {
+	if s.b == nil {
+		return
+	}
+	wipe(s.b)
+	s.release()
+	s.b, s.locked = nil, false
+}
@@ -0,0 +1,28 @@
+//+build linux
+
+package gm
+
+import (
+	"syscall"
+)
+
+const madvDontDump = 0x10
+
+func newSecretBuffer(size int) (*SecretBuffer, error) {
+	b, err := syscall.Mmap(-1, 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+	if err = syscall.Mlock(b); err != nil {
+		_ = syscall.Munmap(b)
+		return nil, err
+	}
+	// best effort, older kernels do not know MADV_DONTDUMP
+	_ = syscall.Madvise(b, madvDontDump)
+	return &SecretBuffer{b: b, locked: true}, nil
+}
+
+func (s *SecretBuffer) release() {
+	_ = syscall.Munlock(s.b)
+	_ = syscall.Munmap(s.b)
+}
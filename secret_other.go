@@ -0,0 +1,9 @@
+//+build !linux
+
+package gm
+
+func newSecretBuffer(size int) (*SecretBuffer, error) {
+	return &SecretBuffer{b: make([]byte, size)}, nil
+}
+
+func (s *SecretBuffer) release() {}
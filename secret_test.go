@@ -0,0 +1,55 @@
+package gm
+
+import (
+	"crypto/rand"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSM2PrivateKeyDestroy(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	digest := HashBeforeSM2(&key.PublicKey, []byte("msg"))
+	_, err = key.Sign(nil, digest, rand.Reader)
+	assert.Nil(t, err)
+
+	key.Destroy()
+	assert.Equal(t, [sm2KeyLen]byte{}, key.K)
+	_, err = key.Bytes()
+	assert.NotNil(t, err)
+}
+
+func TestZeroize(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	key.Zeroize()
+	assert.Equal(t, [sm2KeyLen]byte{}, key.K)
+
+	k := SM4Key{1, 2, 3}
+	k.Zeroize()
+	assert.Equal(t, SM4Key{0, 0, 0}, k)
+}
+
+func TestSM4KeyDestroy(t *testing.T) {
+	buf, err := NewSecretBuffer(16)
+	assert.Nil(t, err)
+	key := SM4Key(buf.Bytes())
+	_, err = rand.Read(key)
+	assert.Nil(t, err)
+	msg := []byte("secret buffer backed sm4 key")
+	c, err := Sm4EncryptCBC(key, msg, rand.Reader)
+	assert.Nil(t, err)
+	p, err := Sm4DecryptCBC(key, c)
+	assert.Nil(t, err)
+	assert.Equal(t, msg, p)
+
+	key.Destroy()
+	assert.Equal(t, make([]byte, 16), []byte(key))
+	buf.Destroy()
+	assert.Nil(t, buf.Bytes())
+	assert.False(t, buf.Locked())
+	buf.Destroy()
+
+	_, err = NewSecretBuffer(0)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,117 @@
+package gm
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"github.com/meshplus/crypto-gm/internal/sm2"
+	"math/big"
+)
+
+// SM2 signatures come in three forms: DER, as returned by Sign, a SEQUENCE
+// of the INTEGERs r and s; raw, r || s as two 32-byte big-endian integers;
+// and batch, as returned by SignBatch, a flag byte followed by the DER form,
+// the flag telling which of the two points of x-coordinate x1 is kG.
+
+var errInvalidSignature = errors.New("invalid SM2 signature")
+
+type sm2Signature struct {
+	R, S *big.Int
+}
+
+// inRange reports whether 1 <= v < N.
+func inRange(v *big.Int) bool {
+	return v.Sign() > 0 && v.Cmp(GetSm2Curve().Params().N) < 0
+}
+
+//MarshalSignature returns the DER form of the signature (r, s), which must
+// both be in [1, N-1].
+func MarshalSignature(r, s *big.Int) ([]byte, error) {
+	if !inRange(r) || !inRange(s) {
+		return nil, errInvalidSignature
+	}
+	return asn1.Marshal(sm2Signature{r, s})
+}
+
+//ParseSignature parses a signature in the DER form strictly: integers and
+// lengths minimally encoded, no trailing data, and r and s in [1, N-1].
+func ParseSignature(der []byte) (r, s *big.Int, err error) {
+	var sig sm2Signature
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil || len(rest) != 0 || !inRange(sig.R) || !inRange(sig.S) {
+		return nil, nil, errInvalidSignature
+	}
+	// encoding/asn1 ignores elements after the last field
+	if canonical, err := asn1.Marshal(sig); err != nil || !bytes.Equal(canonical, der) {
+		return nil, nil, errInvalidSignature
+	}
+	return sig.R, sig.S, nil
+}
+
+//ParseSignatureFlexible parses a signature in the DER, batch or raw form,
+// trying them in this order. Leading zeros of the DER integers are
+// tolerated, r and s must still be in [1, N-1].
+func ParseSignatureFlexible(sig []byte) (r, s *big.Int, err error) {
+	rb, sb := sm2.Unmarshal(sig)
+	if rb == nil && len(sig) > 0 && sig[0] <= 1 {
+		rb, sb = sm2.Unmarshal(sig[1:])
+	}
+	switch {
+	case rb != nil:
+		r, s = new(big.Int).SetBytes(rb), new(big.Int).SetBytes(sb)
+	case len(sig) == 64:
+		r, s = new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	default:
+		return nil, nil, errInvalidSignature
+	}
+	if !inRange(r) || !inRange(s) {
+		return nil, nil, errInvalidSignature
+	}
+	return r, s, nil
+}
+
+//SignatureToRaw converts a signature from the DER form to the 64-byte raw
+// form r || s.
+func SignatureToRaw(der []byte) ([]byte, error) {
+	r, s, err := ParseSignature(der)
+	if err != nil {
+		return nil, err
+	}
+	raw := make([]byte, 64)
+	r.FillBytes(raw[:32])
+	s.FillBytes(raw[32:])
+	return raw, nil
+}
+
+//SignatureFromRaw converts a signature from the raw form r || s to the DER
+// form.
+func SignatureFromRaw(raw []byte) ([]byte, error) {
+	if len(raw) != 64 {
+		return nil, errInvalidSignature
+	}
+	return MarshalSignature(new(big.Int).SetBytes(raw[:32]), new(big.Int).SetBytes(raw[32:]))
+}
+
+//SignatureFromBatch splits a signature of SignBatch into its DER form and
+// its flag.
+func SignatureFromBatch(sig []byte) (der []byte, flag byte, err error) {
+	if len(sig) == 0 || sig[0] > 1 {
+		return nil, 0, errInvalidSignature
+	}
+	if _, _, err := ParseSignature(sig[1:]); err != nil {
+		return nil, 0, err
+	}
+	return sig[1:], sig[0], nil
+}
+
+//SignatureToBatch returns the batch form of a DER signature and its flag,
+// which is 0 or 1.
+func SignatureToBatch(der []byte, flag byte) ([]byte, error) {
+	if flag > 1 {
+		return nil, errInvalidSignature
+	}
+	if _, _, err := ParseSignature(der); err != nil {
+		return nil, err
+	}
+	return append([]byte{flag}, der...), nil
+}
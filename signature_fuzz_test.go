@@ -0,0 +1,49 @@
+//go:build go1.18
+//+build go1.18
+
+package gm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func FuzzParseSignature(f *testing.F) {
+	for _, h := range []string{
+		"3006020101020101",
+		"3007020200010201" + "01",
+		"003006020101020101",
+		"30450220" + "7f" + "00000000000000000000000000000000000000000000000000000000000000" +
+			"022100" + "ff" + "00000000000000000000000000000000000000000000000000000000000000",
+	} {
+		b, _ := hex.DecodeString(h)
+		f.Add(b)
+	}
+	f.Add(make([]byte, 64))
+	key, err := GenerateSM2Key()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Fuzz(func(t *testing.T, sig []byte) {
+		r, s, err := ParseSignature(sig)
+		if err == nil {
+			der, err := MarshalSignature(r, s)
+			if err != nil || !bytes.Equal(der, sig) {
+				t.Fatalf("%x does not round trip", sig)
+			}
+			raw, err := SignatureToRaw(sig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if der, err := SignatureFromRaw(raw); err != nil || !bytes.Equal(der, sig) {
+				t.Fatalf("%x does not round trip through the raw form", sig)
+			}
+		}
+		if fr, fs, err2 := ParseSignatureFlexible(sig); err == nil && (err2 != nil || fr.Cmp(r) != 0 || fs.Cmp(s) != 0) {
+			t.Fatalf("%x is strict but not flexible DER", sig)
+		}
+		SignatureFromBatch(sig)
+		key.PublicKey.Verify(nil, sig, make([]byte, 32))
+	})
+}
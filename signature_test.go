@@ -0,0 +1,95 @@
+package gm
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestSignatureForms(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	digest := HashBeforeSM2(&key.PublicKey, []byte("msg"))
+	for i := 0; i < 64; i++ {
+		der, err := key.Sign(nil, digest, rand.Reader)
+		assert.Nil(t, err)
+		r, s, err := ParseSignature(der)
+		assert.Nil(t, err)
+		again, err := MarshalSignature(r, s)
+		assert.Nil(t, err)
+		assert.Equal(t, der, again)
+
+		raw, err := SignatureToRaw(der)
+		assert.Nil(t, err)
+		assert.Len(t, raw, 64)
+		again, err = SignatureFromRaw(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, der, again)
+		fr, fs, err := ParseSignatureFlexible(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, r, fr)
+		assert.Equal(t, s, fs)
+
+		batch, err := key.SignBatch(nil, digest, rand.Reader)
+		assert.Nil(t, err)
+		bder, flag, err := SignatureFromBatch(batch)
+		assert.Nil(t, err)
+		assert.True(t, flag <= 1)
+		ok, err := key.PublicKey.Verify(nil, bder, digest)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		again, err = SignatureToBatch(bder, flag)
+		assert.Nil(t, err)
+		assert.Equal(t, batch, again)
+		_, _, err = ParseSignatureFlexible(batch)
+		assert.Nil(t, err)
+	}
+}
+
+func TestParseSignatureStrict(t *testing.T) {
+	n := GetSm2Curve().Params().N
+	valid, err := MarshalSignature(big.NewInt(1), new(big.Int).Sub(n, big.NewInt(1)))
+	assert.Nil(t, err)
+	_, _, err = ParseSignature(valid)
+	assert.Nil(t, err)
+
+	for _, h := range []string{
+		"",
+		"3006020101020101" + "00",   // trailing data
+		"3007020200010201" + "01",   // non-minimal integer
+		"308106020101020101",        // non-minimal length
+		"3006020100020101",          // r = 0
+		"30060201ff020101",          // r < 0
+		"3080020101020101" + "0000", // indefinite length
+		"3106020101020101",          // SET
+		"30050201010201",            // truncated
+		"3003020101",                // missing s
+		"3009020101020101020101",    // extra integer
+	} {
+		b, _ := hex.DecodeString(h)
+		_, _, err := ParseSignature(b)
+		assert.NotNil(t, err, h)
+	}
+	_, err = MarshalSignature(n, big.NewInt(1))
+	assert.NotNil(t, err)
+	_, err = MarshalSignature(big.NewInt(1), big.NewInt(0))
+	assert.NotNil(t, err)
+	_, err = SignatureFromRaw(make([]byte, 64))
+	assert.NotNil(t, err)
+	_, err = SignatureToBatch(valid, 2)
+	assert.NotNil(t, err)
+	_, _, err = SignatureFromBatch(append([]byte{2}, valid...))
+	assert.NotNil(t, err)
+
+	// the flexible parser tolerates leading zeros, not the rest
+	b, _ := hex.DecodeString("3007020200010201" + "01")
+	_, _, err = ParseSignatureFlexible(b)
+	assert.Nil(t, err)
+	for _, h := range []string{"3006020101020101" + "00", "3006020100020101", "00"} {
+		b, _ := hex.DecodeString(h)
+		_, _, err := ParseSignatureFlexible(b)
+		assert.NotNil(t, err, h)
+	}
+}
@@ -0,0 +1,843 @@
+package skf
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	gm "github.com/meshplus/crypto-gm"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+)
+
+const (
+	maxNameLen   = 32
+	minPINLen    = 6
+	maxPINLen    = 16
+	blockSize    = 16
+	defaultLabel = "crypto-gm emulator"
+)
+
+//Emulator is a Device whose state is kept in a JSON file, so that
+// applications, containers, keys and PIN retry counters persist across
+// connections. The file holds the private keys in clear: the emulator is for
+// tests, not for keys of value.
+//
+// The emulator has no device authentication: applications are created and
+// deleted without SKF_DevAuth.
+type Emulator struct {
+	mu     sync.Mutex
+	path   string
+	state  *emulatorState
+	closed bool
+}
+
+type emulatorState struct {
+	SerialNumber string                       `json:"serial_number"`
+	Applications map[string]*applicationState `json:"applications"`
+}
+
+type applicationState struct {
+	Admin      *pinState                  `json:"admin"`
+	User       *pinState                  `json:"user"`
+	Containers map[string]*containerState `json:"containers"`
+}
+
+type pinState struct {
+	Salt      []byte `json:"salt"`
+	Hash      []byte `json:"hash"`
+	MaxRetry  int    `json:"max_retry"`
+	Remaining int    `json:"remaining"`
+	Default   bool   `json:"default"`
+}
+
+type containerState struct {
+	SignKey  []byte `json:"sign_key,omitempty"`
+	EncKey   []byte `json:"enc_key,omitempty"`
+	SignCert []byte `json:"sign_cert,omitempty"`
+	EncCert  []byte `json:"enc_cert,omitempty"`
+}
+
+//OpenEmulator connects the emulated device stored in the file path, which
+// is created when it does not exist.
+func OpenEmulator(path string) (*Emulator, error) {
+	e := &Emulator{path: path}
+	b, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		serial := make([]byte, 8)
+		if _, err := defaultRand.Read(serial); err != nil {
+			return nil, err
+		}
+		e.state = &emulatorState{
+			SerialNumber: hex.EncodeToString(serial),
+			Applications: make(map[string]*applicationState),
+		}
+		if err := e.save(); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		e.state = new(emulatorState)
+		if err := json.Unmarshal(b, e.state); err != nil {
+			return nil, ErrFile
+		}
+		if e.state.Applications == nil {
+			e.state.Applications = make(map[string]*applicationState)
+		}
+	}
+	return e, nil
+}
+
+// save writes the state to a temporary file renamed over the file, so that
+// the file is never left half written.
+func (e *Emulator) save() error {
+	b, err := json.MarshalIndent(e.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := e.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return ErrFile
+	}
+	if err := os.Rename(tmp, e.path); err != nil {
+		return ErrFile
+	}
+	return nil
+}
+
+// lock locks the emulator, failing once it is closed.
+func (e *Emulator) lock() error {
+	e.mu.Lock()
+	if e.closed {
+		e.mu.Unlock()
+		return ErrInvalidHandle
+	}
+	return nil
+}
+
+//Info returns the description of the device.
+func (e *Emulator) Info() (*DeviceInfo, error) {
+	if err := e.lock(); err != nil {
+		return nil, err
+	}
+	defer e.mu.Unlock()
+	return &DeviceInfo{
+		Manufacturer: "meshplus",
+		Issuer:       "meshplus",
+		Label:        defaultLabel,
+		SerialNumber: e.state.SerialNumber,
+		AlgSymCap:    uint32(SM4ECB | SM4CBC),
+		AlgAsymCap:   0x00020100 | 0x00020400, // SGD_SM2_1, SGD_SM2_3
+		AlgHashCap:   0x00000001,              // SGD_SM3
+	}, nil
+}
+
+//GenRandom returns n random bytes.
+func (e *Emulator) GenRandom(n int) ([]byte, error) {
+	if err := e.lock(); err != nil {
+		return nil, err
+	}
+	defer e.mu.Unlock()
+	if n <= 0 {
+		return nil, ErrInvalidParam
+	}
+	b := make([]byte, n)
+	if _, err := defaultRand.Read(b); err != nil {
+		return nil, ErrGenRand
+	}
+	return b, nil
+}
+
+//ExtECCVerify verifies a signature with pub.
+func (e *Emulator) ExtECCVerify(pub *gm.SM2PublicKey, digest, signature []byte) (bool, error) {
+	if err := e.lock(); err != nil {
+		return false, err
+	}
+	defer e.mu.Unlock()
+	if !validPublicKey(pub) || len(digest) != 32 {
+		return false, ErrInvalidParam
+	}
+	ok, err := pub.Verify(nil, signature, digest)
+	return ok && err == nil, nil
+}
+
+//ExtECCEncrypt encrypts data with pub.
+func (e *Emulator) ExtECCEncrypt(pub *gm.SM2PublicKey, data []byte) ([]byte, error) {
+	if err := e.lock(); err != nil {
+		return nil, err
+	}
+	defer e.mu.Unlock()
+	if !validPublicKey(pub) || len(data) == 0 {
+		return nil, ErrInvalidParam
+	}
+	c, err := gm.Encrypt(pub, data, defaultRand)
+	if err != nil {
+		return nil, ErrFail
+	}
+	return c, nil
+}
+
+func newPIN(pin string, retry int) (*pinState, error) {
+	if len(pin) < minPINLen || len(pin) > maxPINLen {
+		return nil, ErrPINLenRange
+	}
+	if retry <= 0 {
+		return nil, ErrInvalidParam
+	}
+	p := &pinState{Salt: make([]byte, 16), MaxRetry: retry, Remaining: retry}
+	if _, err := defaultRand.Read(p.Salt); err != nil {
+		return nil, ErrGenRand
+	}
+	p.Hash = hashPIN(p.Salt, pin)
+	return p, nil
+}
+
+func hashPIN(salt []byte, pin string) []byte {
+	h := gm.GetSM3Hasher()
+	h.Write(salt)
+	h.Write([]byte(pin))
+	return h.Sum(nil)
+}
+
+// verify checks pin and updates the tries left, which the caller saves.
+func (p *pinState) verify(pin string) error {
+	if p.Remaining == 0 {
+		return ErrPINLocked
+	}
+	if subtle.ConstantTimeCompare(hashPIN(p.Salt, pin), p.Hash) != 1 {
+		p.Remaining--
+		if p.Remaining == 0 {
+			return ErrPINLocked
+		}
+		return ErrPINIncorrect
+	}
+	p.Remaining = p.MaxRetry
+	return nil
+}
+
+func (p *pinState) set(pin string) error {
+	if len(pin) < minPINLen || len(pin) > maxPINLen {
+		return ErrPINLenRange
+	}
+	p.Hash = hashPIN(p.Salt, pin)
+	p.Remaining = p.MaxRetry
+	p.Default = false
+	return nil
+}
+
+func checkName(name string) error {
+	if len(name) == 0 || len(name) > maxNameLen {
+		return ErrNameLen
+	}
+	return nil
+}
+
+//CreateApplication creates an application. Its PINs are 6 to 16
+// characters long.
+func (e *Emulator) CreateApplication(name, adminPIN string, adminRetry int, userPIN string, userRetry int) (Application, error) {
+	if err := e.lock(); err != nil {
+		return nil, err
+	}
+	defer e.mu.Unlock()
+	if checkName(name) != nil {
+		return nil, ErrApplicationNameInvalid
+	}
+	if _, ok := e.state.Applications[name]; ok {
+		return nil, ErrApplicationExists
+	}
+	admin, err := newPIN(adminPIN, adminRetry)
+	if err != nil {
+		return nil, err
+	}
+	user, err := newPIN(userPIN, userRetry)
+	if err != nil {
+		return nil, err
+	}
+	user.Default = true
+	e.state.Applications[name] = &applicationState{
+		Admin:      admin,
+		User:       user,
+		Containers: make(map[string]*containerState),
+	}
+	if err := e.save(); err != nil {
+		delete(e.state.Applications, name)
+		return nil, err
+	}
+	return &emulatedApplication{e: e, name: name}, nil
+}
+
+//EnumApplication returns the names of the applications, in order.
+func (e *Emulator) EnumApplication() ([]string, error) {
+	if err := e.lock(); err != nil {
+		return nil, err
+	}
+	defer e.mu.Unlock()
+	names := make([]string, 0, len(e.state.Applications))
+	for name := range e.state.Applications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+//OpenApplication opens an application.
+func (e *Emulator) OpenApplication(name string) (Application, error) {
+	if err := e.lock(); err != nil {
+		return nil, err
+	}
+	defer e.mu.Unlock()
+	if _, ok := e.state.Applications[name]; !ok {
+		return nil, ErrApplicationNotExists
+	}
+	return &emulatedApplication{e: e, name: name}, nil
+}
+
+//DeleteApplication deletes an application.
+func (e *Emulator) DeleteApplication(name string) error {
+	if err := e.lock(); err != nil {
+		return err
+	}
+	defer e.mu.Unlock()
+	app, ok := e.state.Applications[name]
+	if !ok {
+		return ErrApplicationNotExists
+	}
+	delete(e.state.Applications, name)
+	if err := e.save(); err != nil {
+		e.state.Applications[name] = app
+		return err
+	}
+	return nil
+}
+
+//Close disconnects the device; its handles can no longer be used.
+func (e *Emulator) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+	return nil
+}
+
+type emulatedApplication struct {
+	e        *Emulator
+	name     string
+	loggedIn bool
+	closed   bool
+}
+
+// lock locks the emulator and returns the state of the application.
+func (a *emulatedApplication) lock() (*applicationState, error) {
+	if err := a.e.lock(); err != nil {
+		return nil, err
+	}
+	if a.closed {
+		a.e.mu.Unlock()
+		return nil, ErrInvalidHandle
+	}
+	state, ok := a.e.state.Applications[a.name]
+	if !ok {
+		a.e.mu.Unlock()
+		return nil, ErrApplicationNotExists
+	}
+	return state, nil
+}
+
+// lockUser is lock for the operations which need the user PIN.
+func (a *emulatedApplication) lockUser() (*applicationState, error) {
+	state, err := a.lock()
+	if err != nil {
+		return nil, err
+	}
+	if !a.loggedIn {
+		a.e.mu.Unlock()
+		return nil, ErrUserNotLoggedIn
+	}
+	return state, nil
+}
+
+func (state *applicationState) pin(t PINType) (*pinState, error) {
+	switch t {
+	case AdminPIN:
+		return state.Admin, nil
+	case UserPIN:
+		return state.User, nil
+	}
+	return nil, ErrUserTypeInvalid
+}
+
+func (a *emulatedApplication) VerifyPIN(t PINType, pin string) (int, error) {
+	state, err := a.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer a.e.mu.Unlock()
+	p, err := state.pin(t)
+	if err != nil {
+		return 0, err
+	}
+	err = p.verify(pin)
+	if err := a.e.save(); err != nil {
+		return p.Remaining, err
+	}
+	if err == nil && t == UserPIN {
+		a.loggedIn = true
+	}
+	return p.Remaining, err
+}
+
+func (a *emulatedApplication) ChangePIN(t PINType, oldPIN, newPIN string) (int, error) {
+	state, err := a.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer a.e.mu.Unlock()
+	p, err := state.pin(t)
+	if err != nil {
+		return 0, err
+	}
+	if len(newPIN) < minPINLen || len(newPIN) > maxPINLen {
+		return p.Remaining, ErrPINLenRange
+	}
+	if err = p.verify(oldPIN); err == nil {
+		err = p.set(newPIN)
+	}
+	if err := a.e.save(); err != nil {
+		return p.Remaining, err
+	}
+	return p.Remaining, err
+}
+
+func (a *emulatedApplication) UnblockPIN(adminPIN, newUserPIN string) (int, error) {
+	state, err := a.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer a.e.mu.Unlock()
+	if len(newUserPIN) < minPINLen || len(newUserPIN) > maxPINLen {
+		return state.Admin.Remaining, ErrPINLenRange
+	}
+	if err = state.Admin.verify(adminPIN); err == nil {
+		err = state.User.set(newUserPIN)
+	}
+	if err := a.e.save(); err != nil {
+		return state.Admin.Remaining, err
+	}
+	return state.Admin.Remaining, err
+}
+
+func (a *emulatedApplication) GetPINInfo(t PINType) (*PINInfo, error) {
+	state, err := a.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer a.e.mu.Unlock()
+	p, err := state.pin(t)
+	if err != nil {
+		return nil, err
+	}
+	return &PINInfo{MaxRetry: p.MaxRetry, Remaining: p.Remaining, Default: p.Default}, nil
+}
+
+func (a *emulatedApplication) ClearSecureState() error {
+	if _, err := a.lock(); err != nil {
+		return err
+	}
+	defer a.e.mu.Unlock()
+	a.loggedIn = false
+	return nil
+}
+
+func (a *emulatedApplication) CreateContainer(name string) (Container, error) {
+	state, err := a.lockUser()
+	if err != nil {
+		return nil, err
+	}
+	defer a.e.mu.Unlock()
+	if err := checkName(name); err != nil {
+		return nil, err
+	}
+	if _, ok := state.Containers[name]; ok {
+		return nil, ErrFileAlreadyExist
+	}
+	state.Containers[name] = new(containerState)
+	if err := a.e.save(); err != nil {
+		delete(state.Containers, name)
+		return nil, err
+	}
+	return &emulatedContainer{a: a, name: name}, nil
+}
+
+func (a *emulatedApplication) EnumContainer() ([]string, error) {
+	state, err := a.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer a.e.mu.Unlock()
+	names := make([]string, 0, len(state.Containers))
+	for name := range state.Containers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (a *emulatedApplication) OpenContainer(name string) (Container, error) {
+	state, err := a.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer a.e.mu.Unlock()
+	if _, ok := state.Containers[name]; !ok {
+		return nil, ErrFileNotExist
+	}
+	return &emulatedContainer{a: a, name: name}, nil
+}
+
+func (a *emulatedApplication) DeleteContainer(name string) error {
+	state, err := a.lockUser()
+	if err != nil {
+		return err
+	}
+	defer a.e.mu.Unlock()
+	c, ok := state.Containers[name]
+	if !ok {
+		return ErrFileNotExist
+	}
+	delete(state.Containers, name)
+	if err := a.e.save(); err != nil {
+		state.Containers[name] = c
+		return err
+	}
+	return nil
+}
+
+func (a *emulatedApplication) Close() error {
+	a.e.mu.Lock()
+	defer a.e.mu.Unlock()
+	a.closed, a.loggedIn = true, false
+	return nil
+}
+
+type emulatedContainer struct {
+	a      *emulatedApplication
+	name   string
+	closed bool
+}
+
+// lock locks the emulator and returns the state of the container; user
+// tells whether the operation needs the user PIN.
+func (c *emulatedContainer) lock(user bool) (*containerState, error) {
+	lock := c.a.lock
+	if user {
+		lock = c.a.lockUser
+	}
+	app, err := lock()
+	if err != nil {
+		return nil, err
+	}
+	if c.closed {
+		c.a.e.mu.Unlock()
+		return nil, ErrInvalidHandle
+	}
+	state, ok := app.Containers[c.name]
+	if !ok {
+		c.a.e.mu.Unlock()
+		return nil, ErrFileNotExist
+	}
+	return state, nil
+}
+
+func (c *emulatedContainer) GenECCKeyPair() (*gm.SM2PublicKey, error) {
+	state, err := c.lock(true)
+	if err != nil {
+		return nil, err
+	}
+	defer c.a.e.mu.Unlock()
+	key, err := gm.GenerateSM2Key()
+	if err != nil {
+		return nil, ErrGenRand
+	}
+	defer key.Destroy()
+	old := state.SignKey
+	state.SignKey = append([]byte(nil), key.K[:]...)
+	if err := c.a.e.save(); err != nil {
+		state.SignKey = old
+		return nil, err
+	}
+	pub := key.PublicKey
+	return &pub, nil
+}
+
+func (c *emulatedContainer) ImportECCKeyPair(env *EnvelopedKey) error {
+	state, err := c.lock(true)
+	if err != nil {
+		return err
+	}
+	defer c.a.e.mu.Unlock()
+	if env == nil || env.Version != 1 || env.SymmAlgID != SM4ECB || env.Bits != 256 ||
+		len(env.EncryptedPrivateKey) != 32 || !validPublicKey(env.PublicKey) {
+		return ErrInvalidParam
+	}
+	signKey, err := privateKey(state.SignKey)
+	if err != nil {
+		return err
+	}
+	defer signKey.Destroy()
+	if len(env.Cipher) != 1+64+16+32 || env.Cipher[0] != 4 {
+		return ErrInData
+	}
+	sk, err := gm.Decrypt(signKey, env.Cipher)
+	defer gm.SM4Key(sk).Destroy()
+	if err != nil {
+		return ErrInData
+	}
+	b, err := gm.GetSm4Cipher(sk)
+	if err != nil {
+		return ErrInData
+	}
+	key := new(gm.SM2PrivateKey)
+	defer key.Destroy()
+	b.Decrypt(key.K[:16], env.EncryptedPrivateKey)
+	b.Decrypt(key.K[16:], env.EncryptedPrivateKey[16:])
+	d := new(big.Int).SetBytes(key.K[:])
+	if d.Sign() == 0 || d.Cmp(gm.GetSm2Curve().Params().N) >= 0 {
+		return ErrInData
+	}
+	key.PublicKey.Curve = gm.GetSm2Curve()
+	key.CalculatePublicKey()
+	if key.PublicKey.X != env.PublicKey.X || key.PublicKey.Y != env.PublicKey.Y {
+		return ErrInData
+	}
+	old := state.EncKey
+	state.EncKey = append([]byte(nil), key.K[:]...)
+	if err := c.a.e.save(); err != nil {
+		state.EncKey = old
+		return err
+	}
+	return nil
+}
+
+// privateKey returns the private key of k, stored by the container.
+func privateKey(k []byte) (*gm.SM2PrivateKey, error) {
+	if len(k) != 32 {
+		return nil, ErrKeyNotFound
+	}
+	key := new(gm.SM2PrivateKey)
+	copy(key.K[:], k)
+	key.PublicKey.Curve = gm.GetSm2Curve()
+	return key.CalculatePublicKey(), nil
+}
+
+func (c *emulatedContainer) ExportPublicKey(sign bool) (*gm.SM2PublicKey, error) {
+	state, err := c.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer c.a.e.mu.Unlock()
+	k := state.EncKey
+	if sign {
+		k = state.SignKey
+	}
+	key, err := privateKey(k)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Destroy()
+	pub := key.PublicKey
+	return &pub, nil
+}
+
+func (c *emulatedContainer) ImportCertificate(sign bool, cert []byte) error {
+	state, err := c.lock(true)
+	if err != nil {
+		return err
+	}
+	defer c.a.e.mu.Unlock()
+	if len(cert) == 0 {
+		return ErrInvalidParam
+	}
+	p := &state.EncCert
+	if sign {
+		p = &state.SignCert
+	}
+	old := *p
+	*p = append([]byte(nil), cert...)
+	if err := c.a.e.save(); err != nil {
+		*p = old
+		return err
+	}
+	return nil
+}
+
+func (c *emulatedContainer) ExportCertificate(sign bool) ([]byte, error) {
+	state, err := c.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer c.a.e.mu.Unlock()
+	cert := state.EncCert
+	if sign {
+		cert = state.SignCert
+	}
+	if cert == nil {
+		return nil, ErrCertNotFound
+	}
+	return append([]byte(nil), cert...), nil
+}
+
+func (c *emulatedContainer) ECCSignData(digest []byte) ([]byte, error) {
+	state, err := c.lock(true)
+	if err != nil {
+		return nil, err
+	}
+	defer c.a.e.mu.Unlock()
+	if len(digest) != 32 {
+		return nil, ErrInDataLen
+	}
+	key, err := privateKey(state.SignKey)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Destroy()
+	sig, err := key.Sign(nil, digest, defaultRand)
+	if err != nil {
+		return nil, ErrFail
+	}
+	return sig, nil
+}
+
+func (c *emulatedContainer) ECCExportSessionKey(alg Algorithm, pub *gm.SM2PublicKey) ([]byte, SessionKey, error) {
+	if _, err := c.lock(false); err != nil {
+		return nil, nil, err
+	}
+	defer c.a.e.mu.Unlock()
+	if alg != SM4ECB && alg != SM4CBC {
+		return nil, nil, ErrNotSupported
+	}
+	if !validPublicKey(pub) {
+		return nil, nil, ErrInvalidParam
+	}
+	key := make(gm.SM4Key, 16)
+	if _, err := defaultRand.Read(key); err != nil {
+		return nil, nil, ErrGenRand
+	}
+	wrapped, err := gm.Encrypt(pub, key, defaultRand)
+	if err != nil {
+		key.Destroy()
+		return nil, nil, ErrFail
+	}
+	return wrapped, &sessionKey{key: key, alg: alg}, nil
+}
+
+func (c *emulatedContainer) ImportSessionKey(alg Algorithm, wrapped []byte) (SessionKey, error) {
+	state, err := c.lock(true)
+	if err != nil {
+		return nil, err
+	}
+	defer c.a.e.mu.Unlock()
+	if alg != SM4ECB && alg != SM4CBC {
+		return nil, ErrNotSupported
+	}
+	key, err := privateKey(state.EncKey)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Destroy()
+	if len(wrapped) != 1+64+16+32 || wrapped[0] != 4 {
+		return nil, ErrInData
+	}
+	sk, err := gm.Decrypt(key, wrapped)
+	if err != nil {
+		gm.SM4Key(sk).Destroy()
+		return nil, ErrInData
+	}
+	return &sessionKey{key: sk, alg: alg}, nil
+}
+
+func (c *emulatedContainer) Close() error {
+	c.a.e.mu.Lock()
+	defer c.a.e.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+type sessionKey struct {
+	mu  sync.Mutex
+	key gm.SM4Key
+	alg Algorithm
+}
+
+func (k *sessionKey) crypt(param BlockCipherParam, data []byte, decrypt bool) ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.key == nil {
+		return nil, ErrInvalidHandle
+	}
+	if k.alg == SM4CBC && len(param.IV) != blockSize {
+		return nil, ErrInvalidParam
+	}
+	if !decrypt && param.Padding {
+		n := blockSize - len(data)%blockSize
+		data = append(append([]byte(nil), data...), bytes.Repeat([]byte{byte(n)}, n)...)
+	}
+	if len(data) == 0 || len(data)%blockSize != 0 {
+		return nil, ErrInDataLen
+	}
+	b, err := gm.GetSm4Cipher(k.key)
+	if err != nil {
+		return nil, ErrFail
+	}
+	out := make([]byte, len(data))
+	switch {
+	case k.alg == SM4ECB && decrypt:
+		for i := 0; i < len(data); i += blockSize {
+			b.Decrypt(out[i:], data[i:])
+		}
+	case k.alg == SM4ECB:
+		for i := 0; i < len(data); i += blockSize {
+			b.Encrypt(out[i:], data[i:])
+		}
+	case decrypt:
+		cipher.NewCBCDecrypter(b, param.IV).CryptBlocks(out, data)
+	default:
+		cipher.NewCBCEncrypter(b, param.IV).CryptBlocks(out, data)
+	}
+	if decrypt && param.Padding {
+		n := int(out[len(out)-1])
+		if n == 0 || n > blockSize || !bytes.Equal(out[len(out)-n:], bytes.Repeat([]byte{byte(n)}, n)) {
+			return nil, ErrDecryptPad
+		}
+		out = out[:len(out)-n]
+	}
+	return out, nil
+}
+
+func (k *sessionKey) Encrypt(param BlockCipherParam, data []byte) ([]byte, error) {
+	return k.crypt(param, data, false)
+}
+
+func (k *sessionKey) Decrypt(param BlockCipherParam, data []byte) ([]byte, error) {
+	return k.crypt(param, data, true)
+}
+
+func (k *sessionKey) Close() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.key.Destroy()
+	k.key = nil
+	return nil
+}
+
+func validPublicKey(pub *gm.SM2PublicKey) bool {
+	if pub == nil {
+		return false
+	}
+	x, y := new(big.Int).SetBytes(pub.X[:]), new(big.Int).SetBytes(pub.Y[:])
+	return gm.GetSm2Curve().IsOnCurve(x, y)
+}
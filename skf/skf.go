@@ -0,0 +1,279 @@
+//Package skf is the smart key interface of GM/T 0016, the application
+// interface of USB keys and smart cards, with Emulator, an implementation
+// backed by a file.
+//
+// A device holds applications, each protected by an administrator PIN and a
+// user PIN with retry counters; an application holds containers, each with a
+// signing key pair, an encryption key pair imported in a digital envelope,
+// and their certificates. Private keys never leave the device.
+//
+// Signatures are DER encoded and SM2 ciphertexts are in the format of
+// gm.Encrypt, as everywhere in this module; a driver of a real key converts
+// them from and to the ECCSIGNATUREBLOB and ECCCIPHERBLOB of its library.
+package skf
+
+import (
+	"crypto/rand"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/sdf"
+	"io"
+)
+
+//Algorithm is the GM/T 0006 algorithm identifier of the sdf package, shared
+// by the two interfaces.
+type Algorithm = sdf.Algorithm
+
+// Symmetric algorithms of session keys.
+const (
+	SM4ECB = sdf.SM4ECB
+	SM4CBC = sdf.SM4CBC
+)
+
+//PINType is the type of a PIN.
+type PINType int
+
+// PIN types.
+const (
+	AdminPIN PINType = 0 // ADMIN_TYPE
+	UserPIN  PINType = 1 // USER_TYPE
+)
+
+//DeviceInfo describes a device, as the DEVINFO structure.
+type DeviceInfo struct {
+	Manufacturer string
+	Issuer       string
+	Label        string
+	SerialNumber string
+	AlgSymCap    uint32
+	AlgAsymCap   uint32
+	AlgHashCap   uint32
+}
+
+//PINInfo is the state of a PIN, as SKF_GetPINInfo returns.
+type PINInfo struct {
+	MaxRetry  int
+	Remaining int
+	Default   bool
+}
+
+//BlockCipherParam are the parameters of a session key operation, as the
+// BLOCKCIPHERPARAM structure; Padding selects PKCS#7 padding.
+type BlockCipherParam struct {
+	IV      []byte
+	Padding bool
+}
+
+//Device is a connected smart key, as SKF_ConnectDev returns.
+type Device interface {
+	//Info returns the description of the device, SKF_GetDevInfo.
+	Info() (*DeviceInfo, error)
+	//GenRandom returns n random bytes, SKF_GenRandom.
+	GenRandom(n int) ([]byte, error)
+	//ExtECCVerify verifies a signature with pub, SKF_ECCVerify.
+	ExtECCVerify(pub *gm.SM2PublicKey, digest, signature []byte) (bool, error)
+	//ExtECCEncrypt encrypts data with pub, SKF_ExtECCEncrypt.
+	ExtECCEncrypt(pub *gm.SM2PublicKey, data []byte) ([]byte, error)
+
+	//CreateApplication creates an application with its PINs and their
+	// numbers of tries, SKF_CreateApplication.
+	CreateApplication(name, adminPIN string, adminRetry int, userPIN string, userRetry int) (Application, error)
+	//EnumApplication returns the names of the applications,
+	// SKF_EnumApplication.
+	EnumApplication() ([]string, error)
+	//OpenApplication opens an application, SKF_OpenApplication.
+	OpenApplication(name string) (Application, error)
+	//DeleteApplication deletes an application and its containers,
+	// SKF_DeleteApplication.
+	DeleteApplication(name string) error
+
+	//Close disconnects the device, SKF_DisConnectDev.
+	Close() error
+}
+
+//Application is an opened application, as SKF_OpenApplication returns.
+// Until VerifyPIN succeeds with the user PIN, the private keys of its
+// containers cannot be used and its containers cannot be changed.
+type Application interface {
+	//VerifyPIN checks a PIN and returns the number of tries left,
+	// SKF_VerifyPIN. The user PIN logs the user in.
+	VerifyPIN(t PINType, pin string) (int, error)
+	//ChangePIN changes a PIN and returns the number of tries left,
+	// SKF_ChangePIN.
+	ChangePIN(t PINType, oldPIN, newPIN string) (int, error)
+	//UnblockPIN sets the user PIN and resets its tries with the
+	// administrator PIN, and returns the number of tries left of the
+	// administrator PIN, SKF_UnblockPIN.
+	UnblockPIN(adminPIN, newUserPIN string) (int, error)
+	//GetPINInfo returns the state of a PIN, SKF_GetPINInfo.
+	GetPINInfo(t PINType) (*PINInfo, error)
+	//ClearSecureState logs the user out, SKF_ClearSecureState.
+	ClearSecureState() error
+
+	//CreateContainer creates a container, SKF_CreateContainer.
+	CreateContainer(name string) (Container, error)
+	//EnumContainer returns the names of the containers, SKF_EnumContainer.
+	EnumContainer() ([]string, error)
+	//OpenContainer opens a container, SKF_OpenContainer.
+	OpenContainer(name string) (Container, error)
+	//DeleteContainer deletes a container, SKF_DeleteContainer.
+	DeleteContainer(name string) error
+
+	//Close closes the application, SKF_CloseApplication.
+	Close() error
+}
+
+//Container is an opened container, as SKF_OpenContainer returns.
+type Container interface {
+	//GenECCKeyPair generates the signing key pair and returns its public
+	// key, SKF_GenECCKeyPair.
+	GenECCKeyPair() (*gm.SM2PublicKey, error)
+	//ImportECCKeyPair imports the encryption key pair from an envelope
+	// sealed for the signing public key, SKF_ImportECCKeyPair.
+	ImportECCKeyPair(env *EnvelopedKey) error
+	//ExportPublicKey returns the signing or the encryption public key,
+	// SKF_ExportPublicKey.
+	ExportPublicKey(sign bool) (*gm.SM2PublicKey, error)
+	//ImportCertificate stores the certificate of the signing or the
+	// encryption key, SKF_ImportCertificate.
+	ImportCertificate(sign bool, cert []byte) error
+	//ExportCertificate returns the certificate of the signing or the
+	// encryption key, SKF_ExportCertificate.
+	ExportCertificate(sign bool) ([]byte, error)
+
+	//ECCSignData signs a digest with the signing private key,
+	// SKF_ECCSignData.
+	ECCSignData(digest []byte) ([]byte, error)
+	//ECCExportSessionKey generates a session key and returns it encrypted
+	// with pub, SKF_ECCExportSessionKey.
+	ECCExportSessionKey(alg Algorithm, pub *gm.SM2PublicKey) ([]byte, SessionKey, error)
+	//ImportSessionKey imports a session key encrypted with the encryption
+	// public key, SKF_ImportSessionKey.
+	ImportSessionKey(alg Algorithm, wrapped []byte) (SessionKey, error)
+
+	//Close closes the container, SKF_CloseContainer.
+	Close() error
+}
+
+//SessionKey is a symmetric key inside the device.
+type SessionKey interface {
+	//Encrypt encrypts data, SKF_EncryptInit and SKF_Encrypt.
+	Encrypt(param BlockCipherParam, data []byte) ([]byte, error)
+	//Decrypt decrypts data, SKF_DecryptInit and SKF_Decrypt.
+	Decrypt(param BlockCipherParam, data []byte) ([]byte, error)
+	//Close destroys the key, SKF_CloseHandle.
+	Close() error
+}
+
+//EnvelopedKey is an SM2 key pair in a digital envelope, as the
+// ENVELOPEDKEYBLOB structure: the private key is encrypted with SM4-ECB under
+// a random key, itself encrypted with the signing public key of the
+// container. EncryptedPrivateKey holds the 32 bytes of the encrypted key,
+// without the left padding of the structure.
+type EnvelopedKey struct {
+	Version             uint32
+	SymmAlgID           Algorithm
+	Bits                uint32
+	EncryptedPrivateKey []byte
+	PublicKey           *gm.SM2PublicKey
+	Cipher              []byte
+}
+
+//SealEnvelopedKey puts key in an envelope for the container whose signing
+// public key is signPub, as a key management centre does. A nil rand is
+// crypto/rand.
+func SealEnvelopedKey(signPub *gm.SM2PublicKey, key *gm.SM2PrivateKey, rand io.Reader) (*EnvelopedKey, error) {
+	if rand == nil {
+		rand = defaultRand
+	}
+	sk := make(gm.SM4Key, 16)
+	defer sk.Destroy()
+	if _, err := io.ReadFull(rand, sk); err != nil {
+		return nil, err
+	}
+	c, err := gm.Encrypt(signPub, sk, rand)
+	if err != nil {
+		return nil, err
+	}
+	b, err := gm.GetSm4Cipher(sk)
+	if err != nil {
+		return nil, err
+	}
+	enc := make([]byte, 32)
+	b.Encrypt(enc, key.K[:16])
+	b.Encrypt(enc[16:], key.K[16:])
+	pub := *key.Public().(*gm.SM2PublicKey)
+	return &EnvelopedKey{
+		Version:             1,
+		SymmAlgID:           SM4ECB,
+		Bits:                256,
+		EncryptedPrivateKey: enc,
+		PublicKey:           &pub,
+		Cipher:              c,
+	}, nil
+}
+
+var defaultRand = rand.Reader
+
+//Error is an error code of GM/T 0016.
+type Error uint32
+
+// Error codes of GM/T 0016.
+const (
+	ErrFail                   Error = 0x0A000001 // SAR_FAIL
+	ErrNotSupported           Error = 0x0A000003 // SAR_NOTSUPPORTYETERR
+	ErrFile                   Error = 0x0A000004 // SAR_FILEERR
+	ErrInvalidHandle          Error = 0x0A000005 // SAR_INVALIDHANDLEERR
+	ErrInvalidParam           Error = 0x0A000006 // SAR_INVALIDPARAMERR
+	ErrNameLen                Error = 0x0A000009 // SAR_NAMELENERR
+	ErrInDataLen              Error = 0x0A000010 // SAR_INDATALENERR
+	ErrInData                 Error = 0x0A000011 // SAR_INDATAERR
+	ErrGenRand                Error = 0x0A000012 // SAR_GENRANDERR
+	ErrHashNotEqual           Error = 0x0A00001A // SAR_HASHNOTEQUALERR
+	ErrKeyNotFound            Error = 0x0A00001B // SAR_KEYNOTFOUNTERR
+	ErrCertNotFound           Error = 0x0A00001C // SAR_CERTNOTFOUNTERR
+	ErrDecryptPad             Error = 0x0A00001E // SAR_DECRYPTPADERR
+	ErrPINIncorrect           Error = 0x0A000024 // SAR_PIN_INCORRECT
+	ErrPINLocked              Error = 0x0A000025 // SAR_PIN_LOCKED
+	ErrPINLenRange            Error = 0x0A000027 // SAR_PIN_LEN_RANGE
+	ErrUserTypeInvalid        Error = 0x0A00002A // SAR_USER_TYPE_INVALID
+	ErrApplicationNameInvalid Error = 0x0A00002B // SAR_APPLICATION_NAME_INVALID
+	ErrApplicationExists      Error = 0x0A00002C // SAR_APPLICATION_EXISTS
+	ErrUserNotLoggedIn        Error = 0x0A00002D // SAR_USER_NOT_LOGGED_IN
+	ErrApplicationNotExists   Error = 0x0A00002E // SAR_APPLICATION_NOT_EXISTS
+	ErrFileAlreadyExist       Error = 0x0A00002F // SAR_FILE_ALREADY_EXIST
+	ErrFileNotExist           Error = 0x0A000031 // SAR_FILE_NOT_EXIST
+)
+
+var errorNames = map[Error]string{
+	ErrFail:                   "failed",
+	ErrNotSupported:           "not supported",
+	ErrFile:                   "file error",
+	ErrInvalidHandle:          "invalid handle",
+	ErrInvalidParam:           "invalid parameter",
+	ErrNameLen:                "invalid name length",
+	ErrInDataLen:              "invalid input length",
+	ErrInData:                 "invalid input",
+	ErrGenRand:                "random number generation failed",
+	ErrHashNotEqual:           "hash mismatch",
+	ErrKeyNotFound:            "key not found",
+	ErrCertNotFound:           "certificate not found",
+	ErrDecryptPad:             "invalid padding",
+	ErrPINIncorrect:           "incorrect PIN",
+	ErrPINLocked:              "PIN locked",
+	ErrPINLenRange:            "invalid PIN length",
+	ErrUserTypeInvalid:        "invalid PIN type",
+	ErrApplicationNameInvalid: "invalid application name",
+	ErrApplicationExists:      "application exists",
+	ErrUserNotLoggedIn:        "user not logged in",
+	ErrApplicationNotExists:   "application does not exist",
+	ErrFileAlreadyExist:       "container exists",
+	ErrFileNotExist:           "container does not exist",
+}
+
+func (e Error) Error() string {
+	if name, ok := errorNames[e]; ok {
+		return "skf: " + name
+	}
+	return fmt.Sprintf("skf: error 0x%08x", uint32(e))
+}
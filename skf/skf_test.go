@@ -0,0 +1,215 @@
+package skf
+
+import (
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	adminPIN = "12345678"
+	userPIN  = "88888888"
+)
+
+func tempStore(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "skf")
+	assert.Nil(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return filepath.Join(dir, "key.json")
+}
+
+func newContainer(t *testing.T, path string) (Device, Application, Container) {
+	e, err := OpenEmulator(path)
+	assert.Nil(t, err)
+	var dev Device = e
+	app, err := dev.CreateApplication("wallet", adminPIN, 10, userPIN, 3)
+	assert.Nil(t, err)
+	_, err = app.CreateContainer("c1")
+	assert.Equal(t, ErrUserNotLoggedIn, err)
+	_, err = app.VerifyPIN(UserPIN, userPIN)
+	assert.Nil(t, err)
+	c, err := app.CreateContainer("c1")
+	assert.Nil(t, err)
+	return dev, app, c
+}
+
+func TestSignAndPersist(t *testing.T) {
+	path := tempStore(t)
+	dev, app, c := newContainer(t, path)
+	pub, err := c.GenECCKeyPair()
+	assert.Nil(t, err)
+	digest := gm.HashBeforeSM2(pub, []byte("transfer 10"))
+	sig, err := c.ECCSignData(digest)
+	assert.Nil(t, err)
+	ok, err := dev.ExtECCVerify(pub, digest, sig)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, _ = dev.ExtECCVerify(pub, gm.HashBeforeSM2(pub, []byte("transfer 11")), sig)
+	assert.False(t, ok)
+
+	assert.Nil(t, c.ImportCertificate(true, []byte("cert")))
+	assert.Nil(t, app.ClearSecureState())
+	_, err = c.ECCSignData(digest)
+	assert.Equal(t, ErrUserNotLoggedIn, err)
+	assert.Nil(t, dev.Close())
+	_, err = app.GetPINInfo(UserPIN)
+	assert.Equal(t, ErrInvalidHandle, err)
+
+	// a new connection finds the application, the container and the key
+	e, err := OpenEmulator(path)
+	assert.Nil(t, err)
+	names, _ := e.EnumApplication()
+	assert.Equal(t, []string{"wallet"}, names)
+	app, err = e.OpenApplication("wallet")
+	assert.Nil(t, err)
+	names, _ = app.EnumContainer()
+	assert.Equal(t, []string{"c1"}, names)
+	c, err = app.OpenContainer("c1")
+	assert.Nil(t, err)
+	again, err := c.ExportPublicKey(true)
+	assert.Nil(t, err)
+	assert.Equal(t, pub.X, again.X)
+	assert.Equal(t, pub.Y, again.Y)
+	cert, err := c.ExportCertificate(true)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("cert"), cert)
+	_, err = c.ExportCertificate(false)
+	assert.Equal(t, ErrCertNotFound, err)
+	_, err = c.ExportPublicKey(false)
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	_, _ = app.VerifyPIN(UserPIN, userPIN)
+	sig, err = c.ECCSignData(digest)
+	assert.Nil(t, err)
+	ok, _ = pub.Verify(nil, sig, digest)
+	assert.True(t, ok)
+}
+
+func TestPINRetry(t *testing.T) {
+	path := tempStore(t)
+	dev, app, _ := newContainer(t, path)
+	info, err := app.GetPINInfo(UserPIN)
+	assert.Nil(t, err)
+	assert.Equal(t, &PINInfo{MaxRetry: 3, Remaining: 3, Default: true}, info)
+
+	n, err := app.VerifyPIN(UserPIN, "00000000")
+	assert.Equal(t, ErrPINIncorrect, err)
+	assert.Equal(t, 2, n)
+	dev.Close()
+
+	// the counter survives a new connection
+	e, _ := OpenEmulator(path)
+	app, _ = e.OpenApplication("wallet")
+	n, err = app.VerifyPIN(UserPIN, "00000000")
+	assert.Equal(t, ErrPINIncorrect, err)
+	assert.Equal(t, 1, n)
+	n, err = app.VerifyPIN(UserPIN, userPIN)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, n)
+
+	for i := 0; i < 3; i++ {
+		n, err = app.VerifyPIN(UserPIN, "00000000")
+	}
+	assert.Equal(t, ErrPINLocked, err)
+	assert.Equal(t, 0, n)
+	_, err = app.VerifyPIN(UserPIN, userPIN)
+	assert.Equal(t, ErrPINLocked, err)
+
+	n, err = app.UnblockPIN("00000000", "66666666")
+	assert.Equal(t, ErrPINIncorrect, err)
+	assert.Equal(t, 9, n)
+	n, err = app.UnblockPIN(adminPIN, "66666666")
+	assert.Nil(t, err)
+	assert.Equal(t, 10, n)
+	_, err = app.VerifyPIN(UserPIN, "66666666")
+	assert.Nil(t, err)
+
+	_, err = app.ChangePIN(UserPIN, "66666666", "123")
+	assert.Equal(t, ErrPINLenRange, err)
+	_, err = app.ChangePIN(UserPIN, "66666666", "77777777")
+	assert.Nil(t, err)
+	info, _ = app.GetPINInfo(UserPIN)
+	assert.False(t, info.Default)
+	_, err = app.VerifyPIN(UserPIN, "77777777")
+	assert.Nil(t, err)
+	_, err = app.VerifyPIN(PINType(2), "77777777")
+	assert.Equal(t, ErrUserTypeInvalid, err)
+}
+
+func TestEnvelopedKeyImport(t *testing.T) {
+	_, _, c := newContainer(t, tempStore(t))
+	encKey, _ := gm.GenerateSM2Key()
+	env, _ := SealEnvelopedKey(&encKey.PublicKey, encKey, nil)
+	assert.Equal(t, ErrKeyNotFound, c.ImportECCKeyPair(env))
+
+	signPub, _ := c.GenECCKeyPair()
+	env, err := SealEnvelopedKey(signPub, encKey, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, c.ImportECCKeyPair(env))
+	pub, err := c.ExportPublicKey(false)
+	assert.Nil(t, err)
+	assert.Equal(t, encKey.PublicKey.X, pub.X)
+
+	// a session key sent to the encryption key is imported
+	sender, _, other := newContainer(t, tempStore(t))
+	wrapped, k1, err := other.ECCExportSessionKey(SM4CBC, pub)
+	assert.Nil(t, err)
+	k2, err := c.ImportSessionKey(SM4CBC, wrapped)
+	assert.Nil(t, err)
+	param := BlockCipherParam{IV: make([]byte, 16), Padding: true}
+	ct, err := k1.Encrypt(param, []byte("secret message"))
+	assert.Nil(t, err)
+	assert.Len(t, ct, 16)
+	pt, err := k2.Decrypt(param, ct)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret message"), pt)
+	_, err = k2.Encrypt(BlockCipherParam{IV: make([]byte, 16)}, []byte("short"))
+	assert.Equal(t, ErrInDataLen, err)
+	assert.Nil(t, k2.Close())
+	_, err = k2.Decrypt(param, ct)
+	assert.Equal(t, ErrInvalidHandle, err)
+	wrapped2, _ := sender.ExtECCEncrypt(pub, make([]byte, 16))
+	_, err = c.ImportSessionKey(SM4ECB, wrapped2)
+	assert.Nil(t, err)
+
+	// an envelope for another container or with a wrong public key fails
+	env, _ = SealEnvelopedKey(pub, encKey, nil)
+	assert.Equal(t, ErrInData, c.ImportECCKeyPair(env))
+	other2, _ := gm.GenerateSM2Key()
+	env, _ = SealEnvelopedKey(signPub, encKey, nil)
+	env.PublicKey = &other2.PublicKey
+	assert.Equal(t, ErrInData, c.ImportECCKeyPair(env))
+}
+
+func TestApplications(t *testing.T) {
+	e, err := OpenEmulator(tempStore(t))
+	assert.Nil(t, err)
+	_, err = e.CreateApplication("a", adminPIN, 10, "123", 3)
+	assert.Equal(t, ErrPINLenRange, err)
+	_, err = e.CreateApplication("", adminPIN, 10, userPIN, 3)
+	assert.Equal(t, ErrApplicationNameInvalid, err)
+	app, err := e.CreateApplication("a", adminPIN, 10, userPIN, 3)
+	assert.Nil(t, err)
+	_, err = e.CreateApplication("a", adminPIN, 10, userPIN, 3)
+	assert.Equal(t, ErrApplicationExists, err)
+	_, _ = e.CreateApplication("b", adminPIN, 10, userPIN, 3)
+	names, _ := e.EnumApplication()
+	assert.Equal(t, []string{"a", "b"}, names)
+
+	_, _ = app.VerifyPIN(UserPIN, userPIN)
+	_, _ = app.CreateContainer("x")
+	_, err = app.CreateContainer("x")
+	assert.Equal(t, ErrFileAlreadyExist, err)
+	assert.Nil(t, app.DeleteContainer("x"))
+	_, err = app.OpenContainer("x")
+	assert.Equal(t, ErrFileNotExist, err)
+
+	assert.Nil(t, e.DeleteApplication("a"))
+	_, err = app.EnumContainer()
+	assert.Equal(t, ErrApplicationNotExists, err)
+	_, err = e.OpenApplication("a")
+	assert.Equal(t, ErrApplicationNotExists, err)
+}
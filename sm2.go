@@ -38,13 +38,28 @@ func GenerateSM2Key() (*SM2PrivateKey, error) {
 	if err != nil {
 		return r, err
 	}
-	aa, b, c := tmp.D.Bytes(), tmp.X.Bytes(), tmp.Y.Bytes()
-	copy(r.K[32-len(aa):], aa)
+	tmp.D.FillBytes(r.K[:])
+	b, c := tmp.X.Bytes(), tmp.Y.Bytes()
 	copy(r.PublicKey.X[32-len(b):], b)
 	copy(r.PublicKey.Y[32-len(c):], c)
+	sm2.WipeInt(tmp.D)
 	return r, nil
 }
 
+//GenerateSM2KeyWithReader generates a key pair whose private key is read
+// from reader, e.g. a DRBG; the same reader output gives the same key.
+func GenerateSM2KeyWithReader(reader io.Reader) (*SM2PrivateKey, error) {
+	k, err := randFieldElement(sm2.Sm2(), reader)
+	if err != nil {
+		return nil, err
+	}
+	r := new(SM2PrivateKey)
+	r.PublicKey.Curve = sm2.Sm2()
+	k.FillBytes(r.K[:])
+	sm2.WipeInt(k)
+	return r.CalculatePublicKey(), nil
+}
+
 //GenerateSM2KeyForDH generate a key using sm2 for dh
 //idA is the ID of self, idB is the ID of another part, isInit indicates whether it is the initiator or not
 func GenerateSM2KeyForDH(idA, idB, randA []byte, privateKey, publicAX, publicAY, publicBX, publicBY *big.Int, RB *SM2PublicKey, isInit bool) (*big.Int, *big.Int, []byte, error) {
@@ -95,6 +110,7 @@ type SM2PrivateKey struct {
 }
 
 //Bytes return key bytes. Inverse method of FromBytes(K []byte, opt AlgorithmOption)
+// The result is a copy of the key, wipe it after use.
 func (key *SM2PrivateKey) Bytes() ([]byte, error) {
 	if key.K == zeroKey {
 		return nil, errors.New("SM2PrivateKey.K is nil")
@@ -122,6 +138,17 @@ func (key *SM2PrivateKey) Sign(k, digest []byte, reader io.Reader) ([]byte, erro
 	return sign, err
 }
 
+//Destroy wipes the private key, which can no longer be used. Copies made
+// by Bytes are not wiped.
+func (key *SM2PrivateKey) Destroy() {
+	wipe(key.K[:])
+}
+
+//Zeroize wipes the private key, it is the same as Destroy.
+func (key *SM2PrivateKey) Zeroize() {
+	key.Destroy()
+}
+
 //SetPublicKey Set the public key contained in the private key
 // when get a SM2PrivateKey by FromBytes(...), the public key contained is empty,
 // you should invoke SetPublicKey(...) or CalculatePublicKey().
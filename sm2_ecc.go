@@ -88,6 +88,26 @@ func Decrypt(priv *SM2PrivateKey, data []byte) ([]byte, error) {
 	return c, nil
 }
 
+var errInvalidCiphertext = errors.New("invalid sm2 ciphertext")
+
+//CiphertextToC1C3C2 reorders a ciphertext of Encrypt, 0x04 || C1 || C2 || C3,
+// as 0x04 || C1 || C3 || C2, the order of GB/T 32918.4-2016 and GM/T 0009.
+func CiphertextToC1C3C2(c []byte) ([]byte, error) {
+	if len(c) < 1+64+32 || c[0] != 4 {
+		return nil, errInvalidCiphertext
+	}
+	return bytes.Join([][]byte{c[:65], c[len(c)-32:], c[65 : len(c)-32]}, nil), nil
+}
+
+//CiphertextFromC1C3C2 is the inverse of CiphertextToC1C3C2, its result can
+// be passed to Decrypt.
+func CiphertextFromC1C3C2(c []byte) ([]byte, error) {
+	if len(c) < 1+64+32 || c[0] != 4 {
+		return nil, errInvalidCiphertext
+	}
+	return bytes.Join([][]byte{c[:65], c[97:], c[65:97]}, nil), nil
+}
+
 var one = big.NewInt(1)
 
 func randFieldElement(c elliptic.Curve, rand io.Reader) (k *big.Int, err error) {
@@ -97,9 +117,11 @@ func randFieldElement(c elliptic.Curve, rand io.Reader) (k *big.Int, err error)
 	if err != nil {
 		return
 	}
-	k = new(big.Int).SetBytes(b)
+	x := new(big.Int).SetBytes(b)
+	wipe(b)
 	n := new(big.Int).Sub(params.N, one)
-	k.Mod(k, n)
+	k = new(big.Int).Mod(x, n)
+	sm2.WipeInt(x)
 	k.Add(k, one)
 	return
 }
@@ -49,3 +49,26 @@ func TestDecrypt(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, string(out), "123456789012345678901234567890123456")
 }
+
+func TestCiphertextC1C3C2(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	for _, msg := range [][]byte{[]byte("a"), message} {
+		c, err := Encrypt(&key.PublicKey, msg, rand.Reader)
+		assert.Nil(t, err)
+		c132, err := CiphertextToC1C3C2(c)
+		assert.Nil(t, err)
+		assert.Equal(t, c[:65], c132[:65])
+		assert.Equal(t, c[len(c)-32:], c132[65:97])
+		assert.Equal(t, c[65:len(c)-32], c132[97:])
+		back, err := CiphertextFromC1C3C2(c132)
+		assert.Nil(t, err)
+		assert.Equal(t, c, back)
+	}
+	for _, bad := range [][]byte{nil, make([]byte, 96), append([]byte{2}, make([]byte, 100)...)} {
+		_, err := CiphertextToC1C3C2(bad)
+		assert.NotNil(t, err)
+		_, err = CiphertextFromC1C3C2(bad)
+		assert.NotNil(t, err)
+	}
+}
@@ -0,0 +1,211 @@
+package gm
+
+import (
+	std "crypto"
+	"crypto/rand"
+	"errors"
+	"github.com/meshplus/crypto-gm/internal/sm2"
+	"io"
+	"math/big"
+	"sync"
+)
+
+//SM2PrecomputedSigner signs with nonces computed ahead of the message: SM2
+// lets k and (x1, y1) = [k]G be computed before the digest is known, so a
+// goroutine keeps a bounded pool of them and signing a digest only takes a
+// few modular operations. Each nonce is taken from the pool once and wiped
+// after use; when the pool is empty a nonce is computed on the spot.
+//
+// The signer holds its own copy of the private key. Close stops the
+// goroutine and wipes the pool and the copy; it must be called.
+type SM2PrecomputedSigner struct {
+	pub SM2PublicKey
+
+	readerMu sync.Mutex // the filler and the Sign fallbacks share reader
+	reader   io.Reader
+
+	mu     sync.RWMutex // held for writing by Close while wiping
+	d      *big.Int
+	dInv   *big.Int // (1 + d)^-1 mod n
+	closed bool
+
+	pool chan *nonce
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// nonce is k with the x-coordinate of [k]G and the flag of SignBatch.
+type nonce struct {
+	k, x1 *big.Int
+	flag  uint8
+}
+
+func (n *nonce) wipe() {
+	sm2.WipeInt(n.k)
+}
+
+//NewSM2PrecomputedSigner returns a signer by key keeping up to size nonces
+// generated from reader, crypto/rand.Reader if nil. The signer serializes
+// its reads, reader need not be safe for concurrent use.
+func NewSM2PrecomputedSigner(key *SM2PrivateKey, size int, reader io.Reader) (*SM2PrecomputedSigner, error) {
+	if size <= 0 {
+		return nil, errors.New("nonce pool size must be positive")
+	}
+	if reader == nil {
+		reader = rand.Reader
+	}
+	n := sm2.Sm2().Params().N
+	d := new(big.Int).SetBytes(key.K[:])
+	if d.Sign() == 0 || d.Cmp(new(big.Int).Sub(n, big.NewInt(1))) >= 0 {
+		sm2.WipeInt(d)
+		return nil, errors.New("invalid sm2 private key")
+	}
+	dInv := new(big.Int).Add(d, big.NewInt(1))
+	dInv.ModInverse(dInv, n)
+	s := &SM2PrecomputedSigner{
+		pub:    *key.Public().(*SM2PublicKey),
+		reader: reader,
+		d:      d,
+		dInv:   dInv,
+		pool:   make(chan *nonce, size),
+		done:   make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.fill()
+	return s, nil
+}
+
+// newNonce returns a random k in [1, n-1] with [k]G.
+func (s *SM2PrecomputedSigner) newNonce() (*nonce, error) {
+	curve := sm2.Sm2().Params()
+	s.readerMu.Lock()
+	k, err := rand.Int(s.reader, curve.N)
+	s.readerMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if k.Sign() == 0 {
+		return nil, errors.New("zero rander")
+	}
+	kb := k.FillBytes(make([]byte, 32))
+	x1, y1 := sm2.Sm2().ScalarBaseMult(kb)
+	wipe(kb)
+	var flag uint8
+	if y1.Cmp(new(big.Int).Sub(curve.P, y1)) > 0 {
+		flag = 1
+	}
+	return &nonce{k: k, x1: x1, flag: flag}, nil
+}
+
+// fill keeps the pool full until Close, or until the reader fails.
+func (s *SM2PrecomputedSigner) fill() {
+	defer s.wg.Done()
+	for {
+		n, err := s.newNonce()
+		if err != nil {
+			return
+		}
+		select {
+		case s.pool <- n:
+		case <-s.done:
+			n.wipe()
+			return
+		}
+	}
+}
+
+// take returns a nonce of the pool, or a new one when it is empty.
+func (s *SM2PrecomputedSigner) take() (*nonce, error) {
+	select {
+	case n := <-s.pool:
+		return n, nil
+	default:
+		return s.newNonce()
+	}
+}
+
+//Public returns the public key.
+func (s *SM2PrecomputedSigner) Public() std.PublicKey {
+	pub := s.pub
+	return &pub
+}
+
+//Sign returns the DER signature of digest, as SM2PrivateKey.Sign. The first
+// parameter and reader are ignored.
+func (s *SM2PrecomputedSigner) Sign(_, digest []byte, _ io.Reader) ([]byte, error) {
+	sig, _, err := s.sign(digest)
+	return sig, err
+}
+
+//SignBatch returns the signature of digest with the flag byte first, as
+// SM2PrivateKey.SignBatch.
+func (s *SM2PrecomputedSigner) SignBatch(_, digest []byte, _ io.Reader) ([]byte, error) {
+	sig, flag, err := s.sign(digest)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{flag}, sig...), nil
+}
+
+func (s *SM2PrecomputedSigner) sign(digest []byte) ([]byte, uint8, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return nil, 0, errors.New("sm2 signer is closed")
+	}
+	e := new(big.Int).SetBytes(digest)
+	for {
+		k, err := s.take()
+		if err != nil {
+			return nil, 0, err
+		}
+		if sig, err := s.signWith(e, k); sig != nil || err != nil {
+			return sig, k.flag, err
+		}
+	}
+}
+
+// signWith signs e with the nonce k, which it wipes. It returns nil when k
+// does not make a valid signature: r = e + x1 and s = (1 + d)^-1 (k - r d)
+// need r != 0, r + k != n and s != 0.
+func (s *SM2PrecomputedSigner) signWith(e *big.Int, k *nonce) ([]byte, error) {
+	defer k.wipe()
+	n := sm2.Sm2().Params().N
+	r, t, sig := new(big.Int), new(big.Int), new(big.Int)
+	defer sm2.WipeInt(t)
+	r.Add(e, k.x1).Mod(r, n)
+	t.Add(r, k.k)
+	if r.Sign() == 0 || t.Cmp(n) == 0 {
+		return nil, nil
+	}
+	t.Mul(r, s.d)
+	t.Sub(k.k, t)
+	sig.Mul(s.dInv, t).Mod(sig, n)
+	if sig.Sign() == 0 {
+		return nil, nil
+	}
+	return MarshalSignature(r, sig)
+}
+
+//Close stops precomputing and wipes the pooled nonces and the private key.
+// The signer can no longer be used.
+func (s *SM2PrecomputedSigner) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.done)
+	s.wg.Wait()
+	for {
+		select {
+		case n := <-s.pool:
+			n.wipe()
+		default:
+			sm2.WipeInt(s.d)
+			sm2.WipeInt(s.dInv)
+			return
+		}
+	}
+}
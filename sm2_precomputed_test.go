@@ -0,0 +1,153 @@
+package gm
+
+import (
+	"bufio"
+	"crypto/rand"
+	"github.com/meshplus/crypto-gm/internal/sm2"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestSM2PrecomputedSigner(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	s, err := NewSM2PrecomputedSigner(key, 8, nil)
+	assert.Nil(t, err)
+	defer s.Close()
+	pub := s.Public().(*SM2PublicKey)
+	assert.Equal(t, key.PublicKey.X, pub.X)
+
+	digest := HashBeforeSM2(pub, []byte("precomputed"))
+	seen := make(map[string]bool)
+	for i := 0; i < 64; i++ {
+		sig, err := s.Sign(nil, digest, nil)
+		assert.Nil(t, err)
+		ok, err := pub.Verify(nil, sig, digest)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		// r = e + x1 repeats only if a nonce is used twice
+		raw, err := SignatureToRaw(sig)
+		assert.Nil(t, err)
+		assert.False(t, seen[string(raw[:32])])
+		seen[string(raw[:32])] = true
+	}
+
+	batch, err := s.SignBatch(nil, digest, nil)
+	assert.Nil(t, err)
+	der, flag, err := SignatureFromBatch(batch)
+	assert.Nil(t, err)
+	ok, err := pub.Verify(nil, der, digest)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	// the flag tells whether y1 > p - y1, with (x1, y1) = [s]G + [r+s]P
+	r, sv, err := ParseSignature(der)
+	assert.Nil(t, err)
+	curve := sm2.Sm2()
+	params := curve.Params()
+	x, y := curve.ScalarBaseMult(sv.Bytes())
+	u := new(big.Int).Add(r, sv)
+	px, py := new(big.Int).SetBytes(pub.X[:]), new(big.Int).SetBytes(pub.Y[:])
+	x2, y2 := curve.ScalarMult(px, py, u.Mod(u, params.N).Bytes())
+	_, y1 := curve.Add(x, y, x2, y2)
+	var want uint8
+	if y1.Cmp(new(big.Int).Sub(params.P, y1)) > 0 {
+		want = 1
+	}
+	assert.Equal(t, want, flag)
+}
+
+func TestSM2PrecomputedSignerConcurrent(t *testing.T) {
+	key, _ := GenerateSM2Key()
+	s, err := NewSM2PrecomputedSigner(key, 2, nil)
+	assert.Nil(t, err)
+	digest := HashBeforeSM2(&key.PublicKey, []byte("burst"))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 8; j++ {
+				sig, err := s.Sign(nil, digest, nil)
+				assert.Nil(t, err)
+				ok, _ := key.PublicKey.Verify(nil, sig, digest)
+				assert.True(t, ok)
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Close()
+	s.Close()
+	_, err = s.Sign(nil, digest, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(s.pool))
+	assert.Equal(t, 0, s.d.Sign())
+}
+
+func TestSM2PrecomputedSignerSharedReader(t *testing.T) {
+	// a bufio.Reader is not safe for concurrent use, run with -race
+	key, _ := GenerateSM2Key()
+	s, err := NewSM2PrecomputedSigner(key, 1, bufio.NewReader(rand.Reader))
+	assert.Nil(t, err)
+	defer s.Close()
+	digest := HashBeforeSM2(&key.PublicKey, []byte("shared reader"))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 8; j++ {
+				sig, err := s.Sign(nil, digest, nil)
+				assert.Nil(t, err)
+				ok, _ := key.PublicKey.Verify(nil, sig, digest)
+				assert.True(t, ok)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSM2PrecomputedSignerWipe(t *testing.T) {
+	key, _ := GenerateSM2Key()
+	s, err := NewSM2PrecomputedSigner(key, 4, nil)
+	assert.Nil(t, err)
+	defer s.Close()
+	n, err := s.newNonce()
+	assert.Nil(t, err)
+	sig, err := s.signWith(big.NewInt(1), n)
+	assert.Nil(t, err)
+	assert.NotNil(t, sig)
+	assert.Equal(t, 0, n.k.Sign())
+	// r = e + x1 = 0
+	n, _ = s.newNonce()
+	sig, err = s.signWith(new(big.Int).Sub(GetSm2Curve().Params().N, n.x1), n)
+	assert.Nil(t, err)
+	assert.Nil(t, sig)
+	assert.Equal(t, 0, n.k.Sign())
+
+	_, err = NewSM2PrecomputedSigner(key, 0, nil)
+	assert.NotNil(t, err)
+	_, err = NewSM2PrecomputedSigner(new(SM2PrivateKey), 1, nil)
+	assert.NotNil(t, err)
+}
+
+func BenchmarkPrecomputedSign(b *testing.B) {
+	key, _ := GenerateSM2Key()
+	s, _ := NewSM2PrecomputedSigner(key, 256, nil)
+	defer s.Close()
+	digest := HashBeforeSM2(&key.PublicKey, []byte("benchmark"))
+	for i := 0; i < b.N; i++ {
+		// measure bursts served from the pool
+		if len(s.pool) == 0 {
+			b.StopTimer()
+			for len(s.pool) < cap(s.pool) {
+				runtime.Gosched()
+			}
+			b.StartTimer()
+		}
+		s.Sign(nil, digest, nil)
+	}
+}
@@ -0,0 +1,62 @@
+package gm
+
+import (
+	"errors"
+	"github.com/meshplus/crypto-gm/internal/sm2"
+	"github.com/meshplus/crypto-gm/internal/sm3"
+)
+
+//PreparedPublicKey is an SM2 public key prepared for verifying many
+// signatures: Z of its user id is computed once, and a table of multiples of
+// the key, about 100 KB, replaces the variable-base multiplication of each
+// verification with a fixed-base one. It is safe for concurrent use.
+type PreparedPublicKey struct {
+	pub   SM2PublicKey
+	z     []byte
+	table *sm2.PublicKeyTable
+}
+
+//NewPreparedPublicKey prepares pub for the user id uid, the default
+// 1234567812345678 when uid is nil.
+func NewPreparedPublicKey(pub *SM2PublicKey, uid []byte) (*PreparedPublicKey, error) {
+	if uid == nil {
+		uid = []byte("1234567812345678")
+	}
+	z, err := ComputeZ(pub, uid)
+	if err != nil {
+		return nil, err
+	}
+	table, err := sm2.NewPublicKeyTable(pub.X[:], pub.Y[:])
+	if err != nil {
+		return nil, errors.New("sm2 public key is not on the curve")
+	}
+	p := &PreparedPublicKey{pub: *pub, z: z, table: table}
+	p.pub.Curve = sm2.Sm2()
+	return p, nil
+}
+
+//PublicKey returns the public key.
+func (p *PreparedPublicKey) PublicKey() *SM2PublicKey {
+	pub := p.pub
+	return &pub
+}
+
+//Hash returns the digest of msg to sign or verify, SM3(Z || msg), as
+// HashBeforeSM2WithID.
+func (p *PreparedPublicKey) Hash(msg []byte) []byte {
+	h := sm3.New()
+	_, _ = h.Write(p.z)
+	_, _ = h.Write(msg)
+	return h.Sum(nil)
+}
+
+//Verify verifies the signature of a digest, as SM2PublicKey.Verify, so the
+// first parameter will be ignored.
+func (p *PreparedPublicKey) Verify(_, signature, digest []byte) (valid bool, err error) {
+	return sm2.VerifySignatureWithTable(signature, digest, p.table)
+}
+
+//VerifyMessage verifies the signature of msg, hashed with Hash.
+func (p *PreparedPublicKey) VerifyMessage(msg, signature []byte) (valid bool, err error) {
+	return p.Verify(nil, signature, p.Hash(msg))
+}
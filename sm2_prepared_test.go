@@ -0,0 +1,74 @@
+package gm
+
+import (
+	"crypto/rand"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPreparedPublicKey(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	p, err := NewPreparedPublicKey(&key.PublicKey, nil)
+	assert.Nil(t, err)
+	msg := []byte("prepared")
+	assert.Equal(t, HashBeforeSM2(&key.PublicKey, msg), p.Hash(msg))
+
+	for i := 0; i < 32; i++ {
+		sig, err := key.Sign(nil, p.Hash(msg), rand.Reader)
+		assert.Nil(t, err)
+		ok, err := p.VerifyMessage(msg, sig)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		batch, err := key.SignBatch(nil, p.Hash(msg), rand.Reader)
+		assert.Nil(t, err)
+		ok, err = p.VerifyMessage(msg, batch)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+
+		sig[len(sig)-1] ^= 1
+		ok, _ = p.VerifyMessage(msg, sig)
+		assert.False(t, ok)
+		sig[len(sig)-1] ^= 1
+		ok, _ = p.VerifyMessage([]byte("other"), sig)
+		assert.False(t, ok)
+	}
+
+	other, _ := GenerateSM2Key()
+	sig, _ := other.Sign(nil, p.Hash(msg), rand.Reader)
+	ok, _ := p.VerifyMessage(msg, sig)
+	assert.False(t, ok)
+
+	id := []byte("node1@example.com")
+	p, err = NewPreparedPublicKey(&key.PublicKey, id)
+	assert.Nil(t, err)
+	digest, _ := HashBeforeSM2WithID(&key.PublicKey, id, msg)
+	assert.Equal(t, digest, p.Hash(msg))
+	sig, _ = key.Sign(nil, digest, rand.Reader)
+	ok, err = p.Verify(nil, sig, digest)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, key.PublicKey.X, p.PublicKey().X)
+
+	bad := key.PublicKey
+	bad.Y = bad.X
+	_, err = NewPreparedPublicKey(&bad, nil)
+	assert.NotNil(t, err)
+}
+
+func BenchmarkPreparedVerify(b *testing.B) {
+	key, _ := GenerateSM2Key()
+	msg := []byte("benchmark")
+	sig, _ := key.Sign(nil, HashBeforeSM2(&key.PublicKey, msg), rand.Reader)
+	b.Run("SM2PublicKey", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			key.PublicKey.Verify(nil, sig, HashBeforeSM2(&key.PublicKey, msg))
+		}
+	})
+	p, _ := NewPreparedPublicKey(&key.PublicKey, nil)
+	b.Run("PreparedPublicKey", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			p.VerifyMessage(msg, sig)
+		}
+	})
+}
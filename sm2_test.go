@@ -30,6 +30,19 @@ func TestGenerateSM2Key(t *testing.T) {
 	}
 }
 
+func TestGenerateSM2KeyWithReader(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x5a}, 40)
+	key, err := GenerateSM2KeyWithReader(bytes.NewReader(seed))
+	assert.Nil(t, err)
+	again, err := GenerateSM2KeyWithReader(bytes.NewReader(seed))
+	assert.Nil(t, err)
+	assert.Equal(t, key, again)
+	assert.True(t, sm2.Sm2().IsOnCurve(new(big.Int).SetBytes(key.PublicKey.X[:]), new(big.Int).SetBytes(key.PublicKey.Y[:])))
+
+	_, err = GenerateSM2KeyWithReader(bytes.NewReader(seed[:39]))
+	assert.NotNil(t, err)
+}
+
 func TestGenerateSM2KeyForDH(t *testing.T) {
 	privateA, err := GenerateSM2Key()
 	assert.Nil(t, err)
@@ -99,7 +112,11 @@ func TestSM2PublicKey_PublicKey(t *testing.T) {
 	assert.True(t, b)
 }
 func TestSignAndVerify(t *testing.T) {
-	for i := 0; i < 0xffff; i++ {
+	n := 0xffff
+	if testing.Short() {
+		n = 0xff
+	}
+	for i := 0; i < n; i++ {
 		priv, err := GenerateSM2Key()
 		assert.Nil(t, err)
 		pub := priv.PublicKey
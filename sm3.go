@@ -1,6 +1,10 @@
 package gm
 
 import (
+	"bytes"
+	"encoding"
+	"errors"
+	"github.com/meshplus/crypto-gm/internal/sm2"
 	"github.com/meshplus/crypto-gm/internal/sm3"
 	"hash"
 )
@@ -35,6 +39,48 @@ func (h *Hasher) BlockSize() int {
 	return h.inner.BlockSize()
 }
 
+// hasherMagic identifies a marshaled Hasher state, its last byte is the
+// version of the format.
+const hasherMagic = "sm3h\x01"
+
+//MarshalBinary implements encoding.BinaryMarshaler, the state can be
+// restored by UnmarshalBinary to continue hashing, e.g. after a restart. The
+// state is the magic "sm3h" and a version byte, the dirty flag of Hash and
+// BatchHash and the state of the inner SM3.
+func (h *Hasher) MarshalBinary() ([]byte, error) {
+	inner, err := h.inner.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, len(hasherMagic)+1+len(inner))
+	b = append(b, hasherMagic...)
+	b = append(b, 0)
+	if h.dirty {
+		b[len(b)-1] = 1
+	}
+	return append(b, inner...), nil
+}
+
+//UnmarshalBinary implements encoding.BinaryUnmarshaler, it restores a state
+// written by MarshalBinary.
+func (h *Hasher) UnmarshalBinary(b []byte) error {
+	n := len(hasherMagic) - 1
+	if len(b) < n || string(b[:n]) != hasherMagic[:n] {
+		return errors.New("sm3: invalid hash state identifier")
+	}
+	if len(b) < n+1 || b[n] != hasherMagic[n] {
+		return errors.New("sm3: unsupported hash state version")
+	}
+	if len(b) < n+2 || b[n+1] > 1 {
+		return errors.New("sm3: invalid hash state")
+	}
+	if err := h.inner.(encoding.BinaryUnmarshaler).UnmarshalBinary(b[n+2:]); err != nil {
+		return err
+	}
+	h.dirty = b[n+1] == 1
+	return nil
+}
+
 //NewSM3Hasher instruct a SM# Hasher
 func NewSM3Hasher() *Hasher {
 	return &Hasher{
@@ -76,6 +122,39 @@ func HashBeforeSM2(pub *SM2PublicKey, msg []byte) []byte {
 	return sm3.SignHashSM3(pub.X[:], pub.Y[:], msg)
 }
 
+//HashBeforeSM2WithID is HashBeforeSM2 with a user id other than the default 1234567812345678.
+// ENTL is two bytes long, so id must be shorter than 8192 bytes.
+func HashBeforeSM2WithID(pub *SM2PublicKey, id, msg []byte) ([]byte, error) {
+	za, err := ComputeZ(pub, id)
+	if err != nil {
+		return nil, err
+	}
+	h := sm3.New()
+	_, _ = h.Write(za)
+	_, _ = h.Write(msg)
+	return h.Sum(nil), nil
+}
+
+//ComputeZ returns Z, the SM3 hash of the bit length of the user id, the id,
+// the curve and the public key, which HashBeforeSM2WithID hashes before the
+// message. id must be shorter than 8192 bytes.
+func ComputeZ(pub *SM2PublicKey, id []byte) ([]byte, error) {
+	if len(id) >= 1<<13 {
+		return nil, errors.New("sm2 user id is too long")
+	}
+	params := sm2.Sm2().Params()
+	return sm3.Hash(bytes.Join([][]byte{intToBytes(len(id) * 8)[2:], id, a,
+		params.B.Bytes(), params.Gx.Bytes(), params.Gy.Bytes(), pub.X[:], pub.Y[:]}, nil)), nil
+}
+
+//SM3HashMany returns the SM3 hashes of msgs, hashing eight messages in
+// parallel with AVX2 on amd64, and four with SSE2 on amd64 without AVX2 and
+// with NEON on arm64. With the gmnosam tag and on every other GOARCH the
+// messages are hashed one after the other, at the speed of Hash.
+func SM3HashMany(msgs [][]byte) [][32]byte {
+	return sm3.HashMany(msgs)
+}
+
 //GetSM3Hasher get hash.Hash
 func GetSM3Hasher() hash.Hash {
 	return sm3.New()
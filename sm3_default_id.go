@@ -1,6 +1,8 @@
 package gm
 
 import (
+	"encoding"
+	"errors"
 	"fmt"
 	"github.com/meshplus/crypto-gm/internal/sm3"
 	"hash"
@@ -100,3 +102,53 @@ func (h *IDHasher) cleanIfDirty() {
 		h.inner.Reset()
 	}
 }
+
+const (
+	// idHasherMagic identifies a marshaled IDHasher state, its last byte is
+	// the version of the format.
+	idHasherMagic = "sm3i\x01"
+	idHasherHead  = len(idHasherMagic) + 2 + 65
+)
+
+//MarshalBinary implements encoding.BinaryMarshaler. The state is the magic
+// "sm3i" and a version byte, the public key index and dirty flag, the public
+// key buffer and the state of the inner SM3.
+func (h *IDHasher) MarshalBinary() ([]byte, error) {
+	inner, err := h.inner.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, idHasherHead+len(inner))
+	b = append(b, idHasherMagic...)
+	b = append(b, h.index, 0)
+	if h.dirty {
+		b[len(b)-1] = 1
+	}
+	b = append(b, h.sm2PkBuf[:]...)
+	return append(b, inner...), nil
+}
+
+//UnmarshalBinary implements encoding.BinaryUnmarshaler, it restores a state
+// written by MarshalBinary.
+func (h *IDHasher) UnmarshalBinary(b []byte) error {
+	n := len(idHasherMagic) - 1
+	if len(b) < n || string(b[:n]) != idHasherMagic[:n] {
+		return errors.New("sm3: invalid hash state identifier")
+	}
+	if len(b) < n+1 || b[n] != idHasherMagic[n] {
+		return errors.New("sm3: unsupported hash state version")
+	}
+	if len(b) < idHasherHead {
+		return errors.New("sm3: invalid hash state size")
+	}
+	index, dirty := b[n+1], b[n+2]
+	if index > 65 || dirty > 1 {
+		return errors.New("sm3: invalid hash state")
+	}
+	if err := h.inner.(encoding.BinaryUnmarshaler).UnmarshalBinary(b[idHasherHead:]); err != nil {
+		return err
+	}
+	h.index, h.dirty = index, dirty == 1
+	copy(h.sm2PkBuf[:], b[n+3:idHasherHead])
+	return nil
+}
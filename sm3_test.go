@@ -3,10 +3,12 @@ package gm
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding"
 	"encoding/hex"
 	"fmt"
 	"github.com/meshplus/crypto-gm/internal/sm3"
 	"github.com/stretchr/testify/assert"
+	"math/big"
 	"os"
 	"os/exec"
 	"testing"
@@ -61,6 +63,53 @@ func TestHashBeforeSM2(t *testing.T) {
 		})
 	}
 }
+func TestHashBeforeSM2WithID(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	hash, err := HashBeforeSM2WithID(&key.PublicKey, []byte("1234567812345678"), []byte(msg))
+	assert.Nil(t, err)
+	assert.Equal(t, HashBeforeSM2(&key.PublicKey, []byte(msg)), hash)
+
+	hash, err = HashBeforeSM2WithID(&key.PublicKey, []byte("ALICE123@YAHOO.COM"), []byte(msg))
+	assert.Nil(t, err)
+	assert.NotEqual(t, HashBeforeSM2(&key.PublicKey, []byte(msg)), hash)
+	s, err := key.Sign(nil, hash, rand.Reader)
+	assert.Nil(t, err)
+	b, err := key.PublicKey.Verify(nil, s, hash)
+	assert.True(t, b)
+	assert.Nil(t, err)
+
+	_, err = HashBeforeSM2WithID(&key.PublicKey, make([]byte, 8192), []byte(msg))
+	assert.NotNil(t, err)
+}
+func TestComputeZ(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	id := []byte("ALICE123@YAHOO.COM")
+	z, err := ComputeZ(&key.PublicKey, id)
+	assert.Nil(t, err)
+
+	params := GetSm2Curve().Params()
+	h := GetSM3Hasher()
+	_, _ = h.Write([]byte{0, byte(len(id) * 8)})
+	_, _ = h.Write(id)
+	for _, v := range []*big.Int{new(big.Int).Sub(params.P, big.NewInt(3)), params.B, params.Gx, params.Gy} {
+		_, _ = h.Write(v.FillBytes(make([]byte, 32)))
+	}
+	_, _ = h.Write(key.PublicKey.X[:])
+	_, _ = h.Write(key.PublicKey.Y[:])
+	assert.Equal(t, h.Sum(nil), z)
+
+	h.Reset()
+	_, _ = h.Write(z)
+	_, _ = h.Write([]byte(msg))
+	digest, err := HashBeforeSM2WithID(&key.PublicKey, id, []byte(msg))
+	assert.Nil(t, err)
+	assert.Equal(t, h.Sum(nil), digest)
+
+	_, err = ComputeZ(&key.PublicKey, make([]byte, 8192))
+	assert.NotNil(t, err)
+}
 func TestHasher_BatchHash(t *testing.T) {
 	msg1 := bytes.Repeat([]byte("abcd"), 7)
 	msg2 := bytes.Repeat([]byte("abcd"), 9)
@@ -176,3 +225,93 @@ func TestSM3Hash(t *testing.T) {
 	}
 	removeFile()
 }
+
+func TestHasher_MarshalBinary(t *testing.T) {
+	msg := make([]byte, 1000)
+	_, _ = rand.Read(msg)
+	want, _ := NewSM3Hasher().Hash(msg)
+
+	for _, split := range []int{0, 17, 64, 500, 1000} {
+		h := NewSM3Hasher()
+		_, _ = h.Write(msg[:split])
+		state, err := h.MarshalBinary()
+		assert.Nil(t, err)
+
+		resumed := NewSM3Hasher()
+		assert.Nil(t, resumed.UnmarshalBinary(state))
+		_, _ = resumed.Write(msg[split:])
+		assert.Equal(t, want, resumed.Sum(nil), "split at %d", split)
+	}
+	h := NewSM3Hasher()
+	state, _ := h.MarshalBinary()
+	assert.EqualError(t, h.UnmarshalBinary([]byte("sm3")), "sm3: invalid hash state identifier")
+	assert.EqualError(t, h.UnmarshalBinary([]byte("sm3h\x02")), "sm3: unsupported hash state version")
+	assert.EqualError(t, h.UnmarshalBinary([]byte("sm3h\x01\x02")), "sm3: invalid hash state")
+	assert.NotNil(t, h.UnmarshalBinary(state[:len(state)-1]))
+}
+
+func TestHasher_MarshalBinaryAfterHash(t *testing.T) {
+	for _, batch := range []bool{false, true} {
+		h := NewSM3Hasher()
+		if batch {
+			_, _ = h.BatchHash([][]byte{[]byte("old"), []byte("state")})
+		} else {
+			_, _ = h.Hash([]byte("old state"))
+		}
+		state, err := h.MarshalBinary()
+		assert.Nil(t, err)
+
+		resumed := NewSM3Hasher()
+		assert.Nil(t, resumed.UnmarshalBinary(state))
+		want, _ := h.Hash([]byte("next"))
+		got, _ := resumed.Hash([]byte("next"))
+		assert.Equal(t, want, got)
+		one := GetSM3Hasher()
+		_, _ = one.Write([]byte("next"))
+		assert.Equal(t, one.Sum(nil), got)
+	}
+}
+
+func TestIDHasher_MarshalBinary(t *testing.T) {
+	key, err := GenerateSM2Key()
+	assert.Nil(t, err)
+	pk, _ := key.PublicKey.Bytes()
+	msg := make([]byte, 300)
+	_, _ = rand.Read(msg)
+	input := append(append([]byte(nil), pk...), msg...)
+
+	one := NewSM3IDHasher()
+	_, _ = one.Write(input)
+	want := one.Sum(nil)
+	assert.Equal(t, HashBeforeSM2(&key.PublicKey, msg), want)
+
+	// splits inside the public key, right after it and in the message
+	for _, split := range []int{0, 1, 30, 64, 65, 66, 200, len(input)} {
+		h := NewSM3IDHasher()
+		_, _ = h.Write(input[:split])
+		state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+		assert.Nil(t, err)
+
+		resumed := NewSM3IDHasher()
+		assert.Nil(t, resumed.(encoding.BinaryUnmarshaler).UnmarshalBinary(state))
+		_, _ = resumed.Write(input[split:])
+		assert.Equal(t, want, resumed.Sum(nil), "split at %d", split)
+	}
+
+	h := NewSM3IDHasher().(*IDHasher)
+	state, _ := h.MarshalBinary()
+	assert.EqualError(t, h.UnmarshalBinary(state[:3]), "sm3: invalid hash state identifier")
+	assert.EqualError(t, h.UnmarshalBinary([]byte("sm3i\x02")), "sm3: unsupported hash state version")
+	assert.EqualError(t, h.UnmarshalBinary(state[:20]), "sm3: invalid hash state size")
+	assert.NotNil(t, h.UnmarshalBinary(state[:len(state)-1]))
+}
+
+func TestSM3HashMany(t *testing.T) {
+	msgs := [][]byte{[]byte("abc"), bytes.Repeat([]byte("abcd"), 16), {}}
+	sums := SM3HashMany(msgs)
+	assert.Len(t, sums, len(msgs))
+	for i, msg := range msgs {
+		h, _ := NewSM3Hasher().Hash(msg)
+		assert.Equal(t, h, sums[i][:])
+	}
+}
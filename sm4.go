@@ -39,6 +39,16 @@ func (t SM4Key) FromBytes(k []byte, opt interface{}) []byte {
 	return t
 }
 
+//Destroy wipes the key, which can no longer be used.
+func (t SM4Key) Destroy() {
+	wipe(t)
+}
+
+//Zeroize wipes the key, it is the same as Destroy.
+func (t SM4Key) Zeroize() {
+	t.Destroy()
+}
+
 //Sm4EncryptCBC encrypt with sm4, use CBC mode with iv
 //iv is
 func Sm4EncryptCBC(key, originMsg []byte, randReader io.Reader) ([]byte, error) {
@@ -0,0 +1,230 @@
+package gm
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+//MACAlgorithm is a block cipher MAC algorithm of GB/T 15852.1-2020
+// (ISO/IEC 9797-1:2011).
+type MACAlgorithm int
+
+//MAC algorithms of GB/T 15852.1. K is the first key, K' the second and K''
+// the third one, all given by the caller. To match the appendix of the
+// standard, pass K' xor 0xf0 as K'' of MacDES, and the SM4 encryptions of 1
+// and 2 under a key as K and K' of LMAC.
+const (
+	MACAlgorithm1 MACAlgorithm = iota + 1 // CBC-MAC, key K
+	MACAlgorithm2                         // EMAC, the result encrypted with K'
+	MACAlgorithm3                         // ANSI retail MAC, decrypted with K' then encrypted with K
+	MACAlgorithm4                         // MacDES, the first block encrypted again with K'' and the result with K'
+	MACAlgorithm5                         // CMAC, key K
+	MACAlgorithm6                         // LMAC, the last block encrypted with K'
+)
+
+//MACPadding is a padding method of GB/T 15852.1.
+type MACPadding int
+
+//Padding methods of GB/T 15852.1.
+const (
+	MACPadding1 MACPadding = iota + 1 // zeros, an empty message is one zero block
+	MACPadding2                       // 0x80 then zeros
+	MACPadding3                       // a block of the length in bits before the message, then zeros
+	MACPadding4                       // none for complete blocks, otherwise 0x80 then zeros, only for MACAlgorithm5
+)
+
+const macBlockSize = 16
+
+type blockMAC struct {
+	algorithm MACAlgorithm
+	padding   MACPadding
+	size      int
+	k, k1, k2 cipher.Block
+	sub1      [macBlockSize]byte // CMAC subkeys
+	sub2      [macBlockSize]byte
+	x         [macBlockSize]byte // chaining value
+	n         int                // blocks processed
+	buf       [macBlockSize]byte // the last, possibly complete, block
+	nbuf      int
+	msg       []byte // the whole message for MACPadding3
+}
+
+//NewSM4CMAC returns SM4-CMAC of NIST SP 800-38B with a 16-byte tag, which
+// is MACAlgorithm5 of GB/T 15852.1.
+func NewSM4CMAC(key []byte) (hash.Hash, error) {
+	return NewSM4MAC(MACAlgorithm5, MACPadding4, macBlockSize, key)
+}
+
+//NewSM4CBCMAC returns the SM4 CBC-MAC with a zero IV and zero padding and a
+// 16-byte tag, which is MACAlgorithm1 with MACPadding1 of GB/T 15852.1. It is
+// only secure for messages of a fixed length.
+func NewSM4CBCMAC(key []byte) (hash.Hash, error) {
+	return NewSM4MAC(MACAlgorithm1, MACPadding1, macBlockSize, key)
+}
+
+//NewSM4MAC returns an SM4 MAC algorithm of GB/T 15852.1 with the padding
+// method and a tag of size bytes, between 4 and 16. keys are K, K' and K''
+// as needed by the algorithm. MACAlgorithm5 takes MACPadding4, the other
+// algorithms take MACPadding1 to MACPadding3. With MACPadding3 the message
+// is buffered until Sum, because its length comes first.
+func NewSM4MAC(algorithm MACAlgorithm, padding MACPadding, size int, keys ...[]byte) (hash.Hash, error) {
+	blocks := make([]cipher.Block, len(keys))
+	for i, key := range keys {
+		b, err := GetSm4Cipher(key)
+		if err != nil {
+			return nil, err
+		}
+		blocks[i] = b
+	}
+	return newBlockMAC(algorithm, padding, size, blocks...)
+}
+
+func newBlockMAC(algorithm MACAlgorithm, padding MACPadding, size int, blocks ...cipher.Block) (*blockMAC, error) {
+	var keys int
+	switch algorithm {
+	case MACAlgorithm1, MACAlgorithm5:
+		keys = 1
+	case MACAlgorithm2, MACAlgorithm3, MACAlgorithm6:
+		keys = 2
+	case MACAlgorithm4:
+		keys = 3
+	default:
+		return nil, fmt.Errorf("unknown MAC algorithm %d", algorithm)
+	}
+	if len(blocks) != keys {
+		return nil, fmt.Errorf("MAC algorithm %d takes %d keys, got %d", algorithm, keys, len(blocks))
+	}
+	if (algorithm == MACAlgorithm5) != (padding == MACPadding4) || padding < MACPadding1 || padding > MACPadding4 {
+		return nil, fmt.Errorf("MAC algorithm %d does not take padding method %d", algorithm, padding)
+	}
+	if size < 4 || size > macBlockSize {
+		return nil, errors.New("MAC size must be between 4 and 16 bytes")
+	}
+	for _, b := range blocks {
+		if b.BlockSize() != macBlockSize {
+			return nil, errors.New("MAC needs a 128-bit block cipher")
+		}
+	}
+	m := &blockMAC{algorithm: algorithm, padding: padding, size: size, k: blocks[0]}
+	if keys > 1 {
+		m.k1 = blocks[1]
+	}
+	if keys > 2 {
+		m.k2 = blocks[2]
+	}
+	if algorithm == MACAlgorithm5 {
+		m.k.Encrypt(m.sub1[:], m.sub1[:])
+		double(&m.sub1, &m.sub1)
+		double(&m.sub2, &m.sub1)
+	}
+	return m, nil
+}
+
+// double sets d to s multiplied by x in GF(2^128), as for CMAC subkeys.
+func double(d, s *[macBlockSize]byte) {
+	msb := s[0] >> 7
+	for i := 0; i < macBlockSize-1; i++ {
+		d[i] = s[i]<<1 | s[i+1]>>7
+	}
+	d[macBlockSize-1] = s[macBlockSize-1]<<1 ^ 0x87&-msb
+}
+
+// block chains a block which is not the last one.
+func (m *blockMAC) block(b []byte) {
+	for i := range m.x {
+		m.x[i] ^= b[i]
+	}
+	m.k.Encrypt(m.x[:], m.x[:])
+	if m.n == 0 && m.algorithm == MACAlgorithm4 {
+		m.k2.Encrypt(m.x[:], m.x[:])
+	}
+	m.n++
+}
+
+// write chains p, holding back the last block which Sum processes.
+func (m *blockMAC) write(p []byte) {
+	for len(p) > 0 {
+		if m.nbuf == macBlockSize {
+			m.block(m.buf[:])
+			m.nbuf = 0
+		}
+		n := copy(m.buf[m.nbuf:], p)
+		m.nbuf += n
+		p = p[n:]
+	}
+}
+
+func (m *blockMAC) Write(p []byte) (int, error) {
+	if m.padding == MACPadding3 {
+		m.msg = append(m.msg, p...)
+	} else {
+		m.write(p)
+	}
+	return len(p), nil
+}
+
+func (m *blockMAC) Sum(in []byte) []byte {
+	d := *m
+	if d.padding == MACPadding3 {
+		var l [macBlockSize]byte
+		binary.BigEndian.PutUint64(l[macBlockSize-8:], uint64(len(d.msg))*8)
+		d.write(l[:])
+		d.write(d.msg)
+	}
+
+	last := d.buf
+	for i := d.nbuf; i < macBlockSize; i++ {
+		last[i] = 0
+	}
+	switch d.padding {
+	case MACPadding2:
+		if d.nbuf == macBlockSize {
+			d.block(last[:])
+			last, d.nbuf = [macBlockSize]byte{}, 0
+		}
+		last[d.nbuf] = 0x80
+	case MACPadding4:
+		sub := &d.sub1
+		if d.nbuf < macBlockSize {
+			last[d.nbuf] = 0x80
+			sub = &d.sub2
+		}
+		for i := range last {
+			last[i] ^= sub[i]
+		}
+	}
+
+	switch d.algorithm {
+	case MACAlgorithm6:
+		for i := range d.x {
+			d.x[i] ^= last[i]
+		}
+		d.k1.Encrypt(d.x[:], d.x[:])
+	default:
+		d.block(last[:])
+	}
+	switch d.algorithm {
+	case MACAlgorithm2, MACAlgorithm4:
+		d.k1.Encrypt(d.x[:], d.x[:])
+	case MACAlgorithm3:
+		d.k1.Decrypt(d.x[:], d.x[:])
+		d.k.Encrypt(d.x[:], d.x[:])
+	}
+	return append(in, d.x[:d.size]...)
+}
+
+func (m *blockMAC) Reset() {
+	m.x, m.buf = [macBlockSize]byte{}, [macBlockSize]byte{}
+	m.n, m.nbuf, m.msg = 0, 0, nil
+}
+
+func (m *blockMAC) Size() int {
+	return m.size
+}
+
+func (m *blockMAC) BlockSize() int {
+	return macBlockSize
+}
@@ -0,0 +1,253 @@
+package gm
+
+import (
+	"crypto/aes"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	macKey  = decodeHex("0123456789abcdeffedcba9876543210")
+	macKey1 = decodeHex("fedcba98765432100123456789abcdef")
+	macKey2 = decodeHex("000102030405060708090a0b0c0d0e0f")
+)
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func macKeys(algorithm MACAlgorithm) [][]byte {
+	switch algorithm {
+	case MACAlgorithm1, MACAlgorithm5:
+		return [][]byte{macKey}
+	case MACAlgorithm4:
+		return [][]byte{macKey, macKey1, macKey2}
+	default:
+		return [][]byte{macKey, macKey1}
+	}
+}
+
+func TestSM4CBCMAC(t *testing.T) {
+	// GB/T 32907-2016 example 1, a single block is its encryption.
+	h, err := NewSM4CBCMAC(macKey)
+	assert.Nil(t, err)
+	_, _ = h.Write(macKey)
+	assert.Equal(t, "681edf34d206965e86b3e94f536e4246", hex.EncodeToString(h.Sum(nil)))
+}
+
+func TestSM4CMAC(t *testing.T) {
+	// Checked with openssl mac -cipher SM4-CBC CMAC.
+	tests := []struct {
+		n   int
+		mac string
+	}{
+		{0, "29e154322e5c7bd8ee6a25ba549b24bc"},
+		{20, "5173334d6885305bc64f20b0937c954a"},
+		{32, "9faa86ca987a5c834083c4b79601e12b"},
+	}
+	for _, tt := range tests {
+		h, err := NewSM4CMAC(macKey)
+		assert.Nil(t, err)
+		for i := 0; i < tt.n; i++ {
+			_, _ = h.Write([]byte{byte(i)})
+		}
+		assert.Equal(t, tt.mac, hex.EncodeToString(h.Sum(nil)), tt.n)
+	}
+}
+
+func TestCMACRFC4493(t *testing.T) {
+	block, err := aes.NewCipher(decodeHex("2b7e151628aed2a6abf7158809cf4f3c"))
+	assert.Nil(t, err)
+	msg := decodeHex("6bc1bee22e409f96e93d7e117393172aae2d8a571e03ac9c9eb76fac45af8e51" +
+		"30c81c46a35ce411e5fbc1191a0a52eff69f2445df4f9b17ad2b417be66c3710")
+	tests := []struct {
+		n   int
+		mac string
+	}{
+		{0, "bb1d6929e95937287fa37d129b756746"},
+		{16, "070a16b46b4d4144f79bdd9dd04a287c"},
+		{40, "dfa66747de9ae63030ca32611497c827"},
+		{64, "51f0bebf7e3b9d92fc49741779363cfe"},
+	}
+	for _, tt := range tests {
+		h, err := newBlockMAC(MACAlgorithm5, MACPadding4, 16, block)
+		assert.Nil(t, err)
+		_, _ = h.Write(msg[:tt.n])
+		assert.Equal(t, tt.mac, hex.EncodeToString(h.Sum(nil)), tt.n)
+	}
+}
+
+func TestSM4MACGBT15852(t *testing.T) {
+	// GB/T 15852.1-2020 appendix B, as reproduced in the cbcmac tests of
+	// github.com/emmansun/gmsm. The appendix uses padding method 2 and
+	// derives K'' of MacDES as K' xor 0xf0 and the keys of LMAC as the
+	// encryptions of 1 and 2 under K.
+	k1 := decodeHex("4149d2aded9456681ec8b511d9e7ee04")
+	k2 := make([]byte, len(k1))
+	for i := range k1 {
+		k2[i] = k1[i] ^ 0xf0
+	}
+	block, err := GetSm4Cipher(macKey)
+	assert.Nil(t, err)
+	l1, l2 := make([]byte, 16), make([]byte, 16)
+	l1[15], l2[15] = 1, 2
+	block.Encrypt(l1, l1)
+	block.Encrypt(l2, l2)
+
+	msgs := []string{"", "This is the test message for mac", "This is the test message "}
+	tests := []struct {
+		algorithm MACAlgorithm
+		padding   MACPadding
+		keys      [][]byte
+		macs      [3]string
+	}{
+		{MACAlgorithm1, MACPadding2, [][]byte{macKey}, [3]string{
+			"8c338e5a27e349beae39214feda97099",
+			"4b6553af3c4e27448412315ac7849535",
+			"421ad1690aa152e2846fa2a5d83445a9",
+		}},
+		{MACAlgorithm2, MACPadding2, [][]byte{macKey, k1}, [3]string{
+			"2cf6edf63cce144489eaddf07b4938db",
+			"e423e35599afd948aec50bdee838e9ea",
+			"f02625cead008d4efbf3f0b2b0c2a75b",
+		}},
+		{MACAlgorithm3, MACPadding2, [][]byte{macKey, k1}, [3]string{
+			"b4736be9a174faa34db1e9f1dacd5d62",
+			"51e9928c2238330c3231b8752a9afd7f",
+			"197247229ce9d7b6ae405bf885b27057",
+		}},
+		{MACAlgorithm4, MACPadding2, [][]byte{macKey, k1, k2}, [3]string{
+			"0c560096b609ed0eaa39afd6e2666511",
+			"7e1a9a5e0ef0947f25cb9485261c985c",
+			"949476d35f17261e1fb8c4396d62dc05",
+		}},
+		{MACAlgorithm5, MACPadding4, [][]byte{macKey}, [3]string{
+			"29e154322e5c7bd8ee6a25ba549b24bc",
+			"692c437100f3b5ee2b8abcef373d990c",
+			"4738a6c760b280fc0c8a8af3886e9f5d",
+		}},
+		{MACAlgorithm6, MACPadding2, [][]byte{l1, l2}, [3]string{
+			"cd7ed27964e257c077f055f8ee383c3f",
+			"a0c465ee5896972f8337aa1f92c99d10",
+			"60dd955ed0ca3d7a64227174dd98dd81",
+		}},
+	}
+	for _, tt := range tests {
+		for i, m := range msgs {
+			h, err := NewSM4MAC(tt.algorithm, tt.padding, 16, tt.keys...)
+			assert.Nil(t, err)
+			_, _ = h.Write([]byte(m))
+			assert.Equal(t, tt.macs[i], hex.EncodeToString(h.Sum(nil)), tt.algorithm, i)
+		}
+	}
+}
+
+func TestSM4MAC(t *testing.T) {
+	// Regression values of this package for the bytes 0 to 19 and the padding
+	// methods the appendix does not cover. The algorithm 1 to 4 and 6 values
+	// were recomputed with a script chaining SM4 of openssl enc -sm4-ecb, the
+	// CMAC one with openssl mac -cipher SM4-CBC CMAC.
+	tests := []struct {
+		algorithm MACAlgorithm
+		padding   MACPadding
+		mac       string
+	}{
+		{MACAlgorithm1, MACPadding1, "ea5646df9c465f0fef3547be0c2249d7"},
+		{MACAlgorithm1, MACPadding2, "856d69de52fa1e74a348fe93d6787131"},
+		{MACAlgorithm1, MACPadding3, "662c55fbfb82b37950c4d14d27f878b8"},
+		{MACAlgorithm2, MACPadding1, "351e6a804b578baf23f204f25f31686d"},
+		{MACAlgorithm2, MACPadding2, "c5ab29d561a2f5cb73292c34d8e3ae58"},
+		{MACAlgorithm2, MACPadding3, "b31c90c0cf8747ccb2ba37425deae428"},
+		{MACAlgorithm3, MACPadding1, "6bcfde1582f73558c7a263c72d8197f2"},
+		{MACAlgorithm3, MACPadding2, "da7553f8ec50645ccbb285e0c483d994"},
+		{MACAlgorithm3, MACPadding3, "a42a39abb42f0b8be270d6421026721e"},
+		{MACAlgorithm4, MACPadding1, "7b146a02084c55ee2b08a380d1a0e319"},
+		{MACAlgorithm4, MACPadding2, "fae21891b0170d8e73820d59154c311c"},
+		{MACAlgorithm4, MACPadding3, "605951fd3c22f33bb8198077456334b5"},
+		{MACAlgorithm5, MACPadding4, "5173334d6885305bc64f20b0937c954a"},
+		{MACAlgorithm6, MACPadding1, "a66286a8097d1307e34e8c7dfcd12792"},
+		{MACAlgorithm6, MACPadding2, "8ded29582ce21ac8a15260f72b753238"},
+		{MACAlgorithm6, MACPadding3, "8730a50bff41712abc7cd0fb51e67f20"},
+	}
+	msg := make([]byte, 20)
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+	for _, tt := range tests {
+		h, err := NewSM4MAC(tt.algorithm, tt.padding, 16, macKeys(tt.algorithm)...)
+		assert.Nil(t, err)
+		_, _ = h.Write(msg[:7])
+		_, _ = h.Write(msg[7:])
+		assert.Equal(t, tt.mac, hex.EncodeToString(h.Sum(nil)), tt)
+
+		h, err = NewSM4MAC(tt.algorithm, tt.padding, 8, macKeys(tt.algorithm)...)
+		assert.Nil(t, err)
+		assert.Equal(t, 8, h.Size())
+		_, _ = h.Write(msg)
+		assert.Equal(t, tt.mac[:16], hex.EncodeToString(h.Sum(nil)), tt)
+	}
+}
+
+func TestSM4MACEmpty(t *testing.T) {
+	// Regression values of this package, recomputed with SM4 of openssl enc.
+	tests := []struct {
+		algorithm MACAlgorithm
+		padding   MACPadding
+		mac       string
+	}{
+		{MACAlgorithm1, MACPadding1, "2677f46b09c122cc975533105bd4a22a"},
+		{MACAlgorithm1, MACPadding2, "8c338e5a27e349beae39214feda97099"},
+		{MACAlgorithm1, MACPadding3, "2677f46b09c122cc975533105bd4a22a"},
+		{MACAlgorithm6, MACPadding2, "455346136de56a09a3b1a33ae641e709"},
+	}
+	for _, tt := range tests {
+		h, err := NewSM4MAC(tt.algorithm, tt.padding, 16, macKeys(tt.algorithm)...)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.mac, hex.EncodeToString(h.Sum(nil)), tt)
+	}
+}
+
+func TestSM4MACState(t *testing.T) {
+	msg := []byte(msg)
+	for _, padding := range []MACPadding{MACPadding1, MACPadding2, MACPadding3} {
+		h, err := NewSM4MAC(MACAlgorithm3, padding, 16, macKey, macKey1)
+		assert.Nil(t, err)
+		_, _ = h.Write(msg)
+		want := h.Sum(nil)
+
+		h.Reset()
+		for i := range msg {
+			_, _ = h.Write(msg[i : i+1])
+			if i == 31 {
+				h.Sum(nil)
+			}
+		}
+		assert.Equal(t, want, h.Sum(nil))
+		assert.Equal(t, append([]byte{1}, want...), h.Sum([]byte{1}))
+		assert.Equal(t, 16, h.BlockSize())
+	}
+}
+
+func TestSM4MACInvalid(t *testing.T) {
+	_, err := NewSM4MAC(MACAlgorithm(7), MACPadding1, 16, macKey)
+	assert.NotNil(t, err)
+	_, err = NewSM4MAC(MACAlgorithm2, MACPadding1, 16, macKey)
+	assert.NotNil(t, err)
+	_, err = NewSM4MAC(MACAlgorithm1, MACPadding4, 16, macKey)
+	assert.NotNil(t, err)
+	_, err = NewSM4MAC(MACAlgorithm5, MACPadding2, 16, macKey)
+	assert.NotNil(t, err)
+	_, err = NewSM4MAC(MACAlgorithm1, MACPadding(5), 16, macKey)
+	assert.NotNil(t, err)
+	_, err = NewSM4MAC(MACAlgorithm1, MACPadding1, 3, macKey)
+	assert.NotNil(t, err)
+	_, err = NewSM4MAC(MACAlgorithm1, MACPadding1, 17, macKey)
+	assert.NotNil(t, err)
+	_, err = NewSM4CMAC(macKey[:15])
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,113 @@
+package threshold
+
+import (
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"io"
+	"math/big"
+)
+
+func checkScalar(k *big.Int) error {
+	if k == nil || k.Sign() < 0 || k.Cmp(ec.N) >= 0 {
+		return errors.New("threshold: scalar out of range")
+	}
+	return nil
+}
+
+func modN(k *big.Int) *big.Int {
+	return k.Mod(k, ec.N)
+}
+
+// schnorrProof proves the knowledge of x with X = x * B, bound to a context
+// naming the prover and the purpose of the proof.
+type schnorrProof struct {
+	A []byte
+	Z *big.Int
+}
+
+func proveDL(rand io.Reader, context []byte, b ec.Point, x *big.Int) (schnorrProof, error) {
+	a, err := ec.RandScalar(rand)
+	if err != nil {
+		return schnorrProof{}, err
+	}
+	pa := ec.Mul(b, a)
+	c := ec.HashToScalar("schnorr", context, b.Marshal(), ec.Mul(b, x).Marshal(), pa.Marshal())
+	z := modN(new(big.Int).Add(a, new(big.Int).Mul(c, x)))
+	return schnorrProof{A: pa.Marshal(), Z: z}, nil
+}
+
+func (proof schnorrProof) verify(context []byte, b, x ec.Point) bool {
+	pa, err := ec.Unmarshal(proof.A)
+	if err != nil || checkScalar(proof.Z) != nil {
+		return false
+	}
+	c := ec.HashToScalar("schnorr", context, b.Marshal(), x.Marshal(), pa.Marshal())
+	return ec.Mul(b, proof.Z).Equal(ec.Add(pa, ec.Mul(x, c)))
+}
+
+// dleqProof proves that log_G W = log_X Y without revealing it.
+type dleqProof struct {
+	A1, A2 []byte
+	Z      *big.Int
+}
+
+func proveDLEQ(rand io.Reader, context []byte, x ec.Point, w *big.Int) (dleqProof, error) {
+	a, err := ec.RandScalar(rand)
+	if err != nil {
+		return dleqProof{}, err
+	}
+	a1, a2 := ec.BaseMul(a), ec.Mul(x, a)
+	c := ec.HashToScalar("dleq", context, ec.BaseMul(w).Marshal(), x.Marshal(), ec.Mul(x, w).Marshal(), a1.Marshal(), a2.Marshal())
+	z := modN(new(big.Int).Add(a, new(big.Int).Mul(c, w)))
+	return dleqProof{A1: a1.Marshal(), A2: a2.Marshal(), Z: z}, nil
+}
+
+func (proof dleqProof) verify(context []byte, w, x, y ec.Point) bool {
+	a1, err1 := ec.Unmarshal(proof.A1)
+	a2, err2 := ec.Unmarshal(proof.A2)
+	if err1 != nil || err2 != nil || checkScalar(proof.Z) != nil {
+		return false
+	}
+	c := ec.HashToScalar("dleq", context, w.Marshal(), x.Marshal(), y.Marshal(), a1.Marshal(), a2.Marshal())
+	return ec.BaseMul(proof.Z).Equal(ec.Add(a1, ec.Mul(w, c))) &&
+		ec.Mul(x, proof.Z).Equal(ec.Add(a2, ec.Mul(y, c)))
+}
+
+// lagrange returns the Lagrange coefficient of party i of the set ids for
+// the value at x.
+func lagrange(i int, ids []int, x int64) *big.Int {
+	num, den := big.NewInt(1), big.NewInt(1)
+	for _, j := range ids {
+		if j == i {
+			continue
+		}
+		num = modN(num.Mul(num, big.NewInt(x-int64(j))))
+		den = modN(den.Mul(den, big.NewInt(int64(i)-int64(j))))
+	}
+	return modN(num.Mul(num, new(big.Int).ModInverse(den, ec.N)))
+}
+
+// signature checks s and returns the DER encoded signature (r, s) after
+// verifying it.
+func signature(pub *gm.SM2PublicKey, digest []byte, r, s *big.Int) ([]byte, error) {
+	if s.Sign() == 0 || new(big.Int).Add(r, s).Cmp(ec.N) == 0 {
+		return nil, errors.New("threshold: degenerate signature, sign again")
+	}
+	sig, err := gm.MarshalSignature(r, s)
+	if err != nil {
+		return nil, err
+	}
+	if ok, err := pub.Verify(nil, sig, digest); !ok || err != nil {
+		return nil, errors.New("threshold: the joint signature does not verify")
+	}
+	return sig, nil
+}
+
+// digestScalar returns the digest e of the SM2 signature as a scalar.
+func digestScalar(digest []byte) (*big.Int, error) {
+	if len(digest) != 32 {
+		return nil, errors.New("threshold: digest must be the 32-byte SM3 hash of Z || M")
+	}
+	return new(big.Int).SetBytes(digest), nil
+}
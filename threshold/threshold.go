@@ -0,0 +1,239 @@
+//Package threshold implements SM2 signing with a private key that is never
+// held by a single machine: a two-party protocol in which the parties hold
+// multiplicative shares d1, d2 of (1+d)^-1, and a t-of-n protocol with a
+// distributed key generation in which any t parties sign.
+//
+// Every protocol is a round-based state machine: Start returns the messages
+// of the first round and Update consumes the messages of the other parties,
+// returning the messages of the next round once a round is complete. Messages
+// are serializable with MarshalBinary and can be carried over any transport;
+// point-to-point messages must be sent over an authenticated and encrypted
+// channel. RunLocal runs a set of parties in-process.
+package threshold
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+)
+
+//Message is a protocol message sent by party From in round Round. To is the
+// receiving party, or 0 for a message broadcast to every other party.
+type Message struct {
+	From    int
+	To      int
+	Round   int
+	Payload []byte
+}
+
+//MarshalBinary encodes the message in DER.
+func (m *Message) MarshalBinary() ([]byte, error) {
+	return asn1.Marshal(*m)
+}
+
+//UnmarshalBinary decodes a message encoded by MarshalBinary.
+func (m *Message) UnmarshalBinary(b []byte) error {
+	return unmarshal(b, m)
+}
+
+//Party is one party of a protocol run.
+type Party interface {
+	// ID returns the identifier of the party, a positive integer.
+	ID() int
+	// Start returns the messages of the first round of the party.
+	Start() ([]*Message, error)
+	// Update handles a message of another party. Once every message of a
+	// round has arrived, it returns the messages of the next round.
+	Update(msg *Message) ([]*Message, error)
+	// Done reports whether the party has finished the protocol.
+	Done() bool
+}
+
+// rounds does the message bookkeeping shared by the protocols: messages are
+// buffered by round until one has arrived from every peer, then the round is
+// handed to step.
+type rounds struct {
+	id      int
+	peers   []int
+	current int // the awaited round, 0 before Start
+	inbox   map[int]map[int]*Message
+	done    bool
+	err     error
+	// step processes the messages of a complete round. It returns the
+	// messages of the next round and marks the party done when it finishes.
+	step func(round int, msgs map[int]*Message) ([]*Message, error)
+}
+
+func (r *rounds) ID() int { return r.id }
+
+func (r *rounds) Done() bool { return r.done }
+
+// start sets the first awaited round and processes it if its messages came
+// before Start.
+func (r *rounds) start(first int, out []*Message) ([]*Message, error) {
+	if r.current != 0 || r.done {
+		return nil, errors.New("threshold: protocol already started")
+	}
+	r.current = first
+	more, err := r.flush()
+	return append(out, more...), err
+}
+
+func (r *rounds) Update(msg *Message) ([]*Message, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.done {
+		return nil, errors.New("threshold: protocol already finished")
+	}
+	if msg.To != 0 && msg.To != r.id {
+		return nil, fmt.Errorf("threshold: message for party %d delivered to party %d", msg.To, r.id)
+	}
+	if !containsID(r.peers, msg.From) {
+		return nil, fmt.Errorf("threshold: message from unknown party %d", msg.From)
+	}
+	if msg.Round < r.current {
+		return nil, fmt.Errorf("threshold: message of party %d for past round %d", msg.From, msg.Round)
+	}
+	if r.inbox == nil {
+		r.inbox = make(map[int]map[int]*Message)
+	}
+	if r.inbox[msg.Round] == nil {
+		r.inbox[msg.Round] = make(map[int]*Message)
+	}
+	if _, ok := r.inbox[msg.Round][msg.From]; ok {
+		return nil, fmt.Errorf("threshold: duplicate message of party %d in round %d", msg.From, msg.Round)
+	}
+	r.inbox[msg.Round][msg.From] = msg
+	return r.flush()
+}
+
+func (r *rounds) flush() ([]*Message, error) {
+	var out []*Message
+	for r.current != 0 && !r.done && len(r.inbox[r.current]) == len(r.peers) {
+		msgs := r.inbox[r.current]
+		delete(r.inbox, r.current)
+		next, err := r.step(r.current, msgs)
+		if err != nil {
+			r.err = err
+			return nil, err
+		}
+		out = append(out, next...)
+		r.current++
+	}
+	return out, nil
+}
+
+func (r *rounds) broadcast(round int, payload interface{}) (*Message, error) {
+	return r.send(0, round, payload)
+}
+
+func (r *rounds) send(to, round int, payload interface{}) (*Message, error) {
+	b, err := asn1.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{From: r.id, To: to, Round: round, Payload: b}, nil
+}
+
+func unmarshal(b []byte, v interface{}) error {
+	rest, err := asn1.Unmarshal(b, v)
+	if err != nil {
+		return errors.New("threshold: malformed message: " + err.Error())
+	}
+	if len(rest) != 0 {
+		return errors.New("threshold: trailing data after message")
+	}
+	return nil
+}
+
+// checkParties checks that ids are distinct positive integers including id,
+// it returns the others.
+func checkParties(id int, ids []int) ([]int, error) {
+	if !containsID(ids, id) {
+		return nil, fmt.Errorf("threshold: party %d is not one of the parties", id)
+	}
+	var peers []int
+	for i, p := range ids {
+		if p <= 0 {
+			return nil, errors.New("threshold: party identifiers must be positive")
+		}
+		if containsID(ids[:i], p) {
+			return nil, fmt.Errorf("threshold: duplicate party %d", p)
+		}
+		if p != id {
+			peers = append(peers, p)
+		}
+	}
+	return peers, nil
+}
+
+func containsID(ids []int, id int) bool {
+	for _, p := range ids {
+		if p == id {
+			return true
+		}
+	}
+	return false
+}
+
+//RunLocal runs the parties in-process until all of them are done, routing
+// every message, after a round trip through its binary encoding, to its
+// recipients.
+func RunLocal(parties ...Party) error {
+	byID := make(map[int]Party, len(parties))
+	for _, p := range parties {
+		if _, ok := byID[p.ID()]; ok {
+			return fmt.Errorf("threshold: duplicate party %d", p.ID())
+		}
+		byID[p.ID()] = p
+	}
+
+	var queue []*Message
+	for _, p := range parties {
+		out, err := p.Start()
+		if err != nil {
+			return err
+		}
+		queue = append(queue, out...)
+	}
+	for len(queue) > 0 {
+		msg := queue[0]
+		queue = queue[1:]
+
+		b, err := msg.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		var recipients []Party
+		if msg.To == 0 {
+			for _, p := range parties {
+				if p.ID() != msg.From {
+					recipients = append(recipients, p)
+				}
+			}
+		} else if p, ok := byID[msg.To]; ok {
+			recipients = append(recipients, p)
+		} else {
+			return fmt.Errorf("threshold: message for unknown party %d", msg.To)
+		}
+		for _, p := range recipients {
+			received := new(Message)
+			if err := received.UnmarshalBinary(b); err != nil {
+				return err
+			}
+			out, err := p.Update(received)
+			if err != nil {
+				return err
+			}
+			queue = append(queue, out...)
+		}
+	}
+
+	for _, p := range parties {
+		if !p.Done() {
+			return fmt.Errorf("threshold: party %d did not finish", p.ID())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package threshold
+
+import (
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func twoPartyKeys(t *testing.T) (*TwoPartyKey, *TwoPartyKey) {
+	g1, err := NewTwoPartyKeyGen(1, nil)
+	assert.Nil(t, err)
+	g2, err := NewTwoPartyKeyGen(2, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, RunLocal(g1, g2))
+	k1, err := g1.Key()
+	assert.Nil(t, err)
+	k2, err := g2.Key()
+	assert.Nil(t, err)
+	return k1, k2
+}
+
+func TestTwoParty(t *testing.T) {
+	k1, k2 := twoPartyKeys(t)
+	assert.Equal(t, k1.PublicKey, k2.PublicKey)
+
+	msg := []byte("two-party sm2 signature")
+	digest := gm.HashBeforeSM2(k1.PublicKey, msg)
+	for i := 0; i < 3; i++ {
+		s1, err := NewTwoPartySigner(k1, digest, nil)
+		assert.Nil(t, err)
+		s2, err := NewTwoPartySigner(k2, digest, nil)
+		assert.Nil(t, err)
+		assert.Nil(t, RunLocal(s2, s1))
+
+		sig, err := s1.Signature()
+		assert.Nil(t, err)
+		ok, err := k1.PublicKey.Verify(nil, sig, digest)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		_, err = s2.Signature()
+		assert.NotNil(t, err)
+	}
+}
+
+func TestTwoPartyKeyMarshal(t *testing.T) {
+	k1, k2 := twoPartyKeys(t)
+	b, err := k1.MarshalBinary()
+	assert.Nil(t, err)
+	k := new(TwoPartyKey)
+	assert.Nil(t, k.UnmarshalBinary(b))
+	assert.Equal(t, k1.ID, k.ID)
+	assert.Equal(t, 0, k1.D.Cmp(k.D))
+	assert.Equal(t, k1.PublicKey.X, k.PublicKey.X)
+
+	digest := gm.HashBeforeSM2(k.PublicKey, []byte("restored"))
+	s1, _ := NewTwoPartySigner(k, digest, nil)
+	s2, _ := NewTwoPartySigner(k2, digest, nil)
+	assert.Nil(t, RunLocal(s1, s2))
+	sig, err := s1.Signature()
+	assert.Nil(t, err)
+	ok, _ := k2.PublicKey.Verify(nil, sig, digest)
+	assert.True(t, ok)
+
+	assert.NotNil(t, k.UnmarshalBinary(append(b, 0)))
+	assert.NotNil(t, k.UnmarshalBinary(b[:len(b)-1]))
+}
+
+func TestTwoPartyTampered(t *testing.T) {
+	k1, k2 := twoPartyKeys(t)
+	digest := gm.HashBeforeSM2(k1.PublicKey, []byte("tampered"))
+	s1, _ := NewTwoPartySigner(k1, digest, nil)
+	s2, _ := NewTwoPartySigner(k2, digest, nil)
+	out, err := s1.Start()
+	assert.Nil(t, err)
+	_, err = s2.Start()
+	assert.Nil(t, err)
+	reply, err := s2.Update(out[0])
+	assert.Nil(t, err)
+	assert.True(t, s2.Done())
+
+	reply[0].Payload[len(reply[0].Payload)-1] ^= 1
+	_, err = s1.Update(reply[0])
+	assert.NotNil(t, err)
+	assert.False(t, s1.Done())
+
+	// a proof made for another digest is rejected
+	other, _ := NewTwoPartySigner(k1, gm.HashBeforeSM2(k1.PublicKey, []byte("other")), nil)
+	out, _ = other.Start()
+	s2, _ = NewTwoPartySigner(k2, digest, nil)
+	_, _ = s2.Start()
+	_, err = s2.Update(out[0])
+	var fe *FaultError
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, 1, fe.Party)
+}
+
+func TestMessageMarshal(t *testing.T) {
+	m := &Message{From: 3, To: 5, Round: 2, Payload: []byte{1, 2, 3}}
+	b, err := m.MarshalBinary()
+	assert.Nil(t, err)
+	got := new(Message)
+	assert.Nil(t, got.UnmarshalBinary(b))
+	assert.Equal(t, m, got)
+	assert.NotNil(t, got.UnmarshalBinary(b[:len(b)-1]))
+}
+
+func TestUpdateChecks(t *testing.T) {
+	g, err := NewKeyGen(1, []int{1, 2, 3}, 2, nil)
+	assert.Nil(t, err)
+	_, err = g.Start()
+	assert.Nil(t, err)
+	_, err = g.Update(&Message{From: 4, Round: 1})
+	assert.NotNil(t, err)
+	g, _ = NewKeyGen(1, []int{1, 2, 3}, 2, nil)
+	_, err = g.Update(&Message{From: 2, To: 3, Round: 1})
+	assert.NotNil(t, err)
+	g, _ = NewKeyGen(1, []int{1, 2, 3}, 2, nil)
+	_, err = g.Update(&Message{From: 2, Round: 2})
+	assert.Nil(t, err)
+	_, err = g.Update(&Message{From: 2, Round: 2})
+	assert.NotNil(t, err)
+
+	_, err = NewKeyGen(1, []int{1, 2, 3}, 3, nil)
+	assert.NotNil(t, err)
+	_, err = NewKeyGen(1, []int{1, 2, 2}, 2, nil)
+	assert.NotNil(t, err)
+	_, err = NewKeyGen(4, []int{1, 2, 3}, 2, nil)
+	assert.NotNil(t, err)
+	_, err = NewTwoPartyKeyGen(3, nil)
+	assert.NotNil(t, err)
+}
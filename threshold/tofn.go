@@ -0,0 +1,630 @@
+package threshold
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"io"
+	"math/big"
+	"sort"
+)
+
+// The t-of-n protocol shares w = (1+d)^-1 with a polynomial of degree t-1.
+// The key generation shares a random w and a random mask u, opens v = w * u
+// through a degree 2t-2 product masked by shares of zero, and publishes
+// X = v^-1 * u * G = w^-1 * G = (1+d) * G, so P = X - G. Party j keeps the
+// share w_j and publishes Y_j = w_j * X.
+//
+// A signing set picks nonces rho_i, publishes R_i = rho_i * X after a
+// commitment round, and with r = e + x(R) for R = sum R_i = (rho * w^-1) * G
+// sends s_i = rho_i + lambda_i * w_i * r; then s = sum s_i - r is the SM2
+// signature w * (k + r) - r for the nonce k = rho * w^-1. Each s_i is checked
+// with s_i * X = R_i + lambda_i * r * Y_i.
+
+// KeyShare is the share of party ID of a t-of-n key.
+type KeyShare struct {
+	ID        int
+	Threshold int
+	// Parties are the identifiers of all parties, in increasing order.
+	Parties []int
+	// Share is the share w_j of (1+d)^-1.
+	Share     *big.Int
+	PublicKey *gm.SM2PublicKey
+	// Verification holds Y_j = w_j * (1+d) * G of every party, in the order
+	// of Parties, with which partial signatures are checked.
+	Verification [][]byte
+}
+
+type keyShareASN1 struct {
+	ID           int
+	Threshold    int
+	Parties      []int
+	Share        *big.Int
+	PublicKey    []byte
+	Verification [][]byte
+}
+
+// MarshalBinary encodes the key share in DER.
+func (k *KeyShare) MarshalBinary() ([]byte, error) {
+	pub, _ := k.PublicKey.Bytes()
+	return asn1.Marshal(keyShareASN1{
+		ID:           k.ID,
+		Threshold:    k.Threshold,
+		Parties:      k.Parties,
+		Share:        k.Share,
+		PublicKey:    pub,
+		Verification: k.Verification,
+	})
+}
+
+// UnmarshalBinary decodes a key share encoded by MarshalBinary.
+func (k *KeyShare) UnmarshalBinary(b []byte) error {
+	var v keyShareASN1
+	if err := unmarshal(b, &v); err != nil {
+		return err
+	}
+	if _, err := checkParties(v.ID, v.Parties); err != nil {
+		return err
+	}
+	if v.Threshold < 2 || len(v.Parties) < 2*v.Threshold-1 || len(v.Verification) != len(v.Parties) {
+		return errors.New("threshold: invalid key share parameters")
+	}
+	if checkScalar(v.Share) != nil {
+		return errors.New("threshold: invalid key share")
+	}
+	p, err := ec.Unmarshal(v.PublicKey)
+	if err != nil {
+		return err
+	}
+	for _, y := range v.Verification {
+		if _, err := ec.Unmarshal(y); err != nil {
+			return err
+		}
+	}
+	*k = KeyShare{
+		ID:           v.ID,
+		Threshold:    v.Threshold,
+		Parties:      v.Parties,
+		Share:        v.Share,
+		PublicKey:    p.PublicKey(),
+		Verification: v.Verification,
+	}
+	return nil
+}
+
+// verification returns Y_id.
+func (k *KeyShare) verification(id int) (ec.Point, error) {
+	for i, p := range k.Parties {
+		if p == id {
+			return ec.Unmarshal(k.Verification[i])
+		}
+	}
+	return ec.Point{}, fmt.Errorf("threshold: party %d does not hold a share", id)
+}
+
+// polynomial is a polynomial over Z_n, lowest coefficient first.
+type polynomial []*big.Int
+
+func randPolynomial(rand io.Reader, constant *big.Int, degree int) (polynomial, error) {
+	f := make(polynomial, degree+1)
+	for i := range f {
+		c, err := ec.RandScalar(rand)
+		if err != nil {
+			return nil, err
+		}
+		f[i] = c
+	}
+	if constant != nil {
+		f[0] = constant
+	}
+	return f, nil
+}
+
+func (f polynomial) eval(x int) *big.Int {
+	v := new(big.Int)
+	bx := big.NewInt(int64(x))
+	for i := len(f) - 1; i >= 0; i-- {
+		v.Mul(v, bx)
+		v.Add(v, f[i])
+		modN(v)
+	}
+	return v
+}
+
+// commit returns the Feldman commitments to the coefficients from index
+// from on.
+func (f polynomial) commit(from int) [][]byte {
+	c := make([][]byte, 0, len(f)-from)
+	for _, a := range f[from:] {
+		c = append(c, ec.BaseMul(a).Marshal())
+	}
+	return c
+}
+
+// evalCommitment returns sum C_i * x^(i+from), the commitment to the value at
+// x of a polynomial whose coefficients from index from on are committed in c.
+func evalCommitment(c []ec.Point, from, x int) ec.Point {
+	acc := ec.Point{}
+	bx := big.NewInt(int64(x))
+	for i := len(c) - 1; i >= 0; i-- {
+		acc = ec.Add(ec.Mul(acc, bx), c[i])
+	}
+	return ec.Mul(acc, new(big.Int).Exp(bx, big.NewInt(int64(from)), ec.N))
+}
+
+func unmarshalPoints(b [][]byte, n int) ([]ec.Point, error) {
+	if len(b) != n {
+		return nil, errors.New("threshold: wrong number of commitments")
+	}
+	p := make([]ec.Point, n)
+	for i := range b {
+		var err error
+		if p[i], err = ec.Unmarshal(b[i]); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+type keyGenMsg1 struct {
+	F, G, Z        [][]byte
+	ProofF, ProofG schnorrProof
+}
+
+type keyGenMsg2 struct {
+	F, G, Z *big.Int
+}
+
+type keyGenMsg3 struct {
+	V *big.Int
+}
+
+type keyGenMsg4 struct {
+	Y     []byte
+	Proof dleqProof
+}
+
+// KeyGen is the distributed generation of a t-of-n key, it takes four
+// rounds: Feldman commitments, point-to-point shares, the opening of the
+// masked product and the verification shares. The shares of round 2 must be
+// sent over private channels.
+type KeyGen struct {
+	rounds
+	rand    io.Reader
+	parties []int
+	t       int
+
+	f, g, z polynomial
+	fc, gc  []ec.Point // sum of the commitments of f and g
+	zc      map[int][]ec.Point
+	commits map[int]*keyGenMsg1
+	w, u, v *big.Int
+	x       ec.Point
+	ys      map[int][]byte
+	share   *KeyShare
+}
+
+// NewKeyGen returns the key generation of party id among parties for a
+// threshold of t signers, which needs 2 <= t and 2t-1 <= len(parties). A nil
+// rand uses crypto/rand.
+func NewKeyGen(id int, parties []int, t int, rand io.Reader) (*KeyGen, error) {
+	peers, err := checkParties(id, parties)
+	if err != nil {
+		return nil, err
+	}
+	if t < 2 || len(parties) < 2*t-1 {
+		return nil, errors.New("threshold: need a threshold of at least 2 and at least 2t-1 parties")
+	}
+	sorted := append([]int(nil), parties...)
+	sort.Ints(sorted)
+	g := &KeyGen{rand: defaultRand(rand), parties: sorted, t: t}
+	g.rounds = rounds{id: id, peers: peers, step: g.step}
+	return g, nil
+}
+
+// Start draws the polynomials of the party and returns its commitments.
+func (g *KeyGen) Start() ([]*Message, error) {
+	var err error
+	if g.f, err = randPolynomial(g.rand, nil, g.t-1); err != nil {
+		return nil, err
+	}
+	if g.g, err = randPolynomial(g.rand, nil, g.t-1); err != nil {
+		return nil, err
+	}
+	if g.z, err = randPolynomial(g.rand, new(big.Int), 2*g.t-2); err != nil {
+		return nil, err
+	}
+	m := keyGenMsg1{F: g.f.commit(0), G: g.g.commit(0), Z: g.z.commit(1)}
+	if m.ProofF, err = proveDL(g.rand, context("keygen-f", g.id), ec.Base(), g.f[0]); err != nil {
+		return nil, err
+	}
+	if m.ProofG, err = proveDL(g.rand, context("keygen-g", g.id), ec.Base(), g.g[0]); err != nil {
+		return nil, err
+	}
+	g.commits = map[int]*keyGenMsg1{g.id: &m}
+	msg, err := g.broadcast(1, m)
+	if err != nil {
+		return nil, err
+	}
+	return g.start(1, []*Message{msg})
+}
+
+func (g *KeyGen) step(round int, msgs map[int]*Message) ([]*Message, error) {
+	switch round {
+	case 1:
+		return g.round1(msgs)
+	case 2:
+		return g.round2(msgs)
+	case 3:
+		return g.round3(msgs)
+	}
+	return nil, g.round4(msgs)
+}
+
+// round1 checks the commitments and sends the shares.
+func (g *KeyGen) round1(msgs map[int]*Message) ([]*Message, error) {
+	for from, msg := range msgs {
+		m := new(keyGenMsg1)
+		if err := unmarshal(msg.Payload, m); err != nil {
+			return nil, fault(from, err.Error())
+		}
+		g.commits[from] = m
+	}
+	g.fc = make([]ec.Point, g.t)
+	g.gc = make([]ec.Point, g.t)
+	for i := range g.fc {
+		g.fc[i] = ec.Point{}
+		g.gc[i] = ec.Point{}
+	}
+	g.zc = make(map[int][]ec.Point)
+	for _, from := range g.parties {
+		m := g.commits[from]
+		f, err := unmarshalPoints(m.F, g.t)
+		if err != nil {
+			return nil, fault(from, err.Error())
+		}
+		gp, err := unmarshalPoints(m.G, g.t)
+		if err != nil {
+			return nil, fault(from, err.Error())
+		}
+		z, err := unmarshalPoints(m.Z, 2*g.t-2)
+		if err != nil {
+			return nil, fault(from, err.Error())
+		}
+		if !m.ProofF.verify(context("keygen-f", from), ec.Base(), f[0]) ||
+			!m.ProofG.verify(context("keygen-g", from), ec.Base(), gp[0]) {
+			return nil, fault(from, "invalid proof of knowledge of the secret")
+		}
+		for i := range f {
+			g.fc[i] = ec.Add(g.fc[i], f[i])
+			g.gc[i] = ec.Add(g.gc[i], gp[i])
+		}
+		g.zc[from] = z
+	}
+
+	out := make([]*Message, 0, len(g.peers))
+	for _, to := range g.peers {
+		msg, err := g.send(to, 2, keyGenMsg2{F: g.f.eval(to), G: g.g.eval(to), Z: g.z.eval(to)})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, msg)
+	}
+	return out, nil
+}
+
+// round2 checks the received shares against the commitments and opens the
+// share of the masked product.
+func (g *KeyGen) round2(msgs map[int]*Message) ([]*Message, error) {
+	w, u, z := g.f.eval(g.id), g.g.eval(g.id), g.z.eval(g.id)
+	for from, msg := range msgs {
+		var m keyGenMsg2
+		if err := unmarshal(msg.Payload, &m); err != nil {
+			return nil, fault(from, err.Error())
+		}
+		if checkScalar(m.F) != nil || checkScalar(m.G) != nil || checkScalar(m.Z) != nil {
+			return nil, fault(from, "share out of range")
+		}
+		c := g.commits[from]
+		f, _ := unmarshalPoints(c.F, g.t)
+		gp, _ := unmarshalPoints(c.G, g.t)
+		if !ec.BaseMul(m.F).Equal(evalCommitment(f, 0, g.id)) ||
+			!ec.BaseMul(m.G).Equal(evalCommitment(gp, 0, g.id)) ||
+			!ec.BaseMul(m.Z).Equal(evalCommitment(g.zc[from], 1, g.id)) {
+			return nil, fault(from, "share does not match the commitments")
+		}
+		w.Add(w, m.F)
+		u.Add(u, m.G)
+		z.Add(z, m.Z)
+	}
+	g.w, g.u = modN(w), modN(u)
+	v := modN(new(big.Int).Add(new(big.Int).Mul(g.w, g.u), z))
+	g.v = v
+	msg, err := g.broadcast(3, keyGenMsg3{V: v})
+	if err != nil {
+		return nil, err
+	}
+	return []*Message{msg}, nil
+}
+
+// round3 interpolates v = w * u, derives X and the public key, and publishes
+// Y_j = w_j * X.
+func (g *KeyGen) round3(msgs map[int]*Message) ([]*Message, error) {
+	vs := map[int]*big.Int{g.id: g.v}
+	for from, msg := range msgs {
+		var m keyGenMsg3
+		if err := unmarshal(msg.Payload, &m); err != nil {
+			return nil, fault(from, err.Error())
+		}
+		if checkScalar(m.V) != nil {
+			return nil, fault(from, "share out of range")
+		}
+		vs[from] = m.V
+	}
+	base := g.parties[:2*g.t-1]
+	v := new(big.Int)
+	for _, i := range base {
+		v.Add(v, new(big.Int).Mul(lagrange(i, base, 0), vs[i]))
+	}
+	modN(v)
+	for _, m := range g.parties[len(base):] {
+		e := new(big.Int)
+		for _, i := range base {
+			e.Add(e, new(big.Int).Mul(lagrange(i, base, int64(m)), vs[i]))
+		}
+		if modN(e).Cmp(vs[m]) != 0 {
+			return nil, errors.New("threshold: inconsistent shares of the masked product")
+		}
+	}
+	if v.Sign() == 0 {
+		return nil, errors.New("threshold: degenerate key, generate again")
+	}
+	g.x = ec.Mul(evalCommitment(g.gc, 0, 0), new(big.Int).ModInverse(v, ec.N))
+	if g.x.IsInfinity() || g.x.Equal(ec.Base()) {
+		return nil, errors.New("threshold: degenerate key, generate again")
+	}
+
+	proof, err := proveDLEQ(g.rand, context("keygen-y", g.id), g.x, g.w)
+	if err != nil {
+		return nil, err
+	}
+	y := ec.Mul(g.x, g.w).Marshal()
+	g.ys = map[int][]byte{g.id: y}
+	msg, err := g.broadcast(4, keyGenMsg4{Y: y, Proof: proof})
+	if err != nil {
+		return nil, err
+	}
+	return []*Message{msg}, nil
+}
+
+// round4 checks the verification shares and that they interpolate to G.
+func (g *KeyGen) round4(msgs map[int]*Message) error {
+	for from, msg := range msgs {
+		var m keyGenMsg4
+		if err := unmarshal(msg.Payload, &m); err != nil {
+			return fault(from, err.Error())
+		}
+		y, err := ec.Unmarshal(m.Y)
+		if err != nil {
+			return fault(from, err.Error())
+		}
+		if !m.Proof.verify(context("keygen-y", from), evalCommitment(g.fc, 0, from), g.x, y) {
+			return fault(from, "invalid proof of the verification share")
+		}
+		g.ys[from] = m.Y
+	}
+	base := g.parties[:g.t]
+	sum := ec.Point{}
+	for _, i := range base {
+		y, _ := ec.Unmarshal(g.ys[i])
+		sum = ec.Add(sum, ec.Mul(y, lagrange(i, base, 0)))
+	}
+	if !sum.Equal(ec.Base()) {
+		return errors.New("threshold: the verification shares do not match the public key")
+	}
+
+	share := &KeyShare{
+		ID:        g.id,
+		Threshold: g.t,
+		Parties:   g.parties,
+		Share:     g.w,
+		PublicKey: ec.Add(g.x, ec.Base().Neg()).PublicKey(),
+	}
+	for _, p := range g.parties {
+		share.Verification = append(share.Verification, g.ys[p])
+	}
+	g.share = share
+	g.done = true
+	return nil
+}
+
+// KeyShare returns the key share once the protocol is done.
+func (g *KeyGen) KeyShare() (*KeyShare, error) {
+	if g.share == nil {
+		return nil, errors.New("threshold: key generation not finished")
+	}
+	return g.share, nil
+}
+
+type signMsg1 struct {
+	Commitment []byte
+}
+
+type signMsg2 struct {
+	R []byte
+}
+
+type signMsg3 struct {
+	S *big.Int
+}
+
+// Signer signs a digest with the key shares of a signing set of at least
+// Threshold parties, it takes three rounds: nonce commitments, nonces and
+// partial signatures. Every party learns the signature.
+type Signer struct {
+	rounds
+	rand    io.Reader
+	share   *KeyShare
+	signers []int
+	digest  []byte
+	e       *big.Int
+	x       ec.Point
+
+	rho     *big.Int
+	ri      map[int]ec.Point
+	commits map[int][]byte
+	r       *big.Int
+	sigma   map[int]*big.Int
+	sig     []byte
+}
+
+// NewSigner returns the signer of the party holding share in the signing set
+// signers for digest, the 32-byte hash returned by gm.HashBeforeSM2. A nil
+// rand uses crypto/rand.
+func NewSigner(share *KeyShare, signers []int, digest []byte, rand io.Reader) (*Signer, error) {
+	peers, err := checkParties(share.ID, signers)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) < share.Threshold {
+		return nil, fmt.Errorf("threshold: need at least %d signers", share.Threshold)
+	}
+	for _, p := range signers {
+		if !containsID(share.Parties, p) {
+			return nil, fmt.Errorf("threshold: party %d does not hold a share", p)
+		}
+	}
+	e, err := digestScalar(digest)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := ec.FromPublicKey(share.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	sorted := append([]int(nil), signers...)
+	sort.Ints(sorted)
+	s := &Signer{
+		rand:    defaultRand(rand),
+		share:   share,
+		signers: sorted,
+		digest:  append([]byte(nil), digest...),
+		e:       e,
+		x:       ec.Add(pub, ec.Base()),
+	}
+	s.rounds = rounds{id: share.ID, peers: peers, step: s.step}
+	return s, nil
+}
+
+func nonceCommitment(id int, digest []byte, r []byte) []byte {
+	h := gm.GetSM3Hasher()
+	_, _ = h.Write(context("sign-nonce", id, digest, r))
+	return h.Sum(nil)
+}
+
+// Start draws the nonce of the party and returns its commitment.
+func (s *Signer) Start() ([]*Message, error) {
+	rho, err := ec.RandScalar(s.rand)
+	if err != nil {
+		return nil, err
+	}
+	s.rho = rho
+	r := ec.Mul(s.x, rho)
+	s.ri = map[int]ec.Point{s.id: r}
+	msg, err := s.broadcast(1, signMsg1{Commitment: nonceCommitment(s.id, s.digest, r.Marshal())})
+	if err != nil {
+		return nil, err
+	}
+	return s.start(1, []*Message{msg})
+}
+
+func (s *Signer) step(round int, msgs map[int]*Message) ([]*Message, error) {
+	switch round {
+	case 1:
+		s.commits = make(map[int][]byte)
+		for from, msg := range msgs {
+			var m signMsg1
+			if err := unmarshal(msg.Payload, &m); err != nil {
+				return nil, fault(from, err.Error())
+			}
+			s.commits[from] = m.Commitment
+		}
+		msg, err := s.broadcast(2, signMsg2{R: s.ri[s.id].Marshal()})
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+
+	case 2:
+		sum := s.ri[s.id]
+		for from, msg := range msgs {
+			var m signMsg2
+			if err := unmarshal(msg.Payload, &m); err != nil {
+				return nil, fault(from, err.Error())
+			}
+			if !bytes.Equal(nonceCommitment(from, s.digest, m.R), s.commits[from]) {
+				return nil, fault(from, "nonce does not match its commitment")
+			}
+			r, err := ec.Unmarshal(m.R)
+			if err != nil {
+				return nil, fault(from, err.Error())
+			}
+			s.ri[from] = r
+			sum = ec.Add(sum, r)
+		}
+		if sum.IsInfinity() {
+			return nil, errors.New("threshold: degenerate nonce, sign again")
+		}
+		s.r = modN(new(big.Int).Add(s.e, sum.X()))
+		if s.r.Sign() == 0 {
+			return nil, errors.New("threshold: degenerate nonce, sign again")
+		}
+		si := new(big.Int).Mul(lagrange(s.id, s.signers, 0), s.share.Share)
+		si.Mul(modN(si), s.r)
+		si.Add(si, s.rho)
+		msg, err := s.broadcast(3, signMsg3{S: modN(si)})
+		if err != nil {
+			return nil, err
+		}
+		s.sigma = map[int]*big.Int{s.id: si}
+		return []*Message{msg}, nil
+	}
+
+	sum := new(big.Int).Set(s.sigma[s.id])
+	for from, msg := range msgs {
+		var m signMsg3
+		if err := unmarshal(msg.Payload, &m); err != nil {
+			return nil, fault(from, err.Error())
+		}
+		if checkScalar(m.S) != nil {
+			return nil, fault(from, "partial signature out of range")
+		}
+		y, err := s.share.verification(from)
+		if err != nil {
+			return nil, err
+		}
+		lr := modN(new(big.Int).Mul(lagrange(from, s.signers, 0), s.r))
+		if !ec.Mul(s.x, m.S).Equal(ec.Add(s.ri[from], ec.Mul(y, lr))) {
+			return nil, fault(from, "invalid partial signature")
+		}
+		sum.Add(sum, m.S)
+	}
+	sig, err := signature(s.share.PublicKey, s.digest, s.r, modN(sum.Sub(sum, s.r)))
+	if err != nil {
+		return nil, err
+	}
+	s.sig = sig
+	s.done = true
+	return nil, nil
+}
+
+// Signature returns the DER encoded signature once the protocol is done.
+func (s *Signer) Signature() ([]byte, error) {
+	if s.sig == nil {
+		return nil, errors.New("threshold: signing not finished")
+	}
+	return s.sig, nil
+}
@@ -0,0 +1,198 @@
+package threshold
+
+import (
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func keyShares(t *testing.T, parties []int, threshold int) map[int]*KeyShare {
+	var gens []Party
+	for _, id := range parties {
+		g, err := NewKeyGen(id, parties, threshold, nil)
+		assert.Nil(t, err)
+		gens = append(gens, g)
+	}
+	assert.Nil(t, RunLocal(gens...))
+	shares := make(map[int]*KeyShare)
+	for _, g := range gens {
+		share, err := g.(*KeyGen).KeyShare()
+		assert.Nil(t, err)
+		shares[share.ID] = share
+	}
+	return shares
+}
+
+func signers(t *testing.T, shares map[int]*KeyShare, set []int, digest []byte) []*Signer {
+	var out []*Signer
+	for _, id := range set {
+		s, err := NewSigner(shares[id], set, digest, nil)
+		assert.Nil(t, err)
+		out = append(out, s)
+	}
+	return out
+}
+
+func sign(t *testing.T, shares map[int]*KeyShare, set []int, digest []byte) []byte {
+	ss := signers(t, shares, set, digest)
+	var parties []Party
+	for _, s := range ss {
+		parties = append(parties, s)
+	}
+	assert.Nil(t, RunLocal(parties...))
+	sig, err := ss[0].Signature()
+	assert.Nil(t, err)
+	for _, s := range ss[1:] {
+		other, _ := s.Signature()
+		assert.Equal(t, sig, other)
+	}
+	return sig
+}
+
+func TestThreshold(t *testing.T) {
+	for _, tc := range []struct {
+		parties []int
+		t       int
+		sets    [][]int
+	}{
+		{[]int{1, 2, 3}, 2, [][]int{{1, 2}, {1, 3}, {3, 2}, {1, 2, 3}}},
+		{[]int{2, 4, 6, 8, 10}, 3, [][]int{{2, 4, 6}, {10, 8, 2}, {4, 6, 8, 10}}},
+	} {
+		shares := keyShares(t, tc.parties, tc.t)
+		pub := shares[tc.parties[0]].PublicKey
+		for _, share := range shares {
+			assert.Equal(t, pub, share.PublicKey)
+		}
+
+		digest := gm.HashBeforeSM2(pub, []byte("threshold sm2 signature"))
+		for _, set := range tc.sets {
+			sig := sign(t, shares, set, digest)
+			ok, err := pub.Verify(nil, sig, digest)
+			assert.Nil(t, err)
+			assert.True(t, ok)
+		}
+
+		// the shares interpolate to (1+d)^-1 for the public key
+		var base []int
+		base = append(base, tc.parties[:tc.t]...)
+		w := new(big.Int)
+		for _, id := range base {
+			w.Add(w, new(big.Int).Mul(lagrange(id, base, 0), shares[id].Share))
+		}
+		p, _ := ec.FromPublicKey(pub)
+		assert.True(t, ec.BaseMul(new(big.Int).ModInverse(modN(w), ec.N)).Equal(ec.Add(p, ec.Base())))
+
+		_, err := NewSigner(shares[tc.parties[0]], tc.parties[:tc.t-1], digest, nil)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestKeyShareMarshal(t *testing.T) {
+	shares := keyShares(t, []int{1, 2, 3}, 2)
+	b, err := shares[2].MarshalBinary()
+	assert.Nil(t, err)
+	share := new(KeyShare)
+	assert.Nil(t, share.UnmarshalBinary(b))
+	assert.Equal(t, shares[2], share)
+
+	digest := gm.HashBeforeSM2(share.PublicKey, []byte("restored"))
+	sig := sign(t, map[int]*KeyShare{2: share, 3: shares[3]}, []int{2, 3}, digest)
+	ok, _ := share.PublicKey.Verify(nil, sig, digest)
+	assert.True(t, ok)
+
+	assert.NotNil(t, share.UnmarshalBinary(b[1:]))
+}
+
+func TestThresholdBadPartialSignature(t *testing.T) {
+	shares := keyShares(t, []int{1, 2, 3}, 2)
+	digest := gm.HashBeforeSM2(shares[1].PublicKey, []byte("cheating"))
+	ss := signers(t, shares, []int{1, 3}, digest)
+	s1, s3 := ss[0], ss[1]
+
+	m1, err := s1.Start()
+	assert.Nil(t, err)
+	m3, err := s3.Start()
+	assert.Nil(t, err)
+	for round := 1; round < 3; round++ {
+		next1, err := s1.Update(m3[0])
+		assert.Nil(t, err)
+		next3, err := s3.Update(m1[0])
+		assert.Nil(t, err)
+		m1, m3 = next1, next3
+	}
+
+	// party 3 sends a wrong partial signature
+	var m signMsg3
+	assert.Nil(t, unmarshal(m3[0].Payload, &m))
+	m.S = modN(m.S.Add(m.S, big.NewInt(1)))
+	bad, _ := s3.broadcast(3, m)
+	_, err = s1.Update(bad)
+	var fe *FaultError
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, 3, fe.Party)
+	assert.False(t, s1.Done())
+}
+
+func TestThresholdBadNonce(t *testing.T) {
+	shares := keyShares(t, []int{1, 2, 3}, 2)
+	digest := gm.HashBeforeSM2(shares[1].PublicKey, []byte("cheating"))
+	ss := signers(t, shares, []int{1, 2}, digest)
+	s1, s2 := ss[0], ss[1]
+
+	m1, _ := s1.Start()
+	m2, _ := s2.Start()
+	_, err := s1.Update(m2[0])
+	assert.Nil(t, err)
+	_, err = s2.Update(m1[0])
+	assert.Nil(t, err)
+
+	// party 2 reveals a nonce other than the committed one
+	bad, _ := s2.broadcast(2, signMsg2{R: ec.Base().Marshal()})
+	_, err = s1.Update(bad)
+	var fe *FaultError
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, 2, fe.Party)
+}
+
+func TestKeyGenBadShare(t *testing.T) {
+	parties := []int{1, 2, 3}
+	var gens []*KeyGen
+	var queue []*Message
+	for _, id := range parties {
+		g, _ := NewKeyGen(id, parties, 2, nil)
+		out, err := g.Start()
+		assert.Nil(t, err)
+		gens = append(gens, g)
+		queue = append(queue, out...)
+	}
+	// deliver the commitments, collecting the shares
+	var shares []*Message
+	for _, msg := range queue {
+		for _, g := range gens {
+			if g.ID() != msg.From {
+				out, err := g.Update(msg)
+				assert.Nil(t, err)
+				shares = append(shares, out...)
+			}
+		}
+	}
+	var err1 error
+	for _, msg := range shares {
+		if msg.From == 2 && msg.To == 1 {
+			var m keyGenMsg2
+			assert.Nil(t, unmarshal(msg.Payload, &m))
+			m.F = modN(m.F.Add(m.F, big.NewInt(1)))
+			msg, _ = gens[1].send(1, 2, m)
+		}
+		_, err := gens[msg.To-1].Update(msg)
+		if msg.To == 1 && err != nil {
+			err1 = err
+		}
+	}
+	var fe *FaultError
+	assert.True(t, errors.As(err1, &fe))
+	assert.Equal(t, 2, fe.Party)
+}
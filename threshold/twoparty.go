@@ -0,0 +1,312 @@
+package threshold
+
+import (
+	"crypto/rand"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/internal/ec"
+	"io"
+	"math/big"
+)
+
+// FaultError reports a message of party Party that fails a check of the
+// protocol, the party is either faulty or malicious.
+type FaultError struct {
+	Party  int
+	Reason string
+}
+
+func (e *FaultError) Error() string {
+	return fmt.Sprintf("threshold: party %d: %s", e.Party, e.Reason)
+}
+
+func fault(party int, reason string) error {
+	return &FaultError{Party: party, Reason: reason}
+}
+
+func context(label string, id int, extra ...[]byte) []byte {
+	b := []byte(fmt.Sprintf("%s/%d/", label, id))
+	for _, e := range extra {
+		b = append(b, e...)
+	}
+	return b
+}
+
+func defaultRand(r io.Reader) io.Reader {
+	if r == nil {
+		return rand.Reader
+	}
+	return r
+}
+
+// TwoPartyKey is the key share of one party of the two-party protocol: the
+// parties hold D = d1 and D = d2 with d1 * d2 = (1+d)^-1 mod n, where d is
+// the private key of PublicKey.
+type TwoPartyKey struct {
+	ID        int
+	D         *big.Int
+	PublicKey *gm.SM2PublicKey
+}
+
+type twoPartyKeyASN1 struct {
+	ID        int
+	D         *big.Int
+	PublicKey []byte
+}
+
+// MarshalBinary encodes the key share in DER.
+func (k *TwoPartyKey) MarshalBinary() ([]byte, error) {
+	pub, _ := k.PublicKey.Bytes()
+	return asn1.Marshal(twoPartyKeyASN1{ID: k.ID, D: k.D, PublicKey: pub})
+}
+
+// UnmarshalBinary decodes a key share encoded by MarshalBinary.
+func (k *TwoPartyKey) UnmarshalBinary(b []byte) error {
+	var v twoPartyKeyASN1
+	if err := unmarshal(b, &v); err != nil {
+		return err
+	}
+	if v.ID != 1 && v.ID != 2 {
+		return errors.New("threshold: two-party identifier must be 1 or 2")
+	}
+	if checkScalar(v.D) != nil || v.D.Sign() == 0 {
+		return errors.New("threshold: invalid key share")
+	}
+	p, err := ec.Unmarshal(v.PublicKey)
+	if err != nil {
+		return err
+	}
+	k.ID, k.D, k.PublicKey = v.ID, v.D, p.PublicKey()
+	return nil
+}
+
+func peerOf(id int) ([]int, error) {
+	switch id {
+	case 1:
+		return []int{2}, nil
+	case 2:
+		return []int{1}, nil
+	}
+	return nil, errors.New("threshold: two-party identifier must be 1 or 2")
+}
+
+type twoPartyKeyGenMsg struct {
+	P     []byte
+	Proof schnorrProof
+}
+
+// TwoPartyKeyGen generates a two-party key. In its single round each party
+// sends Pi = di^-1 * G with a proof of knowledge of di^-1, the public key is
+// P = (d1 * d2)^-1 * G - G.
+type TwoPartyKeyGen struct {
+	rounds
+	rand io.Reader
+	d    *big.Int
+	key  *TwoPartyKey
+}
+
+// NewTwoPartyKeyGen returns the key generation of party id, 1 or 2. A nil
+// rand uses crypto/rand.
+func NewTwoPartyKeyGen(id int, rand io.Reader) (*TwoPartyKeyGen, error) {
+	peers, err := peerOf(id)
+	if err != nil {
+		return nil, err
+	}
+	g := &TwoPartyKeyGen{rand: defaultRand(rand)}
+	g.rounds = rounds{id: id, peers: peers, step: g.step}
+	return g, nil
+}
+
+// Start draws the key share and returns the message of the party.
+func (g *TwoPartyKeyGen) Start() ([]*Message, error) {
+	d, err := ec.RandScalar(g.rand)
+	if err != nil {
+		return nil, err
+	}
+	g.d = d
+	dInv := new(big.Int).ModInverse(d, ec.N)
+	proof, err := proveDL(g.rand, context("2p-keygen", g.id), ec.Base(), dInv)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := g.broadcast(1, twoPartyKeyGenMsg{P: ec.BaseMul(dInv).Marshal(), Proof: proof})
+	if err != nil {
+		return nil, err
+	}
+	return g.start(1, []*Message{msg})
+}
+
+func (g *TwoPartyKeyGen) step(_ int, msgs map[int]*Message) ([]*Message, error) {
+	peer := g.peers[0]
+	var m twoPartyKeyGenMsg
+	if err := unmarshal(msgs[peer].Payload, &m); err != nil {
+		return nil, fault(peer, err.Error())
+	}
+	p, err := ec.Unmarshal(m.P)
+	if err != nil {
+		return nil, fault(peer, err.Error())
+	}
+	if !m.Proof.verify(context("2p-keygen", peer), ec.Base(), p) {
+		return nil, fault(peer, "invalid proof of knowledge of the key share")
+	}
+	pub := ec.Add(ec.Mul(p, new(big.Int).ModInverse(g.d, ec.N)), ec.Base().Neg())
+	if pub.IsInfinity() {
+		return nil, errors.New("threshold: degenerate public key, generate again")
+	}
+	g.key = &TwoPartyKey{ID: g.id, D: g.d, PublicKey: pub.PublicKey()}
+	g.done = true
+	return nil, nil
+}
+
+// Key returns the key share once the protocol is done.
+func (g *TwoPartyKeyGen) Key() (*TwoPartyKey, error) {
+	if g.key == nil {
+		return nil, errors.New("threshold: key generation not finished")
+	}
+	return g.key, nil
+}
+
+type twoPartySignMsg1 struct {
+	Q     []byte
+	Proof schnorrProof
+}
+
+type twoPartySignMsg2 struct {
+	R, S2, S3 *big.Int
+}
+
+// TwoPartySigner signs a digest with a two-party key. Party 1 sends
+// Q1 = k1 * G, party 2 answers with r, s2 = d2 * k3 and s3 = d2 * (r + k2),
+// from which party 1 computes s = d1 * k1 * s2 + d1 * s3 - r; only party 1
+// learns the signature.
+type TwoPartySigner struct {
+	rounds
+	rand   io.Reader
+	key    *TwoPartyKey
+	digest []byte
+	e      *big.Int
+	k1     *big.Int
+	sig    []byte
+}
+
+// NewTwoPartySigner returns the signer of the party holding key for digest,
+// the 32-byte hash returned by gm.HashBeforeSM2. A nil rand uses crypto/rand.
+func NewTwoPartySigner(key *TwoPartyKey, digest []byte, rand io.Reader) (*TwoPartySigner, error) {
+	peers, err := peerOf(key.ID)
+	if err != nil {
+		return nil, err
+	}
+	e, err := digestScalar(digest)
+	if err != nil {
+		return nil, err
+	}
+	s := &TwoPartySigner{rand: defaultRand(rand), key: key, digest: append([]byte(nil), digest...), e: e}
+	s.rounds = rounds{id: key.ID, peers: peers, step: s.step}
+	return s, nil
+}
+
+// Start returns the message of party 1, party 2 waits for it.
+func (s *TwoPartySigner) Start() ([]*Message, error) {
+	if s.id == 2 {
+		return s.start(1, nil)
+	}
+	k1, err := ec.RandScalar(s.rand)
+	if err != nil {
+		return nil, err
+	}
+	s.k1 = k1
+	proof, err := proveDL(s.rand, context("2p-sign", 1, s.digest), ec.Base(), k1)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := s.send(2, 1, twoPartySignMsg1{Q: ec.BaseMul(k1).Marshal(), Proof: proof})
+	if err != nil {
+		return nil, err
+	}
+	return s.start(2, []*Message{msg})
+}
+
+func (s *TwoPartySigner) step(round int, msgs map[int]*Message) ([]*Message, error) {
+	peer := s.peers[0]
+	if round == 1 {
+		var m twoPartySignMsg1
+		if err := unmarshal(msgs[peer].Payload, &m); err != nil {
+			return nil, fault(peer, err.Error())
+		}
+		q1, err := ec.Unmarshal(m.Q)
+		if err != nil {
+			return nil, fault(peer, err.Error())
+		}
+		if !m.Proof.verify(context("2p-sign", 1, s.digest), ec.Base(), q1) {
+			return nil, fault(peer, "invalid proof of knowledge of the nonce")
+		}
+		reply, err := s.respond(q1)
+		if err != nil {
+			return nil, err
+		}
+		s.done = true
+		msg, err := s.send(1, 2, reply)
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+	}
+
+	var m twoPartySignMsg2
+	if err := unmarshal(msgs[peer].Payload, &m); err != nil {
+		return nil, fault(peer, err.Error())
+	}
+	for _, v := range []*big.Int{m.R, m.S2, m.S3} {
+		if checkScalar(v) != nil {
+			return nil, fault(peer, "scalar out of range")
+		}
+	}
+	d1 := s.key.D
+	sum := new(big.Int).Mul(d1, s.k1)
+	sum.Mul(modN(sum), m.S2)
+	sum.Add(sum, new(big.Int).Mul(d1, m.S3))
+	sum.Sub(sum, m.R)
+	sig, err := signature(s.key.PublicKey, s.digest, m.R, modN(sum))
+	if err != nil {
+		return nil, err
+	}
+	s.sig = sig
+	s.done = true
+	return nil, nil
+}
+
+// respond is the computation of party 2.
+func (s *TwoPartySigner) respond(q1 ec.Point) (twoPartySignMsg2, error) {
+	for {
+		k2, err := ec.RandScalar(s.rand)
+		if err != nil {
+			return twoPartySignMsg2{}, err
+		}
+		k3, err := ec.RandScalar(s.rand)
+		if err != nil {
+			return twoPartySignMsg2{}, err
+		}
+		p := ec.Add(ec.Mul(q1, k3), ec.BaseMul(k2))
+		if p.IsInfinity() {
+			continue
+		}
+		r := modN(new(big.Int).Add(s.e, p.X()))
+		if r.Sign() == 0 {
+			continue
+		}
+		d2 := s.key.D
+		s2 := modN(new(big.Int).Mul(d2, k3))
+		s3 := modN(new(big.Int).Mul(d2, new(big.Int).Add(r, k2)))
+		return twoPartySignMsg2{R: r, S2: s2, S3: s3}, nil
+	}
+}
+
+// Signature returns the DER encoded signature, only party 1 learns it.
+func (s *TwoPartySigner) Signature() ([]byte, error) {
+	if s.sig == nil {
+		return nil, errors.New("threshold: no signature, the signer is party 2 or not finished")
+	}
+	return s.sig, nil
+}
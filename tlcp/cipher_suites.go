@@ -0,0 +1,232 @@
+package tlcp
+
+import (
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"hash"
+	"io"
+)
+
+//TLCP cipher suites, GB/T 38636-2020 6.4.5.2.
+const (
+	ECDHE_SM4_CBC_SM3 uint16 = 0xe011
+	ECDHE_SM4_GCM_SM3 uint16 = 0xe051
+	ECC_SM4_CBC_SM3   uint16 = 0xe013
+	ECC_SM4_GCM_SM3   uint16 = 0xe053
+)
+
+type cipherSuite struct {
+	id    uint16
+	name  string
+	ecdhe bool
+	aead  bool
+	// lengths of the MAC key, the write key and the fixed iv
+	macLen, keyLen, ivLen int
+}
+
+var cipherSuites = []*cipherSuite{
+	{ECC_SM4_GCM_SM3, "ECC_SM4_GCM_SM3", false, true, 0, 16, 4},
+	{ECC_SM4_CBC_SM3, "ECC_SM4_CBC_SM3", false, false, 32, 16, 16},
+	{ECDHE_SM4_GCM_SM3, "ECDHE_SM4_GCM_SM3", true, true, 0, 16, 4},
+	{ECDHE_SM4_CBC_SM3, "ECDHE_SM4_CBC_SM3", true, false, 32, 16, 16},
+}
+
+var defaultCipherSuites = []uint16{ECC_SM4_GCM_SM3, ECC_SM4_CBC_SM3, ECDHE_SM4_GCM_SM3, ECDHE_SM4_CBC_SM3}
+
+func cipherSuiteByID(id uint16) *cipherSuite {
+	for _, suite := range cipherSuites {
+		if suite.id == id {
+			return suite
+		}
+	}
+	return nil
+}
+
+//CipherSuiteName returns the standard name for the passed cipher suite ID,
+// or a fallback representation of the ID value if the suite is not
+// implemented by this package.
+func CipherSuiteName(id uint16) string {
+	if suite := cipherSuiteByID(id); suite != nil {
+		return suite.name
+	}
+	return fmt.Sprintf("0x%04X", id)
+}
+
+func (s *cipherSuite) keyAgreement() keyAgreement {
+	if s.ecdhe {
+		return &ecdheKeyAgreement{}
+	}
+	return eccKeyAgreement{}
+}
+
+func (s *cipherSuite) newCipher(macKey, key, iv []byte) (recordCipher, error) {
+	block, err := gm.GetSm4Cipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if s.aead {
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		ret := &gcmCipher{aead: aead}
+		copy(ret.fixedNonce[:], iv)
+		return ret, nil
+	}
+	return &cbcCipher{block: block, mac: hmac.New(gm.GetSM3Hasher, macKey)}, nil
+}
+
+// recordCipher protects the payload of TLCP records.
+type recordCipher interface {
+	// seal returns the protected form of payload.
+	seal(seq *[8]byte, typ recordType, payload []byte, rand io.Reader) ([]byte, error)
+	// open returns the payload of a protected record body.
+	open(seq *[8]byte, typ recordType, body []byte) ([]byte, error)
+}
+
+var errBadRecordMAC = errors.New("tlcp: bad record MAC")
+
+// additionalData returns seq_num + type + version + length.
+func additionalData(seq *[8]byte, typ recordType, length int) []byte {
+	ad := make([]byte, 13)
+	copy(ad, seq[:])
+	ad[8] = byte(typ)
+	ad[9] = byte(VersionTLCP >> 8)
+	ad[10] = byte(VersionTLCP & 0xff)
+	ad[11] = byte(length >> 8)
+	ad[12] = byte(length)
+	return ad
+}
+
+// cbcCipher is SM4-CBC with an explicit IV and HMAC-SM3, MAC-then-encrypt.
+type cbcCipher struct {
+	block cipher.Block
+	mac   hash.Hash
+}
+
+// computeMAC appends the MAC of the record to out. extra is hashed after the
+// MAC is taken, so that the number of compression function calls does not
+// depend on the length of the padding.
+func (c *cbcCipher) computeMAC(out []byte, seq *[8]byte, typ recordType, payload, extra []byte) []byte {
+	c.mac.Reset()
+	_, _ = c.mac.Write(additionalData(seq, typ, len(payload)))
+	_, _ = c.mac.Write(payload)
+	out = c.mac.Sum(out)
+	_, _ = c.mac.Write(extra)
+	return out
+}
+
+func (c *cbcCipher) seal(seq *[8]byte, typ recordType, payload []byte, rand io.Reader) ([]byte, error) {
+	blockSize := c.block.BlockSize()
+	mac := c.computeMAC(nil, seq, typ, payload, nil)
+
+	paddingLen := blockSize - (len(payload)+len(mac))%blockSize
+	body := make([]byte, blockSize, blockSize+len(payload)+len(mac)+paddingLen)
+	if _, err := io.ReadFull(rand, body); err != nil {
+		return nil, err
+	}
+	body = append(body, payload...)
+	body = append(body, mac...)
+	for i := 0; i < paddingLen; i++ {
+		body = append(body, byte(paddingLen-1))
+	}
+	cipher.NewCBCEncrypter(c.block, body[:blockSize]).CryptBlocks(body[blockSize:], body[blockSize:])
+	return body, nil
+}
+
+func (c *cbcCipher) open(seq *[8]byte, typ recordType, body []byte) ([]byte, error) {
+	blockSize := c.block.BlockSize()
+	macSize := c.mac.Size()
+	if len(body)%blockSize != 0 || len(body) < blockSize+roundUp(macSize+1, blockSize) {
+		return nil, errBadRecordMAC
+	}
+	plaintext := make([]byte, len(body)-blockSize)
+	cipher.NewCBCDecrypter(c.block, body[:blockSize]).CryptBlocks(plaintext, body[blockSize:])
+
+	// the padding and the MAC are checked in constant time, as in crypto/tls
+	paddingLen, good := extractPadding(plaintext)
+	n := len(plaintext) - macSize - paddingLen
+	n = subtle.ConstantTimeSelect(int(uint32(n)>>31), 0, n) // if n < 0 { n = 0 }
+	mac := c.computeMAC(nil, seq, typ, plaintext[:n], plaintext[n+macSize:])
+	if subtle.ConstantTimeCompare(mac, plaintext[n:n+macSize])&int(good) != 1 {
+		return nil, errBadRecordMAC
+	}
+	return plaintext[:n], nil
+}
+
+// extractPadding returns the length of the padding to remove from payload,
+// and 255 if the padding is valid or 0 otherwise. It scans the same number
+// of bytes whatever the padding, on error the length is that of the length
+// byte alone so that the other bytes are covered by the MAC.
+func extractPadding(payload []byte) (toRemove int, good byte) {
+	if len(payload) < 1 {
+		return 0, 0
+	}
+	paddingLen := payload[len(payload)-1]
+	t := uint(len(payload)-1) - uint(paddingLen)
+	// the MSB of t is zero if len(payload) > paddingLen
+	good = byte(int32(^t) >> 31)
+
+	// the longest padding is 255 bytes and its length byte
+	toCheck := 256
+	if toCheck > len(payload) {
+		toCheck = len(payload)
+	}
+	for i := 0; i < toCheck; i++ {
+		t := uint(paddingLen) - uint(i)
+		// the MSB of t is zero if i <= paddingLen
+		mask := byte(int32(^t) >> 31)
+		b := payload[len(payload)-1-i]
+		good &^= mask&paddingLen ^ mask&b
+	}
+
+	// good is 255 when all of its bits are set, 0 otherwise
+	good &= good << 4
+	good &= good << 2
+	good &= good << 1
+	good = uint8(int8(good) >> 7)
+
+	paddingLen &= good
+	toRemove = int(paddingLen) + 1
+	return
+}
+
+func roundUp(a, b int) int {
+	return a + (b-a%b)%b
+}
+
+// gcmCipher is SM4-GCM with a 4 byte fixed and an 8 byte explicit nonce,
+// as in RFC 5288.
+type gcmCipher struct {
+	aead       cipher.AEAD
+	fixedNonce [4]byte
+}
+
+func (c *gcmCipher) seal(seq *[8]byte, typ recordType, payload []byte, _ io.Reader) ([]byte, error) {
+	nonce := make([]byte, 12)
+	copy(nonce, c.fixedNonce[:])
+	// the sequence number is unique per key, so it is used as the explicit nonce
+	copy(nonce[4:], seq[:])
+	body := make([]byte, 8, 8+len(payload)+c.aead.Overhead())
+	copy(body, seq[:])
+	return c.aead.Seal(body, nonce, payload, additionalData(seq, typ, len(payload))), nil
+}
+
+func (c *gcmCipher) open(seq *[8]byte, typ recordType, body []byte) ([]byte, error) {
+	if len(body) < 8+c.aead.Overhead() {
+		return nil, errBadRecordMAC
+	}
+	nonce := make([]byte, 12)
+	copy(nonce, c.fixedNonce[:])
+	copy(nonce[4:], body[:8])
+	ciphertext := body[8:]
+	payload, err := c.aead.Open(nil, nonce, ciphertext, additionalData(seq, typ, len(ciphertext)-c.aead.Overhead()))
+	if err != nil {
+		return nil, errBadRecordMAC
+	}
+	return payload, nil
+}
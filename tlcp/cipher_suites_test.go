@@ -0,0 +1,112 @@
+package tlcp
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRecordCiphers(t *testing.T) {
+	for _, suite := range cipherSuites {
+		macKey := bytes.Repeat([]byte{1}, suite.macLen)
+		key := bytes.Repeat([]byte{2}, suite.keyLen)
+		iv := bytes.Repeat([]byte{3}, suite.ivLen)
+		sealer, err := suite.newCipher(macKey, key, iv)
+		assert.Nil(t, err)
+		opener, err := suite.newCipher(macKey, key, iv)
+		assert.Nil(t, err)
+
+		var seq [8]byte
+		for _, n := range []int{0, 1, 15, 16, 17, 100, maxPlaintext} {
+			payload := make([]byte, n)
+			_, _ = rand.Read(payload)
+			body, err := sealer.seal(&seq, recordTypeApplicationData, payload, rand.Reader)
+			assert.Nil(t, err)
+			assert.True(t, len(body) <= maxCiphertext)
+
+			got, err := opener.open(&seq, recordTypeApplicationData, body)
+			assert.Nil(t, err, suite.name)
+			assert.True(t, bytes.Equal(payload, got), suite.name)
+
+			// the sequence number, the record type and the body are authenticated
+			seq[7]++
+			_, err = opener.open(&seq, recordTypeApplicationData, body)
+			assert.Equal(t, errBadRecordMAC, err, suite.name)
+			_, err = opener.open(&seq, recordTypeHandshake, body)
+			assert.Equal(t, errBadRecordMAC, err, suite.name)
+			body[len(body)-1] ^= 1
+			_, err = opener.open(&seq, recordTypeApplicationData, body)
+			assert.Equal(t, errBadRecordMAC, err, suite.name)
+		}
+
+		_, err = opener.open(&seq, recordTypeApplicationData, []byte{1, 2, 3})
+		assert.Equal(t, errBadRecordMAC, err, suite.name)
+	}
+}
+
+// sealPadded seals payload as cbcCipher.seal with the given padding bytes.
+func sealPadded(c *cbcCipher, seq *[8]byte, payload, padding []byte) []byte {
+	body := make([]byte, c.block.BlockSize())
+	body = append(body, payload...)
+	body = c.computeMAC(body, seq, recordTypeApplicationData, payload, nil)
+	body = append(body, padding...)
+	cipher.NewCBCEncrypter(c.block, body[:c.block.BlockSize()]).CryptBlocks(body[c.block.BlockSize():], body[c.block.BlockSize():])
+	return body
+}
+
+func TestCBCPadding(t *testing.T) {
+	suite := cipherSuiteByID(ECC_SM4_CBC_SM3)
+	rc, err := suite.newCipher(bytes.Repeat([]byte{1}, suite.macLen), bytes.Repeat([]byte{2}, suite.keyLen), nil)
+	assert.Nil(t, err)
+	c := rc.(*cbcCipher)
+	var seq [8]byte
+	payload := []byte("hello, world")
+
+	// 12 bytes of payload and 32 of MAC, padded to 48 or to 48+16*k
+	for _, n := range []int{4, 20, 244} {
+		padding := bytes.Repeat([]byte{byte(n - 1)}, n)
+		got, err := c.open(&seq, recordTypeApplicationData, sealPadded(c, &seq, payload, padding))
+		assert.Nil(t, err, n)
+		assert.Equal(t, payload, got, n)
+	}
+
+	for _, padding := range [][]byte{
+		{3, 3, 2, 3},
+		{2, 3, 3, 3},
+		{4, 4, 4, 4},
+		append([]byte{19, 18}, bytes.Repeat([]byte{19}, 18)...),
+		append(bytes.Repeat([]byte{0}, 3), 255),
+	} {
+		_, err := c.open(&seq, recordTypeApplicationData, sealPadded(c, &seq, payload, padding))
+		assert.Equal(t, errBadRecordMAC, err, padding)
+	}
+}
+
+func TestExtractPadding(t *testing.T) {
+	tests := []struct {
+		payload  []byte
+		toRemove int
+		good     byte
+	}{
+		{[]byte{}, 0, 0},
+		{[]byte{0}, 1, 255},
+		{[]byte{1, 1}, 2, 255},
+		{[]byte{9, 1, 1}, 2, 255},
+		{[]byte{9, 2, 1}, 1, 0},
+		{[]byte{1}, 1, 0},
+		{append([]byte{9}, bytes.Repeat([]byte{255}, 256)...), 256, 255},
+		{bytes.Repeat([]byte{255}, 255), 1, 0},
+	}
+	for _, tt := range tests {
+		toRemove, good := extractPadding(tt.payload)
+		assert.Equal(t, tt.toRemove, toRemove, tt.payload)
+		assert.Equal(t, tt.good, good, tt.payload)
+	}
+}
+
+func TestCipherSuiteName(t *testing.T) {
+	assert.Equal(t, "ECDHE_SM4_GCM_SM3", CipherSuiteName(ECDHE_SM4_GCM_SM3))
+	assert.Equal(t, "0x1301", CipherSuiteName(0x1301))
+}
@@ -0,0 +1,344 @@
+package tlcp
+
+import (
+	"container/list"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+//VersionTLCP is the protocol version of GB/T 38636-2020.
+const VersionTLCP = 0x0101
+
+const (
+	maxPlaintext    = 16384        // maximum plaintext payload length
+	maxCiphertext   = 16384 + 2048 // maximum ciphertext payload length
+	recordHeaderLen = 5            // record header length
+	maxHandshake    = 65536        // maximum handshake we support (protocol max is 16 MB)
+
+	sessionTimeout = 24 * time.Hour
+)
+
+// TLCP record types.
+type recordType uint8
+
+const (
+	recordTypeChangeCipherSpec recordType = 20
+	recordTypeAlert            recordType = 21
+	recordTypeHandshake        recordType = 22
+	recordTypeApplicationData  recordType = 23
+)
+
+// TLCP handshake message types.
+const (
+	typeClientHello        uint8 = 1
+	typeServerHello        uint8 = 2
+	typeCertificate        uint8 = 11
+	typeServerKeyExchange  uint8 = 12
+	typeCertificateRequest uint8 = 13
+	typeServerHelloDone    uint8 = 14
+	typeCertificateVerify  uint8 = 15
+	typeClientKeyExchange  uint8 = 16
+	typeFinished           uint8 = 20
+)
+
+const (
+	compressionNone uint8 = 0
+
+	// certTypeECDSASign is the certificate type GB/T 38636 requests SM2
+	// certificates with.
+	certTypeECDSASign uint8 = 64
+
+	// curveSM2 is the named curve id of SM2, RFC 8998.
+	curveSM2 uint16 = 41
+	// curveTypeNamedCurve is the ECCurveType of RFC 4492.
+	curveTypeNamedCurve uint8 = 3
+)
+
+// defaultUID is the SM2 user id used for every signature and key exchange.
+var defaultUID = []byte("1234567812345678")
+
+//ClientAuthType declares the policy the server will follow for client
+// authentication.
+type ClientAuthType int
+
+//ClientAuthType values, the same as in crypto/tls.
+const (
+	NoClientCert ClientAuthType = iota
+	RequestClientCert
+	RequireAnyClientCert
+	VerifyClientCertIfGiven
+	RequireAndVerifyClientCert
+)
+
+//Certificate is a chain of one or more certificates, leaf first, with the
+// SM2 private key of the leaf.
+type Certificate struct {
+	Certificate [][]byte
+	PrivateKey  *gm.SM2PrivateKey
+	// Leaf is the parsed form of the leaf certificate, it is filled in on
+	// first use if nil.
+	Leaf *x509.Certificate
+}
+
+func (c *Certificate) leaf() (*x509.Certificate, error) {
+	if c.Leaf != nil {
+		return c.Leaf, nil
+	}
+	if len(c.Certificate) == 0 {
+		return nil, errors.New("tlcp: empty certificate")
+	}
+	return x509.ParseCertificate(c.Certificate[0])
+}
+
+//X509KeyPair parses a public/private key pair from a pair of PEM encoded
+// data. The private key may be PKCS #8 or SEC 1 encoded.
+func X509KeyPair(certPEMBlock, keyPEMBlock []byte) (Certificate, error) {
+	var cert Certificate
+	for {
+		var block *pem.Block
+		block, certPEMBlock = pem.Decode(certPEMBlock)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			cert.Certificate = append(cert.Certificate, block.Bytes)
+		}
+	}
+	if len(cert.Certificate) == 0 {
+		return cert, errors.New("tlcp: failed to find any PEM data in certificate input")
+	}
+
+	var keyDER []byte
+	for {
+		var block *pem.Block
+		block, keyPEMBlock = pem.Decode(keyPEMBlock)
+		if block == nil {
+			return cert, errors.New("tlcp: failed to find PEM block with type ending in \"PRIVATE KEY\" in key input")
+		}
+		if block.Type == "PRIVATE KEY" || block.Type == "EC PRIVATE KEY" || block.Type == "SM2 PRIVATE KEY" {
+			keyDER = block.Bytes
+			break
+		}
+	}
+	key, err := x509.ParsePKCS8PrivateKey(keyDER)
+	if err != nil {
+		if key, err = x509.ParseSM2PrivateKey(keyDER); err != nil {
+			return cert, errors.New("tlcp: failed to parse private key")
+		}
+	}
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return cert, err
+	}
+	pub, ok := leaf.PublicKey.(*gm.SM2PublicKey)
+	if !ok {
+		return cert, errors.New("tlcp: certificate does not contain an SM2 public key")
+	}
+	if pub.X != key.PublicKey.X || pub.Y != key.PublicKey.Y {
+		return cert, errors.New("tlcp: private key does not match public key")
+	}
+	cert.PrivateKey = key
+	cert.Leaf = leaf
+	return cert, nil
+}
+
+//LoadX509KeyPair reads and parses a public/private key pair from a pair of
+// files.
+func LoadX509KeyPair(certFile, keyFile string) (Certificate, error) {
+	certPEMBlock, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return Certificate{}, err
+	}
+	keyPEMBlock, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return Certificate{}, err
+	}
+	return X509KeyPair(certPEMBlock, keyPEMBlock)
+}
+
+//Config is used to configure a TLCP client or server. A Config may be reused
+// and must not be modified after it has been passed to a TLCP function.
+type Config struct {
+	// Rand provides the source of entropy for nonces and premaster secrets.
+	// If Rand is nil, crypto/rand.Reader is used.
+	Rand io.Reader
+
+	// Time returns the current time. If Time is nil, time.Now is used.
+	Time func() time.Time
+
+	// Certificates holds the signing certificate followed by the encryption
+	// certificate. Servers must set both; clients need them for client
+	// authentication and for the ECDHE cipher suites.
+	Certificates []Certificate
+
+	// RootCAs defines the root certificate authorities clients use to verify
+	// the server certificates.
+	RootCAs *x509.CertPool
+
+	// ServerName is used by clients to verify the hostname of the signing
+	// certificate unless InsecureSkipVerify is set.
+	ServerName string
+
+	// ClientAuth determines the server's policy for client authentication.
+	// The ECDHE cipher suites always require the client certificates.
+	ClientAuth ClientAuthType
+
+	// ClientCAs defines the root certificate authorities servers use to
+	// verify client certificates.
+	ClientCAs *x509.CertPool
+
+	// InsecureSkipVerify controls whether a client verifies the server's
+	// certificate chains and host name. It is for testing only.
+	InsecureSkipVerify bool
+
+	// CipherSuites is the list of enabled cipher suites, in preference
+	// order for clients. If nil, all suites are enabled.
+	CipherSuites []uint16
+
+	// SessionCache enables session resumption. Clients key sessions by
+	// server name and servers by session id.
+	SessionCache SessionCache
+}
+
+func (c *Config) rand() io.Reader {
+	if c.Rand == nil {
+		return rand.Reader
+	}
+	return c.Rand
+}
+
+func (c *Config) time() time.Time {
+	if c.Time == nil {
+		return time.Now()
+	}
+	return c.Time()
+}
+
+func (c *Config) cipherSuites() []uint16 {
+	if c.CipherSuites == nil {
+		return defaultCipherSuites
+	}
+	return c.CipherSuites
+}
+
+// signAndEncCertificates returns the signing and the encryption certificate
+// of c, or nil if c does not hold both.
+func (c *Config) signAndEncCertificates() (sign, enc *Certificate) {
+	if len(c.Certificates) < 2 || c.Certificates[0].PrivateKey == nil || c.Certificates[1].PrivateKey == nil {
+		return nil, nil
+	}
+	return &c.Certificates[0], &c.Certificates[1]
+}
+
+//ConnectionState records basic details about the connection.
+type ConnectionState struct {
+	Version           uint16
+	HandshakeComplete bool
+	DidResume         bool
+	CipherSuite       uint16
+	ServerName        string
+	// PeerCertificates are the signing and the encryption certificate of
+	// the peer followed by the rest of its chain.
+	PeerCertificates []*x509.Certificate
+	VerifiedChains   [][]*x509.Certificate
+}
+
+//SessionState contains the state needed to resume a session.
+type SessionState struct {
+	sessionID        []byte
+	cipherSuite      uint16
+	masterSecret     []byte
+	peerCertificates []*x509.Certificate
+	verifiedChains   [][]*x509.Certificate
+	createdAt        time.Time
+}
+
+//SessionCache is a cache of SessionState objects used for session
+// resumption. Implementations must be safe for concurrent use.
+type SessionCache interface {
+	// Get searches for a SessionState associated with the given key.
+	Get(key string) (session *SessionState, ok bool)
+	// Put adds the SessionState to the cache with the given key, a nil
+	// session removes the key.
+	Put(key string, session *SessionState)
+}
+
+type lruSessionCache struct {
+	sync.Mutex
+
+	m        map[string]*list.Element
+	q        *list.List
+	capacity int
+}
+
+type lruSessionCacheEntry struct {
+	key   string
+	state *SessionState
+}
+
+//NewLRUSessionCache returns a SessionCache with the given capacity that uses
+// an LRU strategy. If capacity is < 1, a default capacity is used instead.
+func NewLRUSessionCache(capacity int) SessionCache {
+	const defaultSessionCacheCapacity = 64
+
+	if capacity < 1 {
+		capacity = defaultSessionCacheCapacity
+	}
+	return &lruSessionCache{
+		m:        make(map[string]*list.Element),
+		q:        list.New(),
+		capacity: capacity,
+	}
+}
+
+func (c *lruSessionCache) Put(key string, session *SessionState) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.m[key]; ok {
+		if session == nil {
+			c.q.Remove(elem)
+			delete(c.m, key)
+		} else {
+			elem.Value.(*lruSessionCacheEntry).state = session
+			c.q.MoveToFront(elem)
+		}
+		return
+	}
+	if session == nil {
+		return
+	}
+
+	if c.q.Len() < c.capacity {
+		c.m[key] = c.q.PushFront(&lruSessionCacheEntry{key, session})
+		return
+	}
+
+	// reuse the least recently used entry
+	elem := c.q.Back()
+	entry := elem.Value.(*lruSessionCacheEntry)
+	delete(c.m, entry.key)
+	entry.key = key
+	entry.state = session
+	c.q.MoveToFront(elem)
+	c.m[key] = elem
+}
+
+func (c *lruSessionCache) Get(key string) (*SessionState, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.m[key]; ok {
+		c.q.MoveToFront(elem)
+		return elem.Value.(*lruSessionCacheEntry).state, true
+	}
+	return nil, false
+}
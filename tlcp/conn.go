@@ -0,0 +1,578 @@
+package tlcp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/meshplus/crypto-gm/x509"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//Conn represents a secured connection. It implements the net.Conn
+// interface.
+type Conn struct {
+	// constant
+	conn     net.Conn
+	isClient bool
+	config   *Config
+
+	// handshakeStatus is 1 if the connection is currently transferring
+	// application data, it is accessed atomically.
+	handshakeStatus uint32
+	// constant after handshake; protected by handshakeMutex
+	handshakeMutex   sync.Mutex
+	handshakeErr     error
+	didResume        bool
+	cipherSuite      uint16
+	peerCertificates []*x509.Certificate
+	verifiedChains   [][]*x509.Certificate
+
+	// input/output
+	in, out  halfConn
+	rawInput bytes.Buffer // raw input, starting with a record header
+	input    bytes.Reader // application data waiting to be read
+	hand     bytes.Buffer // handshake data waiting to be read
+
+	// buffering makes the records of a handshake flight be sent at once
+	buffering bool
+	sendBuf   []byte
+
+	closeNotifyErr  error
+	closeNotifySent bool
+}
+
+// halfConn represents one direction of the record layer connection.
+type halfConn struct {
+	sync.Mutex
+
+	err        error        // first permanent error
+	cipher     recordCipher // nil before the first ChangeCipherSpec
+	seq        [8]byte      // 64-bit sequence number
+	nextCipher recordCipher // next cipher, pending ChangeCipherSpec
+}
+
+func (hc *halfConn) setErrorLocked(err error) error {
+	hc.err = err
+	return err
+}
+
+// prepareCipherSpec sets the cipher to switch to on the next
+// ChangeCipherSpec.
+func (hc *halfConn) prepareCipherSpec(cipher recordCipher) {
+	hc.nextCipher = cipher
+}
+
+// changeCipherSpec changes the cipher and resets the sequence number.
+func (hc *halfConn) changeCipherSpec() error {
+	if hc.nextCipher == nil {
+		return alertInternalError
+	}
+	hc.cipher = hc.nextCipher
+	hc.nextCipher = nil
+	hc.seq = [8]byte{}
+	return nil
+}
+
+// incSeq increments the sequence number.
+func (hc *halfConn) incSeq() {
+	for i := 7; i >= 0; i-- {
+		hc.seq[i]++
+		if hc.seq[i] != 0 {
+			return
+		}
+	}
+
+	// Not allowed to let sequence number wrap.
+	panic("tlcp: sequence number wraparound")
+}
+
+// decrypt returns the payload of the record body.
+func (hc *halfConn) decrypt(typ recordType, body []byte) ([]byte, error) {
+	if hc.cipher == nil {
+		return append([]byte(nil), body...), nil
+	}
+	payload, err := hc.cipher.open(&hc.seq, typ, body)
+	if err != nil {
+		return nil, err
+	}
+	hc.incSeq()
+	return payload, nil
+}
+
+// encrypt returns the record, header included, carrying payload.
+func (hc *halfConn) encrypt(typ recordType, payload []byte, rand io.Reader) ([]byte, error) {
+	body := payload
+	if hc.cipher != nil {
+		var err error
+		if body, err = hc.cipher.seal(&hc.seq, typ, payload, rand); err != nil {
+			return nil, err
+		}
+		hc.incSeq()
+	}
+	record := make([]byte, recordHeaderLen, recordHeaderLen+len(body))
+	record[0] = byte(typ)
+	record[1] = byte(VersionTLCP >> 8)
+	record[2] = byte(VersionTLCP & 0xff)
+	record[3] = byte(len(body) >> 8)
+	record[4] = byte(len(body))
+	return append(record, body...), nil
+}
+
+// readFromUntil reads from r into c.rawInput until it holds at least n bytes.
+func (c *Conn) readFromUntil(r io.Reader, n int) error {
+	if c.rawInput.Len() >= n {
+		return nil
+	}
+	needs := n - c.rawInput.Len()
+	c.rawInput.Grow(needs + bytes.MinRead)
+	_, err := c.rawInput.ReadFrom(&atLeastReader{r, int64(needs)})
+	return err
+}
+
+// atLeastReader reads from R, stopping with EOF once at least N bytes have
+// been read. It is different from an io.LimitedReader in that it doesn't
+// cut short the last Read call.
+type atLeastReader struct {
+	R io.Reader
+	N int64
+}
+
+func (r *atLeastReader) Read(p []byte) (int, error) {
+	if r.N <= 0 {
+		return 0, io.EOF
+	}
+	n, err := r.R.Read(p)
+	r.N -= int64(n)
+	if r.N > 0 && err == io.EOF {
+		return n, io.ErrUnexpectedEOF
+	}
+	if r.N <= 0 && err == nil {
+		return n, io.EOF
+	}
+	return n, err
+}
+
+// readRecord reads the next record, handshake data is appended to c.hand and
+// application data is put into c.input.
+func (c *Conn) readRecord() error {
+	return c.readRecordOrCCS(false)
+}
+
+func (c *Conn) readChangeCipherSpec() error {
+	return c.readRecordOrCCS(true)
+}
+
+func (c *Conn) readRecordOrCCS(expectChangeCipherSpec bool) error {
+	if c.in.err != nil {
+		return c.in.err
+	}
+	handshakeComplete := c.handshakeComplete()
+
+	if c.input.Len() != 0 {
+		return c.in.setErrorLocked(errors.New("tlcp: internal error: attempted to read record with pending application data"))
+	}
+	c.input.Reset(nil)
+
+	if err := c.readFromUntil(c.conn, recordHeaderLen); err != nil {
+		if e, ok := err.(net.Error); !ok || !e.Temporary() {
+			c.in.setErrorLocked(err)
+		}
+		return err
+	}
+	hdr := c.rawInput.Bytes()[:recordHeaderLen]
+	typ := recordType(hdr[0])
+	vers := uint16(hdr[1])<<8 | uint16(hdr[2])
+	n := int(hdr[3])<<8 | int(hdr[4])
+	if vers != VersionTLCP {
+		c.sendAlert(alertProtocolVersion)
+		return c.in.setErrorLocked(fmt.Errorf("tlcp: received record with version %x", vers))
+	}
+	if n > maxCiphertext {
+		c.sendAlert(alertRecordOverflow)
+		return c.in.setErrorLocked(fmt.Errorf("tlcp: oversized record received with length %d", n))
+	}
+	if err := c.readFromUntil(c.conn, recordHeaderLen+n); err != nil {
+		if e, ok := err.(net.Error); !ok || !e.Temporary() {
+			c.in.setErrorLocked(err)
+		}
+		return err
+	}
+
+	record := c.rawInput.Next(recordHeaderLen + n)
+	data, err := c.in.decrypt(typ, record[recordHeaderLen:])
+	if err != nil {
+		return c.in.setErrorLocked(c.sendAlert(alertBadRecordMAC))
+	}
+	if len(data) > maxPlaintext {
+		return c.in.setErrorLocked(c.sendAlert(alertRecordOverflow))
+	}
+
+	// application data is always protected
+	if c.in.cipher == nil && typ == recordTypeApplicationData {
+		return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+	}
+
+	switch typ {
+	default:
+		return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+
+	case recordTypeAlert:
+		if len(data) != 2 {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		if alert(data[1]) == alertCloseNotify {
+			return c.in.setErrorLocked(io.EOF)
+		}
+		switch data[0] {
+		case alertLevelWarning:
+			return c.readRecordOrCCS(expectChangeCipherSpec)
+		case alertLevelError:
+			return c.in.setErrorLocked(&net.OpError{Op: "remote error", Err: alert(data[1])})
+		default:
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+
+	case recordTypeChangeCipherSpec:
+		if len(data) != 1 || data[0] != 1 {
+			return c.in.setErrorLocked(c.sendAlert(alertDecodeError))
+		}
+		// the ChangeCipherSpec must come at a message boundary
+		if !expectChangeCipherSpec || c.hand.Len() > 0 {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		if err := c.in.changeCipherSpec(); err != nil {
+			return c.in.setErrorLocked(c.sendAlert(err.(alert)))
+		}
+
+	case recordTypeApplicationData:
+		if !handshakeComplete || expectChangeCipherSpec {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		// empty records are allowed and skipped
+		if len(data) == 0 {
+			return c.readRecordOrCCS(expectChangeCipherSpec)
+		}
+		c.input.Reset(data)
+
+	case recordTypeHandshake:
+		if len(data) == 0 || expectChangeCipherSpec {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		c.hand.Write(data)
+	}
+
+	return nil
+}
+
+// sendAlertLocked sends a TLCP alert message.
+func (c *Conn) sendAlertLocked(err alert) error {
+	level := byte(alertLevelError)
+	if err == alertNoRenegotiation || err == alertCloseNotify {
+		level = alertLevelWarning
+	}
+
+	_, writeErr := c.writeRecordLocked(recordTypeAlert, []byte{level, byte(err)})
+	if writeErr == nil {
+		writeErr = c.flush()
+	}
+	if err == alertCloseNotify {
+		// closeNotify is a special case in that it isn't an error.
+		return writeErr
+	}
+
+	return c.out.setErrorLocked(&net.OpError{Op: "local error", Err: err})
+}
+
+// sendAlert sends a TLCP alert message.
+func (c *Conn) sendAlert(err alert) error {
+	c.out.Lock()
+	defer c.out.Unlock()
+	return c.sendAlertLocked(err)
+}
+
+// writeRecordLocked writes a record of the given type, fragmenting data as
+// needed.
+func (c *Conn) writeRecordLocked(typ recordType, data []byte) (int, error) {
+	var n int
+	for len(data) > 0 {
+		m := len(data)
+		if m > maxPlaintext {
+			m = maxPlaintext
+		}
+		record, err := c.out.encrypt(typ, data[:m], c.config.rand())
+		if err != nil {
+			return n, err
+		}
+		if err := c.write(record); err != nil {
+			return n, err
+		}
+		n += m
+		data = data[m:]
+	}
+
+	if typ == recordTypeChangeCipherSpec {
+		if err := c.out.changeCipherSpec(); err != nil {
+			return n, c.sendAlertLocked(err.(alert))
+		}
+	}
+	return n, nil
+}
+
+// writeRecord writes a record of the given type, it locks c.out.
+func (c *Conn) writeRecord(typ recordType, data []byte) (int, error) {
+	c.out.Lock()
+	defer c.out.Unlock()
+	return c.writeRecordLocked(typ, data)
+}
+
+func (c *Conn) write(data []byte) error {
+	if c.buffering {
+		c.sendBuf = append(c.sendBuf, data...)
+		return nil
+	}
+	_, err := c.conn.Write(data)
+	return err
+}
+
+// flush sends the buffered records and stops buffering.
+func (c *Conn) flush() error {
+	c.buffering = false
+	if len(c.sendBuf) == 0 {
+		return nil
+	}
+	_, err := c.conn.Write(c.sendBuf)
+	c.sendBuf = nil
+	return err
+}
+
+// readHandshake reads the next handshake message from the record layer.
+func (c *Conn) readHandshake() (interface{}, error) {
+	for c.hand.Len() < 4 {
+		if err := c.readRecord(); err != nil {
+			return nil, err
+		}
+	}
+
+	data := c.hand.Bytes()
+	n := int(data[1])<<16 | int(data[2])<<8 | int(data[3])
+	if n > maxHandshake {
+		c.sendAlert(alertInternalError)
+		return nil, c.in.setErrorLocked(fmt.Errorf("tlcp: handshake message of length %d bytes exceeds maximum of %d bytes", n, maxHandshake))
+	}
+	for c.hand.Len() < 4+n {
+		if err := c.readRecord(); err != nil {
+			return nil, err
+		}
+	}
+	data = append([]byte(nil), c.hand.Next(4+n)...)
+
+	var m handshakeMessage
+	switch data[0] {
+	case typeClientHello:
+		m = new(clientHelloMsg)
+	case typeServerHello:
+		m = new(serverHelloMsg)
+	case typeCertificate:
+		m = new(certificateMsg)
+	case typeServerKeyExchange:
+		m = new(serverKeyExchangeMsg)
+	case typeCertificateRequest:
+		m = new(certificateRequestMsg)
+	case typeServerHelloDone:
+		m = new(serverHelloDoneMsg)
+	case typeClientKeyExchange:
+		m = new(clientKeyExchangeMsg)
+	case typeCertificateVerify:
+		m = new(certificateVerifyMsg)
+	case typeFinished:
+		m = new(finishedMsg)
+	default:
+		return nil, c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+	}
+
+	if !m.unmarshal(data) {
+		return nil, c.in.setErrorLocked(c.sendAlert(alertDecodeError))
+	}
+	return m, nil
+}
+
+func unexpectedMessageError(wanted, got interface{}) error {
+	return fmt.Errorf("tlcp: received unexpected handshake message of type %T when waiting for %T", got, wanted)
+}
+
+var errShutdown = errors.New("tlcp: protocol is shutdown")
+
+//Write writes data to the connection, running the handshake first if it
+// has not yet been run.
+func (c *Conn) Write(b []byte) (int, error) {
+	if err := c.Handshake(); err != nil {
+		return 0, err
+	}
+
+	c.out.Lock()
+	defer c.out.Unlock()
+
+	if err := c.out.err; err != nil {
+		return 0, err
+	}
+	if c.closeNotifySent {
+		return 0, errShutdown
+	}
+
+	n, err := c.writeRecordLocked(recordTypeApplicationData, b)
+	return n, c.out.setErrorLocked(err)
+}
+
+//Read reads data from the connection, running the handshake first if it
+// has not yet been run.
+func (c *Conn) Read(b []byte) (int, error) {
+	if err := c.Handshake(); err != nil {
+		return 0, err
+	}
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	c.in.Lock()
+	defer c.in.Unlock()
+
+	for c.input.Len() == 0 {
+		if err := c.readRecord(); err != nil {
+			return 0, err
+		}
+		// renegotiation is not supported
+		if c.hand.Len() > 0 {
+			return 0, c.in.setErrorLocked(c.sendAlert(alertNoRenegotiation))
+		}
+	}
+
+	n, _ := c.input.Read(b)
+	return n, nil
+}
+
+//Close closes the connection, sending a close_notify alert first if the
+// handshake has completed.
+func (c *Conn) Close() error {
+	var alertErr error
+	if c.handshakeComplete() {
+		if err := c.closeNotify(); err != nil {
+			alertErr = fmt.Errorf("tlcp: failed to send closeNotify alert (but connection was closed anyway): %w", err)
+		}
+	}
+
+	if err := c.conn.Close(); err != nil {
+		return err
+	}
+	return alertErr
+}
+
+//CloseWrite shuts down the writing side of the connection. It should only be
+// called once the handshake has completed and does not call CloseWrite on
+// the underlying connection.
+func (c *Conn) CloseWrite() error {
+	if !c.handshakeComplete() {
+		return errors.New("tlcp: CloseWrite called before handshake complete")
+	}
+	return c.closeNotify()
+}
+
+func (c *Conn) closeNotify() error {
+	c.out.Lock()
+	defer c.out.Unlock()
+
+	if !c.closeNotifySent {
+		// Set a Write Deadline to prevent possibly blocking forever.
+		_ = c.SetWriteDeadline(time.Now().Add(time.Second * 5))
+		c.closeNotifyErr = c.sendAlertLocked(alertCloseNotify)
+		c.closeNotifySent = true
+		// Any subsequent writes will fail.
+		_ = c.SetWriteDeadline(time.Now())
+	}
+	return c.closeNotifyErr
+}
+
+//Handshake runs the client or server handshake protocol if it has not yet
+// been run. Most uses of this package need not call Handshake explicitly,
+// the first Read or Write will call it automatically.
+func (c *Conn) Handshake() error {
+	c.handshakeMutex.Lock()
+	defer c.handshakeMutex.Unlock()
+
+	if err := c.handshakeErr; err != nil {
+		return err
+	}
+	if c.handshakeComplete() {
+		return nil
+	}
+
+	c.in.Lock()
+	defer c.in.Unlock()
+
+	if c.isClient {
+		c.handshakeErr = c.clientHandshake()
+	} else {
+		c.handshakeErr = c.serverHandshake()
+	}
+	if c.handshakeErr == nil {
+		atomic.StoreUint32(&c.handshakeStatus, 1)
+	} else {
+		// the records of an unfinished flight are useless to the peer
+		c.buffering = false
+		c.sendBuf = nil
+	}
+	return c.handshakeErr
+}
+
+func (c *Conn) handshakeComplete() bool {
+	return atomic.LoadUint32(&c.handshakeStatus) == 1
+}
+
+//ConnectionState returns basic TLCP details about the connection.
+func (c *Conn) ConnectionState() ConnectionState {
+	c.handshakeMutex.Lock()
+	defer c.handshakeMutex.Unlock()
+
+	var state ConnectionState
+	state.HandshakeComplete = c.handshakeComplete()
+	state.ServerName = c.config.ServerName
+	if state.HandshakeComplete {
+		state.Version = VersionTLCP
+		state.DidResume = c.didResume
+		state.CipherSuite = c.cipherSuite
+		state.PeerCertificates = c.peerCertificates
+		state.VerifiedChains = c.verifiedChains
+	}
+	return state
+}
+
+//LocalAddr returns the local network address.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+//RemoteAddr returns the remote network address.
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+//SetDeadline sets the read and write deadlines associated with the
+// connection. A zero value for t means Read and Write will not time out.
+// After a Write has timed out, the TLCP state is corrupt and all future
+// writes will return the same error.
+func (c *Conn) SetDeadline(t time.Time) error {
+	return c.conn.SetDeadline(t)
+}
+
+//SetReadDeadline sets the read deadline on the underlying connection.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+//SetWriteDeadline sets the write deadline on the underlying connection.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
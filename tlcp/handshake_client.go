@@ -0,0 +1,434 @@
+package tlcp
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"io"
+)
+
+type clientHandshakeState struct {
+	c            *Conn
+	hello        *clientHelloMsg
+	serverHello  *serverHelloMsg
+	suite        *cipherSuite
+	finishedHash finishedHash
+	masterSecret []byte
+	session      *SessionState // session offered in the ClientHello, if any
+}
+
+func (c *Conn) clientHandshake() error {
+	if c.config == nil {
+		c.config = new(Config)
+	}
+	if len(c.config.ServerName) == 0 && !c.config.InsecureSkipVerify {
+		return errors.New("tlcp: either ServerName or InsecureSkipVerify must be specified in the tlcp.Config")
+	}
+
+	hello, err := c.makeClientHello()
+	if err != nil {
+		return err
+	}
+
+	cacheKey := clientSessionCacheKey(c)
+	var session *SessionState
+	if c.config.SessionCache != nil {
+		if s, ok := c.config.SessionCache.Get(cacheKey); ok && s != nil &&
+			c.config.time().Sub(s.createdAt) < sessionTimeout && containsSuite(hello.cipherSuites, s.cipherSuite) {
+			hello.sessionID = s.sessionID
+			session = s
+		}
+	}
+
+	if _, err := c.writeRecord(recordTypeHandshake, hello.marshal()); err != nil {
+		return err
+	}
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	serverHello, ok := msg.(*serverHelloMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(serverHello, msg)
+	}
+	if serverHello.vers != VersionTLCP {
+		c.sendAlert(alertProtocolVersion)
+		return errors.New("tlcp: server selected an unsupported protocol version")
+	}
+
+	hs := &clientHandshakeState{
+		c:            c,
+		hello:        hello,
+		serverHello:  serverHello,
+		session:      session,
+		finishedHash: newFinishedHash(),
+	}
+	if err := hs.handshake(); err != nil {
+		return err
+	}
+
+	// a session is cached only if the server is willing to resume it
+	if c.config.SessionCache != nil && !c.didResume && len(serverHello.sessionID) > 0 {
+		c.config.SessionCache.Put(cacheKey, &SessionState{
+			sessionID:        serverHello.sessionID,
+			cipherSuite:      hs.suite.id,
+			masterSecret:     hs.masterSecret,
+			peerCertificates: c.peerCertificates,
+			verifiedChains:   c.verifiedChains,
+			createdAt:        c.config.time(),
+		})
+	}
+	return nil
+}
+
+func (c *Conn) makeClientHello() (*clientHelloMsg, error) {
+	hello := &clientHelloMsg{
+		vers:               VersionTLCP,
+		compressionMethods: []uint8{compressionNone},
+	}
+	for _, id := range c.config.cipherSuites() {
+		if cipherSuiteByID(id) != nil {
+			hello.cipherSuites = append(hello.cipherSuites, id)
+		}
+	}
+	if len(hello.cipherSuites) == 0 {
+		return nil, errors.New("tlcp: no supported cipher suites")
+	}
+
+	var err error
+	hello.random, err = c.makeRandom()
+	return hello, err
+}
+
+// makeRandom returns the 4 byte gmt_unix_time followed by 28 random bytes.
+func (c *Conn) makeRandom() ([]byte, error) {
+	random := make([]byte, 32)
+	t := uint32(c.config.time().Unix())
+	random[0], random[1], random[2], random[3] = byte(t>>24), byte(t>>16), byte(t>>8), byte(t)
+	if _, err := io.ReadFull(c.config.rand(), random[4:]); err != nil {
+		return nil, errors.New("tlcp: short read from Rand: " + err.Error())
+	}
+	return random, nil
+}
+
+// clientSessionCacheKey returns the key sessions to the peer of c are
+// cached with, the server name or the remote address.
+func clientSessionCacheKey(c *Conn) string {
+	if len(c.config.ServerName) > 0 {
+		return c.config.ServerName
+	}
+	return c.conn.RemoteAddr().String()
+}
+
+func containsSuite(suites []uint16, id uint16) bool {
+	for _, suite := range suites {
+		if suite == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (hs *clientHandshakeState) handshake() error {
+	c := hs.c
+
+	if !containsSuite(hs.hello.cipherSuites, hs.serverHello.cipherSuite) {
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tlcp: server chose an unconfigured cipher suite")
+	}
+	hs.suite = cipherSuiteByID(hs.serverHello.cipherSuite)
+	if hs.serverHello.compressionMethod != compressionNone {
+		c.sendAlert(alertIllegalParameter)
+		return errors.New("tlcp: server selected unsupported compression format")
+	}
+	c.cipherSuite = hs.suite.id
+
+	_, _ = hs.finishedHash.Write(hs.hello.marshal())
+	_, _ = hs.finishedHash.Write(hs.serverHello.marshal())
+
+	c.buffering = true
+	if hs.session != nil && bytes.Equal(hs.serverHello.sessionID, hs.session.sessionID) {
+		if hs.session.cipherSuite != hs.suite.id {
+			c.sendAlert(alertIllegalParameter)
+			return errors.New("tlcp: server resumed a session with a different cipher suite")
+		}
+		hs.masterSecret = hs.session.masterSecret
+		c.peerCertificates = hs.session.peerCertificates
+		c.verifiedChains = hs.session.verifiedChains
+		c.didResume = true
+
+		if err := hs.establishKeys(); err != nil {
+			return err
+		}
+		if err := hs.readFinished(); err != nil {
+			return err
+		}
+		if err := hs.sendFinished(); err != nil {
+			return err
+		}
+		return c.flush()
+	}
+
+	if err := hs.doFullHandshake(); err != nil {
+		return err
+	}
+	if err := hs.establishKeys(); err != nil {
+		return err
+	}
+	if err := hs.sendFinished(); err != nil {
+		return err
+	}
+	if err := c.flush(); err != nil {
+		return err
+	}
+	return hs.readFinished()
+}
+
+func (hs *clientHandshakeState) doFullHandshake() error {
+	c := hs.c
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	certMsg, ok := msg.(*certificateMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(certMsg, msg)
+	}
+	_, _ = hs.finishedHash.Write(certMsg.marshal())
+	if err := c.verifyServerCertificate(certMsg.certificates); err != nil {
+		return err
+	}
+	signPub := c.peerCertificates[0].PublicKey.(*gm.SM2PublicKey)
+	encPub := c.peerCertificates[1].PublicKey.(*gm.SM2PublicKey)
+
+	msg, err = c.readHandshake()
+	if err != nil {
+		return err
+	}
+	skx, ok := msg.(*serverKeyExchangeMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(skx, msg)
+	}
+	_, _ = hs.finishedHash.Write(skx.marshal())
+	ka := hs.suite.keyAgreement()
+	if err := ka.processServerKeyExchange(c.config, hs.hello.random, hs.serverHello.random, signPub, c.peerCertificates[1], skx); err != nil {
+		c.sendAlert(alertDecryptError)
+		return err
+	}
+
+	msg, err = c.readHandshake()
+	if err != nil {
+		return err
+	}
+	certReq, ok := msg.(*certificateRequestMsg)
+	if ok {
+		_, _ = hs.finishedHash.Write(certReq.marshal())
+		if msg, err = c.readHandshake(); err != nil {
+			return err
+		}
+	}
+	shd, ok := msg.(*serverHelloDoneMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(shd, msg)
+	}
+	_, _ = hs.finishedHash.Write(shd.marshal())
+
+	// the certificates are sent, possibly none, if the server asks for them
+	var sign, enc *Certificate
+	if certReq != nil {
+		sign, enc = c.config.signAndEncCertificates()
+		certMsg := new(certificateMsg)
+		if sign != nil {
+			certMsg.certificates = append([][]byte{sign.Certificate[0], enc.Certificate[0]}, sign.Certificate[1:]...)
+		}
+		_, _ = hs.finishedHash.Write(certMsg.marshal())
+		if _, err := c.writeRecord(recordTypeHandshake, certMsg.marshal()); err != nil {
+			return err
+		}
+	}
+
+	preMasterSecret, ckx, err := ka.generateClientKeyExchange(c.config, enc, encPub)
+	if err != nil {
+		c.sendAlert(alertHandshakeFailure)
+		return err
+	}
+	_, _ = hs.finishedHash.Write(ckx.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, ckx.marshal()); err != nil {
+		return err
+	}
+
+	if sign != nil {
+		signature, err := signSM2(c.config.rand(), sign, hs.finishedHash.Sum())
+		if err != nil {
+			c.sendAlert(alertInternalError)
+			return err
+		}
+		certVerify := &certificateVerifyMsg{signature: signature}
+		_, _ = hs.finishedHash.Write(certVerify.marshal())
+		if _, err := c.writeRecord(recordTypeHandshake, certVerify.marshal()); err != nil {
+			return err
+		}
+	}
+
+	hs.masterSecret = masterFromPreMasterSecret(preMasterSecret, hs.hello.random, hs.serverHello.random)
+	return nil
+}
+
+func (hs *clientHandshakeState) establishKeys() error {
+	c := hs.c
+
+	clientMAC, serverMAC, clientKey, serverKey, clientIV, serverIV :=
+		keysFromMasterSecret(hs.masterSecret, hs.hello.random, hs.serverHello.random, hs.suite.macLen, hs.suite.keyLen, hs.suite.ivLen)
+	clientCipher, err := hs.suite.newCipher(clientMAC, clientKey, clientIV)
+	if err != nil {
+		return err
+	}
+	serverCipher, err := hs.suite.newCipher(serverMAC, serverKey, serverIV)
+	if err != nil {
+		return err
+	}
+
+	c.in.prepareCipherSpec(serverCipher)
+	c.out.prepareCipherSpec(clientCipher)
+	return nil
+}
+
+func (hs *clientHandshakeState) readFinished() error {
+	c := hs.c
+
+	if err := c.readChangeCipherSpec(); err != nil {
+		return err
+	}
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	serverFinished, ok := msg.(*finishedMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(serverFinished, msg)
+	}
+
+	verify := hs.finishedHash.serverSum(hs.masterSecret)
+	if !hmac.Equal(verify, serverFinished.verifyData) {
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tlcp: server's Finished message was incorrect")
+	}
+	_, _ = hs.finishedHash.Write(serverFinished.marshal())
+	return nil
+}
+
+func (hs *clientHandshakeState) sendFinished() error {
+	c := hs.c
+
+	if _, err := c.writeRecord(recordTypeChangeCipherSpec, []byte{1}); err != nil {
+		return err
+	}
+
+	finished := &finishedMsg{verifyData: hs.finishedHash.clientSum(hs.masterSecret)}
+	_, _ = hs.finishedHash.Write(finished.marshal())
+	_, err := c.writeRecord(recordTypeHandshake, finished.marshal())
+	return err
+}
+
+// verifyServerCertificate parses and verifies the signing and encryption
+// certificates of the server, followed by the rest of the chain.
+func (c *Conn) verifyServerCertificate(certificates [][]byte) error {
+	certs, err := c.parsePeerCertificates(certificates)
+	if err != nil {
+		return err
+	}
+
+	if !c.config.InsecureSkipVerify {
+		opts := x509.VerifyOptions{
+			Roots:         c.config.RootCAs,
+			CurrentTime:   c.config.time(),
+			DNSName:       c.config.ServerName,
+			Intermediates: x509.NewCertPool(),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+		chains, err := c.verifyDualCertificates(certs, opts)
+		if err != nil {
+			return err
+		}
+		c.verifiedChains = chains
+	}
+
+	c.peerCertificates = certs
+	return nil
+}
+
+// parsePeerCertificates parses the signing and encryption certificates of
+// the peer and checks their keys and key usages.
+func (c *Conn) parsePeerCertificates(certificates [][]byte) ([]*x509.Certificate, error) {
+	if len(certificates) < 2 {
+		c.sendAlert(alertBadCertificate)
+		return nil, errors.New("tlcp: peer must send both a signing and an encryption certificate")
+	}
+	certs := make([]*x509.Certificate, len(certificates))
+	for i, asn1Data := range certificates {
+		cert, err := x509.ParseCertificate(asn1Data)
+		if err != nil {
+			c.sendAlert(alertBadCertificate)
+			return nil, errors.New("tlcp: failed to parse certificate from peer: " + err.Error())
+		}
+		certs[i] = cert
+	}
+
+	for _, cert := range certs[:2] {
+		if _, ok := cert.PublicKey.(*gm.SM2PublicKey); !ok {
+			c.sendAlert(alertUnsupportedCertificate)
+			return nil, errors.New("tlcp: peer certificate does not contain an SM2 public key")
+		}
+	}
+	if certs[0].KeyUsage != 0 && certs[0].KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+		c.sendAlert(alertUnsupportedCertificate)
+		return nil, errors.New("tlcp: peer signing certificate cannot sign")
+	}
+	encUsages := x509.KeyUsageKeyEncipherment | x509.KeyUsageDataEncipherment | x509.KeyUsageKeyAgreement
+	if certs[1].KeyUsage != 0 && certs[1].KeyUsage&encUsages == 0 {
+		c.sendAlert(alertUnsupportedCertificate)
+		return nil, errors.New("tlcp: peer encryption certificate cannot encrypt")
+	}
+	return certs, nil
+}
+
+// verifyDualCertificates verifies both certificates of the peer and returns
+// the chains of the signing one.
+func (c *Conn) verifyDualCertificates(certs []*x509.Certificate, opts x509.VerifyOptions) ([][]*x509.Certificate, error) {
+	for _, cert := range certs[2:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	chains, err := certs[0].Verify(opts)
+	if err == nil {
+		// the host name is only bound to the signing certificate
+		opts.DNSName = ""
+		_, err = certs[1].Verify(opts)
+	}
+	if err != nil {
+		c.sendAlert(alertForVerifyError(err))
+		return nil, err
+	}
+	return chains, nil
+}
+
+func alertForVerifyError(err error) alert {
+	switch e := err.(type) {
+	case x509.UnknownAuthorityError:
+		return alertUnknownCA
+	case x509.CertificateInvalidError:
+		if e.Reason == x509.Expired {
+			return alertCertificateExpired
+		}
+	}
+	return alertBadCertificate
+}
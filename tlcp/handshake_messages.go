@@ -0,0 +1,339 @@
+package tlcp
+
+// handshakeMessage is a TLCP handshake message. marshal returns the message
+// with its 4 byte header, unmarshal expects the same.
+type handshakeMessage interface {
+	marshal() []byte
+	unmarshal([]byte) bool
+}
+
+// marshalHandshake prefixes body with the handshake header of typ.
+func marshalHandshake(typ uint8, body []byte) []byte {
+	x := make([]byte, 4, 4+len(body))
+	x[0] = typ
+	x[1] = byte(len(body) >> 16)
+	x[2] = byte(len(body) >> 8)
+	x[3] = byte(len(body))
+	return append(x, body...)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendUint24(b []byte, v int) []byte {
+	return append(b, byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint8Prefixed(b, v []byte) []byte {
+	return append(append(b, byte(len(v))), v...)
+}
+
+func appendUint16Prefixed(b, v []byte) []byte {
+	return append(appendUint16(b, uint16(len(v))), v...)
+}
+
+func appendUint24Prefixed(b, v []byte) []byte {
+	return append(appendUint24(b, len(v)), v...)
+}
+
+// reader reads length prefixed fields, every method reports false if the
+// input is too short.
+type reader []byte
+
+func (r *reader) uint8(v *uint8) bool {
+	if len(*r) < 1 {
+		return false
+	}
+	*v = (*r)[0]
+	*r = (*r)[1:]
+	return true
+}
+
+func (r *reader) uint16(v *uint16) bool {
+	if len(*r) < 2 {
+		return false
+	}
+	*v = uint16((*r)[0])<<8 | uint16((*r)[1])
+	*r = (*r)[2:]
+	return true
+}
+
+func (r *reader) uint24(v *int) bool {
+	if len(*r) < 3 {
+		return false
+	}
+	*v = int((*r)[0])<<16 | int((*r)[1])<<8 | int((*r)[2])
+	*r = (*r)[3:]
+	return true
+}
+
+func (r *reader) bytes(v *[]byte, n int) bool {
+	if n < 0 || len(*r) < n {
+		return false
+	}
+	*v = (*r)[:n:n]
+	*r = (*r)[n:]
+	return true
+}
+
+func (r *reader) uint8Prefixed(v *[]byte) bool {
+	var n uint8
+	return r.uint8(&n) && r.bytes(v, int(n))
+}
+
+func (r *reader) uint16Prefixed(v *[]byte) bool {
+	var n uint16
+	return r.uint16(&n) && r.bytes(v, int(n))
+}
+
+func (r *reader) uint24Prefixed(v *[]byte) bool {
+	var n int
+	return r.uint24(&n) && r.bytes(v, n)
+}
+
+// handshakeBody checks the handshake header of data against typ and returns the body.
+func handshakeBody(data []byte, typ uint8) (reader, bool) {
+	if len(data) < 4 || data[0] != typ {
+		return nil, false
+	}
+	n := int(data[1])<<16 | int(data[2])<<8 | int(data[3])
+	if len(data)-4 != n {
+		return nil, false
+	}
+	return reader(data[4:]), true
+}
+
+type clientHelloMsg struct {
+	raw                []byte
+	vers               uint16
+	random             []byte
+	sessionID          []byte
+	cipherSuites       []uint16
+	compressionMethods []uint8
+}
+
+func (m *clientHelloMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	body := appendUint16(nil, m.vers)
+	body = append(body, m.random...)
+	body = appendUint8Prefixed(body, m.sessionID)
+	suites := make([]byte, 0, 2*len(m.cipherSuites))
+	for _, suite := range m.cipherSuites {
+		suites = appendUint16(suites, suite)
+	}
+	body = appendUint16Prefixed(body, suites)
+	body = appendUint8Prefixed(body, m.compressionMethods)
+	m.raw = marshalHandshake(typeClientHello, body)
+	return m.raw
+}
+
+func (m *clientHelloMsg) unmarshal(data []byte) bool {
+	*m = clientHelloMsg{raw: data}
+	s, ok := handshakeBody(data, typeClientHello)
+	var suites, compressionMethods []byte
+	if !ok || !s.uint16(&m.vers) || !s.bytes(&m.random, 32) ||
+		!s.uint8Prefixed(&m.sessionID) || len(m.sessionID) > 32 ||
+		!s.uint16Prefixed(&suites) || len(suites)%2 != 0 ||
+		!s.uint8Prefixed(&compressionMethods) {
+		return false
+	}
+	for r := reader(suites); len(r) > 0; {
+		var suite uint16
+		r.uint16(&suite)
+		m.cipherSuites = append(m.cipherSuites, suite)
+	}
+	m.compressionMethods = compressionMethods
+	// extensions, if any, are ignored
+	return true
+}
+
+type serverHelloMsg struct {
+	raw               []byte
+	vers              uint16
+	random            []byte
+	sessionID         []byte
+	cipherSuite       uint16
+	compressionMethod uint8
+}
+
+func (m *serverHelloMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	body := appendUint16(nil, m.vers)
+	body = append(body, m.random...)
+	body = appendUint8Prefixed(body, m.sessionID)
+	body = appendUint16(body, m.cipherSuite)
+	body = append(body, m.compressionMethod)
+	m.raw = marshalHandshake(typeServerHello, body)
+	return m.raw
+}
+
+func (m *serverHelloMsg) unmarshal(data []byte) bool {
+	*m = serverHelloMsg{raw: data}
+	s, ok := handshakeBody(data, typeServerHello)
+	return ok && s.uint16(&m.vers) && s.bytes(&m.random, 32) &&
+		s.uint8Prefixed(&m.sessionID) && len(m.sessionID) <= 32 &&
+		s.uint16(&m.cipherSuite) && s.uint8(&m.compressionMethod)
+}
+
+type certificateMsg struct {
+	raw          []byte
+	certificates [][]byte
+}
+
+func (m *certificateMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	var certs []byte
+	for _, cert := range m.certificates {
+		certs = appendUint24Prefixed(certs, cert)
+	}
+	m.raw = marshalHandshake(typeCertificate, appendUint24Prefixed(nil, certs))
+	return m.raw
+}
+
+func (m *certificateMsg) unmarshal(data []byte) bool {
+	*m = certificateMsg{raw: data}
+	s, ok := handshakeBody(data, typeCertificate)
+	var certs []byte
+	if !ok || !s.uint24Prefixed(&certs) || len(s) != 0 {
+		return false
+	}
+	for r := reader(certs); len(r) > 0; {
+		var cert []byte
+		if !r.uint24Prefixed(&cert) {
+			return false
+		}
+		m.certificates = append(m.certificates, cert)
+	}
+	return true
+}
+
+type serverKeyExchangeMsg struct {
+	raw []byte
+	key []byte
+}
+
+func (m *serverKeyExchangeMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	m.raw = marshalHandshake(typeServerKeyExchange, m.key)
+	return m.raw
+}
+
+func (m *serverKeyExchangeMsg) unmarshal(data []byte) bool {
+	s, ok := handshakeBody(data, typeServerKeyExchange)
+	*m = serverKeyExchangeMsg{raw: data, key: s}
+	return ok
+}
+
+type certificateRequestMsg struct {
+	raw                    []byte
+	certificateTypes       []byte
+	certificateAuthorities [][]byte
+}
+
+func (m *certificateRequestMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	body := appendUint8Prefixed(nil, m.certificateTypes)
+	var cas []byte
+	for _, ca := range m.certificateAuthorities {
+		cas = appendUint16Prefixed(cas, ca)
+	}
+	body = appendUint16Prefixed(body, cas)
+	m.raw = marshalHandshake(typeCertificateRequest, body)
+	return m.raw
+}
+
+func (m *certificateRequestMsg) unmarshal(data []byte) bool {
+	*m = certificateRequestMsg{raw: data}
+	s, ok := handshakeBody(data, typeCertificateRequest)
+	var cas []byte
+	if !ok || !s.uint8Prefixed(&m.certificateTypes) || !s.uint16Prefixed(&cas) || len(s) != 0 {
+		return false
+	}
+	for r := reader(cas); len(r) > 0; {
+		var ca []byte
+		if !r.uint16Prefixed(&ca) {
+			return false
+		}
+		m.certificateAuthorities = append(m.certificateAuthorities, ca)
+	}
+	return true
+}
+
+type serverHelloDoneMsg struct{}
+
+func (m *serverHelloDoneMsg) marshal() []byte {
+	return marshalHandshake(typeServerHelloDone, nil)
+}
+
+func (m *serverHelloDoneMsg) unmarshal(data []byte) bool {
+	s, ok := handshakeBody(data, typeServerHelloDone)
+	return ok && len(s) == 0
+}
+
+type clientKeyExchangeMsg struct {
+	raw        []byte
+	ciphertext []byte
+}
+
+func (m *clientKeyExchangeMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	m.raw = marshalHandshake(typeClientKeyExchange, m.ciphertext)
+	return m.raw
+}
+
+func (m *clientKeyExchangeMsg) unmarshal(data []byte) bool {
+	s, ok := handshakeBody(data, typeClientKeyExchange)
+	*m = clientKeyExchangeMsg{raw: data, ciphertext: s}
+	return ok
+}
+
+type certificateVerifyMsg struct {
+	raw       []byte
+	signature []byte
+}
+
+func (m *certificateVerifyMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	m.raw = marshalHandshake(typeCertificateVerify, appendUint16Prefixed(nil, m.signature))
+	return m.raw
+}
+
+func (m *certificateVerifyMsg) unmarshal(data []byte) bool {
+	*m = certificateVerifyMsg{raw: data}
+	s, ok := handshakeBody(data, typeCertificateVerify)
+	return ok && s.uint16Prefixed(&m.signature) && len(s) == 0
+}
+
+type finishedMsg struct {
+	raw        []byte
+	verifyData []byte
+}
+
+func (m *finishedMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	m.raw = marshalHandshake(typeFinished, m.verifyData)
+	return m.raw
+}
+
+func (m *finishedMsg) unmarshal(data []byte) bool {
+	s, ok := handshakeBody(data, typeFinished)
+	*m = finishedMsg{raw: data, verifyData: s}
+	return ok && len(s) == finishedVerifyLength
+}
@@ -0,0 +1,46 @@
+package tlcp
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHandshakeMessagesRoundTrip(t *testing.T) {
+	random := bytes.Repeat([]byte{1}, 32)
+	tests := []struct {
+		msg   handshakeMessage
+		empty handshakeMessage
+	}{
+		{&clientHelloMsg{vers: VersionTLCP, random: random, sessionID: []byte{1, 2, 3}, cipherSuites: defaultCipherSuites, compressionMethods: []uint8{0}}, new(clientHelloMsg)},
+		{&serverHelloMsg{vers: VersionTLCP, random: random, sessionID: random, cipherSuite: ECC_SM4_CBC_SM3}, new(serverHelloMsg)},
+		{&certificateMsg{certificates: [][]byte{{1, 2}, {3}, {}}}, new(certificateMsg)},
+		{&serverKeyExchangeMsg{key: []byte{0, 2, 1, 2}}, new(serverKeyExchangeMsg)},
+		{&certificateRequestMsg{certificateTypes: []byte{certTypeECDSASign}, certificateAuthorities: [][]byte{{0x30, 0}, {0x30, 0}}}, new(certificateRequestMsg)},
+		{new(serverHelloDoneMsg), new(serverHelloDoneMsg)},
+		{&clientKeyExchangeMsg{ciphertext: []byte{0, 1, 5}}, new(clientKeyExchangeMsg)},
+		{&certificateVerifyMsg{signature: []byte{1, 2, 3}}, new(certificateVerifyMsg)},
+		{&finishedMsg{verifyData: random[:finishedVerifyLength]}, new(finishedMsg)},
+	}
+	for _, tt := range tests {
+		data := tt.msg.marshal()
+		assert.True(t, tt.empty.unmarshal(data), "%T", tt.msg)
+		assert.Equal(t, tt.msg, tt.empty, "%T", tt.msg)
+
+		// every truncation is rejected
+		for i := 0; i < len(data); i++ {
+			assert.False(t, tt.empty.unmarshal(data[:i]), "%T truncated to %d", tt.msg, i)
+		}
+	}
+}
+
+func TestClientHelloWithExtensions(t *testing.T) {
+	hello := &clientHelloMsg{vers: VersionTLCP, random: make([]byte, 32), cipherSuites: []uint16{ECC_SM4_GCM_SM3}, compressionMethods: []uint8{0}}
+	data := hello.marshal()
+	body := append(append([]byte{}, data[4:]...), 0, 4, 0, 0, 0, 0)
+	withExtensions := marshalHandshake(typeClientHello, body)
+
+	m := new(clientHelloMsg)
+	assert.True(t, m.unmarshal(withExtensions))
+	assert.Equal(t, hello.cipherSuites, m.cipherSuites)
+}
@@ -0,0 +1,396 @@
+package tlcp
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"io"
+)
+
+type serverHandshakeState struct {
+	c            *Conn
+	clientHello  *clientHelloMsg
+	hello        *serverHelloMsg
+	suite        *cipherSuite
+	sign, enc    *Certificate
+	finishedHash finishedHash
+	masterSecret []byte
+}
+
+func (c *Conn) serverHandshake() error {
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	clientHello, ok := msg.(*clientHelloMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(clientHello, msg)
+	}
+
+	hs := &serverHandshakeState{
+		c:            c,
+		clientHello:  clientHello,
+		finishedHash: newFinishedHash(),
+	}
+	if err := hs.processClientHello(); err != nil {
+		return err
+	}
+
+	c.buffering = true
+	if hs.checkForResumption() {
+		c.didResume = true
+		if err := hs.doResumeHandshake(); err != nil {
+			return err
+		}
+		if err := hs.establishKeys(); err != nil {
+			return err
+		}
+		if err := hs.sendFinished(); err != nil {
+			return err
+		}
+		if err := c.flush(); err != nil {
+			return err
+		}
+		return hs.readFinished()
+	}
+
+	if err := hs.doFullHandshake(); err != nil {
+		return err
+	}
+	if err := hs.establishKeys(); err != nil {
+		return err
+	}
+	if err := hs.readFinished(); err != nil {
+		return err
+	}
+	c.buffering = true
+	if err := hs.sendFinished(); err != nil {
+		return err
+	}
+	if err := c.flush(); err != nil {
+		return err
+	}
+
+	if c.config.SessionCache != nil && len(hs.hello.sessionID) > 0 {
+		c.config.SessionCache.Put(hex.EncodeToString(hs.hello.sessionID), &SessionState{
+			sessionID:        hs.hello.sessionID,
+			cipherSuite:      hs.suite.id,
+			masterSecret:     hs.masterSecret,
+			peerCertificates: c.peerCertificates,
+			verifiedChains:   c.verifiedChains,
+			createdAt:        c.config.time(),
+		})
+	}
+	return nil
+}
+
+func (hs *serverHandshakeState) processClientHello() error {
+	c := hs.c
+
+	if hs.clientHello.vers != VersionTLCP {
+		c.sendAlert(alertProtocolVersion)
+		return errors.New("tlcp: client offered an unsupported protocol version")
+	}
+
+	hs.sign, hs.enc = c.config.signAndEncCertificates()
+	if hs.sign == nil {
+		c.sendAlert(alertInternalError)
+		return errors.New("tlcp: server needs both a signing and an encryption certificate")
+	}
+
+	foundCompression := false
+	for _, compression := range hs.clientHello.compressionMethods {
+		if compression == compressionNone {
+			foundCompression = true
+			break
+		}
+	}
+	if !foundCompression {
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tlcp: client does not support uncompressed connections")
+	}
+
+	// the client's preference wins
+	enabled := c.config.cipherSuites()
+	for _, id := range hs.clientHello.cipherSuites {
+		if containsSuite(enabled, id) {
+			if hs.suite = cipherSuiteByID(id); hs.suite != nil {
+				break
+			}
+		}
+	}
+	if hs.suite == nil {
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tlcp: no cipher suite supported by both client and server")
+	}
+
+	random, err := c.makeRandom()
+	if err != nil {
+		c.sendAlert(alertInternalError)
+		return err
+	}
+	hs.hello = &serverHelloMsg{
+		vers:              VersionTLCP,
+		random:            random,
+		cipherSuite:       hs.suite.id,
+		compressionMethod: compressionNone,
+	}
+	return nil
+}
+
+// checkForResumption reports whether the session offered by the client can
+// be resumed.
+func (hs *serverHandshakeState) checkForResumption() bool {
+	c := hs.c
+
+	if c.config.SessionCache == nil || len(hs.clientHello.sessionID) == 0 {
+		return false
+	}
+	session, ok := c.config.SessionCache.Get(hex.EncodeToString(hs.clientHello.sessionID))
+	if !ok || session == nil || c.config.time().Sub(session.createdAt) > sessionTimeout {
+		return false
+	}
+	if !containsSuite(hs.clientHello.cipherSuites, session.cipherSuite) || !containsSuite(c.config.cipherSuites(), session.cipherSuite) {
+		return false
+	}
+	if len(session.peerCertificates) == 0 && requiresClientCert(c.config.ClientAuth) {
+		return false
+	}
+
+	hs.suite = cipherSuiteByID(session.cipherSuite)
+	hs.hello.cipherSuite = session.cipherSuite
+	hs.hello.sessionID = hs.clientHello.sessionID
+	hs.masterSecret = session.masterSecret
+	c.peerCertificates = session.peerCertificates
+	c.verifiedChains = session.verifiedChains
+	return true
+}
+
+func requiresClientCert(clientAuth ClientAuthType) bool {
+	return clientAuth == RequireAnyClientCert || clientAuth == RequireAndVerifyClientCert
+}
+
+func (hs *serverHandshakeState) doResumeHandshake() error {
+	c := hs.c
+
+	c.cipherSuite = hs.suite.id
+	_, _ = hs.finishedHash.Write(hs.clientHello.marshal())
+	_, _ = hs.finishedHash.Write(hs.hello.marshal())
+	_, err := c.writeRecord(recordTypeHandshake, hs.hello.marshal())
+	return err
+}
+
+func (hs *serverHandshakeState) doFullHandshake() error {
+	c := hs.c
+
+	c.cipherSuite = hs.suite.id
+	if c.config.SessionCache != nil {
+		hs.hello.sessionID = make([]byte, 32)
+		if _, err := io.ReadFull(c.config.rand(), hs.hello.sessionID); err != nil {
+			c.sendAlert(alertInternalError)
+			return err
+		}
+	}
+
+	_, _ = hs.finishedHash.Write(hs.clientHello.marshal())
+	_, _ = hs.finishedHash.Write(hs.hello.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, hs.hello.marshal()); err != nil {
+		return err
+	}
+
+	certMsg := &certificateMsg{
+		certificates: append([][]byte{hs.sign.Certificate[0], hs.enc.Certificate[0]}, hs.sign.Certificate[1:]...),
+	}
+	_, _ = hs.finishedHash.Write(certMsg.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, certMsg.marshal()); err != nil {
+		return err
+	}
+
+	ka := hs.suite.keyAgreement()
+	skx, err := ka.generateServerKeyExchange(c.config, hs.sign, hs.enc, hs.clientHello.random, hs.hello.random)
+	if err != nil {
+		c.sendAlert(alertHandshakeFailure)
+		return err
+	}
+	_, _ = hs.finishedHash.Write(skx.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, skx.marshal()); err != nil {
+		return err
+	}
+
+	// the ECDHE suites need the client encryption certificate
+	requestClientCert := c.config.ClientAuth >= RequestClientCert || hs.suite.ecdhe
+	if requestClientCert {
+		certReq := &certificateRequestMsg{certificateTypes: []byte{certTypeECDSASign}}
+		if c.config.ClientCAs != nil {
+			certReq.certificateAuthorities = c.config.ClientCAs.Subjects()
+		}
+		_, _ = hs.finishedHash.Write(certReq.marshal())
+		if _, err := c.writeRecord(recordTypeHandshake, certReq.marshal()); err != nil {
+			return err
+		}
+	}
+
+	helloDone := new(serverHelloDoneMsg)
+	_, _ = hs.finishedHash.Write(helloDone.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, helloDone.marshal()); err != nil {
+		return err
+	}
+	if err := c.flush(); err != nil {
+		return err
+	}
+
+	var clientEncPub *gm.SM2PublicKey
+	if requestClientCert {
+		msg, err := c.readHandshake()
+		if err != nil {
+			return err
+		}
+		certMsg, ok := msg.(*certificateMsg)
+		if !ok {
+			c.sendAlert(alertUnexpectedMessage)
+			return unexpectedMessageError(certMsg, msg)
+		}
+		_, _ = hs.finishedHash.Write(certMsg.marshal())
+		if err := hs.processCertsFromClient(certMsg.certificates); err != nil {
+			return err
+		}
+		if len(c.peerCertificates) > 0 {
+			clientEncPub = c.peerCertificates[1].PublicKey.(*gm.SM2PublicKey)
+		}
+	}
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	ckx, ok := msg.(*clientKeyExchangeMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(ckx, msg)
+	}
+	_, _ = hs.finishedHash.Write(ckx.marshal())
+
+	preMasterSecret, err := ka.processClientKeyExchange(c.config, hs.enc, clientEncPub, ckx)
+	if err != nil {
+		c.sendAlert(alertHandshakeFailure)
+		return err
+	}
+	hs.masterSecret = masterFromPreMasterSecret(preMasterSecret, hs.clientHello.random, hs.hello.random)
+
+	// the client proves the possession of its signing key
+	if len(c.peerCertificates) > 0 {
+		msg, err := c.readHandshake()
+		if err != nil {
+			return err
+		}
+		certVerify, ok := msg.(*certificateVerifyMsg)
+		if !ok {
+			c.sendAlert(alertUnexpectedMessage)
+			return unexpectedMessageError(certVerify, msg)
+		}
+		signPub := c.peerCertificates[0].PublicKey.(*gm.SM2PublicKey)
+		if err := verifySM2(signPub, hs.finishedHash.Sum(), certVerify.signature); err != nil {
+			c.sendAlert(alertDecryptError)
+			return errors.New("tlcp: invalid signature by the client certificate: " + err.Error())
+		}
+		_, _ = hs.finishedHash.Write(certVerify.marshal())
+	}
+	return nil
+}
+
+// processCertsFromClient takes the certificates sent by the client and
+// verifies them according to the client auth policy.
+func (hs *serverHandshakeState) processCertsFromClient(certificates [][]byte) error {
+	c := hs.c
+
+	if len(certificates) == 0 {
+		if requiresClientCert(c.config.ClientAuth) || hs.suite.ecdhe {
+			c.sendAlert(alertBadCertificate)
+			return errors.New("tlcp: client didn't provide a certificate")
+		}
+		return nil
+	}
+
+	certs, err := c.parsePeerCertificates(certificates)
+	if err != nil {
+		return err
+	}
+
+	if c.config.ClientAuth >= VerifyClientCertIfGiven {
+		opts := x509.VerifyOptions{
+			Roots:         c.config.ClientCAs,
+			CurrentTime:   c.config.time(),
+			Intermediates: x509.NewCertPool(),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		chains, err := c.verifyDualCertificates(certs, opts)
+		if err != nil {
+			return errors.New("tlcp: failed to verify client certificate: " + err.Error())
+		}
+		c.verifiedChains = chains
+	}
+
+	c.peerCertificates = certs
+	return nil
+}
+
+func (hs *serverHandshakeState) establishKeys() error {
+	c := hs.c
+
+	clientMAC, serverMAC, clientKey, serverKey, clientIV, serverIV :=
+		keysFromMasterSecret(hs.masterSecret, hs.clientHello.random, hs.hello.random, hs.suite.macLen, hs.suite.keyLen, hs.suite.ivLen)
+	clientCipher, err := hs.suite.newCipher(clientMAC, clientKey, clientIV)
+	if err != nil {
+		return err
+	}
+	serverCipher, err := hs.suite.newCipher(serverMAC, serverKey, serverIV)
+	if err != nil {
+		return err
+	}
+
+	c.in.prepareCipherSpec(clientCipher)
+	c.out.prepareCipherSpec(serverCipher)
+	return nil
+}
+
+func (hs *serverHandshakeState) readFinished() error {
+	c := hs.c
+
+	if err := c.readChangeCipherSpec(); err != nil {
+		return err
+	}
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	clientFinished, ok := msg.(*finishedMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(clientFinished, msg)
+	}
+
+	verify := hs.finishedHash.clientSum(hs.masterSecret)
+	if !hmac.Equal(verify, clientFinished.verifyData) {
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tlcp: client's Finished message is incorrect")
+	}
+	_, _ = hs.finishedHash.Write(clientFinished.marshal())
+	return nil
+}
+
+func (hs *serverHandshakeState) sendFinished() error {
+	c := hs.c
+
+	if _, err := c.writeRecord(recordTypeChangeCipherSpec, []byte{1}); err != nil {
+		return err
+	}
+
+	finished := &finishedMsg{verifyData: hs.finishedHash.serverSum(hs.masterSecret)}
+	_, _ = hs.finishedHash.Write(finished.marshal())
+	_, err := c.writeRecord(recordTypeHandshake, finished.marshal())
+	return err
+}
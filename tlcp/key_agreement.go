@@ -0,0 +1,311 @@
+package tlcp
+
+import (
+	"encoding/asn1"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"io"
+	"math/big"
+)
+
+var errServerKeyExchange = errors.New("tlcp: invalid ServerKeyExchange message")
+var errClientKeyExchange = errors.New("tlcp: invalid ClientKeyExchange message")
+
+// keyAgreement implements the key exchange of a cipher suite. The sign and
+// enc certificates are the ones of the local side, encCert and the public
+// keys are the ones of the peer.
+type keyAgreement interface {
+	// server side
+	generateServerKeyExchange(config *Config, sign, enc *Certificate, clientRandom, serverRandom []byte) (*serverKeyExchangeMsg, error)
+	processClientKeyExchange(config *Config, enc *Certificate, clientEncPub *gm.SM2PublicKey, ckx *clientKeyExchangeMsg) ([]byte, error)
+
+	// client side
+	processServerKeyExchange(config *Config, clientRandom, serverRandom []byte, signPub *gm.SM2PublicKey, encCert *x509.Certificate, skx *serverKeyExchangeMsg) error
+	generateClientKeyExchange(config *Config, enc *Certificate, serverEncPub *gm.SM2PublicKey) ([]byte, *clientKeyExchangeMsg, error)
+}
+
+// eccKeyAgreement is the ECC key exchange: the client encrypts the
+// pre-master secret to the server's encryption certificate, the server
+// signs the randoms and that certificate.
+type eccKeyAgreement struct{}
+
+func eccSignedParams(clientRandom, serverRandom, encCert []byte) []byte {
+	signed := make([]byte, 0, len(clientRandom)+len(serverRandom)+3+len(encCert))
+	signed = append(signed, clientRandom...)
+	signed = append(signed, serverRandom...)
+	return appendUint24Prefixed(signed, encCert)
+}
+
+func (eccKeyAgreement) generateServerKeyExchange(config *Config, sign, enc *Certificate, clientRandom, serverRandom []byte) (*serverKeyExchangeMsg, error) {
+	sig, err := signSM2(config.rand(), sign, eccSignedParams(clientRandom, serverRandom, enc.Certificate[0]))
+	if err != nil {
+		return nil, err
+	}
+	return &serverKeyExchangeMsg{key: appendUint16Prefixed(nil, sig)}, nil
+}
+
+func (eccKeyAgreement) processClientKeyExchange(config *Config, enc *Certificate, _ *gm.SM2PublicKey, ckx *clientKeyExchangeMsg) ([]byte, error) {
+	r := reader(ckx.ciphertext)
+	var der []byte
+	if !r.uint16Prefixed(&der) || len(r) != 0 {
+		return nil, errClientKeyExchange
+	}
+	ciphertext, err := unmarshalSM2Cipher(der)
+	if err != nil {
+		return nil, err
+	}
+	preMasterSecret, err := gm.Decrypt(enc.PrivateKey, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if len(preMasterSecret) != 48 || preMasterSecret[0] != VersionTLCP>>8 || preMasterSecret[1] != VersionTLCP&0xff {
+		return nil, errClientKeyExchange
+	}
+	return preMasterSecret, nil
+}
+
+func (eccKeyAgreement) processServerKeyExchange(config *Config, clientRandom, serverRandom []byte, signPub *gm.SM2PublicKey, encCert *x509.Certificate, skx *serverKeyExchangeMsg) error {
+	r := reader(skx.key)
+	var sig []byte
+	if !r.uint16Prefixed(&sig) || len(r) != 0 {
+		return errServerKeyExchange
+	}
+	return verifySM2(signPub, eccSignedParams(clientRandom, serverRandom, encCert.Raw), sig)
+}
+
+func (eccKeyAgreement) generateClientKeyExchange(config *Config, _ *Certificate, serverEncPub *gm.SM2PublicKey) ([]byte, *clientKeyExchangeMsg, error) {
+	preMasterSecret := make([]byte, 48)
+	preMasterSecret[0] = VersionTLCP >> 8
+	preMasterSecret[1] = VersionTLCP & 0xff
+	if _, err := io.ReadFull(config.rand(), preMasterSecret[2:]); err != nil {
+		return nil, nil, err
+	}
+	ciphertext, err := gm.Encrypt(serverEncPub, preMasterSecret, config.rand())
+	if err != nil {
+		return nil, nil, err
+	}
+	der, err := marshalSM2Cipher(ciphertext)
+	if err != nil {
+		return nil, nil, err
+	}
+	return preMasterSecret, &clientKeyExchangeMsg{ciphertext: appendUint16Prefixed(nil, der)}, nil
+}
+
+// ecdheKeyAgreement is the ECDHE key exchange: both sides run the SM2 key
+// exchange protocol of GM/T 0003.3 with their encryption keys and an
+// ephemeral key each, the client being the initiator.
+type ecdheKeyAgreement struct {
+	ephemeral *gm.SM2PrivateKey
+	peer      *gm.SM2PublicKey
+}
+
+// marshalECDHEParams returns the ServerECDHEParams or ClientECDHEParams
+// holding the ephemeral public key.
+func marshalECDHEParams(pub *gm.SM2PublicKey) []byte {
+	point, _ := pub.Bytes()
+	params := []byte{curveTypeNamedCurve}
+	params = appendUint16(params, curveSM2)
+	return appendUint8Prefixed(params, point)
+}
+
+func parseECDHEParams(r *reader) (*gm.SM2PublicKey, bool) {
+	var curveType uint8
+	var curve uint16
+	var point []byte
+	if !r.uint8(&curveType) || curveType != curveTypeNamedCurve || !r.uint16(&curve) || curve != curveSM2 || !r.uint8Prefixed(&point) {
+		return nil, false
+	}
+	pub, err := parseSM2Point(point)
+	return pub, err == nil
+}
+
+func (ka *ecdheKeyAgreement) generateServerKeyExchange(config *Config, sign, _ *Certificate, clientRandom, serverRandom []byte) (*serverKeyExchangeMsg, error) {
+	ephemeral, err := gm.GenerateSM2KeyWithReader(config.rand())
+	if err != nil {
+		return nil, err
+	}
+	ka.ephemeral = ephemeral
+
+	params := marshalECDHEParams(&ephemeral.PublicKey)
+	signed := make([]byte, 0, len(clientRandom)+len(serverRandom)+len(params))
+	signed = append(signed, clientRandom...)
+	signed = append(signed, serverRandom...)
+	signed = append(signed, params...)
+	sig, err := signSM2(config.rand(), sign, signed)
+	if err != nil {
+		return nil, err
+	}
+	return &serverKeyExchangeMsg{key: appendUint16Prefixed(params, sig)}, nil
+}
+
+func (ka *ecdheKeyAgreement) processClientKeyExchange(config *Config, enc *Certificate, clientEncPub *gm.SM2PublicKey, ckx *clientKeyExchangeMsg) ([]byte, error) {
+	if clientEncPub == nil {
+		return nil, errors.New("tlcp: ECDHE requires the client encryption certificate")
+	}
+	r := reader(ckx.ciphertext)
+	peer, ok := parseECDHEParams(&r)
+	if !ok || len(r) != 0 {
+		return nil, errClientKeyExchange
+	}
+	encPub, err := certificatePublicKey(enc)
+	if err != nil {
+		return nil, err
+	}
+	return sm2KeyExchange(ka.ephemeral, enc.PrivateKey, encPub, peer, clientEncPub, false)
+}
+
+func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientRandom, serverRandom []byte, signPub *gm.SM2PublicKey, _ *x509.Certificate, skx *serverKeyExchangeMsg) error {
+	r := reader(skx.key)
+	peer, ok := parseECDHEParams(&r)
+	if !ok {
+		return errServerKeyExchange
+	}
+	params := skx.key[:len(skx.key)-len(r)]
+	var sig []byte
+	if !r.uint16Prefixed(&sig) || len(r) != 0 {
+		return errServerKeyExchange
+	}
+	signed := make([]byte, 0, len(clientRandom)+len(serverRandom)+len(params))
+	signed = append(signed, clientRandom...)
+	signed = append(signed, serverRandom...)
+	signed = append(signed, params...)
+	if err := verifySM2(signPub, signed, sig); err != nil {
+		return err
+	}
+	ka.peer = peer
+	return nil
+}
+
+func (ka *ecdheKeyAgreement) generateClientKeyExchange(config *Config, enc *Certificate, serverEncPub *gm.SM2PublicKey) ([]byte, *clientKeyExchangeMsg, error) {
+	if enc == nil {
+		return nil, nil, errors.New("tlcp: ECDHE requires a client encryption certificate")
+	}
+	if ka.peer == nil {
+		return nil, nil, errServerKeyExchange
+	}
+	encPub, err := certificatePublicKey(enc)
+	if err != nil {
+		return nil, nil, err
+	}
+	ephemeral, err := gm.GenerateSM2KeyWithReader(config.rand())
+	if err != nil {
+		return nil, nil, err
+	}
+	preMasterSecret, err := sm2KeyExchange(ephemeral, enc.PrivateKey, encPub, ka.peer, serverEncPub, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return preMasterSecret, &clientKeyExchangeMsg{ciphertext: marshalECDHEParams(&ephemeral.PublicKey)}, nil
+}
+
+// sm2KeyExchange returns the 48 byte pre-master secret agreed with the SM2
+// key exchange protocol.
+func sm2KeyExchange(ephemeral, priv *gm.SM2PrivateKey, pub, peerEphemeral, peerPub *gm.SM2PublicKey, isInitiator bool) ([]byte, error) {
+	toInt := func(b [32]byte) *big.Int { return new(big.Int).SetBytes(b[:]) }
+	_, _, z, err := gm.GenerateSM2KeyForDH(defaultUID, defaultUID, ephemeral.K[:], toInt(priv.K),
+		toInt(pub.X), toInt(pub.Y), toInt(peerPub.X), toInt(peerPub.Y), peerEphemeral, isInitiator)
+	if err != nil {
+		return nil, err
+	}
+	return sm3KDF(z, 48), nil
+}
+
+// sm3KDF is the key derivation function of GM/T 0003.4 5.4.3.
+func sm3KDF(z []byte, length int) []byte {
+	out := make([]byte, 0, length+32)
+	h := gm.GetSM3Hasher()
+	for ct := uint32(1); len(out) < length; ct++ {
+		h.Reset()
+		_, _ = h.Write(z)
+		_, _ = h.Write([]byte{byte(ct >> 24), byte(ct >> 16), byte(ct >> 8), byte(ct)})
+		out = h.Sum(out)
+	}
+	return out[:length]
+}
+
+// sm2Cipher is the SM2 ciphertext of GM/T 0009, C1 || C3 || C2 in ASN.1.
+type sm2Cipher struct {
+	XCoordinate *big.Int
+	YCoordinate *big.Int
+	Hash        []byte
+	CipherText  []byte
+}
+
+// marshalSM2Cipher converts the output of gm.Encrypt, 04 || C1 || C2 || C3,
+// to its GM/T 0009 form.
+func marshalSM2Cipher(ciphertext []byte) ([]byte, error) {
+	c, err := gm.CiphertextToC1C3C2(ciphertext)
+	if err != nil {
+		return nil, errors.New("tlcp: invalid SM2 ciphertext")
+	}
+	return asn1.Marshal(sm2Cipher{
+		XCoordinate: new(big.Int).SetBytes(c[1:33]),
+		YCoordinate: new(big.Int).SetBytes(c[33:65]),
+		Hash:        c[65:97],
+		CipherText:  c[97:],
+	})
+}
+
+// unmarshalSM2Cipher is the inverse of marshalSM2Cipher. C1 is checked to be
+// on the curve.
+func unmarshalSM2Cipher(der []byte) ([]byte, error) {
+	var c sm2Cipher
+	if rest, err := asn1.Unmarshal(der, &c); err != nil || len(rest) != 0 {
+		return nil, errors.New("tlcp: invalid SM2 ciphertext")
+	}
+	if c.XCoordinate.Sign() < 0 || c.YCoordinate.Sign() < 0 || c.XCoordinate.BitLen() > 256 || c.YCoordinate.BitLen() > 256 ||
+		len(c.Hash) != 32 || len(c.CipherText) == 0 || !gm.GetSm2Curve().IsOnCurve(c.XCoordinate, c.YCoordinate) {
+		return nil, errors.New("tlcp: invalid SM2 ciphertext")
+	}
+	ret := make([]byte, 65, 65+32+len(c.CipherText))
+	ret[0] = 4
+	c.XCoordinate.FillBytes(ret[1:33])
+	c.YCoordinate.FillBytes(ret[33:65])
+	ret = append(ret, c.Hash...)
+	return gm.CiphertextFromC1C3C2(append(ret, c.CipherText...))
+}
+
+// parseSM2Point parses an uncompressed point and checks that it is on the
+// curve.
+func parseSM2Point(point []byte) (*gm.SM2PublicKey, error) {
+	if len(point) != 65 || point[0] != 4 {
+		return nil, errors.New("tlcp: invalid SM2 point")
+	}
+	x, y := new(big.Int).SetBytes(point[1:33]), new(big.Int).SetBytes(point[33:])
+	if !gm.GetSm2Curve().IsOnCurve(x, y) {
+		return nil, errors.New("tlcp: SM2 point is not on the curve")
+	}
+	pub := &gm.SM2PublicKey{Curve: gm.GetSm2Curve()}
+	copy(pub.X[:], point[1:33])
+	copy(pub.Y[:], point[33:])
+	return pub, nil
+}
+
+func certificatePublicKey(cert *Certificate) (*gm.SM2PublicKey, error) {
+	leaf, err := cert.leaf()
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := leaf.PublicKey.(*gm.SM2PublicKey)
+	if !ok {
+		return nil, errors.New("tlcp: certificate does not contain an SM2 public key")
+	}
+	return pub, nil
+}
+
+// signSM2 signs msg with the key of cert, hashing with the default user id.
+func signSM2(rand io.Reader, cert *Certificate, msg []byte) ([]byte, error) {
+	pub, err := certificatePublicKey(cert)
+	if err != nil {
+		return nil, err
+	}
+	return cert.PrivateKey.Sign(nil, gm.HashBeforeSM2(pub, msg), rand)
+}
+
+func verifySM2(pub *gm.SM2PublicKey, msg, sig []byte) error {
+	if valid, err := pub.Verify(nil, sig, gm.HashBeforeSM2(pub, msg)); !valid || err != nil {
+		return errors.New("tlcp: SM2 verification failure")
+	}
+	return nil
+}
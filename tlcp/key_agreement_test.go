@@ -0,0 +1,93 @@
+package tlcp
+
+import (
+	"crypto/rand"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	mrand "math/rand"
+	"testing"
+)
+
+func TestSM2Cipher(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	msg := []byte("pre-master secret")
+	ciphertext, err := gm.Encrypt(&key.PublicKey, msg, rand.Reader)
+	assert.Nil(t, err)
+
+	der, err := marshalSM2Cipher(ciphertext)
+	assert.Nil(t, err)
+	back, err := unmarshalSM2Cipher(der)
+	assert.Nil(t, err)
+	assert.Equal(t, ciphertext, back)
+	plaintext, err := gm.Decrypt(key, back)
+	assert.Nil(t, err)
+	assert.Equal(t, msg, plaintext)
+
+	// C1 off the curve
+	ciphertext[64] ^= 1
+	der, err = marshalSM2Cipher(ciphertext)
+	assert.Nil(t, err)
+	_, err = unmarshalSM2Cipher(der)
+	assert.NotNil(t, err)
+
+	_, err = marshalSM2Cipher(ciphertext[:96])
+	assert.NotNil(t, err)
+	_, err = unmarshalSM2Cipher(append(der, 0))
+	assert.NotNil(t, err)
+}
+
+func TestSM2KeyExchange(t *testing.T) {
+	a, _ := gm.GenerateSM2Key()
+	b, _ := gm.GenerateSM2Key()
+	ra, _ := gm.GenerateSM2Key()
+	rb, _ := gm.GenerateSM2Key()
+
+	ka, err := sm2KeyExchange(ra, a, &a.PublicKey, &rb.PublicKey, &b.PublicKey, true)
+	assert.Nil(t, err)
+	kb, err := sm2KeyExchange(rb, b, &b.PublicKey, &ra.PublicKey, &a.PublicKey, false)
+	assert.Nil(t, err)
+	assert.Len(t, ka, 48)
+	assert.Equal(t, ka, kb)
+
+	c, _ := gm.GenerateSM2Key()
+	kc, err := sm2KeyExchange(rb, c, &c.PublicKey, &ra.PublicKey, &a.PublicKey, false)
+	assert.Nil(t, err)
+	assert.NotEqual(t, ka, kc)
+}
+
+func TestParseSM2Point(t *testing.T) {
+	key, _ := gm.GenerateSM2Key()
+	point, _ := key.PublicKey.Bytes()
+	pub, err := parseSM2Point(point)
+	assert.Nil(t, err)
+	assert.Equal(t, key.PublicKey.X, pub.X)
+
+	point[64] ^= 1
+	_, err = parseSM2Point(point)
+	assert.NotNil(t, err)
+	_, err = parseSM2Point(point[:64])
+	assert.NotNil(t, err)
+}
+
+func TestECDHEConfigRand(t *testing.T) {
+	// the ephemeral keys come from Config.Rand, a seeded reader repeats them
+	newConfig := func() *Config { return &Config{Rand: mrand.New(mrand.NewSource(1))} }
+	want, err := gm.GenerateSM2KeyWithReader(newConfig().rand())
+	assert.Nil(t, err)
+
+	server := new(ecdheKeyAgreement)
+	random := make([]byte, 32)
+	skx, err := server.generateServerKeyExchange(newConfig(), &pki.server[0], nil, random, random)
+	assert.Nil(t, err)
+	assert.Equal(t, want.K, server.ephemeral.K)
+	params := marshalECDHEParams(&want.PublicKey)
+	assert.Equal(t, params, skx.key[:len(params)])
+
+	serverEncPub, err := certificatePublicKey(&pki.server[1])
+	assert.Nil(t, err)
+	client := &ecdheKeyAgreement{peer: &want.PublicKey}
+	_, ckx, err := client.generateClientKeyExchange(newConfig(), &pki.client[1], serverEncPub)
+	assert.Nil(t, err)
+	assert.Equal(t, params, ckx.ciphertext)
+}
@@ -0,0 +1,113 @@
+package tlcp
+
+import (
+	"crypto/hmac"
+	gm "github.com/meshplus/crypto-gm"
+	"hash"
+)
+
+const (
+	masterSecretLength   = 48 // length of a master secret
+	finishedVerifyLength = 12 // length of verify_data in a Finished message
+)
+
+var (
+	masterSecretLabel   = []byte("master secret")
+	keyExpansionLabel   = []byte("key expansion")
+	clientFinishedLabel = []byte("client finished")
+	serverFinishedLabel = []byte("server finished")
+)
+
+// pHash implements the P_hash function of RFC 5246, 5 with HMAC-SM3.
+func pHash(result, secret, seed []byte) {
+	h := hmac.New(gm.GetSM3Hasher, secret)
+	_, _ = h.Write(seed)
+	a := h.Sum(nil)
+
+	j := 0
+	for j < len(result) {
+		h.Reset()
+		_, _ = h.Write(a)
+		_, _ = h.Write(seed)
+		b := h.Sum(nil)
+		j += copy(result[j:], b)
+
+		h.Reset()
+		_, _ = h.Write(a)
+		a = h.Sum(nil)
+	}
+}
+
+// prf is the PRF of GB/T 38636-2020 6.5.1, which is the TLS 1.2 one with SM3.
+func prf(result, secret, label, seed []byte) {
+	labelAndSeed := make([]byte, len(label)+len(seed))
+	copy(labelAndSeed, label)
+	copy(labelAndSeed[len(label):], seed)
+	pHash(result, secret, labelAndSeed)
+}
+
+// masterFromPreMasterSecret generates the master secret from the pre-master
+// secret.
+func masterFromPreMasterSecret(preMasterSecret, clientRandom, serverRandom []byte) []byte {
+	seed := make([]byte, 0, len(clientRandom)+len(serverRandom))
+	seed = append(seed, clientRandom...)
+	seed = append(seed, serverRandom...)
+
+	masterSecret := make([]byte, masterSecretLength)
+	prf(masterSecret, preMasterSecret, masterSecretLabel, seed)
+	return masterSecret
+}
+
+// keysFromMasterSecret generates the connection keys from the master secret,
+// given the lengths of the MAC key, cipher key and IV.
+func keysFromMasterSecret(masterSecret, clientRandom, serverRandom []byte, macLen, keyLen, ivLen int) (clientMAC, serverMAC, clientKey, serverKey, clientIV, serverIV []byte) {
+	seed := make([]byte, 0, len(serverRandom)+len(clientRandom))
+	seed = append(seed, serverRandom...)
+	seed = append(seed, clientRandom...)
+
+	n := 2*macLen + 2*keyLen + 2*ivLen
+	keyMaterial := make([]byte, n)
+	prf(keyMaterial, masterSecret, keyExpansionLabel, seed)
+	clientMAC = keyMaterial[:macLen]
+	keyMaterial = keyMaterial[macLen:]
+	serverMAC = keyMaterial[:macLen]
+	keyMaterial = keyMaterial[macLen:]
+	clientKey = keyMaterial[:keyLen]
+	keyMaterial = keyMaterial[keyLen:]
+	serverKey = keyMaterial[:keyLen]
+	keyMaterial = keyMaterial[keyLen:]
+	clientIV = keyMaterial[:ivLen]
+	keyMaterial = keyMaterial[ivLen:]
+	serverIV = keyMaterial[:ivLen]
+	return
+}
+
+// finishedHash keeps the running SM3 hash of the handshake transcript.
+type finishedHash struct {
+	hash hash.Hash
+}
+
+func newFinishedHash() finishedHash {
+	return finishedHash{gm.GetSM3Hasher()}
+}
+
+func (h *finishedHash) Write(msg []byte) (int, error) {
+	return h.hash.Write(msg)
+}
+
+// Sum returns the SM3 hash of the transcript so far.
+func (h *finishedHash) Sum() []byte {
+	return h.hash.Sum(nil)
+}
+
+func (h *finishedHash) clientSum(masterSecret []byte) []byte {
+	out := make([]byte, finishedVerifyLength)
+	prf(out, masterSecret, clientFinishedLabel, h.Sum())
+	return out
+}
+
+func (h *finishedHash) serverSum(masterSecret []byte) []byte {
+	out := make([]byte, finishedVerifyLength)
+	prf(out, masterSecret, serverFinishedLabel, h.Sum())
+	return out
+}
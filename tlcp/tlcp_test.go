@@ -0,0 +1,320 @@
+package tlcp
+
+import (
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+type testPKI struct {
+	roots  *x509.CertPool
+	server []Certificate
+	client []Certificate
+	caKey  *gm.SM2PrivateKey
+	ca     *x509.Certificate
+	serial int64
+}
+
+var pki = newTestPKI()
+
+func newTestPKI() *testPKI {
+	p := new(testPKI)
+	p.caKey, _ = gm.GenerateSM2Key()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "TLCP Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, p.caKey.Public(), p.caKey)
+	if err != nil {
+		panic(err)
+	}
+	p.ca, _ = x509.ParseCertificate(der)
+	p.roots = x509.NewCertPool()
+	p.roots.AddCert(p.ca)
+	p.serial = 1
+
+	p.server = []Certificate{
+		p.issue("server.hyperchain.cn", x509.KeyUsageDigitalSignature),
+		p.issue("server.hyperchain.cn", x509.KeyUsageKeyEncipherment|x509.KeyUsageKeyAgreement),
+	}
+	p.client = []Certificate{
+		p.issue("client", x509.KeyUsageDigitalSignature),
+		p.issue("client", x509.KeyUsageKeyEncipherment|x509.KeyUsageKeyAgreement),
+	}
+	return p
+}
+
+func (p *testPKI) issue(name string, usage x509.KeyUsage) Certificate {
+	key, _ := gm.GenerateSM2Key()
+	p.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(p.serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     usage,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{name},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, p.ca, key.Public(), p.caKey)
+	if err != nil {
+		panic(err)
+	}
+	return Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func (p *testPKI) serverConfig() *Config {
+	return &Config{
+		Certificates: p.server,
+		ClientCAs:    p.roots,
+	}
+}
+
+func (p *testPKI) clientConfig() *Config {
+	return &Config{
+		Certificates: p.client,
+		RootCAs:      p.roots,
+		ServerName:   "server.hyperchain.cn",
+	}
+}
+
+// runHandshake connects a client and a server over net.Pipe, sends a
+// message each way and returns the connection states.
+func runHandshake(t *testing.T, clientConfig, serverConfig *Config) (clientState, serverState ConnectionState, clientErr, serverErr error) {
+	c, s := net.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		server := Server(s, serverConfig)
+		defer server.Close()
+		if serverErr = server.Handshake(); serverErr != nil {
+			return
+		}
+		serverState = server.ConnectionState()
+		buf := make([]byte, 5)
+		if _, serverErr = io.ReadFull(server, buf); serverErr != nil {
+			return
+		}
+		_, serverErr = server.Write(append([]byte("echo "), buf...))
+		// wait for the close_notify of the client
+		_, _ = io.Copy(ioutil.Discard, server)
+	}()
+
+	client := Client(c, clientConfig)
+	if clientErr = client.Handshake(); clientErr == nil {
+		clientState = client.ConnectionState()
+		_, clientErr = client.Write([]byte("hello"))
+		assert.Nil(t, clientErr)
+		buf := make([]byte, 10)
+		_, clientErr = io.ReadFull(client, buf)
+		assert.Nil(t, clientErr)
+		assert.Equal(t, "echo hello", string(buf))
+	}
+	client.Close()
+	<-done
+	return
+}
+
+func TestHandshake(t *testing.T) {
+	for _, suite := range []uint16{ECC_SM4_CBC_SM3, ECC_SM4_GCM_SM3, ECDHE_SM4_CBC_SM3, ECDHE_SM4_GCM_SM3} {
+		t.Run(CipherSuiteName(suite), func(t *testing.T) {
+			clientConfig := pki.clientConfig()
+			clientConfig.CipherSuites = []uint16{suite}
+			serverConfig := pki.serverConfig()
+			serverConfig.ClientAuth = RequireAndVerifyClientCert
+
+			clientState, serverState, clientErr, serverErr := runHandshake(t, clientConfig, serverConfig)
+			assert.Nil(t, clientErr)
+			assert.Nil(t, serverErr)
+			assert.Equal(t, suite, clientState.CipherSuite)
+			assert.Equal(t, suite, serverState.CipherSuite)
+			assert.Equal(t, uint16(VersionTLCP), clientState.Version)
+			assert.Len(t, clientState.PeerCertificates, 2)
+			assert.Len(t, clientState.VerifiedChains, 1)
+			assert.Equal(t, "client", serverState.PeerCertificates[0].Subject.CommonName)
+			assert.Len(t, serverState.VerifiedChains, 1)
+		})
+	}
+}
+
+func TestHandshakeWithoutClientCertificate(t *testing.T) {
+	clientConfig := pki.clientConfig()
+	clientConfig.Certificates = nil
+	clientState, serverState, clientErr, serverErr := runHandshake(t, clientConfig, pki.serverConfig())
+	assert.Nil(t, clientErr)
+	assert.Nil(t, serverErr)
+	assert.Equal(t, ECC_SM4_GCM_SM3, clientState.CipherSuite)
+	assert.Len(t, serverState.PeerCertificates, 0)
+
+	// ECDHE needs the client encryption certificate
+	clientConfig.CipherSuites = []uint16{ECDHE_SM4_GCM_SM3}
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, pki.serverConfig())
+	assert.NotNil(t, clientErr)
+	assert.NotNil(t, serverErr)
+
+	// the server requires one
+	clientConfig.CipherSuites = nil
+	serverConfig := pki.serverConfig()
+	serverConfig.ClientAuth = RequireAnyClientCert
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, serverConfig)
+	assert.NotNil(t, clientErr)
+	assert.NotNil(t, serverErr)
+}
+
+func TestHandshakeVerifyFailure(t *testing.T) {
+	clientConfig := pki.clientConfig()
+	clientConfig.ServerName = "other.hyperchain.cn"
+	_, _, clientErr, serverErr := runHandshake(t, clientConfig, pki.serverConfig())
+	assert.IsType(t, x509.HostnameError{}, clientErr)
+	assert.NotNil(t, serverErr)
+
+	other := newTestPKI()
+	clientConfig = pki.clientConfig()
+	clientConfig.RootCAs = other.roots
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, pki.serverConfig())
+	assert.IsType(t, x509.UnknownAuthorityError{}, clientErr)
+	assert.NotNil(t, serverErr)
+
+	clientConfig.InsecureSkipVerify = true
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, pki.serverConfig())
+	assert.Nil(t, clientErr)
+	assert.Nil(t, serverErr)
+
+	// a client certificate from another CA
+	clientConfig = pki.clientConfig()
+	clientConfig.Certificates = other.client
+	serverConfig := pki.serverConfig()
+	serverConfig.ClientAuth = RequireAndVerifyClientCert
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, serverConfig)
+	assert.NotNil(t, clientErr)
+	assert.NotNil(t, serverErr)
+
+	// the sign and the enc certificates swapped
+	serverConfig = pki.serverConfig()
+	serverConfig.Certificates = []Certificate{pki.server[1], pki.server[0]}
+	_, _, clientErr, serverErr = runHandshake(t, pki.clientConfig(), serverConfig)
+	assert.NotNil(t, clientErr)
+	assert.NotNil(t, serverErr)
+}
+
+func TestNoMutualCipherSuite(t *testing.T) {
+	clientConfig := pki.clientConfig()
+	clientConfig.CipherSuites = []uint16{ECC_SM4_CBC_SM3}
+	serverConfig := pki.serverConfig()
+	serverConfig.CipherSuites = []uint16{ECC_SM4_GCM_SM3}
+	_, _, clientErr, serverErr := runHandshake(t, clientConfig, serverConfig)
+	assert.NotNil(t, clientErr)
+	assert.NotNil(t, serverErr)
+}
+
+func TestResumption(t *testing.T) {
+	for _, suite := range []uint16{ECC_SM4_GCM_SM3, ECDHE_SM4_CBC_SM3} {
+		clientConfig := pki.clientConfig()
+		clientConfig.CipherSuites = []uint16{suite}
+		clientConfig.SessionCache = NewLRUSessionCache(1)
+		serverConfig := pki.serverConfig()
+		serverConfig.ClientAuth = RequireAndVerifyClientCert
+		serverConfig.SessionCache = NewLRUSessionCache(0)
+
+		clientState, serverState, clientErr, serverErr := runHandshake(t, clientConfig, serverConfig)
+		assert.Nil(t, clientErr)
+		assert.Nil(t, serverErr)
+		assert.False(t, clientState.DidResume)
+		assert.False(t, serverState.DidResume)
+
+		clientState, serverState, clientErr, serverErr = runHandshake(t, clientConfig, serverConfig)
+		assert.Nil(t, clientErr)
+		assert.Nil(t, serverErr)
+		assert.True(t, clientState.DidResume)
+		assert.True(t, serverState.DidResume)
+		assert.Equal(t, suite, clientState.CipherSuite)
+		assert.Len(t, clientState.PeerCertificates, 2)
+		assert.Len(t, serverState.PeerCertificates, 2)
+
+		// a server that forgot the session runs a full handshake
+		serverConfig.SessionCache = NewLRUSessionCache(0)
+		clientState, _, clientErr, serverErr = runHandshake(t, clientConfig, serverConfig)
+		assert.Nil(t, clientErr)
+		assert.Nil(t, serverErr)
+		assert.False(t, clientState.DidResume)
+	}
+}
+
+func TestListenAndDial(t *testing.T) {
+	l, err := Listen("tcp", "127.0.0.1:0", pki.serverConfig())
+	assert.Nil(t, err)
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(conn, conn)
+	}()
+
+	clientConfig := pki.clientConfig()
+	clientConfig.ServerName = ""
+	_, err = Dial("tcp", l.Addr().String(), clientConfig)
+	// the certificate is for server.hyperchain.cn, not for 127.0.0.1
+	assert.IsType(t, x509.HostnameError{}, err)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(conn, conn)
+	}()
+	conn, err := Dial("tcp", l.Addr().String(), pki.clientConfig())
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	// more than one record
+	msg := make([]byte, 3*maxPlaintext+1)
+	_, _ = rand.Read(msg)
+	go func() {
+		_, _ = conn.Write(msg)
+	}()
+	buf := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, msg, buf)
+
+	_, err = Listen("tcp", "127.0.0.1:0", &Config{})
+	assert.NotNil(t, err)
+}
+
+func TestX509KeyPair(t *testing.T) {
+	cert := pki.server[0]
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	der, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	assert.Nil(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	pair, err := X509KeyPair(certPEM, keyPEM)
+	assert.Nil(t, err)
+	assert.Equal(t, cert.PrivateKey.K, pair.PrivateKey.K)
+	assert.Equal(t, "server.hyperchain.cn", pair.Leaf.Subject.CommonName)
+
+	der, err = x509.MarshalPKCS8PrivateKey(pki.server[1].PrivateKey)
+	assert.Nil(t, err)
+	_, err = X509KeyPair(certPEM, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	assert.NotNil(t, err)
+}
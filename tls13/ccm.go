@@ -0,0 +1,169 @@
+package tls13
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+)
+
+// ccm is the CCM mode of NIST SP 800-38C over a 128-bit block cipher.
+type ccm struct {
+	b         cipher.Block
+	nonceSize int
+	tagSize   int
+}
+
+// newCCM returns the CCM mode of b with the given nonce and tag sizes.
+// RFC 8998 uses a 12 byte nonce and a 16 byte tag.
+func newCCM(b cipher.Block, nonceSize, tagSize int) (cipher.AEAD, error) {
+	if b.BlockSize() != 16 {
+		return nil, errors.New("tls13: CCM requires a 128-bit block cipher")
+	}
+	if nonceSize < 7 || nonceSize > 13 {
+		return nil, errors.New("tls13: invalid CCM nonce size")
+	}
+	if tagSize < 4 || tagSize > 16 || tagSize%2 != 0 {
+		return nil, errors.New("tls13: invalid CCM tag size")
+	}
+	return &ccm{b: b, nonceSize: nonceSize, tagSize: tagSize}, nil
+}
+
+func (c *ccm) NonceSize() int { return c.nonceSize }
+
+func (c *ccm) Overhead() int { return c.tagSize }
+
+// maxLength returns the maximum plaintext length, 2^(8L) - 1 for the length
+// field of L = 15 - nonceSize bytes.
+func (c *ccm) maxLength() uint64 {
+	l := uint(15 - c.nonceSize)
+	if l >= 8 {
+		return 1<<63 - 1
+	}
+	return 1<<(8*l) - 1
+}
+
+// counter returns the counter block A_i.
+func (c *ccm) counter(nonce []byte, i byte) []byte {
+	a := make([]byte, 16)
+	a[0] = byte(14 - c.nonceSize) // L - 1
+	copy(a[1:], nonce)
+	a[15] = i
+	return a
+}
+
+// mac returns the CBC-MAC T of the formatted nonce, associated data and
+// plaintext.
+func (c *ccm) mac(nonce, plaintext, additionalData []byte) []byte {
+	l := 15 - c.nonceSize
+	var b0 [16]byte
+	b0[0] = byte((c.tagSize-2)/2<<3 | (l - 1))
+	if len(additionalData) > 0 {
+		b0[0] |= 0x40
+	}
+	copy(b0[1:], nonce)
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(plaintext)))
+	copy(b0[16-l:], length[8-l:])
+
+	y := make([]byte, 16)
+	c.b.Encrypt(y, b0[:])
+
+	block := make([]byte, 16)
+	absorb := func(data []byte) {
+		for len(data) > 0 {
+			n := copy(block, data)
+			for i := n; i < 16; i++ {
+				block[i] = 0
+			}
+			xorBytes(y, y, block)
+			c.b.Encrypt(y, y)
+			data = data[n:]
+		}
+	}
+
+	if len(additionalData) > 0 {
+		var encoded []byte
+		switch n := uint64(len(additionalData)); {
+		case n < 1<<16-1<<8:
+			encoded = []byte{byte(n >> 8), byte(n)}
+		case n < 1<<32:
+			encoded = []byte{0xff, 0xfe, byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}
+		default:
+			encoded = make([]byte, 10)
+			encoded[0], encoded[1] = 0xff, 0xff
+			binary.BigEndian.PutUint64(encoded[2:], n)
+		}
+		// the encoded length and the data are padded as one string
+		absorb(append(encoded, additionalData...))
+	}
+	absorb(plaintext)
+	return y
+}
+
+func (c *ccm) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	if len(nonce) != c.nonceSize {
+		panic("tls13: incorrect nonce length given to CCM")
+	}
+	if uint64(len(plaintext)) > c.maxLength() {
+		panic("tls13: message too large for CCM")
+	}
+
+	tag := c.mac(nonce, plaintext, additionalData)
+	ret, out := sliceForAppend(dst, len(plaintext)+c.tagSize)
+	cipher.NewCTR(c.b, c.counter(nonce, 1)).XORKeyStream(out, plaintext)
+
+	s0 := make([]byte, 16)
+	c.b.Encrypt(s0, c.counter(nonce, 0))
+	xorBytes(out[len(plaintext):], tag[:c.tagSize], s0[:c.tagSize])
+	return ret
+}
+
+var errOpen = errors.New("tls13: message authentication failed")
+
+func (c *ccm) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(nonce) != c.nonceSize {
+		panic("tls13: incorrect nonce length given to CCM")
+	}
+	if len(ciphertext) < c.tagSize || uint64(len(ciphertext)-c.tagSize) > c.maxLength() {
+		return nil, errOpen
+	}
+
+	tagged := ciphertext[len(ciphertext)-c.tagSize:]
+	ciphertext = ciphertext[:len(ciphertext)-c.tagSize]
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	cipher.NewCTR(c.b, c.counter(nonce, 1)).XORKeyStream(out, ciphertext)
+
+	tag := c.mac(nonce, out, additionalData)
+	s0 := make([]byte, 16)
+	c.b.Encrypt(s0, c.counter(nonce, 0))
+	xorBytes(tag, tag, s0)
+	if subtle.ConstantTimeCompare(tag[:c.tagSize], tagged) != 1 {
+		for i := range out {
+			out[i] = 0
+		}
+		return nil, errOpen
+	}
+	return ret, nil
+}
+
+// sliceForAppend takes a slice and a requested number of bytes. It returns a
+// slice with the contents of the given slice followed by that many bytes and a
+// second slice that aliases into it and contains only the extra bytes.
+func sliceForAppend(in []byte, n int) (head, tail []byte) {
+	if total := len(in) + n; cap(in) >= total {
+		head = in[:total]
+	} else {
+		head = make([]byte, total)
+		copy(head, in)
+	}
+	tail = head[len(in):]
+	return
+}
+
+// xorBytes sets dst[i] = a[i] ^ b[i] for i < len(dst).
+func xorBytes(dst, a, b []byte) {
+	for i := range dst {
+		dst[i] = a[i] ^ b[i]
+	}
+}
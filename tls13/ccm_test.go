@@ -0,0 +1,90 @@
+package tls13
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// RFC 8998 appendix A
+func TestSM4AEADVectors(t *testing.T) {
+	key := decodeHex("0123456789ABCDEFFEDCBA9876543210")
+	nonce := decodeHex("00001234567800000000ABCD")
+	plaintext := decodeHex("AAAAAAAAAAAAAAAABBBBBBBBBBBBBBBB" + "CCCCCCCCCCCCCCCCDDDDDDDDDDDDDDDD" +
+		"EEEEEEEEEEEEEEEEFFFFFFFFFFFFFFFF" + "EEEEEEEEEEEEEEEEAAAAAAAAAAAAAAAA")
+	ad := decodeHex("FEEDFACEDEADBEEFFEEDFACEDEADBEEFABADDAD2")
+
+	tests := []struct {
+		aead       func([]byte) (cipher.AEAD, error)
+		ciphertext string
+		tag        string
+	}{
+		{
+			aeadSM4GCM,
+			"17F399F08C67D5EE19D0DC9969C4BB7D5FD46FD3756489069157B282BB200735" +
+				"D82710CA5C22F0CCFA7CBF93D496AC15A56834CBCF98C397B4024A2691233B8D",
+			"83DE3541E4C2B58177E065A9BF7B62EC",
+		},
+		{
+			aeadSM4CCM,
+			"48AF93501FA62ADBCD414CCE6034D895DDA1BF8F132F042098661572E7483094" +
+				"FD12E518CE062C98ACEE28D95DF4416BED31A2F04476C18BB40C84A74B97DC5B",
+			"16842D4FA186F56AB33256971FA110F4",
+		},
+	}
+	for _, tt := range tests {
+		aead, err := tt.aead(key)
+		assert.Nil(t, err)
+		sealed := aead.Seal(nil, nonce, plaintext, ad)
+		assert.Equal(t, decodeHex(tt.ciphertext+tt.tag), sealed)
+
+		opened, err := aead.Open(nil, nonce, sealed, ad)
+		assert.Nil(t, err)
+		assert.Equal(t, plaintext, opened)
+
+		sealed[0] ^= 1
+		_, err = aead.Open(nil, nonce, sealed, ad)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestCCM(t *testing.T) {
+	block, err := gm.GetSm4Cipher(make([]byte, 16))
+	assert.Nil(t, err)
+	for _, nonceSize := range []int{7, 12, 13} {
+		aead, err := newCCM(block, nonceSize, 16)
+		assert.Nil(t, err)
+		nonce := make([]byte, nonceSize)
+		for _, n := range []int{0, 1, 16, 17, 1000} {
+			msg := make([]byte, n)
+			_, _ = rand.Read(msg)
+			for _, ad := range [][]byte{nil, {1}, make([]byte, 1<<16)} {
+				sealed := aead.Seal([]byte{9}, nonce, msg, ad)
+				assert.Equal(t, byte(9), sealed[0])
+				opened, err := aead.Open(nil, nonce, sealed[1:], ad)
+				assert.Nil(t, err)
+				assert.True(t, bytes.Equal(msg, opened))
+
+				_, err = aead.Open(nil, nonce, sealed[1:], append(ad, 0))
+				assert.NotNil(t, err)
+			}
+		}
+	}
+
+	_, err = newCCM(block, 6, 16)
+	assert.NotNil(t, err)
+	_, err = newCCM(block, 12, 5)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,127 @@
+package tls13
+
+import (
+	"crypto/cipher"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+)
+
+//The cipher suites of RFC 8998.
+const (
+	TLS_SM4_GCM_SM3 uint16 = 0x00c6
+	TLS_SM4_CCM_SM3 uint16 = 0x00c7
+)
+
+const (
+	aeadKeyLength   = 16 // SM4 key length
+	aeadNonceLength = 12 // RFC 8998 nonce and IV length
+	aeadTagLength   = 16
+)
+
+// cipherSuite is a TLS 1.3 cipher suite, the hash is always SM3.
+type cipherSuite struct {
+	id   uint16
+	name string
+	aead func(key []byte) (cipher.AEAD, error)
+}
+
+var cipherSuites = []*cipherSuite{
+	{TLS_SM4_GCM_SM3, "TLS_SM4_GCM_SM3", aeadSM4GCM},
+	{TLS_SM4_CCM_SM3, "TLS_SM4_CCM_SM3", aeadSM4CCM},
+}
+
+var defaultCipherSuites = []uint16{TLS_SM4_GCM_SM3, TLS_SM4_CCM_SM3}
+
+func cipherSuiteByID(id uint16) *cipherSuite {
+	for _, suite := range cipherSuites {
+		if suite.id == id {
+			return suite
+		}
+	}
+	return nil
+}
+
+//CipherSuiteName returns the standard name of the cipher suite, or its
+// hex value if it is not implemented by this package.
+func CipherSuiteName(id uint16) string {
+	if suite := cipherSuiteByID(id); suite != nil {
+		return suite.name
+	}
+	return fmt.Sprintf("0x%04X", id)
+}
+
+func aeadSM4GCM(key []byte) (cipher.AEAD, error) {
+	block, err := gm.GetSm4Cipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func aeadSM4CCM(key []byte) (cipher.AEAD, error) {
+	block, err := gm.GetSm4Cipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return newCCM(block, aeadNonceLength, aeadTagLength)
+}
+
+// trafficCipher protects records with the key and IV of a traffic secret,
+// RFC 8446 section 5.2.
+type trafficCipher struct {
+	aead cipher.AEAD
+	iv   []byte
+}
+
+func newTrafficCipher(suite *cipherSuite, trafficSecret []byte) (*trafficCipher, error) {
+	key, iv := trafficKey(trafficSecret)
+	aead, err := suite.aead(key)
+	if err != nil {
+		return nil, err
+	}
+	return &trafficCipher{aead: aead, iv: iv}, nil
+}
+
+// nonce XORs the sequence number, padded to the left, into the IV.
+func (tc *trafficCipher) nonce(seq *[8]byte) []byte {
+	nonce := append([]byte(nil), tc.iv...)
+	for i, b := range seq {
+		nonce[len(nonce)-8+i] ^= b
+	}
+	return nonce
+}
+
+// seal returns the record, header included, carrying the TLSInnerPlaintext
+// of payload and typ.
+func (tc *trafficCipher) seal(seq *[8]byte, typ recordType, payload []byte) []byte {
+	n := len(payload) + 1 + tc.aead.Overhead()
+	record := make([]byte, recordHeaderLen, recordHeaderLen+n)
+	record[0] = byte(recordTypeApplicationData)
+	record[1] = byte(versionTLS12 >> 8)
+	record[2] = byte(versionTLS12 & 0xff)
+	record[3] = byte(n >> 8)
+	record[4] = byte(n)
+
+	inner := make([]byte, 0, len(payload)+1)
+	inner = append(append(inner, payload...), byte(typ))
+	return tc.aead.Seal(record, tc.nonce(seq), inner, record[:recordHeaderLen])
+}
+
+// open authenticates and decrypts the record and strips the padding of the
+// TLSInnerPlaintext, returning the content and its real type.
+func (tc *trafficCipher) open(seq *[8]byte, record []byte) ([]byte, recordType, error) {
+	inner, err := tc.aead.Open(nil, tc.nonce(seq), record[recordHeaderLen:], record[:recordHeaderLen])
+	if err != nil {
+		return nil, 0, errBadRecordMAC
+	}
+	i := len(inner) - 1
+	for i >= 0 && inner[i] == 0 {
+		i--
+	}
+	if i < 0 {
+		return nil, 0, alertUnexpectedMessage
+	}
+	return inner[:i], recordType(inner[i]), nil
+}
+
+var errBadRecordMAC = alertBadRecordMAC
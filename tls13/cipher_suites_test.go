@@ -0,0 +1,51 @@
+package tls13
+
+import (
+	"bytes"
+	"crypto/rand"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTrafficCiphers(t *testing.T) {
+	secret := bytes.Repeat([]byte{1}, hashLength)
+	for _, suite := range cipherSuites {
+		sealer, err := newTrafficCipher(suite, secret)
+		assert.Nil(t, err)
+		opener, err := newTrafficCipher(suite, secret)
+		assert.Nil(t, err)
+
+		var seq [8]byte
+		for _, n := range []int{0, 1, 100, maxPlaintext} {
+			payload := make([]byte, n)
+			_, _ = rand.Read(payload)
+			record := sealer.seal(&seq, recordTypeHandshake, payload)
+			assert.Equal(t, byte(recordTypeApplicationData), record[0])
+			assert.True(t, len(record)-recordHeaderLen <= maxCiphertextTLS13)
+
+			got, typ, err := opener.open(&seq, record)
+			assert.Nil(t, err, suite.name)
+			assert.Equal(t, recordTypeHandshake, typ)
+			assert.True(t, bytes.Equal(payload, got), suite.name)
+
+			// the sequence number, the header and the body are authenticated
+			seq[7]++
+			_, _, err = opener.open(&seq, record)
+			assert.Equal(t, errBadRecordMAC, err, suite.name)
+			seq[7]--
+			record[2] ^= 1
+			_, _, err = opener.open(&seq, record)
+			assert.Equal(t, errBadRecordMAC, err, suite.name)
+			record[2] ^= 1
+			record[len(record)-1] ^= 1
+			_, _, err = opener.open(&seq, record)
+			assert.Equal(t, errBadRecordMAC, err, suite.name)
+			seq[7]++
+		}
+	}
+}
+
+func TestCipherSuiteName(t *testing.T) {
+	assert.Equal(t, "TLS_SM4_CCM_SM3", CipherSuiteName(TLS_SM4_CCM_SM3))
+	assert.Equal(t, "0x1301", CipherSuiteName(0x1301))
+}
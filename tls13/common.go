@@ -0,0 +1,259 @@
+package tls13
+
+import (
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+//VersionTLS13 is the protocol version of RFC 8446.
+const VersionTLS13 = 0x0304
+
+const (
+	maxPlaintext       = 16384       // maximum plaintext payload length
+	maxCiphertextTLS13 = 16384 + 256 // maximum ciphertext length in TLS 1.3
+	recordHeaderLen    = 5           // record header length
+	maxHandshake       = 65536       // maximum handshake we support (protocol max is 16 MB)
+
+	// versionTLS12 is the legacy version of records and hellos.
+	versionTLS12 = 0x0303
+)
+
+// TLS record types.
+type recordType uint8
+
+const (
+	recordTypeChangeCipherSpec recordType = 20
+	recordTypeAlert            recordType = 21
+	recordTypeHandshake        recordType = 22
+	recordTypeApplicationData  recordType = 23
+)
+
+// TLS 1.3 handshake message types.
+const (
+	typeClientHello         uint8 = 1
+	typeServerHello         uint8 = 2
+	typeNewSessionTicket    uint8 = 4
+	typeEncryptedExtensions uint8 = 8
+	typeCertificate         uint8 = 11
+	typeCertificateRequest  uint8 = 13
+	typeCertificateVerify   uint8 = 15
+	typeFinished            uint8 = 20
+	typeKeyUpdate           uint8 = 24
+)
+
+// TLS extension numbers.
+const (
+	extensionServerName          uint16 = 0
+	extensionSupportedCurves     uint16 = 10
+	extensionSignatureAlgorithms uint16 = 13
+	extensionSupportedVersions   uint16 = 43
+	extensionKeyShare            uint16 = 51
+)
+
+const (
+	compressionNone uint8 = 0
+
+	// curveSM2 is the named group of SM2, RFC 8998.
+	curveSM2 uint16 = 41
+	// signatureSM2WithSM3 is the sm2sig_sm3 signature scheme, RFC 8998.
+	signatureSM2WithSM3 uint16 = 0x0708
+)
+
+// sm2SignatureID is the SM2 user id of sm2sig_sm3, RFC 8998 section 3.2.1.
+var sm2SignatureID = []byte("TLSv1.3+GM+Cipher+Suite")
+
+// helloRetryRequestRandom is the random of a ServerHello that is a
+// HelloRetryRequest, RFC 8446 section 4.1.3.
+var helloRetryRequestRandom = []byte{
+	0xCF, 0x21, 0xAD, 0x74, 0xE5, 0x9A, 0x61, 0x11,
+	0xBE, 0x1D, 0x8C, 0x02, 0x1E, 0x65, 0xB8, 0x91,
+	0xC2, 0xA2, 0x11, 0x16, 0x7A, 0xBB, 0x8C, 0x5E,
+	0x07, 0x9E, 0x09, 0xE2, 0xC8, 0xA8, 0x33, 0x9C,
+}
+
+//ClientAuthType declares the policy the server will follow for client
+// authentication.
+type ClientAuthType int
+
+//ClientAuthType values, the same as in crypto/tls.
+const (
+	NoClientCert ClientAuthType = iota
+	RequestClientCert
+	RequireAnyClientCert
+	VerifyClientCertIfGiven
+	RequireAndVerifyClientCert
+)
+
+//Certificate is a chain of one or more certificates, leaf first, with the
+// SM2 private key of the leaf.
+type Certificate struct {
+	Certificate [][]byte
+	PrivateKey  *gm.SM2PrivateKey
+	// Leaf is the parsed form of the leaf certificate, it is filled in on
+	// first use if nil.
+	Leaf *x509.Certificate
+}
+
+func (c *Certificate) leaf() (*x509.Certificate, error) {
+	if c.Leaf != nil {
+		return c.Leaf, nil
+	}
+	if len(c.Certificate) == 0 {
+		return nil, errors.New("tls13: empty certificate")
+	}
+	return x509.ParseCertificate(c.Certificate[0])
+}
+
+//X509KeyPair parses a public/private key pair from a pair of PEM encoded
+// data. The private key may be PKCS #8 or SEC 1 encoded.
+func X509KeyPair(certPEMBlock, keyPEMBlock []byte) (Certificate, error) {
+	var cert Certificate
+	for {
+		var block *pem.Block
+		block, certPEMBlock = pem.Decode(certPEMBlock)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			cert.Certificate = append(cert.Certificate, block.Bytes)
+		}
+	}
+	if len(cert.Certificate) == 0 {
+		return cert, errors.New("tls13: failed to find any PEM data in certificate input")
+	}
+
+	var keyDER []byte
+	for {
+		var block *pem.Block
+		block, keyPEMBlock = pem.Decode(keyPEMBlock)
+		if block == nil {
+			return cert, errors.New("tls13: failed to find PEM block with type ending in \"PRIVATE KEY\" in key input")
+		}
+		if block.Type == "PRIVATE KEY" || block.Type == "EC PRIVATE KEY" || block.Type == "SM2 PRIVATE KEY" {
+			keyDER = block.Bytes
+			break
+		}
+	}
+	key, err := x509.ParsePKCS8PrivateKey(keyDER)
+	if err != nil {
+		if key, err = x509.ParseSM2PrivateKey(keyDER); err != nil {
+			return cert, errors.New("tls13: failed to parse private key")
+		}
+	}
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return cert, err
+	}
+	pub, ok := leaf.PublicKey.(*gm.SM2PublicKey)
+	if !ok {
+		return cert, errors.New("tls13: certificate does not contain an SM2 public key")
+	}
+	if pub.X != key.PublicKey.X || pub.Y != key.PublicKey.Y {
+		return cert, errors.New("tls13: private key does not match public key")
+	}
+	cert.PrivateKey = key
+	cert.Leaf = leaf
+	return cert, nil
+}
+
+//LoadX509KeyPair reads and parses a public/private key pair from a pair of
+// files.
+func LoadX509KeyPair(certFile, keyFile string) (Certificate, error) {
+	certPEMBlock, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return Certificate{}, err
+	}
+	keyPEMBlock, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return Certificate{}, err
+	}
+	return X509KeyPair(certPEMBlock, keyPEMBlock)
+}
+
+//Config is used to configure a client or server. A Config may be reused and
+// must not be modified after it has been passed to a function of this
+// package.
+type Config struct {
+	// Rand provides the source of entropy for randoms and signatures.
+	// If Rand is nil, crypto/rand.Reader is used.
+	Rand io.Reader
+
+	// Time returns the current time. If Time is nil, time.Now is used.
+	Time func() time.Time
+
+	// Certificates holds the certificate chain presented to the peer, only
+	// the first one is used. Servers must set it; clients need it for
+	// client authentication.
+	Certificates []Certificate
+
+	// RootCAs defines the root certificate authorities clients use to verify
+	// server certificates.
+	RootCAs *x509.CertPool
+
+	// ServerName is sent in the server_name extension and used by clients
+	// to verify the hostname of the server certificate unless
+	// InsecureSkipVerify is set.
+	ServerName string
+
+	// ClientAuth determines the server's policy for client authentication.
+	ClientAuth ClientAuthType
+
+	// ClientCAs defines the root certificate authorities servers use to
+	// verify client certificates.
+	ClientCAs *x509.CertPool
+
+	// InsecureSkipVerify controls whether a client verifies the server's
+	// certificate chain and host name. It is for testing only.
+	InsecureSkipVerify bool
+
+	// CipherSuites is the list of enabled cipher suites, in preference
+	// order for clients. If nil, all suites are enabled.
+	CipherSuites []uint16
+}
+
+func (c *Config) rand() io.Reader {
+	if c.Rand == nil {
+		return rand.Reader
+	}
+	return c.Rand
+}
+
+func (c *Config) time() time.Time {
+	if c.Time == nil {
+		return time.Now()
+	}
+	return c.Time()
+}
+
+func (c *Config) cipherSuites() []uint16 {
+	if c.CipherSuites == nil {
+		return defaultCipherSuites
+	}
+	return c.CipherSuites
+}
+
+// certificate returns the certificate of c, or nil if it has none.
+func (c *Config) certificate() *Certificate {
+	if len(c.Certificates) == 0 || c.Certificates[0].PrivateKey == nil {
+		return nil
+	}
+	return &c.Certificates[0]
+}
+
+//ConnectionState records basic details about the connection.
+type ConnectionState struct {
+	Version           uint16
+	HandshakeComplete bool
+	CipherSuite       uint16
+	// ServerName is the server name the client sent, on both sides.
+	ServerName       string
+	PeerCertificates []*x509.Certificate
+	VerifiedChains   [][]*x509.Certificate
+}
@@ -0,0 +1,633 @@
+package tls13
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/meshplus/crypto-gm/x509"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//Conn represents a secured connection. It implements the net.Conn
+// interface.
+type Conn struct {
+	// constant
+	conn     net.Conn
+	isClient bool
+	config   *Config
+
+	// handshakeStatus is 1 if the connection is currently transferring
+	// application data, it is accessed atomically.
+	handshakeStatus uint32
+	// constant after handshake; protected by handshakeMutex
+	handshakeMutex   sync.Mutex
+	handshakeErr     error
+	cipherSuite      uint16
+	serverName       string
+	peerCertificates []*x509.Certificate
+	verifiedChains   [][]*x509.Certificate
+
+	// input/output
+	in, out  halfConn
+	rawInput bytes.Buffer // raw input, starting with a record header
+	input    bytes.Reader // application data waiting to be read
+	hand     bytes.Buffer // handshake data waiting to be read
+
+	// buffering makes the records of a handshake flight be sent at once
+	buffering bool
+	sendBuf   []byte
+
+	closeNotifyErr  error
+	closeNotifySent bool
+}
+
+// halfConn represents one direction of the record layer connection.
+type halfConn struct {
+	sync.Mutex
+
+	err           error          // first permanent error
+	cipher        *trafficCipher // nil while records are plaintext
+	seq           [8]byte        // 64-bit sequence number
+	suite         *cipherSuite
+	trafficSecret []byte // current traffic secret, for KeyUpdate
+}
+
+func (hc *halfConn) setErrorLocked(err error) error {
+	hc.err = err
+	return err
+}
+
+// setTrafficSecret switches to the keys of secret and resets the sequence
+// number.
+func (hc *halfConn) setTrafficSecret(suite *cipherSuite, secret []byte) error {
+	cipher, err := newTrafficCipher(suite, secret)
+	if err != nil {
+		return err
+	}
+	hc.cipher = cipher
+	hc.suite = suite
+	hc.trafficSecret = secret
+	hc.seq = [8]byte{}
+	return nil
+}
+
+// incSeq increments the sequence number.
+func (hc *halfConn) incSeq() {
+	for i := 7; i >= 0; i-- {
+		hc.seq[i]++
+		if hc.seq[i] != 0 {
+			return
+		}
+	}
+
+	// Not allowed to let sequence number wrap.
+	panic("tls13: sequence number wraparound")
+}
+
+// decrypt returns the content and the real type of the record.
+func (hc *halfConn) decrypt(record []byte) ([]byte, recordType, error) {
+	typ := recordType(record[0])
+	if hc.cipher == nil {
+		return append([]byte(nil), record[recordHeaderLen:]...), typ, nil
+	}
+	if typ != recordTypeApplicationData {
+		return nil, 0, alertUnexpectedMessage
+	}
+	payload, typ, err := hc.cipher.open(&hc.seq, record)
+	if err != nil {
+		return nil, 0, err
+	}
+	hc.incSeq()
+	return payload, typ, nil
+}
+
+// encrypt returns the record, header included, carrying payload.
+func (hc *halfConn) encrypt(typ recordType, payload []byte) []byte {
+	if hc.cipher != nil {
+		record := hc.cipher.seal(&hc.seq, typ, payload)
+		hc.incSeq()
+		return record
+	}
+	record := make([]byte, recordHeaderLen, recordHeaderLen+len(payload))
+	record[0] = byte(typ)
+	record[1] = byte(versionTLS12 >> 8)
+	record[2] = byte(versionTLS12 & 0xff)
+	record[3] = byte(len(payload) >> 8)
+	record[4] = byte(len(payload))
+	return append(record, payload...)
+}
+
+// readFromUntil reads from r into c.rawInput until it holds at least n bytes.
+func (c *Conn) readFromUntil(r io.Reader, n int) error {
+	if c.rawInput.Len() >= n {
+		return nil
+	}
+	needs := n - c.rawInput.Len()
+	c.rawInput.Grow(needs + bytes.MinRead)
+	_, err := c.rawInput.ReadFrom(&atLeastReader{r, int64(needs)})
+	return err
+}
+
+// atLeastReader reads from R, stopping with EOF once at least N bytes have
+// been read. It is different from an io.LimitedReader in that it doesn't
+// cut short the last Read call.
+type atLeastReader struct {
+	R io.Reader
+	N int64
+}
+
+func (r *atLeastReader) Read(p []byte) (int, error) {
+	if r.N <= 0 {
+		return 0, io.EOF
+	}
+	n, err := r.R.Read(p)
+	r.N -= int64(n)
+	if r.N > 0 && err == io.EOF {
+		return n, io.ErrUnexpectedEOF
+	}
+	if r.N <= 0 && err == nil {
+		return n, io.EOF
+	}
+	return n, err
+}
+
+// readRecord reads the next record, handshake data is appended to c.hand and
+// application data is put into c.input.
+func (c *Conn) readRecord() error {
+	if c.in.err != nil {
+		return c.in.err
+	}
+	handshakeComplete := c.handshakeComplete()
+
+	if c.input.Len() != 0 {
+		return c.in.setErrorLocked(errors.New("tls13: internal error: attempted to read record with pending application data"))
+	}
+	c.input.Reset(nil)
+
+	if err := c.readFromUntil(c.conn, recordHeaderLen); err != nil {
+		if e, ok := err.(net.Error); !ok || !e.Temporary() {
+			c.in.setErrorLocked(err)
+		}
+		return err
+	}
+	hdr := c.rawInput.Bytes()[:recordHeaderLen]
+	typ := recordType(hdr[0])
+	vers := uint16(hdr[1])<<8 | uint16(hdr[2])
+	n := int(hdr[3])<<8 | int(hdr[4])
+	// the record version is a legacy field, it only has to look like TLS
+	if hdr[1] != 3 {
+		c.sendAlert(alertProtocolVersion)
+		return c.in.setErrorLocked(fmt.Errorf("tls13: received record with version %x", vers))
+	}
+	if n > maxCiphertextTLS13 {
+		c.sendAlert(alertRecordOverflow)
+		return c.in.setErrorLocked(fmt.Errorf("tls13: oversized record received with length %d", n))
+	}
+	if err := c.readFromUntil(c.conn, recordHeaderLen+n); err != nil {
+		if e, ok := err.(net.Error); !ok || !e.Temporary() {
+			c.in.setErrorLocked(err)
+		}
+		return err
+	}
+	record := c.rawInput.Next(recordHeaderLen + n)
+
+	// a ChangeCipherSpec is only sent for middlebox compatibility during
+	// the handshake and is dropped, RFC 8446 section 5
+	if typ == recordTypeChangeCipherSpec {
+		if handshakeComplete || n != 1 || record[recordHeaderLen] != 1 {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		return c.readRecord()
+	}
+
+	data, typ, err := c.in.decrypt(record)
+	if err != nil {
+		if a, ok := err.(alert); ok {
+			return c.in.setErrorLocked(c.sendAlert(a))
+		}
+		return c.in.setErrorLocked(c.sendAlert(alertInternalError))
+	}
+	if len(data) > maxPlaintext {
+		return c.in.setErrorLocked(c.sendAlert(alertRecordOverflow))
+	}
+
+	switch typ {
+	default:
+		return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+
+	case recordTypeAlert:
+		if len(data) != 2 {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		if alert(data[1]) == alertCloseNotify {
+			return c.in.setErrorLocked(io.EOF)
+		}
+		// every other alert is fatal, whatever its level, except
+		// user_canceled which precedes a close_notify
+		if alert(data[1]) == alertUserCanceled {
+			return c.readRecord()
+		}
+		return c.in.setErrorLocked(&net.OpError{Op: "remote error", Err: alert(data[1])})
+
+	case recordTypeApplicationData:
+		if !handshakeComplete || c.in.cipher == nil {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		// empty records are allowed and skipped
+		if len(data) == 0 {
+			return c.readRecord()
+		}
+		c.input.Reset(data)
+
+	case recordTypeHandshake:
+		if len(data) == 0 {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		c.hand.Write(data)
+	}
+
+	return nil
+}
+
+// setReadTrafficSecret switches the keys records are read with. Handshake
+// messages must not span a key change.
+func (c *Conn) setReadTrafficSecret(suite *cipherSuite, secret []byte) error {
+	if c.hand.Len() > 0 {
+		return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+	}
+	if err := c.in.setTrafficSecret(suite, secret); err != nil {
+		c.sendAlert(alertInternalError)
+		return err
+	}
+	return nil
+}
+
+// setWriteTrafficSecret switches the keys records are written with.
+func (c *Conn) setWriteTrafficSecret(suite *cipherSuite, secret []byte) error {
+	c.out.Lock()
+	defer c.out.Unlock()
+	if err := c.out.setTrafficSecret(suite, secret); err != nil {
+		c.sendAlertLocked(alertInternalError)
+		return err
+	}
+	return nil
+}
+
+// sendAlertLocked sends a TLS alert message.
+func (c *Conn) sendAlertLocked(err alert) error {
+	level := byte(alertLevelError)
+	if err == alertCloseNotify || err == alertUserCanceled {
+		level = alertLevelWarning
+	}
+
+	_, writeErr := c.writeRecordLocked(recordTypeAlert, []byte{level, byte(err)})
+	if writeErr == nil {
+		writeErr = c.flush()
+	}
+	if err == alertCloseNotify {
+		// closeNotify is a special case in that it isn't an error.
+		return writeErr
+	}
+
+	return c.out.setErrorLocked(&net.OpError{Op: "local error", Err: err})
+}
+
+// sendAlert sends a TLS alert message.
+func (c *Conn) sendAlert(err alert) error {
+	c.out.Lock()
+	defer c.out.Unlock()
+	return c.sendAlertLocked(err)
+}
+
+// writeRecordLocked writes a record of the given type, fragmenting data as
+// needed.
+func (c *Conn) writeRecordLocked(typ recordType, data []byte) (int, error) {
+	var n int
+	for len(data) > 0 {
+		m := len(data)
+		if m > maxPlaintext {
+			m = maxPlaintext
+		}
+		if err := c.write(c.out.encrypt(typ, data[:m])); err != nil {
+			return n, err
+		}
+		n += m
+		data = data[m:]
+	}
+	return n, nil
+}
+
+// writeRecord writes a record of the given type, it locks c.out.
+func (c *Conn) writeRecord(typ recordType, data []byte) (int, error) {
+	c.out.Lock()
+	defer c.out.Unlock()
+	return c.writeRecordLocked(typ, data)
+}
+
+// writeChangeCipherSpec writes the plaintext ChangeCipherSpec of middlebox
+// compatibility mode, RFC 8446 appendix D.4.
+func (c *Conn) writeChangeCipherSpec() error {
+	record := []byte{byte(recordTypeChangeCipherSpec), versionTLS12 >> 8, versionTLS12 & 0xff, 0, 1, 1}
+	c.out.Lock()
+	defer c.out.Unlock()
+	return c.write(record)
+}
+
+func (c *Conn) write(data []byte) error {
+	if c.buffering {
+		c.sendBuf = append(c.sendBuf, data...)
+		return nil
+	}
+	_, err := c.conn.Write(data)
+	return err
+}
+
+// flush sends the buffered records and stops buffering.
+func (c *Conn) flush() error {
+	c.buffering = false
+	if len(c.sendBuf) == 0 {
+		return nil
+	}
+	_, err := c.conn.Write(c.sendBuf)
+	c.sendBuf = nil
+	return err
+}
+
+// readHandshake reads the next handshake message from the record layer.
+func (c *Conn) readHandshake() (interface{}, error) {
+	for c.hand.Len() < 4 {
+		if err := c.readRecord(); err != nil {
+			return nil, err
+		}
+	}
+
+	data := c.hand.Bytes()
+	n := int(data[1])<<16 | int(data[2])<<8 | int(data[3])
+	if n > maxHandshake {
+		c.sendAlert(alertInternalError)
+		return nil, c.in.setErrorLocked(fmt.Errorf("tls13: handshake message of length %d bytes exceeds maximum of %d bytes", n, maxHandshake))
+	}
+	for c.hand.Len() < 4+n {
+		if err := c.readRecord(); err != nil {
+			return nil, err
+		}
+	}
+	data = append([]byte(nil), c.hand.Next(4+n)...)
+
+	var m handshakeMessage
+	switch data[0] {
+	case typeClientHello:
+		m = new(clientHelloMsg)
+	case typeServerHello:
+		m = new(serverHelloMsg)
+	case typeNewSessionTicket:
+		m = new(newSessionTicketMsg)
+	case typeEncryptedExtensions:
+		m = new(encryptedExtensionsMsg)
+	case typeCertificate:
+		m = new(certificateMsg)
+	case typeCertificateRequest:
+		m = new(certificateRequestMsg)
+	case typeCertificateVerify:
+		m = new(certificateVerifyMsg)
+	case typeFinished:
+		m = new(finishedMsg)
+	case typeKeyUpdate:
+		m = new(keyUpdateMsg)
+	default:
+		return nil, c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+	}
+
+	if !m.unmarshal(data) {
+		return nil, c.in.setErrorLocked(c.sendAlert(alertDecodeError))
+	}
+	return m, nil
+}
+
+func unexpectedMessageError(wanted, got interface{}) error {
+	return fmt.Errorf("tls13: received unexpected handshake message of type %T when waiting for %T", got, wanted)
+}
+
+// handlePostHandshakeMessage processes a handshake message arrived after
+// the handshake is complete. Tickets are dropped, resumption is not
+// supported.
+func (c *Conn) handlePostHandshakeMessage() error {
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+
+	switch msg := msg.(type) {
+	case *newSessionTicketMsg:
+		if !c.isClient {
+			return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		return nil
+	case *keyUpdateMsg:
+		if err := c.setReadTrafficSecret(c.in.suite, nextTrafficSecret(c.in.trafficSecret)); err != nil {
+			return err
+		}
+		if msg.updateRequested {
+			return c.sendKeyUpdate(false)
+		}
+		return nil
+	default:
+		return c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+	}
+}
+
+// sendKeyUpdate sends a KeyUpdate and switches to the next write traffic
+// secret, requestUpdate asks the peer to do the same.
+func (c *Conn) sendKeyUpdate(requestUpdate bool) error {
+	c.out.Lock()
+	defer c.out.Unlock()
+
+	if c.out.err != nil {
+		return c.out.err
+	}
+	msg := &keyUpdateMsg{updateRequested: requestUpdate}
+	if _, err := c.writeRecordLocked(recordTypeHandshake, msg.marshal()); err != nil {
+		return c.out.setErrorLocked(err)
+	}
+	if err := c.out.setTrafficSecret(c.out.suite, nextTrafficSecret(c.out.trafficSecret)); err != nil {
+		return c.out.setErrorLocked(err)
+	}
+	return nil
+}
+
+var errShutdown = errors.New("tls13: protocol is shutdown")
+
+//Write writes data to the connection, running the handshake first if it
+// has not yet been run.
+func (c *Conn) Write(b []byte) (int, error) {
+	if err := c.Handshake(); err != nil {
+		return 0, err
+	}
+
+	c.out.Lock()
+	defer c.out.Unlock()
+
+	if err := c.out.err; err != nil {
+		return 0, err
+	}
+	if c.closeNotifySent {
+		return 0, errShutdown
+	}
+
+	n, err := c.writeRecordLocked(recordTypeApplicationData, b)
+	return n, c.out.setErrorLocked(err)
+}
+
+//Read reads data from the connection, running the handshake first if it
+// has not yet been run.
+func (c *Conn) Read(b []byte) (int, error) {
+	if err := c.Handshake(); err != nil {
+		return 0, err
+	}
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	c.in.Lock()
+	defer c.in.Unlock()
+
+	for c.input.Len() == 0 {
+		if err := c.readRecord(); err != nil {
+			return 0, err
+		}
+		for c.hand.Len() > 0 {
+			if err := c.handlePostHandshakeMessage(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	n, _ := c.input.Read(b)
+	return n, nil
+}
+
+//Close closes the connection, sending a close_notify alert first if the
+// handshake has completed.
+func (c *Conn) Close() error {
+	var alertErr error
+	if c.handshakeComplete() {
+		if err := c.closeNotify(); err != nil {
+			alertErr = fmt.Errorf("tls13: failed to send closeNotify alert (but connection was closed anyway): %w", err)
+		}
+	}
+
+	if err := c.conn.Close(); err != nil {
+		return err
+	}
+	return alertErr
+}
+
+//CloseWrite shuts down the writing side of the connection. It should only be
+// called once the handshake has completed and does not call CloseWrite on
+// the underlying connection.
+func (c *Conn) CloseWrite() error {
+	if !c.handshakeComplete() {
+		return errors.New("tls13: CloseWrite called before handshake complete")
+	}
+	return c.closeNotify()
+}
+
+func (c *Conn) closeNotify() error {
+	c.out.Lock()
+	defer c.out.Unlock()
+
+	if !c.closeNotifySent {
+		// Set a Write Deadline to prevent possibly blocking forever.
+		_ = c.SetWriteDeadline(time.Now().Add(time.Second * 5))
+		c.closeNotifyErr = c.sendAlertLocked(alertCloseNotify)
+		c.closeNotifySent = true
+		// Any subsequent writes will fail.
+		_ = c.SetWriteDeadline(time.Now())
+	}
+	return c.closeNotifyErr
+}
+
+//Handshake runs the client or server handshake protocol if it has not yet
+// been run. Most uses of this package need not call Handshake explicitly,
+// the first Read or Write will call it automatically.
+func (c *Conn) Handshake() error {
+	c.handshakeMutex.Lock()
+	defer c.handshakeMutex.Unlock()
+
+	if err := c.handshakeErr; err != nil {
+		return err
+	}
+	if c.handshakeComplete() {
+		return nil
+	}
+
+	c.in.Lock()
+	defer c.in.Unlock()
+
+	if c.isClient {
+		c.handshakeErr = c.clientHandshake()
+	} else {
+		c.handshakeErr = c.serverHandshake()
+	}
+	if c.handshakeErr == nil {
+		atomic.StoreUint32(&c.handshakeStatus, 1)
+	} else {
+		// the records of an unfinished flight are useless to the peer
+		c.buffering = false
+		c.sendBuf = nil
+	}
+	return c.handshakeErr
+}
+
+func (c *Conn) handshakeComplete() bool {
+	return atomic.LoadUint32(&c.handshakeStatus) == 1
+}
+
+//ConnectionState returns basic TLS details about the connection.
+func (c *Conn) ConnectionState() ConnectionState {
+	c.handshakeMutex.Lock()
+	defer c.handshakeMutex.Unlock()
+
+	var state ConnectionState
+	state.HandshakeComplete = c.handshakeComplete()
+	state.ServerName = c.serverName
+	if state.HandshakeComplete {
+		state.Version = VersionTLS13
+		state.CipherSuite = c.cipherSuite
+		state.PeerCertificates = c.peerCertificates
+		state.VerifiedChains = c.verifiedChains
+	}
+	return state
+}
+
+//LocalAddr returns the local network address.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+//RemoteAddr returns the remote network address.
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+//SetDeadline sets the read and write deadlines associated with the
+// connection. A zero value for t means Read and Write will not time out.
+// After a Write has timed out, the TLS state is corrupt and all future
+// writes will return the same error.
+func (c *Conn) SetDeadline(t time.Time) error {
+	return c.conn.SetDeadline(t)
+}
+
+//SetReadDeadline sets the read deadline on the underlying connection.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+//SetWriteDeadline sets the write deadline on the underlying connection.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
@@ -0,0 +1,427 @@
+package tls13
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"hash"
+	"io"
+	"net"
+)
+
+type clientHandshakeState struct {
+	c                *Conn
+	hello            *clientHelloMsg
+	serverHello      *serverHelloMsg
+	ecdheKey         *gm.SM2PrivateKey
+	suite            *cipherSuite
+	transcript       hash.Hash
+	certReq          *certificateRequestMsg
+	handshakeSecret  []byte
+	clientHSSecret   []byte
+	serverHSSecret   []byte
+	clientAppSecret  []byte
+	serverAppSecret  []byte
+	usingSessionEcho bool // whether middlebox compatibility mode is used
+}
+
+func (c *Conn) clientHandshake() error {
+	if c.config == nil {
+		c.config = new(Config)
+	}
+	if len(c.config.ServerName) == 0 && !c.config.InsecureSkipVerify {
+		return errors.New("tls13: either ServerName or InsecureSkipVerify must be specified in the tls13.Config")
+	}
+	c.serverName = c.config.ServerName
+
+	hs := &clientHandshakeState{c: c, transcript: gm.GetSM3Hasher()}
+	if err := hs.makeClientHello(); err != nil {
+		return err
+	}
+	_, _ = hs.transcript.Write(hs.hello.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, hs.hello.marshal()); err != nil {
+		return err
+	}
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	serverHello, ok := msg.(*serverHelloMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(serverHello, msg)
+	}
+	hs.serverHello = serverHello
+	return hs.handshake()
+}
+
+func (hs *clientHandshakeState) makeClientHello() error {
+	c := hs.c
+	config := c.config
+
+	hello := &clientHelloMsg{
+		vers:                         versionTLS12,
+		random:                       make([]byte, 32),
+		sessionID:                    make([]byte, 32),
+		compressionMethods:           []uint8{compressionNone},
+		serverName:                   hostnameInSNI(config.ServerName),
+		supportedVersions:            []uint16{VersionTLS13},
+		supportedCurves:              []uint16{curveSM2},
+		supportedSignatureAlgorithms: []uint16{signatureSM2WithSM3},
+	}
+	for _, id := range config.cipherSuites() {
+		if cipherSuiteByID(id) != nil {
+			hello.cipherSuites = append(hello.cipherSuites, id)
+		}
+	}
+	if len(hello.cipherSuites) == 0 {
+		return errors.New("tls13: no supported cipher suites")
+	}
+
+	if _, err := io.ReadFull(config.rand(), hello.random); err != nil {
+		return errors.New("tls13: short read from Rand: " + err.Error())
+	}
+	// a legacy session id makes the handshake look like a TLS 1.2
+	// resumption to middleboxes, RFC 8446 appendix D.4
+	if _, err := io.ReadFull(config.rand(), hello.sessionID); err != nil {
+		return errors.New("tls13: short read from Rand: " + err.Error())
+	}
+
+	key, share, err := generateKeyShare(config.rand())
+	if err != nil {
+		return err
+	}
+	hello.keyShares = []keyShare{{group: curveSM2, data: share}}
+	hs.ecdheKey = key
+	hs.hello = hello
+	return nil
+}
+
+// hostnameInSNI converts name into an appropriate hostname for SNI. Literal
+// IP addresses and absolute FQDNs are not permitted as SNI values.
+func hostnameInSNI(name string) string {
+	host := name
+	if len(host) > 0 && host[0] == '[' && host[len(host)-1] == ']' {
+		host = host[1 : len(host)-1]
+	}
+	if net.ParseIP(host) != nil {
+		return ""
+	}
+	for len(name) > 0 && name[len(name)-1] == '.' {
+		name = name[:len(name)-1]
+	}
+	return name
+}
+
+func containsUint16(list []uint16, v uint16) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (hs *clientHandshakeState) handshake() error {
+	c := hs.c
+
+	if err := hs.checkServerHello(); err != nil {
+		return err
+	}
+	_, _ = hs.transcript.Write(hs.serverHello.marshal())
+
+	if err := hs.establishHandshakeKeys(); err != nil {
+		return err
+	}
+	if err := hs.readServerParameters(); err != nil {
+		return err
+	}
+	if err := hs.readServerCertificate(); err != nil {
+		return err
+	}
+	if err := hs.readServerFinished(); err != nil {
+		return err
+	}
+
+	c.buffering = true
+	if hs.usingSessionEcho {
+		if err := c.writeChangeCipherSpec(); err != nil {
+			return err
+		}
+	}
+	if err := hs.sendClientCertificate(); err != nil {
+		return err
+	}
+	if err := hs.sendClientFinished(); err != nil {
+		return err
+	}
+	if err := c.flush(); err != nil {
+		return err
+	}
+
+	if err := c.setReadTrafficSecret(hs.suite, hs.serverAppSecret); err != nil {
+		return err
+	}
+	return c.setWriteTrafficSecret(hs.suite, hs.clientAppSecret)
+}
+
+func (hs *clientHandshakeState) checkServerHello() error {
+	c := hs.c
+	sh := hs.serverHello
+
+	if bytes.Equal(sh.random, helloRetryRequestRandom) {
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tls13: server sent a HelloRetryRequest, which is not supported")
+	}
+	if sh.supportedVersion != VersionTLS13 {
+		c.sendAlert(alertProtocolVersion)
+		return errors.New("tls13: server selected an unsupported protocol version")
+	}
+	if sh.vers != versionTLS12 || sh.compressionMethod != compressionNone {
+		c.sendAlert(alertIllegalParameter)
+		return errors.New("tls13: server sent an incorrect legacy version or compression")
+	}
+	if !bytes.Equal(sh.sessionID, hs.hello.sessionID) {
+		c.sendAlert(alertIllegalParameter)
+		return errors.New("tls13: server did not echo the legacy session ID")
+	}
+	if !containsUint16(hs.hello.cipherSuites, sh.cipherSuite) {
+		c.sendAlert(alertIllegalParameter)
+		return errors.New("tls13: server chose an unconfigured cipher suite")
+	}
+	if sh.serverShare.group != curveSM2 {
+		c.sendAlert(alertIllegalParameter)
+		return errors.New("tls13: server selected an unsupported group")
+	}
+
+	hs.suite = cipherSuiteByID(sh.cipherSuite)
+	c.cipherSuite = hs.suite.id
+	hs.usingSessionEcho = len(hs.hello.sessionID) > 0
+	return nil
+}
+
+func (hs *clientHandshakeState) establishHandshakeKeys() error {
+	c := hs.c
+
+	shared, err := sharedKey(hs.ecdheKey, hs.serverHello.serverShare.data)
+	if err != nil {
+		c.sendAlert(alertIllegalParameter)
+		return err
+	}
+	hs.handshakeSecret = handshakeSecret(shared)
+	hs.clientHSSecret = deriveSecret(hs.handshakeSecret, clientHandshakeTrafficLabel, hs.transcript)
+	hs.serverHSSecret = deriveSecret(hs.handshakeSecret, serverHandshakeTrafficLabel, hs.transcript)
+
+	if err := c.setReadTrafficSecret(hs.suite, hs.serverHSSecret); err != nil {
+		return err
+	}
+	return c.setWriteTrafficSecret(hs.suite, hs.clientHSSecret)
+}
+
+func (hs *clientHandshakeState) readServerParameters() error {
+	c := hs.c
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	encryptedExtensions, ok := msg.(*encryptedExtensionsMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(encryptedExtensions, msg)
+	}
+	_, _ = hs.transcript.Write(encryptedExtensions.marshal())
+	return nil
+}
+
+func (hs *clientHandshakeState) readServerCertificate() error {
+	c := hs.c
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	if certReq, ok := msg.(*certificateRequestMsg); ok {
+		hs.certReq = certReq
+		_, _ = hs.transcript.Write(certReq.marshal())
+		if msg, err = c.readHandshake(); err != nil {
+			return err
+		}
+	}
+
+	certMsg, ok := msg.(*certificateMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(certMsg, msg)
+	}
+	if len(certMsg.certificates) == 0 {
+		c.sendAlert(alertDecodeError)
+		return errors.New("tls13: received empty certificates message")
+	}
+	_, _ = hs.transcript.Write(certMsg.marshal())
+	if err := c.verifyServerCertificate(certMsg.certificates); err != nil {
+		return err
+	}
+
+	msg, err = c.readHandshake()
+	if err != nil {
+		return err
+	}
+	certVerify, ok := msg.(*certificateVerifyMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(certVerify, msg)
+	}
+	if certVerify.signatureAlgorithm != signatureSM2WithSM3 {
+		c.sendAlert(alertIllegalParameter)
+		return errors.New("tls13: certificate used with invalid signature algorithm")
+	}
+	pub := c.peerCertificates[0].PublicKey.(*gm.SM2PublicKey)
+	if err := verifySM2(pub, signedMessage(serverSignatureContext, hs.transcript), certVerify.signature); err != nil {
+		c.sendAlert(alertDecryptError)
+		return errors.New("tls13: invalid signature by the server certificate: " + err.Error())
+	}
+	_, _ = hs.transcript.Write(certVerify.marshal())
+	return nil
+}
+
+func (hs *clientHandshakeState) readServerFinished() error {
+	c := hs.c
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	finished, ok := msg.(*finishedMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(finished, msg)
+	}
+	expected := finishedVerifyData(hs.serverHSSecret, hs.transcript)
+	if !hmac.Equal(expected, finished.verifyData) {
+		c.sendAlert(alertDecryptError)
+		return errors.New("tls13: invalid server finished hash")
+	}
+	_, _ = hs.transcript.Write(finished.marshal())
+
+	// the application secrets cover the transcript up to the server Finished
+	master := masterSecret(hs.handshakeSecret)
+	hs.clientAppSecret = deriveSecret(master, clientApplicationTrafficLabel, hs.transcript)
+	hs.serverAppSecret = deriveSecret(master, serverApplicationTrafficLabel, hs.transcript)
+	return nil
+}
+
+func (hs *clientHandshakeState) sendClientCertificate() error {
+	c := hs.c
+
+	if hs.certReq == nil {
+		return nil
+	}
+
+	// an empty certificate list is sent if there is no certificate that
+	// fits the request
+	cert := c.config.certificate()
+	if !containsUint16(hs.certReq.supportedSignatureAlgorithms, signatureSM2WithSM3) {
+		cert = nil
+	}
+	certMsg := new(certificateMsg)
+	if cert != nil {
+		certMsg.certificates = cert.Certificate
+	}
+	_, _ = hs.transcript.Write(certMsg.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, certMsg.marshal()); err != nil {
+		return err
+	}
+	if cert == nil {
+		return nil
+	}
+
+	sig, err := signSM2(c.config.rand(), cert, signedMessage(clientSignatureContext, hs.transcript))
+	if err != nil {
+		c.sendAlert(alertInternalError)
+		return errors.New("tls13: failed to sign handshake: " + err.Error())
+	}
+	certVerify := &certificateVerifyMsg{signatureAlgorithm: signatureSM2WithSM3, signature: sig}
+	_, _ = hs.transcript.Write(certVerify.marshal())
+	_, err = c.writeRecord(recordTypeHandshake, certVerify.marshal())
+	return err
+}
+
+func (hs *clientHandshakeState) sendClientFinished() error {
+	c := hs.c
+
+	finished := &finishedMsg{verifyData: finishedVerifyData(hs.clientHSSecret, hs.transcript)}
+	_, _ = hs.transcript.Write(finished.marshal())
+	_, err := c.writeRecord(recordTypeHandshake, finished.marshal())
+	return err
+}
+
+// verifyServerCertificate parses and verifies the certificate chain of the
+// server.
+func (c *Conn) verifyServerCertificate(certificates [][]byte) error {
+	certs, err := c.parsePeerCertificates(certificates)
+	if err != nil {
+		return err
+	}
+
+	if !c.config.InsecureSkipVerify {
+		opts := x509.VerifyOptions{
+			Roots:         c.config.RootCAs,
+			CurrentTime:   c.config.time(),
+			DNSName:       c.config.ServerName,
+			Intermediates: x509.NewCertPool(),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+		for _, cert := range certs[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		chains, err := certs[0].Verify(opts)
+		if err != nil {
+			c.sendAlert(alertForVerifyError(err))
+			return err
+		}
+		c.verifiedChains = chains
+	}
+
+	c.peerCertificates = certs
+	return nil
+}
+
+// parsePeerCertificates parses the certificate chain of the peer and checks
+// that the leaf holds an SM2 key that may sign.
+func (c *Conn) parsePeerCertificates(certificates [][]byte) ([]*x509.Certificate, error) {
+	certs := make([]*x509.Certificate, len(certificates))
+	for i, asn1Data := range certificates {
+		cert, err := x509.ParseCertificate(asn1Data)
+		if err != nil {
+			c.sendAlert(alertBadCertificate)
+			return nil, errors.New("tls13: failed to parse certificate from peer: " + err.Error())
+		}
+		certs[i] = cert
+	}
+
+	if _, ok := certs[0].PublicKey.(*gm.SM2PublicKey); !ok {
+		c.sendAlert(alertUnsupportedCertificate)
+		return nil, errors.New("tls13: peer certificate does not contain an SM2 public key")
+	}
+	if certs[0].KeyUsage != 0 && certs[0].KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+		c.sendAlert(alertUnsupportedCertificate)
+		return nil, errors.New("tls13: peer certificate cannot sign")
+	}
+	return certs, nil
+}
+
+func alertForVerifyError(err error) alert {
+	switch e := err.(type) {
+	case x509.UnknownAuthorityError:
+		return alertUnknownCA
+	case x509.CertificateInvalidError:
+		if e.Reason == x509.Expired {
+			return alertCertificateExpired
+		}
+	}
+	return alertBadCertificate
+}
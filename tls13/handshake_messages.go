@@ -0,0 +1,561 @@
+package tls13
+
+// handshakeMessage is a TLS handshake message. marshal returns the message
+// with its 4 byte header, unmarshal expects the same.
+type handshakeMessage interface {
+	marshal() []byte
+	unmarshal([]byte) bool
+}
+
+// marshalHandshake prefixes body with the handshake header of typ.
+func marshalHandshake(typ uint8, body []byte) []byte {
+	x := make([]byte, 4, 4+len(body))
+	x[0] = typ
+	x[1] = byte(len(body) >> 16)
+	x[2] = byte(len(body) >> 8)
+	x[3] = byte(len(body))
+	return append(x, body...)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendUint24(b []byte, v int) []byte {
+	return append(b, byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint8Prefixed(b, v []byte) []byte {
+	return append(append(b, byte(len(v))), v...)
+}
+
+func appendUint16Prefixed(b, v []byte) []byte {
+	return append(appendUint16(b, uint16(len(v))), v...)
+}
+
+func appendUint24Prefixed(b, v []byte) []byte {
+	return append(appendUint24(b, len(v)), v...)
+}
+
+// reader reads length prefixed fields, every method reports false if the
+// input is too short.
+type reader []byte
+
+func (r *reader) uint8(v *uint8) bool {
+	if len(*r) < 1 {
+		return false
+	}
+	*v = (*r)[0]
+	*r = (*r)[1:]
+	return true
+}
+
+func (r *reader) uint16(v *uint16) bool {
+	if len(*r) < 2 {
+		return false
+	}
+	*v = uint16((*r)[0])<<8 | uint16((*r)[1])
+	*r = (*r)[2:]
+	return true
+}
+
+func (r *reader) uint24(v *int) bool {
+	if len(*r) < 3 {
+		return false
+	}
+	*v = int((*r)[0])<<16 | int((*r)[1])<<8 | int((*r)[2])
+	*r = (*r)[3:]
+	return true
+}
+
+func (r *reader) bytes(v *[]byte, n int) bool {
+	if n < 0 || len(*r) < n {
+		return false
+	}
+	*v = (*r)[:n:n]
+	*r = (*r)[n:]
+	return true
+}
+
+func (r *reader) uint8Prefixed(v *[]byte) bool {
+	var n uint8
+	return r.uint8(&n) && r.bytes(v, int(n))
+}
+
+func (r *reader) uint16Prefixed(v *[]byte) bool {
+	var n uint16
+	return r.uint16(&n) && r.bytes(v, int(n))
+}
+
+func (r *reader) uint24Prefixed(v *[]byte) bool {
+	var n int
+	return r.uint24(&n) && r.bytes(v, n)
+}
+
+// handshakeBody checks the handshake header of data against typ and returns the body.
+func handshakeBody(data []byte, typ uint8) (reader, bool) {
+	if len(data) < 4 || data[0] != typ {
+		return nil, false
+	}
+	n := int(data[1])<<16 | int(data[2])<<8 | int(data[3])
+	if len(data)-4 != n {
+		return nil, false
+	}
+	return reader(data[4:]), true
+}
+
+func (r *reader) uint32(v *uint32) bool {
+	if len(*r) < 4 {
+		return false
+	}
+	*v = uint32((*r)[0])<<24 | uint32((*r)[1])<<16 | uint32((*r)[2])<<8 | uint32((*r)[3])
+	*r = (*r)[4:]
+	return true
+}
+
+// uint16List reads a list of uint16 values that fills data.
+func uint16List(data []byte) ([]uint16, bool) {
+	if len(data) == 0 || len(data)%2 != 0 {
+		return nil, false
+	}
+	list := make([]uint16, 0, len(data)/2)
+	for r := reader(data); len(r) > 0; {
+		var v uint16
+		r.uint16(&v)
+		list = append(list, v)
+	}
+	return list, true
+}
+
+func appendUint16List(b []byte, list []uint16) []byte {
+	for _, v := range list {
+		b = appendUint16(b, v)
+	}
+	return b
+}
+
+// parseExtensions splits an extensions block by type, rejecting duplicates.
+func parseExtensions(data []byte) (map[uint16][]byte, bool) {
+	extensions := make(map[uint16][]byte)
+	for r := reader(data); len(r) > 0; {
+		var typ uint16
+		var body []byte
+		if !r.uint16(&typ) || !r.uint16Prefixed(&body) {
+			return nil, false
+		}
+		if _, dup := extensions[typ]; dup {
+			return nil, false
+		}
+		extensions[typ] = body
+	}
+	return extensions, true
+}
+
+func appendExtension(b []byte, typ uint16, body []byte) []byte {
+	return appendUint16Prefixed(appendUint16(b, typ), body)
+}
+
+// keyShare is a KeyShareEntry of RFC 8446 section 4.2.8.
+type keyShare struct {
+	group uint16
+	data  []byte
+}
+
+type clientHelloMsg struct {
+	raw                          []byte
+	vers                         uint16
+	random                       []byte
+	sessionID                    []byte
+	cipherSuites                 []uint16
+	compressionMethods           []uint8
+	serverName                   string
+	supportedVersions            []uint16
+	supportedCurves              []uint16
+	supportedSignatureAlgorithms []uint16
+	keyShares                    []keyShare
+}
+
+func (m *clientHelloMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	body := appendUint16(nil, m.vers)
+	body = append(body, m.random...)
+	body = appendUint8Prefixed(body, m.sessionID)
+	body = appendUint16Prefixed(body, appendUint16List(nil, m.cipherSuites))
+	body = appendUint8Prefixed(body, m.compressionMethods)
+
+	var extensions []byte
+	if len(m.serverName) > 0 {
+		name := appendUint16Prefixed([]byte{0}, []byte(m.serverName))
+		extensions = appendExtension(extensions, extensionServerName, appendUint16Prefixed(nil, name))
+	}
+	if len(m.supportedCurves) > 0 {
+		extensions = appendExtension(extensions, extensionSupportedCurves, appendUint16Prefixed(nil, appendUint16List(nil, m.supportedCurves)))
+	}
+	if len(m.supportedSignatureAlgorithms) > 0 {
+		extensions = appendExtension(extensions, extensionSignatureAlgorithms, appendUint16Prefixed(nil, appendUint16List(nil, m.supportedSignatureAlgorithms)))
+	}
+	if len(m.supportedVersions) > 0 {
+		extensions = appendExtension(extensions, extensionSupportedVersions, appendUint8Prefixed(nil, appendUint16List(nil, m.supportedVersions)))
+	}
+	if len(m.keyShares) > 0 {
+		var shares []byte
+		for _, ks := range m.keyShares {
+			shares = appendUint16Prefixed(appendUint16(shares, ks.group), ks.data)
+		}
+		extensions = appendExtension(extensions, extensionKeyShare, appendUint16Prefixed(nil, shares))
+	}
+	body = appendUint16Prefixed(body, extensions)
+
+	m.raw = marshalHandshake(typeClientHello, body)
+	return m.raw
+}
+
+func (m *clientHelloMsg) unmarshal(data []byte) bool {
+	*m = clientHelloMsg{raw: data}
+	s, ok := handshakeBody(data, typeClientHello)
+	var suites, compressionMethods, extensionsData []byte
+	if !ok || !s.uint16(&m.vers) || !s.bytes(&m.random, 32) ||
+		!s.uint8Prefixed(&m.sessionID) || len(m.sessionID) > 32 ||
+		!s.uint16Prefixed(&suites) || !s.uint8Prefixed(&compressionMethods) {
+		return false
+	}
+	if m.cipherSuites, ok = uint16List(suites); !ok {
+		return false
+	}
+	m.compressionMethods = compressionMethods
+	// a hello without extensions is a TLS 1.2 one
+	if len(s) == 0 {
+		return true
+	}
+	if !s.uint16Prefixed(&extensionsData) || len(s) != 0 {
+		return false
+	}
+	extensions, ok := parseExtensions(extensionsData)
+	if !ok {
+		return false
+	}
+
+	// unknown extensions are ignored
+	for typ, ext := range extensions {
+		r := reader(ext)
+		var list []byte
+		switch typ {
+		case extensionServerName:
+			if !r.uint16Prefixed(&list) || len(r) != 0 {
+				return false
+			}
+			for names := reader(list); len(names) > 0; {
+				var nameType uint8
+				var name []byte
+				if !names.uint8(&nameType) || !names.uint16Prefixed(&name) {
+					return false
+				}
+				if nameType == 0 && len(m.serverName) == 0 {
+					m.serverName = string(name)
+				}
+			}
+		case extensionSupportedCurves:
+			if !r.uint16Prefixed(&list) || len(r) != 0 {
+				return false
+			}
+			if m.supportedCurves, ok = uint16List(list); !ok {
+				return false
+			}
+		case extensionSignatureAlgorithms:
+			if !r.uint16Prefixed(&list) || len(r) != 0 {
+				return false
+			}
+			if m.supportedSignatureAlgorithms, ok = uint16List(list); !ok {
+				return false
+			}
+		case extensionSupportedVersions:
+			if !r.uint8Prefixed(&list) || len(r) != 0 {
+				return false
+			}
+			if m.supportedVersions, ok = uint16List(list); !ok {
+				return false
+			}
+		case extensionKeyShare:
+			if !r.uint16Prefixed(&list) || len(r) != 0 {
+				return false
+			}
+			for shares := reader(list); len(shares) > 0; {
+				var ks keyShare
+				if !shares.uint16(&ks.group) || !shares.uint16Prefixed(&ks.data) || len(ks.data) == 0 {
+					return false
+				}
+				m.keyShares = append(m.keyShares, ks)
+			}
+		}
+	}
+	return true
+}
+
+type serverHelloMsg struct {
+	raw               []byte
+	vers              uint16
+	random            []byte
+	sessionID         []byte
+	cipherSuite       uint16
+	compressionMethod uint8
+	supportedVersion  uint16
+	serverShare       keyShare
+}
+
+func (m *serverHelloMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	body := appendUint16(nil, m.vers)
+	body = append(body, m.random...)
+	body = appendUint8Prefixed(body, m.sessionID)
+	body = appendUint16(body, m.cipherSuite)
+	body = append(body, m.compressionMethod)
+
+	var extensions []byte
+	if m.supportedVersion != 0 {
+		extensions = appendExtension(extensions, extensionSupportedVersions, appendUint16(nil, m.supportedVersion))
+	}
+	if m.serverShare.group != 0 {
+		share := appendUint16Prefixed(appendUint16(nil, m.serverShare.group), m.serverShare.data)
+		extensions = appendExtension(extensions, extensionKeyShare, share)
+	}
+	body = appendUint16Prefixed(body, extensions)
+
+	m.raw = marshalHandshake(typeServerHello, body)
+	return m.raw
+}
+
+func (m *serverHelloMsg) unmarshal(data []byte) bool {
+	*m = serverHelloMsg{raw: data}
+	s, ok := handshakeBody(data, typeServerHello)
+	var extensionsData []byte
+	if !ok || !s.uint16(&m.vers) || !s.bytes(&m.random, 32) ||
+		!s.uint8Prefixed(&m.sessionID) || len(m.sessionID) > 32 ||
+		!s.uint16(&m.cipherSuite) || !s.uint8(&m.compressionMethod) {
+		return false
+	}
+	if len(s) == 0 {
+		return true
+	}
+	if !s.uint16Prefixed(&extensionsData) || len(s) != 0 {
+		return false
+	}
+	extensions, ok := parseExtensions(extensionsData)
+	if !ok {
+		return false
+	}
+	if ext, ok := extensions[extensionSupportedVersions]; ok {
+		r := reader(ext)
+		if !r.uint16(&m.supportedVersion) || len(r) != 0 {
+			return false
+		}
+	}
+	if ext, ok := extensions[extensionKeyShare]; ok {
+		// a HelloRetryRequest carries only the selected group
+		r := reader(ext)
+		if !r.uint16(&m.serverShare.group) {
+			return false
+		}
+		if len(r) > 0 && (!r.uint16Prefixed(&m.serverShare.data) || len(r) != 0) {
+			return false
+		}
+	}
+	return true
+}
+
+type encryptedExtensionsMsg struct {
+	raw []byte
+}
+
+func (m *encryptedExtensionsMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	m.raw = marshalHandshake(typeEncryptedExtensions, appendUint16Prefixed(nil, nil))
+	return m.raw
+}
+
+func (m *encryptedExtensionsMsg) unmarshal(data []byte) bool {
+	*m = encryptedExtensionsMsg{raw: data}
+	s, ok := handshakeBody(data, typeEncryptedExtensions)
+	var extensionsData []byte
+	if !ok || !s.uint16Prefixed(&extensionsData) || len(s) != 0 {
+		return false
+	}
+	// no extension this package sends a request for is expected back
+	_, ok = parseExtensions(extensionsData)
+	return ok
+}
+
+type certificateRequestMsg struct {
+	raw                          []byte
+	supportedSignatureAlgorithms []uint16
+}
+
+func (m *certificateRequestMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	// the certificate_request_context is empty during the handshake
+	body := appendUint8Prefixed(nil, nil)
+	var extensions []byte
+	if len(m.supportedSignatureAlgorithms) > 0 {
+		extensions = appendExtension(extensions, extensionSignatureAlgorithms, appendUint16Prefixed(nil, appendUint16List(nil, m.supportedSignatureAlgorithms)))
+	}
+	body = appendUint16Prefixed(body, extensions)
+	m.raw = marshalHandshake(typeCertificateRequest, body)
+	return m.raw
+}
+
+func (m *certificateRequestMsg) unmarshal(data []byte) bool {
+	*m = certificateRequestMsg{raw: data}
+	s, ok := handshakeBody(data, typeCertificateRequest)
+	var context, extensionsData []byte
+	if !ok || !s.uint8Prefixed(&context) || len(context) != 0 ||
+		!s.uint16Prefixed(&extensionsData) || len(s) != 0 {
+		return false
+	}
+	extensions, ok := parseExtensions(extensionsData)
+	if !ok {
+		return false
+	}
+	if ext, ok := extensions[extensionSignatureAlgorithms]; ok {
+		r := reader(ext)
+		var list []byte
+		if !r.uint16Prefixed(&list) || len(r) != 0 {
+			return false
+		}
+		if m.supportedSignatureAlgorithms, ok = uint16List(list); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type certificateMsg struct {
+	raw          []byte
+	certificates [][]byte
+}
+
+func (m *certificateMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	var entries []byte
+	for _, cert := range m.certificates {
+		entries = appendUint24Prefixed(entries, cert)
+		entries = appendUint16Prefixed(entries, nil)
+	}
+	body := appendUint8Prefixed(nil, nil)
+	body = appendUint24Prefixed(body, entries)
+	m.raw = marshalHandshake(typeCertificate, body)
+	return m.raw
+}
+
+func (m *certificateMsg) unmarshal(data []byte) bool {
+	*m = certificateMsg{raw: data}
+	s, ok := handshakeBody(data, typeCertificate)
+	var context, entries []byte
+	if !ok || !s.uint8Prefixed(&context) || len(context) != 0 ||
+		!s.uint24Prefixed(&entries) || len(s) != 0 {
+		return false
+	}
+	for r := reader(entries); len(r) > 0; {
+		var cert, extensions []byte
+		// the extensions of an entry are ignored
+		if !r.uint24Prefixed(&cert) || len(cert) == 0 || !r.uint16Prefixed(&extensions) {
+			return false
+		}
+		m.certificates = append(m.certificates, cert)
+	}
+	return true
+}
+
+type certificateVerifyMsg struct {
+	raw                []byte
+	signatureAlgorithm uint16
+	signature          []byte
+}
+
+func (m *certificateVerifyMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	body := appendUint16(nil, m.signatureAlgorithm)
+	body = appendUint16Prefixed(body, m.signature)
+	m.raw = marshalHandshake(typeCertificateVerify, body)
+	return m.raw
+}
+
+func (m *certificateVerifyMsg) unmarshal(data []byte) bool {
+	*m = certificateVerifyMsg{raw: data}
+	s, ok := handshakeBody(data, typeCertificateVerify)
+	return ok && s.uint16(&m.signatureAlgorithm) && s.uint16Prefixed(&m.signature) && len(s) == 0
+}
+
+type finishedMsg struct {
+	raw        []byte
+	verifyData []byte
+}
+
+func (m *finishedMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	m.raw = marshalHandshake(typeFinished, m.verifyData)
+	return m.raw
+}
+
+func (m *finishedMsg) unmarshal(data []byte) bool {
+	s, ok := handshakeBody(data, typeFinished)
+	*m = finishedMsg{raw: data, verifyData: s}
+	return ok && len(s) == hashLength
+}
+
+// newSessionTicketMsg is only parsed, resumption is not supported.
+type newSessionTicketMsg struct {
+	raw []byte
+}
+
+func (m *newSessionTicketMsg) marshal() []byte {
+	return m.raw
+}
+
+func (m *newSessionTicketMsg) unmarshal(data []byte) bool {
+	*m = newSessionTicketMsg{raw: data}
+	s, ok := handshakeBody(data, typeNewSessionTicket)
+	var lifetime, ageAdd uint32
+	var nonce, ticket, extensions []byte
+	return ok && s.uint32(&lifetime) && s.uint32(&ageAdd) && s.uint8Prefixed(&nonce) &&
+		s.uint16Prefixed(&ticket) && len(ticket) > 0 && s.uint16Prefixed(&extensions) && len(s) == 0
+}
+
+type keyUpdateMsg struct {
+	raw             []byte
+	updateRequested bool
+}
+
+func (m *keyUpdateMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+	var request byte
+	if m.updateRequested {
+		request = 1
+	}
+	m.raw = marshalHandshake(typeKeyUpdate, []byte{request})
+	return m.raw
+}
+
+func (m *keyUpdateMsg) unmarshal(data []byte) bool {
+	*m = keyUpdateMsg{raw: data}
+	s, ok := handshakeBody(data, typeKeyUpdate)
+	if !ok || len(s) != 1 || s[0] > 1 {
+		return false
+	}
+	m.updateRequested = s[0] == 1
+	return true
+}
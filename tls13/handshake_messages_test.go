@@ -0,0 +1,95 @@
+package tls13
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHandshakeMessagesRoundTrip(t *testing.T) {
+	random := bytes.Repeat([]byte{1}, 32)
+	share := keyShare{group: curveSM2, data: bytes.Repeat([]byte{4}, 65)}
+	tests := []struct {
+		msg   handshakeMessage
+		empty handshakeMessage
+	}{
+		{&clientHelloMsg{
+			vers:                         versionTLS12,
+			random:                       random,
+			sessionID:                    []byte{1, 2, 3},
+			cipherSuites:                 defaultCipherSuites,
+			compressionMethods:           []uint8{compressionNone},
+			serverName:                   "server.hyperchain.cn",
+			supportedVersions:            []uint16{VersionTLS13},
+			supportedCurves:              []uint16{curveSM2, 23},
+			supportedSignatureAlgorithms: []uint16{signatureSM2WithSM3},
+			keyShares:                    []keyShare{share, {group: 23, data: []byte{1}}},
+		}, new(clientHelloMsg)},
+		{&serverHelloMsg{
+			vers:              versionTLS12,
+			random:            random,
+			sessionID:         []byte{1, 2, 3},
+			cipherSuite:       TLS_SM4_CCM_SM3,
+			compressionMethod: compressionNone,
+			supportedVersion:  VersionTLS13,
+			serverShare:       share,
+		}, new(serverHelloMsg)},
+		{&certificateRequestMsg{supportedSignatureAlgorithms: []uint16{signatureSM2WithSM3}}, new(certificateRequestMsg)},
+		{&certificateMsg{certificates: [][]byte{{1, 2}, {3}}}, new(certificateMsg)},
+		{&certificateVerifyMsg{signatureAlgorithm: signatureSM2WithSM3, signature: []byte{1, 2, 3}}, new(certificateVerifyMsg)},
+		{&finishedMsg{verifyData: random}, new(finishedMsg)},
+		{&keyUpdateMsg{updateRequested: true}, new(keyUpdateMsg)},
+	}
+	for _, tt := range tests {
+		data := tt.msg.marshal()
+		assert.True(t, tt.empty.unmarshal(data), "%T", tt.msg)
+		assert.Equal(t, tt.msg, tt.empty, "%T", tt.msg)
+
+		// every truncation is rejected
+		for i := 0; i < len(data); i++ {
+			assert.False(t, tt.empty.unmarshal(data[:i]), "%T truncated to %d", tt.msg, i)
+		}
+	}
+
+	ee := new(encryptedExtensionsMsg)
+	assert.True(t, ee.unmarshal(new(encryptedExtensionsMsg).marshal()))
+	ticket := marshalHandshake(typeNewSessionTicket, []byte{0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 1, 7, 0, 0})
+	assert.True(t, new(newSessionTicketMsg).unmarshal(ticket))
+	assert.False(t, new(keyUpdateMsg).unmarshal(marshalHandshake(typeKeyUpdate, []byte{2})))
+}
+
+func TestClientHelloExtensions(t *testing.T) {
+	hello := &clientHelloMsg{
+		vers:               versionTLS12,
+		random:             make([]byte, 32),
+		sessionID:          []byte{},
+		cipherSuites:       []uint16{TLS_SM4_GCM_SM3},
+		compressionMethods: []uint8{compressionNone},
+	}
+	// the hello without its empty extensions block
+	data := hello.marshal()
+	body := data[4 : len(data)-2]
+	withExtensions := func(extensions []byte) []byte {
+		b := append([]byte{}, body...)
+		return marshalHandshake(typeClientHello, appendUint16Prefixed(b, extensions))
+	}
+	versions := appendUint8Prefixed(nil, appendUint16List(nil, []uint16{VersionTLS13}))
+
+	// an unknown extension is ignored
+	extensions := appendExtension(nil, extensionSupportedVersions, versions)
+	extensions = appendExtension(extensions, 0xff01, nil)
+	m := new(clientHelloMsg)
+	assert.True(t, m.unmarshal(withExtensions(extensions)))
+	assert.Equal(t, []uint16{VersionTLS13}, m.supportedVersions)
+
+	// a duplicated one is not
+	extensions = appendExtension(extensions, extensionSupportedVersions, versions)
+	assert.False(t, m.unmarshal(withExtensions(extensions)))
+
+	// neither is a malformed one
+	assert.False(t, m.unmarshal(withExtensions(appendExtension(nil, extensionKeyShare, []byte{0, 4, 0, 41, 0, 0}))))
+
+	// a hello without extensions is a TLS 1.2 one
+	assert.True(t, m.unmarshal(marshalHandshake(typeClientHello, body)))
+	assert.Nil(t, m.supportedVersions)
+}
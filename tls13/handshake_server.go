@@ -0,0 +1,340 @@
+package tls13
+
+import (
+	"crypto/hmac"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"hash"
+	"io"
+)
+
+type serverHandshakeState struct {
+	c               *Conn
+	clientHello     *clientHelloMsg
+	hello           *serverHelloMsg
+	suite           *cipherSuite
+	cert            *Certificate
+	clientShare     []byte
+	transcript      hash.Hash
+	handshakeSecret []byte
+	clientHSSecret  []byte
+	serverHSSecret  []byte
+	clientAppSecret []byte
+	serverAppSecret []byte
+}
+
+func (c *Conn) serverHandshake() error {
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	clientHello, ok := msg.(*clientHelloMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(clientHello, msg)
+	}
+
+	hs := &serverHandshakeState{
+		c:           c,
+		clientHello: clientHello,
+		transcript:  gm.GetSM3Hasher(),
+	}
+	if err := hs.processClientHello(); err != nil {
+		return err
+	}
+
+	c.buffering = true
+	if err := hs.sendServerParameters(); err != nil {
+		return err
+	}
+	if err := hs.sendServerCertificate(); err != nil {
+		return err
+	}
+	if err := hs.sendServerFinished(); err != nil {
+		return err
+	}
+	if err := c.flush(); err != nil {
+		return err
+	}
+
+	if err := hs.readClientCertificate(); err != nil {
+		return err
+	}
+	if err := hs.readClientFinished(); err != nil {
+		return err
+	}
+	return c.setReadTrafficSecret(hs.suite, hs.clientAppSecret)
+}
+
+func (hs *serverHandshakeState) processClientHello() error {
+	c := hs.c
+	ch := hs.clientHello
+
+	if !containsUint16(ch.supportedVersions, VersionTLS13) {
+		c.sendAlert(alertProtocolVersion)
+		return errors.New("tls13: client does not support TLS 1.3")
+	}
+	if len(ch.compressionMethods) != 1 || ch.compressionMethods[0] != compressionNone {
+		c.sendAlert(alertIllegalParameter)
+		return errors.New("tls13: TLS 1.3 client supports illegal compression methods")
+	}
+
+	if hs.cert = c.config.certificate(); hs.cert == nil {
+		c.sendAlert(alertInternalError)
+		return errors.New("tls13: server has no certificate")
+	}
+
+	// the client's preference wins
+	enabled := c.config.cipherSuites()
+	for _, id := range ch.cipherSuites {
+		if containsUint16(enabled, id) {
+			if hs.suite = cipherSuiteByID(id); hs.suite != nil {
+				break
+			}
+		}
+	}
+	if hs.suite == nil {
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tls13: no cipher suite supported by both client and server")
+	}
+	c.cipherSuite = hs.suite.id
+
+	if !containsUint16(ch.supportedSignatureAlgorithms, signatureSM2WithSM3) {
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tls13: client does not support sm2sig_sm3")
+	}
+
+	for _, ks := range ch.keyShares {
+		if ks.group == curveSM2 {
+			hs.clientShare = ks.data
+			break
+		}
+	}
+	if hs.clientShare == nil {
+		// only a HelloRetryRequest could ask for the share
+		c.sendAlert(alertHandshakeFailure)
+		return errors.New("tls13: client sent no curveSM2 key share, HelloRetryRequest is not supported")
+	}
+
+	c.serverName = ch.serverName
+	return nil
+}
+
+func (hs *serverHandshakeState) sendServerParameters() error {
+	c := hs.c
+
+	key, share, err := generateKeyShare(c.config.rand())
+	if err != nil {
+		c.sendAlert(alertInternalError)
+		return err
+	}
+	shared, err := sharedKey(key, hs.clientShare)
+	if err != nil {
+		c.sendAlert(alertIllegalParameter)
+		return err
+	}
+
+	hs.hello = &serverHelloMsg{
+		vers:              versionTLS12,
+		random:            make([]byte, 32),
+		sessionID:         hs.clientHello.sessionID,
+		cipherSuite:       hs.suite.id,
+		compressionMethod: compressionNone,
+		supportedVersion:  VersionTLS13,
+		serverShare:       keyShare{group: curveSM2, data: share},
+	}
+	if _, err := io.ReadFull(c.config.rand(), hs.hello.random); err != nil {
+		c.sendAlert(alertInternalError)
+		return errors.New("tls13: short read from Rand: " + err.Error())
+	}
+
+	_, _ = hs.transcript.Write(hs.clientHello.marshal())
+	_, _ = hs.transcript.Write(hs.hello.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, hs.hello.marshal()); err != nil {
+		return err
+	}
+	// middlebox compatibility mode, RFC 8446 appendix D.4
+	if len(hs.clientHello.sessionID) > 0 {
+		if err := c.writeChangeCipherSpec(); err != nil {
+			return err
+		}
+	}
+
+	hs.handshakeSecret = handshakeSecret(shared)
+	hs.clientHSSecret = deriveSecret(hs.handshakeSecret, clientHandshakeTrafficLabel, hs.transcript)
+	hs.serverHSSecret = deriveSecret(hs.handshakeSecret, serverHandshakeTrafficLabel, hs.transcript)
+	if err := c.setReadTrafficSecret(hs.suite, hs.clientHSSecret); err != nil {
+		return err
+	}
+	if err := c.setWriteTrafficSecret(hs.suite, hs.serverHSSecret); err != nil {
+		return err
+	}
+
+	encryptedExtensions := new(encryptedExtensionsMsg)
+	_, _ = hs.transcript.Write(encryptedExtensions.marshal())
+	_, err = c.writeRecord(recordTypeHandshake, encryptedExtensions.marshal())
+	return err
+}
+
+func (hs *serverHandshakeState) requestClientCert() bool {
+	return hs.c.config.ClientAuth >= RequestClientCert
+}
+
+func (hs *serverHandshakeState) sendServerCertificate() error {
+	c := hs.c
+
+	if hs.requestClientCert() {
+		certReq := &certificateRequestMsg{supportedSignatureAlgorithms: []uint16{signatureSM2WithSM3}}
+		_, _ = hs.transcript.Write(certReq.marshal())
+		if _, err := c.writeRecord(recordTypeHandshake, certReq.marshal()); err != nil {
+			return err
+		}
+	}
+
+	certMsg := &certificateMsg{certificates: hs.cert.Certificate}
+	_, _ = hs.transcript.Write(certMsg.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, certMsg.marshal()); err != nil {
+		return err
+	}
+
+	sig, err := signSM2(c.config.rand(), hs.cert, signedMessage(serverSignatureContext, hs.transcript))
+	if err != nil {
+		c.sendAlert(alertInternalError)
+		return errors.New("tls13: failed to sign handshake: " + err.Error())
+	}
+	certVerify := &certificateVerifyMsg{signatureAlgorithm: signatureSM2WithSM3, signature: sig}
+	_, _ = hs.transcript.Write(certVerify.marshal())
+	_, err = c.writeRecord(recordTypeHandshake, certVerify.marshal())
+	return err
+}
+
+func (hs *serverHandshakeState) sendServerFinished() error {
+	c := hs.c
+
+	finished := &finishedMsg{verifyData: finishedVerifyData(hs.serverHSSecret, hs.transcript)}
+	_, _ = hs.transcript.Write(finished.marshal())
+	if _, err := c.writeRecord(recordTypeHandshake, finished.marshal()); err != nil {
+		return err
+	}
+
+	// the application secrets cover the transcript up to the server
+	// Finished, the server may write with them right away
+	master := masterSecret(hs.handshakeSecret)
+	hs.clientAppSecret = deriveSecret(master, clientApplicationTrafficLabel, hs.transcript)
+	hs.serverAppSecret = deriveSecret(master, serverApplicationTrafficLabel, hs.transcript)
+	return c.setWriteTrafficSecret(hs.suite, hs.serverAppSecret)
+}
+
+func (hs *serverHandshakeState) readClientCertificate() error {
+	c := hs.c
+
+	if !hs.requestClientCert() {
+		return nil
+	}
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	certMsg, ok := msg.(*certificateMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(certMsg, msg)
+	}
+	_, _ = hs.transcript.Write(certMsg.marshal())
+	if err := c.processCertsFromClient(certMsg.certificates); err != nil {
+		return err
+	}
+	if len(certMsg.certificates) == 0 {
+		return nil
+	}
+
+	msg, err = c.readHandshake()
+	if err != nil {
+		return err
+	}
+	certVerify, ok := msg.(*certificateVerifyMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(certVerify, msg)
+	}
+	if certVerify.signatureAlgorithm != signatureSM2WithSM3 {
+		c.sendAlert(alertIllegalParameter)
+		return errors.New("tls13: client certificate used with invalid signature algorithm")
+	}
+	pub := c.peerCertificates[0].PublicKey.(*gm.SM2PublicKey)
+	if err := verifySM2(pub, signedMessage(clientSignatureContext, hs.transcript), certVerify.signature); err != nil {
+		c.sendAlert(alertDecryptError)
+		return errors.New("tls13: invalid signature by the client certificate: " + err.Error())
+	}
+	_, _ = hs.transcript.Write(certVerify.marshal())
+	return nil
+}
+
+func (hs *serverHandshakeState) readClientFinished() error {
+	c := hs.c
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+	finished, ok := msg.(*finishedMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(finished, msg)
+	}
+	expected := finishedVerifyData(hs.clientHSSecret, hs.transcript)
+	if !hmac.Equal(expected, finished.verifyData) {
+		c.sendAlert(alertDecryptError)
+		return errors.New("tls13: invalid client finished hash")
+	}
+	return nil
+}
+
+// processCertsFromClient verifies the client certificate chain according to
+// the ClientAuth policy of the server.
+func (c *Conn) processCertsFromClient(certificates [][]byte) error {
+	if len(certificates) == 0 {
+		if requiresClientCert(c.config.ClientAuth) {
+			c.sendAlert(alertCertificateRequired)
+			return errors.New("tls13: client didn't provide a certificate")
+		}
+		return nil
+	}
+
+	certs, err := c.parsePeerCertificates(certificates)
+	if err != nil {
+		return err
+	}
+
+	if c.config.ClientAuth >= VerifyClientCertIfGiven {
+		opts := x509.VerifyOptions{
+			Roots:         c.config.ClientCAs,
+			CurrentTime:   c.config.time(),
+			Intermediates: x509.NewCertPool(),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		for _, cert := range certs[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		chains, err := certs[0].Verify(opts)
+		if err != nil {
+			c.sendAlert(alertForVerifyError(err))
+			return errors.New("tls13: failed to verify client certificate: " + err.Error())
+		}
+		c.verifiedChains = chains
+	}
+
+	c.peerCertificates = certs
+	return nil
+}
+
+func requiresClientCert(c ClientAuthType) bool {
+	switch c {
+	case RequireAnyClientCert, RequireAndVerifyClientCert:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,187 @@
+package tls13
+
+import (
+	"crypto/hmac"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"hash"
+	"io"
+	"math/big"
+)
+
+const hashLength = 32 // SM3 output length, also the length of every secret
+
+// RFC 8446 section 7.1 labels.
+const (
+	derivedLabel                  = "derived"
+	clientHandshakeTrafficLabel   = "c hs traffic"
+	serverHandshakeTrafficLabel   = "s hs traffic"
+	clientApplicationTrafficLabel = "c ap traffic"
+	serverApplicationTrafficLabel = "s ap traffic"
+	trafficUpdateLabel            = "traffic upd"
+)
+
+// hkdfExtract implements HKDF-Extract of RFC 5869 with HMAC-SM3. A nil salt
+// is a string of zeros.
+func hkdfExtract(secret, salt []byte) []byte {
+	if salt == nil {
+		salt = make([]byte, hashLength)
+	}
+	h := hmac.New(gm.GetSM3Hasher, salt)
+	_, _ = h.Write(secret)
+	return h.Sum(nil)
+}
+
+// hkdfExpand implements HKDF-Expand of RFC 5869 with HMAC-SM3.
+func hkdfExpand(prk, info []byte, length int) []byte {
+	out := make([]byte, 0, length+hashLength)
+	h := hmac.New(gm.GetSM3Hasher, prk)
+	var t []byte
+	for counter := byte(1); len(out) < length; counter++ {
+		h.Reset()
+		_, _ = h.Write(t)
+		_, _ = h.Write(info)
+		_, _ = h.Write([]byte{counter})
+		t = h.Sum(nil)
+		out = append(out, t...)
+	}
+	return out[:length]
+}
+
+// expandLabel implements HKDF-Expand-Label of RFC 8446 section 7.1.
+func expandLabel(secret []byte, label string, context []byte, length int) []byte {
+	hkdfLabel := appendUint16(nil, uint16(length))
+	hkdfLabel = appendUint8Prefixed(hkdfLabel, []byte("tls13 "+label))
+	hkdfLabel = appendUint8Prefixed(hkdfLabel, context)
+	return hkdfExpand(secret, hkdfLabel, length)
+}
+
+// deriveSecret implements Derive-Secret of RFC 8446 section 7.1, a nil
+// transcript is the empty one.
+func deriveSecret(secret []byte, label string, transcript hash.Hash) []byte {
+	if transcript == nil {
+		transcript = gm.GetSM3Hasher()
+	}
+	return expandLabel(secret, label, transcript.Sum(nil), hashLength)
+}
+
+// handshakeSecret returns the handshake secret of the ECDHE shared secret,
+// there is no PSK.
+func handshakeSecret(sharedKey []byte) []byte {
+	earlySecret := hkdfExtract(make([]byte, hashLength), nil)
+	return hkdfExtract(sharedKey, deriveSecret(earlySecret, derivedLabel, nil))
+}
+
+// masterSecret returns the master secret following the handshake secret.
+func masterSecret(handshakeSecret []byte) []byte {
+	return hkdfExtract(make([]byte, hashLength), deriveSecret(handshakeSecret, derivedLabel, nil))
+}
+
+// trafficKey returns the record key and IV of a traffic secret.
+func trafficKey(trafficSecret []byte) (key, iv []byte) {
+	key = expandLabel(trafficSecret, "key", nil, aeadKeyLength)
+	iv = expandLabel(trafficSecret, "iv", nil, aeadNonceLength)
+	return
+}
+
+// nextTrafficSecret returns the traffic secret following a KeyUpdate.
+func nextTrafficSecret(trafficSecret []byte) []byte {
+	return expandLabel(trafficSecret, trafficUpdateLabel, nil, hashLength)
+}
+
+// finishedVerifyData returns the verify_data of a Finished message sent
+// under the given traffic secret.
+func finishedVerifyData(trafficSecret []byte, transcript hash.Hash) []byte {
+	finishedKey := expandLabel(trafficSecret, "finished", nil, hashLength)
+	h := hmac.New(gm.GetSM3Hasher, finishedKey)
+	_, _ = h.Write(transcript.Sum(nil))
+	return h.Sum(nil)
+}
+
+// generateKeyShare returns an ephemeral SM2 key and its uncompressed point
+// for the curveSM2 key share.
+func generateKeyShare(rand io.Reader) (*gm.SM2PrivateKey, []byte, error) {
+	curve := gm.GetSm2Curve()
+	n := curve.Params().N
+	buf := make([]byte, 40)
+	if _, err := io.ReadFull(rand, buf); err != nil {
+		return nil, nil, errors.New("tls13: short read from Rand: " + err.Error())
+	}
+	// a scalar in [1, n-1]
+	k := new(big.Int).SetBytes(buf)
+	k.Mod(k, new(big.Int).Sub(n, big.NewInt(1)))
+	k.Add(k, big.NewInt(1))
+
+	key := new(gm.SM2PrivateKey)
+	key.PublicKey.Curve = curve
+	k.FillBytes(key.K[:])
+	key.CalculatePublicKey()
+	point, err := key.PublicKey.Bytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, point, nil
+}
+
+// sharedKey returns the x-coordinate of the ECDHE result of key and the
+// peer's key share, RFC 8446 section 7.4.2.
+func sharedKey(key *gm.SM2PrivateKey, peerShare []byte) ([]byte, error) {
+	curve := gm.GetSm2Curve()
+	if len(peerShare) != 65 || peerShare[0] != 4 {
+		return nil, errors.New("tls13: invalid SM2 key share")
+	}
+	x, y := new(big.Int).SetBytes(peerShare[1:33]), new(big.Int).SetBytes(peerShare[33:])
+	if !curve.IsOnCurve(x, y) {
+		return nil, errors.New("tls13: SM2 key share is not on the curve")
+	}
+	x, y = curve.ScalarMult(x, y, key.K[:])
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, errors.New("tls13: SM2 key share is the point at infinity")
+	}
+	return x.FillBytes(make([]byte, 32)), nil
+}
+
+// signedMessage returns the content covered by a CertificateVerify,
+// RFC 8446 section 4.4.3.
+func signedMessage(context string, transcript hash.Hash) []byte {
+	msg := make([]byte, 64, 64+len(context)+1+hashLength)
+	for i := range msg {
+		msg[i] = 0x20
+	}
+	msg = append(msg, context...)
+	msg = append(msg, 0)
+	return append(msg, transcript.Sum(nil)...)
+}
+
+const (
+	serverSignatureContext = "TLS 1.3, server CertificateVerify"
+	clientSignatureContext = "TLS 1.3, client CertificateVerify"
+)
+
+// signSM2 signs msg with sm2sig_sm3, whose user id is sm2SignatureID.
+func signSM2(rand io.Reader, cert *Certificate, msg []byte) ([]byte, error) {
+	leaf, err := cert.leaf()
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := leaf.PublicKey.(*gm.SM2PublicKey)
+	if !ok {
+		return nil, errors.New("tls13: certificate does not contain an SM2 public key")
+	}
+	digest, err := gm.HashBeforeSM2WithID(pub, sm2SignatureID, msg)
+	if err != nil {
+		return nil, err
+	}
+	return cert.PrivateKey.Sign(nil, digest, rand)
+}
+
+func verifySM2(pub *gm.SM2PublicKey, msg, sig []byte) error {
+	digest, err := gm.HashBeforeSM2WithID(pub, sm2SignatureID, msg)
+	if err != nil {
+		return err
+	}
+	if valid, err := pub.Verify(nil, sig, digest); !valid || err != nil {
+		return errors.New("tls13: SM2 verification failure")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package tls13
+
+import (
+	"crypto/rand"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSharedKey(t *testing.T) {
+	a, shareA, err := generateKeyShare(rand.Reader)
+	assert.Nil(t, err)
+	b, shareB, err := generateKeyShare(rand.Reader)
+	assert.Nil(t, err)
+
+	ka, err := sharedKey(a, shareB)
+	assert.Nil(t, err)
+	kb, err := sharedKey(b, shareA)
+	assert.Nil(t, err)
+	assert.Len(t, ka, 32)
+	assert.Equal(t, ka, kb)
+
+	shareB[64] ^= 1
+	_, err = sharedKey(a, shareB)
+	assert.NotNil(t, err)
+	_, err = sharedKey(a, shareB[:64])
+	assert.NotNil(t, err)
+}
+
+func TestExpandLabel(t *testing.T) {
+	secret := make([]byte, hashLength)
+	key, iv := trafficKey(secret)
+	assert.Len(t, key, aeadKeyLength)
+	assert.Len(t, iv, aeadNonceLength)
+	// the label is part of the output
+	assert.NotEqual(t, key, expandLabel(secret, "iv", nil, aeadKeyLength))
+	// longer outputs extend shorter ones
+	assert.Equal(t, hkdfExpand(secret, []byte("info"), 20), hkdfExpand(secret, []byte("info"), 70)[:20])
+	assert.NotEqual(t, secret, nextTrafficSecret(secret))
+}
+
+func TestSignatureID(t *testing.T) {
+	msg := signedMessage(serverSignatureContext, gm.GetSM3Hasher())
+	assert.Len(t, msg, 64+len(serverSignatureContext)+1+hashLength)
+
+	sig, err := signSM2(rand.Reader, &pki.server, msg)
+	assert.Nil(t, err)
+	pub := &pki.server.PrivateKey.PublicKey
+	assert.Nil(t, verifySM2(pub, msg, sig))
+	assert.NotNil(t, verifySM2(pub, msg[1:], sig))
+
+	// sm2sig_sm3 does not use the default user id
+	valid, _ := pub.Verify(nil, sig, gm.HashBeforeSM2(pub, msg))
+	assert.False(t, valid)
+}
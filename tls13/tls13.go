@@ -0,0 +1,145 @@
+//Package tls13 implements TLS 1.3 with the ShangMi cipher suites of
+// RFC 8998: TLS_SM4_GCM_SM3 and TLS_SM4_CCM_SM3, the curveSM2 key share and
+// the sm2sig_sm3 signature scheme. Only full handshakes are supported, there
+// is no PSK, 0-RTT or HelloRetryRequest.
+package tls13
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"time"
+)
+
+//Server returns a new TLS 1.3 server side connection using conn as the
+// underlying transport. The configuration config must be non-nil and must
+// include a certificate.
+func Server(conn net.Conn, config *Config) *Conn {
+	return &Conn{
+		conn:   conn,
+		config: config,
+	}
+}
+
+//Client returns a new TLS 1.3 client side connection using conn as the
+// underlying transport. The config cannot be nil: users must set either
+// ServerName or InsecureSkipVerify in the config.
+func Client(conn net.Conn, config *Config) *Conn {
+	return &Conn{
+		conn:     conn,
+		config:   config,
+		isClient: true,
+	}
+}
+
+// A listener implements a network listener (net.Listener) for TLS 1.3
+// connections.
+type listener struct {
+	net.Listener
+	config *Config
+}
+
+//Accept waits for and returns the next incoming TLS 1.3 connection. The
+// returned connection is of type *Conn.
+func (l *listener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return Server(c, l.config), nil
+}
+
+//NewListener creates a Listener which accepts connections from an inner
+// Listener and wraps each connection with Server.
+func NewListener(inner net.Listener, config *Config) net.Listener {
+	return &listener{
+		Listener: inner,
+		config:   config,
+	}
+}
+
+//Listen creates a TLS 1.3 listener accepting connections on the given network
+// address using net.Listen.
+func Listen(network, laddr string, config *Config) (net.Listener, error) {
+	if config == nil {
+		return nil, errors.New("tls13: the Config must not be nil")
+	}
+	if config.certificate() == nil {
+		return nil, errors.New("tls13: the Config must hold a certificate")
+	}
+	l, err := net.Listen(network, laddr)
+	if err != nil {
+		return nil, err
+	}
+	return NewListener(l, config), nil
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "tls13: DialWithDialer timed out" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+//DialWithDialer connects to the given network address using dialer.Dial and
+// then initiates a TLS 1.3 handshake, returning the resulting connection. Any
+// timeout or deadline given in the dialer apply to connection and handshake
+// as a whole.
+//
+// If config.ServerName is empty, the host name of addr is used.
+func DialWithDialer(dialer *net.Dialer, network, addr string, config *Config) (*Conn, error) {
+	timeout := dialer.Timeout
+	if !dialer.Deadline.IsZero() {
+		deadlineTimeout := time.Until(dialer.Deadline)
+		if timeout == 0 || deadlineTimeout < timeout {
+			timeout = deadlineTimeout
+		}
+	}
+
+	var errChannel chan error
+	if timeout != 0 {
+		errChannel = make(chan error, 2)
+		timer := time.AfterFunc(timeout, func() {
+			errChannel <- timeoutError{}
+		})
+		defer timer.Stop()
+	}
+
+	rawConn, err := dialer.Dial(network, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil {
+		config = new(Config)
+	}
+	if config.ServerName == "" {
+		colonPos := strings.LastIndex(addr, ":")
+		if colonPos == -1 {
+			colonPos = len(addr)
+		}
+		c := *config
+		c.ServerName = addr[:colonPos]
+		config = &c
+	}
+
+	conn := Client(rawConn, config)
+	if timeout == 0 {
+		err = conn.Handshake()
+	} else {
+		go func() {
+			errChannel <- conn.Handshake()
+		}()
+		err = <-errChannel
+	}
+	if err != nil {
+		rawConn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+//Dial connects to the given network address using net.Dial and then
+// initiates a TLS 1.3 handshake, returning the resulting connection.
+func Dial(network, addr string, config *Config) (*Conn, error) {
+	return DialWithDialer(new(net.Dialer), network, addr, config)
+}
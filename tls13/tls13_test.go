@@ -0,0 +1,361 @@
+package tls13
+
+import (
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/meshplus/crypto-gm/x509"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+type testPKI struct {
+	roots  *x509.CertPool
+	server Certificate
+	client Certificate
+	caKey  *gm.SM2PrivateKey
+	ca     *x509.Certificate
+	serial int64
+}
+
+var pki = newTestPKI()
+
+func newTestPKI() *testPKI {
+	p := new(testPKI)
+	p.caKey, _ = gm.GenerateSM2Key()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "TLS 1.3 Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, p.caKey.Public(), p.caKey)
+	if err != nil {
+		panic(err)
+	}
+	p.ca, _ = x509.ParseCertificate(der)
+	p.roots = x509.NewCertPool()
+	p.roots.AddCert(p.ca)
+	p.serial = 1
+
+	p.server = p.issue("server.hyperchain.cn")
+	p.client = p.issue("client")
+	return p
+}
+
+func (p *testPKI) issue(name string) Certificate {
+	key, _ := gm.GenerateSM2Key()
+	p.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(p.serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{name},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, p.ca, key.Public(), p.caKey)
+	if err != nil {
+		panic(err)
+	}
+	return Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func (p *testPKI) serverConfig() *Config {
+	return &Config{
+		Certificates: []Certificate{p.server},
+		ClientCAs:    p.roots,
+	}
+}
+
+func (p *testPKI) clientConfig() *Config {
+	return &Config{
+		Certificates: []Certificate{p.client},
+		RootCAs:      p.roots,
+		ServerName:   "server.hyperchain.cn",
+	}
+}
+
+// localPipe returns the two ends of a loopback TCP connection. Unlike
+// net.Pipe it is buffered, so an alert or a KeyUpdate does not block while
+// the peer is still writing.
+func localPipe(t *testing.T) (net.Conn, net.Conn) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	accepted := make(chan net.Conn)
+	go func() {
+		c, _ := l.Accept()
+		accepted <- c
+	}()
+	c, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := <-accepted
+	if s == nil {
+		t.Fatal("failed to accept the loopback connection")
+	}
+	return c, s
+}
+
+// runHandshake connects a client and a server, sends a message each way and
+// returns the connection states.
+func runHandshake(t *testing.T, clientConfig, serverConfig *Config) (clientState, serverState ConnectionState, clientErr, serverErr error) {
+	c, s := localPipe(t)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		server := Server(s, serverConfig)
+		defer server.Close()
+		if serverErr = server.Handshake(); serverErr != nil {
+			return
+		}
+		serverState = server.ConnectionState()
+		buf := make([]byte, 5)
+		if _, serverErr = io.ReadFull(server, buf); serverErr != nil {
+			return
+		}
+		_, serverErr = server.Write(append([]byte("echo "), buf...))
+		// wait for the close_notify of the client
+		_, _ = io.Copy(ioutil.Discard, server)
+	}()
+
+	// a client learns that the server rejected its certificate on the first
+	// read after the handshake
+	client := Client(c, clientConfig)
+	if clientErr = client.Handshake(); clientErr == nil {
+		clientState = client.ConnectionState()
+		_, clientErr = client.Write([]byte("hello"))
+		assert.Nil(t, clientErr)
+		buf := make([]byte, 10)
+		if _, clientErr = io.ReadFull(client, buf); clientErr == nil {
+			assert.Equal(t, "echo hello", string(buf))
+		}
+	}
+	client.Close()
+	<-done
+	return
+}
+
+func TestHandshake(t *testing.T) {
+	for _, suite := range []uint16{TLS_SM4_GCM_SM3, TLS_SM4_CCM_SM3} {
+		t.Run(CipherSuiteName(suite), func(t *testing.T) {
+			clientConfig := pki.clientConfig()
+			clientConfig.CipherSuites = []uint16{suite}
+			serverConfig := pki.serverConfig()
+			serverConfig.ClientAuth = RequireAndVerifyClientCert
+
+			clientState, serverState, clientErr, serverErr := runHandshake(t, clientConfig, serverConfig)
+			assert.Nil(t, clientErr)
+			assert.Nil(t, serverErr)
+			assert.Equal(t, suite, clientState.CipherSuite)
+			assert.Equal(t, suite, serverState.CipherSuite)
+			assert.Equal(t, uint16(VersionTLS13), clientState.Version)
+			assert.Equal(t, "server.hyperchain.cn", serverState.ServerName)
+			assert.Len(t, clientState.PeerCertificates, 1)
+			assert.Len(t, clientState.VerifiedChains, 1)
+			assert.Equal(t, "client", serverState.PeerCertificates[0].Subject.CommonName)
+			assert.Len(t, serverState.VerifiedChains, 1)
+		})
+	}
+}
+
+func TestHandshakeWithoutClientCertificate(t *testing.T) {
+	clientConfig := pki.clientConfig()
+	clientConfig.Certificates = nil
+	clientState, serverState, clientErr, serverErr := runHandshake(t, clientConfig, pki.serverConfig())
+	assert.Nil(t, clientErr)
+	assert.Nil(t, serverErr)
+	assert.Equal(t, TLS_SM4_GCM_SM3, clientState.CipherSuite)
+	assert.Len(t, serverState.PeerCertificates, 0)
+
+	// requested but not required
+	serverConfig := pki.serverConfig()
+	serverConfig.ClientAuth = VerifyClientCertIfGiven
+	_, serverState, clientErr, serverErr = runHandshake(t, clientConfig, serverConfig)
+	assert.Nil(t, clientErr)
+	assert.Nil(t, serverErr)
+	assert.Len(t, serverState.PeerCertificates, 0)
+
+	// the server requires one, the client learns it after its Finished
+	serverConfig.ClientAuth = RequireAnyClientCert
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, serverConfig)
+	assert.Equal(t, "remote error: tls13: certificate required", clientErr.Error())
+	assert.NotNil(t, serverErr)
+}
+
+func TestHandshakeVerifyFailure(t *testing.T) {
+	clientConfig := pki.clientConfig()
+	clientConfig.ServerName = "other.hyperchain.cn"
+	_, _, clientErr, serverErr := runHandshake(t, clientConfig, pki.serverConfig())
+	assert.IsType(t, x509.HostnameError{}, clientErr)
+	assert.NotNil(t, serverErr)
+
+	other := newTestPKI()
+	clientConfig = pki.clientConfig()
+	clientConfig.RootCAs = other.roots
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, pki.serverConfig())
+	assert.IsType(t, x509.UnknownAuthorityError{}, clientErr)
+	assert.NotNil(t, serverErr)
+
+	clientConfig.InsecureSkipVerify = true
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, pki.serverConfig())
+	assert.Nil(t, clientErr)
+	assert.Nil(t, serverErr)
+
+	// a client certificate from another CA
+	clientConfig = pki.clientConfig()
+	clientConfig.Certificates = []Certificate{other.client}
+	serverConfig := pki.serverConfig()
+	serverConfig.ClientAuth = RequireAndVerifyClientCert
+	_, _, clientErr, serverErr = runHandshake(t, clientConfig, serverConfig)
+	assert.NotNil(t, clientErr)
+	assert.NotNil(t, serverErr)
+
+	// a server key that does not match its certificate
+	serverConfig = pki.serverConfig()
+	serverConfig.Certificates = []Certificate{{Certificate: pki.server.Certificate, PrivateKey: other.server.PrivateKey}}
+	_, _, clientErr, serverErr = runHandshake(t, pki.clientConfig(), serverConfig)
+	assert.NotNil(t, clientErr)
+	assert.NotNil(t, serverErr)
+}
+
+func TestNoMutualCipherSuite(t *testing.T) {
+	clientConfig := pki.clientConfig()
+	clientConfig.CipherSuites = []uint16{TLS_SM4_CCM_SM3}
+	serverConfig := pki.serverConfig()
+	serverConfig.CipherSuites = []uint16{TLS_SM4_GCM_SM3}
+	_, _, clientErr, serverErr := runHandshake(t, clientConfig, serverConfig)
+	assert.NotNil(t, clientErr)
+	assert.NotNil(t, serverErr)
+}
+
+func TestServerRejectsLegacyClientHello(t *testing.T) {
+	c, s := net.Pipe()
+	done := make(chan error)
+	go func() {
+		server := Server(s, pki.serverConfig())
+		done <- server.Handshake()
+		server.Close()
+	}()
+
+	// a TLS 1.2 hello, without supported_versions
+	hello := &clientHelloMsg{
+		vers:               versionTLS12,
+		random:             make([]byte, 32),
+		cipherSuites:       defaultCipherSuites,
+		compressionMethods: []uint8{compressionNone},
+	}
+	client := Client(c, pki.clientConfig())
+	_, err := client.writeRecord(recordTypeHandshake, hello.marshal())
+	assert.Nil(t, err)
+	err = client.readRecord()
+	assert.Equal(t, "remote error: tls13: protocol version not supported", err.Error())
+	assert.NotNil(t, <-done)
+	c.Close()
+}
+
+func TestKeyUpdate(t *testing.T) {
+	c, s := localPipe(t)
+	done := make(chan error)
+	go func() {
+		server := Server(s, pki.serverConfig())
+		defer server.Close()
+		_, err := io.Copy(server, server)
+		done <- err
+	}()
+
+	client := Client(c, pki.clientConfig())
+	for i := 0; i < 3; i++ {
+		// the server answers the request with its own KeyUpdate
+		assert.Nil(t, client.Handshake())
+		assert.Nil(t, client.sendKeyUpdate(i%2 == 0))
+		_, err := client.Write([]byte("hello"))
+		assert.Nil(t, err)
+		buf := make([]byte, 5)
+		_, err = io.ReadFull(client, buf)
+		assert.Nil(t, err)
+		assert.Equal(t, "hello", string(buf))
+	}
+	client.Close()
+	assert.Nil(t, <-done)
+}
+
+func TestListenAndDial(t *testing.T) {
+	l, err := Listen("tcp", "127.0.0.1:0", pki.serverConfig())
+	assert.Nil(t, err)
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(conn, conn)
+	}()
+
+	clientConfig := pki.clientConfig()
+	clientConfig.ServerName = ""
+	_, err = Dial("tcp", l.Addr().String(), clientConfig)
+	// the certificate is for server.hyperchain.cn, not for 127.0.0.1
+	assert.IsType(t, x509.HostnameError{}, err)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(conn, conn)
+	}()
+	conn, err := Dial("tcp", l.Addr().String(), pki.clientConfig())
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	// more than one record
+	msg := make([]byte, 3*maxPlaintext+1)
+	_, _ = rand.Read(msg)
+	go func() {
+		_, _ = conn.Write(msg)
+	}()
+	buf := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, msg, buf)
+
+	_, err = Listen("tcp", "127.0.0.1:0", &Config{})
+	assert.NotNil(t, err)
+}
+
+func TestX509KeyPair(t *testing.T) {
+	cert := pki.server
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	der, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	assert.Nil(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	pair, err := X509KeyPair(certPEM, keyPEM)
+	assert.Nil(t, err)
+	assert.Equal(t, cert.PrivateKey.K, pair.PrivateKey.K)
+	assert.Equal(t, "server.hyperchain.cn", pair.Leaf.Subject.CommonName)
+
+	der, err = x509.MarshalPKCS8PrivateKey(pki.client.PrivateKey)
+	assert.Nil(t, err)
+	_, err = X509KeyPair(certPEM, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	assert.NotNil(t, err)
+}
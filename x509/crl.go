@@ -0,0 +1,133 @@
+package x509
+
+import (
+	"bytes"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"io"
+	"math/big"
+	"time"
+)
+
+var (
+	oidExtensionCRLNumber = asn1.ObjectIdentifier{2, 5, 29, 20}
+	pemCRLPrefix          = []byte("-----BEGIN X509 CRL")
+	pemType               = "X509 CRL"
+)
+
+// certificateList mirrors pkix.CertificateList, but keeps the issuer raw so
+// that it matches the subject of the issuing certificate byte for byte.
+type certificateList struct {
+	TBSCertList        tbsCertificateList
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	SignatureValue     asn1.BitString
+}
+
+type tbsCertificateList struct {
+	Raw                 asn1.RawContent
+	Version             int `asn1:"optional,default:0"`
+	Signature           pkix.AlgorithmIdentifier
+	Issuer              asn1.RawValue
+	ThisUpdate          time.Time
+	NextUpdate          time.Time                 `asn1:"optional"`
+	RevokedCertificates []pkix.RevokedCertificate `asn1:"optional"`
+	Extensions          []pkix.Extension          `asn1:"tag:0,optional,explicit"`
+}
+
+//ParseCRL parses a CRL from the given bytes. It's often the case that PEM
+// encoded CRLs will appear where they should be DER encoded, so this function
+// will transparently handle PEM encoding as long as there isn't any leading
+// garbage.
+func ParseCRL(crlBytes []byte) (*pkix.CertificateList, error) {
+	if bytes.HasPrefix(crlBytes, pemCRLPrefix) {
+		block, _ := pem.Decode(crlBytes)
+		if block != nil && block.Type == pemType {
+			crlBytes = block.Bytes
+		}
+	}
+	return ParseDERCRL(crlBytes)
+}
+
+//ParseDERCRL parses a DER encoded CRL from the given bytes.
+func ParseDERCRL(derBytes []byte) (*pkix.CertificateList, error) {
+	certList := new(pkix.CertificateList)
+	if rest, err := asn1.Unmarshal(derBytes, certList); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("x509: trailing data after CRL")
+	}
+	return certList, nil
+}
+
+//CreateCRL returns a DER encoded CRL, signed by this Certificate, that
+// contains the given list of revoked certificates. number is the CRL number
+// extension, it is omitted when nil.
+func (c *Certificate) CreateCRL(rand io.Reader, priv *gm.SM2PrivateKey, revokedCerts []pkix.RevokedCertificate, number *big.Int, now, expiry time.Time) (crlBytes []byte, err error) {
+	if priv == nil {
+		return nil, errors.New("x509: private key is nil")
+	}
+	if c.KeyUsage != 0 && c.KeyUsage&KeyUsageCRLSign == 0 {
+		return nil, errors.New("x509: issuer must have the crlSign key usage bit set")
+	}
+
+	// Force revocation times to UTC per RFC 5280.
+	revokedCertsUTC := make([]pkix.RevokedCertificate, len(revokedCerts))
+	for i, rc := range revokedCerts {
+		rc.RevocationTime = rc.RevocationTime.UTC()
+		revokedCertsUTC[i] = rc
+	}
+
+	issuer, err := subjectBytes(c)
+	if err != nil {
+		return nil, err
+	}
+
+	tbsCertList := tbsCertificateList{
+		Version:             1,
+		Signature:           sm2SignatureAlgorithm,
+		Issuer:              asn1.RawValue{FullBytes: issuer},
+		ThisUpdate:          now.UTC(),
+		NextUpdate:          expiry.UTC(),
+		RevokedCertificates: revokedCertsUTC,
+	}
+
+	// Authority Key Id
+	if len(c.SubjectKeyID) > 0 {
+		var aki pkix.Extension
+		aki.Id = oidExtensionAuthorityKeyID
+		aki.Value, err = asn1.Marshal(authKeyID{ID: c.SubjectKeyID})
+		if err != nil {
+			return
+		}
+		tbsCertList.Extensions = append(tbsCertList.Extensions, aki)
+	}
+
+	if number != nil {
+		var crlNumber pkix.Extension
+		crlNumber.Id = oidExtensionCRLNumber
+		crlNumber.Value, err = asn1.Marshal(number)
+		if err != nil {
+			return
+		}
+		tbsCertList.Extensions = append(tbsCertList.Extensions, crlNumber)
+	}
+
+	tbsCertListContents, err := asn1.Marshal(tbsCertList)
+	if err != nil {
+		return
+	}
+
+	signature, err := signSM2(rand, priv, nil, tbsCertListContents)
+	if err != nil {
+		return
+	}
+
+	return asn1.Marshal(certificateList{
+		TBSCertList:        tbsCertList,
+		SignatureAlgorithm: sm2SignatureAlgorithm,
+		SignatureValue:     asn1.BitString{Bytes: signature, BitLength: len(signature) * 8},
+	})
+}
@@ -0,0 +1,53 @@
+package x509
+
+import (
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCreateCRL(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	now := time.Now()
+	template := &Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "crl issuer"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		KeyUsage:              KeyUsageCertSign | KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	issuer := createTestCert(t, template, template, key, key)
+
+	revoked := []pkix.RevokedCertificate{
+		{SerialNumber: big.NewInt(10), RevocationTime: now.Add(-time.Minute)},
+		{SerialNumber: big.NewInt(11), RevocationTime: now.Add(-time.Minute)},
+	}
+	der, err := issuer.CreateCRL(rand.Reader, key, revoked, big.NewInt(7), now, now.Add(time.Hour))
+	assert.Nil(t, err)
+
+	crl, err := ParseCRL(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+	assert.Nil(t, err)
+	assert.Nil(t, issuer.CheckCRLSignature(crl))
+	assert.Len(t, crl.TBSCertList.RevokedCertificates, 2)
+	assert.Equal(t, int64(11), crl.TBSCertList.RevokedCertificates[1].SerialNumber.Int64())
+	assert.Equal(t, 1, crl.TBSCertList.Version)
+	assert.Len(t, crl.TBSCertList.Extensions, 2)
+	assert.False(t, crl.HasExpired(now))
+	assert.True(t, crl.HasExpired(now.Add(2*time.Hour)))
+
+	other, _ := gm.GenerateSM2Key()
+	otherIssuer := createTestCert(t, template, template, other, other)
+	assert.NotNil(t, otherIssuer.CheckCRLSignature(crl))
+
+	leaf := readCert(t, "sm2_sign_cert.pem")
+	_, err = leaf.CreateCRL(rand.Reader, key, revoked, nil, now, now.Add(time.Hour))
+	assert.NotNil(t, err)
+}
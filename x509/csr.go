@@ -0,0 +1,278 @@
+package x509
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	gm "github.com/meshplus/crypto-gm"
+	"io"
+	"net"
+	"net/url"
+)
+
+var oidExtensionRequest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 14}
+
+// tbsCertificateRequest is the PKCS #10 CertificationRequestInfo, RFC 2986.
+type tbsCertificateRequest struct {
+	Raw           asn1.RawContent
+	Version       int
+	Subject       asn1.RawValue
+	PublicKey     publicKeyInfo
+	RawAttributes []asn1.RawValue `asn1:"tag:0"`
+}
+
+type certificateRequest struct {
+	Raw                asn1.RawContent
+	TBSCSR             tbsCertificateRequest
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	SignatureValue     asn1.BitString
+}
+
+//Attribute is a PKCS #10 attribute whose values are kept as DER.
+type Attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+//CertificateRequest a PKCS #10, certificate signature request.
+type CertificateRequest struct {
+	Raw                      []byte // Complete ASN.1 DER content (CSR, signature algorithm and signature).
+	RawTBSCertificateRequest []byte // Certificate request info part of raw ASN.1 DER content.
+	RawSubjectPublicKeyInfo  []byte // DER encoded SubjectPublicKeyInfo.
+	RawSubject               []byte // DER encoded Subject.
+
+	Version            int
+	Signature          []byte
+	SignatureAlgorithm SignatureAlgorithm
+
+	PublicKeyAlgorithm PublicKeyAlgorithm
+	PublicKey          interface{}
+
+	Subject pkix.Name
+
+	// UID is the SM2 user id the request is signed and checked with. It is
+	// not part of the encoded request, so it has to be agreed on with the CA;
+	// the default 1234567812345678 is used if it is empty.
+	UID []byte
+
+	// Attributes contains the attributes of the request other than the
+	// extensionRequest, e.g. a challengePassword. They are copied into any
+	// CSR marshaled by CreateCertificateRequest.
+	Attributes []Attribute
+
+	// Extensions contains the requested extensions parsed from the
+	// extensionRequest attribute.
+	Extensions []pkix.Extension
+
+	// ExtraExtensions contains extensions to be copied, raw, into any CSR
+	// marshaled by CreateCertificateRequest. Values override any extensions
+	// that would otherwise be produced based on the other fields.
+	ExtraExtensions []pkix.Extension
+
+	// Requested key usages, see Certificate.
+	KeyUsage           KeyUsage
+	ExtKeyUsage        []ExtKeyUsage
+	UnknownExtKeyUsage []asn1.ObjectIdentifier
+
+	// Requested basic constraints, see Certificate.
+	BasicConstraintsValid bool
+	IsCA                  bool
+	MaxPathLen            int
+	MaxPathLenZero        bool
+
+	// Subject Alternate Name values.
+	DNSNames       []string
+	EmailAddresses []string
+	IPAddresses    []net.IP
+	URIs           []*url.URL
+}
+
+//CreateCertificateRequest creates a new certificate request based on a
+// template and signs it with priv using SM2-with-SM3 and template.UID. The
+// public key of the request is the one of priv.
+func CreateCertificateRequest(rand io.Reader, template *CertificateRequest, priv *gm.SM2PrivateKey) (csr []byte, err error) {
+	if priv == nil {
+		return nil, errors.New("x509: private key is nil")
+	}
+	if template.SignatureAlgorithm != UnknownSignatureAlgorithm && template.SignatureAlgorithm != SM2WithSM3 {
+		return nil, errors.New("x509: cannot sign CertificateRequest with " + template.SignatureAlgorithm.String())
+	}
+
+	publicKeyBytes, publicKeyAlgorithm, err := marshalPublicKey(priv.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	asn1Subject := template.RawSubject
+	if len(asn1Subject) == 0 {
+		asn1Subject, err = asn1.Marshal(template.Subject.ToRDNSequence())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	extensions, err := buildExtensions(&Certificate{
+		KeyUsage:              template.KeyUsage,
+		ExtKeyUsage:           template.ExtKeyUsage,
+		UnknownExtKeyUsage:    template.UnknownExtKeyUsage,
+		BasicConstraintsValid: template.BasicConstraintsValid,
+		IsCA:                  template.IsCA,
+		MaxPathLen:            template.MaxPathLen,
+		MaxPathLenZero:        template.MaxPathLenZero,
+		DNSNames:              template.DNSNames,
+		EmailAddresses:        template.EmailAddresses,
+		IPAddresses:           template.IPAddresses,
+		URIs:                  template.URIs,
+		ExtraExtensions:       template.ExtraExtensions,
+	}, false, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawAttributes []asn1.RawValue
+	for _, attr := range template.Attributes {
+		if attr.Type.Equal(oidExtensionRequest) {
+			return nil, errors.New("x509: extensionRequest must be given as ExtraExtensions, not as an attribute")
+		}
+		der, err := asn1.Marshal(attr)
+		if err != nil {
+			return nil, err
+		}
+		rawAttributes = append(rawAttributes, asn1.RawValue{FullBytes: der})
+	}
+	if len(extensions) > 0 {
+		value, err := asn1.Marshal(extensions)
+		if err != nil {
+			return nil, err
+		}
+		der, err := asn1.Marshal(Attribute{
+			Type:   oidExtensionRequest,
+			Values: []asn1.RawValue{{FullBytes: value}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		rawAttributes = append(rawAttributes, asn1.RawValue{FullBytes: der})
+	}
+
+	tbsCSR := tbsCertificateRequest{
+		Version: 0, // PKCS #10, RFC 2986
+		Subject: asn1.RawValue{FullBytes: asn1Subject},
+		PublicKey: publicKeyInfo{
+			Algorithm: publicKeyAlgorithm,
+			PublicKey: asn1.BitString{
+				Bytes:     publicKeyBytes,
+				BitLength: len(publicKeyBytes) * 8,
+			},
+		},
+		RawAttributes: rawAttributes,
+	}
+
+	tbsCSRContents, err := asn1.Marshal(tbsCSR)
+	if err != nil {
+		return nil, err
+	}
+	tbsCSR.Raw = tbsCSRContents
+
+	signature, err := signSM2(rand, priv, template.UID, tbsCSRContents)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(certificateRequest{
+		TBSCSR:             tbsCSR,
+		SignatureAlgorithm: sm2SignatureAlgorithm,
+		SignatureValue: asn1.BitString{
+			Bytes:     signature,
+			BitLength: len(signature) * 8,
+		},
+	})
+}
+
+//ParseCertificateRequest parses a single certificate request from the given
+// ASN.1 DER data.
+func ParseCertificateRequest(asn1Data []byte) (*CertificateRequest, error) {
+	var csr certificateRequest
+
+	rest, err := asn1.Unmarshal(asn1Data, &csr)
+	if err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, asn1.SyntaxError{Msg: "trailing data"}
+	}
+
+	return parseCertificateRequest(&csr)
+}
+
+func parseCertificateRequest(in *certificateRequest) (*CertificateRequest, error) {
+	out := &CertificateRequest{
+		Raw:                      in.Raw,
+		RawTBSCertificateRequest: in.TBSCSR.Raw,
+		RawSubjectPublicKeyInfo:  in.TBSCSR.PublicKey.Raw,
+		RawSubject:               in.TBSCSR.Subject.FullBytes,
+
+		Signature:          in.SignatureValue.RightAlign(),
+		SignatureAlgorithm: getSignatureAlgorithmFromAI(in.SignatureAlgorithm),
+
+		Version: in.TBSCSR.Version,
+	}
+
+	var err error
+	out.PublicKeyAlgorithm, out.PublicKey, err = parsePublicKey(&in.TBSCSR.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var subject pkix.RDNSequence
+	if rest, err := asn1.Unmarshal(in.TBSCSR.Subject.FullBytes, &subject); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("x509: trailing data after X.509 Subject")
+	}
+	out.Subject.FillFromRDNSequence(&subject)
+
+	for _, rawAttr := range in.TBSCSR.RawAttributes {
+		var attr Attribute
+		if rest, err := asn1.Unmarshal(rawAttr.FullBytes, &attr); err != nil || len(rest) != 0 || len(attr.Values) == 0 {
+			return nil, errors.New("x509: invalid CSR attribute")
+		}
+		if !attr.Type.Equal(oidExtensionRequest) {
+			out.Attributes = append(out.Attributes, attr)
+			continue
+		}
+		var extensions []pkix.Extension
+		if rest, err := asn1.Unmarshal(attr.Values[0].FullBytes, &extensions); err != nil {
+			return nil, err
+		} else if len(rest) != 0 {
+			return nil, errors.New("x509: trailing data after CSR extensionRequest")
+		}
+		out.Extensions = append(out.Extensions, extensions...)
+	}
+
+	// the requested extensions are interpreted as they would be in a certificate
+	var requested Certificate
+	for _, e := range out.Extensions {
+		if _, err := requested.parseExtension(e); err != nil {
+			return nil, err
+		}
+	}
+	out.KeyUsage = requested.KeyUsage
+	out.ExtKeyUsage = requested.ExtKeyUsage
+	out.UnknownExtKeyUsage = requested.UnknownExtKeyUsage
+	out.BasicConstraintsValid = requested.BasicConstraintsValid
+	out.IsCA = requested.IsCA
+	out.MaxPathLen = requested.MaxPathLen
+	out.MaxPathLenZero = requested.MaxPathLenZero
+	out.DNSNames = requested.DNSNames
+	out.EmailAddresses = requested.EmailAddresses
+	out.IPAddresses = requested.IPAddresses
+	out.URIs = requested.URIs
+
+	return out, nil
+}
+
+//CheckSignature reports whether the signature on c is valid. SM2 signatures
+// are checked with c.UID.
+func (c *CertificateRequest) CheckSignature() error {
+	return checkSignatureWithID(c.SignatureAlgorithm, c.RawTBSCertificateRequest, c.Signature, c.PublicKey, c.UID)
+}
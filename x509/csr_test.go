@@ -0,0 +1,98 @@
+package x509
+
+import (
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+)
+
+func TestCreateCertificateRequest(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	template := &CertificateRequest{
+		Subject:     pkix.Name{Country: []string{"CN"}, Organization: []string{"趣链科技"}, CommonName: "node1"},
+		DNSNames:    []string{"node1.hyperchain.cn"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+	}
+	der, err := CreateCertificateRequest(rand.Reader, template, key)
+	assert.Nil(t, err)
+
+	csr, err := ParseCertificateRequest(der)
+	assert.Nil(t, err)
+	assert.Nil(t, csr.CheckSignature())
+	assert.Equal(t, SM2WithSM3, csr.SignatureAlgorithm)
+	assert.Equal(t, SM2, csr.PublicKeyAlgorithm)
+	assert.Equal(t, "node1", csr.Subject.CommonName)
+	assert.Equal(t, []string{"趣链科技"}, csr.Subject.Organization)
+	assert.Equal(t, template.DNSNames, csr.DNSNames)
+	assert.True(t, csr.IPAddresses[0].Equal(template.IPAddresses[0]))
+	assert.Equal(t, key.PublicKey.X, csr.PublicKey.(*gm.SM2PublicKey).X)
+
+	csr.RawTBSCertificateRequest[len(csr.RawTBSCertificateRequest)-1] ^= 1
+	assert.NotNil(t, csr.CheckSignature())
+
+	_, err = CreateCertificateRequest(rand.Reader, &CertificateRequest{SignatureAlgorithm: ECDSAWithSHA256}, key)
+	assert.NotNil(t, err)
+}
+
+func TestCertificateRequestUID(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	template := &CertificateRequest{
+		Subject: pkix.Name{CommonName: "node2"},
+		UID:     []byte("node2@hyperchain.cn"),
+	}
+	der, err := CreateCertificateRequest(rand.Reader, template, key)
+	assert.Nil(t, err)
+
+	csr, err := ParseCertificateRequest(der)
+	assert.Nil(t, err)
+	assert.NotNil(t, csr.CheckSignature())
+	csr.UID = template.UID
+	assert.Nil(t, csr.CheckSignature())
+	csr.UID = []byte("node3@hyperchain.cn")
+	assert.NotNil(t, csr.CheckSignature())
+}
+
+func TestCertificateRequestExtensions(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	oidChallengePassword := asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 7}
+	password, _ := asn1.Marshal("secret")
+	oidCustom := asn1.ObjectIdentifier{1, 2, 3, 4}
+	template := &CertificateRequest{
+		Subject:               pkix.Name{CommonName: "sub ca"},
+		KeyUsage:              KeyUsageCertSign | KeyUsageCRLSign,
+		ExtKeyUsage:           []ExtKeyUsage{ExtKeyUsageServerAuth, ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+		Attributes:            []Attribute{{Type: oidChallengePassword, Values: []asn1.RawValue{{FullBytes: password}}}},
+		ExtraExtensions:       []pkix.Extension{{Id: oidCustom, Value: []byte{0x05, 0x00}}},
+	}
+	der, err := CreateCertificateRequest(rand.Reader, template, key)
+	assert.Nil(t, err)
+
+	csr, err := ParseCertificateRequest(der)
+	assert.Nil(t, err)
+	assert.Nil(t, csr.CheckSignature())
+	assert.Equal(t, template.KeyUsage, csr.KeyUsage)
+	assert.Equal(t, template.ExtKeyUsage, csr.ExtKeyUsage)
+	assert.True(t, csr.BasicConstraintsValid)
+	assert.True(t, csr.IsCA)
+	assert.Equal(t, 0, csr.MaxPathLen)
+	assert.True(t, csr.MaxPathLenZero)
+	assert.Len(t, csr.Extensions, 4)
+	assert.True(t, csr.Extensions[3].Id.Equal(oidCustom))
+	assert.Len(t, csr.Attributes, 1)
+	assert.True(t, csr.Attributes[0].Type.Equal(oidChallengePassword))
+	assert.Equal(t, password, csr.Attributes[0].Values[0].FullBytes)
+
+	template.Attributes = []Attribute{{Type: oidExtensionRequest}}
+	_, err = CreateCertificateRequest(rand.Reader, template, key)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,123 @@
+package x509
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"math/big"
+)
+
+const sm2PrivateKeyVersion = 1
+
+// pkcs8 reflects an ASN.1, PKCS #8 PrivateKey. See
+// ftp://ftp.rsasecurity.com/pub/pkcs/pkcs-8/pkcs-8v1_2.asn
+// and RFC 5208.
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+	// optional attributes omitted.
+}
+
+// sm2PrivateKey reflects an ASN.1 Elliptic Curve Private Key Structure.
+// See RFC 5915 and GM/T 0010.
+type sm2PrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+//MarshalSM2PrivateKey converts an SM2 private key to SEC 1, ASN.1 DER form.
+func MarshalSM2PrivateKey(key *gm.SM2PrivateKey) ([]byte, error) {
+	return marshalSM2PrivateKeyWithOID(key, oidNamedCurveSM2)
+}
+
+func marshalSM2PrivateKeyWithOID(key *gm.SM2PrivateKey, oid asn1.ObjectIdentifier) ([]byte, error) {
+	k, err := key.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	pub, _ := key.Public().(*gm.SM2PublicKey).Bytes()
+	return asn1.Marshal(sm2PrivateKey{
+		Version:       sm2PrivateKeyVersion,
+		PrivateKey:    k,
+		NamedCurveOID: oid,
+		PublicKey:     asn1.BitString{Bytes: pub, BitLength: 8 * len(pub)},
+	})
+}
+
+//ParseSM2PrivateKey parses an SM2 private key in SEC 1, ASN.1 DER form.
+func ParseSM2PrivateKey(der []byte) (*gm.SM2PrivateKey, error) {
+	return parseSM2PrivateKey(nil, der)
+}
+
+// parseSM2PrivateKey parses an ASN.1 Elliptic Curve Private Key Structure.
+// The OID for the named curve may be provided from another source (such as
+// the PKCS8 container) - if it is provided then use this instead of the OID
+// that may exist in the EC private key structure.
+func parseSM2PrivateKey(namedCurveOID *asn1.ObjectIdentifier, der []byte) (*gm.SM2PrivateKey, error) {
+	var privKey sm2PrivateKey
+	if _, err := asn1.Unmarshal(der, &privKey); err != nil {
+		return nil, errors.New("x509: failed to parse SM2 private key: " + err.Error())
+	}
+	if privKey.Version != sm2PrivateKeyVersion {
+		return nil, fmt.Errorf("x509: unknown SM2 private key version %d", privKey.Version)
+	}
+
+	oid := privKey.NamedCurveOID
+	if namedCurveOID != nil {
+		oid = *namedCurveOID
+	}
+	if len(oid) != 0 && !oid.Equal(oidNamedCurveSM2) {
+		return nil, fmt.Errorf("x509: unknown elliptic curve %v", oid)
+	}
+
+	k := new(big.Int).SetBytes(privKey.PrivateKey)
+	curveOrder := gm.GetSm2Curve().Params().N
+	if k.Sign() == 0 || k.Cmp(curveOrder) >= 0 {
+		return nil, errors.New("x509: invalid SM2 private key value")
+	}
+
+	key := new(gm.SM2PrivateKey)
+	if err := key.FromBytes(k.Bytes(), 0); err != nil {
+		return nil, err
+	}
+	key.CalculatePublicKey()
+	return key, nil
+}
+
+//MarshalPKCS8PrivateKey converts an SM2 private key to PKCS #8, ASN.1 DER form.
+func MarshalPKCS8PrivateKey(key *gm.SM2PrivateKey) ([]byte, error) {
+	oidBytes, err := asn1.Marshal(oidNamedCurveSM2)
+	if err != nil {
+		return nil, errors.New("x509: failed to marshal curve OID: " + err.Error())
+	}
+	// the curve is carried by the PKCS #8 algorithm parameters, RFC 5915 omits it here
+	privateKey, err := marshalSM2PrivateKeyWithOID(key, nil)
+	if err != nil {
+		return nil, errors.New("x509: failed to marshal SM2 private key while building PKCS#8: " + err.Error())
+	}
+	return asn1.Marshal(pkcs8{
+		Algo: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: oidBytes},
+		},
+		PrivateKey: privateKey,
+	})
+}
+
+//ParsePKCS8PrivateKey parses an unencrypted SM2 private key in PKCS #8, ASN.1 DER form.
+func ParsePKCS8PrivateKey(der []byte) (*gm.SM2PrivateKey, error) {
+	var privKey pkcs8
+	if _, err := asn1.Unmarshal(der, &privKey); err != nil {
+		return nil, err
+	}
+	algo := privKey.Algo.Algorithm
+	if !algo.Equal(oidNamedCurveSM2) && !(algo.Equal(oidPublicKeyECDSA) && isSM2Params(privKey.Algo.Parameters)) {
+		return nil, fmt.Errorf("x509: PKCS#8 wrapping contained private key with unknown algorithm: %v", algo)
+	}
+	return parseSM2PrivateKey(&oidNamedCurveSM2, privKey.PrivateKey)
+}
@@ -0,0 +1,43 @@
+package x509
+
+import (
+	"encoding/pem"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+func TestParsePKCS8PrivateKey(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/sm2_sign_key.pem")
+	assert.Nil(t, err)
+	block, _ := pem.Decode(data)
+	key, err := ParsePKCS8PrivateKey(block.Bytes)
+	assert.Nil(t, err)
+
+	cert := readCert(t, "sm2_sign_cert.pem")
+	pub := cert.PublicKey.(*gm.SM2PublicKey)
+	assert.Equal(t, pub.X, key.PublicKey.X)
+	assert.Equal(t, pub.Y, key.PublicKey.Y)
+
+	der, err := MarshalPKCS8PrivateKey(key)
+	assert.Nil(t, err)
+	key2, err := ParsePKCS8PrivateKey(der)
+	assert.Nil(t, err)
+	assert.Equal(t, key.K, key2.K)
+}
+
+func TestMarshalSM2PrivateKey(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	der, err := MarshalSM2PrivateKey(key)
+	assert.Nil(t, err)
+	key2, err := ParseSM2PrivateKey(der)
+	assert.Nil(t, err)
+	assert.Equal(t, key.K, key2.K)
+	assert.Equal(t, key.PublicKey.X, key2.PublicKey.X)
+	assert.Equal(t, key.PublicKey.Y, key2.PublicKey.Y)
+
+	_, err = ParsePKCS8PrivateKey(der)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,190 @@
+package x509
+
+import (
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"testing"
+	"time"
+)
+
+var fixtureTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func fixtureRoots(t *testing.T) *CertPool {
+	data, err := ioutil.ReadFile("testdata/sm2_ca.pem")
+	assert.Nil(t, err)
+	roots := NewCertPool()
+	assert.True(t, roots.AppendCertsFromPEM(data))
+	// the RSA root has the same subject as the SM2 one
+	data, err = ioutil.ReadFile("testdata/rsa_ca.pem")
+	assert.Nil(t, err)
+	assert.True(t, roots.AppendCertsFromPEM(data))
+	return roots
+}
+
+func TestVerifyFixtures(t *testing.T) {
+	roots := fixtureRoots(t)
+	for _, name := range []string{"sm2_sign_cert.pem", "sm2_enc_cert.pem", "sm2_auth_cert.pem", "rsa_sign_cert.pem"} {
+		leaf := readCert(t, name)
+		chains, err := leaf.Verify(VerifyOptions{Roots: roots, CurrentTime: fixtureTime})
+		assert.Nil(t, err, name)
+		assert.Len(t, chains, 1, name)
+		assert.Len(t, chains[0], 2, name)
+		assert.Equal(t, leaf.PublicKeyAlgorithm, chains[0][1].PublicKeyAlgorithm, name)
+	}
+
+	leaf := readCert(t, "sm2_sign_cert.pem")
+	_, err := leaf.Verify(VerifyOptions{Roots: roots, CurrentTime: fixtureTime, DNSName: "localhost"})
+	assert.Nil(t, err)
+	_, err = leaf.Verify(VerifyOptions{Roots: roots, CurrentTime: fixtureTime, DNSName: "example.com"})
+	assert.IsType(t, HostnameError{}, err)
+
+	_, err = leaf.Verify(VerifyOptions{Roots: roots, CurrentTime: time.Date(2032, 1, 1, 0, 0, 0, 0, time.UTC)})
+	assert.IsType(t, CertificateInvalidError{}, err)
+	assert.Equal(t, Expired, err.(CertificateInvalidError).Reason)
+
+	_, err = leaf.Verify(VerifyOptions{Roots: NewCertPool(), CurrentTime: fixtureTime})
+	assert.IsType(t, UnknownAuthorityError{}, err)
+
+	_, err = leaf.Verify(VerifyOptions{Roots: roots, CurrentTime: fixtureTime, KeyUsages: []ExtKeyUsage{ExtKeyUsageCodeSigning}})
+	assert.IsType(t, CertificateInvalidError{}, err)
+	assert.Equal(t, IncompatibleUsage, err.(CertificateInvalidError).Reason)
+}
+
+// TestVerifyCFCA verifies a leaf issued by CFCA TEST SM2 OCA1, an SM2
+// intermediate of the public test hierarchy of the China Financial
+// Certification Authority. Its root, CFCA TEST CS SM2 CA, is not a fixture,
+// so the intermediate is the trust anchor.
+func TestVerifyCFCA(t *testing.T) {
+	oca1 := readCert(t, "cfca_sm2_oca1.pem")
+	assert.Equal(t, SM2WithSM3, oca1.SignatureAlgorithm)
+	assert.Equal(t, SM2, oca1.PublicKeyAlgorithm)
+	assert.Equal(t, "CFCA TEST SM2 OCA1", oca1.Subject.CommonName)
+	assert.Equal(t, "CFCA TEST CS SM2 CA", oca1.Issuer.CommonName)
+	assert.Equal(t, []string{"China Financial Certification Authority"}, oca1.Subject.Organization)
+	assert.True(t, oca1.IsCA)
+	assert.Equal(t, KeyUsageCertSign|KeyUsageCRLSign, oca1.KeyUsage)
+	assert.Equal(t, "6bfe18da8f423aa6b86db32e88833a34a2c130e1", hex.EncodeToString(oca1.SubjectKeyID))
+	assert.Equal(t, []string{"http://210.74.42.3/testrca/SM2/crl1.crl"}, oca1.CRLDistributionPoints)
+
+	leaf := readCert(t, "cfca_sm2_leaf.pem")
+	assert.Equal(t, SM2WithSM3, leaf.SignatureAlgorithm)
+	assert.Equal(t, "1040990809", leaf.SerialNumber.Text(16))
+	assert.Equal(t, "051@邮储线上收单商户@N51011300018878@1", leaf.Subject.CommonName)
+	assert.Equal(t, []string{"PSBC", "Organizational-2"}, leaf.Subject.OrganizationalUnit)
+	assert.False(t, leaf.IsCA)
+	assert.Equal(t, KeyUsageDigitalSignature|KeyUsageContentCommitment, leaf.KeyUsage)
+	assert.Equal(t, []ExtKeyUsage{ExtKeyUsageClientAuth, ExtKeyUsageEmailProtection}, leaf.ExtKeyUsage)
+	assert.Equal(t, oca1.SubjectKeyID, leaf.AuthorityKeyID)
+	assert.Nil(t, leaf.CheckSignatureFrom(oca1))
+
+	roots := NewCertPool()
+	roots.AddCert(oca1)
+	valid := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	chains, err := leaf.Verify(VerifyOptions{Roots: roots, CurrentTime: valid, KeyUsages: []ExtKeyUsage{ExtKeyUsageClientAuth}})
+	assert.Nil(t, err)
+	assert.Len(t, chains, 1)
+	assert.Equal(t, []*Certificate{leaf, oca1}, chains[0])
+
+	_, err = leaf.Verify(VerifyOptions{Roots: roots, CurrentTime: valid})
+	assert.IsType(t, CertificateInvalidError{}, err)
+	assert.Equal(t, IncompatibleUsage, err.(CertificateInvalidError).Reason)
+
+	// the leaf expired on 2025-11-19
+	_, err = leaf.Verify(VerifyOptions{Roots: roots, CurrentTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), KeyUsages: []ExtKeyUsage{ExtKeyUsageClientAuth}})
+	assert.IsType(t, CertificateInvalidError{}, err)
+	assert.Equal(t, Expired, err.(CertificateInvalidError).Reason)
+
+	_, err = leaf.Verify(VerifyOptions{Roots: fixtureRoots(t), CurrentTime: valid, KeyUsages: []ExtKeyUsage{ExtKeyUsageClientAuth}})
+	assert.IsType(t, UnknownAuthorityError{}, err)
+}
+
+func TestVerifyIntermediate(t *testing.T) {
+	now := time.Now()
+	rootKey, _ := gm.GenerateSM2Key()
+	interKey, _ := gm.GenerateSM2Key()
+	leafKey, _ := gm.GenerateSM2Key()
+
+	rootTemplate := &Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		KeyUsage:              KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            1,
+	}
+	root := createTestCert(t, rootTemplate, rootTemplate, rootKey, rootKey)
+
+	interTemplate := &Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "intermediate"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		KeyUsage:              KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	inter := createTestCert(t, interTemplate, root, interKey, rootKey)
+
+	leafTemplate := &Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		DNSNames:     []string{"*.hyperchain.cn"},
+	}
+	leaf := createTestCert(t, leafTemplate, inter, leafKey, interKey)
+
+	roots := NewCertPool()
+	roots.AddCert(root)
+	intermediates := NewCertPool()
+	intermediates.AddCert(inter)
+
+	chains, err := leaf.Verify(VerifyOptions{Roots: roots, Intermediates: intermediates, DNSName: "node.hyperchain.cn"})
+	assert.Nil(t, err)
+	assert.Len(t, chains, 1)
+	assert.Equal(t, []*Certificate{leaf, inter, root}, chains[0])
+
+	_, err = leaf.Verify(VerifyOptions{Roots: roots})
+	assert.IsType(t, UnknownAuthorityError{}, err)
+
+	// the intermediate forbids further intermediates
+	subTemplate := &Certificate{
+		SerialNumber:          big.NewInt(4),
+		Subject:               pkix.Name{CommonName: "sub"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	subKey, _ := gm.GenerateSM2Key()
+	sub := createTestCert(t, subTemplate, inter, subKey, interKey)
+	intermediates.AddCert(sub)
+	leaf2 := createTestCert(t, leafTemplate, sub, leafKey, subKey)
+	_, err = leaf2.Verify(VerifyOptions{Roots: roots, Intermediates: intermediates})
+	assert.IsType(t, CertificateInvalidError{}, err)
+	assert.Equal(t, TooManyIntermediates, err.(CertificateInvalidError).Reason)
+}
+
+func createTestCert(t *testing.T, template, parent *Certificate, key, signer *gm.SM2PrivateKey) *Certificate {
+	der, err := CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	assert.Nil(t, err)
+	cert, err := ParseCertificate(der)
+	assert.Nil(t, err)
+	return cert
+}
+
+func TestMatchHostnames(t *testing.T) {
+	assert.True(t, matchHostnames("*.hyperchain.cn", "node.hyperchain.cn"))
+	assert.True(t, matchHostnames("node.hyperchain.cn.", "node.hyperchain.cn"))
+	assert.False(t, matchHostnames("*.hyperchain.cn", "hyperchain.cn"))
+	assert.False(t, matchHostnames("*.hyperchain.cn", "a.node.hyperchain.cn"))
+	assert.False(t, matchHostnames("", "hyperchain.cn"))
+}
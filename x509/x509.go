@@ -0,0 +1,1029 @@
+// Package x509 parses and creates X.509 certificates, certificate signing
+// requests and CRLs signed with SM2-with-SM3 (GM/T 0015).
+package x509
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	gm "github.com/meshplus/crypto-gm"
+	"io"
+	"math/big"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+//SignatureAlgorithm algorithm used to sign a certificate, CSR or CRL
+type SignatureAlgorithm int
+
+//signature algorithms, only SM2WithSM3 can be used to sign, the others can only be verified
+const (
+	UnknownSignatureAlgorithm SignatureAlgorithm = iota
+	SM2WithSM3
+	SHA256WithRSA
+	SHA384WithRSA
+	SHA512WithRSA
+	ECDSAWithSHA256
+	ECDSAWithSHA384
+	ECDSAWithSHA512
+)
+
+var signatureAlgorithmDetails = []struct {
+	algo       SignatureAlgorithm
+	name       string
+	oid        asn1.ObjectIdentifier
+	pubKeyAlgo PublicKeyAlgorithm
+	std        stdx509.SignatureAlgorithm
+}{
+	{SM2WithSM3, "SM2-SM3", oidSignatureSM2WithSM3, SM2, stdx509.UnknownSignatureAlgorithm},
+	{SHA256WithRSA, "SHA256-RSA", oidSignatureSHA256WithRSA, RSA, stdx509.SHA256WithRSA},
+	{SHA384WithRSA, "SHA384-RSA", oidSignatureSHA384WithRSA, RSA, stdx509.SHA384WithRSA},
+	{SHA512WithRSA, "SHA512-RSA", oidSignatureSHA512WithRSA, RSA, stdx509.SHA512WithRSA},
+	{ECDSAWithSHA256, "ECDSA-SHA256", oidSignatureECDSAWithSHA256, ECDSA, stdx509.ECDSAWithSHA256},
+	{ECDSAWithSHA384, "ECDSA-SHA384", oidSignatureECDSAWithSHA384, ECDSA, stdx509.ECDSAWithSHA384},
+	{ECDSAWithSHA512, "ECDSA-SHA512", oidSignatureECDSAWithSHA512, ECDSA, stdx509.ECDSAWithSHA512},
+}
+
+func (algo SignatureAlgorithm) String() string {
+	for _, details := range signatureAlgorithmDetails {
+		if details.algo == algo {
+			return details.name
+		}
+	}
+	return strconv.Itoa(int(algo))
+}
+
+//PublicKeyAlgorithm algorithm of the public key in a certificate
+type PublicKeyAlgorithm int
+
+//public key algorithms
+const (
+	UnknownPublicKeyAlgorithm PublicKeyAlgorithm = iota
+	SM2
+	RSA
+	ECDSA
+	Ed25519
+)
+
+var publicKeyAlgoName = [...]string{
+	SM2:     "SM2",
+	RSA:     "RSA",
+	ECDSA:   "ECDSA",
+	Ed25519: "Ed25519",
+}
+
+func (algo PublicKeyAlgorithm) String() string {
+	if 0 < algo && int(algo) < len(publicKeyAlgoName) {
+		return publicKeyAlgoName[algo]
+	}
+	return strconv.Itoa(int(algo))
+}
+
+//GM/T 0006-2012 and RFC 5758
+var (
+	oidSignatureSM2WithSM3      = asn1.ObjectIdentifier{1, 2, 156, 10197, 1, 501}
+	oidSignatureSHA256WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+	oidSignatureSHA384WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 12}
+	oidSignatureSHA512WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 13}
+	oidSignatureECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidSignatureECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidSignatureECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveSM2  = asn1.ObjectIdentifier{1, 2, 156, 10197, 1, 301}
+
+	oidExtensionSubjectKeyID          = asn1.ObjectIdentifier{2, 5, 29, 14}
+	oidExtensionKeyUsage              = asn1.ObjectIdentifier{2, 5, 29, 15}
+	oidExtensionSubjectAltName        = asn1.ObjectIdentifier{2, 5, 29, 17}
+	oidExtensionBasicConstraints      = asn1.ObjectIdentifier{2, 5, 29, 19}
+	oidExtensionCRLDistributionPoints = asn1.ObjectIdentifier{2, 5, 29, 31}
+	oidExtensionAuthorityKeyID        = asn1.ObjectIdentifier{2, 5, 29, 35}
+	oidExtensionExtendedKeyUsage      = asn1.ObjectIdentifier{2, 5, 29, 37}
+)
+
+func getSignatureAlgorithmFromAI(ai pkix.AlgorithmIdentifier) SignatureAlgorithm {
+	for _, details := range signatureAlgorithmDetails {
+		if ai.Algorithm.Equal(details.oid) {
+			return details.algo
+		}
+	}
+	return UnknownSignatureAlgorithm
+}
+
+//KeyUsage set of actions that are valid for a given key
+type KeyUsage int
+
+//key usages, see RFC 5280, section 4.2.1.3
+const (
+	KeyUsageDigitalSignature KeyUsage = 1 << iota
+	KeyUsageContentCommitment
+	KeyUsageKeyEncipherment
+	KeyUsageDataEncipherment
+	KeyUsageKeyAgreement
+	KeyUsageCertSign
+	KeyUsageCRLSign
+	KeyUsageEncipherOnly
+	KeyUsageDecipherOnly
+)
+
+//ExtKeyUsage extended set of actions that are valid for a given key
+type ExtKeyUsage int
+
+//extended key usages, see RFC 5280, section 4.2.1.12
+const (
+	ExtKeyUsageAny ExtKeyUsage = iota
+	ExtKeyUsageServerAuth
+	ExtKeyUsageClientAuth
+	ExtKeyUsageCodeSigning
+	ExtKeyUsageEmailProtection
+	ExtKeyUsageTimeStamping
+	ExtKeyUsageOCSPSigning
+)
+
+var extKeyUsageOIDs = []struct {
+	extKeyUsage ExtKeyUsage
+	oid         asn1.ObjectIdentifier
+}{
+	{ExtKeyUsageAny, asn1.ObjectIdentifier{2, 5, 29, 37, 0}},
+	{ExtKeyUsageServerAuth, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 1}},
+	{ExtKeyUsageClientAuth, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 2}},
+	{ExtKeyUsageCodeSigning, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 3}},
+	{ExtKeyUsageEmailProtection, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 4}},
+	{ExtKeyUsageTimeStamping, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 8}},
+	{ExtKeyUsageOCSPSigning, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 9}},
+}
+
+func extKeyUsageFromOID(oid asn1.ObjectIdentifier) (ExtKeyUsage, bool) {
+	for _, pair := range extKeyUsageOIDs {
+		if oid.Equal(pair.oid) {
+			return pair.extKeyUsage, true
+		}
+	}
+	return 0, false
+}
+
+func oidFromExtKeyUsage(eku ExtKeyUsage) (asn1.ObjectIdentifier, bool) {
+	for _, pair := range extKeyUsageOIDs {
+		if eku == pair.extKeyUsage {
+			return pair.oid, true
+		}
+	}
+	return nil, false
+}
+
+type certificate struct {
+	Raw                asn1.RawContent
+	TBSCertificate     tbsCertificate
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	SignatureValue     asn1.BitString
+}
+
+type tbsCertificate struct {
+	Raw                asn1.RawContent
+	Version            int `asn1:"optional,explicit,default:0,tag:0"`
+	SerialNumber       *big.Int
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Issuer             asn1.RawValue
+	Validity           validity
+	Subject            asn1.RawValue
+	PublicKey          publicKeyInfo
+	UniqueID           asn1.BitString   `asn1:"optional,tag:1"`
+	SubjectUniqueID    asn1.BitString   `asn1:"optional,tag:2"`
+	Extensions         []pkix.Extension `asn1:"optional,explicit,tag:3"`
+}
+
+type validity struct {
+	NotBefore, NotAfter time.Time
+}
+
+type publicKeyInfo struct {
+	Raw       asn1.RawContent
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+type basicConstraints struct {
+	IsCA       bool `asn1:"optional"`
+	MaxPathLen int  `asn1:"optional,default:-1"`
+}
+
+type authKeyID struct {
+	ID []byte `asn1:"optional,tag:0"`
+}
+
+type distributionPoint struct {
+	DistributionPoint distributionPointName `asn1:"optional,tag:0"`
+	Reason            asn1.BitString        `asn1:"optional,tag:1"`
+	CRLIssuer         asn1.RawValue         `asn1:"optional,tag:2"`
+}
+
+type distributionPointName struct {
+	FullName     []asn1.RawValue  `asn1:"optional,tag:0"`
+	RelativeName pkix.RDNSequence `asn1:"optional,tag:1"`
+}
+
+//Certificate an X.509 certificate
+type Certificate struct {
+	Raw                     []byte // Complete ASN.1 DER content (certificate, signature algorithm and signature).
+	RawTBSCertificate       []byte // Certificate part of raw ASN.1 DER content.
+	RawSubjectPublicKeyInfo []byte // DER encoded SubjectPublicKeyInfo.
+	RawSubject              []byte // DER encoded Subject
+	RawIssuer               []byte // DER encoded Issuer
+
+	Signature          []byte
+	SignatureAlgorithm SignatureAlgorithm
+
+	PublicKeyAlgorithm PublicKeyAlgorithm
+	PublicKey          interface{} // *gm.SM2PublicKey for SM2 certificates
+
+	Version             int
+	SerialNumber        *big.Int
+	Issuer              pkix.Name
+	Subject             pkix.Name
+	NotBefore, NotAfter time.Time
+	KeyUsage            KeyUsage
+
+	// Extensions contains raw X.509 extensions. When parsing certificates,
+	// this can be used to extract non-critical extensions that are not
+	// parsed by this package.
+	Extensions []pkix.Extension
+
+	// ExtraExtensions contains extensions to be copied, raw, into any
+	// marshaled certificates. Values override any extensions that would
+	// otherwise be produced based on the other fields.
+	ExtraExtensions []pkix.Extension
+
+	// UnhandledCriticalExtensions contains a list of extension IDs that
+	// were not (fully) processed when parsing.
+	UnhandledCriticalExtensions []asn1.ObjectIdentifier
+
+	ExtKeyUsage        []ExtKeyUsage
+	UnknownExtKeyUsage []asn1.ObjectIdentifier
+
+	// BasicConstraintsValid indicates whether IsCA, MaxPathLen and
+	// MaxPathLenZero are valid.
+	BasicConstraintsValid bool
+	IsCA                  bool
+	// MaxPathLen -1 means unset, 0 with MaxPathLenZero false means unset
+	// when creating a certificate.
+	MaxPathLen     int
+	MaxPathLenZero bool
+
+	SubjectKeyID   []byte
+	AuthorityKeyID []byte
+
+	// Subject Alternate Name values.
+	DNSNames       []string
+	EmailAddresses []string
+	IPAddresses    []net.IP
+	URIs           []*url.URL
+
+	CRLDistributionPoints []string
+}
+
+//ErrUnsupportedAlgorithm results from attempting to perform an operation
+// that involves algorithms that are not currently implemented.
+var ErrUnsupportedAlgorithm = errors.New("x509: cannot verify signature: algorithm unimplemented")
+
+//ConstraintViolationError results when a requested usage is not permitted by
+// a certificate. For example: checking a signature when the public key isn't a
+// certificate signing key.
+type ConstraintViolationError struct{}
+
+func (ConstraintViolationError) Error() string {
+	return "x509: invalid signature: parent certificate cannot sign this kind of certificate"
+}
+
+//Equal report whether two certificates are the same
+func (c *Certificate) Equal(other *Certificate) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	return bytes.Equal(c.Raw, other.Raw)
+}
+
+//CheckSignatureFrom verifies that the signature on c is a valid signature
+// from parent.
+func (c *Certificate) CheckSignatureFrom(parent *Certificate) error {
+	// RFC 5280, 4.2.1.9: only v3 certificates may act as CAs, v1 roots are
+	// accepted the same way crypto/x509 does.
+	if parent.Version == 3 && !parent.BasicConstraintsValid ||
+		parent.BasicConstraintsValid && !parent.IsCA {
+		return ConstraintViolationError{}
+	}
+	if parent.KeyUsage != 0 && parent.KeyUsage&KeyUsageCertSign == 0 {
+		return ConstraintViolationError{}
+	}
+	if parent.PublicKeyAlgorithm == UnknownPublicKeyAlgorithm {
+		return ErrUnsupportedAlgorithm
+	}
+	return parent.CheckSignature(c.SignatureAlgorithm, c.RawTBSCertificate, c.Signature)
+}
+
+//CheckSignature verifies that signature is a valid signature over signed from
+// c's public key.
+func (c *Certificate) CheckSignature(algo SignatureAlgorithm, signed, signature []byte) error {
+	return checkSignature(algo, signed, signature, c.PublicKey)
+}
+
+func checkSignature(algo SignatureAlgorithm, signed, signature []byte, publicKey interface{}) error {
+	return checkSignatureWithID(algo, signed, signature, publicKey, nil)
+}
+
+// checkSignatureWithID is checkSignature with the SM2 user id uid, the
+// default one is used if uid is empty.
+func checkSignatureWithID(algo SignatureAlgorithm, signed, signature []byte, publicKey interface{}, uid []byte) error {
+	for _, details := range signatureAlgorithmDetails {
+		if details.algo != algo {
+			continue
+		}
+		if algo == SM2WithSM3 {
+			pub, ok := publicKey.(*gm.SM2PublicKey)
+			if !ok {
+				return fmt.Errorf("x509: signature algorithm specifies an %s public key, but have public key of type %T", details.pubKeyAlgo, publicKey)
+			}
+			digest, err := sm2Digest(pub, uid, signed)
+			if err != nil {
+				return err
+			}
+			if valid, err := pub.Verify(nil, signature, digest); !valid || err != nil {
+				return errors.New("x509: SM2 verification failure")
+			}
+			return nil
+		}
+		// non Guomi algorithms are checked by crypto/x509
+		return (&stdx509.Certificate{PublicKey: publicKey}).CheckSignature(details.std, signed, signature)
+	}
+	return ErrUnsupportedAlgorithm
+}
+
+//CheckCRLSignature checks that the signature in crl is from c.
+func (c *Certificate) CheckCRLSignature(crl *pkix.CertificateList) error {
+	algo := getSignatureAlgorithmFromAI(crl.SignatureAlgorithm)
+	return c.CheckSignature(algo, crl.TBSCertList.Raw, crl.SignatureValue.RightAlign())
+}
+
+//ParseCertificate parses a single certificate from the given ASN.1 DER data.
+func ParseCertificate(asn1Data []byte) (*Certificate, error) {
+	var cert certificate
+	rest, err := asn1.Unmarshal(asn1Data, &cert)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, asn1.SyntaxError{Msg: "trailing data"}
+	}
+	return parseCertificate(&cert)
+}
+
+//ParseCertificates parses one or more certificates from the given ASN.1 DER
+// data. The certificates must be concatenated with no intermediate padding.
+func ParseCertificates(asn1Data []byte) ([]*Certificate, error) {
+	var v []*certificate
+	for len(asn1Data) > 0 {
+		cert := new(certificate)
+		var err error
+		asn1Data, err = asn1.Unmarshal(asn1Data, cert)
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, cert)
+	}
+
+	ret := make([]*Certificate, len(v))
+	for i, ci := range v {
+		cert, err := parseCertificate(ci)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = cert
+	}
+	return ret, nil
+}
+
+//ParseCertificatePEM parses the first CERTIFICATE block of PEM encoded data
+func ParseCertificatePEM(pemData []byte) (*Certificate, error) {
+	for {
+		var block *pem.Block
+		block, pemData = pem.Decode(pemData)
+		if block == nil {
+			return nil, errors.New("x509: no CERTIFICATE PEM block found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+func parseCertificate(in *certificate) (*Certificate, error) {
+	out := new(Certificate)
+	out.Raw = in.Raw
+	out.RawTBSCertificate = in.TBSCertificate.Raw
+	out.RawSubjectPublicKeyInfo = in.TBSCertificate.PublicKey.Raw
+	out.RawSubject = in.TBSCertificate.Subject.FullBytes
+	out.RawIssuer = in.TBSCertificate.Issuer.FullBytes
+
+	out.Signature = in.SignatureValue.RightAlign()
+	out.SignatureAlgorithm = getSignatureAlgorithmFromAI(in.TBSCertificate.SignatureAlgorithm)
+
+	var err error
+	out.PublicKeyAlgorithm, out.PublicKey, err = parsePublicKey(&in.TBSCertificate.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	out.Version = in.TBSCertificate.Version + 1
+	out.SerialNumber = in.TBSCertificate.SerialNumber
+
+	var issuer, subject pkix.RDNSequence
+	if rest, err := asn1.Unmarshal(in.TBSCertificate.Subject.FullBytes, &subject); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("x509: trailing data after X.509 subject")
+	}
+	if rest, err := asn1.Unmarshal(in.TBSCertificate.Issuer.FullBytes, &issuer); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("x509: trailing data after X.509 issuer")
+	}
+	out.Issuer.FillFromRDNSequence(&issuer)
+	out.Subject.FillFromRDNSequence(&subject)
+
+	out.NotBefore = in.TBSCertificate.Validity.NotBefore
+	out.NotAfter = in.TBSCertificate.Validity.NotAfter
+
+	for _, e := range in.TBSCertificate.Extensions {
+		out.Extensions = append(out.Extensions, e)
+		unhandled, err := out.parseExtension(e)
+		if err != nil {
+			return nil, err
+		}
+		if e.Critical && unhandled {
+			out.UnhandledCriticalExtensions = append(out.UnhandledCriticalExtensions, e.Id)
+		}
+	}
+	return out, nil
+}
+
+func (c *Certificate) parseExtension(e pkix.Extension) (unhandled bool, err error) {
+	switch {
+	case e.Id.Equal(oidExtensionKeyUsage):
+		c.KeyUsage, err = parseKeyUsage(e.Value)
+	case e.Id.Equal(oidExtensionBasicConstraints):
+		var constraints basicConstraints
+		if rest, err := asn1.Unmarshal(e.Value, &constraints); err != nil {
+			return false, err
+		} else if len(rest) != 0 {
+			return false, errors.New("x509: trailing data after X.509 BasicConstraints")
+		}
+		c.BasicConstraintsValid = true
+		c.IsCA = constraints.IsCA
+		c.MaxPathLen = constraints.MaxPathLen
+		c.MaxPathLenZero = c.MaxPathLen == 0
+	case e.Id.Equal(oidExtensionSubjectAltName):
+		c.DNSNames, c.EmailAddresses, c.IPAddresses, c.URIs, err = parseSANExtension(e.Value)
+		if err != nil {
+			return false, err
+		}
+		// a SAN extension with no entries we understand is still unhandled
+		unhandled = len(c.DNSNames) == 0 && len(c.EmailAddresses) == 0 && len(c.IPAddresses) == 0 && len(c.URIs) == 0
+	case e.Id.Equal(oidExtensionExtendedKeyUsage):
+		var keyUsage []asn1.ObjectIdentifier
+		if rest, err := asn1.Unmarshal(e.Value, &keyUsage); err != nil {
+			return false, err
+		} else if len(rest) != 0 {
+			return false, errors.New("x509: trailing data after X.509 ExtendedKeyUsage")
+		}
+		for _, u := range keyUsage {
+			if extKeyUsage, ok := extKeyUsageFromOID(u); ok {
+				c.ExtKeyUsage = append(c.ExtKeyUsage, extKeyUsage)
+			} else {
+				c.UnknownExtKeyUsage = append(c.UnknownExtKeyUsage, u)
+			}
+		}
+	case e.Id.Equal(oidExtensionSubjectKeyID):
+		var keyID []byte
+		if rest, err := asn1.Unmarshal(e.Value, &keyID); err != nil {
+			return false, err
+		} else if len(rest) != 0 {
+			return false, errors.New("x509: trailing data after X.509 key-id")
+		}
+		c.SubjectKeyID = keyID
+	case e.Id.Equal(oidExtensionAuthorityKeyID):
+		var a authKeyID
+		if rest, err := asn1.Unmarshal(e.Value, &a); err != nil {
+			return false, err
+		} else if len(rest) != 0 {
+			return false, errors.New("x509: trailing data after X.509 authority key-id")
+		}
+		c.AuthorityKeyID = a.ID
+	case e.Id.Equal(oidExtensionCRLDistributionPoints):
+		var cdp []distributionPoint
+		if rest, err := asn1.Unmarshal(e.Value, &cdp); err != nil {
+			return false, err
+		} else if len(rest) != 0 {
+			return false, errors.New("x509: trailing data after X.509 CRL distribution point")
+		}
+		for _, dp := range cdp {
+			for _, name := range dp.DistributionPoint.FullName {
+				if name.Tag == 6 {
+					c.CRLDistributionPoints = append(c.CRLDistributionPoints, string(name.Bytes))
+				}
+			}
+		}
+	default:
+		return true, nil
+	}
+	return false, err
+}
+
+func parseKeyUsage(der []byte) (KeyUsage, error) {
+	var usageBits asn1.BitString
+	if rest, err := asn1.Unmarshal(der, &usageBits); err != nil {
+		return 0, err
+	} else if len(rest) != 0 {
+		return 0, errors.New("x509: trailing data after X.509 KeyUsage")
+	}
+	var usage int
+	for i := 0; i < 9; i++ {
+		if usageBits.At(i) != 0 {
+			usage |= 1 << uint(i)
+		}
+	}
+	return KeyUsage(usage), nil
+}
+
+func parseSANExtension(value []byte) (dnsNames, emailAddresses []string, ipAddresses []net.IP, uris []*url.URL, err error) {
+	var seq asn1.RawValue
+	rest, err := asn1.Unmarshal(value, &seq)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	} else if len(rest) != 0 {
+		return nil, nil, nil, nil, errors.New("x509: trailing data after X.509 extension")
+	}
+	if !seq.IsCompound || seq.Tag != asn1.TagSequence || seq.Class != asn1.ClassUniversal {
+		return nil, nil, nil, nil, asn1.StructuralError{Msg: "bad SAN sequence"}
+	}
+
+	rest = seq.Bytes
+	for len(rest) > 0 {
+		var v asn1.RawValue
+		rest, err = asn1.Unmarshal(rest, &v)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if v.Class != asn1.ClassContextSpecific {
+			return nil, nil, nil, nil, asn1.StructuralError{Msg: "bad SAN choice"}
+		}
+		switch v.Tag {
+		case nameTypeEmail:
+			emailAddresses = append(emailAddresses, string(v.Bytes))
+		case nameTypeDNS:
+			dnsNames = append(dnsNames, string(v.Bytes))
+		case nameTypeURI:
+			uri, err := url.Parse(string(v.Bytes))
+			if err != nil {
+				return nil, nil, nil, nil, fmt.Errorf("x509: cannot parse URI %q: %s", string(v.Bytes), err)
+			}
+			uris = append(uris, uri)
+		case nameTypeIP:
+			switch len(v.Bytes) {
+			case net.IPv4len, net.IPv6len:
+				ipAddresses = append(ipAddresses, v.Bytes)
+			default:
+				return nil, nil, nil, nil, errors.New("x509: cannot parse IP address of length " + strconv.Itoa(len(v.Bytes)))
+			}
+		}
+	}
+	return
+}
+
+const (
+	nameTypeEmail = 1
+	nameTypeDNS   = 2
+	nameTypeURI   = 6
+	nameTypeIP    = 7
+)
+
+func parsePublicKey(keyData *publicKeyInfo) (PublicKeyAlgorithm, interface{}, error) {
+	algo := keyData.Algorithm.Algorithm
+	if algo.Equal(oidNamedCurveSM2) || algo.Equal(oidPublicKeyECDSA) && isSM2Params(keyData.Algorithm.Parameters) {
+		pub, err := parseSM2PublicKey(keyData.PublicKey.RightAlign())
+		if err != nil {
+			return UnknownPublicKeyAlgorithm, nil, err
+		}
+		return SM2, pub, nil
+	}
+
+	pub, err := stdx509.ParsePKIXPublicKey(keyData.Raw)
+	if err != nil {
+		// unknown algorithms are not an error, the certificate is still usable as a leaf
+		return UnknownPublicKeyAlgorithm, nil, nil
+	}
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return RSA, pub, nil
+	case *ecdsa.PublicKey:
+		return ECDSA, pub, nil
+	case ed25519.PublicKey:
+		return Ed25519, pub, nil
+	}
+	return UnknownPublicKeyAlgorithm, nil, nil
+}
+
+func isSM2Params(params asn1.RawValue) bool {
+	var namedCurve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(params.FullBytes, &namedCurve); err != nil {
+		return false
+	}
+	return namedCurve.Equal(oidNamedCurveSM2)
+}
+
+func parseSM2PublicKey(point []byte) (*gm.SM2PublicKey, error) {
+	if len(point) != 65 || point[0] != 0x04 {
+		return nil, errors.New("x509: invalid SM2 public key encoding")
+	}
+	x, y := new(big.Int).SetBytes(point[1:33]), new(big.Int).SetBytes(point[33:])
+	if !gm.GetSm2Curve().IsOnCurve(x, y) {
+		return nil, errors.New("x509: SM2 public key is not on curve")
+	}
+	pub := new(gm.SM2PublicKey)
+	if err := pub.FromBytes(point, 0); err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+func marshalPublicKey(pub interface{}) (publicKeyBytes []byte, publicKeyAlgorithm pkix.AlgorithmIdentifier, err error) {
+	switch pub := pub.(type) {
+	case *gm.SM2PublicKey:
+		publicKeyBytes, _ = pub.Bytes()
+		paramBytes, err := asn1.Marshal(oidNamedCurveSM2)
+		if err != nil {
+			return nil, pkix.AlgorithmIdentifier{}, err
+		}
+		publicKeyAlgorithm.Algorithm = oidPublicKeyECDSA
+		publicKeyAlgorithm.Parameters.FullBytes = paramBytes
+		return publicKeyBytes, publicKeyAlgorithm, nil
+	case nil:
+		return nil, pkix.AlgorithmIdentifier{}, errors.New("x509: public key is nil")
+	}
+
+	der, err := stdx509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, pkix.AlgorithmIdentifier{}, err
+	}
+	var info publicKeyInfo
+	if _, err = asn1.Unmarshal(der, &info); err != nil {
+		return nil, pkix.AlgorithmIdentifier{}, err
+	}
+	return info.PublicKey.RightAlign(), info.Algorithm, nil
+}
+
+//MarshalPKIXPublicKey converts a public key to PKIX, ASN.1 DER form.
+func MarshalPKIXPublicKey(pub interface{}) ([]byte, error) {
+	publicKeyBytes, publicKeyAlgorithm, err := marshalPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(publicKeyInfo{
+		Algorithm: publicKeyAlgorithm,
+		PublicKey: asn1.BitString{
+			Bytes:     publicKeyBytes,
+			BitLength: 8 * len(publicKeyBytes),
+		},
+	})
+}
+
+//ParsePKIXPublicKey parses a public key in PKIX, ASN.1 DER form. SM2 keys are
+// returned as *gm.SM2PublicKey, other keys the same way as crypto/x509.
+func ParsePKIXPublicKey(derBytes []byte) (pub interface{}, err error) {
+	var pki publicKeyInfo
+	if rest, err := asn1.Unmarshal(derBytes, &pki); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("x509: trailing data after ASN.1 of public-key")
+	}
+	algo, pub, err := parsePublicKey(&pki)
+	if err != nil {
+		return nil, err
+	}
+	if algo == UnknownPublicKeyAlgorithm {
+		return nil, errors.New("x509: unknown public key algorithm")
+	}
+	return pub, nil
+}
+
+func reverseBitsInAByte(in byte) byte {
+	b1 := in>>4 | in<<4
+	b2 := b1>>2&0x33 | b1<<2&0xcc
+	b3 := b2>>1&0x55 | b2<<1&0xaa
+	return b3
+}
+
+// asn1BitLength returns the bit-length of bitString by considering the
+// most-significant bit in a byte to be the "first" bit.
+func asn1BitLength(bitString []byte) int {
+	bitLen := len(bitString) * 8
+
+	for i := range bitString {
+		b := bitString[len(bitString)-i-1]
+
+		for bit := uint(0); bit < 8; bit++ {
+			if (b>>bit)&1 == 1 {
+				return bitLen
+			}
+			bitLen--
+		}
+	}
+	return 0
+}
+
+func marshalKeyUsage(ku KeyUsage) (pkix.Extension, error) {
+	ext := pkix.Extension{Id: oidExtensionKeyUsage, Critical: true}
+
+	var a [2]byte
+	a[0] = reverseBitsInAByte(byte(ku))
+	a[1] = reverseBitsInAByte(byte(ku >> 8))
+
+	l := 1
+	if a[1] != 0 {
+		l = 2
+	}
+
+	bitString := a[:l]
+	var err error
+	ext.Value, err = asn1.Marshal(asn1.BitString{Bytes: bitString, BitLength: asn1BitLength(bitString)})
+	return ext, err
+}
+
+func marshalExtKeyUsage(extUsages []ExtKeyUsage, unknownUsages []asn1.ObjectIdentifier) (pkix.Extension, error) {
+	ext := pkix.Extension{Id: oidExtensionExtendedKeyUsage}
+
+	oids := make([]asn1.ObjectIdentifier, len(extUsages)+len(unknownUsages))
+	for i, u := range extUsages {
+		oid, ok := oidFromExtKeyUsage(u)
+		if !ok {
+			return ext, errors.New("x509: unknown extended key usage")
+		}
+		oids[i] = oid
+	}
+	copy(oids[len(extUsages):], unknownUsages)
+
+	var err error
+	ext.Value, err = asn1.Marshal(oids)
+	return ext, err
+}
+
+func marshalBasicConstraints(isCA bool, maxPathLen int, maxPathLenZero bool) (pkix.Extension, error) {
+	ext := pkix.Extension{Id: oidExtensionBasicConstraints, Critical: true}
+	// Leaving MaxPathLen as zero indicates that no maximum path
+	// length is desired, unless MaxPathLenZero is set. A value of
+	// -1 causes encoding/asn1 to omit the value as desired.
+	if maxPathLen == 0 && !maxPathLenZero {
+		maxPathLen = -1
+	}
+	var err error
+	ext.Value, err = asn1.Marshal(basicConstraints{isCA, maxPathLen})
+	return ext, err
+}
+
+func marshalSANs(dnsNames, emailAddresses []string, ipAddresses []net.IP, uris []*url.URL) ([]byte, error) {
+	var rawValues []asn1.RawValue
+	for _, name := range dnsNames {
+		rawValues = append(rawValues, asn1.RawValue{Tag: nameTypeDNS, Class: asn1.ClassContextSpecific, Bytes: []byte(name)})
+	}
+	for _, email := range emailAddresses {
+		rawValues = append(rawValues, asn1.RawValue{Tag: nameTypeEmail, Class: asn1.ClassContextSpecific, Bytes: []byte(email)})
+	}
+	for _, rawIP := range ipAddresses {
+		// If possible, we always want to encode IPv4 addresses in 4 bytes.
+		ip := rawIP.To4()
+		if ip == nil {
+			ip = rawIP
+		}
+		rawValues = append(rawValues, asn1.RawValue{Tag: nameTypeIP, Class: asn1.ClassContextSpecific, Bytes: ip})
+	}
+	for _, uri := range uris {
+		rawValues = append(rawValues, asn1.RawValue{Tag: nameTypeURI, Class: asn1.ClassContextSpecific, Bytes: []byte(uri.String())})
+	}
+	return asn1.Marshal(rawValues)
+}
+
+func marshalCRLDistributionPoints(points []string) (pkix.Extension, error) {
+	ext := pkix.Extension{Id: oidExtensionCRLDistributionPoints}
+
+	var crlDp []distributionPoint
+	for _, name := range points {
+		dp := distributionPoint{
+			DistributionPoint: distributionPointName{
+				FullName: []asn1.RawValue{
+					{Tag: nameTypeURI, Class: asn1.ClassContextSpecific, Bytes: []byte(name)},
+				},
+			},
+		}
+		crlDp = append(crlDp, dp)
+	}
+
+	var err error
+	ext.Value, err = asn1.Marshal(crlDp)
+	return ext, err
+}
+
+func oidInExtensions(oid asn1.ObjectIdentifier, extensions []pkix.Extension) bool {
+	for _, e := range extensions {
+		if e.Id.Equal(oid) {
+			return true
+		}
+	}
+	return false
+}
+
+func buildExtensions(template *Certificate, subjectIsEmpty bool, authorityKeyID []byte, subjectKeyID []byte) ([]pkix.Extension, error) {
+	ret := make([]pkix.Extension, 0, 8)
+
+	if template.KeyUsage != 0 && !oidInExtensions(oidExtensionKeyUsage, template.ExtraExtensions) {
+		ext, err := marshalKeyUsage(template.KeyUsage)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, ext)
+	}
+
+	if (len(template.ExtKeyUsage) > 0 || len(template.UnknownExtKeyUsage) > 0) &&
+		!oidInExtensions(oidExtensionExtendedKeyUsage, template.ExtraExtensions) {
+		ext, err := marshalExtKeyUsage(template.ExtKeyUsage, template.UnknownExtKeyUsage)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, ext)
+	}
+
+	if template.BasicConstraintsValid && !oidInExtensions(oidExtensionBasicConstraints, template.ExtraExtensions) {
+		ext, err := marshalBasicConstraints(template.IsCA, template.MaxPathLen, template.MaxPathLenZero)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, ext)
+	}
+
+	if len(subjectKeyID) > 0 && !oidInExtensions(oidExtensionSubjectKeyID, template.ExtraExtensions) {
+		value, err := asn1.Marshal(subjectKeyID)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, pkix.Extension{Id: oidExtensionSubjectKeyID, Value: value})
+	}
+
+	if len(authorityKeyID) > 0 && !oidInExtensions(oidExtensionAuthorityKeyID, template.ExtraExtensions) {
+		value, err := asn1.Marshal(authKeyID{authorityKeyID})
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, pkix.Extension{Id: oidExtensionAuthorityKeyID, Value: value})
+	}
+
+	if (len(template.DNSNames) > 0 || len(template.EmailAddresses) > 0 || len(template.IPAddresses) > 0 || len(template.URIs) > 0) &&
+		!oidInExtensions(oidExtensionSubjectAltName, template.ExtraExtensions) {
+		value, err := marshalSANs(template.DNSNames, template.EmailAddresses, template.IPAddresses, template.URIs)
+		if err != nil {
+			return nil, err
+		}
+		// RFC 5280, 4.2.1.6: the extension is critical if the subject is empty
+		ret = append(ret, pkix.Extension{Id: oidExtensionSubjectAltName, Critical: subjectIsEmpty, Value: value})
+	}
+
+	if len(template.CRLDistributionPoints) > 0 && !oidInExtensions(oidExtensionCRLDistributionPoints, template.ExtraExtensions) {
+		ext, err := marshalCRLDistributionPoints(template.CRLDistributionPoints)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, ext)
+	}
+
+	return append(ret, template.ExtraExtensions...), nil
+}
+
+var emptyASN1Subject = []byte{0x30, 0}
+
+func subjectBytes(cert *Certificate) ([]byte, error) {
+	if len(cert.RawSubject) > 0 {
+		return cert.RawSubject, nil
+	}
+	return asn1.Marshal(cert.Subject.ToRDNSequence())
+}
+
+// sm2SignatureAlgorithm is the only AlgorithmIdentifier used when signing,
+// GM/T 0015 omits the parameters.
+var sm2SignatureAlgorithm = pkix.AlgorithmIdentifier{Algorithm: oidSignatureSM2WithSM3}
+
+func signSM2(rand io.Reader, priv *gm.SM2PrivateKey, uid, signed []byte) ([]byte, error) {
+	pub, ok := priv.Public().(*gm.SM2PublicKey)
+	if !ok {
+		return nil, errors.New("x509: invalid SM2 private key")
+	}
+	digest, err := sm2Digest(pub, uid, signed)
+	if err != nil {
+		return nil, err
+	}
+	return priv.Sign(nil, digest, rand)
+}
+
+func sm2Digest(pub *gm.SM2PublicKey, uid, signed []byte) ([]byte, error) {
+	if len(uid) == 0 {
+		return gm.HashBeforeSM2(pub, signed), nil
+	}
+	return gm.HashBeforeSM2WithID(pub, uid, signed)
+}
+
+//CreateCertificate creates a new X.509 v3 certificate based on a template and
+// signs it with priv using SM2-with-SM3. If parent is equal to template the
+// certificate is self-signed. pub is the public key of the signee, usually a
+// *gm.SM2PublicKey.
+func CreateCertificate(rand io.Reader, template, parent *Certificate, pub interface{}, priv *gm.SM2PrivateKey) ([]byte, error) {
+	if priv == nil {
+		return nil, errors.New("x509: private key is nil")
+	}
+	if template.SerialNumber == nil {
+		return nil, errors.New("x509: no SerialNumber given")
+	}
+	if template.SignatureAlgorithm != UnknownSignatureAlgorithm && template.SignatureAlgorithm != SM2WithSM3 {
+		return nil, fmt.Errorf("x509: cannot sign with %s", template.SignatureAlgorithm)
+	}
+	if parentPub, ok := parent.PublicKey.(*gm.SM2PublicKey); ok {
+		signerPub := priv.Public().(*gm.SM2PublicKey)
+		if parentPub.X != signerPub.X || parentPub.Y != signerPub.Y {
+			return nil, errors.New("x509: provided PrivateKey doesn't match parent's PublicKey")
+		}
+	}
+
+	publicKeyBytes, publicKeyAlgorithm, err := marshalPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	asn1Issuer, err := subjectBytes(parent)
+	if err != nil {
+		return nil, err
+	}
+	asn1Subject, err := subjectBytes(template)
+	if err != nil {
+		return nil, err
+	}
+
+	authorityKeyID := template.AuthorityKeyID
+	if !bytes.Equal(asn1Issuer, asn1Subject) && len(parent.SubjectKeyID) > 0 {
+		authorityKeyID = parent.SubjectKeyID
+	}
+
+	subjectKeyID := template.SubjectKeyID
+	if len(subjectKeyID) == 0 && template.IsCA {
+		// RFC 5280, 4.2.1.2 method (1), with SM3 in place of SHA-1
+		h, _ := gm.NewSM3Hasher().Hash(publicKeyBytes)
+		subjectKeyID = h[:20]
+	}
+
+	extensions, err := buildExtensions(template, bytes.Equal(asn1Subject, emptyASN1Subject), authorityKeyID, subjectKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedPublicKey := asn1.BitString{BitLength: len(publicKeyBytes) * 8, Bytes: publicKeyBytes}
+	c := tbsCertificate{
+		Version:            2,
+		SerialNumber:       template.SerialNumber,
+		SignatureAlgorithm: sm2SignatureAlgorithm,
+		Issuer:             asn1.RawValue{FullBytes: asn1Issuer},
+		Validity:           validity{template.NotBefore.UTC(), template.NotAfter.UTC()},
+		Subject:            asn1.RawValue{FullBytes: asn1Subject},
+		PublicKey:          publicKeyInfo{nil, publicKeyAlgorithm, encodedPublicKey},
+		Extensions:         extensions,
+	}
+
+	tbsCertContents, err := asn1.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	c.Raw = tbsCertContents
+
+	signature, err := signSM2(rand, priv, nil, c.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(certificate{
+		nil,
+		c,
+		sm2SignatureAlgorithm,
+		asn1.BitString{Bytes: signature, BitLength: len(signature) * 8},
+	})
+}
@@ -0,0 +1,153 @@
+package x509
+
+import (
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	gm "github.com/meshplus/crypto-gm"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+func readCert(t *testing.T, name string) *Certificate {
+	data, err := ioutil.ReadFile("testdata/" + name)
+	assert.Nil(t, err)
+	cert, err := ParseCertificatePEM(data)
+	assert.Nil(t, err)
+	return cert
+}
+
+func TestParseCertificate(t *testing.T) {
+	ca := readCert(t, "sm2_ca.pem")
+	assert.Equal(t, 3, ca.Version)
+	assert.Equal(t, SM2WithSM3, ca.SignatureAlgorithm)
+	assert.Equal(t, SM2, ca.PublicKeyAlgorithm)
+	assert.Equal(t, "2ce05b36c733ee7", ca.SerialNumber.Text(16))
+	assert.Equal(t, []string{"测试CA"}, ca.Subject.Organization)
+	assert.Equal(t, []string{"杭州"}, ca.Subject.Locality)
+	assert.True(t, ca.BasicConstraintsValid)
+	assert.True(t, ca.IsCA)
+	assert.Equal(t, KeyUsageCertSign|KeyUsageCRLSign, ca.KeyUsage)
+	assert.Equal(t, "91d3d7a6f97b77fc795dcbd232acbb4004b0f8ff", hex.EncodeToString(ca.SubjectKeyID))
+	assert.Equal(t, ca.SubjectKeyID, ca.AuthorityKeyID)
+	assert.Equal(t, time.Date(2031, 5, 30, 6, 55, 2, 0, time.UTC), ca.NotAfter)
+	pub, ok := ca.PublicKey.(*gm.SM2PublicKey)
+	assert.True(t, ok)
+	assert.Equal(t, "ba38508dca0a07d9fe0e9cf6d5e7bd2bc9db55713bc05183a8fcc93d75c2acbc", hex.EncodeToString(pub.X[:]))
+
+	leaf := readCert(t, "sm2_sign_cert.pem")
+	assert.Equal(t, "localhost", leaf.Subject.CommonName)
+	assert.Equal(t, KeyUsageDigitalSignature|KeyUsageContentCommitment, leaf.KeyUsage)
+	assert.Equal(t, []ExtKeyUsage{ExtKeyUsageServerAuth, ExtKeyUsageClientAuth}, leaf.ExtKeyUsage)
+	assert.Equal(t, ca.SubjectKeyID, leaf.AuthorityKeyID)
+	assert.Nil(t, leaf.CheckSignatureFrom(ca))
+	assert.NotNil(t, ca.CheckSignatureFrom(leaf))
+
+	enc := readCert(t, "sm2_enc_cert.pem")
+	assert.Equal(t, KeyUsageKeyEncipherment|KeyUsageDataEncipherment, enc.KeyUsage)
+	assert.Nil(t, enc.CheckSignatureFrom(ca))
+}
+
+func TestParseCertificateRSA(t *testing.T) {
+	ca := readCert(t, "rsa_ca.pem")
+	leaf := readCert(t, "rsa_sign_cert.pem")
+	assert.Equal(t, RSA, ca.PublicKeyAlgorithm)
+	assert.Equal(t, SHA256WithRSA, leaf.SignatureAlgorithm)
+	assert.Nil(t, leaf.CheckSignatureFrom(ca))
+
+	sm2CA := readCert(t, "sm2_ca.pem")
+	assert.NotNil(t, leaf.CheckSignatureFrom(sm2CA))
+}
+
+func TestParseCertificates(t *testing.T) {
+	ca := readCert(t, "sm2_ca.pem")
+	leaf := readCert(t, "sm2_sign_cert.pem")
+	certs, err := ParseCertificates(append(append([]byte{}, leaf.Raw...), ca.Raw...))
+	assert.Nil(t, err)
+	assert.Len(t, certs, 2)
+	assert.True(t, certs[0].Equal(leaf))
+	assert.True(t, certs[1].Equal(ca))
+
+	_, err = ParseCertificate(append(append([]byte{}, leaf.Raw...), 0))
+	assert.NotNil(t, err)
+}
+
+func TestCreateCertificate(t *testing.T) {
+	caKey, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	now := time.Now()
+	caTemplate := &Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Country: []string{"CN"}, Organization: []string{"Hyperchain"}, CommonName: "Test Root CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		KeyUsage:              KeyUsageCertSign | KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	assert.Nil(t, err)
+	ca, err := ParseCertificate(caDER)
+	assert.Nil(t, err)
+	assert.Nil(t, ca.CheckSignatureFrom(ca))
+	assert.Len(t, ca.SubjectKeyID, 20)
+	assert.Equal(t, "Test Root CA", ca.Subject.CommonName)
+	assert.Equal(t, -1, ca.MaxPathLen)
+
+	leafKey, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	leafTemplate := &Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "node1"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		KeyUsage:              KeyUsageDigitalSignature,
+		ExtKeyUsage:           []ExtKeyUsage{ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"node1.hyperchain.cn"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		CRLDistributionPoints: []string{"http://crl.hyperchain.cn/root.crl"},
+	}
+	leafDER, err := CreateCertificate(rand.Reader, leafTemplate, ca, leafKey.Public(), caKey)
+	assert.Nil(t, err)
+	leaf, err := ParseCertificate(leafDER)
+	assert.Nil(t, err)
+	assert.Nil(t, leaf.CheckSignatureFrom(ca))
+	assert.Equal(t, ca.SubjectKeyID, leaf.AuthorityKeyID)
+	assert.Equal(t, ca.RawSubject, leaf.RawIssuer)
+	assert.Equal(t, []string{"node1.hyperchain.cn"}, leaf.DNSNames)
+	assert.True(t, leaf.IPAddresses[0].Equal(net.ParseIP("127.0.0.1")))
+	assert.Equal(t, leafTemplate.CRLDistributionPoints, leaf.CRLDistributionPoints)
+	assert.False(t, leaf.IsCA)
+	pub := leaf.PublicKey.(*gm.SM2PublicKey)
+	assert.Equal(t, leafKey.PublicKey.X, pub.X)
+	assert.Equal(t, leafKey.PublicKey.Y, pub.Y)
+
+	// the signer must be the parent
+	_, err = CreateCertificate(rand.Reader, leafTemplate, ca, leafKey.Public(), leafKey)
+	assert.NotNil(t, err)
+	_, err = CreateCertificate(rand.Reader, &Certificate{}, ca, leafKey.Public(), caKey)
+	assert.NotNil(t, err)
+}
+
+func TestPKIXPublicKey(t *testing.T) {
+	key, err := gm.GenerateSM2Key()
+	assert.Nil(t, err)
+	der, err := MarshalPKIXPublicKey(key.Public())
+	assert.Nil(t, err)
+	pub, err := ParsePKIXPublicKey(der)
+	assert.Nil(t, err)
+	assert.Equal(t, key.PublicKey.X, pub.(*gm.SM2PublicKey).X)
+	assert.Equal(t, key.PublicKey.Y, pub.(*gm.SM2PublicKey).Y)
+
+	leaf := readCert(t, "sm2_sign_cert.pem")
+	pub, err = ParsePKIXPublicKey(leaf.RawSubjectPublicKeyInfo)
+	assert.Nil(t, err)
+	der, err = MarshalPKIXPublicKey(pub)
+	assert.Nil(t, err)
+	assert.Equal(t, leaf.RawSubjectPublicKeyInfo, der)
+}
@@ -0,0 +1,178 @@
+package zuc
+
+import (
+	"crypto/cipher"
+	"errors"
+	"hash"
+)
+
+// The 7-bit constants loaded with the key of ZUC-256. The first four differ
+// between the keystream generator and the MAC of each tag size.
+var (
+	d256       = [16]byte{0x22, 0x2f, 0x24, 0x2a, 0x6d, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x52, 0x10, 0x30}
+	d256MAC32  = [16]byte{0x22, 0x2f, 0x25, 0x2a, 0x6d, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x52, 0x10, 0x30}
+	d256MAC64  = [16]byte{0x23, 0x2f, 0x24, 0x2a, 0x6d, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x52, 0x10, 0x30}
+	d256MAC128 = [16]byte{0x23, 0x2f, 0x25, 0x2a, 0x6d, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x52, 0x10, 0x30}
+)
+
+// cell returns a 31-bit LFSR cell of ZUC-256.
+func cell(a byte, d byte, b, c byte) uint32 {
+	return uint32(a)<<23 | uint32(d)<<16 | uint32(b)<<8 | uint32(c)
+}
+
+// newState256 loads the 32-byte key and the 25-byte IV, whose last 8 bytes
+// hold 6 bits each.
+func newState256(key, iv []byte, d *[16]byte) (*state, error) {
+	if len(key) != 32 {
+		return nil, KeySizeError(len(key))
+	}
+	if len(iv) != 25 {
+		return nil, KeySizeError(len(iv))
+	}
+	k := key
+	var v [25]byte
+	copy(v[:], iv)
+	for i := 17; i < 25; i++ {
+		v[i] &= 0x3f
+	}
+
+	st := new(state)
+	st.s = [16]uint32{
+		cell(k[0], d[0], k[21], k[16]),
+		cell(k[1], d[1], k[22], k[17]),
+		cell(k[2], d[2], k[23], k[18]),
+		cell(k[3], d[3], k[24], k[19]),
+		cell(k[4], d[4], k[25], k[20]),
+		cell(v[0], d[5]|v[17], k[5], k[26]),
+		cell(v[1], d[6]|v[18], k[6], k[27]),
+		cell(v[10], d[7]|v[19], k[7], v[2]),
+		cell(k[8], d[8]|v[20], v[3], v[11]),
+		cell(k[9], d[9]|v[21], v[12], v[4]),
+		cell(v[5], d[10]|v[22], k[10], k[28]),
+		cell(k[11], d[11]|v[23], v[6], v[13]),
+		cell(k[12], d[12]|v[24], v[7], v[14]),
+		cell(k[13], d[13], v[15], v[8]),
+		cell(k[14], d[14]|k[31]>>4, v[16], v[9]),
+		cell(k[15], d[15]|k[31]&0x0f, k[30], k[29]),
+	}
+	st.initialize()
+	return st, nil
+}
+
+//NewCipher256 returns the ZUC-256 keystream generator of a 32-byte key and a
+// 25-byte IV; only the low 6 bits of the last 8 bytes of the IV are used.
+func NewCipher256(key, iv []byte) (cipher.Stream, error) {
+	st, err := newState256(key, iv, &d256)
+	if err != nil {
+		return nil, err
+	}
+	return newStream(st), nil
+}
+
+//MAC256 is the ZUC-256 MAC, it implements hash.Hash for byte aligned
+// messages.
+type MAC256 struct {
+	key, iv []byte
+	words   int // tag size in words
+	st      *state
+	tag     []uint32
+	// ks holds the keystream from the current message position on, one word
+	// more than the tag so that windows at any bit offset are available.
+	ks  []uint32
+	bit uint // bits of ks[0] already consumed
+}
+
+var _ hash.Hash = (*MAC256)(nil)
+
+//NewMAC256 returns a ZUC-256 MAC with a tag of tagSize bytes, 4, 8 or 16.
+func NewMAC256(key, iv []byte, tagSize int) (*MAC256, error) {
+	switch tagSize {
+	case 4, 8, 16:
+	default:
+		return nil, errors.New("zuc: tag size must be 4, 8 or 16 bytes")
+	}
+	m := &MAC256{
+		key:   append([]byte(nil), key...),
+		iv:    append([]byte(nil), iv...),
+		words: tagSize / 4,
+	}
+	if err := m.reset(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *MAC256) reset() error {
+	d := &d256MAC32
+	switch m.words {
+	case 2:
+		d = &d256MAC64
+	case 4:
+		d = &d256MAC128
+	}
+	st, err := newState256(m.key, m.iv, d)
+	if err != nil {
+		return err
+	}
+	m.st = st
+	m.tag = make([]uint32, m.words)
+	for i := range m.tag {
+		m.tag[i] = st.next()
+	}
+	m.ks = make([]uint32, m.words+1)
+	for i := range m.ks {
+		m.ks[i] = st.next()
+	}
+	m.bit = 0
+	return nil
+}
+
+// window returns the word i of the tag-sized keystream window at the current
+// bit.
+func (m *MAC256) window(i int) uint32 {
+	if m.bit == 0 {
+		return m.ks[i]
+	}
+	return m.ks[i]<<m.bit | m.ks[i+1]>>(32-m.bit)
+}
+
+func (m *MAC256) Write(p []byte) (int, error) {
+	for _, b := range p {
+		for j := uint(0); j < 8; j++ {
+			if b>>(7-j)&1 == 1 {
+				for i := range m.tag {
+					m.tag[i] ^= m.window(i)
+				}
+			}
+			m.bit++
+		}
+		if m.bit == 32 {
+			copy(m.ks, m.ks[1:])
+			m.ks[m.words] = m.st.next()
+			m.bit = 0
+		}
+	}
+	return len(p), nil
+}
+
+//Sum appends the tag of the message written so far to b, it does not change
+// the state.
+func (m *MAC256) Sum(b []byte) []byte {
+	for i, t := range m.tag {
+		w := t ^ m.window(i)
+		b = append(b, byte(w>>24), byte(w>>16), byte(w>>8), byte(w))
+	}
+	return b
+}
+
+//Reset restarts the MAC with its key and IV.
+func (m *MAC256) Reset() {
+	// the key and IV were checked by NewMAC256
+	_ = m.reset()
+}
+
+//Size returns the tag size in bytes.
+func (m *MAC256) Size() int { return 4 * m.words }
+
+//BlockSize returns 4, the keystream word size.
+func (m *MAC256) BlockSize() int { return 4 }
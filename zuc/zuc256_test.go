@@ -0,0 +1,66 @@
+package zuc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// The test vectors of "The ZUC-256 Stream Cipher", section 3
+func TestZUC256(t *testing.T) {
+	tests := []struct {
+		key, iv   []byte
+		keystream string
+	}{
+		{make([]byte, 32), make([]byte, 25), "58d03ad62e032ce2"},
+		{bytes.Repeat([]byte{0xff}, 32), bytes.Repeat([]byte{0xff}, 25), "3356cbaed1a1c18b"},
+	}
+	for _, test := range tests {
+		s, err := NewCipher256(test.key, test.iv)
+		assert.Nil(t, err)
+		out := make([]byte, 8)
+		s.XORKeyStream(out, out)
+		assert.Equal(t, test.keystream, hex.EncodeToString(out))
+	}
+
+	_, err := NewCipher256(make([]byte, 16), make([]byte, 25))
+	assert.NotNil(t, err)
+	_, err = NewCipher256(make([]byte, 32), make([]byte, 16))
+	assert.NotNil(t, err)
+}
+
+func TestMAC256(t *testing.T) {
+	zeros, ones := make([]byte, 32), bytes.Repeat([]byte{0xff}, 32)
+	tests := []struct {
+		key, iv, msg []byte
+		tags         []string // 32, 64 and 128-bit tags
+	}{
+		{zeros, zeros[:25], make([]byte, 50), []string{
+			"9b972a74", "673e54990034d38c", "d85e54bbcb9600967084c952a1654b26"}},
+		{ones, ones[:25], bytes.Repeat([]byte{0x11}, 500), []string{
+			"5c7c8b88", "ea1dee544bb6223b", "3a83b554be408ca5494124ed9d473205"}},
+	}
+	for _, test := range tests {
+		for i, size := range []int{4, 8, 16} {
+			m, err := NewMAC256(test.key, test.iv, size)
+			assert.Nil(t, err)
+			assert.Equal(t, size, m.Size())
+			_, _ = m.Write(test.msg)
+			assert.Equal(t, test.tags[i], hex.EncodeToString(m.Sum(nil)))
+			// Sum doesn't change the state
+			assert.Equal(t, test.tags[i], hex.EncodeToString(m.Sum(nil)))
+
+			m.Reset()
+			for _, b := range test.msg {
+				_, _ = m.Write([]byte{b})
+			}
+			assert.Equal(t, test.tags[i], hex.EncodeToString(m.Sum(nil)))
+		}
+	}
+
+	_, err := NewMAC256(zeros, zeros[:25], 12)
+	assert.NotNil(t, err)
+	_, err = NewMAC256(zeros[:16], zeros[:25], 4)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,96 @@
+package zuc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestSBoxes(t *testing.T) {
+	for _, s := range [][256]byte{s0, s1} {
+		var seen [256]bool
+		for _, v := range s {
+			assert.False(t, seen[v])
+			seen[v] = true
+		}
+	}
+}
+
+// GM/T 0001-2012 appendix A
+func TestZUC128(t *testing.T) {
+	tests := []struct {
+		key, iv, keystream string
+	}{
+		{"00000000000000000000000000000000", "00000000000000000000000000000000", "27bede74018082da"},
+		{"ffffffffffffffffffffffffffffffff", "ffffffffffffffffffffffffffffffff", "0657cfa07096398b"},
+	}
+	for _, test := range tests {
+		s, err := NewCipher(decodeHex(test.key), decodeHex(test.iv))
+		assert.Nil(t, err)
+		out := make([]byte, 8)
+		s.XORKeyStream(out, out)
+		assert.Equal(t, test.keystream, hex.EncodeToString(out))
+	}
+
+	_, err := NewCipher(make([]byte, 15), make([]byte, 16))
+	assert.NotNil(t, err)
+	_, err = NewCipher(make([]byte, 16), make([]byte, 8))
+	assert.NotNil(t, err)
+}
+
+func TestStreamChunks(t *testing.T) {
+	key, iv := bytes.Repeat([]byte{7}, 16), bytes.Repeat([]byte{9}, 16)
+	whole, _ := NewCipher(key, iv)
+	want := make([]byte, 101)
+	whole.XORKeyStream(want, want)
+
+	chunked, _ := NewCipher(key, iv)
+	got := make([]byte, 101)
+	rest := got
+	for _, n := range []int{1, 3, 0, 4, 17, 76} {
+		chunked.XORKeyStream(rest[:n], rest[:n])
+		rest = rest[n:]
+	}
+	assert.Equal(t, want, got)
+}
+
+// 3GPP confidentiality algorithm 128-EEA3, test set 1
+func TestEEA3(t *testing.T) {
+	s, err := NewEEA3(decodeHex("173d14ba5003731d7a60049470f00a29"), 0x66035492, 0xf, 0)
+	assert.Nil(t, err)
+	const length = 193
+	msg := decodeHex("6cf65340735552ab0c9752fa6f9025fe0bd675d9005875b200")
+	out := make([]byte, len(msg))
+	s.XORKeyStream(out, msg)
+	out[len(out)-1] &= ^byte(0xff >> (length % 8))
+	assert.Equal(t, "a6c85fc66afb8533aafc2518dfe784940ee1e4b030238cc800", hex.EncodeToString(out))
+
+	_, err = NewEEA3(make([]byte, 16), 0, 32, 0)
+	assert.NotNil(t, err)
+	_, err = NewEEA3(make([]byte, 16), 0, 0, 2)
+	assert.NotNil(t, err)
+}
+
+// 3GPP integrity algorithm 128-EIA3, test sets 1 and 3
+func TestEIA3(t *testing.T) {
+	mac, err := EIA3(make([]byte, 16), 0, 0, 0, make([]byte, 4), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0xc8a9595e), mac)
+
+	msg := decodeHex("983b41d47d780c9e1ad11d7eb70391b1de0b35da2dc62f83e7b78d6306ca0ea07e941b7be91348f9fcb170e2217fecd97f9f68adb16e5d7d21e569d280ed775cebde3f4093c5388100000000")
+	mac, err = EIA3(decodeHex("c9e6cec4607c72db000aefa88385ab0a"), 0xa94059da, 0xa, 1, msg, 577)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0xfae8ff0b), mac)
+
+	_, err = EIA3(make([]byte, 16), 0, 0, 0, make([]byte, 4), 33)
+	assert.NotNil(t, err)
+}